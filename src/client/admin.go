@@ -22,7 +22,13 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 
 // Extract all cluster state, call f with each operation.
 func (c APIClient) Extract(objects bool, f func(op *admin.Op) error) error {
-	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), &admin.ExtractRequest{NoObjects: !objects})
+	return c.ExtractWithRequest(&admin.ExtractRequest{NoObjects: !objects}, f)
+}
+
+// ExtractWithRequest extracts the cluster state selected by 'req' and calls f
+// with each operation.
+func (c APIClient) ExtractWithRequest(req *admin.ExtractRequest, f func(op *admin.Op) error) error {
+	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -55,8 +61,14 @@ func (c APIClient) ExtractAll(objects bool) ([]*admin.Op, error) {
 
 // ExtractWriter extracts all cluster state and marshals it to w.
 func (c APIClient) ExtractWriter(objects bool, w io.Writer) error {
+	return c.ExtractWriterWithRequest(&admin.ExtractRequest{NoObjects: !objects}, w)
+}
+
+// ExtractWriterWithRequest extracts the cluster state selected by 'req' and
+// marshals it to w.
+func (c APIClient) ExtractWriterWithRequest(req *admin.ExtractRequest, w io.Writer) error {
 	writer := pbutil.NewWriter(w)
-	return c.Extract(objects, func(op *admin.Op) error {
+	return c.ExtractWithRequest(req, func(op *admin.Op) error {
 		_, err := writer.Write(op)
 		return err
 	})
@@ -64,7 +76,27 @@ func (c APIClient) ExtractWriter(objects bool, w io.Writer) error {
 
 // ExtractURL extracts all cluster state and marshalls it to object storage.
 func (c APIClient) ExtractURL(url string) error {
-	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), &admin.ExtractRequest{URL: url})
+	return c.ExtractURLWithRequest(&admin.ExtractRequest{URL: url})
+}
+
+// ExtractURLWithManifest extracts cluster state to object storage along with a
+// manifest, which lets the extract be resumed if it's interrupted (by calling
+// this again with 'resume' set) and lets later extracts be incremental to it.
+// If 'baseURL' is set, only what's changed since the extract at 'baseURL' is
+// extracted.
+func (c APIClient) ExtractURLWithManifest(url string, baseURL string, resume bool) error {
+	return c.ExtractURLWithRequest(&admin.ExtractRequest{
+		URL:      url,
+		Manifest: true,
+		BaseURL:  baseURL,
+		Resume:   resume,
+	})
+}
+
+// ExtractURLWithRequest extracts the cluster state selected by 'req' to the
+// object storage URL in 'req'.
+func (c APIClient) ExtractURLWithRequest(req *admin.ExtractRequest) error {
+	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), req)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -112,6 +144,12 @@ func (c APIClient) Restore(ops []*admin.Op) (retErr error) {
 // RestoreReader restores cluster state from a reader containing marshaled ops.
 // Such as those written by ExtractWriter.
 func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
+	return c.RestoreReaderWithRequest(&admin.RestoreRequest{}, r)
+}
+
+// RestoreReaderWithRequest restores cluster state from a reader containing
+// marshaled ops, with the renames and conflict policy in 'req'.
+func (c APIClient) RestoreReaderWithRequest(req *admin.RestoreRequest, r io.Reader) (retErr error) {
 	restoreClient, err := c.AdminAPIClient.Restore(c.Ctx())
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -123,6 +161,8 @@ func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
 	}()
 	reader := pbutil.NewReader(r)
 	op := &admin.Op{}
+	// The restore's options are sent along with the first op
+	first := *req
 	for {
 		if err := reader.Read(op); err != nil {
 			if errors.Is(err, io.EOF) {
@@ -130,9 +170,11 @@ func (c APIClient) RestoreReader(r io.Reader) (retErr error) {
 			}
 			return err
 		}
-		if err := restoreClient.Send(&admin.RestoreRequest{Op: op}); err != nil {
+		first.Op = op
+		if err := restoreClient.Send(&first); err != nil {
 			return grpcutil.ScrubGRPC(err)
 		}
+		first = admin.RestoreRequest{}
 	}
 	return nil
 }
@@ -155,6 +197,23 @@ func (c APIClient) RestoreFrom(objects bool, otherC *APIClient) (retErr error) {
 
 // RestoreURL restures cluster state from object storage.
 func (c APIClient) RestoreURL(url string) (retErr error) {
+	return c.RestoreURLs(url, nil, false)
+}
+
+// RestoreURLs restores cluster state from the extract at 'url', followed by the
+// incremental extracts at 'incrementalURLs' (in order). If 'resume' is set,
+// segments that were restored by a previous, interrupted call are skipped.
+func (c APIClient) RestoreURLs(url string, incrementalURLs []string, resume bool) (retErr error) {
+	return c.RestoreURLWithRequest(&admin.RestoreRequest{
+		URL:             url,
+		IncrementalURLs: incrementalURLs,
+		Resume:          resume,
+	})
+}
+
+// RestoreURLWithRequest restores cluster state from the object storage URLs in
+// 'req', with the renames and conflict policy in 'req'.
+func (c APIClient) RestoreURLWithRequest(req *admin.RestoreRequest) (retErr error) {
 	restoreClient, err := c.AdminAPIClient.Restore(c.Ctx())
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -164,5 +223,55 @@ func (c APIClient) RestoreURL(url string) (retErr error) {
 			retErr = grpcutil.ScrubGRPC(err)
 		}
 	}()
-	return grpcutil.ScrubGRPC(restoreClient.Send(&admin.RestoreRequest{URL: url}))
+	return grpcutil.ScrubGRPC(restoreClient.Send(req))
+}
+
+// RestoreDryRunURL reads and validates the extracts at the object storage URLs
+// in 'req' (as RestoreURLWithRequest would restore them) without restoring
+// anything, and returns a report of what a restore would do.
+func (c APIClient) RestoreDryRunURL(req *admin.RestoreRequest) (*admin.RestoreReport, error) {
+	restoreClient, err := c.AdminAPIClient.RestoreDryRun(c.Ctx())
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	if err := restoreClient.Send(req); err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	report, err := restoreClient.CloseAndRecv()
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return report, nil
+}
+
+// RestoreDryRunReader reads and validates the marshaled ops in 'r' (as
+// RestoreReaderWithRequest would restore them) without restoring anything,
+// and returns a report of what a restore would do.
+func (c APIClient) RestoreDryRunReader(req *admin.RestoreRequest, r io.Reader) (*admin.RestoreReport, error) {
+	restoreClient, err := c.AdminAPIClient.RestoreDryRun(c.Ctx())
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	reader := pbutil.NewReader(r)
+	op := &admin.Op{}
+	// The restore's options are sent along with the first op
+	first := *req
+	for {
+		if err := reader.Read(op); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		first.Op = op
+		if err := restoreClient.Send(&first); err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		first = admin.RestoreRequest{}
+	}
+	report, err := restoreClient.CloseAndRecv()
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return report, nil
 }
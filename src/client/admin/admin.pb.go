@@ -19,6 +19,7 @@ import (
 	pps1 "github.com/pachyderm/pachyderm/src/client/admin/v1_8/pps"
 	pfs2 "github.com/pachyderm/pachyderm/src/client/admin/v1_9/pfs"
 	pps2 "github.com/pachyderm/pachyderm/src/client/admin/v1_9/pps"
+	auth "github.com/pachyderm/pachyderm/src/client/auth"
 	pfs5 "github.com/pachyderm/pachyderm/src/client/pfs"
 	pps5 "github.com/pachyderm/pachyderm/src/client/pps"
 	grpc "google.golang.org/grpc"
@@ -40,6 +41,68 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type ConflictPolicy int32
+
+const (
+	// MERGE restores into existing repos, skipping anything (commits, branches,
+	// pipelines) that already exists.
+	ConflictPolicy_MERGE ConflictPolicy = 0
+	// FAIL checks every restored repo for conflicts before creating any of them,
+	// and fails the restore if any already exist.
+	ConflictPolicy_FAIL ConflictPolicy = 1
+	// SKIP doesn't restore repos that already exist, nor their commits,
+	// branches, ACLs, pipelines or jobs.
+	ConflictPolicy_SKIP ConflictPolicy = 2
+)
+
+var ConflictPolicy_name = map[int32]string{
+	0: "MERGE",
+	1: "FAIL",
+	2: "SKIP",
+}
+
+var ConflictPolicy_value = map[string]int32{
+	"MERGE": 0,
+	"FAIL":  1,
+	"SKIP":  2,
+}
+
+func (x ConflictPolicy) String() string {
+	return proto.EnumName(ConflictPolicy_name, int32(x))
+}
+
+func (ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{0}
+}
+
+type RestoreIssue_Severity int32
+
+const (
+	// WARNING issues don't prevent a restore, but may mean that what's
+	// restored isn't what was extracted.
+	RestoreIssue_WARNING RestoreIssue_Severity = 0
+	// ERROR issues would fail (or corrupt) a restore.
+	RestoreIssue_ERROR RestoreIssue_Severity = 1
+)
+
+var RestoreIssue_Severity_name = map[int32]string{
+	0: "WARNING",
+	1: "ERROR",
+}
+
+var RestoreIssue_Severity_value = map[string]int32{
+	"WARNING": 0,
+	"ERROR":   1,
+}
+
+func (x RestoreIssue_Severity) String() string {
+	return proto.EnumName(RestoreIssue_Severity_name, int32(x))
+}
+
+func (RestoreIssue_Severity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{14, 0}
+}
+
 type Op1_7 struct {
 	Object               *pfs.PutObjectRequest      `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
 	Tag                  *pfs.TagObjectRequest      `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
@@ -548,18 +611,24 @@ func (m *Op1_11) GetJob() *pps4.CreateJobRequest {
 }
 
 type Op1_12 struct {
-	Object               *pfs5.PutObjectRequest      `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
-	CreateObject         *pfs5.CreateObjectRequest   `protobuf:"bytes,9,opt,name=create_object,json=createObject,proto3" json:"create_object,omitempty"`
-	Tag                  *pfs5.TagObjectRequest      `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
-	Block                *pfs5.PutBlockRequest       `protobuf:"bytes,10,opt,name=block,proto3" json:"block,omitempty"`
-	Repo                 *pfs5.CreateRepoRequest     `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
-	Commit               *pfs5.BuildCommitRequest    `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty"`
-	Branch               *pfs5.CreateBranchRequest   `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
-	Pipeline             *pps5.CreatePipelineRequest `protobuf:"bytes,7,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	Job                  *pps5.CreateJobRequest      `protobuf:"bytes,8,opt,name=job,proto3" json:"job,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	Object       *pfs5.PutObjectRequest      `protobuf:"bytes,2,opt,name=object,proto3" json:"object,omitempty"`
+	CreateObject *pfs5.CreateObjectRequest   `protobuf:"bytes,9,opt,name=create_object,json=createObject,proto3" json:"create_object,omitempty"`
+	Tag          *pfs5.TagObjectRequest      `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	Block        *pfs5.PutBlockRequest       `protobuf:"bytes,10,opt,name=block,proto3" json:"block,omitempty"`
+	Repo         *pfs5.CreateRepoRequest     `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit       *pfs5.BuildCommitRequest    `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty"`
+	Branch       *pfs5.CreateBranchRequest   `protobuf:"bytes,6,opt,name=branch,proto3" json:"branch,omitempty"`
+	Pipeline     *pps5.CreatePipelineRequest `protobuf:"bytes,7,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	Job          *pps5.CreateJobRequest      `protobuf:"bytes,8,opt,name=job,proto3" json:"job,omitempty"`
+	// acl restores the ACL of a repo (including its branch ACLs) or pipeline.
+	// It's only extracted from, and restored to, clusters with auth activated.
+	Acl *auth.SetACLRequest `protobuf:"bytes,11,opt,name=acl,proto3" json:"acl,omitempty"`
+	// tombstone deletes something that was in the extract that an incremental
+	// extract is based on.
+	Tombstone            *Tombstone `protobuf:"bytes,12,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
 func (m *Op1_12) Reset()         { *m = Op1_12{} }
@@ -658,6 +727,94 @@ func (m *Op1_12) GetJob() *pps5.CreateJobRequest {
 	return nil
 }
 
+func (m *Op1_12) GetAcl() *auth.SetACLRequest {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+func (m *Op1_12) GetTombstone() *Tombstone {
+	if m != nil {
+		return m.Tombstone
+	}
+	return nil
+}
+
+// Tombstone records that a repo, commit, branch or pipeline that was in the
+// extract that an incremental extract is based on has since been deleted.
+// Exactly one field is set.
+type Tombstone struct {
+	Repo                 *pfs5.Repo     `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Commit               *pfs5.Commit   `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	Branch               *pfs5.Branch   `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Pipeline             *pps5.Pipeline `protobuf:"bytes,4,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Tombstone) Reset()         { *m = Tombstone{} }
+func (m *Tombstone) String() string { return proto.CompactTextString(m) }
+func (*Tombstone) ProtoMessage()    {}
+func (*Tombstone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{6}
+}
+func (m *Tombstone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Tombstone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Tombstone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Tombstone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Tombstone.Merge(m, src)
+}
+func (m *Tombstone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Tombstone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Tombstone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Tombstone proto.InternalMessageInfo
+
+func (m *Tombstone) GetRepo() *pfs5.Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *Tombstone) GetCommit() *pfs5.Commit {
+	if m != nil {
+		return m.Commit
+	}
+	return nil
+}
+
+func (m *Tombstone) GetBranch() *pfs5.Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *Tombstone) GetPipeline() *pps5.Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
 type Op struct {
 	Op1_7                *Op1_7   `protobuf:"bytes,1,opt,name=op1_7,json=op17,proto3" json:"op1_7,omitempty"`
 	Op1_8                *Op1_8   `protobuf:"bytes,2,opt,name=op1_8,json=op18,proto3" json:"op1_8,omitempty"`
@@ -674,7 +831,7 @@ func (m *Op) Reset()         { *m = Op{} }
 func (m *Op) String() string { return proto.CompactTextString(m) }
 func (*Op) ProtoMessage()    {}
 func (*Op) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6597bb2f2302afbd, []int{6}
+	return fileDescriptor_6597bb2f2302afbd, []int{7}
 }
 func (m *Op) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +911,45 @@ type ExtractRequest struct {
 	// NoRepos, if true, will cause extract to omit repos, commits and branches.
 	NoRepos bool `protobuf:"varint,3,opt,name=no_repos,json=noRepos,proto3" json:"no_repos,omitempty"`
 	// NoPipelines, if true, will cause extract to omit pipelines.
-	NoPipelines          bool     `protobuf:"varint,4,opt,name=no_pipelines,json=noPipelines,proto3" json:"no_pipelines,omitempty"`
+	NoPipelines bool `protobuf:"varint,4,opt,name=no_pipelines,json=noPipelines,proto3" json:"no_pipelines,omitempty"`
+	// Manifest, if true, causes an extract to URL to be written as a series of
+	// segments (<URL>.0, <URL>.1, ...) along with a BackupManifest (at
+	// <URL>.manifest). The manifest lets an interrupted extract be resumed, and
+	// lets later extracts be incremental to this one.
+	Manifest bool `protobuf:"varint,5,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	// BaseURL, if set, is the URL of a previous extract (written with a
+	// manifest), and causes extract to only write what has been created or
+	// changed since, along with tombstones for what has been deleted. Implies
+	// Manifest.
+	BaseURL string `protobuf:"bytes,6,opt,name=base_URL,json=baseURL,proto3" json:"base_URL,omitempty"`
+	// Resume, if true, resumes an interrupted extract to URL (written with a
+	// manifest), skipping everything in its completed segments. Implies
+	// Manifest.
+	Resume bool `protobuf:"varint,7,opt,name=resume,proto3" json:"resume,omitempty"`
+	// The remaining fields, if set, limit the extract to part of the cluster
+	// (e.g. to migrate one project's DAG to another cluster). Only the objects,
+	// blocks and tags that the extracted commits refer to are extracted.
+	//
+	// Repos, if set, limits the extract to these repos (along with their
+	// commits, branches and ACLs).
+	Repos []string `protobuf:"bytes,8,rep,name=repos,proto3" json:"repos,omitempty"`
+	// Pipelines, if set, limits the extract to these pipelines (along with their
+	// output repos, spec commits, ACLs and jobs). If Repos is also set, both the
+	// repos and the pipelines are extracted.
+	Pipelines []string `protobuf:"bytes,9,rep,name=pipelines,proto3" json:"pipelines,omitempty"`
+	// Branches, if set, limits the extracted branches of any repo that has a
+	// branch in the list to the listed branches, and the extracted commits of
+	// that repo to the commits on those branches. Each branch must be in a repo
+	// that is extracted.
+	Branches []*pfs5.Branch `protobuf:"bytes,10,rep,name=branches,proto3" json:"branches,omitempty"`
+	// CommitRanges, if set, limits the extracted commits of each repo that has a
+	// range to the commits in that range. Each range must be in a repo that is
+	// extracted.
+	CommitRanges []*CommitRange `protobuf:"bytes,11,rep,name=commit_ranges,json=commitRanges,proto3" json:"commit_ranges,omitempty"`
+	// IncludeProvenance, if true, also extracts everything that the selected
+	// repos and pipelines are provenant on (i.e. the upstream repos and the
+	// pipelines that produce them), transitively.
+	IncludeProvenance    bool     `protobuf:"varint,12,opt,name=include_provenance,json=includeProvenance,proto3" json:"include_provenance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -764,7 +959,7 @@ func (m *ExtractRequest) Reset()         { *m = ExtractRequest{} }
 func (m *ExtractRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtractRequest) ProtoMessage()    {}
 func (*ExtractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6597bb2f2302afbd, []int{7}
+	return fileDescriptor_6597bb2f2302afbd, []int{8}
 }
 func (m *ExtractRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,6 +1016,245 @@ func (m *ExtractRequest) GetNoPipelines() bool {
 	return false
 }
 
+func (m *ExtractRequest) GetManifest() bool {
+	if m != nil {
+		return m.Manifest
+	}
+	return false
+}
+
+func (m *ExtractRequest) GetBaseURL() string {
+	if m != nil {
+		return m.BaseURL
+	}
+	return ""
+}
+
+func (m *ExtractRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+func (m *ExtractRequest) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetPipelines() []string {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetBranches() []*pfs5.Branch {
+	if m != nil {
+		return m.Branches
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetCommitRanges() []*CommitRange {
+	if m != nil {
+		return m.CommitRanges
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetIncludeProvenance() bool {
+	if m != nil {
+		return m.IncludeProvenance
+	}
+	return false
+}
+
+// CommitRange is the commits in a repo after 'from' (exclusive), up to and
+// including 'to'. If 'from' is unset, the range includes all of the ancestors
+// of 'to'.
+type CommitRange struct {
+	From                 *pfs5.Commit `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *pfs5.Commit `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CommitRange) Reset()         { *m = CommitRange{} }
+func (m *CommitRange) String() string { return proto.CompactTextString(m) }
+func (*CommitRange) ProtoMessage()    {}
+func (*CommitRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{9}
+}
+func (m *CommitRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommitRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommitRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommitRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommitRange.Merge(m, src)
+}
+func (m *CommitRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommitRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommitRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommitRange proto.InternalMessageInfo
+
+func (m *CommitRange) GetFrom() *pfs5.Commit {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *CommitRange) GetTo() *pfs5.Commit {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+// BackupManifest records the contents of an extract written to object storage,
+// so that the extract can be resumed if it's interrupted, and so that later
+// extracts can be incremental to it.
+type BackupManifest struct {
+	// ID identifies the extract, and BaseID identifies the extract that it's
+	// incremental to (or is "" for full extracts).
+	ID     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BaseID string `protobuf:"bytes,2,opt,name=base_id,json=baseId,proto3" json:"base_id,omitempty"`
+	// EtcdRevision is the etcd revision at which the extract started. An
+	// extract that's incremental to this one includes what's changed in etcd
+	// since this revision, and BaseEtcdRevision is the EtcdRevision of the
+	// extract that this one is incremental to.
+	EtcdRevision     int64 `protobuf:"varint,3,opt,name=etcd_revision,json=etcdRevision,proto3" json:"etcd_revision,omitempty"`
+	BaseEtcdRevision int64 `protobuf:"varint,9,opt,name=base_etcd_revision,json=baseEtcdRevision,proto3" json:"base_etcd_revision,omitempty"`
+	// Segments is the number of segments that have been completely written.
+	Segments int64 `protobuf:"varint,4,opt,name=segments,proto3" json:"segments,omitempty"`
+	// Complete is true once every segment of the extract has been written.
+	Complete bool             `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	Started  *types.Timestamp `protobuf:"bytes,6,opt,name=started,proto3" json:"started,omitempty"`
+	Finished *types.Timestamp `protobuf:"bytes,7,opt,name=finished,proto3" json:"finished,omitempty"`
+	// Entries identify what's in the completed segments of an extract that's
+	// still in progress (e.g. "block/<hash>" or "commit/<repo>@<id>"), so that
+	// it can be resumed. They're cleared once the extract is complete.
+	Entries              []string `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupManifest) Reset()         { *m = BackupManifest{} }
+func (m *BackupManifest) String() string { return proto.CompactTextString(m) }
+func (*BackupManifest) ProtoMessage()    {}
+func (*BackupManifest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{10}
+}
+func (m *BackupManifest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupManifest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupManifest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupManifest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupManifest.Merge(m, src)
+}
+func (m *BackupManifest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupManifest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupManifest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupManifest proto.InternalMessageInfo
+
+func (m *BackupManifest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetBaseID() string {
+	if m != nil {
+		return m.BaseID
+	}
+	return ""
+}
+
+func (m *BackupManifest) GetEtcdRevision() int64 {
+	if m != nil {
+		return m.EtcdRevision
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetBaseEtcdRevision() int64 {
+	if m != nil {
+		return m.BaseEtcdRevision
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetSegments() int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return 0
+}
+
+func (m *BackupManifest) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *BackupManifest) GetStarted() *types.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *BackupManifest) GetFinished() *types.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *BackupManifest) GetEntries() []string {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 type ExtractPipelineRequest struct {
 	Pipeline             *pps5.Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -832,7 +1266,7 @@ func (m *ExtractPipelineRequest) Reset()         { *m = ExtractPipelineRequest{}
 func (m *ExtractPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ExtractPipelineRequest) ProtoMessage()    {}
 func (*ExtractPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6597bb2f2302afbd, []int{8}
+	return fileDescriptor_6597bb2f2302afbd, []int{11}
 }
 func (m *ExtractPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -872,17 +1306,34 @@ type RestoreRequest struct {
 	Op *Op `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
 	// URL is an object storage URL, if it's not "" data will be restored from
 	// this URL.
-	URL                  string   `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	// IncrementalURLs are extracts (written with manifests) that are restored,
+	// in order, after URL. Each must be incremental to the one before it.
+	IncrementalURLs []string `protobuf:"bytes,3,rep,name=incremental_URLs,json=incrementalURLs,proto3" json:"incremental_URLs,omitempty"`
+	// Resume, if true, skips the segments of URL and IncrementalURLs that a
+	// previous, interrupted restore into this cluster already restored.
+	Resume bool `protobuf:"varint,4,opt,name=resume,proto3" json:"resume,omitempty"`
+	// The remaining fields are only read from the first request in the stream.
+	//
+	// RenameRepos maps the names of extracted repos to the names that they're
+	// restored as.
+	RenameRepos map[string]string `protobuf:"bytes,5,rep,name=rename_repos,json=renameRepos,proto3" json:"rename_repos,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// RenamePipelines maps the names of extracted pipelines to the names that
+	// they're restored as. Their output repos and spec branches are renamed too.
+	RenamePipelines map[string]string `protobuf:"bytes,6,rep,name=rename_pipelines,json=renamePipelines,proto3" json:"rename_pipelines,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// OnConflict determines what happens when a restored repo (after renaming)
+	// already exists in the cluster.
+	OnConflict           ConflictPolicy `protobuf:"varint,7,opt,name=on_conflict,json=onConflict,proto3,enum=admin.ConflictPolicy" json:"on_conflict,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *RestoreRequest) Reset()         { *m = RestoreRequest{} }
 func (m *RestoreRequest) String() string { return proto.CompactTextString(m) }
 func (*RestoreRequest) ProtoMessage()    {}
 func (*RestoreRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6597bb2f2302afbd, []int{9}
+	return fileDescriptor_6597bb2f2302afbd, []int{12}
 }
 func (m *RestoreRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,22 +1376,340 @@ func (m *RestoreRequest) GetURL() string {
 	return ""
 }
 
-type ClusterInfo struct {
-	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	DeploymentID         string   `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RestoreRequest) GetIncrementalURLs() []string {
+	if m != nil {
+		return m.IncrementalURLs
+	}
+	return nil
 }
 
-func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
-func (m *ClusterInfo) String() string { return proto.CompactTextString(m) }
-func (*ClusterInfo) ProtoMessage()    {}
-func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6597bb2f2302afbd, []int{10}
-}
-func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *RestoreRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+func (m *RestoreRequest) GetRenameRepos() map[string]string {
+	if m != nil {
+		return m.RenameRepos
+	}
+	return nil
+}
+
+func (m *RestoreRequest) GetRenamePipelines() map[string]string {
+	if m != nil {
+		return m.RenamePipelines
+	}
+	return nil
+}
+
+func (m *RestoreRequest) GetOnConflict() ConflictPolicy {
+	if m != nil {
+		return m.OnConflict
+	}
+	return ConflictPolicy_MERGE
+}
+
+// RestoreCheckpoint records the progress of restoring an extract (written with
+// a manifest) into a cluster. It's written next to the extract, at
+// <URL>.restore-<deployment ID>.
+type RestoreCheckpoint struct {
+	// ID is the ID of the restored extract's manifest.
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Segments is the number of segments that have been completely restored.
+	Segments             int64    `protobuf:"varint,2,opt,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreCheckpoint) Reset()         { *m = RestoreCheckpoint{} }
+func (m *RestoreCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*RestoreCheckpoint) ProtoMessage()    {}
+func (*RestoreCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{13}
+}
+func (m *RestoreCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCheckpoint.Merge(m, src)
+}
+func (m *RestoreCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCheckpoint proto.InternalMessageInfo
+
+func (m *RestoreCheckpoint) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *RestoreCheckpoint) GetSegments() int64 {
+	if m != nil {
+		return m.Segments
+	}
+	return 0
+}
+
+// RestoreIssue is a problem found by RestoreDryRun.
+type RestoreIssue struct {
+	Severity RestoreIssue_Severity `protobuf:"varint,1,opt,name=severity,proto3,enum=admin.RestoreIssue_Severity" json:"severity,omitempty"`
+	// Op describes the op with the issue, e.g. "pipeline edges" or "op 17" (the
+	// 17th op in the extract), or is "" for issues with the extract as a whole.
+	Op                   string   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreIssue) Reset()         { *m = RestoreIssue{} }
+func (m *RestoreIssue) String() string { return proto.CompactTextString(m) }
+func (*RestoreIssue) ProtoMessage()    {}
+func (*RestoreIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{14}
+}
+func (m *RestoreIssue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreIssue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreIssue.Merge(m, src)
+}
+func (m *RestoreIssue) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreIssue proto.InternalMessageInfo
+
+func (m *RestoreIssue) GetSeverity() RestoreIssue_Severity {
+	if m != nil {
+		return m.Severity
+	}
+	return RestoreIssue_WARNING
+}
+
+func (m *RestoreIssue) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *RestoreIssue) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// RestoreReport is the result of RestoreDryRun.
+type RestoreReport struct {
+	// Version is the version of the extract's ops, e.g. "1.9". Ops older than
+	// the cluster's version are converted before they're restored.
+	Version   string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Ops       int64  `protobuf:"varint,2,opt,name=ops,proto3" json:"ops,omitempty"`
+	Repos     int64  `protobuf:"varint,3,opt,name=repos,proto3" json:"repos,omitempty"`
+	Commits   int64  `protobuf:"varint,4,opt,name=commits,proto3" json:"commits,omitempty"`
+	Branches  int64  `protobuf:"varint,5,opt,name=branches,proto3" json:"branches,omitempty"`
+	Pipelines int64  `protobuf:"varint,6,opt,name=pipelines,proto3" json:"pipelines,omitempty"`
+	Jobs      int64  `protobuf:"varint,7,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	Objects   int64  `protobuf:"varint,8,opt,name=objects,proto3" json:"objects,omitempty"`
+	Blocks    int64  `protobuf:"varint,9,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	Acls      int64  `protobuf:"varint,10,opt,name=acls,proto3" json:"acls,omitempty"`
+	// DataBytes is the size of the object and block data in the extract, which
+	// the restore writes to object storage.
+	DataBytes uint64 `protobuf:"varint,11,opt,name=data_bytes,json=dataBytes,proto3" json:"data_bytes,omitempty"`
+	// CommitBytes is the total size of the restored commits.
+	CommitBytes          uint64          `protobuf:"varint,12,opt,name=commit_bytes,json=commitBytes,proto3" json:"commit_bytes,omitempty"`
+	Issues               []*RestoreIssue `protobuf:"bytes,13,rep,name=issues,proto3" json:"issues,omitempty"`
+	Tombstones           int64           `protobuf:"varint,14,opt,name=tombstones,proto3" json:"tombstones,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RestoreReport) Reset()         { *m = RestoreReport{} }
+func (m *RestoreReport) String() string { return proto.CompactTextString(m) }
+func (*RestoreReport) ProtoMessage()    {}
+func (*RestoreReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{15}
+}
+func (m *RestoreReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestoreReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestoreReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestoreReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreReport.Merge(m, src)
+}
+func (m *RestoreReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestoreReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreReport proto.InternalMessageInfo
+
+func (m *RestoreReport) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *RestoreReport) GetOps() int64 {
+	if m != nil {
+		return m.Ops
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetRepos() int64 {
+	if m != nil {
+		return m.Repos
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetCommits() int64 {
+	if m != nil {
+		return m.Commits
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetBranches() int64 {
+	if m != nil {
+		return m.Branches
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetPipelines() int64 {
+	if m != nil {
+		return m.Pipelines
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetJobs() int64 {
+	if m != nil {
+		return m.Jobs
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetObjects() int64 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetAcls() int64 {
+	if m != nil {
+		return m.Acls
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetDataBytes() uint64 {
+	if m != nil {
+		return m.DataBytes
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetCommitBytes() uint64 {
+	if m != nil {
+		return m.CommitBytes
+	}
+	return 0
+}
+
+func (m *RestoreReport) GetIssues() []*RestoreIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+func (m *RestoreReport) GetTombstones() int64 {
+	if m != nil {
+		return m.Tombstones
+	}
+	return 0
+}
+
+type ClusterInfo struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeploymentID         string   `protobuf:"bytes,2,opt,name=deployment_id,json=deploymentId,proto3" json:"deployment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
+func (m *ClusterInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusterInfo) ProtoMessage()    {}
+func (*ClusterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6597bb2f2302afbd, []int{16}
+}
+func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
 func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
@@ -981,88 +1750,154 @@ func (m *ClusterInfo) GetDeploymentID() string {
 }
 
 func init() {
+	proto.RegisterEnum("admin.ConflictPolicy", ConflictPolicy_name, ConflictPolicy_value)
+	proto.RegisterEnum("admin.RestoreIssue_Severity", RestoreIssue_Severity_name, RestoreIssue_Severity_value)
 	proto.RegisterType((*Op1_7)(nil), "admin.Op1_7")
 	proto.RegisterType((*Op1_8)(nil), "admin.Op1_8")
 	proto.RegisterType((*Op1_9)(nil), "admin.Op1_9")
 	proto.RegisterType((*Op1_10)(nil), "admin.Op1_10")
 	proto.RegisterType((*Op1_11)(nil), "admin.Op1_11")
 	proto.RegisterType((*Op1_12)(nil), "admin.Op1_12")
+	proto.RegisterType((*Tombstone)(nil), "admin.Tombstone")
 	proto.RegisterType((*Op)(nil), "admin.Op")
 	proto.RegisterType((*ExtractRequest)(nil), "admin.ExtractRequest")
+	proto.RegisterType((*CommitRange)(nil), "admin.CommitRange")
+	proto.RegisterType((*BackupManifest)(nil), "admin.BackupManifest")
 	proto.RegisterType((*ExtractPipelineRequest)(nil), "admin.ExtractPipelineRequest")
 	proto.RegisterType((*RestoreRequest)(nil), "admin.RestoreRequest")
+	proto.RegisterMapType((map[string]string)(nil), "admin.RestoreRequest.RenamePipelinesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "admin.RestoreRequest.RenameReposEntry")
+	proto.RegisterType((*RestoreCheckpoint)(nil), "admin.RestoreCheckpoint")
+	proto.RegisterType((*RestoreIssue)(nil), "admin.RestoreIssue")
+	proto.RegisterType((*RestoreReport)(nil), "admin.RestoreReport")
 	proto.RegisterType((*ClusterInfo)(nil), "admin.ClusterInfo")
 }
 
 func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptor_6597bb2f2302afbd) }
 
 var fileDescriptor_6597bb2f2302afbd = []byte{
-	// 1034 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x97, 0x41, 0x6f, 0xe3, 0x44,
-	0x18, 0x86, 0xeb, 0xa4, 0x49, 0xd3, 0x69, 0x5a, 0x56, 0xa3, 0xb6, 0xb8, 0xe9, 0xb6, 0xdd, 0x8d,
-	0x90, 0x76, 0x59, 0x16, 0x3b, 0x93, 0xdd, 0xa5, 0x36, 0x50, 0xa4, 0x4d, 0xdb, 0x43, 0x10, 0x52,
-	0x2b, 0x0b, 0x2e, 0x08, 0x29, 0x4a, 0x9c, 0x69, 0xea, 0x92, 0x78, 0x86, 0xd8, 0x41, 0xf4, 0xc4,
-	0x0f, 0x43, 0xe2, 0xcc, 0x91, 0x5f, 0x50, 0x50, 0x4e, 0xdc, 0xf9, 0x03, 0xc8, 0xe3, 0xf1, 0x64,
-	0x3c, 0xb1, 0x1b, 0x92, 0x83, 0x2b, 0xd7, 0xf3, 0xbe, 0xdf, 0x7c, 0xdf, 0xfb, 0x58, 0xad, 0x07,
-	0xe8, 0xee, 0xd0, 0xc3, 0x7e, 0x68, 0x76, 0xfb, 0x23, 0xcf, 0x8f, 0x7f, 0x1a, 0x74, 0x4c, 0x42,
-	0x02, 0x4b, 0xec, 0x97, 0xda, 0xe1, 0x80, 0x90, 0xc1, 0x10, 0x9b, 0xec, 0x61, 0x6f, 0x72, 0x63,
-	0xe2, 0x11, 0x0d, 0xef, 0x63, 0x4d, 0x6d, 0x77, 0x40, 0x06, 0x84, 0xdd, 0x9a, 0xd1, 0x1d, 0x7f,
-	0x7a, 0x92, 0xaa, 0xf9, 0x33, 0xea, 0x9c, 0x9a, 0xf4, 0x26, 0x88, 0xae, 0x47, 0x04, 0x34, 0x88,
-	0xae, 0x3c, 0x81, 0xb5, 0xa8, 0x82, 0xb5, 0xa8, 0x82, 0xbd, 0xa8, 0x82, 0xad, 0x54, 0x78, 0xa6,
-	0x0a, 0x50, 0x43, 0x29, 0x91, 0xa9, 0x58, 0x50, 0x03, 0x2d, 0xac, 0x81, 0x94, 0x1a, 0xbb, 0x5c,
-	0x91, 0xf6, 0x89, 0xa7, 0xb2, 0xb6, 0xfe, 0x7b, 0x01, 0x94, 0xae, 0x28, 0xea, 0x9c, 0x42, 0x04,
-	0xca, 0xa4, 0x77, 0x87, 0xdd, 0x50, 0x2f, 0x3c, 0xd3, 0x5e, 0x6e, 0x35, 0x0f, 0x0c, 0x7a, 0x13,
-	0x74, 0x50, 0xe7, 0xd4, 0xb8, 0x9e, 0x84, 0x57, 0x6c, 0xc5, 0xc1, 0x3f, 0x4d, 0x70, 0x10, 0x3a,
-	0x5c, 0x08, 0x3f, 0x01, 0xc5, 0xb0, 0x3b, 0xd0, 0x8b, 0x8a, 0xfe, 0xdb, 0xee, 0x20, 0xad, 0x8f,
-	0x54, 0xd0, 0x00, 0xeb, 0x63, 0x4c, 0x89, 0xbe, 0xce, 0xd4, 0x35, 0xa1, 0x3e, 0x1f, 0xe3, 0x6e,
-	0x88, 0x1d, 0x4c, 0x49, 0x22, 0x67, 0x3a, 0xf8, 0x06, 0x94, 0x5d, 0x32, 0x1a, 0x79, 0xa1, 0x5e,
-	0x62, 0x8e, 0x43, 0xe1, 0x68, 0x4d, 0xbc, 0x61, 0xff, 0x9c, 0xad, 0x89, 0x8e, 0x62, 0x29, 0x7c,
-	0x0b, 0xca, 0xbd, 0x71, 0xd7, 0x77, 0x6f, 0xf5, 0x32, 0x33, 0x3d, 0x55, 0xb6, 0x69, 0xb1, 0x45,
-	0xe1, 0x8a, 0xb5, 0xf0, 0x73, 0x50, 0xa1, 0x1e, 0xc5, 0x43, 0xcf, 0xc7, 0xfa, 0x06, 0xf3, 0x1d,
-	0x1b, 0x94, 0xca, 0xbe, 0x6b, 0xbe, 0x9c, 0x38, 0x85, 0x5e, 0x04, 0x68, 0xe5, 0x06, 0x68, 0x2d,
-	0x19, 0xa0, 0xb5, 0x54, 0x80, 0xd6, 0xd2, 0x01, 0x5a, 0xab, 0x04, 0x68, 0xad, 0x18, 0xa0, 0xb5,
-	0x30, 0xc0, 0x87, 0x62, 0x1c, 0xa0, 0x9d, 0x1b, 0xa0, 0x9d, 0x1f, 0xe0, 0x7b, 0xb0, 0xed, 0xb2,
-	0xfa, 0x1d, 0xee, 0xdc, 0x4c, 0x75, 0x6d, 0xf3, 0xdd, 0xd3, 0xe6, 0xaa, 0x2b, 0x3d, 0xcc, 0x66,
-	0x60, 0xe7, 0x32, 0x28, 0xf5, 0x86, 0xc4, 0xfd, 0x51, 0x07, 0x4c, 0xae, 0xcb, 0x1d, 0xb6, 0xa2,
-	0x85, 0x44, 0x1d, 0xcb, 0x72, 0x98, 0xd9, 0x4b, 0x33, 0xb3, 0x57, 0x61, 0x66, 0xaf, 0xc8, 0xcc,
-	0x5e, 0xc4, 0x2c, 0xca, 0xec, 0x8e, 0xf4, 0xf4, 0x4a, 0x92, 0x59, 0xca, 0xf6, 0x35, 0xe9, 0x89,
-	0xcc, 0xee, 0x48, 0xaf, 0xfe, 0x4f, 0x11, 0x94, 0x23, 0xc0, 0xa8, 0x01, 0x9b, 0x0a, 0xe1, 0x24,
-	0x10, 0xd4, 0xc8, 0x47, 0xdc, 0xca, 0x46, 0x7c, 0x34, 0xb3, 0x2e, 0x66, 0xfc, 0x5a, 0x66, 0x2c,
-	0x6d, 0x9a, 0x0d, 0xd9, 0x4c, 0x43, 0x3e, 0x48, 0x35, 0x99, 0x45, 0xd9, 0x4c, 0x51, 0x3e, 0x54,
-	0x3b, 0x9b, 0xc7, 0xfc, 0x56, 0xc1, 0xfc, 0x74, 0x66, 0x79, 0x84, 0xf3, 0x3b, 0x85, 0xf3, 0x5c,
-	0x04, 0xd9, 0xa0, 0xbf, 0x98, 0x03, 0x7d, 0xc2, 0x89, 0x09, 0x63, 0x3e, 0xe9, 0xd7, 0x32, 0xe9,
-	0x9a, 0xea, 0xcb, 0x45, 0x8d, 0xf2, 0x51, 0xa3, 0xd5, 0x51, 0xa3, 0x95, 0x51, 0xa3, 0x25, 0x51,
-	0xa3, 0x25, 0x51, 0xa3, 0xe5, 0x51, 0xa3, 0x95, 0x50, 0xa3, 0x55, 0x51, 0xa3, 0x15, 0x51, 0xa3,
-	0x1c, 0xd4, 0xbf, 0x25, 0xa8, 0x9b, 0xf0, 0x53, 0x05, 0xf5, 0x5e, 0xd4, 0x6c, 0x3e, 0xe5, 0xb3,
-	0x6c, 0xca, 0xec, 0x6f, 0xe9, 0xff, 0x00, 0xfc, 0x42, 0x06, 0x1c, 0x6f, 0x95, 0xcd, 0xf6, 0x55,
-	0x9a, 0xed, 0x6e, 0xd2, 0x55, 0x16, 0xd6, 0x57, 0x29, 0xac, 0xfb, 0x52, 0x2b, 0xf3, 0x44, 0x4d,
-	0x85, 0xe8, 0x87, 0x4c, 0xfd, 0x08, 0xcc, 0x86, 0x02, 0x53, 0x9e, 0x34, 0x9b, 0xe3, 0x67, 0x73,
-	0x1c, 0x19, 0x8f, 0x85, 0x08, 0x5f, 0xc8, 0x08, 0xf7, 0x24, 0x8b, 0x4a, 0xef, 0x2f, 0x0d, 0x14,
-	0xae, 0x28, 0x7c, 0x0e, 0x4a, 0x24, 0xfa, 0xf8, 0xd3, 0x35, 0xe6, 0xa8, 0x1a, 0xf1, 0xe7, 0x3c,
-	0xfb, 0x20, 0x74, 0xd6, 0x09, 0x45, 0xa7, 0x89, 0xc4, 0xe2, 0x6c, 0x65, 0x89, 0xc5, 0x24, 0x56,
-	0x22, 0xb1, 0x39, 0x13, 0x59, 0x62, 0x33, 0x89, 0x0d, 0x3f, 0x02, 0x65, 0xc2, 0xfe, 0x05, 0xf0,
-	0x84, 0xb7, 0x25, 0x0d, 0x6a, 0x38, 0x91, 0x1f, 0x35, 0x84, 0x0a, 0xf1, 0x64, 0x53, 0x2a, 0x14,
-	0xab, 0x90, 0x50, 0x35, 0x79, 0x9c, 0x29, 0x55, 0x33, 0x56, 0x35, 0xeb, 0xbf, 0x82, 0x9d, 0xcb,
-	0x5f, 0xc2, 0x71, 0x57, 0xbc, 0x14, 0xf0, 0x09, 0x28, 0x7e, 0xe7, 0x7c, 0xc3, 0x46, 0xdd, 0x74,
-	0xa2, 0x5b, 0x78, 0x04, 0x80, 0x4f, 0xf8, 0x5b, 0x18, 0xb0, 0x01, 0x2b, 0xce, 0xa6, 0x4f, 0xe2,
-	0x77, 0x29, 0x80, 0x07, 0xa0, 0xe2, 0x93, 0x4e, 0xc4, 0x3c, 0x60, 0xa3, 0x55, 0x9c, 0x0d, 0x9f,
-	0x44, 0xef, 0x43, 0x00, 0x9f, 0x83, 0xaa, 0x4f, 0x3a, 0x49, 0xee, 0x01, 0x9b, 0xaa, 0xe2, 0x6c,
-	0xf9, 0x24, 0x61, 0x13, 0xd4, 0xcf, 0xc1, 0x3e, 0x6f, 0x40, 0xe1, 0x05, 0x3f, 0x96, 0xe8, 0x6a,
-	0x7c, 0x84, 0x08, 0x95, 0xd0, 0xcd, 0x3e, 0x8e, 0xce, 0xc0, 0x8e, 0x83, 0x83, 0x90, 0x8c, 0x85,
-	0xf9, 0x00, 0x14, 0x08, 0xe5, 0xb6, 0x4d, 0x31, 0xb9, 0x53, 0x20, 0x34, 0x19, 0xb0, 0x20, 0x06,
-	0xac, 0xff, 0x00, 0xb6, 0xce, 0x87, 0x93, 0x20, 0xc4, 0xe3, 0xb6, 0x7f, 0x43, 0xe0, 0x3e, 0x28,
-	0x78, 0xfd, 0x38, 0x80, 0x56, 0x79, 0xfa, 0x70, 0x52, 0x68, 0x5f, 0x38, 0x05, 0xaf, 0x0f, 0xdf,
-	0x81, 0xed, 0x3e, 0xa6, 0x43, 0x72, 0x3f, 0xc2, 0x7e, 0xd8, 0xf1, 0xfa, 0x71, 0x89, 0xd6, 0x93,
-	0xe9, 0xc3, 0x49, 0xf5, 0x42, 0x2c, 0xb4, 0x2f, 0x9c, 0xea, 0x4c, 0xd6, 0xee, 0x37, 0xff, 0xd5,
-	0x40, 0xf1, 0xfd, 0x75, 0x1b, 0x9a, 0x60, 0x83, 0x4f, 0x0a, 0xf7, 0x78, 0x47, 0xe9, 0xe8, 0x6b,
-	0xb3, 0x46, 0xeb, 0x6b, 0x0d, 0x0d, 0x9e, 0x81, 0x0f, 0x94, 0x68, 0xe0, 0x51, 0xda, 0xa8, 0x44,
-	0x96, 0x2a, 0x00, 0xbf, 0x04, 0x1b, 0x3c, 0x14, 0xb1, 0x5f, 0x3a, 0xa4, 0xda, 0xbe, 0x11, 0x9f,
-	0x41, 0x8d, 0xe4, 0x0c, 0x6a, 0x5c, 0x46, 0x67, 0xd0, 0xfa, 0xda, 0x4b, 0x0d, 0x7e, 0x05, 0x76,
-	0xda, 0x7e, 0x40, 0xb1, 0x1b, 0xf2, 0x68, 0x60, 0x8e, 0xba, 0x06, 0x79, 0x71, 0x29, 0xc2, 0xfa,
-	0x5a, 0xeb, 0xec, 0x8f, 0xe9, 0xb1, 0xf6, 0xe7, 0xf4, 0x58, 0xfb, 0x7b, 0x7a, 0xac, 0x7d, 0x6f,
-	0x0e, 0xbc, 0xf0, 0x76, 0xd2, 0x33, 0x5c, 0x32, 0x32, 0x69, 0xd7, 0xbd, 0xbd, 0xef, 0xe3, 0xb1,
-	0x7c, 0x17, 0x8c, 0x5d, 0x53, 0x3e, 0xb0, 0xf5, 0xca, 0x6c, 0x93, 0x37, 0xff, 0x05, 0x00, 0x00,
-	0xff, 0xff, 0x88, 0x96, 0x62, 0x4f, 0x47, 0x0f, 0x00, 0x00,
+	// 1933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xcd, 0x72, 0xdb, 0xc8,
+	0x11, 0x16, 0x09, 0xf1, 0xaf, 0x49, 0xc9, 0xdc, 0xb1, 0xac, 0xc0, 0xb4, 0x2d, 0x79, 0xe9, 0x64,
+	0xad, 0x78, 0xbd, 0xa4, 0xa0, 0xf5, 0xae, 0xc8, 0x4d, 0xec, 0x8a, 0x28, 0x29, 0x2e, 0x66, 0xbd,
+	0x6b, 0xd5, 0xd8, 0xae, 0x54, 0xa5, 0x52, 0xc5, 0x02, 0xc1, 0x11, 0x05, 0x8b, 0xc4, 0x20, 0x00,
+	0xa8, 0x8a, 0x5e, 0x22, 0x87, 0x1c, 0x72, 0x4d, 0x5e, 0x22, 0xd7, 0x9c, 0x73, 0xcc, 0x13, 0x28,
+	0x2e, 0x9d, 0x72, 0xcf, 0x0b, 0xa4, 0xe6, 0x0f, 0x7f, 0x24, 0xc5, 0x88, 0x07, 0xab, 0x30, 0xd3,
+	0x5f, 0xf7, 0xf4, 0x7c, 0x5f, 0x77, 0x03, 0x26, 0xe8, 0xd6, 0xc8, 0x26, 0x4e, 0xd0, 0x34, 0x07,
+	0x63, 0xdb, 0x11, 0x7f, 0x1b, 0xae, 0x47, 0x03, 0x8a, 0x72, 0x7c, 0x51, 0x7b, 0x30, 0xa4, 0x74,
+	0x38, 0x22, 0x4d, 0xbe, 0xd9, 0x9f, 0x9c, 0x36, 0xc9, 0xd8, 0x0d, 0x2e, 0x05, 0xa6, 0xb6, 0x9d,
+	0x36, 0x06, 0xf6, 0x98, 0xf8, 0x81, 0x39, 0x76, 0x25, 0x60, 0x63, 0x48, 0x87, 0x94, 0x3f, 0x36,
+	0xd9, 0x93, 0x72, 0x4b, 0x1c, 0x7a, 0x61, 0xf4, 0xf6, 0x9b, 0xee, 0xa9, 0xcf, 0xfe, 0xdd, 0x00,
+	0x70, 0x7d, 0xf6, 0x6f, 0x1e, 0xa0, 0xb5, 0x28, 0x42, 0x6b, 0x51, 0x84, 0xf6, 0xa2, 0x08, 0xed,
+	0x54, 0x84, 0xc7, 0x69, 0x80, 0xb1, 0x9b, 0x0a, 0x31, 0x13, 0xb1, 0x20, 0x86, 0xb1, 0x30, 0x86,
+	0x91, 0x8a, 0xb1, 0xa9, 0x10, 0x93, 0xe0, 0x8c, 0xff, 0x51, 0xdc, 0xcb, 0xfd, 0x64, 0xbc, 0x70,
+	0x37, 0x1e, 0xa3, 0xfe, 0x8f, 0x2c, 0xe4, 0xde, 0xba, 0x46, 0x6f, 0x1f, 0x19, 0x90, 0xa7, 0xfd,
+	0x8f, 0xc4, 0x0a, 0xf4, 0xec, 0xe3, 0xcc, 0x4e, 0x79, 0xef, 0x7e, 0xc3, 0x3d, 0xf5, 0x7b, 0x46,
+	0x6f, 0xbf, 0x71, 0x32, 0x09, 0xde, 0x72, 0x0b, 0x26, 0x7f, 0x98, 0x10, 0x3f, 0xc0, 0x12, 0x88,
+	0xbe, 0x04, 0x2d, 0x30, 0x87, 0xba, 0x96, 0xc2, 0xbf, 0x37, 0x87, 0x49, 0x3c, 0x43, 0xa1, 0x06,
+	0xac, 0x7a, 0xc4, 0xa5, 0xfa, 0x2a, 0x47, 0xd7, 0x42, 0xf4, 0xa1, 0x47, 0xcc, 0x80, 0x60, 0xe2,
+	0x52, 0x05, 0xe7, 0x38, 0xf4, 0x35, 0xe4, 0x2d, 0x3a, 0x1e, 0xdb, 0x81, 0x9e, 0xe3, 0x1e, 0x0f,
+	0x42, 0x8f, 0xce, 0xc4, 0x1e, 0x0d, 0x0e, 0xb9, 0x2d, 0xcc, 0x48, 0x40, 0xd1, 0x0b, 0xc8, 0xf7,
+	0x3d, 0xd3, 0xb1, 0xce, 0xf4, 0x3c, 0x77, 0x7a, 0x98, 0x3a, 0xa6, 0xc3, 0x8d, 0xa1, 0x97, 0xc0,
+	0xa2, 0xef, 0xa0, 0xe8, 0xda, 0x2e, 0x19, 0xd9, 0x0e, 0xd1, 0x0b, 0xdc, 0x6f, 0xab, 0xe1, 0xba,
+	0x71, 0xbf, 0x13, 0x69, 0x56, 0x9e, 0x21, 0x3e, 0x24, 0xb0, 0x35, 0x97, 0xc0, 0xd6, 0x2d, 0x09,
+	0x6c, 0xdd, 0x8a, 0xc0, 0xd6, 0xad, 0x09, 0x6c, 0x2d, 0x43, 0x60, 0x6b, 0x49, 0x02, 0x5b, 0x0b,
+	0x09, 0xbc, 0xd2, 0x04, 0x81, 0xed, 0xb9, 0x04, 0xb6, 0xe7, 0x13, 0x78, 0x00, 0x6b, 0x16, 0x8f,
+	0xdf, 0x93, 0x9e, 0xa5, 0x44, 0xd6, 0x6d, 0x79, 0x7a, 0xd2, 0xb9, 0x62, 0xc5, 0x36, 0x67, 0x6b,
+	0xd0, 0x9e, 0xab, 0x41, 0xae, 0x3f, 0xa2, 0xd6, 0xb9, 0x0e, 0x1c, 0xae, 0xc7, 0x33, 0xec, 0x30,
+	0x83, 0x42, 0x0b, 0xd8, 0x1c, 0xcd, 0xda, 0xb7, 0xd6, 0xac, 0xbd, 0x8c, 0x66, 0xed, 0x25, 0x35,
+	0x6b, 0x2f, 0xd2, 0x8c, 0x71, 0xf6, 0x91, 0xf6, 0xf5, 0xa2, 0xe2, 0x2c, 0xe1, 0xf6, 0x1b, 0xda,
+	0x0f, 0x39, 0xfb, 0x48, 0xfb, 0xf5, 0xff, 0x68, 0x90, 0x67, 0x02, 0x1b, 0xbb, 0x68, 0x2f, 0xa5,
+	0xb0, 0x22, 0xc4, 0xd8, 0x9d, 0x2f, 0x71, 0x67, 0xb6, 0xc4, 0x8f, 0x22, 0xd7, 0xc5, 0x1a, 0x3f,
+	0x8f, 0x6b, 0x1c, 0x3b, 0x74, 0xb6, 0xc8, 0xcd, 0xa4, 0xc8, 0xf7, 0x13, 0x49, 0xce, 0x52, 0xb9,
+	0x99, 0x50, 0xf9, 0x41, 0x3a, 0xb3, 0x69, 0x99, 0x5f, 0xa4, 0x64, 0x7e, 0x18, 0xb9, 0xdc, 0xa0,
+	0xf3, 0x37, 0x29, 0x9d, 0xa7, 0x28, 0x98, 0x2d, 0xf4, 0x2f, 0xa6, 0x84, 0xde, 0x96, 0x8a, 0x19,
+	0xbb, 0x0b, 0x95, 0x7e, 0x1e, 0x57, 0xba, 0x96, 0xf6, 0x9b, 0x2b, 0xb5, 0x31, 0x5f, 0x6a, 0x63,
+	0x79, 0xa9, 0x8d, 0xa5, 0xa5, 0x36, 0x6e, 0x29, 0xb5, 0x71, 0x4b, 0xa9, 0x8d, 0xdb, 0x4b, 0x6d,
+	0x2c, 0x25, 0xb5, 0xb1, 0xac, 0xd4, 0xc6, 0x92, 0x52, 0x1b, 0x73, 0xa4, 0xfe, 0xf3, 0xaa, 0x94,
+	0x7a, 0x0f, 0x7d, 0x95, 0x92, 0xfa, 0x1e, 0x4b, 0x76, 0xbe, 0xca, 0x2f, 0x67, 0xab, 0xcc, 0x67,
+	0xe9, 0xff, 0x21, 0xf0, 0xd3, 0xb8, 0xc0, 0xe2, 0xa8, 0xd9, 0xda, 0x3e, 0x4b, 0x6a, 0xbb, 0xa1,
+	0xb2, 0x9a, 0x25, 0xeb, 0xb3, 0x84, 0xac, 0x9b, 0xb1, 0x54, 0xa6, 0x15, 0x6d, 0xa6, 0x14, 0xfd,
+	0x09, 0x47, 0xdf, 0x20, 0xe6, 0x6e, 0x4a, 0xcc, 0xf8, 0x4d, 0x67, 0xeb, 0xf8, 0xed, 0x94, 0x8e,
+	0x5c, 0x8f, 0x85, 0x12, 0x3e, 0x8d, 0x4b, 0x78, 0x2f, 0xe6, 0x92, 0x52, 0x0f, 0xfd, 0x0c, 0x34,
+	0xd3, 0x1a, 0xe9, 0x65, 0x0e, 0xbc, 0xdb, 0xe0, 0x1f, 0x8f, 0xef, 0x48, 0x70, 0x70, 0xf8, 0x26,
+	0x84, 0x99, 0xd6, 0x08, 0x35, 0xa0, 0x14, 0xd0, 0x71, 0xdf, 0x0f, 0xa8, 0x43, 0xf4, 0x0a, 0x07,
+	0x57, 0x1b, 0xe2, 0xff, 0x0a, 0xef, 0xd5, 0x3e, 0x8e, 0x20, 0xf5, 0xbf, 0x66, 0xa0, 0x14, 0x1a,
+	0xd0, 0x23, 0x49, 0x6a, 0x86, 0x3b, 0x96, 0xf8, 0xad, 0x39, 0x9d, 0x82, 0xc7, 0x27, 0x21, 0x8f,
+	0xa2, 0x6c, 0xca, 0x82, 0x16, 0x41, 0xa1, 0xe2, 0xee, 0x49, 0xc8, 0x9d, 0x16, 0x03, 0x49, 0xd6,
+	0x14, 0x5d, 0x3f, 0x8f, 0xd1, 0x25, 0x14, 0x5c, 0xe3, 0x77, 0x0f, 0x89, 0x8a, 0xbe, 0x36, 0xfe,
+	0x9d, 0x81, 0xec, 0x5b, 0x17, 0x7d, 0x0e, 0x39, 0xca, 0xbe, 0x7a, 0x65, 0x6e, 0x15, 0x79, 0x29,
+	0xfe, 0x25, 0x8c, 0x57, 0xa9, 0x6b, 0xec, 0x2b, 0x48, 0x4b, 0xcf, 0x4e, 0x41, 0x5a, 0x1c, 0xd2,
+	0x52, 0x90, 0xb6, 0xae, 0x4d, 0x41, 0xda, 0x1c, 0xd2, 0x46, 0x3f, 0x85, 0x3c, 0xe5, 0xef, 0xbe,
+	0x30, 0xb1, 0x08, 0x63, 0xec, 0x62, 0xe6, 0x6f, 0xec, 0x86, 0x28, 0x43, 0xcf, 0x4d, 0xa3, 0x0c,
+	0x81, 0x32, 0x42, 0xd4, 0x9e, 0x9e, 0x9f, 0x46, 0xed, 0x09, 0xd4, 0x5e, 0xfd, 0x6f, 0x1a, 0xac,
+	0x1f, 0xff, 0x31, 0xf0, 0xcc, 0xb0, 0x1d, 0x50, 0x15, 0xb4, 0x0f, 0xf8, 0x0d, 0xbf, 0x6b, 0x09,
+	0xb3, 0x47, 0xf4, 0x08, 0xc0, 0xa1, 0xb2, 0xff, 0x7c, 0x7e, 0xc3, 0x22, 0x2e, 0x39, 0x54, 0x74,
+	0x91, 0x8f, 0xee, 0x43, 0xd1, 0xa1, 0x3d, 0xa6, 0x92, 0xcf, 0xef, 0x56, 0xc4, 0x05, 0x87, 0x32,
+	0xe9, 0x7c, 0xf4, 0x39, 0x54, 0x1c, 0xda, 0x53, 0x7c, 0xfa, 0xfc, 0x5a, 0x45, 0x5c, 0x76, 0xa8,
+	0x22, 0xdb, 0x47, 0x35, 0x28, 0x8e, 0x4d, 0xc7, 0x3e, 0x25, 0xbe, 0x68, 0x91, 0x22, 0x0e, 0xd7,
+	0xe8, 0x0b, 0x28, 0xf6, 0x4d, 0x9f, 0xf4, 0x58, 0x3e, 0xec, 0x16, 0xa5, 0x4e, 0xf9, 0xfa, 0x6a,
+	0xbb, 0xd0, 0x31, 0x7d, 0xf2, 0x01, 0xbf, 0xc1, 0x85, 0xbe, 0x78, 0x40, 0x9b, 0x90, 0xf7, 0x88,
+	0x3f, 0x19, 0x8b, 0xfa, 0x2f, 0x62, 0xb9, 0x42, 0x1b, 0x90, 0x13, 0x69, 0x15, 0x1f, 0x6b, 0x3b,
+	0x25, 0x2c, 0x16, 0xe8, 0x21, 0x94, 0xa2, 0x8c, 0x4a, 0xdc, 0x12, 0x6d, 0xa0, 0xa7, 0x50, 0x14,
+	0x85, 0x42, 0x7c, 0x1d, 0x1e, 0x6b, 0xe9, 0x2a, 0x0a, 0x8d, 0x68, 0x1f, 0xd6, 0x44, 0xd9, 0xf5,
+	0x3c, 0xd3, 0x19, 0x12, 0x5f, 0x2f, 0x73, 0x34, 0x92, 0x3c, 0xcb, 0xd2, 0x64, 0x26, 0x5c, 0xb1,
+	0xa2, 0x85, 0x8f, 0xbe, 0x02, 0x64, 0x3b, 0xd6, 0x68, 0x32, 0x20, 0x3d, 0xd7, 0xa3, 0x17, 0xc4,
+	0x31, 0x1d, 0x4b, 0x34, 0x4c, 0x11, 0x7f, 0x26, 0x2d, 0x27, 0xa1, 0xa1, 0xfe, 0x3d, 0x94, 0x63,
+	0xb1, 0xd0, 0x36, 0xac, 0x9e, 0x7a, 0x74, 0x2c, 0x6b, 0x31, 0xd1, 0x06, 0xdc, 0x80, 0x1e, 0x40,
+	0x36, 0xa0, 0xb3, 0xba, 0x24, 0x1b, 0xd0, 0xfa, 0xa7, 0x2c, 0xac, 0x77, 0x4c, 0xeb, 0x7c, 0xe2,
+	0xfe, 0xa0, 0x48, 0xde, 0x84, 0xac, 0x3d, 0x10, 0x72, 0x77, 0xf2, 0xd7, 0x57, 0xdb, 0xd9, 0xee,
+	0x11, 0xce, 0xda, 0x03, 0xf4, 0x04, 0x38, 0xbf, 0x3d, 0x7b, 0xc0, 0x83, 0x95, 0x3a, 0x70, 0x7d,
+	0xb5, 0x9d, 0x67, 0xdc, 0x77, 0x8f, 0x70, 0x9e, 0x99, 0xba, 0x0c, 0xb4, 0x46, 0x02, 0x6b, 0xd0,
+	0xf3, 0xc8, 0x85, 0xed, 0xdb, 0xd4, 0xe1, 0x05, 0xa0, 0xe1, 0x0a, 0xdb, 0xc4, 0x72, 0x0f, 0x3d,
+	0x07, 0xc4, 0x23, 0x25, 0x91, 0x25, 0x8e, 0xac, 0x32, 0xcb, 0x71, 0x1c, 0x5d, 0x83, 0xa2, 0x4f,
+	0x86, 0x63, 0xe2, 0x04, 0xa2, 0x5e, 0x34, 0x1c, 0xae, 0x99, 0xcd, 0xa2, 0x63, 0x77, 0x44, 0x02,
+	0xa2, 0x8a, 0x45, 0xad, 0xd1, 0x0b, 0x28, 0xf8, 0x81, 0xe9, 0x05, 0x64, 0x20, 0x2b, 0xbe, 0xd6,
+	0x10, 0xbf, 0x3b, 0x34, 0xd4, 0xef, 0x0e, 0x8d, 0xf7, 0xea, 0x77, 0x07, 0xac, 0xa0, 0x6c, 0x78,
+	0x9e, 0xda, 0x8e, 0xed, 0x9f, 0x91, 0x81, 0x5e, 0x58, 0xe8, 0x16, 0x62, 0x91, 0x0e, 0x05, 0xe2,
+	0x04, 0x9e, 0x4d, 0x54, 0x71, 0xa9, 0x65, 0xfd, 0x10, 0x36, 0x65, 0x47, 0xa5, 0x46, 0x6f, 0x62,
+	0xf2, 0x64, 0x6e, 0x9e, 0x3c, 0xff, 0xd5, 0x60, 0x1d, 0x13, 0x3f, 0xa0, 0x5e, 0xe8, 0x7d, 0x1f,
+	0xb2, 0xd4, 0x0d, 0xc7, 0xa3, 0x6a, 0x66, 0x9c, 0xa5, 0xae, 0x6a, 0xd9, 0x6c, 0xd4, 0xb2, 0xaf,
+	0xa0, 0x6a, 0x3b, 0x96, 0x47, 0x18, 0x6d, 0xe6, 0x88, 0x35, 0x10, 0xeb, 0x4d, 0x6d, 0xa7, 0xd4,
+	0xb9, 0x7b, 0x7d, 0xb5, 0x7d, 0xa7, 0x1b, 0xd9, 0x98, 0x09, 0xdf, 0xb1, 0x93, 0x1b, 0xb1, 0x8e,
+	0x5a, 0x4d, 0x74, 0x54, 0x17, 0x2a, 0x1e, 0x71, 0xcc, 0x31, 0x91, 0xfd, 0x9e, 0xe3, 0x35, 0xff,
+	0x85, 0x4c, 0x27, 0x99, 0x71, 0x03, 0x73, 0x24, 0x9f, 0x04, 0xc7, 0x4e, 0xe0, 0x5d, 0xe2, 0xb2,
+	0x17, 0xed, 0xa0, 0x0f, 0x50, 0x95, 0xa1, 0xa2, 0x6e, 0xcc, 0xf3, 0x70, 0xcf, 0x6e, 0x0a, 0x17,
+	0x4e, 0x0e, 0x11, 0xf2, 0x8e, 0x97, 0xdc, 0x45, 0xdf, 0x42, 0x99, 0x3a, 0x3d, 0x8b, 0x3a, 0xa7,
+	0x23, 0xdb, 0x0a, 0xb8, 0xa6, 0xeb, 0x7b, 0xf7, 0xc2, 0xa6, 0x14, 0xdb, 0x27, 0x74, 0x64, 0x5b,
+	0x97, 0x18, 0xa8, 0xa3, 0x76, 0x6a, 0xaf, 0xa0, 0x9a, 0xce, 0x97, 0xf1, 0x7a, 0x4e, 0x2e, 0xd5,
+	0x28, 0x3c, 0x27, 0x97, 0x6c, 0xa2, 0x5c, 0x98, 0xa3, 0x09, 0x91, 0x5c, 0x8b, 0xc5, 0x77, 0xd9,
+	0x56, 0xa6, 0xd6, 0x81, 0x8d, 0x59, 0x09, 0xde, 0x26, 0x46, 0xfd, 0x35, 0x7c, 0x26, 0xef, 0x7c,
+	0x78, 0x46, 0xac, 0x73, 0x97, 0xda, 0xce, 0xfc, 0xfe, 0x8c, 0xf7, 0x49, 0x36, 0xd9, 0x27, 0xf5,
+	0xbf, 0x64, 0xa0, 0x22, 0x23, 0x75, 0x7d, 0x7f, 0x42, 0x50, 0x8b, 0x81, 0x2f, 0x88, 0x67, 0x07,
+	0x22, 0x95, 0xf5, 0xbd, 0x87, 0x49, 0x92, 0x39, 0xac, 0xf1, 0x4e, 0x62, 0x70, 0x88, 0x46, 0xeb,
+	0xbc, 0xec, 0x44, 0xaa, 0xac, 0xd6, 0x74, 0x28, 0x8c, 0x89, 0xef, 0x9b, 0x43, 0xc2, 0x7b, 0xbd,
+	0x84, 0xd5, 0xb2, 0x5e, 0x87, 0xa2, 0xf2, 0x47, 0x65, 0x28, 0xfc, 0xf6, 0x00, 0xff, 0xd8, 0xfd,
+	0xf1, 0x75, 0x75, 0x05, 0x95, 0x20, 0x77, 0x8c, 0xf1, 0x5b, 0x5c, 0xcd, 0xd4, 0xff, 0xa4, 0xc1,
+	0x5a, 0x28, 0xab, 0x4b, 0xbd, 0x80, 0xc5, 0xbb, 0x20, 0x1e, 0x9f, 0x08, 0x82, 0x23, 0xb5, 0x64,
+	0xcc, 0x51, 0x57, 0xdd, 0x8d, 0x3d, 0x46, 0xf3, 0x5c, 0x4c, 0x19, 0xb1, 0x60, 0x11, 0xc4, 0x7c,
+	0x55, 0xf3, 0x42, 0x2d, 0x19, 0x45, 0xe1, 0x2c, 0xcf, 0x09, 0x8a, 0xd4, 0x3a, 0xf9, 0x16, 0xc8,
+	0x73, 0x63, 0xb4, 0x81, 0x10, 0xac, 0x7e, 0xa4, 0x7d, 0x9f, 0x97, 0x8f, 0x86, 0xf9, 0x33, 0x3b,
+	0x47, 0xbd, 0x03, 0x8b, 0xe2, 0x1c, 0xb9, 0x64, 0xdd, 0xc2, 0xbf, 0x0c, 0x7d, 0x39, 0xd4, 0xe4,
+	0x8a, 0x45, 0x31, 0xad, 0x91, 0xcf, 0xbf, 0x29, 0x35, 0xcc, 0x9f, 0xd9, 0xcb, 0x74, 0x60, 0x06,
+	0x66, 0xaf, 0x7f, 0x19, 0xf0, 0x77, 0x46, 0x66, 0x67, 0x15, 0x97, 0xd8, 0x4e, 0x87, 0x6d, 0xb0,
+	0x37, 0xa6, 0x7c, 0xab, 0x08, 0x40, 0x85, 0x03, 0xca, 0x62, 0x4f, 0x40, 0xbe, 0x84, 0xbc, 0xcd,
+	0xd4, 0xf2, 0xf5, 0x35, 0xde, 0x2e, 0x77, 0x67, 0x28, 0x89, 0x25, 0x04, 0x6d, 0x01, 0x84, 0x5f,
+	0x5c, 0xbe, 0xbe, 0xce, 0x13, 0x89, 0xed, 0xd4, 0x7f, 0x0f, 0xe5, 0xc3, 0xd1, 0xc4, 0x0f, 0x88,
+	0xd7, 0x75, 0x4e, 0xe9, 0xdc, 0x62, 0xfb, 0x06, 0xd6, 0x06, 0xc4, 0x1d, 0xd1, 0x4b, 0x56, 0x5f,
+	0xd1, 0x2b, 0xa1, 0x7a, 0x7d, 0xb5, 0x5d, 0x39, 0x0a, 0x0d, 0xdd, 0x23, 0x5c, 0x89, 0x60, 0xdd,
+	0xc1, 0xb3, 0x26, 0xac, 0x27, 0x5b, 0x8e, 0xd5, 0xc2, 0x0f, 0xc7, 0xf8, 0xf5, 0x71, 0x75, 0x05,
+	0x15, 0x61, 0xf5, 0xd7, 0x07, 0xdd, 0x37, 0xd5, 0x0c, 0x7b, 0x7a, 0xf7, 0x7d, 0xf7, 0xa4, 0x9a,
+	0xdd, 0xfb, 0x7b, 0x16, 0xb4, 0x83, 0x93, 0x2e, 0x6a, 0x42, 0x41, 0x0e, 0x51, 0xa4, 0x7a, 0x37,
+	0xf9, 0x99, 0x52, 0x8b, 0x46, 0x60, 0x7d, 0x65, 0x37, 0x83, 0x5e, 0xc2, 0x9d, 0xd4, 0xd4, 0x45,
+	0x8f, 0x92, 0x8e, 0xa9, 0x69, 0x9c, 0x08, 0x80, 0x7e, 0x09, 0x05, 0x49, 0x5f, 0x78, 0x5e, 0x72,
+	0xfa, 0xd4, 0x36, 0xa7, 0x5e, 0x0b, 0xc7, 0xec, 0x27, 0xee, 0xfa, 0xca, 0x4e, 0x06, 0xfd, 0x2a,
+	0x2c, 0xea, 0x23, 0xef, 0x12, 0x4f, 0x9c, 0x79, 0x31, 0x36, 0xd2, 0xdb, 0xac, 0x03, 0x78, 0x84,
+	0x57, 0xb0, 0xde, 0x75, 0x7c, 0x97, 0x58, 0x81, 0x54, 0x03, 0xcd, 0x39, 0xaf, 0x16, 0x7e, 0x5f,
+	0x44, 0xaa, 0xd5, 0x57, 0x3a, 0x2f, 0xff, 0x79, 0xbd, 0x95, 0xf9, 0xd7, 0xf5, 0x56, 0xe6, 0xd3,
+	0xf5, 0x56, 0xe6, 0x77, 0xcd, 0xa1, 0x1d, 0x9c, 0x4d, 0xfa, 0x0d, 0x8b, 0x8e, 0x9b, 0xae, 0x69,
+	0x9d, 0x5d, 0x0e, 0x88, 0x17, 0x7f, 0xf2, 0x3d, 0xab, 0x19, 0xff, 0xc1, 0xb8, 0x9f, 0xe7, 0x87,
+	0x7c, 0xfd, 0xbf, 0x01, 0x00, 0x31, 0x7b, 0x50, 0x38, 0xe8, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1080,6 +1915,10 @@ type APIClient interface {
 	Extract(ctx context.Context, in *ExtractRequest, opts ...grpc.CallOption) (API_ExtractClient, error)
 	ExtractPipeline(ctx context.Context, in *ExtractPipelineRequest, opts ...grpc.CallOption) (*Op, error)
 	Restore(ctx context.Context, opts ...grpc.CallOption) (API_RestoreClient, error)
+	// RestoreDryRun reads, converts and validates a stream of restore requests
+	// (in the same way as Restore) without restoring anything, and reports what
+	// a restore would do and any problems it would have.
+	RestoreDryRun(ctx context.Context, opts ...grpc.CallOption) (API_RestoreDryRunClient, error)
 	InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error)
 }
 
@@ -1166,6 +2005,40 @@ func (x *aPIRestoreClient) CloseAndRecv() (*types.Empty, error) {
 	return m, nil
 }
 
+func (c *aPIClient) RestoreDryRun(ctx context.Context, opts ...grpc.CallOption) (API_RestoreDryRunClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/admin.API/RestoreDryRun", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRestoreDryRunClient{stream}
+	return x, nil
+}
+
+type API_RestoreDryRunClient interface {
+	Send(*RestoreRequest) error
+	CloseAndRecv() (*RestoreReport, error)
+	grpc.ClientStream
+}
+
+type aPIRestoreDryRunClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRestoreDryRunClient) Send(m *RestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIRestoreDryRunClient) CloseAndRecv() (*RestoreReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *aPIClient) InspectCluster(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ClusterInfo, error) {
 	out := new(ClusterInfo)
 	err := c.cc.Invoke(ctx, "/admin.API/InspectCluster", in, out, opts...)
@@ -1180,6 +2053,10 @@ type APIServer interface {
 	Extract(*ExtractRequest, API_ExtractServer) error
 	ExtractPipeline(context.Context, *ExtractPipelineRequest) (*Op, error)
 	Restore(API_RestoreServer) error
+	// RestoreDryRun reads, converts and validates a stream of restore requests
+	// (in the same way as Restore) without restoring anything, and reports what
+	// a restore would do and any problems it would have.
+	RestoreDryRun(API_RestoreDryRunServer) error
 	InspectCluster(context.Context, *types.Empty) (*ClusterInfo, error)
 }
 
@@ -1196,6 +2073,9 @@ func (*UnimplementedAPIServer) ExtractPipeline(ctx context.Context, req *Extract
 func (*UnimplementedAPIServer) Restore(srv API_RestoreServer) error {
 	return status.Errorf(codes.Unimplemented, "method Restore not implemented")
 }
+func (*UnimplementedAPIServer) RestoreDryRun(srv API_RestoreDryRunServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreDryRun not implemented")
+}
 func (*UnimplementedAPIServer) InspectCluster(ctx context.Context, req *types.Empty) (*ClusterInfo, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InspectCluster not implemented")
 }
@@ -1269,6 +2149,32 @@ func (x *aPIRestoreServer) Recv() (*RestoreRequest, error) {
 	return m, nil
 }
 
+func _API_RestoreDryRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).RestoreDryRun(&aPIRestoreDryRunServer{stream})
+}
+
+type API_RestoreDryRunServer interface {
+	SendAndClose(*RestoreReport) error
+	Recv() (*RestoreRequest, error)
+	grpc.ServerStream
+}
+
+type aPIRestoreDryRunServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRestoreDryRunServer) SendAndClose(m *RestoreReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIRestoreDryRunServer) Recv() (*RestoreRequest, error) {
+	m := new(RestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _API_InspectCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.Empty)
 	if err := dec(in); err != nil {
@@ -1311,6 +2217,11 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_Restore_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "RestoreDryRun",
+			Handler:       _API_RestoreDryRun_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "client/admin/admin.proto",
 }
@@ -1942,6 +2853,30 @@ func (m *Op1_12) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Tombstone != nil {
+		{
+			size, err := m.Tombstone.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Acl != nil {
+		{
+			size, err := m.Acl.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	if m.Block != nil {
 		{
 			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
@@ -2053,7 +2988,7 @@ func (m *Op1_12) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Op) Marshal() (dAtA []byte, err error) {
+func (m *Tombstone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2063,12 +2998,12 @@ func (m *Op) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Op) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tombstone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Op) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Tombstone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2077,9 +3012,9 @@ func (m *Op) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Op1_12 != nil {
+	if m.Pipeline != nil {
 		{
-			size, err := m.Op1_12.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -2087,9 +3022,84 @@ func (m *Op) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintAdmin(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x22
 	}
-	if m.Op1_11 != nil {
+	if m.Branch != nil {
+		{
+			size, err := m.Branch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commit != nil {
+		{
+			size, err := m.Commit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Op) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Op) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Op) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Op1_12 != nil {
+		{
+			size, err := m.Op1_12.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Op1_11 != nil {
 		{
 			size, err := m.Op1_11.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
@@ -2176,6 +3186,89 @@ func (m *ExtractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.IncludeProvenance {
+		i--
+		if m.IncludeProvenance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CommitRanges) > 0 {
+		for iNdEx := len(m.CommitRanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommitRanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Branches) > 0 {
+		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Branches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for iNdEx := len(m.Pipelines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pipelines[iNdEx])
+			copy(dAtA[i:], m.Pipelines[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Pipelines[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repos[iNdEx])
+			copy(dAtA[i:], m.Repos[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Repos[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Resume {
+		i--
+		if m.Resume {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BaseURL) > 0 {
+		i -= len(m.BaseURL)
+		copy(dAtA[i:], m.BaseURL)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BaseURL)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Manifest {
+		i--
+		if m.Manifest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.NoPipelines {
 		i--
 		if m.NoPipelines {
@@ -2216,6 +3309,156 @@ func (m *ExtractRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommitRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommitRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommitRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupManifest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupManifest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupManifest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.BaseEtcdRevision != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.BaseEtcdRevision))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Entries[iNdEx])
+			copy(dAtA[i:], m.Entries[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.Entries[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAdmin(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Segments != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Segments))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EtcdRevision != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.EtcdRevision))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BaseID) > 0 {
+		i -= len(m.BaseID)
+		copy(dAtA[i:], m.BaseID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.BaseID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ExtractPipelineRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2279,6 +3522,68 @@ func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.OnConflict != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.OnConflict))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RenamePipelines) > 0 {
+		for k := range m.RenamePipelines {
+			v := m.RenamePipelines[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RenameRepos) > 0 {
+		for k := range m.RenameRepos {
+			v := m.RenameRepos[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintAdmin(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintAdmin(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Resume {
+		i--
+		if m.Resume {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IncrementalURLs) > 0 {
+		for iNdEx := len(m.IncrementalURLs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IncrementalURLs[iNdEx])
+			copy(dAtA[i:], m.IncrementalURLs[iNdEx])
+			i = encodeVarintAdmin(dAtA, i, uint64(len(m.IncrementalURLs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.URL) > 0 {
 		i -= len(m.URL)
 		copy(dAtA[i:], m.URL)
@@ -2301,7 +3606,7 @@ func (m *RestoreRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+func (m *RestoreCheckpoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2311,12 +3616,12 @@ func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *RestoreCheckpoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RestoreCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2325,12 +3630,10 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DeploymentID) > 0 {
-		i -= len(m.DeploymentID)
-		copy(dAtA[i:], m.DeploymentID)
-		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeploymentID)))
+	if m.Segments != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Segments))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.ID) > 0 {
 		i -= len(m.ID)
@@ -2342,12 +3645,207 @@ func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAdmin(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *RestoreIssue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreIssue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Severity != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RestoreReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestoreReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestoreReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Tombstones != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Tombstones))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Issues) > 0 {
+		for iNdEx := len(m.Issues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Issues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAdmin(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.CommitBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.CommitBytes))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.DataBytes != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.DataBytes))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Acls != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Acls))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Objects != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Objects))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Jobs != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Jobs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Pipelines != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipelines))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Branches != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Branches))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Commits != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Commits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Repos != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Repos))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Ops != 0 {
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Ops))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.DeploymentID) > 0 {
+		i -= len(m.DeploymentID)
+		copy(dAtA[i:], m.DeploymentID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.DeploymentID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAdmin(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAdmin(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -2611,6 +4109,42 @@ func (m *Op1_12) Size() (n int) {
 		l = m.Block.Size()
 		n += 1 + l + sovAdmin(uint64(l))
 	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Tombstone != nil {
+		l = m.Tombstone.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Tombstone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Commit != nil {
+		l = m.Commit.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Branch != nil {
+		l = m.Branch.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2672,6 +4206,109 @@ func (m *ExtractRequest) Size() (n int) {
 	if m.NoPipelines {
 		n += 2
 	}
+	if m.Manifest {
+		n += 2
+	}
+	l = len(m.BaseURL)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Resume {
+		n += 2
+	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Branches) > 0 {
+		for _, e := range m.Branches {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.CommitRanges) > 0 {
+		for _, e := range m.CommitRanges {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.IncludeProvenance {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CommitRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupManifest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.BaseID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.EtcdRevision != 0 {
+		n += 1 + sovAdmin(uint64(m.EtcdRevision))
+	}
+	if m.Segments != 0 {
+		n += 1 + sovAdmin(uint64(m.Segments))
+	}
+	if m.Complete {
+		n += 2
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, s := range m.Entries {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.BaseEtcdRevision != 0 {
+		n += 1 + sovAdmin(uint64(m.BaseEtcdRevision))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2708,13 +4345,41 @@ func (m *RestoreRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAdmin(uint64(l))
 	}
+	if len(m.IncrementalURLs) > 0 {
+		for _, s := range m.IncrementalURLs {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Resume {
+		n += 2
+	}
+	if len(m.RenameRepos) > 0 {
+		for k, v := range m.RenameRepos {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RenamePipelines) > 0 {
+		for k, v := range m.RenamePipelines {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAdmin(uint64(len(k))) + 1 + len(v) + sovAdmin(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAdmin(uint64(mapEntrySize))
+		}
+	}
+	if m.OnConflict != 0 {
+		n += 1 + sovAdmin(uint64(m.OnConflict))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ClusterInfo) Size() (n int) {
+func (m *RestoreCheckpoint) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2724,7 +4389,107 @@ func (m *ClusterInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAdmin(uint64(l))
 	}
-	l = len(m.DeploymentID)
+	if m.Segments != 0 {
+		n += 1 + sovAdmin(uint64(m.Segments))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreIssue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		n += 1 + sovAdmin(uint64(m.Severity))
+	}
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RestoreReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Ops != 0 {
+		n += 1 + sovAdmin(uint64(m.Ops))
+	}
+	if m.Repos != 0 {
+		n += 1 + sovAdmin(uint64(m.Repos))
+	}
+	if m.Commits != 0 {
+		n += 1 + sovAdmin(uint64(m.Commits))
+	}
+	if m.Branches != 0 {
+		n += 1 + sovAdmin(uint64(m.Branches))
+	}
+	if m.Pipelines != 0 {
+		n += 1 + sovAdmin(uint64(m.Pipelines))
+	}
+	if m.Jobs != 0 {
+		n += 1 + sovAdmin(uint64(m.Jobs))
+	}
+	if m.Objects != 0 {
+		n += 1 + sovAdmin(uint64(m.Objects))
+	}
+	if m.Blocks != 0 {
+		n += 1 + sovAdmin(uint64(m.Blocks))
+	}
+	if m.Acls != 0 {
+		n += 1 + sovAdmin(uint64(m.Acls))
+	}
+	if m.DataBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.DataBytes))
+	}
+	if m.CommitBytes != 0 {
+		n += 1 + sovAdmin(uint64(m.CommitBytes))
+	}
+	if len(m.Issues) > 0 {
+		for _, e := range m.Issues {
+			l = e.Size()
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Tombstones != 0 {
+		n += 1 + sovAdmin(uint64(m.Tombstones))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	l = len(m.DeploymentID)
 	if l > 0 {
 		n += 1 + l + sovAdmin(uint64(l))
 	}
@@ -4767,63 +6532,9 @@ func (m *Op1_12) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthAdmin
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Op) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Op: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_7", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4850,16 +6561,16 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_7 == nil {
-				m.Op1_7 = &Op1_7{}
+			if m.Acl == nil {
+				m.Acl = &auth.SetACLRequest{}
 			}
-			if err := m.Op1_7.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_8", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4886,16 +6597,70 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_8 == nil {
-				m.Op1_8 = &Op1_8{}
+			if m.Tombstone == nil {
+				m.Tombstone = &Tombstone{}
 			}
-			if err := m.Op1_8.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tombstone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tombstone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tombstone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tombstone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_9", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4922,16 +6687,16 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_9 == nil {
-				m.Op1_9 = &Op1_9{}
+			if m.Repo == nil {
+				m.Repo = &pfs5.Repo{}
 			}
-			if err := m.Op1_9.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_10", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4958,16 +6723,16 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_10 == nil {
-				m.Op1_10 = &Op1_10{}
+			if m.Commit == nil {
+				m.Commit = &pfs5.Commit{}
 			}
-			if err := m.Op1_10.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_11", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4994,16 +6759,16 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_11 == nil {
-				m.Op1_11 = &Op1_11{}
+			if m.Branch == nil {
+				m.Branch = &pfs5.Branch{}
 			}
-			if err := m.Op1_11.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_12", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5030,10 +6795,10 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_12 == nil {
-				m.Op1_12 = &Op1_12{}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps5.Pipeline{}
 			}
-			if err := m.Op1_12.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5062,7 +6827,7 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
+func (m *Op) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5085,17 +6850,17 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Op: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_7", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5105,29 +6870,33 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			if m.Op1_7 == nil {
+				m.Op1_7 = &Op1_7{}
+			}
+			if err := m.Op1_7.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoObjects", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_8", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5137,17 +6906,33 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoObjects = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Op1_8 == nil {
+				m.Op1_8 = &Op1_8{}
+			}
+			if err := m.Op1_8.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoRepos", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_9", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5157,17 +6942,33 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoRepos = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Op1_9 == nil {
+				m.Op1_9 = &Op1_9{}
+			}
+			if err := m.Op1_9.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoPipelines", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_10", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5177,69 +6978,67 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoPipelines = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
+			if m.Op1_10 == nil {
+				m.Op1_10 = &Op1_10{}
 			}
-			if iNdEx >= l {
+			if err := m.Op1_10.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_11", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Op1_11 == nil {
+				m.Op1_11 = &Op1_11{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExtractPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtractPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Op1_11.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_12", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5266,10 +7065,10 @@ func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &pps5.Pipeline{}
+			if m.Op1_12 == nil {
+				m.Op1_12 = &Op1_12{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Op1_12.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5298,7 +7097,7 @@ func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5321,17 +7120,17 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtractRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtractRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAdmin
@@ -5341,31 +7140,107 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAdmin
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op == nil {
-				m.Op = &Op{}
-			}
-			if err := m.Op.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoObjects", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoObjects = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoRepos", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoRepos = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoPipelines", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoPipelines = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Manifest = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5393,8 +7268,1732 @@ func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.BaseURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resume = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &pfs5.Branch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitRanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitRanges = append(m.CommitRanges, &CommitRange{})
+			if err := m.CommitRanges[len(m.CommitRanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeProvenance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeProvenance = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &pfs5.Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &pfs5.Commit{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupManifest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupManifest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupManifest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EtcdRevision", wireType)
+			}
+			m.EtcdRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EtcdRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			m.Segments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Segments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseEtcdRevision", wireType)
+			}
+			m.BaseEtcdRevision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseEtcdRevision |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtractPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtractPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtractPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &pps5.Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Op == nil {
+				m.Op = &Op{}
+			}
+			if err := m.Op.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncrementalURLs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncrementalURLs = append(m.IncrementalURLs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resume", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resume = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenameRepos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenameRepos == nil {
+				m.RenameRepos = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RenameRepos[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenamePipelines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenamePipelines == nil {
+				m.RenamePipelines = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAdmin
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAdmin
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAdmin(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAdmin
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RenamePipelines[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnConflict", wireType)
+			}
+			m.OnConflict = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OnConflict |= ConflictPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			m.Segments = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Segments |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreIssue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreIssue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreIssue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= RestoreIssue_Severity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestoreReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestoreReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestoreReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			m.Ops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ops |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			m.Repos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Repos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			m.Commits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Commits |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			m.Branches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Branches |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			m.Pipelines = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Pipelines |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
+			}
+			m.Jobs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Jobs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+			}
+			m.Objects = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Objects |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acls", wireType)
+			}
+			m.Acls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Acls |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataBytes", wireType)
+			}
+			m.DataBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitBytes", wireType)
+			}
+			m.CommitBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issues = append(m.Issues, &RestoreIssue{})
+			if err := m.Issues[len(m.Issues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			m.Tombstones = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tombstones |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"io"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+// ListAuditEvents calls 'f' with each audit event recorded by the cluster
+// that was made by 'principal', targets 'resource', and occurred at or after
+// 'since'. Empty or zero arguments are not used to filter events.
+func (c APIClient) ListAuditEvents(principal, resource string, since time.Time, f func(*audit.Event) error) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	req := &audit.ListEventsRequest{
+		Principal: principal,
+		Resource:  resource,
+	}
+	if !since.IsZero() {
+		ts, err := types.TimestampProto(since)
+		if err != nil {
+			return err
+		}
+		req.Since = ts
+	}
+	eventsClient, err := c.AuditAPIClient.ListEvents(c.Ctx(), req)
+	if err != nil {
+		return err
+	}
+	for {
+		event, err := eventsClient.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := f(event); err != nil {
+			if errors.Is(err, errutil.ErrBreak) {
+				return nil
+			}
+			return err
+		}
+	}
+}
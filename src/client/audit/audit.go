@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+)
+
+// Repo is the system repo to which pachd appends audit events when the "repo"
+// audit sink is configured
+const Repo = "__audit__"
+
+// Matches returns true if 'e' satisfies every filter set in 'req'
+func (e *Event) Matches(req *ListEventsRequest) bool {
+	if req.Principal != "" && e.Principal != req.Principal {
+		return false
+	}
+	if req.Since != nil {
+		since, err := types.TimestampFromProto(req.Since)
+		if err != nil {
+			return false
+		}
+		t, err := types.TimestampFromProto(e.Time)
+		if err != nil || t.Before(since) {
+			return false
+		}
+	}
+	if req.Resource != "" {
+		for _, r := range e.Resources {
+			if matchesResource(r, req.Resource) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// matchesResource returns true if 'resource' (e.g. "commit:images@master")
+// matches 'filter'. A filter matches a resource if its kind (if any) is the
+// resource's kind, and its name is either the resource's full name
+// ("images@master") or the repo or pipeline that the resource belongs to
+// ("images").
+func matchesResource(resource, filter string) bool {
+	kind, name := splitResource(resource)
+	filterKind, filterName := splitResource(filter)
+	if filterKind != "" && filterKind != kind {
+		return false
+	}
+	if name == filterName {
+		return true
+	}
+	owner := name
+	if i := strings.IndexByte(owner, '@'); i >= 0 {
+		owner = owner[:i]
+	}
+	return owner == filterName
+}
+
+func splitResource(resource string) (kind, name string) {
+	parts := strings.SplitN(resource, ":", 2)
+	if len(parts) == 1 {
+		return "", parts[0]
+	}
+	return parts[0], parts[1]
+}
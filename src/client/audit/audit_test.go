@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestEventMatches(t *testing.T) {
+	now := time.Now()
+	ts, err := types.TimestampProto(now)
+	require.NoError(t, err)
+	e := &Event{
+		Time:      ts,
+		Principal: "github:alice",
+		RPC:       "/pfs.API/DeleteCommit",
+		Resources: []string{"commit:images@master"},
+	}
+	require.True(t, e.Matches(&ListEventsRequest{}))
+	require.True(t, e.Matches(&ListEventsRequest{Principal: "github:alice"}))
+	require.False(t, e.Matches(&ListEventsRequest{Principal: "github:bob"}))
+
+	require.True(t, e.Matches(&ListEventsRequest{Resource: "images"}))
+	require.True(t, e.Matches(&ListEventsRequest{Resource: "images@master"}))
+	require.True(t, e.Matches(&ListEventsRequest{Resource: "commit:images"}))
+	require.False(t, e.Matches(&ListEventsRequest{Resource: "pipeline:images"}))
+	require.False(t, e.Matches(&ListEventsRequest{Resource: "edges"}))
+
+	before, err := types.TimestampProto(now.Add(-time.Minute))
+	require.NoError(t, err)
+	after, err := types.TimestampProto(now.Add(time.Minute))
+	require.NoError(t, err)
+	require.True(t, e.Matches(&ListEventsRequest{Since: before}))
+	require.False(t, e.Matches(&ListEventsRequest{Since: after}))
+}
@@ -33,6 +33,10 @@ const (
 	// (with this prefix) is a logical PPS pipeline (even though the pipeline may
 	// not exist).
 	PipelinePrefix = "pipeline:"
+
+	// AllClusterUsersSubject is a special principal that, when it appears on a
+	// repo's ACL, grants its scope to every user in the cluster
+	AllClusterUsersSubject = "allClusterUsers"
 )
 
 // ParseScope parses the string 's' to a scope (for example, parsing a command-
@@ -46,6 +50,18 @@ func ParseScope(s string) (Scope, error) {
 	return Scope_NONE, errors.Errorf("unrecognized scope: %s", s)
 }
 
+// ParsePermission parses the string representation of a permission and
+// returns the Permission.
+func ParsePermission(s string) (Permission, error) {
+	for name, value := range Permission_value {
+		if value != int32(Permission_PERMISSION_UNKNOWN) &&
+			strings.EqualFold(strings.Replace(s, "-", "_", -1), name) {
+			return Permission(value), nil
+		}
+	}
+	return Permission_PERMISSION_UNKNOWN, errors.Errorf("unrecognized permission: %s", s)
+}
+
 var (
 	// ErrNotActivated is returned by an Auth API if the Auth service
 	// has not been activated.
@@ -132,7 +148,12 @@ type ErrNotAuthorized struct {
 	Subject string // subject trying to perform blocked operation -- always set
 
 	Repo     string // Repo that the user is attempting to access
+	Branch   string // Branch of 'Repo' that the user is attempting to write
+	Pipeline string // Pipeline that the user is attempting to operate on
 	Required Scope  // Caller needs 'Required'-level access to 'Repo'
+	// Caller needs 'Permissions' on 'Repo' (set instead of 'Required' when an
+	// operation checks for permissions that custom roles may grant)
+	Permissions []Permission
 
 	// Group 2:
 	// AdminOp indicates an operation that the caller couldn't perform because
@@ -150,12 +171,23 @@ func (e *ErrNotAuthorized) Error() string {
 		msg += e.Subject + " is "
 	}
 	msg += errNotAuthorizedMsg
-	if e.Repo != "" {
+	if e.Pipeline != "" {
+		msg += " on the pipeline " + e.Pipeline
+	} else if e.Branch != "" {
+		msg += " on the branch " + e.Repo + "@" + e.Branch
+	} else if e.Repo != "" {
 		msg += " on the repo " + e.Repo
 	}
 	if e.Required != Scope_NONE {
 		msg += ", must have at least " + e.Required.String() + " access"
 	}
+	if len(e.Permissions) > 0 {
+		perms := make([]string, len(e.Permissions))
+		for i, p := range e.Permissions {
+			perms[i] = p.String()
+		}
+		msg += ", must have the permissions " + strings.Join(perms, ", ")
+	}
 	if e.AdminOp != "" {
 		msg += "; must be an admin to call " + e.AdminOp
 	}
@@ -65,6 +65,15 @@ func TestIsErrNotAuthorized(t *testing.T) {
 	})))
 }
 
+func TestErrNotAuthorizedMessage(t *testing.T) {
+	require.Equal(t,
+		"alice is not authorized to perform this operation on the branch data@master, must have at least WRITER access",
+		(&ErrNotAuthorized{Subject: "alice", Repo: "data", Branch: "master", Required: Scope_WRITER}).Error())
+	require.Equal(t,
+		"alice is not authorized to perform this operation on the pipeline edges, must have at least OWNER access",
+		(&ErrNotAuthorized{Subject: "alice", Repo: "edges", Pipeline: "edges", Required: Scope_OWNER}).Error())
+}
+
 func TestIsErrInvalidPrincipal(t *testing.T) {
 	require.False(t, IsErrInvalidPrincipal(nil))
 	require.True(t, IsErrInvalidPrincipal(&ErrInvalidPrincipal{
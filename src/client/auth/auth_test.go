@@ -0,0 +1,18 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestParsePermission(t *testing.T) {
+	p, err := ParsePermission("repo_read")
+	require.NoError(t, err)
+	require.Equal(t, Permission_REPO_READ, p)
+	p, err = ParsePermission("pipeline-logs")
+	require.NoError(t, err)
+	require.Equal(t, Permission_PIPELINE_LOGS, p)
+	_, err = ParsePermission("permission_unknown")
+	require.YesError(t, err)
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	gotls "crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
 	"fmt"
@@ -26,6 +27,7 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/audit"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/debug"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
@@ -38,6 +40,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/tls"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/replication"
 	"github.com/pachyderm/pachyderm/src/client/transaction"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 )
@@ -74,6 +77,12 @@ type TransactionAPIClient transaction.APIClient
 // DebugClient is an alias of debug.DebugClient
 type DebugClient debug.DebugClient
 
+// AuditAPIClient is an alias of audit.APIClient
+type AuditAPIClient audit.APIClient
+
+// ReplicationAPIClient is an alias of replication.APIClient
+type ReplicationAPIClient replication.APIClient
+
 // An APIClient is a wrapper around pfs, pps and block APIClients.
 type APIClient struct {
 	PfsAPIClient
@@ -84,6 +93,8 @@ type APIClient struct {
 	AdminAPIClient
 	TransactionAPIClient
 	DebugClient
+	AuditAPIClient
+	ReplicationAPIClient
 	Enterprise enterprise.APIClient // not embedded--method name conflicts with AuthAPIClient
 
 	// addr is a "host:port" string pointing at a pachd endpoint
@@ -92,6 +103,10 @@ type APIClient struct {
 	// The trusted CAs, for authenticating a pachd server over TLS
 	caCerts *x509.CertPool
 
+	// clientCert, if set, is presented to pachd to authenticate this client
+	// over mutual TLS
+	clientCert *gotls.Certificate
+
 	// gzipCompress configures whether to enable compression by default for all calls
 	gzipCompress bool
 
@@ -145,6 +160,7 @@ type clientSettings struct {
 	gzipCompress         bool
 	dialTimeout          time.Duration
 	caCerts              *x509.CertPool
+	clientCert           *gotls.Certificate
 	storageV2            bool
 }
 
@@ -177,6 +193,7 @@ func NewFromAddress(addr string, options ...Option) (*APIClient, error) {
 	c := &APIClient{
 		addr:         addr,
 		caCerts:      settings.caCerts,
+		clientCert:   settings.clientCert,
 		limiter:      limit.New(settings.maxConcurrentStreams),
 		gzipCompress: settings.gzipCompress,
 		storageV2:    settings.storageV2,
@@ -236,6 +253,21 @@ func WithAdditionalRootCAs(pemBytes []byte) Option {
 	}
 }
 
+// WithClientCert instructs the New* functions to create a client that
+// presents the PEM-encoded certificate and private key at 'certPath' and
+// 'keyPath' to pachd, to authenticate over mutual TLS. The client must also
+// be configured to use TLS (e.g. with WithSystemCAs).
+func WithClientCert(certPath, keyPath string) Option {
+	return func(settings *clientSettings) error {
+		cert, err := gotls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return errors.Wrapf(err, "could not load client certificate from %q and %q", certPath, keyPath)
+		}
+		settings.clientCert = &cert
+		return nil
+	}
+}
+
 // WithSystemCAs uses the system certs for client creatin.
 func WithSystemCAs(settings *clientSettings) error {
 	certs, err := x509.SystemCertPool()
@@ -359,16 +391,25 @@ func getUserMachineAddrAndOpts(context *config.Context) (*grpcutil.PachdAddress,
 			return nil, nil, errors.New("must set pachd_address to grpcs://... if server_cas is set")
 		}
 
-		if pachdAddress.Secured {
-			options = append(options, WithSystemCAs)
+		if (context.ClientCert == "") != (context.ClientKey == "") {
+			return nil, nil, errors.New("must set both client_cert and client_key, or neither")
+		}
+		if context.ClientCert != "" && !pachdAddress.Secured {
+			return nil, nil, errors.New("must set pachd_address to grpcs://... if client_cert is set")
 		}
+
 		// Also get cert info from config (if set)
 		if context.ServerCAs != "" {
 			pemBytes, err := base64.StdEncoding.DecodeString(context.ServerCAs)
 			if err != nil {
 				return nil, nil, errors.Wrap(err, "could not decode server CA certs in config")
 			}
-			return pachdAddress, []Option{WithAdditionalRootCAs(pemBytes)}, nil
+			options = append(options, WithAdditionalRootCAs(pemBytes))
+		} else if pachdAddress.Secured {
+			options = append(options, WithSystemCAs)
+		}
+		if context.ClientCert != "" {
+			options = append(options, WithClientCert(context.ClientCert, context.ClientKey))
 		}
 		return pachdAddress, options, nil
 	}
@@ -403,7 +444,7 @@ func NewForTest() (*APIClient, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not read config")
 	}
-	_, context, err := cfg.ActiveContext(true)
+	_, context, err := cfg.ActiveResolvedContext(true)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get active context")
 	}
@@ -437,7 +478,16 @@ func NewOnUserMachine(prefix string, options ...Option) (*APIClient, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "could not read config")
 	}
-	_, context, err := cfg.ActiveContext(true)
+	// Only the session token of the active context (and of the contexts it
+	// inherits from) is read from the secret store. Values inherited from
+	// parent contexts are used to connect, but the cluster deployment ID is
+	// saved to the active context itself
+	contextName, _, err := cfg.ActiveContext(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active context")
+	}
+	cfg.LoadSessionToken(contextName)
+	context, err := cfg.ResolvedContext(contextName)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get active context")
 	}
@@ -494,7 +544,7 @@ func NewOnUserMachine(prefix string, options ...Option) (*APIClient, error) {
 	}
 	if context.ClusterDeploymentID != clusterInfo.DeploymentID {
 		if context.ClusterDeploymentID == "" {
-			context.ClusterDeploymentID = clusterInfo.DeploymentID
+			cfg.V2.Contexts[contextName].ClusterDeploymentID = clusterInfo.DeploymentID
 			if err = cfg.Write(); err != nil {
 				return nil, errors.Wrap(err, "could not write config to save cluster deployment ID")
 			}
@@ -606,9 +656,18 @@ func DefaultDialOptions() []grpc.DialOption {
 
 func (c *APIClient) connect(timeout time.Duration) error {
 	dialOptions := DefaultDialOptions()
-	if c.caCerts == nil {
+	switch {
+	case c.caCerts == nil && c.clientCert != nil:
+		return errors.New("a client certificate can only be used with TLS (grpcs://)")
+	case c.caCerts == nil:
 		dialOptions = append(dialOptions, grpc.WithInsecure())
-	} else {
+	case c.clientCert != nil:
+		tlsCreds := credentials.NewTLS(&gotls.Config{
+			RootCAs:      c.caCerts,
+			Certificates: []gotls.Certificate{*c.clientCert},
+		})
+		dialOptions = append(dialOptions, grpc.WithTransportCredentials(tlsCreds))
+	default:
 		tlsCreds := credentials.NewClientTLSFromCert(c.caCerts, "")
 		dialOptions = append(dialOptions, grpc.WithTransportCredentials(tlsCreds))
 	}
@@ -646,6 +705,8 @@ func (c *APIClient) connect(timeout time.Duration) error {
 	c.AdminAPIClient = admin.NewAPIClient(clientConn)
 	c.TransactionAPIClient = transaction.NewAPIClient(clientConn)
 	c.DebugClient = debug.NewDebugClient(clientConn)
+	c.AuditAPIClient = audit.NewAPIClient(clientConn)
+	c.ReplicationAPIClient = replication.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = health.NewHealthClient(clientConn)
 	return nil
@@ -2,7 +2,9 @@ package client
 
 import (
 	"io"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client/debug"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 )
@@ -22,6 +24,32 @@ func (c APIClient) Profile(profile *debug.Profile, filter *debug.Filter, w io.Wr
 	return grpcutil.WriteFromStreamingBytesClient(profileC, w)
 }
 
+// ProfileRange collects the continuously collected profiles for a time range,
+// merged into a single profile per container.
+func (c APIClient) ProfileRange(name string, from, to time.Time, filter *debug.Filter, w io.Writer) (retErr error) {
+	defer func() {
+		retErr = grpcutil.ScrubGRPC(retErr)
+	}()
+	fromProto, err := types.TimestampProto(from)
+	if err != nil {
+		return err
+	}
+	toProto, err := types.TimestampProto(to)
+	if err != nil {
+		return err
+	}
+	profileC, err := c.DebugClient.ProfileRange(c.Ctx(), &debug.ProfileRangeRequest{
+		Name:   name,
+		From:   fromProto,
+		To:     toProto,
+		Filter: filter,
+	})
+	if err != nil {
+		return err
+	}
+	return grpcutil.WriteFromStreamingBytesClient(profileC, w)
+}
+
 // Binary collects a set of binaries.
 func (c APIClient) Binary(filter *debug.Filter, w io.Writer) (retErr error) {
 	defer func() {
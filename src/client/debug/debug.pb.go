@@ -294,6 +294,79 @@ func (m *Worker) GetRedirected() bool {
 	return false
 }
 
+// ProfileRangeRequest requests the continuously collected profiles for a
+// time range, merged into a single profile per container.
+type ProfileRangeRequest struct {
+	Name                 string           `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	From                 *types.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *types.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Filter               *Filter          `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ProfileRangeRequest) Reset()         { *m = ProfileRangeRequest{} }
+func (m *ProfileRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*ProfileRangeRequest) ProtoMessage()    {}
+func (*ProfileRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d15a320d0127c22, []int{4}
+}
+func (m *ProfileRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfileRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfileRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfileRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfileRangeRequest.Merge(m, src)
+}
+func (m *ProfileRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfileRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfileRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfileRangeRequest proto.InternalMessageInfo
+
+func (m *ProfileRangeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProfileRangeRequest) GetFrom() *types.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *ProfileRangeRequest) GetTo() *types.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *ProfileRangeRequest) GetFilter() *Filter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
 type BinaryRequest struct {
 	Filter               *Filter  `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -305,7 +378,7 @@ func (m *BinaryRequest) Reset()         { *m = BinaryRequest{} }
 func (m *BinaryRequest) String() string { return proto.CompactTextString(m) }
 func (*BinaryRequest) ProtoMessage()    {}
 func (*BinaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d15a320d0127c22, []int{4}
+	return fileDescriptor_6d15a320d0127c22, []int{5}
 }
 func (m *BinaryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,7 +425,7 @@ func (m *DumpRequest) Reset()         { *m = DumpRequest{} }
 func (m *DumpRequest) String() string { return proto.CompactTextString(m) }
 func (*DumpRequest) ProtoMessage()    {}
 func (*DumpRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d15a320d0127c22, []int{5}
+	return fileDescriptor_6d15a320d0127c22, []int{6}
 }
 func (m *DumpRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,6 +466,7 @@ func init() {
 	proto.RegisterType((*Profile)(nil), "debug.Profile")
 	proto.RegisterType((*Filter)(nil), "debug.Filter")
 	proto.RegisterType((*Worker)(nil), "debug.Worker")
+	proto.RegisterType((*ProfileRangeRequest)(nil), "debug.ProfileRangeRequest")
 	proto.RegisterType((*BinaryRequest)(nil), "debug.BinaryRequest")
 	proto.RegisterType((*DumpRequest)(nil), "debug.DumpRequest")
 }
@@ -400,34 +474,39 @@ func init() {
 func init() { proto.RegisterFile("client/debug/debug.proto", fileDescriptor_6d15a320d0127c22) }
 
 var fileDescriptor_6d15a320d0127c22 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x5d, 0x6b, 0xd4, 0x40,
-	0x14, 0xdd, 0xb1, 0xdd, 0x34, 0xde, 0xd2, 0x22, 0x97, 0x2a, 0x6b, 0x85, 0x20, 0x01, 0xb1, 0x20,
-	0x24, 0x52, 0x3f, 0x1e, 0x14, 0x11, 0x97, 0x45, 0xf6, 0xb1, 0x0c, 0xa2, 0xe0, 0x5b, 0x36, 0xb9,
-	0x9b, 0x0e, 0x66, 0x33, 0xe3, 0x64, 0x42, 0xd9, 0x37, 0x7f, 0x5e, 0x1f, 0xfd, 0x09, 0xb2, 0xbf,
-	0x44, 0x32, 0x33, 0xd9, 0xa6, 0x16, 0x2c, 0x7d, 0xd8, 0x65, 0xe6, 0x9c, 0x73, 0xcf, 0xdc, 0x73,
-	0x2f, 0x81, 0x49, 0x5e, 0x09, 0xaa, 0x4d, 0x5a, 0xd0, 0xa2, 0x2d, 0xdd, 0x7f, 0xa2, 0xb4, 0x34,
-	0x12, 0xc7, 0xf6, 0x72, 0x1c, 0x95, 0x52, 0x96, 0x15, 0xa5, 0x16, 0x5c, 0xb4, 0xcb, 0xf4, 0x42,
-	0x67, 0x4a, 0x91, 0x6e, 0x9c, 0xec, 0x26, 0x5f, 0xb4, 0x3a, 0x33, 0x42, 0xd6, 0x9e, 0x3f, 0xf2,
-	0x0f, 0x28, 0xd5, 0x74, 0x3f, 0x87, 0xc6, 0x19, 0x1c, 0x9e, 0x69, 0xb9, 0x14, 0x15, 0x71, 0xfa,
-	0xd9, 0x52, 0x63, 0xf0, 0x04, 0xf6, 0x94, 0x43, 0x26, 0xec, 0x29, 0x3b, 0xd9, 0x3f, 0x3d, 0x4c,
-	0x5c, 0x37, 0xbd, 0xae, 0xa7, 0xf1, 0x19, 0x04, 0x4b, 0x51, 0x19, 0xd2, 0x93, 0x7b, 0x56, 0x78,
-	0xe0, 0x85, 0x9f, 0x2d, 0xc8, 0x3d, 0x19, 0x7f, 0x81, 0x3d, 0x5f, 0x8a, 0x08, 0xbb, 0x75, 0xb6,
-	0x72, 0xc6, 0xf7, 0xb9, 0x3d, 0xe3, 0x1b, 0x08, 0xfb, 0x4e, 0xbd, 0xcf, 0xe3, 0xc4, 0x45, 0x49,
-	0xfa, 0x28, 0xc9, 0xcc, 0x0b, 0xf8, 0x56, 0x1a, 0xff, 0x62, 0x10, 0xb8, 0x87, 0xf0, 0x11, 0x8c,
-	0x55, 0x96, 0x9f, 0x17, 0xd6, 0x36, 0x9c, 0x8f, 0xb8, 0xbb, 0xe2, 0x0b, 0x08, 0x95, 0x50, 0x54,
-	0x89, 0x9a, 0xb6, 0x1d, 0x76, 0xc9, 0xcf, 0x3c, 0x38, 0x1f, 0xf1, 0xad, 0x00, 0x9f, 0x43, 0x70,
-	0x21, 0xf5, 0x0f, 0xd2, 0x93, 0x9d, 0x6b, 0x61, 0xbe, 0x59, 0x70, 0x3e, 0xe2, 0x9e, 0x9e, 0x86,
-	0x7d, 0xea, 0xf8, 0x1d, 0x04, 0x8e, 0xc5, 0x07, 0xb0, 0xa3, 0x64, 0xe1, 0x63, 0x75, 0x47, 0x8c,
-	0x00, 0x34, 0x15, 0x42, 0x53, 0x6e, 0xa8, 0xb0, 0xaf, 0x87, 0x7c, 0x80, 0xc4, 0x6f, 0xe1, 0x60,
-	0x2a, 0xea, 0x4c, 0xaf, 0xfb, 0xb1, 0x5f, 0x0d, 0x93, 0xfd, 0x6f, 0x98, 0xaf, 0x61, 0x7f, 0xd6,
-	0xae, 0xd4, 0xdd, 0xaa, 0x4e, 0x2f, 0x19, 0x8c, 0x67, 0x1d, 0x81, 0x9f, 0xae, 0x96, 0xf1, 0xf0,
-	0x9f, 0xbd, 0x3a, 0xcb, 0xe3, 0x27, 0x37, 0xa6, 0x3f, 0x5d, 0x1b, 0x6a, 0xbe, 0x66, 0x55, 0x4b,
-	0xf1, 0xe8, 0x25, 0xc3, 0x8f, 0x10, 0xb8, 0xd6, 0xf1, 0xc8, 0x3b, 0x5c, 0x4b, 0x72, 0xbb, 0xc1,
-	0x7b, 0xd8, 0xed, 0x32, 0x20, 0xfa, 0xf2, 0x41, 0xa0, 0x5b, 0x8b, 0xa7, 0x1f, 0x2e, 0x37, 0x11,
-	0xfb, 0xbd, 0x89, 0xd8, 0x9f, 0x4d, 0xc4, 0xbe, 0xa7, 0xa5, 0x30, 0xe7, 0xed, 0x22, 0xc9, 0xe5,
-	0x2a, 0xed, 0x96, 0xbe, 0x2e, 0x48, 0x0f, 0x4f, 0x8d, 0xce, 0xd3, 0xe1, 0x87, 0xb5, 0x08, 0xac,
-	0xef, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xac, 0x50, 0xf4, 0x22, 0x6f, 0x03, 0x00, 0x00,
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0x6e, 0xfa, 0x93, 0x8d, 0x67, 0xdd, 0x45, 0xc6, 0x55, 0x62, 0x85, 0x28, 0x01, 0x71, 0x51,
+	0x48, 0x64, 0xfd, 0xb9, 0x50, 0x44, 0x2c, 0x45, 0x0a, 0xde, 0x2c, 0x61, 0x51, 0xf0, 0x2e, 0x4d,
+	0x4e, 0xb3, 0x83, 0x49, 0x66, 0x9c, 0x4c, 0x58, 0x7a, 0xe7, 0x4b, 0xf8, 0x16, 0x3e, 0x88, 0x97,
+	0x3e, 0x82, 0xf4, 0x49, 0x24, 0x33, 0x93, 0x6e, 0xb6, 0x95, 0x2d, 0x5e, 0xb4, 0xcc, 0x9c, 0xef,
+	0x3b, 0xdf, 0x9c, 0xef, 0x9b, 0x21, 0xe0, 0x26, 0x39, 0xc5, 0x52, 0x86, 0x29, 0xce, 0xeb, 0x4c,
+	0xff, 0x07, 0x5c, 0x30, 0xc9, 0xc8, 0x48, 0x6d, 0xc6, 0x5e, 0xc6, 0x58, 0x96, 0x63, 0xa8, 0x8a,
+	0xf3, 0x7a, 0x11, 0x5e, 0x88, 0x98, 0x73, 0x14, 0x95, 0xa6, 0x6d, 0xe3, 0x69, 0x2d, 0x62, 0x49,
+	0x59, 0x69, 0xf0, 0x07, 0x9b, 0xb8, 0xa4, 0x05, 0x56, 0x32, 0x2e, 0xb8, 0x21, 0x1c, 0x99, 0x09,
+	0x38, 0xaf, 0x9a, 0x9f, 0xae, 0xfa, 0x31, 0x1c, 0x9e, 0x0a, 0xb6, 0xa0, 0x39, 0x46, 0xf8, 0xad,
+	0xc6, 0x4a, 0x92, 0x63, 0xd8, 0xe3, 0xba, 0xe2, 0x5a, 0x0f, 0xad, 0xe3, 0xfd, 0x93, 0xc3, 0x40,
+	0x8f, 0xdb, 0xf2, 0x5a, 0x98, 0x3c, 0x02, 0x7b, 0x41, 0x73, 0x89, 0xc2, 0xed, 0x2b, 0xe2, 0x81,
+	0x21, 0x7e, 0x50, 0xc5, 0xc8, 0x80, 0xfe, 0x19, 0xec, 0x99, 0x56, 0x42, 0x60, 0x58, 0xc6, 0x85,
+	0x16, 0xbe, 0x11, 0xa9, 0x35, 0x79, 0x09, 0x4e, 0x6b, 0xc5, 0xe8, 0xdc, 0x0b, 0xb4, 0x97, 0xa0,
+	0xf5, 0x12, 0x4c, 0x0d, 0x21, 0x5a, 0x53, 0xfd, 0xef, 0x16, 0xd8, 0xfa, 0x20, 0x72, 0x17, 0x46,
+	0x3c, 0x4e, 0xce, 0x53, 0x25, 0xeb, 0xcc, 0x7a, 0x91, 0xde, 0x92, 0xa7, 0xe0, 0x70, 0xca, 0x31,
+	0xa7, 0x25, 0xae, 0x27, 0x6c, 0x9c, 0x9f, 0x9a, 0xe2, 0xac, 0x17, 0xad, 0x09, 0xe4, 0x31, 0xd8,
+	0x17, 0x4c, 0x7c, 0x45, 0xe1, 0x0e, 0xae, 0x98, 0xf9, 0xac, 0x8a, 0xb3, 0x5e, 0x64, 0xe0, 0x89,
+	0xd3, 0xba, 0xf6, 0x5f, 0x83, 0xad, 0x51, 0x72, 0x0b, 0x06, 0x9c, 0xa5, 0xc6, 0x56, 0xb3, 0x24,
+	0x1e, 0x80, 0xc0, 0x94, 0x0a, 0x4c, 0x24, 0xa6, 0xea, 0x74, 0x27, 0xea, 0x54, 0xfc, 0x9f, 0x16,
+	0xdc, 0x6e, 0x03, 0x8d, 0xcb, 0x6c, 0x9d, 0xfe, 0xbf, 0x12, 0x0a, 0x60, 0xb8, 0x10, 0xac, 0x30,
+	0x1e, 0xc6, 0x5b, 0xe9, 0x9c, 0xb5, 0x37, 0x1d, 0x29, 0x1e, 0x79, 0x02, 0x7d, 0xc9, 0xdc, 0xc1,
+	0x4e, 0x76, 0x5f, 0xb2, 0xce, 0x1d, 0x0e, 0xaf, 0xbb, 0xc3, 0x57, 0x70, 0x30, 0xa1, 0x65, 0x2c,
+	0x96, 0xed, 0x9c, 0x97, 0x7d, 0xd6, 0x75, 0x7d, 0x2f, 0x60, 0x7f, 0x5a, 0x17, 0xfc, 0xff, 0xba,
+	0x4e, 0x7e, 0xf4, 0x61, 0x34, 0x6d, 0x00, 0xf2, 0xfe, 0xf2, 0xed, 0xdc, 0xd9, 0x78, 0x86, 0x5a,
+	0x72, 0x7c, 0x7f, 0xcb, 0xe0, 0x64, 0x29, 0xb1, 0xfa, 0x14, 0xe7, 0x35, 0xfa, 0xbd, 0x67, 0x16,
+	0xf9, 0x08, 0x37, 0xbb, 0x41, 0x93, 0xf1, 0x86, 0x4e, 0x27, 0xfd, 0xdd, 0x62, 0xef, 0xc0, 0xd6,
+	0x39, 0x90, 0x23, 0x23, 0x73, 0x25, 0x96, 0xdd, 0x02, 0x6f, 0x60, 0xd8, 0x04, 0x42, 0x88, 0x69,
+	0xef, 0xa4, 0xb3, 0xb3, 0x79, 0xf2, 0xf6, 0xd7, 0xca, 0xb3, 0x7e, 0xaf, 0x3c, 0xeb, 0xcf, 0xca,
+	0xb3, 0xbe, 0x84, 0x19, 0x95, 0xe7, 0xf5, 0x3c, 0x48, 0x58, 0x11, 0x36, 0x0f, 0x7e, 0x99, 0xa2,
+	0xe8, 0xae, 0x2a, 0x91, 0x84, 0xdd, 0xaf, 0xce, 0xdc, 0x56, 0xba, 0xcf, 0xff, 0x0e, 0x00, 0x47,
+	0xd6, 0xd1, 0x80, 0x8c, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -443,6 +522,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type DebugClient interface {
 	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (Debug_ProfileClient, error)
+	ProfileRange(ctx context.Context, in *ProfileRangeRequest, opts ...grpc.CallOption) (Debug_ProfileRangeClient, error)
 	Binary(ctx context.Context, in *BinaryRequest, opts ...grpc.CallOption) (Debug_BinaryClient, error)
 	Dump(ctx context.Context, in *DumpRequest, opts ...grpc.CallOption) (Debug_DumpClient, error)
 }
@@ -487,8 +567,40 @@ func (x *debugProfileClient) Recv() (*types.BytesValue, error) {
 	return m, nil
 }
 
+func (c *debugClient) ProfileRange(ctx context.Context, in *ProfileRangeRequest, opts ...grpc.CallOption) (Debug_ProfileRangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[1], "/debug.Debug/ProfileRange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &debugProfileRangeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Debug_ProfileRangeClient interface {
+	Recv() (*types.BytesValue, error)
+	grpc.ClientStream
+}
+
+type debugProfileRangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *debugProfileRangeClient) Recv() (*types.BytesValue, error) {
+	m := new(types.BytesValue)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *debugClient) Binary(ctx context.Context, in *BinaryRequest, opts ...grpc.CallOption) (Debug_BinaryClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[1], "/debug.Debug/Binary", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[2], "/debug.Debug/Binary", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -520,7 +632,7 @@ func (x *debugBinaryClient) Recv() (*types.BytesValue, error) {
 }
 
 func (c *debugClient) Dump(ctx context.Context, in *DumpRequest, opts ...grpc.CallOption) (Debug_DumpClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[2], "/debug.Debug/Dump", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Debug_serviceDesc.Streams[3], "/debug.Debug/Dump", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -554,6 +666,7 @@ func (x *debugDumpClient) Recv() (*types.BytesValue, error) {
 // DebugServer is the server API for Debug service.
 type DebugServer interface {
 	Profile(*ProfileRequest, Debug_ProfileServer) error
+	ProfileRange(*ProfileRangeRequest, Debug_ProfileRangeServer) error
 	Binary(*BinaryRequest, Debug_BinaryServer) error
 	Dump(*DumpRequest, Debug_DumpServer) error
 }
@@ -565,6 +678,9 @@ type UnimplementedDebugServer struct {
 func (*UnimplementedDebugServer) Profile(req *ProfileRequest, srv Debug_ProfileServer) error {
 	return status.Errorf(codes.Unimplemented, "method Profile not implemented")
 }
+func (*UnimplementedDebugServer) ProfileRange(req *ProfileRangeRequest, srv Debug_ProfileRangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProfileRange not implemented")
+}
 func (*UnimplementedDebugServer) Binary(req *BinaryRequest, srv Debug_BinaryServer) error {
 	return status.Errorf(codes.Unimplemented, "method Binary not implemented")
 }
@@ -597,6 +713,27 @@ func (x *debugProfileServer) Send(m *types.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Debug_ProfileRange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileRangeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DebugServer).ProfileRange(m, &debugProfileRangeServer{stream})
+}
+
+type Debug_ProfileRangeServer interface {
+	Send(*types.BytesValue) error
+	grpc.ServerStream
+}
+
+type debugProfileRangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *debugProfileRangeServer) Send(m *types.BytesValue) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Debug_Binary_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(BinaryRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -649,6 +786,11 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Debug_Profile_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ProfileRange",
+			Handler:       _Debug_ProfileRange_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Binary",
 			Handler:       _Debug_Binary_Handler,
@@ -899,6 +1041,76 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProfileRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProfileRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfileRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Filter != nil {
+		{
+			size, err := m.Filter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.To != nil {
+		{
+			size, err := m.To.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.From != nil {
+		{
+			size, err := m.From.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDebug(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDebug(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BinaryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1095,6 +1307,34 @@ func (m *Worker) Size() (n int) {
 	return n
 }
 
+func (m *ProfileRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.Filter != nil {
+		l = m.Filter.Size()
+		n += 1 + l + sovDebug(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *BinaryRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1632,6 +1872,200 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProfileRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDebug
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfileRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfileRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.From == nil {
+				m.From = &types.Timestamp{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.To == nil {
+				m.To = &types.Timestamp{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDebug
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDebug
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Filter == nil {
+				m.Filter = &Filter{}
+			}
+			if err := m.Filter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDebug(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthDebug
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BinaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -455,6 +455,14 @@ type RepoInfo struct {
 	SizeBytes   uint64           `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 	Description string           `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
 	Branches    []*Branch        `protobuf:"bytes,7,rep,name=branches,proto3" json:"branches,omitempty"`
+	// labels are the data classification labels (e.g. "pii") set on this repo
+	// by its owner. Data policies in the auth config determine what each label
+	// implies
+	Labels []string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty"`
+	// inherited_labels are the classification labels that this repo has
+	// inherited from the repos in its provenance. Labels are never removed from
+	// this list, as the repo's existing commits may still contain labelled data
+	InheritedLabels []string `protobuf:"bytes,9,rep,name=inherited_labels,json=inheritedLabels,proto3" json:"inherited_labels,omitempty"`
 	// Set by ListRepo and InspectRepo if Pachyderm's auth system is active, but
 	// not stored in etcd. To set a user's auth scope for a repo, use the
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
@@ -532,6 +540,20 @@ func (m *RepoInfo) GetBranches() []*Branch {
 	return nil
 }
 
+func (m *RepoInfo) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *RepoInfo) GetInheritedLabels() []string {
+	if m != nil {
+		return m.InheritedLabels
+	}
+	return nil
+}
+
 func (m *RepoInfo) GetAuthInfo() *RepoAuthInfo {
 	if m != nil {
 		return m.AuthInfo
@@ -1673,9 +1695,14 @@ func (m *PathRange) GetUpper() string {
 }
 
 type CreateRepoRequest struct {
-	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Update               bool     `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
+	Repo        *Repo  `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Update      bool   `protobuf:"varint,4,opt,name=update,proto3" json:"update,omitempty"`
+	// labels are the data classification labels to set on the repo. If 'update'
+	// is set, these replace the repo's existing labels, unless no labels are
+	// given and 'clear_labels' is unset (in which case the labels are unchanged)
+	Labels               []string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	ClearLabels          bool     `protobuf:"varint,6,opt,name=clear_labels,json=clearLabels,proto3" json:"clear_labels,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1735,6 +1762,20 @@ func (m *CreateRepoRequest) GetUpdate() bool {
 	return false
 }
 
+func (m *CreateRepoRequest) GetLabels() []string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *CreateRepoRequest) GetClearLabels() bool {
+	if m != nil {
+		return m.ClearLabels
+	}
+	return false
+}
+
 type InspectRepoRequest struct {
 	Repo                 *Repo    `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -5356,7 +5397,12 @@ func (m *GetObjDirectRequest) GetObj() string {
 }
 
 type DeleteObjDirectRequest struct {
-	Object               string   `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// Delete a single object by its path.
+	Object string `protobuf:"bytes,1,opt,name=object,proto3" json:"object,omitempty"`
+	// Delete all objects with paths matching this prefix, cannot be applied to
+	// the core storage layer paths, as those are generally not safe to delete
+	// (use garbage collection for that). This is for deleting objects generated
+	// vi `PutObjDirect`.
 	Prefix               string   `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -5564,257 +5610,259 @@ func init() {
 func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptor_b48f014707f6595c) }
 
 var fileDescriptor_b48f014707f6595c = []byte{
-	// 3992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5b, 0x4b, 0x73, 0xdb, 0x58,
-	0x76, 0x16, 0xf8, 0x04, 0x0e, 0x29, 0x91, 0xba, 0x92, 0x69, 0x9a, 0x6e, 0xb7, 0xdd, 0x70, 0x77,
-	0x8f, 0x5b, 0xdd, 0x23, 0x69, 0xa8, 0xf4, 0xc3, 0xf6, 0xb4, 0x5d, 0xd6, 0xcb, 0xa6, 0xc7, 0x65,
-	0x29, 0xa0, 0x5a, 0x49, 0xa6, 0x92, 0xb0, 0x40, 0xf2, 0x92, 0x84, 0x0d, 0x11, 0x1c, 0x00, 0xb4,
-	0xac, 0x59, 0x24, 0xbb, 0xe4, 0x47, 0x64, 0x93, 0x9a, 0x75, 0x16, 0xa9, 0xec, 0x52, 0x59, 0x64,
-	0x91, 0x4d, 0x2a, 0xa9, 0x54, 0xcd, 0x2f, 0x48, 0xa5, 0xfc, 0x33, 0xb2, 0x49, 0xea, 0xbe, 0x80,
-	0x8b, 0x07, 0x1f, 0x72, 0x25, 0x8b, 0x6e, 0x01, 0xf7, 0x9e, 0x73, 0xef, 0xb9, 0xe7, 0x9c, 0x7b,
-	0x1e, 0x1f, 0x68, 0xd8, 0xec, 0xd9, 0x16, 0x1e, 0xfb, 0x3b, 0x93, 0x81, 0x47, 0xfe, 0xdb, 0x9e,
-	0xb8, 0x8e, 0xef, 0xa0, 0xec, 0x64, 0xe0, 0x35, 0x6e, 0x0f, 0x1d, 0x67, 0x68, 0xe3, 0x1d, 0x3a,
-	0xd4, 0x9d, 0x0e, 0x76, 0xf0, 0xc5, 0xc4, 0xbf, 0x62, 0x14, 0x8d, 0xbb, 0xf1, 0x49, 0xdf, 0xba,
-	0xc0, 0x9e, 0x6f, 0x5e, 0x4c, 0x38, 0xc1, 0xa7, 0x71, 0x82, 0x4b, 0xd7, 0x9c, 0x4c, 0xb0, 0xcb,
-	0xb7, 0x68, 0x6c, 0x0e, 0x9d, 0xa1, 0x43, 0x1f, 0x77, 0xc8, 0x13, 0x1f, 0xad, 0x71, 0x71, 0xcc,
-	0xa9, 0x3f, 0xa2, 0xff, 0x63, 0xe3, 0x7a, 0x03, 0x72, 0x06, 0x9e, 0x38, 0x08, 0x41, 0x6e, 0x6c,
-	0x5e, 0xe0, 0xba, 0x72, 0x4f, 0x79, 0xa0, 0x19, 0xf4, 0x59, 0x7f, 0x0c, 0x85, 0x7d, 0xd7, 0x1c,
-	0xf7, 0x46, 0xe8, 0x0e, 0xe4, 0x5c, 0x3c, 0x71, 0xe8, 0x6c, 0xa9, 0xa9, 0x6d, 0x93, 0x03, 0x11,
-	0x36, 0x83, 0x0e, 0x07, 0xcc, 0x19, 0x89, 0xf9, 0x29, 0xe4, 0x8e, 0x2d, 0x1b, 0xa3, 0xfb, 0x50,
-	0xe8, 0x39, 0x17, 0x17, 0x96, 0xcf, 0x99, 0x4b, 0x94, 0xf9, 0x80, 0x0e, 0x19, 0x7c, 0x8a, 0x2c,
-	0x30, 0x31, 0xfd, 0x91, 0x58, 0x80, 0x3c, 0xeb, 0xb7, 0x21, 0xbf, 0x6f, 0x3b, 0xbd, 0xb7, 0x64,
-	0x72, 0x64, 0x7a, 0x23, 0x21, 0x1a, 0x79, 0xd6, 0x3f, 0x81, 0xc2, 0x49, 0xf7, 0x0d, 0xee, 0xf9,
-	0xa9, 0xb3, 0xb7, 0x20, 0x7b, 0x66, 0x0e, 0x53, 0xcf, 0xf4, 0x3f, 0x0a, 0xa8, 0x44, 0xf2, 0xd6,
-	0x78, 0xe0, 0x2c, 0x3a, 0xd6, 0x1f, 0x40, 0xb1, 0xe7, 0x62, 0xd3, 0xc7, 0x7d, 0x2a, 0x58, 0xa9,
-	0xd9, 0xd8, 0x66, 0xba, 0xdf, 0x16, 0xba, 0xdf, 0x3e, 0x13, 0xc6, 0x31, 0x04, 0x29, 0xba, 0x03,
-	0xe0, 0x59, 0xbf, 0xc5, 0x9d, 0xee, 0x95, 0x8f, 0xbd, 0x7a, 0xf6, 0x9e, 0xf2, 0x20, 0x67, 0x68,
-	0x64, 0x64, 0x9f, 0x0c, 0xa0, 0x7b, 0x50, 0xea, 0x63, 0xaf, 0xe7, 0x5a, 0x13, 0xdf, 0x72, 0xc6,
-	0xf5, 0x3c, 0x95, 0x4d, 0x1e, 0x42, 0x3f, 0x03, 0xb5, 0x4b, 0xd5, 0x8e, 0xbd, 0x7a, 0xf1, 0x5e,
-	0x36, 0xd0, 0x19, 0xb3, 0x85, 0x11, 0x4c, 0xa2, 0x6d, 0xd0, 0x88, 0x25, 0x3b, 0xd6, 0x78, 0xe0,
-	0xd4, 0x0b, 0x54, 0xc2, 0xf5, 0xe0, 0x0c, 0xcf, 0xa6, 0xfe, 0x88, 0x1c, 0xd2, 0x50, 0x4d, 0xfe,
-	0xf4, 0x32, 0xa7, 0xe6, 0xaa, 0x79, 0xfd, 0x09, 0x94, 0xe5, 0x79, 0xb4, 0x0d, 0x65, 0xb3, 0xd7,
-	0xc3, 0x9e, 0xd7, 0xb1, 0xf1, 0x3b, 0x6c, 0x53, 0x65, 0xac, 0x35, 0x4b, 0xdb, 0xd4, 0x49, 0xda,
-	0x3d, 0x67, 0x82, 0x8d, 0x12, 0x23, 0x78, 0x45, 0xe6, 0xf5, 0xdf, 0x65, 0x00, 0x98, 0x28, 0x94,
-	0xfd, 0x3e, 0x14, 0x98, 0x40, 0xf5, 0x9c, 0x64, 0x5f, 0x2e, 0x2b, 0x9f, 0x42, 0x77, 0x21, 0x37,
-	0xc2, 0xa6, 0x50, 0x63, 0xc4, 0x05, 0xe8, 0x04, 0xfa, 0x1a, 0x60, 0xe2, 0x3a, 0xef, 0xf0, 0xd8,
-	0x1c, 0xf7, 0x70, 0x3d, 0x9b, 0x3c, 0xb5, 0x34, 0x4d, 0x88, 0xbd, 0x69, 0x57, 0x10, 0xe7, 0x53,
-	0x88, 0xc3, 0x69, 0xf4, 0x03, 0xac, 0xf7, 0x2d, 0x17, 0xf7, 0xfc, 0x8e, 0xb4, 0x41, 0x21, 0xc9,
-	0x53, 0x65, 0x54, 0xa7, 0xe1, 0x36, 0x5f, 0x42, 0xd1, 0x77, 0xad, 0xe1, 0x10, 0xbb, 0xf5, 0x22,
-	0x95, 0xbb, 0x4c, 0xe9, 0xcf, 0xd8, 0x98, 0x21, 0x26, 0x53, 0xdd, 0xec, 0x29, 0x94, 0x42, 0x1d,
-	0x79, 0x68, 0x17, 0x4a, 0x4c, 0x13, 0xcc, 0x56, 0x0a, 0xdd, 0xbe, 0x22, 0x6d, 0x4f, 0x2d, 0x05,
-	0xdd, 0xe0, 0x59, 0xff, 0x0b, 0x28, 0xf2, 0x8d, 0x50, 0x2d, 0xd0, 0x30, 0xdb, 0x41, 0x28, 0xb5,
-	0x0a, 0x59, 0xd3, 0xb6, 0xa9, 0x4e, 0x55, 0x83, 0x3c, 0xa2, 0xdb, 0xa0, 0xf5, 0x5c, 0x67, 0xdc,
-	0xf1, 0x26, 0xb8, 0x47, 0x3d, 0x4f, 0x33, 0x54, 0x32, 0xd0, 0x9e, 0xe0, 0x1e, 0x11, 0x93, 0x78,
-	0x21, 0x35, 0x93, 0x66, 0xd0, 0x67, 0x54, 0x87, 0x22, 0xbb, 0x81, 0x1e, 0x75, 0xc4, 0xac, 0x21,
-	0x5e, 0xf5, 0x3d, 0x28, 0x33, 0x03, 0x9d, 0xb8, 0xd6, 0xd0, 0x1a, 0xa3, 0xfb, 0x90, 0x7b, 0x6b,
-	0x8d, 0xfb, 0xdc, 0x3b, 0x98, 0xe8, 0x6c, 0xea, 0x57, 0xd6, 0xb8, 0x6f, 0xd0, 0x49, 0xfd, 0x29,
-	0x14, 0x18, 0xd3, 0xa2, 0x9b, 0x55, 0x83, 0x8c, 0xc5, 0xbc, 0x41, 0xdb, 0x2f, 0x7c, 0xf8, 0xcf,
-	0xbb, 0x99, 0xd6, 0xa1, 0x91, 0xb1, 0xfa, 0x7a, 0x1b, 0x4a, 0xdc, 0x2d, 0xcc, 0xf1, 0x10, 0xa3,
-	0xcf, 0x20, 0x6f, 0x3b, 0x97, 0xd8, 0x4d, 0x0b, 0x1d, 0x6c, 0x86, 0x90, 0x4c, 0x49, 0xf4, 0x4b,
-	0x73, 0x2d, 0x36, 0xa3, 0xff, 0x29, 0x54, 0xd9, 0x80, 0x64, 0xdb, 0xa5, 0xa2, 0x52, 0xe8, 0xda,
-	0x99, 0x99, 0xae, 0xad, 0xff, 0x47, 0x01, 0x80, 0xf1, 0x89, 0xeb, 0x70, 0x9d, 0x85, 0x2b, 0xb3,
-	0xef, 0xcc, 0x57, 0x50, 0x70, 0xa8, 0x82, 0xeb, 0xeb, 0xd2, 0xd5, 0x96, 0x8d, 0x62, 0x70, 0x82,
-	0x78, 0x4c, 0x51, 0x93, 0x31, 0x65, 0x17, 0x56, 0x27, 0xa6, 0x8b, 0xc7, 0x7e, 0x87, 0x4b, 0x97,
-	0xa2, 0xae, 0x32, 0xa3, 0xe0, 0x16, 0xdc, 0x85, 0xd5, 0xde, 0xc8, 0xb2, 0xfb, 0x1d, 0xe1, 0x20,
-	0x25, 0xe9, 0xce, 0x08, 0x0e, 0x4a, 0xc1, 0x5e, 0x3c, 0x12, 0x2e, 0x3d, 0xdf, 0x74, 0x49, 0xb8,
-	0xcc, 0x2e, 0x0e, 0x97, 0x9c, 0x14, 0x7d, 0x07, 0xea, 0xc0, 0x1a, 0x5b, 0xde, 0x08, 0xf7, 0x79,
-	0x04, 0x99, 0xc7, 0x16, 0xd0, 0xc6, 0xc2, 0x6c, 0x3e, 0x1e, 0x66, 0xbf, 0x8d, 0x04, 0x94, 0x2a,
-	0x95, 0xfd, 0x86, 0x24, 0x7b, 0xe8, 0x0b, 0x91, 0xd0, 0xf2, 0x15, 0x54, 0x5d, 0x6c, 0xf6, 0xaf,
-	0xe4, 0x60, 0x51, 0xa6, 0x37, 0xa3, 0x42, 0xc7, 0x25, 0x17, 0xda, 0x8d, 0x44, 0x21, 0x8d, 0xee,
-	0x50, 0x95, 0xb5, 0x43, 0x5c, 0x38, 0x12, 0x8a, 0xee, 0x42, 0xce, 0x77, 0x31, 0xe6, 0xd1, 0x84,
-	0x69, 0x92, 0x65, 0x31, 0x83, 0x4e, 0x10, 0x67, 0x26, 0x7f, 0xbd, 0xfa, 0xaa, 0xa4, 0x6b, 0x4e,
-	0xc1, 0x66, 0x88, 0xeb, 0xf4, 0x4d, 0x7f, 0x7a, 0xe1, 0xd5, 0xd7, 0x92, 0xab, 0xf0, 0x29, 0xf4,
-	0x08, 0x6e, 0x89, 0x6d, 0x85, 0xc1, 0xbd, 0x8e, 0x37, 0xa5, 0x41, 0xbc, 0x8e, 0xe8, 0x71, 0x6e,
-	0x06, 0x04, 0xdc, 0x7c, 0x6d, 0x36, 0x9d, 0xce, 0x3b, 0x30, 0x2d, 0x7b, 0xea, 0xe2, 0xfa, 0x46,
-	0x3a, 0xef, 0x31, 0x9b, 0x46, 0xdf, 0xc1, 0xcd, 0x24, 0xaf, 0xef, 0xf8, 0xa6, 0x5d, 0xdf, 0xa4,
-	0x9c, 0x37, 0xe2, 0x9c, 0x67, 0x64, 0xf2, 0x65, 0x4e, 0x2d, 0x54, 0x8b, 0x2f, 0x73, 0x2a, 0x54,
-	0x4b, 0xfa, 0x3f, 0x64, 0x40, 0x25, 0x85, 0x83, 0x48, 0xd0, 0x03, 0xcb, 0xc6, 0x91, 0x30, 0x42,
-	0x26, 0x0d, 0x3a, 0x8c, 0xb6, 0x40, 0x23, 0x7f, 0x3b, 0xfe, 0xd5, 0x84, 0x15, 0x1f, 0x6b, 0xcd,
-	0xd5, 0x80, 0xe6, 0xec, 0x6a, 0x82, 0x89, 0xbf, 0xb0, 0xa7, 0x45, 0x69, 0xf9, 0x07, 0xd0, 0x98,
-	0xc0, 0xc4, 0x7d, 0x61, 0xa1, 0x1f, 0x86, 0xc4, 0xa8, 0x01, 0x2a, 0xbd, 0x06, 0x2e, 0x1e, 0xd3,
-	0xbc, 0x42, 0x62, 0x2e, 0x7f, 0x47, 0x5f, 0x40, 0xd1, 0xa1, 0xa6, 0xf1, 0xea, 0x6a, 0xd2, 0xa4,
-	0x62, 0x0e, 0x7d, 0x0d, 0x5a, 0x97, 0x94, 0x3a, 0x06, 0x1e, 0x78, 0xdc, 0x93, 0xd8, 0x39, 0xf6,
-	0xf9, 0xa8, 0x11, 0xce, 0x07, 0x05, 0x0f, 0xf1, 0xa2, 0x32, 0x2f, 0x78, 0xbe, 0x07, 0x8d, 0x1c,
-	0x83, 0x45, 0xcd, 0x4d, 0x39, 0x6a, 0xe6, 0x44, 0xa0, 0xdc, 0x94, 0x03, 0x65, 0x4e, 0xc4, 0x46,
-	0x03, 0x54, 0xb1, 0x07, 0xba, 0x07, 0x79, 0xba, 0x0b, 0xd7, 0x36, 0x48, 0x12, 0xb0, 0x09, 0xf4,
-	0x39, 0xe4, 0x5d, 0xb2, 0x05, 0x8f, 0x1e, 0x6b, 0x8c, 0x42, 0x6c, 0x6c, 0xb0, 0x49, 0xfd, 0xcf,
-	0x00, 0xd8, 0x01, 0x45, 0x40, 0x64, 0xc7, 0x8c, 0x04, 0x44, 0xe1, 0xb0, 0x6c, 0x8a, 0x18, 0x92,
-	0xee, 0xd0, 0x71, 0xf1, 0x80, 0x2f, 0x1e, 0x53, 0x80, 0x2a, 0x14, 0xa0, 0xef, 0xd1, 0x78, 0x3b,
-	0x31, 0x7b, 0x34, 0xb0, 0x7d, 0x01, 0x6b, 0xd6, 0x78, 0x32, 0x25, 0xd9, 0x1d, 0x0f, 0xac, 0xf7,
-	0xd8, 0xab, 0x67, 0xa8, 0x0d, 0x56, 0xe9, 0xe8, 0x29, 0x1f, 0xd4, 0xff, 0x12, 0xf2, 0xed, 0x91,
-	0xe9, 0xf6, 0xd1, 0x0e, 0x40, 0x2f, 0xe0, 0xe6, 0x22, 0x55, 0xc4, 0xad, 0xe5, 0xc3, 0x86, 0x44,
-	0x92, 0x7e, 0xe6, 0x53, 0xd3, 0x1f, 0xc9, 0x67, 0x46, 0x77, 0xa1, 0xe4, 0x4c, 0x7d, 0x2a, 0x07,
-	0xa9, 0x63, 0x59, 0xee, 0x05, 0x36, 0x44, 0x88, 0x89, 0x85, 0x02, 0xa6, 0xa8, 0x85, 0xb4, 0x54,
-	0x0b, 0x69, 0xc2, 0x42, 0x2e, 0xac, 0x1f, 0xd0, 0xca, 0x92, 0xa6, 0x4f, 0xfc, 0x9b, 0x29, 0xf6,
-	0x16, 0xa6, 0xd7, 0x58, 0x3e, 0xc8, 0x26, 0xf3, 0x41, 0x0d, 0x0a, 0xd3, 0x49, 0xdf, 0xf4, 0x59,
-	0x39, 0xa0, 0x1a, 0xfc, 0xed, 0x65, 0x4e, 0xcd, 0x54, 0xb3, 0xfa, 0x1e, 0xa0, 0xd6, 0x98, 0x14,
-	0x11, 0xfe, 0xf2, 0x9b, 0xea, 0x37, 0xa1, 0xf2, 0xca, 0xf2, 0x64, 0x8e, 0x97, 0x39, 0x55, 0xa9,
-	0x66, 0xf4, 0x27, 0x50, 0x0d, 0x27, 0xbc, 0x89, 0x33, 0xf6, 0xe8, 0xcd, 0x25, 0x4c, 0x72, 0x39,
-	0xb4, 0x1a, 0x2c, 0xc8, 0xca, 0x56, 0x97, 0x3f, 0xe9, 0xbf, 0x86, 0xf5, 0x43, 0x6c, 0xe3, 0x6b,
-	0x69, 0x60, 0x13, 0xf2, 0x03, 0xc7, 0xed, 0x61, 0x5e, 0x1d, 0xb1, 0x17, 0x51, 0x31, 0x65, 0x83,
-	0x8a, 0x49, 0xff, 0x7b, 0x05, 0x50, 0x9b, 0x64, 0x22, 0x1e, 0xb3, 0xf9, 0xea, 0xf7, 0xa1, 0xc0,
-	0x92, 0x61, 0x6a, 0x16, 0x67, 0x53, 0x71, 0x2d, 0xe7, 0x52, 0xb5, 0xcc, 0xf3, 0x7c, 0x36, 0x52,
-	0xb9, 0x45, 0x93, 0x53, 0x7e, 0xc9, 0xe4, 0xc4, 0x8d, 0xf3, 0xcf, 0x59, 0x40, 0xfb, 0xd3, 0x20,
-	0xef, 0x5e, 0x4b, 0xe4, 0x5a, 0xa4, 0x58, 0xd7, 0x52, 0x6a, 0x8d, 0xf2, 0xa2, 0x5a, 0x23, 0x2a,
-	0x7b, 0x61, 0xd9, 0xc4, 0x2a, 0x72, 0x5f, 0x76, 0x61, 0xee, 0x2b, 0x2e, 0x91, 0xfb, 0xd4, 0xd9,
-	0xb9, 0x6f, 0x0d, 0x32, 0xad, 0x43, 0xde, 0x56, 0x65, 0x5a, 0x87, 0xb1, 0xb8, 0xaf, 0xc5, 0xe3,
-	0xbe, 0x54, 0xb4, 0xc0, 0xc7, 0x15, 0x2d, 0xa5, 0xe5, 0x8b, 0x16, 0x6e, 0xc1, 0xff, 0x56, 0x60,
-	0xe3, 0x98, 0x0e, 0x25, 0x4c, 0xb8, 0xb8, 0x76, 0x8c, 0x79, 0x5d, 0x26, 0xe9, 0x75, 0xcb, 0xab,
-	0x3a, 0xbf, 0x84, 0xaa, 0x8b, 0xb3, 0x55, 0x1d, 0x55, 0x6d, 0x21, 0xae, 0xda, 0x4d, 0xc8, 0x53,
-	0x60, 0x83, 0x87, 0x18, 0xf6, 0xa2, 0x8f, 0x61, 0x93, 0xc7, 0x96, 0x8f, 0x38, 0xfc, 0x2f, 0xa0,
-	0xc4, 0xf2, 0x84, 0xe7, 0x93, 0xd8, 0xc5, 0x52, 0xbe, 0x5c, 0x74, 0xb5, 0xc9, 0xb8, 0x01, 0x94,
-	0x88, 0x3e, 0xeb, 0xbf, 0x53, 0x60, 0x9d, 0x84, 0x9f, 0xe8, 0x6e, 0x0b, 0xc2, 0xc7, 0x5d, 0xc8,
-	0x0d, 0x5c, 0xe7, 0x22, 0xb5, 0x5f, 0x25, 0x13, 0xe8, 0x36, 0x64, 0x7c, 0x27, 0xa2, 0x61, 0x3e,
-	0x9d, 0xf1, 0x49, 0x77, 0x53, 0x18, 0x4f, 0x2f, 0xba, 0xd8, 0xa5, 0x27, 0xcf, 0x19, 0xfc, 0x8d,
-	0x74, 0x5b, 0x2e, 0x7e, 0x87, 0x5d, 0x0f, 0x53, 0xff, 0x54, 0x0d, 0xf1, 0x4a, 0xda, 0xc5, 0xb0,
-	0x87, 0xa0, 0xed, 0x22, 0x3b, 0x70, 0xb2, 0x5d, 0x0c, 0xc9, 0x68, 0x96, 0xe2, 0xcf, 0xfa, 0xbf,
-	0x2b, 0xb0, 0xc1, 0xd2, 0x04, 0xef, 0x22, 0xf8, 0x39, 0x45, 0xe3, 0xad, 0xcc, 0x6a, 0xbc, 0x6f,
-	0x81, 0xea, 0x75, 0xa4, 0x2e, 0x47, 0x33, 0x8a, 0x1e, 0x07, 0x7d, 0xee, 0x47, 0xa2, 0xd7, 0x8c,
-	0x2e, 0x25, 0xda, 0xb8, 0xe7, 0xe6, 0x37, 0xee, 0x52, 0x47, 0x9d, 0x9f, 0xd3, 0x51, 0xeb, 0x8f,
-	0x03, 0x1f, 0x89, 0x9e, 0xe6, 0x7e, 0xa4, 0x13, 0x9e, 0xd1, 0x90, 0xbd, 0x62, 0xf6, 0x8e, 0x72,
-	0x2e, 0xb0, 0xb7, 0x64, 0x99, 0x4c, 0xd4, 0x32, 0xa7, 0xb0, 0xc1, 0x92, 0xcf, 0xf5, 0x25, 0x49,
-	0x4f, 0x42, 0xfa, 0x23, 0xb1, 0xe2, 0xf5, 0xfd, 0x5f, 0x37, 0x01, 0x1d, 0xdb, 0xd3, 0x78, 0xdc,
-	0xf8, 0x22, 0xec, 0xe2, 0x95, 0x64, 0x93, 0x26, 0xe6, 0xd0, 0xe7, 0xa0, 0xfa, 0x4e, 0x87, 0x9c,
-	0x97, 0x15, 0x49, 0x11, 0x3d, 0x14, 0x7d, 0x87, 0xfc, 0xf5, 0xf4, 0x7f, 0x51, 0xa0, 0xd6, 0x9e,
-	0x76, 0x49, 0x38, 0xe9, 0xe2, 0x6b, 0x5d, 0x9a, 0x5a, 0xa4, 0x5d, 0x96, 0x93, 0x4b, 0x8e, 0xf8,
-	0x00, 0x37, 0xf9, 0x8c, 0x5c, 0x41, 0x49, 0x82, 0x7b, 0x97, 0x9d, 0x75, 0xef, 0xbe, 0x84, 0x3c,
-	0xbb, 0xfa, 0xb9, 0x19, 0x57, 0x9f, 0x4d, 0xeb, 0xbf, 0x81, 0xb5, 0xe7, 0xd8, 0xa7, 0xad, 0x42,
-	0x28, 0xfc, 0xbc, 0x56, 0xe2, 0x33, 0x28, 0x3b, 0x83, 0x81, 0x87, 0x7d, 0x1e, 0xcd, 0x32, 0xb4,
-	0x5f, 0x29, 0xb1, 0x31, 0x16, 0xcf, 0x92, 0x1d, 0x44, 0x56, 0x0a, 0x77, 0xfa, 0x97, 0xb0, 0x76,
-	0xf2, 0x0e, 0xbb, 0x97, 0xae, 0xe5, 0xe3, 0xd6, 0xb8, 0x8f, 0xdf, 0x13, 0xfb, 0x5b, 0xe4, 0x81,
-	0xee, 0x99, 0x35, 0xd8, 0x8b, 0xfe, 0x57, 0x59, 0x58, 0x3b, 0x9d, 0x5e, 0x47, 0xb6, 0x4d, 0xc8,
-	0xbf, 0x33, 0xed, 0x29, 0x8b, 0xe8, 0x65, 0x83, 0xbd, 0x90, 0x62, 0x66, 0xea, 0xda, 0x3c, 0xd3,
-	0x91, 0x47, 0xf4, 0x09, 0x29, 0xaa, 0x7a, 0x53, 0xd7, 0xb3, 0xde, 0x61, 0x1a, 0x8e, 0x55, 0x23,
-	0x1c, 0x40, 0xdf, 0x80, 0xd6, 0xc7, 0xb6, 0x75, 0x61, 0xf9, 0x1c, 0xd0, 0x5a, 0xe3, 0xc5, 0xec,
-	0xa1, 0x18, 0x35, 0x42, 0x02, 0xf4, 0x0d, 0x20, 0xdf, 0x74, 0x87, 0xd8, 0xef, 0xd0, 0x0e, 0x4b,
-	0xca, 0xbb, 0x59, 0xa3, 0xca, 0x66, 0x88, 0x84, 0x87, 0x2c, 0x13, 0x6c, 0xc1, 0xba, 0x4c, 0x1d,
-	0xe6, 0xda, 0xac, 0x51, 0x09, 0x89, 0x99, 0x1a, 0xbf, 0x80, 0x35, 0x12, 0x79, 0xb0, 0xdb, 0x71,
-	0x71, 0xcf, 0x71, 0xfb, 0x1e, 0xcd, 0xa0, 0x59, 0x63, 0x95, 0x8d, 0x1a, 0x6c, 0x10, 0xfd, 0x12,
-	0x2a, 0x8e, 0x50, 0x67, 0x87, 0xa9, 0x91, 0x25, 0xe8, 0x0d, 0x96, 0x8a, 0x22, 0xaa, 0x36, 0xd6,
-	0x9c, 0xa8, 0xea, 0x6b, 0x50, 0xe8, 0xd3, 0x4b, 0x46, 0x0b, 0x1a, 0xd5, 0xe0, 0x6f, 0x2c, 0x01,
-	0x73, 0x20, 0xf4, 0x1f, 0x15, 0x58, 0x0d, 0x0c, 0x41, 0x36, 0x8d, 0x59, 0x58, 0x89, 0x59, 0x98,
-	0x16, 0xf9, 0x34, 0x03, 0x76, 0x68, 0x03, 0x96, 0xe1, 0x45, 0x3e, 0x1d, 0x7a, 0x61, 0x7a, 0xa3,
-	0x34, 0x99, 0xb3, 0xcb, 0xcb, 0x1c, 0x69, 0x82, 0x72, 0xf3, 0x9b, 0xa0, 0x7f, 0x53, 0x24, 0x27,
-	0x62, 0x0a, 0xdb, 0x84, 0xbc, 0x37, 0xb1, 0x79, 0xfc, 0x50, 0x0d, 0xf6, 0x82, 0xbe, 0x21, 0x91,
-	0x8d, 0xa9, 0x99, 0xdd, 0x79, 0xc4, 0x1a, 0x18, 0x99, 0xd7, 0x10, 0x24, 0xc4, 0x83, 0x7c, 0xe7,
-	0xa2, 0xeb, 0xf9, 0xce, 0x18, 0xf3, 0x32, 0x39, 0x1c, 0x40, 0x5b, 0x50, 0x60, 0x36, 0xe2, 0xd2,
-	0xa5, 0x2d, 0xc5, 0x29, 0x08, 0xed, 0xc0, 0x71, 0xfc, 0x20, 0xd2, 0xa7, 0xd2, 0x32, 0x0a, 0xdd,
-	0x82, 0xca, 0x81, 0x33, 0xb9, 0x92, 0x6f, 0xc4, 0x6d, 0xc8, 0x7a, 0x6e, 0x2f, 0x79, 0x21, 0xc8,
-	0x28, 0x99, 0xec, 0x7b, 0x02, 0xc2, 0x92, 0x27, 0xfb, 0x9e, 0x4f, 0x8e, 0x10, 0xe8, 0x55, 0x1c,
-	0x21, 0x18, 0x90, 0x3a, 0x9b, 0xe5, 0xef, 0x9f, 0xfe, 0xe7, 0xac, 0xb3, 0xb9, 0xc6, 0x8d, 0x45,
-	0x90, 0x1b, 0x4c, 0x03, 0x6c, 0x96, 0x3e, 0x93, 0x1c, 0x33, 0xb2, 0x3c, 0xdf, 0x71, 0xaf, 0x78,
-	0xec, 0x10, 0xaf, 0xfa, 0x2e, 0x54, 0xfe, 0xc8, 0xb4, 0xdf, 0x5e, 0x43, 0xa2, 0x53, 0xa8, 0x3c,
-	0xb7, 0x9d, 0xae, 0xcc, 0xb1, 0x54, 0xfd, 0x54, 0x87, 0xe2, 0xc4, 0xf4, 0x7d, 0xec, 0x8a, 0xc2,
-	0x51, 0xbc, 0x92, 0xfe, 0x54, 0xa0, 0x2e, 0x5e, 0x80, 0xab, 0x24, 0xba, 0x33, 0x41, 0xc2, 0x70,
-	0x15, 0x5a, 0x79, 0x5c, 0x42, 0xe5, 0xd0, 0x1a, 0x0c, 0x64, 0x51, 0x3e, 0x07, 0x75, 0x8c, 0x2f,
-	0x3b, 0xe9, 0x07, 0x28, 0x8e, 0xf1, 0x25, 0xfd, 0x30, 0xf4, 0x39, 0xa8, 0x8e, 0xdd, 0x67, 0x54,
-	0x09, 0x53, 0x16, 0x1d, 0xbb, 0x4f, 0xa9, 0xea, 0x50, 0xf4, 0x46, 0xa6, 0x6d, 0x3b, 0x97, 0xdc,
-	0x98, 0xe2, 0x55, 0x7f, 0x03, 0xd5, 0x70, 0xe3, 0xb0, 0xad, 0x14, 0x3b, 0x7b, 0x33, 0x04, 0xe7,
-	0xdb, 0xd3, 0x43, 0x8a, 0xfd, 0xc5, 0xdd, 0x88, 0xd3, 0x72, 0x21, 0x3c, 0xbd, 0x29, 0x5a, 0xd0,
-	0x6b, 0xd8, 0xe8, 0x2e, 0x94, 0x8e, 0x3d, 0x72, 0x5b, 0x19, 0x75, 0x15, 0xb2, 0x03, 0xeb, 0x3d,
-	0xbf, 0x9c, 0xe4, 0x51, 0xff, 0x0e, 0xca, 0x8c, 0x80, 0x0b, 0x2f, 0x51, 0x68, 0x94, 0x82, 0x56,
-	0xd0, 0xae, 0xeb, 0x04, 0x88, 0x00, 0x7d, 0xd1, 0xff, 0x49, 0x81, 0x1a, 0xd9, 0xe7, 0x64, 0x82,
-	0x5d, 0x93, 0xe2, 0x15, 0x6c, 0x8b, 0xf3, 0xe6, 0x72, 0x4e, 0xb0, 0x03, 0xc5, 0xc9, 0xd4, 0xef,
-	0xf8, 0xa6, 0x00, 0xcd, 0x37, 0xc5, 0xdd, 0x3c, 0x33, 0xdd, 0x60, 0xad, 0x17, 0x2b, 0x46, 0x61,
-	0x42, 0x87, 0xd0, 0x13, 0x28, 0xb3, 0xf0, 0xc9, 0x95, 0xc5, 0x62, 0xda, 0x2d, 0x91, 0x3c, 0xb8,
-	0x5a, 0x3c, 0x99, 0xb5, 0xd4, 0x0f, 0xc7, 0xf7, 0x4b, 0xa0, 0x39, 0x42, 0x56, 0xbd, 0x05, 0x95,
-	0xd8, 0x4e, 0xe4, 0xe0, 0xbe, 0x39, 0x14, 0x07, 0xf7, 0xd9, 0x97, 0xbb, 0xbe, 0xe9, 0x9b, 0x54,
-	0xbe, 0xb2, 0x41, 0x9f, 0x09, 0xd5, 0xd1, 0xc9, 0xb1, 0x68, 0xde, 0x8f, 0x4e, 0x8e, 0xf5, 0x27,
-	0xb0, 0x99, 0xb6, 0x3d, 0xad, 0xbb, 0x02, 0x0f, 0xd0, 0x0c, 0xf6, 0x22, 0x76, 0xc9, 0x04, 0xbb,
-	0x90, 0x7b, 0xf7, 0x1c, 0x47, 0x45, 0x59, 0x60, 0xd3, 0x11, 0xa0, 0xb8, 0xcf, 0x9d, 0x37, 0xd1,
-	0x03, 0xc9, 0x93, 0x15, 0x29, 0x6e, 0x07, 0x8e, 0x14, 0x78, 0xf3, 0x03, 0xe9, 0x66, 0x64, 0x52,
-	0x29, 0xb9, 0x7b, 0xea, 0x0f, 0xa1, 0xce, 0xea, 0xf9, 0xb3, 0x8b, 0x09, 0x19, 0x68, 0x63, 0x3f,
-	0x70, 0x94, 0x3b, 0x00, 0xf4, 0x48, 0xd8, 0xef, 0x58, 0x7d, 0xae, 0x36, 0x8d, 0x8f, 0xb4, 0xfa,
-	0xfa, 0x1f, 0x43, 0xcd, 0xc0, 0x63, 0x7c, 0x29, 0x73, 0x0a, 0x8f, 0x9d, 0xc7, 0x48, 0xf2, 0x9b,
-	0xef, 0xdb, 0x1d, 0x0f, 0xf7, 0x9c, 0x71, 0x5f, 0x94, 0x40, 0xe0, 0xfb, 0x76, 0x9b, 0x8d, 0x90,
-	0xba, 0xfc, 0xc0, 0xc6, 0xa6, 0x1b, 0x29, 0x0b, 0x97, 0x74, 0x3b, 0x7d, 0x04, 0xd5, 0xd3, 0xa9,
-	0xcf, 0x5b, 0x48, 0x2e, 0x50, 0x50, 0xd9, 0x28, 0x72, 0x65, 0xf3, 0x09, 0xe4, 0x7c, 0x73, 0x28,
-	0x2e, 0xa5, 0xca, 0x7a, 0x04, 0x73, 0x68, 0xd0, 0xd1, 0x10, 0xa6, 0xcc, 0xce, 0x80, 0x29, 0xf5,
-	0x81, 0xe8, 0x85, 0xa2, 0x9b, 0xfd, 0x9f, 0x23, 0x91, 0x7f, 0xa3, 0xc0, 0xfa, 0x73, 0xcc, 0x8f,
-	0xe4, 0x49, 0xd5, 0xb8, 0xc0, 0x7c, 0x95, 0x39, 0x98, 0x6f, 0x5a, 0xc1, 0x99, 0x5b, 0x54, 0x70,
-	0x46, 0xfa, 0xeb, 0x3b, 0x00, 0x14, 0x5b, 0xef, 0x04, 0x9f, 0xf5, 0x72, 0x24, 0x5b, 0xfb, 0xa6,
-	0xdd, 0xb6, 0x7e, 0x8b, 0xf9, 0x45, 0xe3, 0x62, 0x33, 0xd1, 0x16, 0x23, 0xbc, 0x81, 0x41, 0x32,
-	0x92, 0x41, 0xf4, 0x3d, 0x7a, 0x51, 0xae, 0xb7, 0x94, 0xfe, 0xb7, 0x0a, 0x54, 0x05, 0x57, 0xa0,
-	0x9c, 0x08, 0xd2, 0xad, 0x2c, 0x40, 0xba, 0xff, 0xdf, 0x55, 0x84, 0x18, 0x32, 0x29, 0x1f, 0x4c,
-	0xff, 0x09, 0xaa, 0x67, 0xe6, 0xf0, 0x23, 0x3c, 0x67, 0xae, 0xd7, 0xea, 0x9b, 0x80, 0xc8, 0x56,
-	0x51, 0x5f, 0x21, 0x79, 0x9c, 0x8c, 0x9e, 0x99, 0xc3, 0x40, 0x43, 0x35, 0x28, 0x30, 0x28, 0x5b,
-	0x7c, 0xed, 0x65, 0x6f, 0x0c, 0xe8, 0xee, 0xd9, 0xd3, 0x3e, 0xee, 0x70, 0x59, 0x58, 0x71, 0xb1,
-	0xca, 0x47, 0xd9, 0xca, 0x7a, 0x9b, 0x1d, 0x89, 0xad, 0xc8, 0xe3, 0x45, 0x23, 0x8c, 0xaf, 0xb2,
-	0x60, 0x34, 0xd2, 0x86, 0x47, 0xcb, 0xcc, 0x3c, 0x9a, 0xfe, 0xa3, 0x08, 0xb4, 0x1f, 0xe5, 0xea,
-	0xfa, 0x4d, 0xb8, 0x11, 0x63, 0x67, 0x82, 0xe9, 0xbf, 0x10, 0x69, 0x55, 0x56, 0x80, 0xd0, 0xa3,
-	0x32, 0x4b, 0x8f, 0x32, 0x0b, 0x5f, 0xe8, 0x21, 0xa0, 0x83, 0x11, 0xee, 0xbd, 0xbd, 0xbe, 0xd9,
-	0xf4, 0x9f, 0xc3, 0x46, 0x84, 0x95, 0xeb, 0xac, 0x06, 0x05, 0xfc, 0xde, 0xf2, 0x7c, 0x8f, 0x67,
-	0x6c, 0xfe, 0xa6, 0xef, 0x42, 0x91, 0x9f, 0x62, 0xd9, 0xd3, 0xff, 0x08, 0x1b, 0x2c, 0xee, 0x1d,
-	0xd2, 0x1f, 0x18, 0x48, 0xf5, 0x80, 0xd3, 0x7d, 0x23, 0x92, 0x9e, 0xd3, 0x7d, 0x33, 0xe3, 0xee,
-	0xfd, 0x0c, 0x36, 0x58, 0x8c, 0x59, 0xc0, 0xae, 0xbf, 0x80, 0x5a, 0xa0, 0xe5, 0x28, 0x6d, 0x2d,
-	0xa2, 0x07, 0x2d, 0xf0, 0xd8, 0xd0, 0xd5, 0x32, 0xb2, 0xab, 0xe9, 0x7f, 0x9d, 0x81, 0x92, 0xf8,
-	0x82, 0x43, 0x1a, 0x93, 0xef, 0xe3, 0x07, 0xbd, 0x23, 0x1d, 0x94, 0x92, 0xf0, 0x67, 0xef, 0x68,
-	0xec, 0xbb, 0x57, 0x61, 0x8c, 0xdb, 0x8e, 0x5c, 0x89, 0x46, 0x82, 0x8b, 0xd8, 0x90, 0xb1, 0x50,
-	0xba, 0x46, 0x0b, 0xca, 0xf2, 0x42, 0xe4, 0x90, 0x6f, 0xf1, 0x95, 0x38, 0xe4, 0x5b, 0x7c, 0x85,
-	0xee, 0xcb, 0x3a, 0x4a, 0xc4, 0x0e, 0x36, 0xf7, 0x28, 0xf3, 0x83, 0xd2, 0x38, 0x04, 0x2d, 0x58,
-	0x3d, 0x65, 0x9d, 0xcf, 0xa2, 0xeb, 0x44, 0x21, 0xd0, 0x60, 0x95, 0xad, 0x2d, 0x80, 0xf0, 0x47,
-	0x0e, 0x48, 0x85, 0xdc, 0x4f, 0xed, 0x23, 0xa3, 0xba, 0x42, 0x9e, 0x9e, 0xfd, 0x74, 0x76, 0x52,
-	0x55, 0xc8, 0xd3, 0x71, 0xfb, 0xe0, 0x57, 0xd5, 0xcc, 0xd6, 0xd7, 0xec, 0xbb, 0x25, 0xfd, 0xd8,
-	0x58, 0x06, 0xd5, 0x38, 0x6a, 0x1f, 0x19, 0xe7, 0x47, 0x87, 0x8c, 0xfa, 0xb8, 0xf5, 0xea, 0xa8,
-	0xaa, 0xa0, 0x22, 0x64, 0x0f, 0x5b, 0x46, 0x35, 0xb3, 0xb5, 0x27, 0x00, 0x3f, 0x8a, 0x5a, 0xa0,
-	0x12, 0x14, 0xdb, 0x67, 0xcf, 0x8c, 0x33, 0x4a, 0xae, 0x41, 0xde, 0x38, 0x7a, 0x76, 0xf8, 0x27,
-	0x55, 0x85, 0xac, 0x73, 0xdc, 0x7a, 0xdd, 0x6a, 0xbf, 0x38, 0x3a, 0xac, 0x66, 0xb6, 0x1e, 0x83,
-	0x16, 0xf4, 0xea, 0x64, 0xd1, 0xd7, 0x27, 0xaf, 0x8f, 0xd8, 0xf2, 0x2f, 0xdb, 0x27, 0xaf, 0x99,
-	0x30, 0xaf, 0x5a, 0xaf, 0x8f, 0xaa, 0x19, 0xb2, 0x51, 0xfb, 0x0f, 0x5f, 0x55, 0xb3, 0xe4, 0xe1,
-	0xa0, 0x7d, 0x5e, 0xcd, 0x35, 0x7f, 0xbf, 0x0e, 0xd9, 0x67, 0xa7, 0x2d, 0xf4, 0x04, 0x20, 0xfc,
-	0x9e, 0x84, 0x6a, 0x2c, 0x53, 0xc7, 0x3f, 0x30, 0x35, 0x6a, 0x09, 0x38, 0xfb, 0x88, 0xa2, 0xb7,
-	0x2b, 0xe8, 0x7b, 0x28, 0x49, 0xdf, 0x86, 0xd0, 0x4d, 0xba, 0x40, 0xf2, 0x6b, 0x51, 0x23, 0xfa,
-	0x39, 0x47, 0x5f, 0x41, 0x0f, 0x41, 0x15, 0x9f, 0x81, 0x10, 0xab, 0x38, 0x63, 0x9f, 0x8b, 0x1a,
-	0x37, 0x62, 0xa3, 0xfc, 0x72, 0xaf, 0x10, 0x99, 0xc3, 0x2f, 0x40, 0x5c, 0xe6, 0xc4, 0x27, 0xa1,
-	0x39, 0x32, 0x7f, 0x0b, 0x25, 0xe9, 0x23, 0x0f, 0x97, 0x39, 0xf9, 0xd9, 0xa7, 0x21, 0xd7, 0x2d,
-	0xfa, 0x0a, 0xda, 0x87, 0xb2, 0x0c, 0xd3, 0xa3, 0x3a, 0xaf, 0xd5, 0x12, 0xc8, 0xfd, 0x9c, 0xad,
-	0x7f, 0x84, 0xd5, 0x08, 0xdc, 0x8d, 0x6e, 0xc9, 0x0a, 0x8b, 0xae, 0x12, 0x47, 0x78, 0xf5, 0x15,
-	0xf4, 0x03, 0x40, 0x08, 0x5e, 0xf3, 0x93, 0x27, 0xd0, 0xec, 0x46, 0x35, 0xc6, 0xe8, 0xe9, 0x2b,
-	0xe8, 0x29, 0x4b, 0x04, 0xc2, 0xcb, 0x5c, 0x6c, 0x5e, 0xcc, 0xe4, 0x4f, 0x6e, 0xbc, 0xab, 0x90,
-	0xd3, 0xcb, 0x38, 0x25, 0x3f, 0x7d, 0x0a, 0x74, 0x39, 0xe7, 0xf4, 0x8f, 0xa1, 0x24, 0xe1, 0x95,
-	0x5c, 0xf1, 0x49, 0x04, 0x33, 0x5d, 0x80, 0x03, 0xa8, 0xc4, 0x80, 0x48, 0x74, 0x9b, 0x59, 0x2e,
-	0x15, 0x9e, 0x4c, 0x5f, 0xe4, 0x5b, 0x28, 0x49, 0x1f, 0xcb, 0xb8, 0x04, 0xc9, 0xcf, 0x67, 0x29,
-	0xa6, 0x97, 0xe1, 0x74, 0x7e, 0xf8, 0x14, 0x84, 0x7d, 0x29, 0xd3, 0xf3, 0x45, 0x22, 0xa6, 0x8f,
-	0xae, 0x12, 0xff, 0x2d, 0x58, 0x68, 0x7a, 0xce, 0x1b, 0x9a, 0x2e, 0xca, 0x58, 0x8d, 0x31, 0x7a,
-	0x4c, 0x78, 0x19, 0xb3, 0x8e, 0x58, 0x6e, 0x59, 0xe1, 0x1f, 0x41, 0x91, 0x83, 0x35, 0x68, 0x23,
-	0x0a, 0xdd, 0x2c, 0xe0, 0x7c, 0xa0, 0xa0, 0x47, 0xa0, 0x0a, 0x3c, 0x87, 0xdf, 0xf4, 0x18, 0xbc,
-	0x33, 0x67, 0xdf, 0xa7, 0x50, 0xe4, 0xc0, 0x2d, 0xdf, 0x37, 0x0a, 0xe3, 0x36, 0x6e, 0x27, 0x38,
-	0x69, 0xa5, 0x77, 0x4e, 0x73, 0x25, 0x31, 0x78, 0x18, 0x9f, 0xe8, 0x22, 0x91, 0xf8, 0x24, 0x2f,
-	0x14, 0x6d, 0xbc, 0xf4, 0x15, 0xd4, 0x64, 0xf1, 0x49, 0x92, 0x3a, 0x06, 0xfa, 0x34, 0xd6, 0x22,
-	0x2c, 0x1e, 0x8d, 0x69, 0x6b, 0x82, 0x88, 0x5f, 0xb1, 0x74, 0xce, 0xf8, 0x66, 0xbb, 0x0a, 0xda,
-	0x03, 0x55, 0x80, 0x3e, 0x9c, 0x29, 0x86, 0x01, 0xa5, 0x31, 0x35, 0x41, 0x15, 0xb8, 0x0f, 0x67,
-	0x8a, 0xc1, 0x40, 0xe9, 0x32, 0x0a, 0xa2, 0x88, 0x8c, 0x71, 0xce, 0x94, 0xed, 0x1e, 0x82, 0x2a,
-	0xda, 0x5d, 0xce, 0x14, 0x83, 0x7a, 0x78, 0xc8, 0x8e, 0xf7, 0xc4, 0x72, 0xc8, 0xa6, 0xcc, 0xb5,
-	0x18, 0x56, 0xb0, 0xcc, 0xe5, 0xd1, 0x18, 0xf9, 0x33, 0xdb, 0x46, 0x33, 0xc8, 0xe6, 0xb0, 0xef,
-	0x40, 0xee, 0xd8, 0xeb, 0xbd, 0x45, 0xec, 0x7a, 0x48, 0x38, 0x4c, 0x63, 0x5d, 0x1a, 0x11, 0xd2,
-	0xee, 0x2a, 0xe8, 0x25, 0x54, 0x22, 0x98, 0xca, 0x79, 0x93, 0x07, 0x9b, 0x74, 0xa4, 0x65, 0xae,
-	0xff, 0x3f, 0x03, 0x95, 0xe1, 0x0a, 0xe7, 0x4d, 0xa1, 0xeb, 0x28, 0xcc, 0xb0, 0xd8, 0x8b, 0x9f,
-	0x02, 0x08, 0xa5, 0x06, 0x8b, 0xc4, 0x75, 0x7f, 0x33, 0x55, 0xf7, 0xe7, 0x4d, 0xba, 0x80, 0x01,
-	0xd5, 0x38, 0x7e, 0x30, 0xff, 0x40, 0x77, 0xa4, 0x08, 0x97, 0xc4, 0x1c, 0xe8, 0xb9, 0x5e, 0x40,
-	0x25, 0x06, 0x2c, 0xf0, 0x25, 0xd3, 0xe1, 0x86, 0x39, 0xe6, 0x39, 0x84, 0x55, 0x09, 0x48, 0x38,
-	0x6f, 0xf2, 0xd0, 0x98, 0x06, 0x2e, 0xcc, 0x5e, 0xa5, 0xf9, 0x77, 0x25, 0xd0, 0x58, 0xcd, 0x46,
-	0x0a, 0x9b, 0x3d, 0xd0, 0x02, 0x7c, 0x01, 0xdd, 0x10, 0x31, 0x2b, 0xd2, 0x11, 0x34, 0xe4, 0x3a,
-	0x8f, 0x1e, 0xe9, 0x21, 0x85, 0xd1, 0xd9, 0x40, 0x9b, 0x02, 0xe6, 0x33, 0x38, 0xcb, 0x12, 0xa7,
-	0x47, 0x59, 0x9f, 0x02, 0x04, 0x54, 0xde, 0x2c, 0xb6, 0x79, 0x6e, 0x12, 0xe4, 0x18, 0x2e, 0xb3,
-	0x9c, 0x63, 0x96, 0x5c, 0x05, 0x3d, 0x04, 0x2d, 0x40, 0x20, 0x90, 0x7c, 0xba, 0xc5, 0x2e, 0x76,
-	0x04, 0x10, 0x82, 0x17, 0xfc, 0x86, 0x26, 0xd0, 0x8c, 0xc5, 0xcb, 0xfc, 0x12, 0x54, 0x01, 0x33,
-	0xa0, 0x00, 0x48, 0x94, 0x3b, 0xea, 0x25, 0xae, 0x8a, 0xcc, 0x1d, 0x03, 0x1a, 0x16, 0x0b, 0x70,
-	0x40, 0x55, 0xc0, 0x60, 0x06, 0x6e, 0x86, 0x38, 0xec, 0xb0, 0x78, 0x91, 0x26, 0x68, 0x01, 0x12,
-	0x80, 0xc2, 0x3a, 0x34, 0x22, 0x89, 0x84, 0x71, 0xf0, 0x93, 0x6b, 0x01, 0x52, 0xc0, 0x79, 0xe2,
-	0xc8, 0xc1, 0xdc, 0x08, 0x25, 0xaa, 0x83, 0x34, 0xeb, 0x55, 0x22, 0xbd, 0x12, 0xcd, 0x4f, 0xfb,
-	0x50, 0x92, 0x1a, 0x55, 0x9e, 0xd8, 0x92, 0x5d, 0x6f, 0xa3, 0x9e, 0x9c, 0x08, 0xa2, 0xf2, 0x63,
-	0x28, 0x49, 0x28, 0x04, 0x5f, 0x23, 0x89, 0x4b, 0xa4, 0x6c, 0xbf, 0x4b, 0xae, 0xff, 0x6a, 0xa4,
-	0x8d, 0x47, 0x32, 0x02, 0x1c, 0x5b, 0xa0, 0x91, 0x36, 0x15, 0x88, 0xb1, 0x07, 0x05, 0x1a, 0x11,
-	0x87, 0x28, 0x68, 0xef, 0x17, 0x9b, 0xe8, 0x2b, 0x00, 0xae, 0xb0, 0x28, 0x63, 0x8a, 0xaa, 0x1e,
-	0xb3, 0x54, 0x4e, 0x1a, 0x40, 0x29, 0x21, 0x4b, 0x20, 0x83, 0xd4, 0x6a, 0x44, 0x70, 0x04, 0x11,
-	0x7a, 0x03, 0x84, 0x21, 0x92, 0xb9, 0xe4, 0x05, 0x6e, 0x26, 0xc6, 0x25, 0x25, 0x17, 0xf9, 0x6f,
-	0x09, 0x3f, 0x22, 0x71, 0x1d, 0x42, 0x59, 0x46, 0x0b, 0x78, 0x50, 0x48, 0x01, 0x10, 0xe6, 0x5e,
-	0xab, 0x16, 0x94, 0x65, 0xd0, 0x80, 0xaf, 0x92, 0x82, 0x23, 0x2c, 0x56, 0xfb, 0x0b, 0xa8, 0xc4,
-	0x60, 0x05, 0x1e, 0xf4, 0xd3, 0xc1, 0x86, 0xd9, 0x62, 0xed, 0x3f, 0xfe, 0xd7, 0x0f, 0x9f, 0x2a,
-	0xbf, 0xff, 0xf0, 0xa9, 0xf2, 0x5f, 0x1f, 0x3e, 0x55, 0x7e, 0xfd, 0xf3, 0xa1, 0xe5, 0x8f, 0xa6,
-	0xdd, 0xed, 0x9e, 0x73, 0xb1, 0x33, 0x31, 0x7b, 0xa3, 0xab, 0x3e, 0x76, 0xe5, 0x27, 0xcf, 0xed,
-	0xed, 0x84, 0xff, 0x84, 0xaa, 0x5b, 0xa0, 0xcb, 0xed, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x8c, 0xba, 0x83, 0xeb, 0x57, 0x35, 0x00, 0x00,
+	// 4032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3b, 0x5b, 0x6f, 0x1b, 0x57,
+	0x7a, 0x1a, 0x72, 0x48, 0xce, 0x7c, 0xa4, 0xc4, 0xd1, 0x91, 0x4c, 0xd3, 0x74, 0x1c, 0x3b, 0xe3,
+	0x24, 0xeb, 0x38, 0x59, 0x49, 0x2b, 0x35, 0x17, 0xdb, 0x1b, 0x1b, 0xd6, 0xcd, 0x96, 0xd7, 0xb0,
+	0xdc, 0xa1, 0xe2, 0xb6, 0x8b, 0xb6, 0xc4, 0x90, 0x3c, 0x24, 0x27, 0x1e, 0x71, 0xb8, 0x33, 0x43,
+	0x2b, 0xda, 0x87, 0xf6, 0xad, 0xfd, 0x11, 0x7d, 0x29, 0xf6, 0xb9, 0x05, 0x8a, 0xbe, 0x15, 0x7d,
+	0x28, 0xd0, 0xbe, 0x14, 0x2d, 0x0a, 0xe4, 0x17, 0x14, 0x45, 0x7e, 0x46, 0x9f, 0x8a, 0x73, 0x9b,
+	0x39, 0x73, 0xe1, 0x45, 0x46, 0xf7, 0x21, 0xd1, 0xb9, 0x7c, 0xdf, 0x39, 0xdf, 0xed, 0x7c, 0xb7,
+	0xa1, 0x61, 0xb3, 0xe7, 0x3a, 0x78, 0x1c, 0x6e, 0x4f, 0x06, 0x01, 0xf9, 0x6f, 0x6b, 0xe2, 0x7b,
+	0xa1, 0x87, 0x8a, 0x93, 0x41, 0xd0, 0xba, 0x39, 0xf4, 0xbc, 0xa1, 0x8b, 0xb7, 0xe9, 0x52, 0x77,
+	0x3a, 0xd8, 0xc6, 0xe7, 0x93, 0xf0, 0x92, 0x41, 0xb4, 0x6e, 0xa7, 0x37, 0x43, 0xe7, 0x1c, 0x07,
+	0xa1, 0x7d, 0x3e, 0xe1, 0x00, 0x1f, 0xa6, 0x01, 0x2e, 0x7c, 0x7b, 0x32, 0xc1, 0x3e, 0xbf, 0xa2,
+	0xb5, 0x39, 0xf4, 0x86, 0x1e, 0x1d, 0x6e, 0x93, 0x11, 0x5f, 0x6d, 0x70, 0x72, 0xec, 0x69, 0x38,
+	0xa2, 0xff, 0x63, 0xeb, 0x66, 0x0b, 0x54, 0x0b, 0x4f, 0x3c, 0x84, 0x40, 0x1d, 0xdb, 0xe7, 0xb8,
+	0xa9, 0xdc, 0x51, 0xee, 0xe9, 0x16, 0x1d, 0x9b, 0x8f, 0xa0, 0xbc, 0xef, 0xdb, 0xe3, 0xde, 0x08,
+	0xdd, 0x02, 0xd5, 0xc7, 0x13, 0x8f, 0xee, 0x56, 0x77, 0xf5, 0x2d, 0xc2, 0x10, 0x41, 0xb3, 0x54,
+	0x5f, 0x46, 0x2e, 0x48, 0xc8, 0x4f, 0x40, 0x3d, 0x76, 0x5c, 0x8c, 0xee, 0x42, 0xb9, 0xe7, 0x9d,
+	0x9f, 0x3b, 0x21, 0x47, 0xae, 0x52, 0xe4, 0x03, 0xba, 0x64, 0xf1, 0x2d, 0x72, 0xc0, 0xc4, 0x0e,
+	0x47, 0xe2, 0x00, 0x32, 0x36, 0x6f, 0x42, 0x69, 0xdf, 0xf5, 0x7a, 0x6f, 0xc9, 0xe6, 0xc8, 0x0e,
+	0x46, 0x82, 0x34, 0x32, 0x36, 0x3f, 0x80, 0xf2, 0x69, 0xf7, 0x7b, 0xdc, 0x0b, 0x73, 0x77, 0x6f,
+	0x40, 0xf1, 0xcc, 0x1e, 0xe6, 0xf2, 0xf4, 0xaf, 0x05, 0xd0, 0x08, 0xe5, 0x27, 0xe3, 0x81, 0xb7,
+	0x88, 0xad, 0x3f, 0x80, 0x4a, 0xcf, 0xc7, 0x76, 0x88, 0xfb, 0x94, 0xb0, 0xea, 0x6e, 0x6b, 0x8b,
+	0xc9, 0x7e, 0x4b, 0xc8, 0x7e, 0xeb, 0x4c, 0x28, 0xc7, 0x12, 0xa0, 0xe8, 0x16, 0x40, 0xe0, 0xfc,
+	0x16, 0x77, 0xba, 0x97, 0x21, 0x0e, 0x9a, 0xc5, 0x3b, 0xca, 0x3d, 0xd5, 0xd2, 0xc9, 0xca, 0x3e,
+	0x59, 0x40, 0x77, 0xa0, 0xda, 0xc7, 0x41, 0xcf, 0x77, 0x26, 0xa1, 0xe3, 0x8d, 0x9b, 0x25, 0x4a,
+	0x9b, 0xbc, 0x84, 0x7e, 0x06, 0x5a, 0x97, 0x8a, 0x1d, 0x07, 0xcd, 0xca, 0x9d, 0x62, 0x24, 0x33,
+	0xa6, 0x0b, 0x2b, 0xda, 0x44, 0x0d, 0x28, 0xbb, 0x76, 0x17, 0xbb, 0x41, 0x53, 0xbb, 0x53, 0xbc,
+	0xa7, 0x5b, 0x7c, 0x86, 0x3e, 0x03, 0xc3, 0x19, 0x8f, 0xb0, 0xef, 0x84, 0xb8, 0xdf, 0xe1, 0x10,
+	0x3a, 0x85, 0xa8, 0x47, 0xeb, 0x2f, 0x19, 0xe8, 0x16, 0xe8, 0xc4, 0x18, 0x3a, 0xce, 0x78, 0xe0,
+	0x35, 0xcb, 0x94, 0xc9, 0xf5, 0x48, 0x0c, 0x4f, 0xa7, 0xe1, 0x88, 0xc8, 0xc9, 0xd2, 0x6c, 0x3e,
+	0x7a, 0xa1, 0x6a, 0xaa, 0x51, 0x32, 0x1f, 0x43, 0x4d, 0xde, 0x47, 0x5b, 0x50, 0xb3, 0x7b, 0x3d,
+	0x1c, 0x04, 0x1d, 0x17, 0xbf, 0xc3, 0x2e, 0x95, 0xe7, 0xda, 0x6e, 0x75, 0x8b, 0xda, 0x59, 0xbb,
+	0xe7, 0x4d, 0xb0, 0x55, 0x65, 0x00, 0x2f, 0xc9, 0xbe, 0xf9, 0xbb, 0x02, 0x00, 0xe3, 0x86, 0xa2,
+	0xdf, 0x85, 0x32, 0xe3, 0xa9, 0xa9, 0x4a, 0x26, 0xc2, 0xd9, 0xe5, 0x5b, 0xe8, 0x36, 0xa8, 0x23,
+	0x6c, 0x0b, 0x4d, 0x24, 0xac, 0x88, 0x6e, 0xa0, 0xcf, 0x01, 0x26, 0xbe, 0xf7, 0x0e, 0x8f, 0xed,
+	0x71, 0x0f, 0x37, 0x8b, 0x59, 0xc1, 0x49, 0xdb, 0x04, 0x38, 0x98, 0x76, 0x05, 0x70, 0x29, 0x07,
+	0x38, 0xde, 0x46, 0xdf, 0xc0, 0x7a, 0xdf, 0xf1, 0x71, 0x2f, 0xec, 0x48, 0x17, 0x94, 0xb3, 0x38,
+	0x06, 0x83, 0x7a, 0x1d, 0x5f, 0xf3, 0x29, 0x54, 0x42, 0xdf, 0x19, 0x0e, 0xb1, 0xdf, 0xac, 0x50,
+	0xba, 0x6b, 0x14, 0xfe, 0x8c, 0xad, 0x59, 0x62, 0x33, 0xd7, 0x52, 0x9f, 0x40, 0x35, 0x96, 0x51,
+	0x80, 0x76, 0xa0, 0xca, 0x24, 0xc1, 0x74, 0xa5, 0xd0, 0xeb, 0xeb, 0xd2, 0xf5, 0x54, 0x53, 0xd0,
+	0x8d, 0xc6, 0xe6, 0x5f, 0x40, 0x85, 0x5f, 0x44, 0x2c, 0x85, 0x4b, 0x98, 0xdd, 0xc0, 0x67, 0xc8,
+	0x80, 0xa2, 0xed, 0xba, 0x54, 0xa6, 0x9a, 0x45, 0x86, 0xe8, 0x26, 0xe8, 0x3d, 0xdf, 0x1b, 0x77,
+	0x82, 0x09, 0xee, 0x51, 0xe3, 0xd5, 0x2d, 0x8d, 0x2c, 0xb4, 0x27, 0xb8, 0x47, 0xc8, 0x24, 0x86,
+	0x4c, 0xd5, 0xa4, 0x5b, 0x74, 0x8c, 0x9a, 0x50, 0x61, 0x8f, 0x38, 0xa0, 0xb6, 0x5c, 0xb4, 0xc4,
+	0xd4, 0xdc, 0x83, 0x1a, 0x53, 0xd0, 0xa9, 0xef, 0x0c, 0x9d, 0x31, 0xba, 0x0b, 0xea, 0x5b, 0x67,
+	0xdc, 0xe7, 0xd6, 0xc1, 0x48, 0x67, 0x5b, 0xbf, 0x72, 0xc6, 0x7d, 0x8b, 0x6e, 0x9a, 0x4f, 0xa0,
+	0xcc, 0x90, 0x16, 0x3d, 0xce, 0x06, 0x14, 0x1c, 0x66, 0x0d, 0xfa, 0x7e, 0xf9, 0xa7, 0xff, 0xbe,
+	0x5d, 0x38, 0x39, 0xb4, 0x0a, 0x4e, 0xdf, 0x6c, 0x43, 0x95, 0x9b, 0x85, 0x3d, 0x1e, 0x62, 0xf4,
+	0x11, 0x94, 0x5c, 0xef, 0x02, 0xfb, 0x79, 0xde, 0x87, 0xed, 0x10, 0x90, 0x29, 0x71, 0xa0, 0x79,
+	0xa6, 0xc5, 0x76, 0xcc, 0x3f, 0x05, 0x83, 0x2d, 0x48, 0xba, 0x5d, 0xca, 0xb1, 0xc5, 0xa6, 0x5d,
+	0x98, 0x69, 0xda, 0xe6, 0x7f, 0x95, 0x01, 0x18, 0x9e, 0x78, 0x0e, 0x57, 0x39, 0xb8, 0x3e, 0xfb,
+	0xcd, 0x7c, 0x06, 0x65, 0x8f, 0x0a, 0xb8, 0xb9, 0x2e, 0x3d, 0x6d, 0x59, 0x29, 0x16, 0x07, 0x48,
+	0xbb, 0x25, 0x2d, 0xeb, 0x96, 0x76, 0x60, 0x75, 0x62, 0xfb, 0x78, 0x1c, 0x76, 0x38, 0x75, 0x39,
+	0xe2, 0xaa, 0x31, 0x08, 0x36, 0x23, 0x18, 0xbd, 0x91, 0xe3, 0xf6, 0x3b, 0xc2, 0x40, 0xaa, 0xd2,
+	0x9b, 0x11, 0x18, 0x14, 0x82, 0x4d, 0x02, 0xe2, 0x71, 0x83, 0xd0, 0xf6, 0x89, 0xc7, 0x2d, 0x2e,
+	0xf6, 0xb8, 0x1c, 0x14, 0x7d, 0x05, 0xda, 0xc0, 0x19, 0x3b, 0xc1, 0x08, 0xf7, 0x9b, 0xea, 0x42,
+	0xb4, 0x08, 0x36, 0xe5, 0xa9, 0x4b, 0x69, 0x4f, 0xfd, 0x65, 0xc2, 0xa1, 0x18, 0x94, 0xf6, 0x6b,
+	0x12, 0xed, 0xb1, 0x2d, 0x24, 0x5c, 0xcb, 0x67, 0x60, 0xf8, 0xd8, 0xee, 0x5f, 0xca, 0xce, 0xa2,
+	0x46, 0x5f, 0x46, 0x9d, 0xae, 0xc7, 0x68, 0x68, 0x27, 0xe1, 0x85, 0x74, 0x7a, 0x83, 0x21, 0x4b,
+	0x87, 0x98, 0x70, 0xc2, 0x15, 0xdd, 0x06, 0x35, 0xf4, 0x31, 0xe6, 0xde, 0x84, 0x49, 0x92, 0x05,
+	0x42, 0x8b, 0x6e, 0x10, 0x63, 0x26, 0x7f, 0x83, 0xe6, 0xea, 0x9d, 0x62, 0x1a, 0x82, 0xed, 0x10,
+	0xd3, 0xe9, 0xdb, 0xe1, 0xf4, 0x3c, 0x68, 0xae, 0x65, 0x4f, 0xe1, 0x5b, 0xe8, 0x21, 0xdc, 0x10,
+	0xd7, 0x0a, 0x85, 0x07, 0x9d, 0x60, 0x4a, 0x9d, 0x78, 0x13, 0x51, 0x76, 0xae, 0x47, 0x00, 0x5c,
+	0x7d, 0x6d, 0xb6, 0x9d, 0x8f, 0x3b, 0xb0, 0x1d, 0x77, 0xea, 0xe3, 0xe6, 0x46, 0x3e, 0xee, 0x31,
+	0xdb, 0x46, 0x5f, 0xc1, 0xf5, 0x2c, 0x6e, 0xe8, 0x85, 0xb6, 0xdb, 0xdc, 0xa4, 0x98, 0xd7, 0xd2,
+	0x98, 0x67, 0x64, 0xf3, 0x85, 0xaa, 0x95, 0x8d, 0xca, 0x0b, 0x55, 0x03, 0xa3, 0x6a, 0xfe, 0x63,
+	0x01, 0x34, 0x92, 0x7b, 0x88, 0x18, 0x3f, 0x70, 0x5c, 0x9c, 0x70, 0x23, 0x64, 0xd3, 0xa2, 0xcb,
+	0xe8, 0x3e, 0xe8, 0xe4, 0x6f, 0x27, 0xbc, 0x9c, 0xb0, 0xfc, 0x65, 0x6d, 0x77, 0x35, 0x82, 0x39,
+	0xbb, 0x9c, 0x60, 0x62, 0x2f, 0x6c, 0xb4, 0x28, 0xb2, 0x7f, 0x03, 0x3a, 0x23, 0x98, 0x98, 0x2f,
+	0x2c, 0xb4, 0xc3, 0x18, 0x18, 0xb5, 0x40, 0xa3, 0xcf, 0xc0, 0xc7, 0x63, 0x1a, 0x57, 0x74, 0x2b,
+	0x9a, 0xa3, 0x4f, 0xa0, 0xe2, 0x51, 0xd5, 0xb0, 0x28, 0x9f, 0x52, 0x97, 0xd8, 0x43, 0x9f, 0x83,
+	0xde, 0x25, 0xd9, 0x92, 0x85, 0x07, 0x01, 0xb7, 0x24, 0xc6, 0xc7, 0x3e, 0x5f, 0xb5, 0xe2, 0xfd,
+	0x28, 0x67, 0x22, 0x56, 0x54, 0xe3, 0x39, 0xd3, 0xd7, 0xa0, 0x13, 0x36, 0x98, 0xd7, 0xdc, 0x94,
+	0xbd, 0xa6, 0x2a, 0x1c, 0xe5, 0xa6, 0xec, 0x28, 0x55, 0xe1, 0x1b, 0x2d, 0xd0, 0xc4, 0x1d, 0xe8,
+	0x0e, 0x94, 0xe8, 0x2d, 0x5c, 0xda, 0x20, 0x51, 0xc0, 0x36, 0xd0, 0xc7, 0x50, 0xf2, 0xc9, 0x15,
+	0xdc, 0x7b, 0xac, 0x31, 0x08, 0x71, 0xb1, 0xc5, 0x36, 0xcd, 0x3f, 0x03, 0x60, 0x0c, 0x0a, 0x87,
+	0xc8, 0xd8, 0x4c, 0x38, 0x44, 0x61, 0xb0, 0x6c, 0x8b, 0x28, 0x92, 0xde, 0xd0, 0xf1, 0xf1, 0x80,
+	0x1f, 0x9e, 0x12, 0x80, 0x26, 0x04, 0x60, 0xee, 0x51, 0x7f, 0x3b, 0xb1, 0x7b, 0xd4, 0xb1, 0x7d,
+	0x02, 0x6b, 0xce, 0x78, 0x32, 0x25, 0xd1, 0x1d, 0x0f, 0x9c, 0x1f, 0x70, 0xd0, 0x2c, 0x50, 0x1d,
+	0xac, 0xd2, 0xd5, 0xd7, 0x7c, 0xd1, 0xfc, 0x4b, 0x28, 0xb5, 0x47, 0xb6, 0xdf, 0x47, 0xdb, 0x00,
+	0xbd, 0x08, 0x9b, 0x93, 0x54, 0x17, 0xaf, 0x96, 0x2f, 0x5b, 0x12, 0x48, 0x3e, 0xcf, 0xaf, 0xed,
+	0x70, 0x24, 0xf3, 0x8c, 0x6e, 0x43, 0xd5, 0x9b, 0x86, 0x94, 0x0e, 0x92, 0x0a, 0xb3, 0xd8, 0x0b,
+	0x6c, 0x89, 0x00, 0x13, 0x0d, 0x45, 0x48, 0x49, 0x0d, 0xe9, 0xb9, 0x1a, 0xd2, 0x85, 0x86, 0xfe,
+	0x5e, 0x81, 0xf5, 0x03, 0x9a, 0x9d, 0xd2, 0xf8, 0x89, 0x7f, 0x33, 0xc5, 0xc1, 0xc2, 0xf8, 0x9a,
+	0x0a, 0x08, 0xc5, 0x6c, 0x40, 0x68, 0x40, 0x79, 0x3a, 0xe9, 0xdb, 0x21, 0xcb, 0x07, 0x34, 0x8b,
+	0xcf, 0xa4, 0xb4, 0xb4, 0x94, 0x48, 0x4b, 0x3f, 0x82, 0x5a, 0xcf, 0xc5, 0xb6, 0x2f, 0x52, 0xd2,
+	0x32, 0xc5, 0xaa, 0xd2, 0x35, 0x96, 0x8e, 0xbe, 0x50, 0xb5, 0x82, 0x51, 0x34, 0xf7, 0x00, 0x9d,
+	0x8c, 0x49, 0x02, 0x12, 0x2e, 0x4f, 0xaf, 0x79, 0x1d, 0xea, 0x2f, 0x9d, 0x40, 0xc6, 0x78, 0xa1,
+	0x6a, 0x8a, 0x51, 0x30, 0x1f, 0x83, 0x11, 0x6f, 0x04, 0x13, 0x6f, 0x1c, 0xd0, 0x57, 0x4f, 0x90,
+	0xe4, 0x54, 0x6a, 0x35, 0x3a, 0x90, 0xa5, 0xbc, 0x3e, 0x1f, 0x99, 0xbf, 0x86, 0xf5, 0x43, 0xec,
+	0xe2, 0x2b, 0x09, 0x6f, 0x13, 0x4a, 0x03, 0xcf, 0xef, 0x61, 0x9e, 0x59, 0xb1, 0x89, 0xc8, 0xb6,
+	0x8a, 0x51, 0xb6, 0x65, 0xfe, 0x83, 0x02, 0xa8, 0x4d, 0xa2, 0x18, 0xf7, 0xf7, 0xfc, 0xf4, 0xbb,
+	0x50, 0x66, 0x81, 0x34, 0x37, 0x03, 0x60, 0x5b, 0x69, 0x05, 0xa9, 0xb9, 0x0a, 0xe2, 0x39, 0x42,
+	0x31, 0x91, 0xf5, 0x25, 0x03, 0x5b, 0x69, 0xc9, 0xc0, 0xc6, 0x95, 0xf3, 0x2f, 0x45, 0x40, 0xfb,
+	0xd3, 0x28, 0x66, 0x5f, 0x89, 0xe4, 0x46, 0x22, 0xd1, 0xd7, 0x73, 0xf2, 0x94, 0xda, 0xa2, 0x3c,
+	0x25, 0x49, 0x7b, 0x79, 0xd9, 0xa0, 0x2c, 0xe2, 0x66, 0x71, 0x61, 0xdc, 0xac, 0x2c, 0x11, 0x37,
+	0xb5, 0xd9, 0x71, 0x73, 0x0d, 0x0a, 0x27, 0x87, 0xbc, 0xaa, 0x2b, 0x9c, 0x1c, 0xa6, 0x62, 0x86,
+	0x9e, 0x8e, 0x19, 0x52, 0xc2, 0x03, 0xef, 0x97, 0xf0, 0x54, 0x97, 0x4f, 0x78, 0xb8, 0x06, 0xff,
+	0x57, 0x81, 0x8d, 0x63, 0xba, 0x94, 0x51, 0xe1, 0xe2, 0xbc, 0x33, 0x65, 0x75, 0x85, 0xac, 0xd5,
+	0x2d, 0x2f, 0xea, 0xd2, 0x12, 0xa2, 0xae, 0xcc, 0x16, 0x75, 0x52, 0xb4, 0xe5, 0xb4, 0x68, 0x37,
+	0xa1, 0x44, 0xfb, 0x2a, 0xdc, 0x3b, 0xb1, 0x89, 0x39, 0x86, 0x4d, 0xee, 0x5b, 0xde, 0x83, 0xf9,
+	0x5f, 0x40, 0x95, 0xc5, 0x98, 0x20, 0x24, 0x6e, 0x8f, 0xa5, 0x0b, 0x72, 0xc2, 0xd6, 0x26, 0xeb,
+	0x16, 0x50, 0x20, 0x3a, 0x36, 0x7f, 0xa7, 0xc0, 0x3a, 0x71, 0x3f, 0xc9, 0xdb, 0x16, 0xb8, 0x8f,
+	0xdb, 0xa0, 0x0e, 0x7c, 0xef, 0x3c, 0xb7, 0xd6, 0x25, 0x1b, 0xe8, 0x26, 0x14, 0x42, 0xaf, 0x59,
+	0xcc, 0x6e, 0x17, 0x42, 0x52, 0x19, 0x95, 0xc7, 0xd3, 0xf3, 0x2e, 0xf6, 0x29, 0xe7, 0xaa, 0xc5,
+	0x67, 0xa4, 0x52, 0xf3, 0xf1, 0x3b, 0xec, 0x07, 0x98, 0xda, 0xa7, 0x66, 0x89, 0x29, 0x29, 0x35,
+	0xe3, 0xfa, 0x83, 0x96, 0x9a, 0x8c, 0xe1, 0x6c, 0xa9, 0x19, 0x83, 0xd1, 0x08, 0xc7, 0xc7, 0xe6,
+	0x7f, 0x2a, 0xb0, 0xc1, 0x22, 0x0c, 0xaf, 0x40, 0x38, 0x9f, 0xa2, 0x68, 0x57, 0x66, 0x15, 0xed,
+	0x37, 0x40, 0x0b, 0x3a, 0x52, 0x85, 0xa4, 0x5b, 0x95, 0x80, 0x1d, 0x21, 0x55, 0x38, 0xc5, 0xd9,
+	0x15, 0x4e, 0xb2, 0xe8, 0x57, 0xe7, 0x17, 0xfd, 0x52, 0x35, 0x5e, 0x9a, 0x53, 0x8d, 0x9b, 0x8f,
+	0x22, 0x1b, 0x49, 0x72, 0x73, 0x37, 0x51, 0x45, 0xcf, 0x28, 0xe6, 0x5e, 0x32, 0x7d, 0x27, 0x31,
+	0x17, 0xe8, 0x5b, 0xd2, 0x4c, 0x21, 0xa9, 0x99, 0xd7, 0xb0, 0xc1, 0x82, 0xcf, 0xd5, 0x29, 0xc9,
+	0x0f, 0x42, 0xe6, 0x43, 0x71, 0xe2, 0xd5, 0xed, 0xdf, 0xb4, 0x01, 0x1d, 0xbb, 0xd3, 0xb4, 0xdf,
+	0xf8, 0x24, 0xee, 0x00, 0x28, 0xd9, 0x02, 0x4f, 0xec, 0xa1, 0x8f, 0x41, 0x0b, 0xbd, 0x0e, 0xe1,
+	0x97, 0x25, 0x58, 0x09, 0x39, 0x54, 0x42, 0x8f, 0xfc, 0x0d, 0xcc, 0x7f, 0x53, 0xa0, 0xd1, 0x9e,
+	0x76, 0x89, 0x3b, 0xe9, 0xe2, 0x2b, 0x3d, 0x9a, 0x46, 0xa2, 0xd4, 0x96, 0x83, 0x8b, 0x4a, 0x6c,
+	0x80, 0xab, 0x7c, 0x46, 0xac, 0xa0, 0x20, 0xd1, 0xbb, 0x2b, 0xce, 0x7a, 0x77, 0x9f, 0x42, 0x89,
+	0x3d, 0x7d, 0x75, 0xc6, 0xd3, 0x67, 0xdb, 0xe6, 0x6f, 0x60, 0xed, 0x19, 0x0e, 0x69, 0x99, 0x11,
+	0x13, 0x3f, 0xaf, 0x0c, 0xf9, 0x08, 0x6a, 0xde, 0x60, 0x10, 0xe0, 0x90, 0x7b, 0xb3, 0x02, 0xad,
+	0x75, 0xaa, 0x6c, 0x8d, 0xf9, 0xb3, 0x6c, 0xf5, 0x51, 0x94, 0xdc, 0x9d, 0xf9, 0x29, 0xac, 0x9d,
+	0xbe, 0xc3, 0xfe, 0x85, 0xef, 0x84, 0xf8, 0x64, 0xdc, 0xc7, 0x3f, 0x10, 0xfd, 0x3b, 0x64, 0x40,
+	0xef, 0x2c, 0x5a, 0x6c, 0x62, 0xfe, 0x55, 0x11, 0xd6, 0x5e, 0x4f, 0xaf, 0x42, 0xdb, 0x26, 0x94,
+	0xde, 0xd9, 0xee, 0x94, 0x79, 0xf4, 0x9a, 0xc5, 0x26, 0x24, 0x99, 0x99, 0xfa, 0x2e, 0x8f, 0x74,
+	0x64, 0x88, 0x3e, 0x20, 0x49, 0x55, 0x6f, 0xea, 0x07, 0xce, 0x3b, 0xcc, 0x93, 0xbb, 0x78, 0x01,
+	0x7d, 0x01, 0x7a, 0x1f, 0xbb, 0xce, 0xb9, 0x13, 0xf2, 0x66, 0xd8, 0x1a, 0x4f, 0x84, 0x0f, 0xc5,
+	0xaa, 0x15, 0x03, 0xa0, 0x2f, 0x00, 0x85, 0xb6, 0x3f, 0xc4, 0x61, 0x87, 0x56, 0x67, 0x52, 0xdc,
+	0x2d, 0x5a, 0x06, 0xdb, 0x21, 0x14, 0x1e, 0xd2, 0x75, 0x74, 0x1f, 0xd6, 0x65, 0xe8, 0x38, 0xd6,
+	0x16, 0xad, 0x7a, 0x0c, 0xcc, 0xc4, 0xf8, 0x09, 0xac, 0x11, 0xcf, 0x83, 0xfd, 0x8e, 0x8f, 0x7b,
+	0x9e, 0xdf, 0x0f, 0x68, 0x04, 0x2d, 0x5a, 0xab, 0x6c, 0xd5, 0x62, 0x8b, 0xe8, 0x97, 0x50, 0xf7,
+	0x84, 0x38, 0x3b, 0x4c, 0x8c, 0x2c, 0x40, 0x6f, 0xb0, 0x50, 0x94, 0x10, 0xb5, 0xb5, 0xe6, 0x25,
+	0x45, 0xdf, 0x80, 0x72, 0x9f, 0x3e, 0x32, 0x9a, 0xd0, 0x68, 0x16, 0x9f, 0xb1, 0x00, 0xcc, 0x9b,
+	0xa8, 0xff, 0xa4, 0xc0, 0x6a, 0xa4, 0x08, 0x72, 0x69, 0x4a, 0xc3, 0x4a, 0x4a, 0xc3, 0xb4, 0x40,
+	0xa0, 0x11, 0xb0, 0x43, 0x8b, 0xb7, 0x02, 0x2f, 0x10, 0xe8, 0xd2, 0x73, 0x3b, 0x18, 0xe5, 0xd1,
+	0x5c, 0x5c, 0x9e, 0xe6, 0x44, 0x01, 0xa5, 0xce, 0x2f, 0xa0, 0xfe, 0x43, 0x81, 0xb5, 0x04, 0xed,
+	0x34, 0xdc, 0x06, 0x13, 0x97, 0xfb, 0x0f, 0xcd, 0x62, 0x13, 0xf4, 0x05, 0xf1, 0x6c, 0x4c, 0xcc,
+	0xec, 0xcd, 0x23, 0x56, 0xfc, 0xc8, 0xb8, 0x96, 0x00, 0x21, 0x16, 0x14, 0x7a, 0xe7, 0xdd, 0x20,
+	0xf4, 0xc6, 0x98, 0xa7, 0xc9, 0xf1, 0x02, 0xba, 0x0f, 0x65, 0xa6, 0x23, 0x4e, 0x5d, 0xde, 0x51,
+	0x1c, 0x82, 0xc0, 0x0e, 0x3c, 0x2f, 0x8c, 0x3c, 0x7d, 0x2e, 0x2c, 0x83, 0x30, 0x1d, 0xa8, 0x1f,
+	0x78, 0x93, 0x4b, 0xf9, 0x45, 0xdc, 0x84, 0x62, 0xe0, 0xf7, 0xb2, 0x0f, 0x82, 0xac, 0x92, 0xcd,
+	0x7e, 0x20, 0xda, 0x5f, 0xf2, 0x66, 0x3f, 0x08, 0x09, 0x0b, 0x91, 0x5c, 0x05, 0x0b, 0xd1, 0x82,
+	0x54, 0xd9, 0x2c, 0xff, 0xfe, 0xcc, 0x3f, 0x67, 0x95, 0xcd, 0x15, 0x5e, 0x2c, 0x02, 0x75, 0x30,
+	0x8d, 0xfa, 0xba, 0x74, 0x4c, 0x62, 0xcc, 0xc8, 0x09, 0x42, 0xcf, 0xbf, 0xe4, 0xbe, 0x43, 0x4c,
+	0xcd, 0x1d, 0xa8, 0xff, 0x91, 0xed, 0xbe, 0xbd, 0x02, 0x45, 0xaf, 0xa1, 0xfe, 0xcc, 0xf5, 0xba,
+	0x32, 0xc6, 0x52, 0xf9, 0x53, 0x13, 0x2a, 0x13, 0x3b, 0x0c, 0xb1, 0x2f, 0x12, 0x47, 0x31, 0x25,
+	0xb5, 0xad, 0xe8, 0xd8, 0x04, 0x51, 0x4f, 0x26, 0x53, 0x9d, 0x09, 0x10, 0xd6, 0x93, 0x21, 0x23,
+	0xf3, 0x02, 0xea, 0x87, 0xce, 0x60, 0x20, 0x93, 0xf2, 0x31, 0x68, 0x63, 0x7c, 0xd1, 0xc9, 0x67,
+	0xa0, 0x32, 0xc6, 0x17, 0x64, 0x40, 0xa0, 0x3c, 0xb7, 0xcf, 0xa0, 0x32, 0xaa, 0xac, 0x78, 0x6e,
+	0x9f, 0x42, 0x35, 0xa1, 0x12, 0x8c, 0x6c, 0xd7, 0xf5, 0x2e, 0xb8, 0x32, 0xc5, 0xd4, 0xfc, 0x1e,
+	0x8c, 0xf8, 0xe2, 0xb8, 0xac, 0x14, 0x37, 0x07, 0x33, 0x08, 0xe7, 0xd7, 0x53, 0x26, 0xc5, 0xfd,
+	0xe2, 0x6d, 0xa4, 0x61, 0x39, 0x11, 0x81, 0xb9, 0x2b, 0x4a, 0xd0, 0x2b, 0xe8, 0xe8, 0x36, 0x54,
+	0x8f, 0x83, 0xde, 0x5b, 0x01, 0x6d, 0x40, 0x71, 0xe0, 0xfc, 0xc0, 0x1f, 0x27, 0x19, 0x9a, 0x5f,
+	0x41, 0x8d, 0x01, 0x70, 0xe2, 0x25, 0x08, 0x9d, 0x42, 0xd0, 0x0c, 0xda, 0xf7, 0xbd, 0xa8, 0x9b,
+	0x40, 0x27, 0xe6, 0x3f, 0x2b, 0xd0, 0x20, 0xf7, 0x9c, 0x4e, 0xb0, 0x6f, 0xd3, 0x5e, 0x07, 0xbb,
+	0xe2, 0xcd, 0xee, 0x72, 0x46, 0xb0, 0x0d, 0x15, 0xd2, 0xe4, 0x08, 0x6d, 0xd1, 0x70, 0xdf, 0x14,
+	0x6f, 0xf3, 0xcc, 0xf6, 0xa3, 0xb3, 0x9e, 0xaf, 0x58, 0xe5, 0x09, 0x5d, 0x42, 0x8f, 0xa1, 0xc6,
+	0xdc, 0x27, 0x17, 0x16, 0xf3, 0x69, 0x37, 0x44, 0xf0, 0xe0, 0x62, 0x09, 0x64, 0xd4, 0x6a, 0x3f,
+	0x5e, 0xdf, 0xaf, 0x82, 0xee, 0x09, 0x5a, 0xcd, 0x13, 0xa8, 0xa7, 0x6e, 0x22, 0x8c, 0x87, 0xf6,
+	0x50, 0x30, 0x1e, 0xb2, 0x0f, 0x87, 0x7d, 0x3b, 0xb4, 0x29, 0x7d, 0x35, 0x8b, 0x8e, 0x09, 0xd4,
+	0xd1, 0xe9, 0xb1, 0x28, 0xde, 0x8f, 0x4e, 0x8f, 0xcd, 0xc7, 0xb0, 0x99, 0x77, 0x3d, 0xcd, 0xbb,
+	0x22, 0x0b, 0xd0, 0x2d, 0x36, 0x11, 0xb7, 0x14, 0xa2, 0x5b, 0xc8, 0xbb, 0x7b, 0x86, 0x93, 0xa4,
+	0x2c, 0xd0, 0xe9, 0x08, 0x50, 0xda, 0xe6, 0xde, 0xec, 0xa2, 0x7b, 0x92, 0x25, 0x2b, 0x92, 0xdf,
+	0x8e, 0x0c, 0x29, 0xb2, 0xe6, 0x7b, 0xd2, 0xcb, 0x28, 0xe4, 0x42, 0x72, 0xf3, 0x34, 0x1f, 0x40,
+	0x93, 0xe5, 0xf3, 0x67, 0xe7, 0x13, 0xb2, 0xd0, 0xc6, 0x61, 0x64, 0x28, 0xb7, 0x00, 0x28, 0x4b,
+	0x38, 0xec, 0x38, 0x7d, 0x2e, 0x36, 0x9d, 0xaf, 0x9c, 0xf4, 0xcd, 0x3f, 0x86, 0x86, 0x85, 0xc7,
+	0xf8, 0x42, 0xc6, 0x14, 0x16, 0x3b, 0x0f, 0x91, 0xc4, 0xb7, 0x30, 0x74, 0x3b, 0x01, 0xee, 0x79,
+	0xe3, 0xbe, 0x48, 0x81, 0x20, 0x0c, 0xdd, 0x36, 0x5b, 0x21, 0x79, 0xf9, 0x01, 0x69, 0x16, 0x25,
+	0xd2, 0xc2, 0x25, 0xcd, 0xce, 0x1c, 0x81, 0xf1, 0x7a, 0x1a, 0xf2, 0x12, 0x92, 0x13, 0x14, 0x65,
+	0x36, 0x8a, 0x9c, 0xd9, 0x7c, 0x00, 0x6a, 0x68, 0x0f, 0xc5, 0xa3, 0xd4, 0x58, 0x8d, 0x60, 0x0f,
+	0x2d, 0xba, 0x1a, 0xb7, 0x38, 0x8b, 0x33, 0x5a, 0x9c, 0xe6, 0x40, 0xd4, 0x42, 0xc9, 0xcb, 0xfe,
+	0xdf, 0xbb, 0x98, 0x7f, 0xa3, 0xc0, 0xfa, 0x33, 0xcc, 0x59, 0x0a, 0xa4, 0x6c, 0x5c, 0xf4, 0x8b,
+	0x95, 0x39, 0xfd, 0xe2, 0xbc, 0x84, 0x53, 0x5d, 0x94, 0x70, 0x26, 0xea, 0xeb, 0x5b, 0x00, 0xb4,
+	0x2f, 0xdf, 0x89, 0x3e, 0x09, 0xaa, 0x24, 0x5a, 0x87, 0xb6, 0xdb, 0x76, 0x7e, 0x8b, 0xf9, 0x43,
+	0xe3, 0x64, 0x33, 0xd2, 0x16, 0x77, 0x87, 0x23, 0x85, 0x14, 0x24, 0x85, 0x98, 0x7b, 0xf4, 0xa1,
+	0x5c, 0xed, 0x28, 0xf3, 0x6f, 0x15, 0x30, 0x04, 0x56, 0x24, 0x9c, 0x44, 0x97, 0x5c, 0x59, 0xd0,
+	0x25, 0xff, 0xbd, 0x8b, 0x08, 0xb1, 0xce, 0xa4, 0xcc, 0x98, 0xf9, 0x1d, 0x18, 0x67, 0xf6, 0xf0,
+	0x3d, 0x2c, 0x67, 0xae, 0xd5, 0x9a, 0x9b, 0x80, 0xc8, 0x55, 0x49, 0x5b, 0x21, 0x71, 0x9c, 0xac,
+	0x9e, 0xd9, 0xc3, 0x48, 0x42, 0x0d, 0x28, 0xb3, 0x36, 0xb8, 0xf8, 0x52, 0xcc, 0x66, 0xac, 0x49,
+	0xde, 0x73, 0xa7, 0x7d, 0xdc, 0xe1, 0xb4, 0xb0, 0xe4, 0x62, 0x95, 0xaf, 0xb2, 0x93, 0xcd, 0x36,
+	0x18, 0xf1, 0x89, 0xdc, 0x5f, 0xb4, 0x62, 0xff, 0x2a, 0x13, 0x46, 0x16, 0x25, 0xd6, 0x0a, 0x33,
+	0x59, 0x33, 0xbf, 0x15, 0x8e, 0xf6, 0xbd, 0x4c, 0xdd, 0xbc, 0x0e, 0xd7, 0x52, 0xe8, 0x8c, 0x30,
+	0xf3, 0x17, 0x22, 0xac, 0xca, 0x02, 0x10, 0x72, 0x54, 0x66, 0xc9, 0x51, 0x46, 0xe1, 0x07, 0x3d,
+	0x00, 0x74, 0x30, 0xc2, 0xbd, 0xb7, 0x57, 0x57, 0x9b, 0xf9, 0x73, 0xd8, 0x48, 0xa0, 0x72, 0x99,
+	0x35, 0xa0, 0x8c, 0x7f, 0x70, 0x82, 0x30, 0xe0, 0x11, 0x9b, 0xcf, 0xcc, 0x1d, 0xa8, 0x70, 0x2e,
+	0x96, 0xe5, 0xfe, 0x5b, 0xd8, 0x60, 0x7e, 0xef, 0xd0, 0xf1, 0x25, 0xe2, 0x0c, 0x28, 0x7a, 0xdd,
+	0xef, 0x45, 0xd0, 0xf3, 0xba, 0xdf, 0xcf, 0x78, 0x7b, 0x3f, 0x83, 0x8d, 0x67, 0x78, 0x09, 0x74,
+	0xf3, 0x39, 0x34, 0x22, 0x29, 0x27, 0x61, 0x1b, 0x09, 0x39, 0xe8, 0x91, 0xc5, 0xc6, 0xa6, 0x56,
+	0x90, 0x4d, 0xcd, 0xfc, 0xeb, 0x02, 0x54, 0xc5, 0xd7, 0x1f, 0x52, 0x98, 0x7c, 0x9d, 0x66, 0xf4,
+	0x96, 0xc4, 0x28, 0x05, 0xe1, 0xe3, 0xe0, 0x68, 0x1c, 0xfa, 0x97, 0xb1, 0x8f, 0xdb, 0x4a, 0x3c,
+	0x89, 0x56, 0x06, 0x8b, 0xe8, 0x90, 0xa1, 0x50, 0xb8, 0xd6, 0x09, 0xd4, 0xe4, 0x83, 0x08, 0x93,
+	0x6f, 0xf1, 0xa5, 0x60, 0xf2, 0x2d, 0xbe, 0x44, 0x77, 0x65, 0x19, 0x65, 0x7c, 0x07, 0xdb, 0x7b,
+	0x58, 0xf8, 0x46, 0x69, 0x1d, 0x82, 0x1e, 0x9d, 0x9e, 0x73, 0xce, 0x47, 0xc9, 0x73, 0x92, 0x2d,
+	0xd0, 0xe8, 0x94, 0xfb, 0xf7, 0x01, 0xe2, 0x1f, 0x48, 0x20, 0x0d, 0xd4, 0xef, 0xda, 0x47, 0x96,
+	0xb1, 0x42, 0x46, 0x4f, 0xbf, 0x3b, 0x3b, 0x35, 0x14, 0x32, 0x3a, 0x6e, 0x1f, 0xfc, 0xca, 0x28,
+	0xdc, 0xff, 0x9c, 0x7d, 0xf3, 0xa4, 0x1f, 0x2a, 0x6b, 0xa0, 0x59, 0x47, 0xed, 0x23, 0xeb, 0xcd,
+	0xd1, 0x21, 0x83, 0x3e, 0x3e, 0x79, 0x79, 0x64, 0x28, 0xa8, 0x02, 0xc5, 0xc3, 0x13, 0xcb, 0x28,
+	0xdc, 0xdf, 0x83, 0xaa, 0xd4, 0xb5, 0x40, 0x55, 0xa8, 0xb4, 0xcf, 0x9e, 0x5a, 0x67, 0x14, 0x5c,
+	0x87, 0x92, 0x75, 0xf4, 0xf4, 0xf0, 0x4f, 0x0c, 0x85, 0x9c, 0x73, 0x7c, 0xf2, 0xea, 0xa4, 0xfd,
+	0xfc, 0xe8, 0xd0, 0x28, 0xdc, 0x7f, 0x04, 0x7a, 0x54, 0xab, 0x93, 0x43, 0x5f, 0x9d, 0xbe, 0x3a,
+	0x62, 0xc7, 0xbf, 0x68, 0x9f, 0xbe, 0x62, 0xc4, 0xbc, 0x3c, 0x79, 0x75, 0x64, 0x14, 0xc8, 0x45,
+	0xed, 0x3f, 0x7c, 0x69, 0x14, 0xc9, 0xe0, 0xa0, 0xfd, 0xc6, 0x50, 0x77, 0x7f, 0x5c, 0x87, 0xe2,
+	0xd3, 0xd7, 0x27, 0xe8, 0x31, 0x40, 0xfc, 0x29, 0x0a, 0x35, 0x58, 0xa4, 0x4e, 0x7f, 0x9b, 0x6a,
+	0x35, 0x32, 0xed, 0xec, 0x23, 0xda, 0xbd, 0x5d, 0x41, 0x5f, 0x43, 0x55, 0xfa, 0x36, 0x84, 0xae,
+	0xd3, 0x03, 0xb2, 0x5f, 0x8b, 0x5a, 0xc9, 0xcf, 0x39, 0xe6, 0x0a, 0x7a, 0x00, 0x9a, 0xf8, 0x0c,
+	0x84, 0x58, 0xc6, 0x99, 0xfa, 0x5c, 0xd4, 0xba, 0x96, 0x5a, 0xe5, 0x8f, 0x7b, 0x85, 0xd0, 0x1c,
+	0x7f, 0x01, 0xe2, 0x34, 0x67, 0x3e, 0x09, 0xcd, 0xa1, 0xf9, 0x4b, 0xa8, 0x4a, 0x1f, 0x79, 0x38,
+	0xcd, 0xd9, 0xcf, 0x3e, 0x2d, 0x39, 0x6f, 0x31, 0x57, 0xd0, 0x3e, 0xd4, 0xe4, 0x36, 0x3d, 0x6a,
+	0xf2, 0x5c, 0x2d, 0xd3, 0xb9, 0x9f, 0x73, 0xf5, 0xb7, 0xb0, 0x9a, 0x68, 0x77, 0xa3, 0x1b, 0xb2,
+	0xc0, 0x92, 0xa7, 0xa4, 0x3b, 0xbc, 0xe6, 0x0a, 0xfa, 0x06, 0x20, 0x6e, 0x5e, 0x73, 0xce, 0x33,
+	0xdd, 0xec, 0x96, 0x91, 0x42, 0x0c, 0xcc, 0x15, 0xf4, 0x84, 0x05, 0x02, 0x61, 0x65, 0x3e, 0xb6,
+	0xcf, 0x67, 0xe2, 0x67, 0x2f, 0xde, 0x51, 0x08, 0xf7, 0x72, 0x9f, 0x92, 0x73, 0x9f, 0xd3, 0xba,
+	0x9c, 0xc3, 0xfd, 0x23, 0xa8, 0x4a, 0xfd, 0x4a, 0x2e, 0xf8, 0x6c, 0x07, 0x33, 0x9f, 0x80, 0x03,
+	0xa8, 0xa7, 0x1a, 0x91, 0xe8, 0x26, 0xd3, 0x5c, 0x6e, 0x7b, 0x32, 0xff, 0x90, 0x2f, 0xa1, 0x2a,
+	0x7d, 0x2c, 0xe3, 0x14, 0x64, 0x3f, 0x9f, 0xe5, 0xa8, 0x5e, 0x6e, 0xa7, 0x73, 0xe6, 0x73, 0x3a,
+	0xec, 0x4b, 0xa9, 0x9e, 0x1f, 0x92, 0x50, 0x7d, 0xf2, 0x94, 0xf4, 0xef, 0xc8, 0x62, 0xd5, 0x73,
+	0xdc, 0x58, 0x75, 0x49, 0x44, 0x23, 0x85, 0x18, 0x30, 0xe2, 0xe5, 0x9e, 0x75, 0x42, 0x73, 0xcb,
+	0x12, 0xff, 0x10, 0x2a, 0xbc, 0x59, 0x83, 0x36, 0x92, 0xad, 0x9b, 0x05, 0x98, 0xf7, 0x14, 0xf4,
+	0x10, 0x34, 0xd1, 0xcf, 0xe1, 0x2f, 0x3d, 0xd5, 0xde, 0x99, 0x73, 0xef, 0x13, 0xa8, 0x3c, 0xc3,
+	0xf2, 0xbd, 0xc9, 0x36, 0x6e, 0xeb, 0x66, 0x06, 0x93, 0x66, 0x7a, 0x6f, 0x68, 0xac, 0x24, 0x0a,
+	0x8f, 0xfd, 0x13, 0x3d, 0x24, 0xe1, 0x9f, 0xe4, 0x83, 0x92, 0x85, 0x97, 0xb9, 0x82, 0x76, 0x99,
+	0x7f, 0x92, 0xa8, 0x4e, 0x35, 0x7d, 0x5a, 0x6b, 0x09, 0x94, 0x80, 0xfa, 0xb4, 0x35, 0x01, 0xc4,
+	0x9f, 0x58, 0x3e, 0x66, 0xfa, 0xb2, 0x1d, 0x05, 0xed, 0x81, 0x26, 0x9a, 0x3e, 0x1c, 0x29, 0xd5,
+	0x03, 0xca, 0x43, 0xda, 0x05, 0x4d, 0xf4, 0x7d, 0x38, 0x52, 0xaa, 0x0d, 0x94, 0x4f, 0xa3, 0x00,
+	0x4a, 0xd0, 0x98, 0xc6, 0xcc, 0xb9, 0xee, 0x01, 0x68, 0xa2, 0xdc, 0xe5, 0x48, 0xa9, 0x56, 0x4f,
+	0xeb, 0x5a, 0x6a, 0x35, 0xeb, 0xb2, 0x29, 0x72, 0x23, 0xd5, 0x2b, 0x58, 0xe6, 0xf1, 0xe8, 0x0c,
+	0xfc, 0xa9, 0xeb, 0xa2, 0x19, 0x60, 0x73, 0xd0, 0xb7, 0x41, 0x25, 0xbd, 0x15, 0xc4, 0x9e, 0x87,
+	0xd4, 0x87, 0x69, 0xad, 0x4b, 0x2b, 0x82, 0xda, 0x1d, 0x05, 0xbd, 0x80, 0x7a, 0xa2, 0xa7, 0xf2,
+	0x66, 0x97, 0x3b, 0x9b, 0xfc, 0x4e, 0xcb, 0x5c, 0xfb, 0x7f, 0x0a, 0x1a, 0xeb, 0x2b, 0x90, 0x5e,
+	0x84, 0x30, 0x62, 0xb9, 0xcd, 0xb0, 0xd8, 0x8a, 0x9f, 0x00, 0x08, 0xa1, 0x46, 0x87, 0xa4, 0x65,
+	0x7f, 0x3d, 0x57, 0xf6, 0x6f, 0x76, 0xe9, 0x01, 0x16, 0x18, 0xe9, 0xfe, 0xc1, 0x7c, 0x86, 0x6e,
+	0x49, 0x1e, 0x2e, 0xdb, 0x73, 0xa0, 0x7c, 0x3d, 0x87, 0x7a, 0xaa, 0xb1, 0xc0, 0x8f, 0xcc, 0x6f,
+	0x37, 0xcc, 0x51, 0xcf, 0x21, 0xac, 0x4a, 0x8d, 0x84, 0x37, 0xbb, 0xdc, 0x35, 0xe6, 0x35, 0x17,
+	0x66, 0x9f, 0xb2, 0xfb, 0x77, 0x55, 0xd0, 0x59, 0xce, 0x46, 0x12, 0x9b, 0x3d, 0xd0, 0xa3, 0xfe,
+	0x02, 0xba, 0x26, 0x7c, 0x56, 0xa2, 0x22, 0x68, 0xc9, 0x79, 0x1e, 0x65, 0xe9, 0x01, 0x6d, 0xa3,
+	0xb3, 0x85, 0x36, 0x6d, 0x98, 0xcf, 0xc0, 0xac, 0x49, 0x98, 0x01, 0x45, 0x7d, 0x02, 0x10, 0x41,
+	0x05, 0xb3, 0xd0, 0xe6, 0x99, 0x49, 0x14, 0x63, 0x38, 0xcd, 0x72, 0x8c, 0x59, 0xf2, 0x14, 0xf4,
+	0x00, 0xf4, 0xa8, 0x03, 0x81, 0x64, 0xee, 0x16, 0x9b, 0xd8, 0x11, 0x40, 0x84, 0x1a, 0xf0, 0x17,
+	0x9a, 0xe9, 0x66, 0x2c, 0x3e, 0xe6, 0x97, 0xa0, 0x89, 0x36, 0x03, 0x8a, 0x1a, 0x89, 0x72, 0x45,
+	0xbd, 0xc4, 0x53, 0x91, 0xb1, 0x53, 0x8d, 0x86, 0xc5, 0x04, 0x1c, 0x80, 0x2e, 0x70, 0x84, 0x1a,
+	0xd2, 0x6d, 0x87, 0xc5, 0x87, 0xec, 0x82, 0x1e, 0x75, 0x02, 0x50, 0x9c, 0x87, 0x26, 0x28, 0x91,
+	0x7a, 0x1c, 0x9c, 0x73, 0x3d, 0xea, 0x14, 0x70, 0x9c, 0x74, 0xe7, 0x60, 0xae, 0x87, 0x12, 0xd9,
+	0x41, 0x9e, 0xf6, 0xea, 0x89, 0x5a, 0x89, 0xc6, 0xa7, 0x7d, 0xa8, 0x4a, 0x85, 0x2a, 0x0f, 0x6c,
+	0xd9, 0xaa, 0xb7, 0xd5, 0xcc, 0x6e, 0x44, 0x5e, 0xf9, 0x11, 0x54, 0xa5, 0x2e, 0x04, 0x3f, 0x23,
+	0xdb, 0x97, 0xc8, 0xb9, 0x7e, 0x87, 0x3c, 0xff, 0xd5, 0x44, 0x19, 0x8f, 0xe4, 0x0e, 0x70, 0xea,
+	0x80, 0x56, 0xde, 0x56, 0x44, 0xc6, 0x1e, 0x94, 0xa9, 0x47, 0x1c, 0xa2, 0xa8, 0xbc, 0x5f, 0xac,
+	0xa2, 0xcf, 0x00, 0xb8, 0xc0, 0x92, 0x88, 0x39, 0xa2, 0x7a, 0xc4, 0x42, 0x39, 0x29, 0x00, 0xa5,
+	0x80, 0x2c, 0x35, 0x19, 0x5a, 0xd7, 0x52, 0xab, 0x52, 0x24, 0x78, 0x22, 0x22, 0x17, 0x45, 0x97,
+	0x23, 0x97, 0x7c, 0xc0, 0xf5, 0xcc, 0xba, 0x24, 0xe4, 0x0a, 0xff, 0x1d, 0xe2, 0x7b, 0x04, 0xae,
+	0x43, 0xa8, 0xc9, 0xdd, 0x02, 0xee, 0x14, 0x72, 0x1a, 0x08, 0x73, 0x9f, 0xd5, 0x09, 0xd4, 0x9e,
+	0xe1, 0xcc, 0x29, 0x39, 0x7d, 0x84, 0xc5, 0x62, 0x7f, 0x0e, 0xf5, 0x54, 0x5b, 0x81, 0x3b, 0xfd,
+	0xfc, 0x66, 0xc3, 0x6c, 0xb2, 0xf6, 0x1f, 0xfd, 0xfb, 0x4f, 0x1f, 0x2a, 0x3f, 0xfe, 0xf4, 0xa1,
+	0xf2, 0x3f, 0x3f, 0x7d, 0xa8, 0xfc, 0xfa, 0xe7, 0x43, 0x27, 0x1c, 0x4d, 0xbb, 0x5b, 0x3d, 0xef,
+	0x7c, 0x7b, 0x62, 0xf7, 0x46, 0x97, 0x7d, 0xec, 0xcb, 0xa3, 0xc0, 0xef, 0x6d, 0xc7, 0xff, 0x82,
+	0xab, 0x5b, 0xa6, 0xc7, 0xed, 0xfd, 0xdf, 0x00, 0xa9, 0x25, 0xc0, 0x04, 0xd6, 0x35, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -9166,6 +9214,24 @@ func (m *RepoInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.InheritedLabels) > 0 {
+		for iNdEx := len(m.InheritedLabels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.InheritedLabels[iNdEx])
+			copy(dAtA[i:], m.InheritedLabels[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.InheritedLabels[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Branches) > 0 {
 		for iNdEx := len(m.Branches) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10283,6 +10349,25 @@ func (m *CreateRepoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ClearLabels {
+		i--
+		if m.ClearLabels {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Labels) > 0 {
+		for iNdEx := len(m.Labels) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Labels[iNdEx])
+			copy(dAtA[i:], m.Labels[iNdEx])
+			i = encodeVarintPfs(dAtA, i, uint64(len(m.Labels[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Update {
 		i--
 		if m.Update {
@@ -13683,6 +13768,18 @@ func (m *RepoInfo) Size() (n int) {
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if len(m.InheritedLabels) > 0 {
+		for _, s := range m.InheritedLabels {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14145,6 +14242,15 @@ func (m *CreateRepoRequest) Size() (n int) {
 	if m.Update {
 		n += 2
 	}
+	if len(m.Labels) > 0 {
+		for _, s := range m.Labels {
+			l = len(s)
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.ClearLabels {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -16344,6 +16450,70 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InheritedLabels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InheritedLabels = append(m.InheritedLabels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -19188,6 +19358,58 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Update = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Labels = append(m.Labels, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearLabels", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClearLabels = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -20,6 +20,11 @@ const contextEnvVar = "PACH_CONTEXT"
 var defaultConfigDir = filepath.Join(os.Getenv("HOME"), ".pachyderm")
 var defaultConfigPath = filepath.Join(defaultConfigDir, "config.json")
 
+// currentV2Version is the version of the v2 config format written by this
+// version of pachctl. Configs with an older version are migrated by
+// migrateV2 when they're read.
+const currentV2Version = 1
+
 var configMu sync.Mutex
 var value *Config
 
@@ -74,7 +79,11 @@ func Read(ignoreCache bool) (*Config, error) {
 		// Read json file
 		p := configPath()
 		if raw, err := ioutil.ReadFile(p); err == nil {
-			err = json.Unmarshal(raw, &value)
+			// Parse into a new config, rather than over the cached one, so
+			// that fields missing from the file (e.g. the version of an old
+			// config) aren't taken from the cache
+			value = &Config{}
+			err = json.Unmarshal(raw, value)
 			if err != nil {
 				return nil, errors.Wrapf(err, "could not parse config json at %q", p)
 			}
@@ -103,6 +112,14 @@ func Read(ignoreCache bool) (*Config, error) {
 			}
 		}
 
+		if value.V2.Version < currentV2Version {
+			updated = true
+			log.Debugf("Config V2 version %d is out of date - migrating it to version %d.", value.V2.Version, currentV2Version)
+			if err := value.migrateV2(); err != nil {
+				return nil, err
+			}
+		}
+
 		for contextName, context := range value.V2.Contexts {
 			pachdAddress, err := grpcutil.ParsePachdAddress(context.PachdAddress)
 			if err != nil {
@@ -142,6 +159,7 @@ func (c *Config) initV2() error {
 		ActiveContext: "default",
 		Contexts:      map[string]*Context{},
 		Metrics:       true,
+		Version:       currentV2Version,
 	}
 
 	if c.V1 != nil {
@@ -162,6 +180,24 @@ func (c *Config) initV2() error {
 	return nil
 }
 
+// migrateV2 migrates a v2 config written by an older version of pachctl to
+// the current version. Each step migrates the config from one version to the
+// next.
+func (c *Config) migrateV2() error {
+	for c.V2.Version < currentV2Version {
+		switch c.V2.Version {
+		case 0:
+			// Version 1 keeps session tokens in a secret store rather than in
+			// the config file. Nothing needs to change here: the tokens are
+			// moved into the secret store when the migrated config is written.
+		default:
+			return errors.Errorf("cannot migrate config from unknown version %d", c.V2.Version)
+		}
+		c.V2.Version++
+	}
+	return nil
+}
+
 // Write writes the configuration in 'c' to this machine's Pachyderm config
 // file.
 func (c *Config) Write() error {
@@ -169,11 +205,6 @@ func (c *Config) Write() error {
 		panic("config V1 included (this is a bug)")
 	}
 
-	rawConfig, err := json.MarshalIndent(c, "", "  ")
-	if err != nil {
-		return err
-	}
-
 	p := configPath()
 
 	// Because we're writing the config back to disk, we'll also need to make sure
@@ -188,12 +219,23 @@ func (c *Config) Write() error {
 		}
 	} else {
 		// using the default config path, create the config directory
-		err = os.MkdirAll(defaultConfigDir, 0755)
-		if err != nil {
+		if err := os.MkdirAll(defaultConfigDir, 0755); err != nil {
 			return err
 		}
 	}
 
+	// Move session tokens into the secret store, so that they aren't written
+	// to the config file
+	onDisk := proto.Clone(c).(*Config)
+	if err := onDisk.storeSecrets(value); err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(onDisk, "", "  ")
+	if err != nil {
+		return err
+	}
+
 	// Write to a temporary file first, then rename the temporary file to `p`.
 	// This ensures the write is atomic on POSIX.
 	tmpfile, err := ioutil.TempFile("", "pachyderm-config-*.json")
@@ -217,7 +259,12 @@ func (c *Config) Write() error {
 		}
 	}
 
-	// essentially short-cuts reading the new config back from disk
+	// essentially short-cuts reading the new config back from disk (keeping
+	// the session tokens that were set or loaded, so that they aren't read
+	// from the secret store again)
 	value = proto.Clone(c).(*Config)
+	for name, context := range value.V2.Contexts {
+		context.SessionTokenStore = onDisk.V2.Contexts[name].SessionTokenStore
+	}
 	return nil
 }
@@ -214,13 +214,16 @@ func (m *ConfigV1) GetActiveTransaction() string {
 
 // ConfigV2 specifies v2 of the pachyderm config (June 2019 - present)
 type ConfigV2 struct {
-	ActiveContext        string              `protobuf:"bytes,1,opt,name=active_context,json=activeContext,proto3" json:"active_context,omitempty"`
-	Contexts             map[string]*Context `protobuf:"bytes,2,rep,name=contexts,proto3" json:"contexts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Metrics              bool                `protobuf:"varint,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
-	MaxShellCompletions  int64               `protobuf:"varint,4,opt,name=max_shell_completions,json=maxShellCompletions,proto3" json:"max_shell_completions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	ActiveContext       string              `protobuf:"bytes,1,opt,name=active_context,json=activeContext,proto3" json:"active_context,omitempty"`
+	Contexts            map[string]*Context `protobuf:"bytes,2,rep,name=contexts,proto3" json:"contexts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Metrics             bool                `protobuf:"varint,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	MaxShellCompletions int64               `protobuf:"varint,4,opt,name=max_shell_completions,json=maxShellCompletions,proto3" json:"max_shell_completions,omitempty"`
+	// The version of the v2 config format, which is used to migrate configs
+	// written by older versions of pachctl (see migrateV2).
+	Version              int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ConfigV2) Reset()         { *m = ConfigV2{} }
@@ -284,9 +287,20 @@ func (m *ConfigV2) GetMaxShellCompletions() int64 {
 	return 0
 }
 
+func (m *ConfigV2) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type Context struct {
 	// Where this context came from
 	Source ContextSource `protobuf:"varint,1,opt,name=source,proto3,enum=config.ContextSource" json:"source,omitempty"`
+	// The name of another context that this context inherits from. Any field
+	// that isn't set in this context (other than active_transaction) takes
+	// its value from the parent context.
+	Parent string `protobuf:"bytes,14,opt,name=parent,proto3" json:"parent,omitempty"`
 	// The hostname or IP address pointing pachd at a pachyderm cluster.
 	PachdAddress string `protobuf:"bytes,2,opt,name=pachd_address,json=pachdAddress,proto3" json:"pachd_address,omitempty"`
 	// Trusted root certificates (overrides installed certificates), formatted
@@ -295,7 +309,14 @@ type Context struct {
 	// A secret token identifying the current pachctl user within their
 	// pachyderm cluster. This is included in all RPCs sent by pachctl, and used
 	// to determine if pachctl actions are authorized.
+	//
+	// The token is only written to the config file if no secret store is
+	// available; otherwise it's kept in the secret store named by
+	// session_token_store.
 	SessionToken string `protobuf:"bytes,4,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`
+	// The secret store (e.g. "keyring" or "file") that holds this context's
+	// session token.
+	SessionTokenStore string `protobuf:"bytes,18,opt,name=session_token_store,json=sessionTokenStore,proto3" json:"session_token_store,omitempty"`
 	// The currently active transaction for batching together pachctl commands.
 	// This can be set or cleared via many of the `pachctl * transaction` commands.
 	// This is the ID of the transaction object stored in the pachyderm etcd.
@@ -312,7 +333,18 @@ type Context struct {
 	// A unique ID for the cluster deployment. At client initialization time,
 	// we ensure this is the same as what the cluster reports back, to prevent
 	// us from connecting to the wrong cluster.
-	ClusterDeploymentID  string   `protobuf:"bytes,11,opt,name=cluster_deployment_id,json=clusterDeploymentId,proto3" json:"cluster_deployment_id,omitempty"`
+	ClusterDeploymentID string `protobuf:"bytes,11,opt,name=cluster_deployment_id,json=clusterDeploymentId,proto3" json:"cluster_deployment_id,omitempty"`
+	// Paths to a PEM-encoded client certificate and private key, which are
+	// presented to pachd (which must use TLS) to authenticate via mutual TLS.
+	ClientCert string `protobuf:"bytes,12,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
+	ClientKey  string `protobuf:"bytes,13,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	// Defaults used by pachctl commands run in this context: the repo and
+	// branch of arguments that omit them (e.g. '@master:/file' or
+	// 'images@:/file'), and the default value of --output (e.g. "json" or
+	// "yaml").
+	DefaultRepo          string   `protobuf:"bytes,15,opt,name=default_repo,json=defaultRepo,proto3" json:"default_repo,omitempty"`
+	DefaultBranch        string   `protobuf:"bytes,16,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	OutputFormat         string   `protobuf:"bytes,17,opt,name=output_format,json=outputFormat,proto3" json:"output_format,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -358,6 +390,13 @@ func (m *Context) GetSource() ContextSource {
 	return ContextSource_NONE
 }
 
+func (m *Context) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
 func (m *Context) GetPachdAddress() string {
 	if m != nil {
 		return m.PachdAddress
@@ -379,6 +418,13 @@ func (m *Context) GetSessionToken() string {
 	return ""
 }
 
+func (m *Context) GetSessionTokenStore() string {
+	if m != nil {
+		return m.SessionTokenStore
+	}
+	return ""
+}
+
 func (m *Context) GetActiveTransaction() string {
 	if m != nil {
 		return m.ActiveTransaction
@@ -421,6 +467,41 @@ func (m *Context) GetClusterDeploymentID() string {
 	return ""
 }
 
+func (m *Context) GetClientCert() string {
+	if m != nil {
+		return m.ClientCert
+	}
+	return ""
+}
+
+func (m *Context) GetClientKey() string {
+	if m != nil {
+		return m.ClientKey
+	}
+	return ""
+}
+
+func (m *Context) GetDefaultRepo() string {
+	if m != nil {
+		return m.DefaultRepo
+	}
+	return ""
+}
+
+func (m *Context) GetDefaultBranch() string {
+	if m != nil {
+		return m.DefaultBranch
+	}
+	return ""
+}
+
+func (m *Context) GetOutputFormat() string {
+	if m != nil {
+		return m.OutputFormat
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("config.ContextSource", ContextSource_name, ContextSource_value)
 	proto.RegisterType((*Config)(nil), "config.Config")
@@ -434,50 +515,58 @@ func init() {
 func init() { proto.RegisterFile("client/pkg/config/config.proto", fileDescriptor_60f651abce1dcdf3) }
 
 var fileDescriptor_60f651abce1dcdf3 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
-	0x18, 0xbd, 0x06, 0x02, 0xf6, 0x07, 0x24, 0xdc, 0x21, 0xd1, 0xb5, 0x72, 0x2b, 0x92, 0x12, 0x45,
-	0x8a, 0xaa, 0x06, 0x84, 0xdb, 0x45, 0x95, 0x4d, 0x15, 0x20, 0x69, 0x69, 0x53, 0x12, 0x39, 0x3f,
-	0x8b, 0x6e, 0x2c, 0xc7, 0x1e, 0xc0, 0x0a, 0xf6, 0xb8, 0x33, 0x03, 0x0d, 0x8f, 0xd0, 0xc7, 0xe8,
-	0x23, 0xf4, 0x2d, 0xba, 0xec, 0x13, 0x44, 0x15, 0x4f, 0x52, 0xcd, 0x8c, 0x21, 0xe4, 0xa7, 0x52,
-	0x57, 0x5d, 0x31, 0x3e, 0xe7, 0x7c, 0x7f, 0xf3, 0x1d, 0x06, 0x2a, 0xde, 0x30, 0xc0, 0x11, 0xaf,
-	0xc7, 0x57, 0xfd, 0xba, 0x47, 0xa2, 0x5e, 0x30, 0xfb, 0xa9, 0xc5, 0x94, 0x70, 0x82, 0xb2, 0xea,
-	0x6b, 0x7d, 0xb5, 0x4f, 0xfa, 0x44, 0x42, 0x75, 0x71, 0x52, 0x6c, 0xf5, 0x13, 0x64, 0x5b, 0x92,
-	0x47, 0x5b, 0x90, 0x1b, 0x31, 0x4c, 0x9d, 0xc0, 0x37, 0xb5, 0x4d, 0x6d, 0xc7, 0x68, 0xc2, 0xf4,
-	0x66, 0x23, 0x7b, 0xce, 0x30, 0xed, 0xb4, 0xed, 0xac, 0xa0, 0x3a, 0x3e, 0xda, 0x84, 0xd4, 0xb8,
-	0x61, 0xa6, 0x36, 0xb5, 0x9d, 0xbc, 0x55, 0xaa, 0x25, 0x75, 0x54, 0x82, 0x8b, 0x86, 0x9d, 0x1a,
-	0x37, 0xa4, 0xc2, 0x32, 0xd3, 0x8f, 0x2a, 0x2c, 0x3b, 0x35, 0xb6, 0xaa, 0xdf, 0x34, 0xd0, 0x67,
-	0x21, 0x68, 0x0b, 0x8a, 0xb1, 0xeb, 0x0d, 0x7c, 0xc7, 0xf5, 0x7d, 0x8a, 0x19, 0x93, 0xb9, 0x0d,
-	0xbb, 0x20, 0xc1, 0x7d, 0x85, 0xa1, 0xe7, 0x00, 0x0c, 0xd3, 0x31, 0xa6, 0x8e, 0xe7, 0x32, 0x99,
-	0xdb, 0x68, 0x16, 0xa7, 0x37, 0x1b, 0xc6, 0xa9, 0x44, 0x5b, 0xfb, 0xcc, 0x36, 0x94, 0xa0, 0xe5,
-	0x32, 0x91, 0x92, 0x61, 0xc6, 0x02, 0x12, 0x39, 0x9c, 0x5c, 0xe1, 0x48, 0x8d, 0x63, 0x17, 0x12,
-	0xf0, 0x4c, 0x60, 0x68, 0x17, 0x90, 0xeb, 0xf1, 0x60, 0x8c, 0x1d, 0x4e, 0xdd, 0x88, 0x89, 0x33,
-	0x89, 0xcc, 0x8c, 0x54, 0xfe, 0xab, 0x98, 0xb3, 0x5b, 0xa2, 0xfa, 0x25, 0x35, 0xef, 0xd9, 0x42,
-	0xdb, 0xb0, 0x9c, 0xc4, 0x7a, 0x24, 0xe2, 0xf8, 0x9a, 0x27, 0x15, 0x8a, 0x0a, 0x6d, 0x29, 0x10,
-	0xed, 0x81, 0x9e, 0xf0, 0x62, 0xaa, 0xf4, 0x4e, 0xde, 0xaa, 0xdc, 0xbf, 0x8f, 0x5a, 0xa2, 0x65,
-	0x07, 0x11, 0xa7, 0x13, 0x7b, 0xae, 0x47, 0x26, 0xe4, 0x42, 0xcc, 0x69, 0xe0, 0xa9, 0x71, 0x75,
-	0x7b, 0xf6, 0x89, 0x2c, 0x58, 0x0b, 0xdd, 0x6b, 0x87, 0x0d, 0xf0, 0x70, 0xe8, 0x78, 0x24, 0x8c,
-	0x87, 0x58, 0x74, 0xc8, 0x64, 0xef, 0x69, 0xbb, 0x1c, 0xba, 0xd7, 0xa7, 0x82, 0x6b, 0xdd, 0x52,
-	0xeb, 0x47, 0x50, 0xbc, 0x53, 0x08, 0x95, 0x20, 0x7d, 0x85, 0x27, 0x49, 0xdb, 0xe2, 0x88, 0xb6,
-	0x61, 0x69, 0xec, 0x0e, 0x47, 0x38, 0xd9, 0xed, 0xca, 0x42, 0xa7, 0x22, 0xce, 0x56, 0xec, 0x5e,
-	0xea, 0x95, 0x56, 0xfd, 0x9a, 0x81, 0xdc, 0x6c, 0xc6, 0x5d, 0xc8, 0x32, 0x32, 0xa2, 0x1e, 0x96,
-	0xb9, 0x96, 0xad, 0xb5, 0x7b, 0x71, 0xa7, 0x92, 0xb4, 0x13, 0xd1, 0x5f, 0xd9, 0x76, 0xe6, 0x8f,
-	0xb7, 0xbd, 0xf4, 0x9b, 0x6d, 0xa3, 0xa7, 0x50, 0xf0, 0x86, 0x23, 0xc6, 0x31, 0x75, 0x22, 0x37,
-	0xc4, 0x66, 0x56, 0x0a, 0xf3, 0x09, 0xd6, 0x75, 0x43, 0x8c, 0xfe, 0x07, 0xc3, 0x1d, 0xf1, 0x81,
-	0x13, 0x44, 0x3d, 0x62, 0xe6, 0x24, 0xaf, 0x0b, 0xa0, 0x13, 0xf5, 0x08, 0x7a, 0x02, 0x86, 0x88,
-	0x63, 0xb1, 0xeb, 0x61, 0x53, 0x97, 0xe4, 0x2d, 0x80, 0x8e, 0x60, 0x25, 0x26, 0x94, 0x3b, 0x3d,
-	0x42, 0x3f, 0xbb, 0xd4, 0xc7, 0x94, 0x99, 0x20, 0xed, 0xb1, 0x75, 0xef, 0xf2, 0x6a, 0x27, 0x84,
-	0xf2, 0xc3, 0xb9, 0x4a, 0x79, 0x64, 0x39, 0xbe, 0x03, 0xa2, 0xf7, 0xb0, 0x36, 0xeb, 0xd5, 0xc7,
-	0xf1, 0x90, 0x4c, 0x42, 0x1c, 0x71, 0xf1, 0x27, 0xce, 0xcb, 0x8b, 0xfb, 0x6f, 0x7a, 0xb3, 0x51,
-	0x6e, 0x29, 0x41, 0x7b, 0xce, 0x77, 0xda, 0x76, 0xd9, 0x7b, 0x00, 0xfa, 0xeb, 0xfb, 0x50, 0x7e,
-	0xa4, 0xe6, 0x23, 0x76, 0x59, 0x5d, 0xb4, 0x4b, 0x71, 0xc1, 0x1d, 0xef, 0x32, 0xba, 0x51, 0x82,
-	0x67, 0xaf, 0xe7, 0x8e, 0x53, 0x0e, 0x40, 0x3a, 0x64, 0xba, 0xc7, 0xdd, 0x83, 0xd2, 0x3f, 0xa8,
-	0x08, 0x46, 0xeb, 0xb8, 0x7b, 0xd8, 0x79, 0xe3, 0x5c, 0x34, 0x4a, 0x1a, 0xca, 0x41, 0xfa, 0xed,
-	0x79, 0xb3, 0x94, 0x42, 0x05, 0xd0, 0x3b, 0x1f, 0x4e, 0x8e, 0xed, 0xb3, 0x83, 0x76, 0x29, 0xdd,
-	0x6c, 0x7e, 0x9f, 0x56, 0xb4, 0x1f, 0xd3, 0x8a, 0xf6, 0x73, 0x5a, 0xd1, 0x3e, 0xbe, 0xec, 0x07,
-	0x7c, 0x30, 0xba, 0xac, 0x79, 0x24, 0xac, 0x0b, 0xaf, 0x4c, 0x7c, 0x4c, 0x17, 0x4f, 0x8c, 0x7a,
-	0xf5, 0x07, 0xcf, 0xe0, 0x65, 0x56, 0x3e, 0x71, 0x2f, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x16,
-	0x1c, 0xdd, 0x64, 0x22, 0x05, 0x00, 0x00,
+	// 803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xd1, 0x4e, 0x23, 0x37,
+	0x14, 0xed, 0x24, 0x30, 0xc9, 0xdc, 0x24, 0x90, 0x75, 0x96, 0x76, 0x44, 0xdb, 0x40, 0x41, 0x2b,
+	0xa1, 0xaa, 0x9b, 0x88, 0xb4, 0x0f, 0xd5, 0xbe, 0x54, 0x24, 0x40, 0x9b, 0xee, 0x16, 0x56, 0x03,
+	0xbb, 0x0f, 0x7d, 0x19, 0x19, 0xcf, 0x0d, 0x89, 0x98, 0x19, 0x4f, 0x6d, 0x4f, 0x4a, 0xfe, 0xa4,
+	0xef, 0xfd, 0x82, 0xfe, 0x45, 0x1f, 0xfb, 0x05, 0xa8, 0xca, 0x97, 0x54, 0xb6, 0x27, 0x10, 0xd8,
+	0xec, 0x6b, 0x9f, 0x62, 0x9f, 0x73, 0x7c, 0x7d, 0xef, 0x9d, 0x73, 0x1d, 0x68, 0xb3, 0x78, 0x82,
+	0xa9, 0xea, 0x66, 0x37, 0xd7, 0x5d, 0xc6, 0xd3, 0xd1, 0x64, 0xf1, 0xd3, 0xc9, 0x04, 0x57, 0x9c,
+	0xb8, 0x76, 0xb7, 0xfd, 0xfc, 0x9a, 0x5f, 0x73, 0x03, 0x75, 0xf5, 0xca, 0xb2, 0x7b, 0xbf, 0x81,
+	0x3b, 0x30, 0x3c, 0xd9, 0x87, 0x4a, 0x2e, 0x51, 0x84, 0x93, 0xc8, 0x77, 0x76, 0x9d, 0x03, 0xaf,
+	0x0f, 0xf3, 0xbb, 0x1d, 0xf7, 0x9d, 0x44, 0x31, 0x3c, 0x0e, 0x5c, 0x4d, 0x0d, 0x23, 0xb2, 0x0b,
+	0xa5, 0xe9, 0xa1, 0x5f, 0xda, 0x75, 0x0e, 0x6a, 0xbd, 0x66, 0xa7, 0xb8, 0xc7, 0x06, 0x78, 0x7f,
+	0x18, 0x94, 0xa6, 0x87, 0x46, 0xd1, 0xf3, 0xcb, 0x2b, 0x15, 0xbd, 0xa0, 0x34, 0xed, 0xed, 0xfd,
+	0xe5, 0x40, 0x75, 0x71, 0x84, 0xec, 0x43, 0x23, 0xa3, 0x6c, 0x1c, 0x85, 0x34, 0x8a, 0x04, 0x4a,
+	0x69, 0x62, 0x7b, 0x41, 0xdd, 0x80, 0x47, 0x16, 0x23, 0xdf, 0x00, 0x48, 0x14, 0x53, 0x14, 0x21,
+	0xa3, 0xd2, 0xc4, 0xf6, 0xfa, 0x8d, 0xf9, 0xdd, 0x8e, 0x77, 0x61, 0xd0, 0xc1, 0x91, 0x0c, 0x3c,
+	0x2b, 0x18, 0x50, 0xa9, 0x43, 0x4a, 0x94, 0x72, 0xc2, 0xd3, 0x50, 0xf1, 0x1b, 0x4c, 0x6d, 0x39,
+	0x41, 0xbd, 0x00, 0x2f, 0x35, 0x46, 0x5e, 0x02, 0xa1, 0x4c, 0x4d, 0xa6, 0x18, 0x2a, 0x41, 0x53,
+	0xa9, 0xd7, 0x3c, 0xf5, 0xd7, 0x8c, 0xf2, 0x99, 0x65, 0x2e, 0x1f, 0x88, 0xbd, 0x3f, 0x4b, 0xf7,
+	0x39, 0xf7, 0xc8, 0x0b, 0xd8, 0x28, 0xce, 0x32, 0x9e, 0x2a, 0xbc, 0x55, 0xc5, 0x0d, 0x0d, 0x8b,
+	0x0e, 0x2c, 0x48, 0x5e, 0x41, 0xb5, 0xe0, 0x75, 0x55, 0xe5, 0x83, 0x5a, 0xaf, 0xfd, 0xb4, 0x1f,
+	0x9d, 0x42, 0x2b, 0x4f, 0x52, 0x25, 0x66, 0xc1, 0xbd, 0x9e, 0xf8, 0x50, 0x49, 0x50, 0x89, 0x09,
+	0xb3, 0xe5, 0x56, 0x83, 0xc5, 0x96, 0xf4, 0x60, 0x2b, 0xa1, 0xb7, 0xa1, 0x1c, 0x63, 0x1c, 0x87,
+	0x8c, 0x27, 0x59, 0x8c, 0x3a, 0x43, 0x69, 0x72, 0x2f, 0x07, 0xad, 0x84, 0xde, 0x5e, 0x68, 0x6e,
+	0xf0, 0x40, 0xe9, 0x68, 0x53, 0x14, 0xba, 0x78, 0x7f, 0xdd, 0xa8, 0x16, 0xdb, 0xed, 0x37, 0xd0,
+	0x78, 0x94, 0x02, 0x69, 0x42, 0xf9, 0x06, 0x67, 0x45, 0x41, 0x7a, 0x49, 0x5e, 0xc0, 0xfa, 0x94,
+	0xc6, 0x39, 0x16, 0x5f, 0x7d, 0x73, 0xa9, 0x06, 0x7d, 0x2e, 0xb0, 0xec, 0xab, 0xd2, 0xf7, 0xce,
+	0xde, 0x1f, 0x2e, 0x54, 0x16, 0xd5, 0xbf, 0x04, 0x57, 0xf2, 0x5c, 0x30, 0x34, 0xb1, 0x36, 0x7a,
+	0x5b, 0x4f, 0xce, 0x5d, 0x18, 0x32, 0x28, 0x44, 0xe4, 0x53, 0x70, 0x33, 0x2a, 0x30, 0x55, 0xfe,
+	0x86, 0xb9, 0xba, 0xd8, 0xfd, 0x2f, 0xfe, 0x58, 0x5b, 0xe1, 0x8f, 0x0e, 0xb4, 0x1e, 0x89, 0x42,
+	0xa9, 0xb8, 0x40, 0x9f, 0x58, 0x83, 0x2c, 0x4b, 0x2f, 0x34, 0xf1, 0x11, 0x3f, 0xad, 0x7f, 0xc4,
+	0x4f, 0xe4, 0x2b, 0xa8, 0xb3, 0x38, 0x97, 0x0a, 0x45, 0x98, 0xd2, 0x04, 0x7d, 0xd7, 0x08, 0x6b,
+	0x05, 0x76, 0x46, 0x13, 0x24, 0x9f, 0x83, 0x47, 0x73, 0x35, 0x0e, 0x27, 0xe9, 0x88, 0xfb, 0x15,
+	0xc3, 0x57, 0x35, 0x30, 0x4c, 0x47, 0x9c, 0x7c, 0x01, 0x9e, 0x3e, 0x27, 0x33, 0xca, 0xd0, 0xaf,
+	0x1a, 0xf2, 0x01, 0x20, 0x6f, 0x60, 0x33, 0xe3, 0x42, 0x85, 0x23, 0x2e, 0x7e, 0xa7, 0x22, 0x42,
+	0x21, 0x7d, 0x30, 0x06, 0xdc, 0x7f, 0xf2, 0x11, 0x3a, 0x6f, 0xb9, 0x50, 0xa7, 0xf7, 0x2a, 0xeb,
+	0xc2, 0x8d, 0xec, 0x11, 0x48, 0x5e, 0xc3, 0xd6, 0x22, 0xd7, 0x08, 0xb3, 0x98, 0xcf, 0x12, 0x4c,
+	0x95, 0x7e, 0x26, 0x6a, 0xa6, 0xd1, 0x9f, 0xcd, 0xef, 0x76, 0x5a, 0x03, 0x2b, 0x38, 0xbe, 0xe7,
+	0x87, 0xc7, 0x41, 0x8b, 0x7d, 0x00, 0x46, 0x64, 0x07, 0x6a, 0xf6, 0xbd, 0x0a, 0x19, 0x0a, 0xe5,
+	0xd7, 0x4d, 0xea, 0x60, 0xa1, 0x01, 0x0a, 0x45, 0xbe, 0x84, 0x62, 0x17, 0x6a, 0x1f, 0x36, 0x6c,
+	0x69, 0x16, 0x79, 0x8d, 0x33, 0xdd, 0xb8, 0x08, 0x47, 0x34, 0x8f, 0x55, 0x28, 0x30, 0xe3, 0xfe,
+	0xa6, 0x6d, 0x5c, 0x81, 0x05, 0x98, 0x71, 0x3d, 0x9e, 0x0b, 0xc9, 0x95, 0xa0, 0x29, 0x1b, 0xfb,
+	0x4d, 0x3b, 0x9e, 0x05, 0xda, 0x37, 0xa0, 0xb6, 0x01, 0xcf, 0x55, 0x96, 0x9b, 0x36, 0x25, 0x54,
+	0xf9, 0xcf, 0xac, 0x0d, 0x2c, 0x78, 0x6a, 0xb0, 0xed, 0x23, 0x68, 0xad, 0x68, 0xd1, 0x8a, 0x29,
+	0x79, 0xbe, 0x3c, 0x25, 0x8d, 0xa5, 0xa1, 0xf8, 0x79, 0xad, 0xea, 0x35, 0xe1, 0xeb, 0x1f, 0xa0,
+	0xf1, 0xc8, 0xf8, 0xa4, 0x0a, 0x6b, 0x67, 0xe7, 0x67, 0x27, 0xcd, 0x4f, 0x48, 0x03, 0xbc, 0xc1,
+	0xf9, 0xd9, 0xe9, 0xf0, 0xc7, 0xf0, 0xfd, 0x61, 0xd3, 0x21, 0x15, 0x28, 0xff, 0xf4, 0xae, 0xdf,
+	0x2c, 0x91, 0x3a, 0x54, 0x87, 0xbf, 0xbc, 0x3d, 0x0f, 0x2e, 0x4f, 0x8e, 0x9b, 0xe5, 0x7e, 0xff,
+	0xef, 0x79, 0xdb, 0xf9, 0x67, 0xde, 0x76, 0xfe, 0x9d, 0xb7, 0x9d, 0x5f, 0xbf, 0xbb, 0x9e, 0xa8,
+	0x71, 0x7e, 0xd5, 0x61, 0x3c, 0xe9, 0xea, 0x51, 0x98, 0x45, 0x28, 0x96, 0x57, 0x52, 0xb0, 0xee,
+	0x07, 0xff, 0x0b, 0x57, 0xae, 0x79, 0xf3, 0xbf, 0xfd, 0x6f, 0x00, 0x9e, 0xba, 0xd1, 0x9e, 0x33,
+	0x06, 0x00, 0x00,
 }
 
 func (m *Config) Marshal() (dAtA []byte, err error) {
@@ -617,6 +706,11 @@ func (m *ConfigV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Version != 0 {
+		i = encodeVarintConfig(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MaxShellCompletions != 0 {
 		i = encodeVarintConfig(dAtA, i, uint64(m.MaxShellCompletions))
 		i--
@@ -692,6 +786,61 @@ func (m *Context) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SessionTokenStore) > 0 {
+		i -= len(m.SessionTokenStore)
+		copy(dAtA[i:], m.SessionTokenStore)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.SessionTokenStore)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OutputFormat) > 0 {
+		i -= len(m.OutputFormat)
+		copy(dAtA[i:], m.OutputFormat)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.OutputFormat)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.DefaultBranch) > 0 {
+		i -= len(m.DefaultBranch)
+		copy(dAtA[i:], m.DefaultBranch)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.DefaultBranch)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DefaultRepo) > 0 {
+		i -= len(m.DefaultRepo)
+		copy(dAtA[i:], m.DefaultRepo)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.DefaultRepo)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ClientKey) > 0 {
+		i -= len(m.ClientKey)
+		copy(dAtA[i:], m.ClientKey)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.ClientKey)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ClientCert) > 0 {
+		i -= len(m.ClientCert)
+		copy(dAtA[i:], m.ClientCert)
+		i = encodeVarintConfig(dAtA, i, uint64(len(m.ClientCert)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.ClusterDeploymentID) > 0 {
 		i -= len(m.ClusterDeploymentID)
 		copy(dAtA[i:], m.ClusterDeploymentID)
@@ -865,6 +1014,9 @@ func (m *ConfigV2) Size() (n int) {
 	if m.MaxShellCompletions != 0 {
 		n += 1 + sovConfig(uint64(m.MaxShellCompletions))
 	}
+	if m.Version != 0 {
+		n += 1 + sovConfig(uint64(m.Version))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -920,6 +1072,34 @@ func (m *Context) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovConfig(uint64(l))
 	}
+	l = len(m.ClientCert)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.ClientKey)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.DefaultRepo)
+	if l > 0 {
+		n += 1 + l + sovConfig(uint64(l))
+	}
+	l = len(m.DefaultBranch)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	l = len(m.OutputFormat)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
+	l = len(m.SessionTokenStore)
+	if l > 0 {
+		n += 2 + l + sovConfig(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1501,6 +1681,25 @@ func (m *ConfigV2) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -1943,6 +2142,230 @@ func (m *Context) Unmarshal(dAtA []byte) error {
 			}
 			m.ClusterDeploymentID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCert = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRepo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultRepo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionTokenStore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConfig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConfig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConfig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionTokenStore = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConfig(dAtA[iNdEx:])
@@ -0,0 +1,255 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// withConfigDir points the config (and the file secret store) at a
+// temporary directory, returning the config path and a function that undoes
+// this.
+func withConfigDir(t *testing.T, secretStore string) (string, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "pachyderm-config-test-")
+	require.NoError(t, err)
+	p := filepath.Join(dir, "config.json")
+	require.NoError(t, os.Setenv(configEnvVar, p))
+	require.NoError(t, os.Setenv(secretStoreEnvVar, secretStore))
+	return p, func() {
+		os.Unsetenv(configEnvVar)
+		os.Unsetenv(secretStoreEnvVar)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestResolvedContext(t *testing.T) {
+	c := &Config{V2: &ConfigV2{Contexts: map[string]*Context{
+		"base": {
+			PachdAddress:      "grpc://pachd:650",
+			Namespace:         "prod",
+			SessionToken:      "token",
+			ClientKey:         "key",
+			DefaultBranch:     "master",
+			ActiveTransaction: "txn",
+		},
+		"images":  {Parent: "base", DefaultRepo: "images", DefaultBranch: "staging"},
+		"edges":   {Parent: "images", OutputFormat: "yaml"},
+		"staging": {Parent: "edges", PachdAddress: "grpc://staging:650"},
+	}}}
+
+	edges, err := c.ResolvedContext("edges")
+	require.NoError(t, err)
+	require.Equal(t, "grpc://pachd:650", edges.PachdAddress)
+	require.Equal(t, "prod", edges.Namespace)
+	require.Equal(t, "token", edges.SessionToken)
+	require.Equal(t, "key", edges.ClientKey)
+	require.Equal(t, "images", edges.DefaultRepo)
+	require.Equal(t, "staging", edges.DefaultBranch)
+	require.Equal(t, "yaml", edges.OutputFormat)
+	// Transactions are never inherited
+	require.Equal(t, "", edges.ActiveTransaction)
+	// The stored context isn't changed
+	require.Equal(t, "", c.V2.Contexts["edges"].PachdAddress)
+
+	// Credentials aren't inherited by contexts that connect to another pachd
+	staging, err := c.ResolvedContext("staging")
+	require.NoError(t, err)
+	require.Equal(t, "grpc://staging:650", staging.PachdAddress)
+	require.Equal(t, "prod", staging.Namespace)
+	require.Equal(t, "", staging.SessionToken)
+	require.Equal(t, "", staging.ClientKey)
+
+	c.V2.Contexts["base"].Parent = "edges"
+	_, err = c.ResolvedContext("edges")
+	require.YesError(t, err)
+	require.Matches(t, "inherits from itself", err.Error())
+
+	c.V2.Contexts["base"].Parent = "missing"
+	_, err = c.ResolvedContext("edges")
+	require.YesError(t, err)
+	require.Matches(t, "does not exist", err.Error())
+}
+
+func TestSessionTokenFileStore(t *testing.T) {
+	p, cleanup := withConfigDir(t, fileSecretStoreName)
+	defer cleanup()
+	cfg, err := Read(true)
+	require.NoError(t, err)
+	_, context, err := cfg.ActiveContext(true)
+	require.NoError(t, err)
+	context.SessionToken = "secret-token"
+	require.NoError(t, cfg.Write())
+
+	// The token is kept out of the config file
+	raw, err := ioutil.ReadFile(p)
+	require.NoError(t, err)
+	require.False(t, strings.Contains(string(raw), "secret-token"))
+	require.True(t, strings.Contains(string(raw), `"session_token_store": "file"`))
+	secrets, err := ioutil.ReadFile(secretsPath())
+	require.NoError(t, err)
+	require.True(t, strings.Contains(string(secrets), "secret-token"))
+	info, err := os.Stat(secretsPath())
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// ...but is read back from the secret store when it's loaded
+	cfg, err = Read(true)
+	require.NoError(t, err)
+	name, context, err := cfg.ActiveContext(true)
+	require.NoError(t, err)
+	require.Equal(t, "", context.SessionToken)
+	cfg.LoadSessionToken(name)
+	require.Equal(t, "secret-token", context.SessionToken)
+
+	// Logging out removes the token from the secret store
+	context.SessionToken = ""
+	require.NoError(t, cfg.Write())
+	_, err = os.Stat(secretsPath())
+	require.True(t, os.IsNotExist(err))
+}
+
+// lockableSecretStore is a secret store that can be locked, like an OS keyring,
+// so that reading secrets from it fails
+type lockableSecretStore struct {
+	secrets map[string]string
+	locked  bool
+	reads   []string
+}
+
+func (s *lockableSecretStore) Name() string {
+	return "lockable"
+}
+
+func (s *lockableSecretStore) Get(key string) (string, error) {
+	s.reads = append(s.reads, key)
+	if s.locked {
+		return "", errors.Errorf("the store is locked")
+	}
+	secret, ok := s.secrets[key]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+	return secret, nil
+}
+
+func (s *lockableSecretStore) Set(key, secret string) error {
+	s.secrets[key] = secret
+	return nil
+}
+
+func (s *lockableSecretStore) Delete(key string) error {
+	delete(s.secrets, key)
+	return nil
+}
+
+func TestSessionTokenLockedStore(t *testing.T) {
+	store := &lockableSecretStore{secrets: make(map[string]string)}
+	RegisterSecretStore(store.Name(), func() (SecretStore, error) { return store, nil })
+	_, cleanup := withConfigDir(t, store.Name())
+	defer cleanup()
+	cfg, err := Read(true)
+	require.NoError(t, err)
+	name, context, err := cfg.ActiveContext(true)
+	require.NoError(t, err)
+	context.SessionToken = "secret-token"
+	require.NoError(t, cfg.Write())
+	require.Equal(t, "secret-token", store.secrets[secretKey(cfg, name)])
+
+	// A token that can't be read is empty, but writing the config doesn't
+	// delete it
+	store.locked = true
+	cfg, err = Read(true)
+	require.NoError(t, err)
+	cfg.LoadSessionToken(name)
+	require.Equal(t, "", cfg.V2.Contexts[name].SessionToken)
+	require.NoError(t, cfg.Write())
+	store.locked = false
+	cfg, err = Read(true)
+	require.NoError(t, err)
+	cfg.LoadSessionToken(name)
+	require.Equal(t, "secret-token", cfg.V2.Contexts[name].SessionToken)
+
+	// Clearing a token that was read deletes it
+	cfg.V2.Contexts[name].SessionToken = ""
+	require.NoError(t, cfg.Write())
+	require.Equal(t, 0, len(store.secrets))
+}
+
+func TestLoadSessionToken(t *testing.T) {
+	store := &lockableSecretStore{secrets: make(map[string]string)}
+	RegisterSecretStore(store.Name(), func() (SecretStore, error) { return store, nil })
+	_, cleanup := withConfigDir(t, store.Name())
+	defer cleanup()
+	cfg, err := Read(true)
+	require.NoError(t, err)
+	cfg.V2.Contexts = map[string]*Context{
+		"base":    {PachdAddress: "grpc://pachd:650", SessionToken: "base-token"},
+		"images":  {Parent: "base"},
+		"staging": {PachdAddress: "grpc://staging:650", SessionToken: "staging-token"},
+	}
+	cfg.V2.ActiveContext = "images"
+	require.NoError(t, cfg.Write())
+
+	// Reading the config doesn't read any session tokens
+	store.reads = nil
+	cfg, err = Read(true)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(store.reads))
+	_, _, err = cfg.ActiveResolvedContext(true)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(store.reads))
+
+	// Loading the active context's token only reads the tokens that it uses
+	cfg.LoadSessionToken("images")
+	require.Equal(t, []string{secretKey(cfg, "base")}, store.reads)
+	images, err := cfg.ResolvedContext("images")
+	require.NoError(t, err)
+	require.Equal(t, "base-token", images.SessionToken)
+	require.Equal(t, "", cfg.V2.Contexts["staging"].SessionToken)
+
+	// Loaded tokens are cached, so they aren't read again
+	store.reads = nil
+	cfg, err = Read(false)
+	require.NoError(t, err)
+	cfg.LoadSessionToken("images")
+	require.Equal(t, 0, len(store.reads))
+	require.Equal(t, "base-token", cfg.V2.Contexts["base"].SessionToken)
+
+	// Logging out deletes a token even if it was never read
+	cfg, err = Read(true)
+	require.NoError(t, err)
+	cfg.V2.Contexts["staging"].ClearSessionToken()
+	require.NoError(t, cfg.Write())
+	_, ok := store.secrets[secretKey(cfg, "staging")]
+	require.False(t, ok)
+	require.Equal(t, "base-token", store.secrets[secretKey(cfg, "base")])
+}
+
+func TestMigrateV2(t *testing.T) {
+	p, cleanup := withConfigDir(t, fileSecretStoreName)
+	defer cleanup()
+	// A config written before session tokens were moved to a secret store
+	require.NoError(t, ioutil.WriteFile(p, []byte(`{
+  "user_id": "user",
+  "v2": {
+    "active_context": "default",
+    "contexts": {"default": {"pachd_address": "grpc://pachd:650", "session_token": "old-token"}}
+  }
+}`), 0644))
+
+	cfg, err := Read(true)
+	require.NoError(t, err)
+	require.Equal(t, int64(currentV2Version), cfg.V2.Version)
+	require.Equal(t, "old-token", cfg.V2.Contexts["default"].SessionToken)
+
+	raw, err := ioutil.ReadFile(p)
+	require.NoError(t, err)
+	require.False(t, strings.Contains(string(raw), "old-token"))
+	require.True(t, strings.Contains(string(raw), `"version": 1`))
+}
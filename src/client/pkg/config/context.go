@@ -1,5 +1,10 @@
 package config
 
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
 // EqualClusterReference returns whether two contexts appear to point to the
 // same underlying kubernetes cluster
 func (c *Context) EqualClusterReference(other *Context) bool {
@@ -20,3 +25,91 @@ func (c *Context) EqualClusterReference(other *Context) bool {
 	}
 	return true
 }
+
+// ClearSessionToken logs the context out. Its session token is deleted from
+// the secret store that holds it when the config is written, even if the
+// token was never loaded (see LoadSessionToken).
+func (c *Context) ClearSessionToken() {
+	c.SessionToken = ""
+	c.SessionTokenStore = ""
+}
+
+// ResolvedContext returns the named context with the values it inherits from
+// its parent contexts filled in. The returned context is a copy, so changes
+// to it aren't written back to the config.
+func (c *Config) ResolvedContext(name string) (*Context, error) {
+	if c.V2 == nil {
+		return nil, errors.Errorf("cannot resolve a context in a non-v2 config")
+	}
+	context := c.V2.Contexts[name]
+	if context == nil {
+		return nil, errors.Errorf("context does not exist: %s", name)
+	}
+	resolved := proto.Clone(context).(*Context)
+	seen := map[string]bool{name: true}
+	for parentName := context.Parent; parentName != ""; parentName = context.Parent {
+		if seen[parentName] {
+			return nil, errors.Errorf("pachctl config error: context %q inherits from itself (via %q)", name, parentName)
+		}
+		seen[parentName] = true
+		if context = c.V2.Contexts[parentName]; context == nil {
+			return nil, errors.Errorf("pachctl config error: context %q inherits from context %q, which does not exist", name, parentName)
+		}
+		inheritFrom(resolved, context)
+	}
+	return resolved, nil
+}
+
+// inheritFrom fills in the fields of 'c' that aren't set with the values of
+// 'parent'. The active transaction is never inherited, as a transaction
+// started in one context shouldn't be extended by commands run in another.
+// Credentials are only inherited by contexts that connect to the same pachd
+// as 'parent', so that they're never sent to a different cluster.
+func inheritFrom(c, parent *Context) {
+	sameCluster := c.PachdAddress == "" || c.PachdAddress == parent.PachdAddress
+	fields := []struct{ field, parentValue *string }{
+		{&c.PachdAddress, &parent.PachdAddress},
+		{&c.ServerCAs, &parent.ServerCAs},
+		{&c.ClusterName, &parent.ClusterName},
+		{&c.AuthInfo, &parent.AuthInfo},
+		{&c.Namespace, &parent.Namespace},
+		{&c.ClusterDeploymentID, &parent.ClusterDeploymentID},
+		{&c.DefaultRepo, &parent.DefaultRepo},
+		{&c.DefaultBranch, &parent.DefaultBranch},
+		{&c.OutputFormat, &parent.OutputFormat},
+	}
+	if sameCluster {
+		fields = append(fields, []struct{ field, parentValue *string }{
+			{&c.SessionToken, &parent.SessionToken},
+			{&c.ClientCert, &parent.ClientCert},
+			{&c.ClientKey, &parent.ClientKey},
+		}...)
+	}
+	for _, f := range fields {
+		if *f.field == "" {
+			*f.field = *f.parentValue
+		}
+	}
+	if len(c.PortForwarders) == 0 && len(parent.PortForwarders) > 0 {
+		c.PortForwarders = make(map[string]uint32)
+		for service, port := range parent.PortForwarders {
+			c.PortForwarders[service] = port
+		}
+	}
+}
+
+// ActiveResolvedContext is like ActiveContext, but returns the active
+// context with the values it inherits from its parent contexts filled in
+// (see ResolvedContext). It should be used by code that only reads the
+// context.
+func (c *Config) ActiveResolvedContext(errorOnNoActive bool) (string, *Context, error) {
+	name, context, err := c.ActiveContext(errorOnNoActive)
+	if err != nil || context == nil {
+		return name, context, err
+	}
+	resolved, err := c.ResolvedContext(name)
+	if err != nil {
+		return "", nil, err
+	}
+	return name, resolved, nil
+}
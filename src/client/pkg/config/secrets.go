@@ -0,0 +1,430 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// secretStoreEnvVar selects the secret store that session tokens are kept
+	// in. If it's unset, the OS keyring is used if one is available, and the
+	// file store otherwise. Setting it to "none" keeps session tokens in the
+	// config file, as older versions of pachctl did.
+	secretStoreEnvVar = "PACH_SECRET_STORE"
+
+	noSecretStore = "none"
+	// secretService is the service name that secrets are stored under in the
+	// OS keyring.
+	secretService = "pachyderm"
+)
+
+// ErrSecretNotFound is returned by SecretStore.Get if no secret is stored
+// under the given key.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretStore stores secrets (such as session tokens) outside of the pachctl
+// config file.
+type SecretStore interface {
+	// Name returns the name of the store, which is recorded in the config
+	// alongside each context whose session token the store holds.
+	Name() string
+	// Get returns the secret stored under key, or ErrSecretNotFound.
+	Get(key string) (string, error)
+	// Set stores secret under key, replacing any existing secret.
+	Set(key, secret string) error
+	// Delete removes the secret stored under key, if any.
+	Delete(key string) error
+}
+
+var secretStores = map[string]func() (SecretStore, error){
+	"keyring": newKeyringSecretStore,
+	"file":    newFileSecretStore,
+}
+
+// RegisterSecretStore makes a secret store available under the given name,
+// so that it can be selected with $PACH_SECRET_STORE.
+func RegisterSecretStore(name string, newStore func() (SecretStore, error)) {
+	secretStores[name] = newStore
+}
+
+// getSecretStore returns the secret store with the given name.
+func getSecretStore(name string) (SecretStore, error) {
+	newStore, ok := secretStores[name]
+	if !ok {
+		return nil, errors.Errorf("unknown secret store %q", name)
+	}
+	return newStore()
+}
+
+// defaultSecretStore returns the secret store that session tokens should be
+// written to, or nil if they should be kept in the config file.
+func defaultSecretStore() (SecretStore, error) {
+	if name, ok := os.LookupEnv(secretStoreEnvVar); ok && name != "" {
+		if name == noSecretStore {
+			return nil, nil
+		}
+		return getSecretStore(name)
+	}
+	if keyringAvailable() {
+		return newKeyringSecretStore()
+	}
+	return newFileSecretStore()
+}
+
+// secretKey returns the key that the session token of the given context is
+// stored under. Keys include the config's user ID, so that configs at
+// different paths don't share secrets.
+func secretKey(c *Config, contextName string) string {
+	return fmt.Sprintf("%s/%s", c.UserID, contextName)
+}
+
+// LoadSessionToken reads the session token of the named context, and those of
+// the contexts it inherits from, from the secret stores that hold them. Read
+// doesn't load any session tokens, so that only commands that connect to
+// pachd read a token (e.g. from the OS keyring), and only the token they use.
+// A token that can't be read is logged and left empty, so that the user just
+// appears to be logged out (but it's kept in its store, see storeSecrets).
+func (c *Config) LoadSessionToken(name string) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	seen := make(map[string]bool)
+	for !seen[name] {
+		seen[name] = true
+		context := c.V2.Contexts[name]
+		if context == nil {
+			return
+		}
+		c.loadSessionToken(name, context)
+		name = context.Parent
+	}
+}
+
+// loadSessionToken reads the session token of one context. Tokens are also
+// kept in the cached config, so that they aren't read again and aren't
+// rewritten to their store unless they change.
+func (c *Config) loadSessionToken(name string, context *Context) {
+	storeName := context.SessionTokenStore
+	if storeName == "" || context.SessionToken != "" {
+		return
+	}
+	store, err := getSecretStore(storeName)
+	if err == nil {
+		context.SessionToken, err = store.Get(secretKey(c, name))
+	}
+	if errors.Is(err, ErrSecretNotFound) {
+		context.SessionTokenStore = ""
+	} else if err != nil {
+		log.Warnf("could not read the session token of context %q from the %s secret store: %v", name, storeName, err)
+		return
+	}
+	if value == nil || value == c || value.V2 == nil || value.UserID != c.UserID {
+		return
+	}
+	if cached := value.V2.Contexts[name]; cached != nil && cached.SessionToken == "" && cached.SessionTokenStore == storeName {
+		cached.SessionToken = context.SessionToken
+		cached.SessionTokenStore = context.SessionTokenStore
+	}
+}
+
+// storeSecrets moves the session tokens of c's contexts into the default
+// secret store, so that they aren't written to the config file. prev is the
+// config as it was last read or written (or nil), and is used to avoid
+// rewriting unchanged secrets and to delete the secrets of removed contexts.
+func (c *Config) storeSecrets(prev *Config) error {
+	store, err := defaultSecretStore()
+	if err != nil {
+		return err
+	}
+	var prevContexts map[string]*Context
+	if prev != nil && prev.V2 != nil {
+		prevContexts = prev.V2.Contexts
+	}
+	names := make([]string, 0, len(c.V2.Contexts))
+	for name := range c.V2.Contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		context := c.V2.Contexts[name]
+		prevContext := prevContexts[name]
+		if context.SessionToken == "" || store == nil {
+			// A token that couldn't be read (e.g. because the keyring is
+			// locked) is empty, but it's only deleted from its store if it
+			// was read and has since been cleared (e.g. by logging out)
+			if context.SessionToken == "" && (prevContext == nil || prevContext.SessionToken == "") {
+				// ...or if it was cleared without being read (see
+				// ClearSessionToken)
+				if context.SessionTokenStore == "" && prevContext != nil && prevContext.SessionTokenStore != "" {
+					deleteSecret(prevContext.SessionTokenStore, secretKey(c, name))
+				}
+				continue
+			}
+			if context.SessionTokenStore != "" {
+				deleteSecret(context.SessionTokenStore, secretKey(c, name))
+				context.SessionTokenStore = ""
+			}
+			continue
+		}
+		if prevContext != nil && prevContext.SessionToken == context.SessionToken &&
+			prevContext.SessionTokenStore != "" && prevContext.SessionTokenStore == context.SessionTokenStore {
+			context.SessionToken = ""
+			continue
+		}
+		oldStore := context.SessionTokenStore
+		if oldStore == "" && prevContext != nil {
+			oldStore = prevContext.SessionTokenStore
+		}
+		if oldStore != "" && oldStore != store.Name() {
+			deleteSecret(oldStore, secretKey(c, name))
+		}
+		context.SessionTokenStore = store.Name()
+		if err := store.Set(secretKey(c, name), context.SessionToken); err != nil {
+			if store.Name() == fileSecretStoreName {
+				return errors.Wrapf(err, "could not store the session token of context %q", name)
+			}
+			// Fall back to the file store if the keyring can't be written
+			// (e.g. because it's locked)
+			log.Warnf("could not store the session token of context %q in the %s secret store, falling back to a file: %v", name, store.Name(), err)
+			fileStore, err := newFileSecretStore()
+			if err != nil {
+				return err
+			}
+			if err := fileStore.Set(secretKey(c, name), context.SessionToken); err != nil {
+				return errors.Wrapf(err, "could not store the session token of context %q", name)
+			}
+			context.SessionTokenStore = fileStore.Name()
+		}
+		context.SessionToken = ""
+	}
+	for name, prevContext := range prevContexts {
+		if _, ok := c.V2.Contexts[name]; !ok && prevContext.SessionTokenStore != "" {
+			deleteSecret(prevContext.SessionTokenStore, secretKey(c, name))
+		}
+	}
+	return nil
+}
+
+// deleteSecret deletes a secret from the named store, logging any error (a
+// leftover secret is harmless, as it's never read again).
+func deleteSecret(storeName, key string) {
+	store, err := getSecretStore(storeName)
+	if err == nil {
+		err = store.Delete(key)
+	}
+	if err != nil {
+		log.Warnf("could not delete %q from the %s secret store: %v", key, storeName, err)
+	}
+}
+
+const fileSecretStoreName = "file"
+
+// fileSecretStore stores secrets in a JSON file next to the config file,
+// which only the user can read. It's used when no OS keyring is available.
+type fileSecretStore struct {
+	path string
+}
+
+func newFileSecretStore() (SecretStore, error) {
+	return &fileSecretStore{path: secretsPath()}, nil
+}
+
+// secretsPath returns the path of the file secret store, which is derived
+// from the config path (e.g. config.json -> config.secrets.json).
+func secretsPath() string {
+	p := configPath()
+	ext := filepath.Ext(p)
+	return strings.TrimSuffix(p, ext) + ".secrets" + ext
+}
+
+func (s *fileSecretStore) Name() string {
+	return fileSecretStoreName
+}
+
+func (s *fileSecretStore) read() (map[string]string, error) {
+	secrets := make(map[string]string)
+	raw, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secrets, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	if err := json.Unmarshal(raw, &secrets); err != nil {
+		return nil, errors.Wrapf(err, "could not parse secrets at %q", s.path)
+	}
+	return secrets, nil
+}
+
+func (s *fileSecretStore) write(secrets map[string]string) error {
+	if len(secrets) == 0 {
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return errors.EnsureStack(err)
+		}
+		return nil
+	}
+	raw, err := json.MarshalIndent(secrets, "", "  ")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	// Write to a temporary file in the same directory and rename it, so that
+	// the secrets are replaced atomically and are never readable by others
+	tmpfile, err := ioutil.TempFile(filepath.Dir(s.path), ".pachyderm-secrets-*")
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write(raw); err != nil {
+		tmpfile.Close()
+		return errors.EnsureStack(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(os.Rename(tmpfile.Name(), s.path))
+}
+
+func (s *fileSecretStore) Get(key string) (string, error) {
+	secrets, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[key]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+	return secret, nil
+}
+
+func (s *fileSecretStore) Set(key, secret string) error {
+	secrets, err := s.read()
+	if err != nil {
+		return err
+	}
+	secrets[key] = secret
+	return s.write(secrets)
+}
+
+func (s *fileSecretStore) Delete(key string) error {
+	secrets, err := s.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[key]; !ok {
+		return nil
+	}
+	delete(secrets, key)
+	return s.write(secrets)
+}
+
+// keyringSecretStore stores secrets in the OS keyring: the login keychain on
+// macOS (via 'security') and the Secret Service on Linux (via 'secret-tool').
+// Secrets are always passed to these tools on stdin, so that they don't show
+// up in the process list.
+type keyringSecretStore struct{}
+
+func keyringAvailable() bool {
+	switch runtime.GOOS {
+	case "darwin":
+		_, err := exec.LookPath("security")
+		return err == nil
+	case "linux":
+		// secret-tool needs a session bus to reach the Secret Service
+		if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+			return false
+		}
+		_, err := exec.LookPath("secret-tool")
+		return err == nil
+	default:
+		return false
+	}
+}
+
+func newKeyringSecretStore() (SecretStore, error) {
+	if !keyringAvailable() {
+		return nil, errors.Errorf("no OS keyring is available on this machine (set %s=file to store secrets in a file)", secretStoreEnvVar)
+	}
+	return keyringSecretStore{}, nil
+}
+
+func (keyringSecretStore) Name() string {
+	return "keyring"
+}
+
+// runKeyringTool runs a keyring tool, returning its stdout and its exit code
+// (or an error if it couldn't be run).
+func runKeyringTool(stdin string, name string, args ...string) (string, int, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return stdout.String(), exitErr.ExitCode(), errors.Errorf("%s failed: %s", name, strings.TrimSpace(stderr.String()))
+		}
+		return "", 0, errors.EnsureStack(err)
+	}
+	return stdout.String(), 0, nil
+}
+
+// securityQuote quotes an argument of a command run by 'security -i'.
+func securityQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func (keyringSecretStore) Get(key string) (string, error) {
+	if runtime.GOOS == "darwin" {
+		// 'security' exits with 44 if the item doesn't exist
+		out, code, err := runKeyringTool("", "security", "find-generic-password", "-s", secretService, "-a", key, "-w")
+		if code == 44 {
+			return "", ErrSecretNotFound
+		}
+		return strings.TrimSuffix(out, "\n"), err
+	}
+	// 'secret-tool' exits with 1 (and prints nothing) if the item doesn't
+	// exist
+	out, code, err := runKeyringTool("", "secret-tool", "lookup", "service", secretService, "account", key)
+	if code == 1 && out == "" {
+		return "", ErrSecretNotFound
+	}
+	return out, err
+}
+
+func (keyringSecretStore) Set(key, secret string) error {
+	if runtime.GOOS == "darwin" {
+		// Run the command in interactive mode, so that the secret is read from
+		// stdin rather than passed as an argument
+		command := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+			securityQuote(secretService), securityQuote(key), securityQuote(secret))
+		_, _, err := runKeyringTool(command, "security", "-i")
+		return err
+	}
+	_, _, err := runKeyringTool(secret, "secret-tool", "store", "--label", fmt.Sprintf("Pachyderm session token (%s)", key),
+		"service", secretService, "account", key)
+	return err
+}
+
+func (keyringSecretStore) Delete(key string) error {
+	if runtime.GOOS == "darwin" {
+		_, code, err := runKeyringTool("", "security", "delete-generic-password", "-s", secretService, "-a", key)
+		if code == 44 {
+			return nil
+		}
+		return err
+	}
+	// 'secret-tool clear' succeeds whether or not the item exists
+	_, _, err := runKeyringTool("", "secret-tool", "clear", "service", secretService, "account", key)
+	return err
+}
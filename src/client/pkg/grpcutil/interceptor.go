@@ -0,0 +1,45 @@
+package grpcutil
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Interceptor is a pair of gRPC server interceptors that are applied to every
+// unary and streaming RPC, respectively, received by a Server. Either may be
+// nil.
+type Interceptor struct {
+	Unary  grpc.UnaryServerInterceptor
+	Stream grpc.StreamServerInterceptor
+}
+
+// chainUnaryInterceptors returns a unary interceptor that calls each of
+// 'interceptors' in order, with the last one calling the RPC's handler
+func chainUnaryInterceptors(interceptors []grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, h)
+			}
+		}
+		return next(ctx, req)
+	}
+}
+
+// chainStreamInterceptors returns a stream interceptor that calls each of
+// 'interceptors' in order, with the last one calling the RPC's handler
+func chainStreamInterceptors(interceptors []grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		next := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, h := interceptors[i], next
+			next = func(srv interface{}, ss grpc.ServerStream) error {
+				return interceptor(srv, ss, info, h)
+			}
+		}
+		return next(srv, ss)
+	}
+}
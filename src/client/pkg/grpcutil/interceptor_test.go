@@ -0,0 +1,32 @@
+package grpcutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"google.golang.org/grpc"
+)
+
+func TestChainUnaryInterceptors(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			calls = append(calls, name+" before")
+			resp, err := handler(ctx, req)
+			calls = append(calls, name+" after")
+			return resp, err
+		}
+	}
+	chain := chainUnaryInterceptors([]grpc.UnaryServerInterceptor{
+		interceptor("a"), interceptor("b"),
+	})
+	resp, err := chain(context.Background(), "req", &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls = append(calls, "handler")
+			return req.(string) + " resp", nil
+		})
+	require.NoError(t, err)
+	require.Equal(t, "req resp", resp)
+	require.Equal(t, []string{"a before", "b before", "handler", "b after", "a after"}, calls)
+}
@@ -36,7 +36,20 @@ type Server struct {
 // corresponding private key in 'TLSVolumePath', this will serve GRPC traffic
 // over TLS. If either are missing this will serve GRPC traffic over
 // unencrypted HTTP,
-func NewServer(ctx context.Context, publicPortTLSAllowed bool) (*Server, error) {
+//
+// 'interceptors' are applied, in order, to every RPC received by the server,
+// after the tracing interceptors.
+func NewServer(ctx context.Context, publicPortTLSAllowed bool, interceptors ...Interceptor) (*Server, error) {
+	unaryInterceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{tracing.StreamServerInterceptor()}
+	for _, i := range interceptors {
+		if i.Unary != nil {
+			unaryInterceptors = append(unaryInterceptors, i.Unary)
+		}
+		if i.Stream != nil {
+			streamInterceptors = append(streamInterceptors, i.Stream)
+		}
+	}
 	opts := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(math.MaxUint32),
 		grpc.MaxRecvMsgSize(MaxMsgSize),
@@ -45,8 +58,8 @@ func NewServer(ctx context.Context, publicPortTLSAllowed bool) (*Server, error)
 			MinTime:             5 * time.Second,
 			PermitWithoutStream: true,
 		}),
-		grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()),
-		grpc.StreamInterceptor(tracing.StreamServerInterceptor()),
+		grpc.UnaryInterceptor(chainUnaryInterceptors(unaryInterceptors)),
+		grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors)),
 	}
 
 	var cLoader *tls.CertLoader
@@ -62,7 +75,14 @@ func NewServer(ctx context.Context, publicPortTLSAllowed bool) (*Server, error)
 			if err != nil {
 				return nil, errors.Wrapf(err, "couldn't build transport creds: %v", err)
 			}
-			transportCreds := credentials.NewTLS(&gotls.Config{GetCertificate: cLoader.GetCertificate})
+			transportCreds := credentials.NewTLS(&gotls.Config{
+				GetCertificate: cLoader.GetCertificate,
+				// Clients may authenticate with a certificate instead of a token.
+				// Certificates aren't verified here, as the CAs that issue them
+				// are part of the auth config (see the auth server's
+				// ClientCertInterceptor)
+				ClientAuth: gotls.RequestClientCert,
+			})
 			opts = append(opts, grpc.Creds(transportCreds))
 		}
 	}
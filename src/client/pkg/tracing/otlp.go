@@ -0,0 +1,283 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/uber/jaeger-client-go"
+)
+
+const (
+	// otlpEndpointEnvVar and otlpTracesEndpointEnvVar are the standard
+	// OpenTelemetry environment variables that configure where traces are
+	// exported to. Setting either one causes pachyderm to export traces to an
+	// OTLP collector (over HTTP, with JSON encoding), in addition to Jaeger.
+	otlpEndpointEnvVar       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	otlpTracesEndpointEnvVar = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+
+	otlpFlushInterval = time.Second
+	otlpQueueSize     = 1000
+	otlpBatchSize     = 100
+)
+
+// OTLPEndpointEnvVars are the environment variables that configure OTLP
+// export. They're propagated from pachd to workers.
+var OTLPEndpointEnvVars = []string{otlpEndpointEnvVar, otlpTracesEndpointEnvVar}
+
+// otlpEndpointFromEnv returns the URL that traces should be exported to, or ""
+// if OTLP export isn't configured.
+func otlpEndpointFromEnv() string {
+	if endpoint := os.Getenv(otlpTracesEndpointEnvVar); endpoint != "" {
+		return endpoint
+	}
+	if endpoint := os.Getenv(otlpEndpointEnvVar); endpoint != "" {
+		return strings.TrimSuffix(endpoint, "/") + "/v1/traces"
+	}
+	return ""
+}
+
+// The types below are the subset of the OTLP JSON encoding that pachyderm
+// exports (see opentelemetry-proto/opentelemetry/proto/trace/v1).
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource      `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []*otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string           `json:"traceId"`
+	SpanID            string           `json:"spanId"`
+	ParentSpanID      string           `json:"parentSpanId,omitempty"`
+	Name              string           `json:"name"`
+	Kind              int              `json:"kind"`
+	StartTimeUnixNano string           `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string           `json:"endTimeUnixNano"`
+	Attributes        []*otlpAttribute `json:"attributes,omitempty"`
+	Status            *otlpStatus      `json:"status,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	otlpSpanKindInternal = 1
+	otlpSpanKindServer   = 2
+	otlpSpanKindClient   = 3
+	otlpStatusCodeError  = 2
+)
+
+func otlpStringAttribute(key, value string) *otlpAttribute {
+	return &otlpAttribute{Key: key, Value: otlpValue{StringValue: &value}}
+}
+
+func newOTLPAttribute(key string, value interface{}) *otlpAttribute {
+	switch v := value.(type) {
+	case string:
+		return otlpStringAttribute(key, v)
+	case bool:
+		return &otlpAttribute{Key: key, Value: otlpValue{BoolValue: &v}}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		s := fmt.Sprintf("%d", v)
+		return &otlpAttribute{Key: key, Value: otlpValue{IntValue: &s}}
+	case float32:
+		f := float64(v)
+		return &otlpAttribute{Key: key, Value: otlpValue{DoubleValue: &f}}
+	case float64:
+		return &otlpAttribute{Key: key, Value: otlpValue{DoubleValue: &v}}
+	default:
+		return otlpStringAttribute(key, fmt.Sprintf("%v", v))
+	}
+}
+
+// otlpReporter is a jaeger.Reporter that exports spans to an OTLP collector.
+// Spans are converted as they're reported (the jaeger span may be reused once
+// Report returns), and sent in batches by a background goroutine.
+type otlpReporter struct {
+	endpoint string
+	resource otlpResource
+	logger   jaeger.Logger
+	client   *http.Client
+
+	spans     chan *otlpSpan
+	closeOnce sync.Once
+	done      chan struct{}
+	closed    chan struct{}
+}
+
+func newOTLPReporter(endpoint string, logger jaeger.Logger) *otlpReporter {
+	attributes := []*otlpAttribute{otlpStringAttribute("service.name", JaegerServiceName)}
+	if hostname, err := os.Hostname(); err == nil {
+		attributes = append(attributes, otlpStringAttribute("host.name", hostname))
+	}
+	r := &otlpReporter{
+		endpoint: endpoint,
+		resource: otlpResource{Attributes: attributes},
+		logger:   logger,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		spans:    make(chan *otlpSpan, otlpQueueSize),
+		done:     make(chan struct{}),
+		closed:   make(chan struct{}),
+	}
+	go r.run()
+	return r
+}
+
+// Report implements jaeger.Reporter
+func (r *otlpReporter) Report(span *jaeger.Span) {
+	select {
+	case r.spans <- toOTLPSpan(span):
+	default:
+		r.logger.Error("OTLP span queue is full, dropping span")
+	}
+}
+
+// Close implements jaeger.Reporter. It flushes any queued spans.
+func (r *otlpReporter) Close() {
+	r.closeOnce.Do(func() {
+		close(r.done)
+		<-r.closed
+	})
+}
+
+func (r *otlpReporter) run() {
+	defer close(r.closed)
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+	var batch []*otlpSpan
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := r.send(batch); err != nil {
+			r.logger.Error(fmt.Sprintf("could not export %d spans to %s: %v", len(batch), r.endpoint, err))
+		}
+		batch = nil
+	}
+	for {
+		select {
+		case span := <-r.spans:
+			if batch = append(batch, span); len(batch) >= otlpBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-r.done:
+			for {
+				select {
+				case span := <-r.spans:
+					batch = append(batch, span)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (r *otlpReporter) send(spans []*otlpSpan) error {
+	body, err := json.Marshal(&otlpRequest{
+		ResourceSpans: []*otlpResourceSpans{{
+			Resource: r.resource,
+			ScopeSpans: []*otlpScopeSpans{{
+				Scope: otlpScope{Name: "pachyderm"},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := r.client.Post(r.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("collector returned %s", resp.Status)
+	}
+	return nil
+}
+
+func toOTLPSpan(span *jaeger.Span) *otlpSpan {
+	ctx := span.SpanContext()
+	result := &otlpSpan{
+		TraceID:           fmt.Sprintf("%016x%016x", ctx.TraceID().High, ctx.TraceID().Low),
+		SpanID:            fmt.Sprintf("%016x", uint64(ctx.SpanID())),
+		Name:              span.OperationName(),
+		Kind:              otlpSpanKindInternal,
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.StartTime().Add(span.Duration()).UnixNano(), 10),
+	}
+	if ctx.ParentID() != 0 {
+		result.ParentSpanID = fmt.Sprintf("%016x", uint64(ctx.ParentID()))
+	}
+	for key, value := range span.Tags() {
+		switch key {
+		case "span.kind":
+			switch fmt.Sprintf("%v", value) {
+			case "server":
+				result.Kind = otlpSpanKindServer
+			case "client":
+				result.Kind = otlpSpanKindClient
+			}
+			continue
+		case "error":
+			if isErr, ok := value.(bool); ok && isErr {
+				result.Status = &otlpStatus{Code: otlpStatusCodeError}
+			}
+			continue
+		}
+		result.Attributes = append(result.Attributes, newOTLPAttribute(key, value))
+	}
+	sort.Slice(result.Attributes, func(i, j int) bool {
+		return result.Attributes[i].Key < result.Attributes[j].Key
+	})
+	if result.Status != nil {
+		for _, attr := range result.Attributes {
+			if attr.Key == "error.message" && attr.Value.StringValue != nil {
+				result.Status.Message = *attr.Value.StringValue
+			}
+		}
+	}
+	return result
+}
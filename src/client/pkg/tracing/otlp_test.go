@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/uber/jaeger-client-go"
+)
+
+func TestOTLPEndpointFromEnv(t *testing.T) {
+	defer os.Unsetenv(otlpEndpointEnvVar)
+	defer os.Unsetenv(otlpTracesEndpointEnvVar)
+	require.Equal(t, "", otlpEndpointFromEnv())
+	os.Setenv(otlpEndpointEnvVar, "http://collector:4318/")
+	require.Equal(t, "http://collector:4318/v1/traces", otlpEndpointFromEnv())
+	os.Setenv(otlpTracesEndpointEnvVar, "http://traces:4318/custom")
+	require.Equal(t, "http://traces:4318/custom", otlpEndpointFromEnv())
+}
+
+func TestOTLPReporter(t *testing.T) {
+	requests := make(chan *otlpRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &otlpRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		requests <- req
+	}))
+	defer server.Close()
+
+	reporter := newOTLPReporter(server.URL, jaeger.NullLogger)
+	tracer, closer := jaeger.NewTracer("test", jaeger.NewConstSampler(true), reporter)
+	start := time.Unix(100, 0)
+	parent := tracer.StartSpan("parent", opentracing.StartTime(start))
+	child := tracer.StartSpan("child", opentracing.ChildOf(parent.Context()), opentracing.StartTime(start), opentracing.Tags{
+		"span.kind":     "client",
+		"error":         true,
+		"error.message": "failed",
+		"count":         3,
+		"worker":        "w1",
+	})
+	child.FinishWithOptions(opentracing.FinishOptions{FinishTime: start.Add(time.Second)})
+	parent.FinishWithOptions(opentracing.FinishOptions{FinishTime: start.Add(2 * time.Second)})
+	// Closing the tracer flushes the queued spans
+	require.NoError(t, closer.Close())
+
+	var spans []*otlpSpan
+	for len(spans) < 2 {
+		req := <-requests
+		require.Equal(t, 1, len(req.ResourceSpans))
+		require.Equal(t, "service.name", req.ResourceSpans[0].Resource.Attributes[0].Key)
+		for _, scopeSpans := range req.ResourceSpans[0].ScopeSpans {
+			spans = append(spans, scopeSpans.Spans...)
+		}
+	}
+	childSpan, parentSpan := spans[0], spans[1]
+	parentCtx := parent.Context().(jaeger.SpanContext)
+	require.Equal(t, "child", childSpan.Name)
+	require.Equal(t, parentSpan.TraceID, childSpan.TraceID)
+	require.Equal(t, 32, len(childSpan.TraceID))
+	require.Equal(t, fmt.Sprintf("%016x", uint64(parentCtx.SpanID())), parentSpan.SpanID)
+	require.Equal(t, parentSpan.SpanID, childSpan.ParentSpanID)
+	require.Equal(t, "", parentSpan.ParentSpanID)
+	require.Equal(t, otlpSpanKindClient, childSpan.Kind)
+	require.Equal(t, otlpSpanKindInternal, parentSpan.Kind)
+	require.Equal(t, "100000000000", childSpan.StartTimeUnixNano)
+	require.Equal(t, "101000000000", childSpan.EndTimeUnixNano)
+	require.Equal(t, &otlpStatus{Code: otlpStatusCodeError, Message: "failed"}, childSpan.Status)
+	require.True(t, parentSpan.Status == nil)
+
+	// Attributes are sorted, and typed
+	var keys []string
+	for _, attr := range childSpan.Attributes {
+		keys = append(keys, attr.Key)
+	}
+	require.Equal(t, []string{"count", "error.message", "worker"}, keys)
+	require.Equal(t, "3", *childSpan.Attributes[0].Value.IntValue)
+	require.Equal(t, "w1", *childSpan.Attributes[2].Value.StringValue)
+}
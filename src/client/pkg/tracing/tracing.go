@@ -99,7 +99,10 @@ func FinishAnySpan(span opentracing.Span, kvs ...interface{}) {
 }
 
 // InstallJaegerTracerFromEnv installs a Jaeger client as the opentracing global
-// tracer, relying on environment variables to configure the client
+// tracer, relying on environment variables to configure the client. The
+// tracer reports spans to a Jaeger collector, to an OTLP collector (if
+// OTEL_EXPORTER_OTLP_ENDPOINT or OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set), or
+// to both. It returns the Jaeger endpoint, if any.
 func InstallJaegerTracerFromEnv() string {
 	jaegerOnce.Do(func() {
 		var onUserMachine bool
@@ -110,14 +113,11 @@ func InstallJaegerTracerFromEnv() string {
 				jaegerEndpoint = fmt.Sprintf("%s:%s", host, port)
 			}
 		}
-		if jaegerEndpoint == "" {
-			return // break early -- not using Jaeger
+		otlpEndpoint := otlpEndpointFromEnv()
+		if jaegerEndpoint == "" && otlpEndpoint == "" {
+			return // break early -- not using Jaeger or OTLP
 		}
 
-		// canonicalize jaegerEndpoint as http://<hostport>/api/traces
-		jaegerEndpoint = strings.TrimPrefix(jaegerEndpoint, "http://")
-		jaegerEndpoint = strings.TrimSuffix(jaegerEndpoint, "/api/traces")
-		jaegerEndpoint = fmt.Sprintf("http://%s/api/traces", jaegerEndpoint)
 		cfg := jaegercfg.Configuration{
 			ServiceName: JaegerServiceName,
 			// Configure Jaeger to sample every call, but use the SpanInclusionFunc
@@ -127,11 +127,6 @@ func InstallJaegerTracerFromEnv() string {
 				Type:  "const",
 				Param: 1,
 			},
-			Reporter: &jaegercfg.ReporterConfig{
-				LogSpans:            true,
-				BufferFlushInterval: 1 * time.Second,
-				CollectorEndpoint:   jaegerEndpoint,
-			},
 		}
 
 		// configure jaeger logger
@@ -140,15 +135,40 @@ func InstallJaegerTracerFromEnv() string {
 			logger = jaeger.StdLogger
 		}
 
+		var reporters []jaeger.Reporter
+		if jaegerEndpoint != "" {
+			// canonicalize jaegerEndpoint as http://<hostport>/api/traces
+			jaegerEndpoint = strings.TrimPrefix(jaegerEndpoint, "http://")
+			jaegerEndpoint = strings.TrimSuffix(jaegerEndpoint, "/api/traces")
+			jaegerEndpoint = fmt.Sprintf("http://%s/api/traces", jaegerEndpoint)
+			reporterCfg := &jaegercfg.ReporterConfig{
+				LogSpans:            true,
+				BufferFlushInterval: 1 * time.Second,
+				CollectorEndpoint:   jaegerEndpoint,
+			}
+			reporter, err := reporterCfg.NewReporter(JaegerServiceName, jaeger.NewNullMetrics(), logger)
+			if err != nil {
+				log.Errorf("jaeger-collector service is deployed, but Pachyderm could not create a Jaeger reporter: %v", err)
+				return
+			}
+			reporters = append(reporters, reporter)
+		}
+		if otlpEndpoint != "" {
+			reporters = append(reporters, newOTLPReporter(otlpEndpoint, logger))
+		}
+
 		// Hack: ignore second argument (io.Closer) because the Jaeger
 		// implementation of opentracing.Tracer also implements io.Closer (i.e. the
 		// first and second return values from cfg.New(), here, are two interfaces
 		// that wrap the same underlying type). Instead of storing the second return
 		// value here, just cast the tracer to io.Closer in CloseAndReportTraces()
 		// (below) and call 'Close()' on it there.
-		tracer, _, err := cfg.NewTracer(jaegercfg.Logger(logger))
+		tracer, _, err := cfg.NewTracer(
+			jaegercfg.Logger(logger),
+			jaegercfg.Reporter(jaeger.NewCompositeReporter(reporters...)),
+		)
 		if err != nil {
-			log.Errorf("jaeger-collector service is deployed, but Pachyderm could not install Jaeger tracer: %v", err)
+			log.Errorf("tracing is configured, but Pachyderm could not install Jaeger tracer: %v", err)
 			return
 		}
 		opentracing.SetGlobalTracer(tracer)
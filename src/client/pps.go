@@ -65,6 +65,18 @@ const (
 	OutputCommitIDEnv = "PACH_OUTPUT_COMMIT_ID"
 	// PeerPortEnv is the env var that sets a custom peer port
 	PeerPortEnv = "PEER_PORT"
+	// VaultAddressEnv is the env var that sets the address of the Vault server
+	// that workers read Vault-backed pipeline secrets from
+	VaultAddressEnv = "VAULT_ADDR"
+	// VaultAuthRolePrefixEnv is the env var that sets the prefix of the Vault
+	// roles that workers log in as (using their Kubernetes service account
+	// token). Each pipeline's workers log in as the prefix followed by the
+	// pipeline's name, so that each pipeline can only read the secrets that
+	// its own role's policies allow.
+	VaultAuthRolePrefixEnv = "VAULT_AUTH_ROLE_PREFIX"
+	// VaultAuthPathEnv is the env var that sets the mount path of the
+	// Kubernetes auth method in Vault
+	VaultAuthPathEnv = "VAULT_AUTH_PATH"
 )
 
 // NewJob creates a pps.Job.
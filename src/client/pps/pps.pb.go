@@ -73,11 +73,12 @@ func (JobState) EnumDescriptor() ([]byte, []int) {
 type DatumState int32
 
 const (
-	DatumState_FAILED    DatumState = 0
-	DatumState_SUCCESS   DatumState = 1
-	DatumState_SKIPPED   DatumState = 2
-	DatumState_STARTING  DatumState = 3
-	DatumState_RECOVERED DatumState = 4
+	DatumState_FAILED      DatumState = 0
+	DatumState_SUCCESS     DatumState = 1
+	DatumState_SKIPPED     DatumState = 2
+	DatumState_STARTING    DatumState = 3
+	DatumState_RECOVERED   DatumState = 4
+	DatumState_QUARANTINED DatumState = 5
 )
 
 var DatumState_name = map[int32]string{
@@ -86,14 +87,16 @@ var DatumState_name = map[int32]string{
 	2: "SKIPPED",
 	3: "STARTING",
 	4: "RECOVERED",
+	5: "QUARANTINED",
 }
 
 var DatumState_value = map[string]int32{
-	"FAILED":    0,
-	"SUCCESS":   1,
-	"SKIPPED":   2,
-	"STARTING":  3,
-	"RECOVERED": 4,
+	"FAILED":      0,
+	"SUCCESS":     1,
+	"SKIPPED":     2,
+	"STARTING":    3,
+	"RECOVERED":   4,
+	"QUARANTINED": 5,
 }
 
 func (x DatumState) String() string {
@@ -188,13 +191,105 @@ func (PipelineState) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_dbf57f97f56369c0, []int{3}
 }
 
+type SQLEgress_FileFormat int32
+
+const (
+	// CSV files, whose first record holds the column names.
+	SQLEgress_CSV SQLEgress_FileFormat = 0
+	// JSON files containing a stream of objects, one row per object.
+	SQLEgress_JSON SQLEgress_FileFormat = 1
+	// pg_dump files containing a single COPY statement (PostgreSQL only).
+	SQLEgress_PGDUMP SQLEgress_FileFormat = 2
+)
+
+var SQLEgress_FileFormat_name = map[int32]string{
+	0: "CSV",
+	1: "JSON",
+	2: "PGDUMP",
+}
+
+var SQLEgress_FileFormat_value = map[string]int32{
+	"CSV":    0,
+	"JSON":   1,
+	"PGDUMP": 2,
+}
+
+func (x SQLEgress_FileFormat) String() string {
+	return proto.EnumName(SQLEgress_FileFormat_name, int32(x))
+}
+
+func (SQLEgress_FileFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{5, 0}
+}
+
+type IngestSpoutSource_Protocol int32
+
+const (
+	// Each POST request is a message. It is written to the request's path,
+	// or to a random file name if the path is "/". The response is sent once
+	// the message is committed.
+	IngestSpoutSource_HTTP IngestSpoutSource_Protocol = 0
+	// Each line received on a connection is a message, written to a file with
+	// a random name.
+	IngestSpoutSource_TCP IngestSpoutSource_Protocol = 1
+)
+
+var IngestSpoutSource_Protocol_name = map[int32]string{
+	0: "HTTP",
+	1: "TCP",
+}
+
+var IngestSpoutSource_Protocol_value = map[string]int32{
+	"HTTP": 0,
+	"TCP":  1,
+}
+
+func (x IngestSpoutSource_Protocol) String() string {
+	return proto.EnumName(IngestSpoutSource_Protocol_name, int32(x))
+}
+
+func (IngestSpoutSource_Protocol) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{12, 0}
+}
+
+type ListUsageRequest_GroupBy int32
+
+const (
+	ListUsageRequest_PIPELINE ListUsageRequest_GroupBy = 0
+	// Group by the values of label_key in the pipelines' metadata.
+	ListUsageRequest_LABEL ListUsageRequest_GroupBy = 1
+)
+
+var ListUsageRequest_GroupBy_name = map[int32]string{
+	0: "PIPELINE",
+	1: "LABEL",
+}
+
+var ListUsageRequest_GroupBy_value = map[string]int32{
+	"PIPELINE": 0,
+	"LABEL":    1,
+}
+
+func (x ListUsageRequest_GroupBy) String() string {
+	return proto.EnumName(ListUsageRequest_GroupBy_name, int32(x))
+}
+
+func (ListUsageRequest_GroupBy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{44, 0}
+}
+
 type SecretMount struct {
 	// Name must be the name of the secret in kubernetes.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// Key of the secret to load into env_var, this field only has meaning if EnvVar != "".
-	Key                  string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
-	MountPath            string   `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
-	EnvVar               string   `protobuf:"bytes,3,opt,name=env_var,json=envVar,proto3" json:"env_var,omitempty"`
+	Key       string `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	MountPath string `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	EnvVar    string `protobuf:"bytes,3,opt,name=env_var,json=envVar,proto3" json:"env_var,omitempty"`
+	// vault_path, if set, is a path in Vault (e.g. "pachyderm/creds/my-role" or
+	// "secret/data/db") that the worker reads the secret from before processing
+	// each datum, renewing or re-reading it as its lease runs out. If set, name
+	// must be empty.
+	VaultPath            string   `protobuf:"bytes,5,opt,name=vault_path,json=vaultPath,proto3" json:"vault_path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -261,6 +356,13 @@ func (m *SecretMount) GetEnvVar() string {
 	return ""
 }
 
+func (m *SecretMount) GetVaultPath() string {
+	if m != nil {
+		return m.VaultPath
+	}
+	return ""
+}
+
 type Transform struct {
 	Image                string            `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
 	Cmd                  []string          `protobuf:"bytes,2,rep,name=cmd,proto3" json:"cmd,omitempty"`
@@ -525,11 +627,17 @@ func (m *TFJob) GetTFJob() string {
 	return ""
 }
 
+// Egress describes where the output commit of a job is written once the job
+// has finished. Exactly one of URL, sql_database or http should be set.
 type Egress struct {
-	URL                  string   `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// URL is an object storage URL (e.g. s3://bucket/dir) that the output
+	// commit is copied to.
+	URL                  string      `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	SqlDatabase          *SQLEgress  `protobuf:"bytes,2,opt,name=sql_database,json=sqlDatabase,proto3" json:"sql_database,omitempty"`
+	Http                 *HTTPEgress `protobuf:"bytes,3,opt,name=http,proto3" json:"http,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Egress) Reset()         { *m = Egress{} }
@@ -572,6 +680,158 @@ func (m *Egress) GetURL() string {
 	return ""
 }
 
+func (m *Egress) GetSqlDatabase() *SQLEgress {
+	if m != nil {
+		return m.SqlDatabase
+	}
+	return nil
+}
+
+func (m *Egress) GetHttp() *HTTPEgress {
+	if m != nil {
+		return m.Http
+	}
+	return nil
+}
+
+// SQLEgress loads the files in the output commit into tables of a PostgreSQL
+// or MySQL database.
+type SQLEgress struct {
+	// url is the database to connect to, e.g.
+	// postgres://user@host:5432/db?sslmode=disable or mysql://user@host:3306/db
+	Url        string               `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	FileFormat SQLEgress_FileFormat `protobuf:"varint,2,opt,name=file_format,json=fileFormat,proto3,enum=pps.SQLEgress_FileFormat" json:"file_format,omitempty"`
+	// table is the table that every file is loaded into. If unset, each file is
+	// loaded into the table named by the first component of its path, minus
+	// any extension (so both /users.csv and /users/0.csv go into "users").
+	Table string `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+	// password_env_var is the name of an environment variable (typically set
+	// from a secret in the pipeline's transform) holding the database password.
+	PasswordEnvVar       string   `protobuf:"bytes,4,opt,name=password_env_var,json=passwordEnvVar,proto3" json:"password_env_var,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SQLEgress) Reset()         { *m = SQLEgress{} }
+func (m *SQLEgress) String() string { return proto.CompactTextString(m) }
+func (*SQLEgress) ProtoMessage()    {}
+func (*SQLEgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{5}
+}
+func (m *SQLEgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SQLEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SQLEgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SQLEgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SQLEgress.Merge(m, src)
+}
+func (m *SQLEgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *SQLEgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_SQLEgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SQLEgress proto.InternalMessageInfo
+
+func (m *SQLEgress) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SQLEgress) GetFileFormat() SQLEgress_FileFormat {
+	if m != nil {
+		return m.FileFormat
+	}
+	return SQLEgress_CSV
+}
+
+func (m *SQLEgress) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *SQLEgress) GetPasswordEnvVar() string {
+	if m != nil {
+		return m.PasswordEnvVar
+	}
+	return ""
+}
+
+// HTTPEgress POSTs every file in the output commit to a URL.
+type HTTPEgress struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// headers are added to every request. The path of the file is sent in the
+	// Pach-File-Path header.
+	Headers              map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *HTTPEgress) Reset()         { *m = HTTPEgress{} }
+func (m *HTTPEgress) String() string { return proto.CompactTextString(m) }
+func (*HTTPEgress) ProtoMessage()    {}
+func (*HTTPEgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{6}
+}
+func (m *HTTPEgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPEgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HTTPEgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HTTPEgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPEgress.Merge(m, src)
+}
+func (m *HTTPEgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPEgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPEgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPEgress proto.InternalMessageInfo
+
+func (m *HTTPEgress) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *HTTPEgress) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
 type Job struct {
 	ID                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -583,7 +843,7 @@ func (m *Job) Reset()         { *m = Job{} }
 func (m *Job) String() string { return proto.CompactTextString(m) }
 func (*Job) ProtoMessage()    {}
 func (*Job) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{5}
+	return fileDescriptor_dbf57f97f56369c0, []int{7}
 }
 func (m *Job) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,7 +891,7 @@ func (m *Metadata) Reset()         { *m = Metadata{} }
 func (m *Metadata) String() string { return proto.CompactTextString(m) }
 func (*Metadata) ProtoMessage()    {}
 func (*Metadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{6}
+	return fileDescriptor_dbf57f97f56369c0, []int{8}
 }
 func (m *Metadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -688,7 +948,7 @@ func (m *Service) Reset()         { *m = Service{} }
 func (m *Service) String() string { return proto.CompactTextString(m) }
 func (*Service) ProtoMessage()    {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{7}
+	return fileDescriptor_dbf57f97f56369c0, []int{9}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -746,19 +1006,27 @@ func (m *Service) GetType() string {
 }
 
 type Spout struct {
-	Overwrite            bool     `protobuf:"varint,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	Service              *Service `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	Marker               string   `protobuf:"bytes,3,opt,name=marker,proto3" json:"marker,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Overwrite bool     `protobuf:"varint,1,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Service   *Service `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Marker    string   `protobuf:"bytes,3,opt,name=marker,proto3" json:"marker,omitempty"`
+	// Built-in sources. If one is set, the worker reads the source itself and no
+	// user code is run. Offsets are checkpointed in the marker, which defaults to
+	// "checkpoint".
+	Kafka  *KafkaSpoutSource  `protobuf:"bytes,4,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	Ingest *IngestSpoutSource `protobuf:"bytes,5,opt,name=ingest,proto3" json:"ingest,omitempty"`
+	// batch controls how messages from a built-in source are grouped into
+	// output commits.
+	Batch                *SpoutBatch `protobuf:"bytes,6,opt,name=batch,proto3" json:"batch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
 func (m *Spout) Reset()         { *m = Spout{} }
 func (m *Spout) String() string { return proto.CompactTextString(m) }
 func (*Spout) ProtoMessage()    {}
 func (*Spout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{8}
+	return fileDescriptor_dbf57f97f56369c0, []int{10}
 }
 func (m *Spout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -808,44 +1076,49 @@ func (m *Spout) GetMarker() string {
 	return ""
 }
 
-type PFSInput struct {
-	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
-	Commit string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
-	Glob   string `protobuf:"bytes,5,opt,name=glob,proto3" json:"glob,omitempty"`
-	JoinOn string `protobuf:"bytes,8,opt,name=join_on,json=joinOn,proto3" json:"join_on,omitempty"`
-	Lazy   bool   `protobuf:"varint,6,opt,name=lazy,proto3" json:"lazy,omitempty"`
-	// EmptyFiles, if true, will cause files from this PFS input to be
-	// presented as empty files. This is useful in shuffle pipelines where you
-	// want to read the names of files and reorganize them using symlinks.
-	EmptyFiles bool `protobuf:"varint,7,opt,name=empty_files,json=emptyFiles,proto3" json:"empty_files,omitempty"`
-	// S3, if true, will cause the worker to NOT download or link files from this
-	// input into the /pfs directory. Instead, an instance of our S3 gateway
-	// service will run on each of the sidecars, and data can be retrieved from
-	// this input by querying
-	// http://<pipeline>-s3.<namespace>/<job id>.<input>/my/file
-	S3 bool `protobuf:"varint,9,opt,name=s3,proto3" json:"s3,omitempty"`
-	// Trigger defines when this input is processed by the pipeline, if it's nil
-	// the input is processed anytime something is committed to the input branch.
-	Trigger              *pfs.Trigger `protobuf:"bytes,10,opt,name=trigger,proto3" json:"trigger,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *Spout) GetKafka() *KafkaSpoutSource {
+	if m != nil {
+		return m.Kafka
+	}
+	return nil
 }
 
-func (m *PFSInput) Reset()         { *m = PFSInput{} }
-func (m *PFSInput) String() string { return proto.CompactTextString(m) }
-func (*PFSInput) ProtoMessage()    {}
-func (*PFSInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{9}
+func (m *Spout) GetIngest() *IngestSpoutSource {
+	if m != nil {
+		return m.Ingest
+	}
+	return nil
 }
-func (m *PFSInput) XXX_Unmarshal(b []byte) error {
+
+func (m *Spout) GetBatch() *SpoutBatch {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
+// KafkaSpoutSource reads every partition of a Kafka topic. Each message is
+// written to the file /<topic>/<partition>-<offset>.
+type KafkaSpoutSource struct {
+	Brokers              []string `protobuf:"bytes,1,rep,name=brokers,proto3" json:"brokers,omitempty"`
+	Topic                string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KafkaSpoutSource) Reset()         { *m = KafkaSpoutSource{} }
+func (m *KafkaSpoutSource) String() string { return proto.CompactTextString(m) }
+func (*KafkaSpoutSource) ProtoMessage()    {}
+func (*KafkaSpoutSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{11}
+}
+func (m *KafkaSpoutSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PFSInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KafkaSpoutSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PFSInput.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KafkaSpoutSource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -855,68 +1128,257 @@ func (m *PFSInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PFSInput) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PFSInput.Merge(m, src)
+func (m *KafkaSpoutSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaSpoutSource.Merge(m, src)
 }
-func (m *PFSInput) XXX_Size() int {
+func (m *KafkaSpoutSource) XXX_Size() int {
 	return m.Size()
 }
-func (m *PFSInput) XXX_DiscardUnknown() {
-	xxx_messageInfo_PFSInput.DiscardUnknown(m)
+func (m *KafkaSpoutSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaSpoutSource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PFSInput proto.InternalMessageInfo
+var xxx_messageInfo_KafkaSpoutSource proto.InternalMessageInfo
 
-func (m *PFSInput) GetName() string {
+func (m *KafkaSpoutSource) GetBrokers() []string {
 	if m != nil {
-		return m.Name
+		return m.Brokers
 	}
-	return ""
+	return nil
 }
 
-func (m *PFSInput) GetRepo() string {
+func (m *KafkaSpoutSource) GetTopic() string {
 	if m != nil {
-		return m.Repo
+		return m.Topic
 	}
 	return ""
 }
 
-func (m *PFSInput) GetBranch() string {
-	if m != nil {
-		return m.Branch
-	}
-	return ""
+// IngestSpoutSource listens on spout.service.internal_port for data pushed
+// to the pipeline.
+type IngestSpoutSource struct {
+	Protocol             IngestSpoutSource_Protocol `protobuf:"varint,1,opt,name=protocol,proto3,enum=pps.IngestSpoutSource_Protocol" json:"protocol,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (m *PFSInput) GetCommit() string {
-	if m != nil {
-		return m.Commit
-	}
-	return ""
+func (m *IngestSpoutSource) Reset()         { *m = IngestSpoutSource{} }
+func (m *IngestSpoutSource) String() string { return proto.CompactTextString(m) }
+func (*IngestSpoutSource) ProtoMessage()    {}
+func (*IngestSpoutSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{12}
 }
-
-func (m *PFSInput) GetGlob() string {
-	if m != nil {
-		return m.Glob
+func (m *IngestSpoutSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IngestSpoutSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IngestSpoutSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *IngestSpoutSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngestSpoutSource.Merge(m, src)
+}
+func (m *IngestSpoutSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *IngestSpoutSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngestSpoutSource.DiscardUnknown(m)
 }
 
-func (m *PFSInput) GetJoinOn() string {
+var xxx_messageInfo_IngestSpoutSource proto.InternalMessageInfo
+
+func (m *IngestSpoutSource) GetProtocol() IngestSpoutSource_Protocol {
 	if m != nil {
-		return m.JoinOn
+		return m.Protocol
 	}
-	return ""
+	return IngestSpoutSource_HTTP
 }
 
-func (m *PFSInput) GetLazy() bool {
-	if m != nil {
-		return m.Lazy
-	}
-	return false
+// SpoutBatch finishes the current output commit of a built-in spout as soon
+// as any of its limits is reached.
+type SpoutBatch struct {
+	// max_messages defaults to 1000.
+	MaxMessages int64 `protobuf:"varint,1,opt,name=max_messages,json=maxMessages,proto3" json:"max_messages,omitempty"`
+	MaxBytes    int64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// max_delay defaults to 10 seconds.
+	MaxDelay             *types.Duration `protobuf:"bytes,3,opt,name=max_delay,json=maxDelay,proto3" json:"max_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *PFSInput) GetEmptyFiles() bool {
+func (m *SpoutBatch) Reset()         { *m = SpoutBatch{} }
+func (m *SpoutBatch) String() string { return proto.CompactTextString(m) }
+func (*SpoutBatch) ProtoMessage()    {}
+func (*SpoutBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{13}
+}
+func (m *SpoutBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpoutBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpoutBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SpoutBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpoutBatch.Merge(m, src)
+}
+func (m *SpoutBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpoutBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpoutBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpoutBatch proto.InternalMessageInfo
+
+func (m *SpoutBatch) GetMaxMessages() int64 {
+	if m != nil {
+		return m.MaxMessages
+	}
+	return 0
+}
+
+func (m *SpoutBatch) GetMaxBytes() int64 {
+	if m != nil {
+		return m.MaxBytes
+	}
+	return 0
+}
+
+func (m *SpoutBatch) GetMaxDelay() *types.Duration {
+	if m != nil {
+		return m.MaxDelay
+	}
+	return nil
+}
+
+type PFSInput struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Repo   string `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Branch string `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
+	Commit string `protobuf:"bytes,4,opt,name=commit,proto3" json:"commit,omitempty"`
+	Glob   string `protobuf:"bytes,5,opt,name=glob,proto3" json:"glob,omitempty"`
+	JoinOn string `protobuf:"bytes,8,opt,name=join_on,json=joinOn,proto3" json:"join_on,omitempty"`
+	Lazy   bool   `protobuf:"varint,6,opt,name=lazy,proto3" json:"lazy,omitempty"`
+	// EmptyFiles, if true, will cause files from this PFS input to be
+	// presented as empty files. This is useful in shuffle pipelines where you
+	// want to read the names of files and reorganize them using symlinks.
+	EmptyFiles bool `protobuf:"varint,7,opt,name=empty_files,json=emptyFiles,proto3" json:"empty_files,omitempty"`
+	// S3, if true, will cause the worker to NOT download or link files from this
+	// input into the /pfs directory. Instead, an instance of our S3 gateway
+	// service will run on each of the sidecars, and data can be retrieved from
+	// this input by querying
+	// http://<pipeline>-s3.<namespace>/<job id>.<input>/my/file
+	S3 bool `protobuf:"varint,9,opt,name=s3,proto3" json:"s3,omitempty"`
+	// Trigger defines when this input is processed by the pipeline, if it's nil
+	// the input is processed anytime something is committed to the input branch.
+	Trigger              *pfs.Trigger `protobuf:"bytes,10,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PFSInput) Reset()         { *m = PFSInput{} }
+func (m *PFSInput) String() string { return proto.CompactTextString(m) }
+func (*PFSInput) ProtoMessage()    {}
+func (*PFSInput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{14}
+}
+func (m *PFSInput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PFSInput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PFSInput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PFSInput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PFSInput.Merge(m, src)
+}
+func (m *PFSInput) XXX_Size() int {
+	return m.Size()
+}
+func (m *PFSInput) XXX_DiscardUnknown() {
+	xxx_messageInfo_PFSInput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PFSInput proto.InternalMessageInfo
+
+func (m *PFSInput) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PFSInput) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *PFSInput) GetBranch() string {
+	if m != nil {
+		return m.Branch
+	}
+	return ""
+}
+
+func (m *PFSInput) GetCommit() string {
+	if m != nil {
+		return m.Commit
+	}
+	return ""
+}
+
+func (m *PFSInput) GetGlob() string {
+	if m != nil {
+		return m.Glob
+	}
+	return ""
+}
+
+func (m *PFSInput) GetJoinOn() string {
+	if m != nil {
+		return m.JoinOn
+	}
+	return ""
+}
+
+func (m *PFSInput) GetLazy() bool {
+	if m != nil {
+		return m.Lazy
+	}
+	return false
+}
+
+func (m *PFSInput) GetEmptyFiles() bool {
 	if m != nil {
 		return m.EmptyFiles
 	}
@@ -955,7 +1417,7 @@ func (m *CronInput) Reset()         { *m = CronInput{} }
 func (m *CronInput) String() string { return proto.CompactTextString(m) }
 func (*CronInput) ProtoMessage()    {}
 func (*CronInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{10}
+	return fileDescriptor_dbf57f97f56369c0, []int{15}
 }
 func (m *CronInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1502,7 @@ func (m *GitInput) Reset()         { *m = GitInput{} }
 func (m *GitInput) String() string { return proto.CompactTextString(m) }
 func (*GitInput) ProtoMessage()    {}
 func (*GitInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{11}
+	return fileDescriptor_dbf57f97f56369c0, []int{16}
 }
 func (m *GitInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1113,7 +1575,7 @@ func (m *Input) Reset()         { *m = Input{} }
 func (m *Input) String() string { return proto.CompactTextString(m) }
 func (*Input) ProtoMessage()    {}
 func (*Input) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{12}
+	return fileDescriptor_dbf57f97f56369c0, []int{17}
 }
 func (m *Input) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1660,7 @@ func (m *JobInput) Reset()         { *m = JobInput{} }
 func (m *JobInput) String() string { return proto.CompactTextString(m) }
 func (*JobInput) ProtoMessage()    {}
 func (*JobInput) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{13}
+	return fileDescriptor_dbf57f97f56369c0, []int{18}
 }
 func (m *JobInput) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1741,7 @@ func (m *ParallelismSpec) Reset()         { *m = ParallelismSpec{} }
 func (m *ParallelismSpec) String() string { return proto.CompactTextString(m) }
 func (*ParallelismSpec) ProtoMessage()    {}
 func (*ParallelismSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{14}
+	return fileDescriptor_dbf57f97f56369c0, []int{19}
 }
 func (m *ParallelismSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1335,7 +1797,7 @@ func (m *HashtreeSpec) Reset()         { *m = HashtreeSpec{} }
 func (m *HashtreeSpec) String() string { return proto.CompactTextString(m) }
 func (*HashtreeSpec) ProtoMessage()    {}
 func (*HashtreeSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{15}
+	return fileDescriptor_dbf57f97f56369c0, []int{20}
 }
 func (m *HashtreeSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1385,7 +1847,7 @@ func (m *InputFile) Reset()         { *m = InputFile{} }
 func (m *InputFile) String() string { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()    {}
 func (*InputFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{16}
+	return fileDescriptor_dbf57f97f56369c0, []int{21}
 }
 func (m *InputFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1441,7 +1903,7 @@ func (m *Datum) Reset()         { *m = Datum{} }
 func (m *Datum) String() string { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()    {}
 func (*Datum) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{17}
+	return fileDescriptor_dbf57f97f56369c0, []int{22}
 }
 func (m *Datum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,7 +1961,7 @@ func (m *DatumInfo) Reset()         { *m = DatumInfo{} }
 func (m *DatumInfo) String() string { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()    {}
 func (*DatumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{18}
+	return fileDescriptor_dbf57f97f56369c0, []int{23}
 }
 func (m *DatumInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1578,7 +2040,7 @@ func (m *Aggregate) Reset()         { *m = Aggregate{} }
 func (m *Aggregate) String() string { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()    {}
 func (*Aggregate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{19}
+	return fileDescriptor_dbf57f97f56369c0, []int{24}
 }
 func (m *Aggregate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1643,21 +2105,25 @@ func (m *Aggregate) GetNinetyFifthPercentile() float64 {
 }
 
 type ProcessStats struct {
-	DownloadTime         *types.Duration `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
-	ProcessTime          *types.Duration `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
-	UploadTime           *types.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
-	DownloadBytes        uint64          `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
-	UploadBytes          uint64          `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	DownloadTime  *types.Duration `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
+	ProcessTime   *types.Duration `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
+	UploadTime    *types.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime,proto3" json:"upload_time,omitempty"`
+	DownloadBytes uint64          `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	UploadBytes   uint64          `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	// CPU time (user + system) used by the user code
+	CpuSeconds float64 `protobuf:"fixed64,6,opt,name=cpu_seconds,json=cpuSeconds,proto3" json:"cpu_seconds,omitempty"`
+	// Peak memory of the user code multiplied by its running time
+	MemoryByteSeconds    float64  `protobuf:"fixed64,7,opt,name=memory_byte_seconds,json=memoryByteSeconds,proto3" json:"memory_byte_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ProcessStats) Reset()         { *m = ProcessStats{} }
 func (m *ProcessStats) String() string { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()    {}
 func (*ProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{20}
+	return fileDescriptor_dbf57f97f56369c0, []int{25}
 }
 func (m *ProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1721,6 +2187,20 @@ func (m *ProcessStats) GetUploadBytes() uint64 {
 	return 0
 }
 
+func (m *ProcessStats) GetCpuSeconds() float64 {
+	if m != nil {
+		return m.CpuSeconds
+	}
+	return 0
+}
+
+func (m *ProcessStats) GetMemoryByteSeconds() float64 {
+	if m != nil {
+		return m.MemoryByteSeconds
+	}
+	return 0
+}
+
 type AggregateProcessStats struct {
 	DownloadTime         *Aggregate `protobuf:"bytes,1,opt,name=download_time,json=downloadTime,proto3" json:"download_time,omitempty"`
 	ProcessTime          *Aggregate `protobuf:"bytes,2,opt,name=process_time,json=processTime,proto3" json:"process_time,omitempty"`
@@ -1736,7 +2216,7 @@ func (m *AggregateProcessStats) Reset()         { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()    {}
 func (*AggregateProcessStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{21}
+	return fileDescriptor_dbf57f97f56369c0, []int{26}
 }
 func (m *AggregateProcessStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1819,7 +2299,7 @@ func (m *WorkerStatus) Reset()         { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()    {}
 func (*WorkerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{22}
+	return fileDescriptor_dbf57f97f56369c0, []int{27}
 }
 func (m *WorkerStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1927,7 +2407,7 @@ func (m *ResourceSpec) Reset()         { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()    {}
 func (*ResourceSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{23}
+	return fileDescriptor_dbf57f97f56369c0, []int{28}
 }
 func (m *ResourceSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1998,7 +2478,7 @@ func (m *GPUSpec) Reset()         { *m = GPUSpec{} }
 func (m *GPUSpec) String() string { return proto.CompactTextString(m) }
 func (*GPUSpec) ProtoMessage()    {}
 func (*GPUSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{24}
+	return fileDescriptor_dbf57f97f56369c0, []int{29}
 }
 func (m *GPUSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2041,45 +2521,42 @@ func (m *GPUSpec) GetNumber() int64 {
 	return 0
 }
 
-// EtcdJobInfo is the portion of the JobInfo that gets stored in etcd during
-// job execution. It contains fields which change over the lifetime of the job
-// but aren't used in the execution of the job.
-type EtcdJobInfo struct {
-	Job          *Job        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
-	Pipeline     *Pipeline   `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
-	OutputCommit *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
-	// Job restart count (e.g. due to datum failure)
-	Restart uint64 `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
-	// Counts of how many times we processed or skipped a datum
-	DataProcessed int64 `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped   int64 `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataTotal     int64 `protobuf:"varint,7,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	DataFailed    int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
-	DataRecovered int64 `protobuf:"varint,15,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
-	// Download/process/upload time and download/upload bytes
-	Stats                *ProcessStats    `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
-	StatsCommit          *pfs.Commit      `protobuf:"bytes,10,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
-	State                JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
-	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
-	Started              *types.Timestamp `protobuf:"bytes,13,opt,name=started,proto3" json:"started,omitempty"`
-	Finished             *types.Timestamp `protobuf:"bytes,14,opt,name=finished,proto3" json:"finished,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+// ResourceUsage is the resources used by a set of finished jobs, for
+// chargeback.
+type ResourceUsage struct {
+	// group is the pipeline name or label value that the usage is grouped by,
+	// if any.
+	Group string `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Jobs  int64  `protobuf:"varint,2,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	// cpu_seconds is the CPU time (user + system) used by the jobs' user code.
+	CpuSeconds float64 `protobuf:"fixed64,3,opt,name=cpu_seconds,json=cpuSeconds,proto3" json:"cpu_seconds,omitempty"`
+	// memory_byte_seconds approximates the memory used by the jobs' user code:
+	// each run of the user code counts its peak resident memory (maxrss) for
+	// its whole running time, so this overestimates the usage of code whose
+	// memory use varies.
+	MemoryByteSeconds    float64  `protobuf:"fixed64,4,opt,name=memory_byte_seconds,json=memoryByteSeconds,proto3" json:"memory_byte_seconds,omitempty"`
+	DownloadBytes        uint64   `protobuf:"varint,5,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
+	UploadBytes          uint64   `protobuf:"varint,6,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	DataProcessed        int64    `protobuf:"varint,7,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped          int64    `protobuf:"varint,8,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataFailed           int64    `protobuf:"varint,9,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EtcdJobInfo) Reset()         { *m = EtcdJobInfo{} }
-func (m *EtcdJobInfo) String() string { return proto.CompactTextString(m) }
-func (*EtcdJobInfo) ProtoMessage()    {}
-func (*EtcdJobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{25}
+func (m *ResourceUsage) Reset()         { *m = ResourceUsage{} }
+func (m *ResourceUsage) String() string { return proto.CompactTextString(m) }
+func (*ResourceUsage) ProtoMessage()    {}
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{30}
 }
-func (m *EtcdJobInfo) XXX_Unmarshal(b []byte) error {
+func (m *ResourceUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EtcdJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EtcdJobInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ResourceUsage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2089,77 +2566,208 @@ func (m *EtcdJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EtcdJobInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EtcdJobInfo.Merge(m, src)
+func (m *ResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResourceUsage.Merge(m, src)
 }
-func (m *EtcdJobInfo) XXX_Size() int {
+func (m *ResourceUsage) XXX_Size() int {
 	return m.Size()
 }
-func (m *EtcdJobInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_EtcdJobInfo.DiscardUnknown(m)
+func (m *ResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResourceUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EtcdJobInfo proto.InternalMessageInfo
+var xxx_messageInfo_ResourceUsage proto.InternalMessageInfo
 
-func (m *EtcdJobInfo) GetJob() *Job {
+func (m *ResourceUsage) GetGroup() string {
 	if m != nil {
-		return m.Job
+		return m.Group
 	}
-	return nil
+	return ""
 }
 
-func (m *EtcdJobInfo) GetPipeline() *Pipeline {
+func (m *ResourceUsage) GetJobs() int64 {
 	if m != nil {
-		return m.Pipeline
+		return m.Jobs
 	}
-	return nil
+	return 0
 }
 
-func (m *EtcdJobInfo) GetOutputCommit() *pfs.Commit {
+func (m *ResourceUsage) GetCpuSeconds() float64 {
 	if m != nil {
-		return m.OutputCommit
+		return m.CpuSeconds
 	}
-	return nil
+	return 0
 }
 
-func (m *EtcdJobInfo) GetRestart() uint64 {
+func (m *ResourceUsage) GetMemoryByteSeconds() float64 {
 	if m != nil {
-		return m.Restart
+		return m.MemoryByteSeconds
 	}
 	return 0
 }
 
-func (m *EtcdJobInfo) GetDataProcessed() int64 {
+func (m *ResourceUsage) GetDownloadBytes() uint64 {
 	if m != nil {
-		return m.DataProcessed
+		return m.DownloadBytes
 	}
 	return 0
 }
 
-func (m *EtcdJobInfo) GetDataSkipped() int64 {
+func (m *ResourceUsage) GetUploadBytes() uint64 {
 	if m != nil {
-		return m.DataSkipped
+		return m.UploadBytes
 	}
 	return 0
 }
 
-func (m *EtcdJobInfo) GetDataTotal() int64 {
+func (m *ResourceUsage) GetDataProcessed() int64 {
 	if m != nil {
-		return m.DataTotal
+		return m.DataProcessed
 	}
 	return 0
 }
 
-func (m *EtcdJobInfo) GetDataFailed() int64 {
+func (m *ResourceUsage) GetDataSkipped() int64 {
 	if m != nil {
-		return m.DataFailed
+		return m.DataSkipped
 	}
 	return 0
 }
 
-func (m *EtcdJobInfo) GetDataRecovered() int64 {
+func (m *ResourceUsage) GetDataFailed() int64 {
 	if m != nil {
-		return m.DataRecovered
+		return m.DataFailed
+	}
+	return 0
+}
+
+// EtcdJobInfo is the portion of the JobInfo that gets stored in etcd during
+// job execution. It contains fields which change over the lifetime of the job
+// but aren't used in the execution of the job.
+type EtcdJobInfo struct {
+	Job          *Job        `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	Pipeline     *Pipeline   `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
+	OutputCommit *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
+	// Job restart count (e.g. due to datum failure)
+	Restart uint64 `protobuf:"varint,4,opt,name=restart,proto3" json:"restart,omitempty"`
+	// Counts of how many times we processed or skipped a datum
+	DataProcessed   int64 `protobuf:"varint,5,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped     int64 `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataTotal       int64 `protobuf:"varint,7,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	DataFailed      int64 `protobuf:"varint,8,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	DataRecovered   int64 `protobuf:"varint,15,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataQuarantined int64 `protobuf:"varint,16,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
+	// Download/process/upload time and download/upload bytes
+	Stats                *ProcessStats    `protobuf:"bytes,9,opt,name=stats,proto3" json:"stats,omitempty"`
+	StatsCommit          *pfs.Commit      `protobuf:"bytes,10,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
+	State                JobState         `protobuf:"varint,11,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
+	Reason               string           `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	Started              *types.Timestamp `protobuf:"bytes,13,opt,name=started,proto3" json:"started,omitempty"`
+	Finished             *types.Timestamp `protobuf:"bytes,14,opt,name=finished,proto3" json:"finished,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EtcdJobInfo) Reset()         { *m = EtcdJobInfo{} }
+func (m *EtcdJobInfo) String() string { return proto.CompactTextString(m) }
+func (*EtcdJobInfo) ProtoMessage()    {}
+func (*EtcdJobInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{31}
+}
+func (m *EtcdJobInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EtcdJobInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EtcdJobInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EtcdJobInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EtcdJobInfo.Merge(m, src)
+}
+func (m *EtcdJobInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EtcdJobInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EtcdJobInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EtcdJobInfo proto.InternalMessageInfo
+
+func (m *EtcdJobInfo) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *EtcdJobInfo) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *EtcdJobInfo) GetOutputCommit() *pfs.Commit {
+	if m != nil {
+		return m.OutputCommit
+	}
+	return nil
+}
+
+func (m *EtcdJobInfo) GetRestart() uint64 {
+	if m != nil {
+		return m.Restart
+	}
+	return 0
+}
+
+func (m *EtcdJobInfo) GetDataProcessed() int64 {
+	if m != nil {
+		return m.DataProcessed
+	}
+	return 0
+}
+
+func (m *EtcdJobInfo) GetDataSkipped() int64 {
+	if m != nil {
+		return m.DataSkipped
+	}
+	return 0
+}
+
+func (m *EtcdJobInfo) GetDataTotal() int64 {
+	if m != nil {
+		return m.DataTotal
+	}
+	return 0
+}
+
+func (m *EtcdJobInfo) GetDataFailed() int64 {
+	if m != nil {
+		return m.DataFailed
+	}
+	return 0
+}
+
+func (m *EtcdJobInfo) GetDataRecovered() int64 {
+	if m != nil {
+		return m.DataRecovered
+	}
+	return 0
+}
+
+func (m *EtcdJobInfo) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
 	}
 	return 0
 }
@@ -2229,6 +2837,7 @@ type JobInfo struct {
 	DataSkipped           int64            `protobuf:"varint,30,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
 	DataFailed            int64            `protobuf:"varint,40,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
 	DataRecovered         int64            `protobuf:"varint,46,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataQuarantined       int64            `protobuf:"varint,50,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
 	DataTotal             int64            `protobuf:"varint,23,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
 	Stats                 *ProcessStats    `protobuf:"bytes,31,opt,name=stats,proto3" json:"stats,omitempty"`
 	WorkerStatus          []*WorkerStatus  `protobuf:"bytes,24,rep,name=worker_status,json=workerStatus,proto3" json:"worker_status,omitempty"`
@@ -2244,9 +2853,11 @@ type JobInfo struct {
 	DatumTimeout          *types.Duration  `protobuf:"bytes,38,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
 	JobTimeout            *types.Duration  `protobuf:"bytes,39,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
 	DatumTries            int64            `protobuf:"varint,41,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	RetryPolicy           *RetryPolicy     `protobuf:"bytes,49,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 	SchedulingSpec        *SchedulingSpec  `protobuf:"bytes,42,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
 	PodSpec               string           `protobuf:"bytes,43,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
 	PodPatch              string           `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	Timeline              *JobTimeline     `protobuf:"bytes,51,opt,name=timeline,proto3" json:"timeline,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
 	XXX_unrecognized      []byte           `json:"-"`
 	XXX_sizecache         int32            `json:"-"`
@@ -2256,7 +2867,7 @@ func (m *JobInfo) Reset()         { *m = JobInfo{} }
 func (m *JobInfo) String() string { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()    {}
 func (*JobInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{26}
+	return fileDescriptor_dbf57f97f56369c0, []int{32}
 }
 func (m *JobInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2439,6 +3050,13 @@ func (m *JobInfo) GetDataRecovered() int64 {
 	return 0
 }
 
+func (m *JobInfo) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
 func (m *JobInfo) GetDataTotal() int64 {
 	if m != nil {
 		return m.DataTotal
@@ -2544,6 +3162,13 @@ func (m *JobInfo) GetDatumTries() int64 {
 	return 0
 }
 
+func (m *JobInfo) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 func (m *JobInfo) GetSchedulingSpec() *SchedulingSpec {
 	if m != nil {
 		return m.SchedulingSpec
@@ -2565,6 +3190,180 @@ func (m *JobInfo) GetPodPatch() string {
 	return ""
 }
 
+func (m *JobInfo) GetTimeline() *JobTimeline {
+	if m != nil {
+		return m.Timeline
+	}
+	return nil
+}
+
+// TimelineSpan is one step in a job's timeline: a step of the master (e.g.
+// planning or merging the job), a task run by a worker, or a phase of
+// processing a datum (downloading, processing or uploading).
+type TimelineSpan struct {
+	SpanID       string `protobuf:"bytes,1,opt,name=span_id,json=spanId,proto3" json:"span_id,omitempty"`
+	ParentSpanID string `protobuf:"bytes,2,opt,name=parent_span_id,json=parentSpanId,proto3" json:"parent_span_id,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// worker is the worker pod that ran the span, or empty for the steps of
+	// the job's master
+	Worker               string           `protobuf:"bytes,4,opt,name=worker,proto3" json:"worker,omitempty"`
+	DatumID              string           `protobuf:"bytes,5,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	Start                *types.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *types.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Error                string           `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TimelineSpan) Reset()         { *m = TimelineSpan{} }
+func (m *TimelineSpan) String() string { return proto.CompactTextString(m) }
+func (*TimelineSpan) ProtoMessage()    {}
+func (*TimelineSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{33}
+}
+func (m *TimelineSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimelineSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimelineSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimelineSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelineSpan.Merge(m, src)
+}
+func (m *TimelineSpan) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimelineSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelineSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimelineSpan proto.InternalMessageInfo
+
+func (m *TimelineSpan) GetSpanID() string {
+	if m != nil {
+		return m.SpanID
+	}
+	return ""
+}
+
+func (m *TimelineSpan) GetParentSpanID() string {
+	if m != nil {
+		return m.ParentSpanID
+	}
+	return ""
+}
+
+func (m *TimelineSpan) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TimelineSpan) GetWorker() string {
+	if m != nil {
+		return m.Worker
+	}
+	return ""
+}
+
+func (m *TimelineSpan) GetDatumID() string {
+	if m != nil {
+		return m.DatumID
+	}
+	return ""
+}
+
+func (m *TimelineSpan) GetStart() *types.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *TimelineSpan) GetEnd() *types.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *TimelineSpan) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// JobTimeline is the timeline of a job. The same spans are exported as a
+// trace (whose trace ID is the job ID) if tracing is configured.
+type JobTimeline struct {
+	Spans []*TimelineSpan `protobuf:"bytes,1,rep,name=spans,proto3" json:"spans,omitempty"`
+	// datum_spans_dropped is the number of datum spans that weren't kept, as
+	// only a sample of the datums in each task are recorded.
+	DatumSpansDropped    int64    `protobuf:"varint,2,opt,name=datum_spans_dropped,json=datumSpansDropped,proto3" json:"datum_spans_dropped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JobTimeline) Reset()         { *m = JobTimeline{} }
+func (m *JobTimeline) String() string { return proto.CompactTextString(m) }
+func (*JobTimeline) ProtoMessage()    {}
+func (*JobTimeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{34}
+}
+func (m *JobTimeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobTimeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobTimeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobTimeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTimeline.Merge(m, src)
+}
+func (m *JobTimeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTimeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTimeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobTimeline proto.InternalMessageInfo
+
+func (m *JobTimeline) GetSpans() []*TimelineSpan {
+	if m != nil {
+		return m.Spans
+	}
+	return nil
+}
+
+func (m *JobTimeline) GetDatumSpansDropped() int64 {
+	if m != nil {
+		return m.DatumSpansDropped
+	}
+	return 0
+}
+
 type Worker struct {
 	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State                WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
@@ -2577,7 +3376,7 @@ func (m *Worker) Reset()         { *m = Worker{} }
 func (m *Worker) String() string { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()    {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{27}
+	return fileDescriptor_dbf57f97f56369c0, []int{35}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2631,7 +3430,7 @@ func (m *JobInfos) Reset()         { *m = JobInfos{} }
 func (m *JobInfos) String() string { return proto.CompactTextString(m) }
 func (*JobInfos) ProtoMessage()    {}
 func (*JobInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{28}
+	return fileDescriptor_dbf57f97f56369c0, []int{36}
 }
 func (m *JobInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2678,7 +3477,7 @@ func (m *Pipeline) Reset()         { *m = Pipeline{} }
 func (m *Pipeline) String() string { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()    {}
 func (*Pipeline) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{29}
+	return fileDescriptor_dbf57f97f56369c0, []int{37}
 }
 func (m *Pipeline) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2731,17 +3530,19 @@ type EtcdPipelineInfo struct {
 	// pachd). This allows the worker master to shard work correctly without
 	// k8s privileges and without knowing the number of cluster nodes in the
 	// Coefficient case.
-	Parallelism          uint64   `protobuf:"varint,7,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Parallelism uint64 `protobuf:"varint,7,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	// usage is the total resources used by the pipeline's finished jobs.
+	Usage                *ResourceUsage `protobuf:"bytes,8,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *EtcdPipelineInfo) Reset()         { *m = EtcdPipelineInfo{} }
 func (m *EtcdPipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*EtcdPipelineInfo) ProtoMessage()    {}
 func (*EtcdPipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{30}
+	return fileDescriptor_dbf57f97f56369c0, []int{38}
 }
 func (m *EtcdPipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2819,7 +3620,14 @@ func (m *EtcdPipelineInfo) GetParallelism() uint64 {
 	return 0
 }
 
-type PipelineInfo struct {
+func (m *EtcdPipelineInfo) GetUsage() *ResourceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type PipelineInfo struct {
 	ID        string     `protobuf:"bytes,17,opt,name=id,proto3" json:"id,omitempty"`
 	Pipeline  *Pipeline  `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	Version   uint64     `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
@@ -2857,32 +3665,36 @@ type PipelineInfo struct {
 	EnableStats           bool            `protobuf:"varint,24,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	Salt                  string          `protobuf:"bytes,25,opt,name=salt,proto3" json:"salt,omitempty"`
 	// reason includes any error messages associated with a failed pipeline
-	Reason               string          `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,30,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	GithookURL           string          `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Standby              bool            `protobuf:"varint,37,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,39,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,40,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,41,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	S3Out                bool            `protobuf:"varint,47,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,48,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reason         string            `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxQueueSize   int64             `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service        *Service          `protobuf:"bytes,30,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout            `protobuf:"bytes,45,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec      *ChunkSpec        `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout   *types.Duration   `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration   `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	GithookURL     string            `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
+	SpecCommit     *pfs.Commit       `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Standby        bool              `protobuf:"varint,37,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries     int64             `protobuf:"varint,39,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	RetryPolicy    *RetryPolicy      `protobuf:"bytes,52,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	SchedulingSpec *SchedulingSpec   `protobuf:"bytes,40,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string            `protobuf:"bytes,41,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string            `protobuf:"bytes,44,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	S3Out          bool              `protobuf:"varint,47,opt,name=s3_out,json=s3Out,proto3" json:"s3_out,omitempty"`
+	Metadata       *Metadata         `protobuf:"bytes,48,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Template       *PipelineTemplate `protobuf:"bytes,53,opt,name=template,proto3" json:"template,omitempty"`
+	// usage is the total resources used by the pipeline's finished jobs.
+	Usage                *ResourceUsage `protobuf:"bytes,54,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *PipelineInfo) Reset()         { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()    {}
 func (*PipelineInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{31}
+	return fileDescriptor_dbf57f97f56369c0, []int{39}
 }
 func (m *PipelineInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3163,6 +3975,13 @@ func (m *PipelineInfo) GetDatumTries() int64 {
 	return 0
 }
 
+func (m *PipelineInfo) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 func (m *PipelineInfo) GetSchedulingSpec() *SchedulingSpec {
 	if m != nil {
 		return m.SchedulingSpec
@@ -3198,6 +4017,20 @@ func (m *PipelineInfo) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *PipelineInfo) GetTemplate() *PipelineTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetUsage() *ResourceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo         []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo,proto3" json:"pipeline_info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -3209,7 +4042,7 @@ func (m *PipelineInfos) Reset()         { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()    {}
 func (*PipelineInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{32}
+	return fileDescriptor_dbf57f97f56369c0, []int{40}
 }
 func (m *PipelineInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3251,11 +4084,12 @@ type CreateJobRequest struct {
 	// Fields below should only be set when restoring an extracted job.
 	Restart uint64 `protobuf:"varint,26,opt,name=restart,proto3" json:"restart,omitempty"`
 	// Counts of how many times we processed or skipped a datum
-	DataProcessed int64 `protobuf:"varint,27,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
-	DataSkipped   int64 `protobuf:"varint,28,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
-	DataTotal     int64 `protobuf:"varint,29,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
-	DataFailed    int64 `protobuf:"varint,30,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
-	DataRecovered int64 `protobuf:"varint,31,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataProcessed   int64 `protobuf:"varint,27,opt,name=data_processed,json=dataProcessed,proto3" json:"data_processed,omitempty"`
+	DataSkipped     int64 `protobuf:"varint,28,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
+	DataTotal       int64 `protobuf:"varint,29,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
+	DataFailed      int64 `protobuf:"varint,30,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
+	DataRecovered   int64 `protobuf:"varint,31,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataQuarantined int64 `protobuf:"varint,38,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
 	// Download/process/upload time and download/upload bytes
 	Stats                *ProcessStats    `protobuf:"bytes,32,opt,name=stats,proto3" json:"stats,omitempty"`
 	StatsCommit          *pfs.Commit      `protobuf:"bytes,33,opt,name=stats_commit,json=statsCommit,proto3" json:"stats_commit,omitempty"`
@@ -3272,7 +4106,7 @@ func (m *CreateJobRequest) Reset()         { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()    {}
 func (*CreateJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{33}
+	return fileDescriptor_dbf57f97f56369c0, []int{41}
 }
 func (m *CreateJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3357,6 +4191,13 @@ func (m *CreateJobRequest) GetDataRecovered() int64 {
 	return 0
 }
 
+func (m *CreateJobRequest) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
 func (m *CreateJobRequest) GetStats() *ProcessStats {
 	if m != nil {
 		return m.Stats
@@ -3405,6 +4246,7 @@ type InspectJobRequest struct {
 	OutputCommit         *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
 	BlockState           bool        `protobuf:"varint,2,opt,name=block_state,json=blockState,proto3" json:"block_state,omitempty"`
 	Full                 bool        `protobuf:"varint,4,opt,name=full,proto3" json:"full,omitempty"`
+	Timeline             bool        `protobuf:"varint,5,opt,name=timeline,proto3" json:"timeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
 	XXX_sizecache        int32       `json:"-"`
@@ -3414,7 +4256,7 @@ func (m *InspectJobRequest) Reset()         { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()    {}
 func (*InspectJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{34}
+	return fileDescriptor_dbf57f97f56369c0, []int{42}
 }
 func (m *InspectJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3471,6 +4313,13 @@ func (m *InspectJobRequest) GetFull() bool {
 	return false
 }
 
+func (m *InspectJobRequest) GetTimeline() bool {
+	if m != nil {
+		return m.Timeline
+	}
+	return false
+}
+
 type ListJobRequest struct {
 	Pipeline     *Pipeline     `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	InputCommit  []*pfs.Commit `protobuf:"bytes,2,rep,name=input_commit,json=inputCommit,proto3" json:"input_commit,omitempty"`
@@ -3498,7 +4347,7 @@ func (m *ListJobRequest) Reset()         { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()    {}
 func (*ListJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{35}
+	return fileDescriptor_dbf57f97f56369c0, []int{43}
 }
 func (m *ListJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3562,6 +4411,117 @@ func (m *ListJobRequest) GetFull() bool {
 	return false
 }
 
+type ListUsageRequest struct {
+	// since, if set, only counts jobs that started at or after it.
+	Since                *types.Timestamp         `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	GroupBy              ListUsageRequest_GroupBy `protobuf:"varint,2,opt,name=group_by,json=groupBy,proto3,enum=pps.ListUsageRequest_GroupBy" json:"group_by,omitempty"`
+	LabelKey             string                   `protobuf:"bytes,3,opt,name=label_key,json=labelKey,proto3" json:"label_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ListUsageRequest) Reset()         { *m = ListUsageRequest{} }
+func (m *ListUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsageRequest) ProtoMessage()    {}
+func (*ListUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{44}
+}
+func (m *ListUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsageRequest.Merge(m, src)
+}
+func (m *ListUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsageRequest proto.InternalMessageInfo
+
+func (m *ListUsageRequest) GetSince() *types.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ListUsageRequest) GetGroupBy() ListUsageRequest_GroupBy {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ListUsageRequest_PIPELINE
+}
+
+func (m *ListUsageRequest) GetLabelKey() string {
+	if m != nil {
+		return m.LabelKey
+	}
+	return ""
+}
+
+type ListUsageResponse struct {
+	Usage                []*ResourceUsage `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListUsageResponse) Reset()         { *m = ListUsageResponse{} }
+func (m *ListUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsageResponse) ProtoMessage()    {}
+func (*ListUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{45}
+}
+func (m *ListUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsageResponse.Merge(m, src)
+}
+func (m *ListUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsageResponse proto.InternalMessageInfo
+
+func (m *ListUsageResponse) GetUsage() []*ResourceUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type FlushJobRequest struct {
 	Commits              []*pfs.Commit `protobuf:"bytes,1,rep,name=commits,proto3" json:"commits,omitempty"`
 	ToPipelines          []*Pipeline   `protobuf:"bytes,2,rep,name=to_pipelines,json=toPipelines,proto3" json:"to_pipelines,omitempty"`
@@ -3574,7 +4534,7 @@ func (m *FlushJobRequest) Reset()         { *m = FlushJobRequest{} }
 func (m *FlushJobRequest) String() string { return proto.CompactTextString(m) }
 func (*FlushJobRequest) ProtoMessage()    {}
 func (*FlushJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{36}
+	return fileDescriptor_dbf57f97f56369c0, []int{46}
 }
 func (m *FlushJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +4588,7 @@ func (m *DeleteJobRequest) Reset()         { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()    {}
 func (*DeleteJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{37}
+	return fileDescriptor_dbf57f97f56369c0, []int{47}
 }
 func (m *DeleteJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3675,7 +4635,7 @@ func (m *StopJobRequest) Reset()         { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()    {}
 func (*StopJobRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{38}
+	return fileDescriptor_dbf57f97f56369c0, []int{48}
 }
 func (m *StopJobRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3720,6 +4680,7 @@ type UpdateJobStateRequest struct {
 	DataSkipped          int64         `protobuf:"varint,6,opt,name=data_skipped,json=dataSkipped,proto3" json:"data_skipped,omitempty"`
 	DataFailed           int64         `protobuf:"varint,7,opt,name=data_failed,json=dataFailed,proto3" json:"data_failed,omitempty"`
 	DataRecovered        int64         `protobuf:"varint,8,opt,name=data_recovered,json=dataRecovered,proto3" json:"data_recovered,omitempty"`
+	DataQuarantined      int64         `protobuf:"varint,11,opt,name=data_quarantined,json=dataQuarantined,proto3" json:"data_quarantined,omitempty"`
 	DataTotal            int64         `protobuf:"varint,9,opt,name=data_total,json=dataTotal,proto3" json:"data_total,omitempty"`
 	Stats                *ProcessStats `protobuf:"bytes,10,opt,name=stats,proto3" json:"stats,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -3731,7 +4692,7 @@ func (m *UpdateJobStateRequest) Reset()         { *m = UpdateJobStateRequest{} }
 func (m *UpdateJobStateRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobStateRequest) ProtoMessage()    {}
 func (*UpdateJobStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{39}
+	return fileDescriptor_dbf57f97f56369c0, []int{49}
 }
 func (m *UpdateJobStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3816,6 +4777,13 @@ func (m *UpdateJobStateRequest) GetDataRecovered() int64 {
 	return 0
 }
 
+func (m *UpdateJobStateRequest) GetDataQuarantined() int64 {
+	if m != nil {
+		return m.DataQuarantined
+	}
+	return 0
+}
+
 func (m *UpdateJobStateRequest) GetDataTotal() int64 {
 	if m != nil {
 		return m.DataTotal
@@ -3864,7 +4832,7 @@ func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()    {}
 func (*GetLogsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{40}
+	return fileDescriptor_dbf57f97f56369c0, []int{50}
 }
 func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3975,7 +4943,7 @@ func (m *LogMessage) Reset()         { *m = LogMessage{} }
 func (m *LogMessage) String() string { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()    {}
 func (*LogMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{41}
+	return fileDescriptor_dbf57f97f56369c0, []int{51}
 }
 func (m *LogMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4079,7 +5047,7 @@ func (m *RestartDatumRequest) Reset()         { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()    {}
 func (*RestartDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{42}
+	return fileDescriptor_dbf57f97f56369c0, []int{52}
 }
 func (m *RestartDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4133,7 +5101,7 @@ func (m *InspectDatumRequest) Reset()         { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()    {}
 func (*InspectDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{43}
+	return fileDescriptor_dbf57f97f56369c0, []int{53}
 }
 func (m *InspectDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4182,7 +5150,7 @@ func (m *ListDatumRequest) Reset()         { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()    {}
 func (*ListDatumRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{44}
+	return fileDescriptor_dbf57f97f56369c0, []int{54}
 }
 func (m *ListDatumRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4245,7 +5213,7 @@ func (m *ListDatumResponse) Reset()         { *m = ListDatumResponse{} }
 func (m *ListDatumResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDatumResponse) ProtoMessage()    {}
 func (*ListDatumResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{45}
+	return fileDescriptor_dbf57f97f56369c0, []int{55}
 }
 func (m *ListDatumResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4314,7 +5282,7 @@ func (m *ListDatumStreamResponse) Reset()         { *m = ListDatumStreamResponse
 func (m *ListDatumStreamResponse) String() string { return proto.CompactTextString(m) }
 func (*ListDatumStreamResponse) ProtoMessage()    {}
 func (*ListDatumStreamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{46}
+	return fileDescriptor_dbf57f97f56369c0, []int{56}
 }
 func (m *ListDatumStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4383,7 +5351,7 @@ func (m *ChunkSpec) Reset()         { *m = ChunkSpec{} }
 func (m *ChunkSpec) String() string { return proto.CompactTextString(m) }
 func (*ChunkSpec) ProtoMessage()    {}
 func (*ChunkSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{47}
+	return fileDescriptor_dbf57f97f56369c0, []int{57}
 }
 func (m *ChunkSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4426,6 +5394,155 @@ func (m *ChunkSpec) GetSizeBytes() int64 {
 	return 0
 }
 
+// RetryPolicy specifies how a pipeline should retry datums that fail.
+type RetryPolicy struct {
+	// initial_backoff is the time to wait before the first retry of a failed
+	// datum. If unset, failed datums are retried immediately.
+	InitialBackoff *types.Duration `protobuf:"bytes,1,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	// max_backoff caps the time to wait between retries.
+	MaxBackoff *types.Duration `protobuf:"bytes,2,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// multiplier is the factor by which the backoff grows after each retry. If
+	// unset, it defaults to 2.
+	Multiplier float64 `protobuf:"fixed64,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	// retry_return_code, if nonempty, restricts retries to failures of the
+	// user code with one of these exit codes. Any other failure is permanent.
+	RetryReturnCode []int64 `protobuf:"varint,4,rep,packed,name=retry_return_code,json=retryReturnCode,proto3" json:"retry_return_code,omitempty"`
+	// quarantine, if true, causes datums that fail permanently to be skipped and
+	// recorded rather than failing the job. The job finishes successfully and
+	// reports the skipped datums in JobInfo.data_quarantined.
+	Quarantine           bool     `protobuf:"varint,5,opt,name=quarantine,proto3" json:"quarantine,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{58}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetInitialBackoff() *types.Duration {
+	if m != nil {
+		return m.InitialBackoff
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetMaxBackoff() *types.Duration {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+func (m *RetryPolicy) GetRetryReturnCode() []int64 {
+	if m != nil {
+		return m.RetryReturnCode
+	}
+	return nil
+}
+
+func (m *RetryPolicy) GetQuarantine() bool {
+	if m != nil {
+		return m.Quarantine
+	}
+	return false
+}
+
+// PipelineTemplate records the template a pipeline spec was rendered from, so
+// that the spec can be traced back to its source.
+type PipelineTemplate struct {
+	// source is the unrendered template.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// args are the arguments the template was rendered with.
+	Args                 map[string]string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PipelineTemplate) Reset()         { *m = PipelineTemplate{} }
+func (m *PipelineTemplate) String() string { return proto.CompactTextString(m) }
+func (*PipelineTemplate) ProtoMessage()    {}
+func (*PipelineTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbf57f97f56369c0, []int{59}
+}
+func (m *PipelineTemplate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineTemplate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PipelineTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineTemplate.Merge(m, src)
+}
+func (m *PipelineTemplate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PipelineTemplate proto.InternalMessageInfo
+
+func (m *PipelineTemplate) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *PipelineTemplate) GetArgs() map[string]string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
 type SchedulingSpec struct {
 	NodeSelector         map[string]string `protobuf:"bytes,1,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	PriorityClassName    string            `protobuf:"bytes,2,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
@@ -4438,7 +5555,7 @@ func (m *SchedulingSpec) Reset()         { *m = SchedulingSpec{} }
 func (m *SchedulingSpec) String() string { return proto.CompactTextString(m) }
 func (*SchedulingSpec) ProtoMessage()    {}
 func (*SchedulingSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{48}
+	return fileDescriptor_dbf57f97f56369c0, []int{60}
 }
 func (m *SchedulingSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4508,31 +5625,37 @@ type CreatePipelineRequest struct {
 	EnableStats           bool          `protobuf:"varint,17,opt,name=enable_stats,json=enableStats,proto3" json:"enable_stats,omitempty"`
 	// Reprocess forces the pipeline to reprocess all datums.
 	// It only has meaning if Update is true
-	Reprocess            bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
-	MaxQueueSize         int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service              *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
-	Spout                *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
-	ChunkSpec            *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
-	DatumTimeout         *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
-	JobTimeout           *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
-	Salt                 string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
-	Standby              bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
-	DatumTries           int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
-	SchedulingSpec       *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
-	PodSpec              string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
-	PodPatch             string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
-	SpecCommit           *pfs.Commit     `protobuf:"bytes,34,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
-	Metadata             *Metadata       `protobuf:"bytes,46,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Reprocess      bool            `protobuf:"varint,18,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+	MaxQueueSize   int64           `protobuf:"varint,20,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service        *Service        `protobuf:"bytes,21,opt,name=service,proto3" json:"service,omitempty"`
+	Spout          *Spout          `protobuf:"bytes,33,opt,name=spout,proto3" json:"spout,omitempty"`
+	ChunkSpec      *ChunkSpec      `protobuf:"bytes,23,opt,name=chunk_spec,json=chunkSpec,proto3" json:"chunk_spec,omitempty"`
+	DatumTimeout   *types.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout,proto3" json:"datum_timeout,omitempty"`
+	JobTimeout     *types.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout,proto3" json:"job_timeout,omitempty"`
+	Salt           string          `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
+	Standby        bool            `protobuf:"varint,27,opt,name=standby,proto3" json:"standby,omitempty"`
+	DatumTries     int64           `protobuf:"varint,28,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	RetryPolicy    *RetryPolicy    `protobuf:"bytes,48,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	SchedulingSpec *SchedulingSpec `protobuf:"bytes,29,opt,name=scheduling_spec,json=schedulingSpec,proto3" json:"scheduling_spec,omitempty"`
+	PodSpec        string          `protobuf:"bytes,30,opt,name=pod_spec,json=podSpec,proto3" json:"pod_spec,omitempty"`
+	PodPatch       string          `protobuf:"bytes,32,opt,name=pod_patch,json=podPatch,proto3" json:"pod_patch,omitempty"`
+	SpecCommit     *pfs.Commit     `protobuf:"bytes,34,opt,name=spec_commit,json=specCommit,proto3" json:"spec_commit,omitempty"`
+	Metadata       *Metadata       `protobuf:"bytes,46,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// template is set by pachctl when the spec was rendered from a template.
+	Template *PipelineTemplate `protobuf:"bytes,49,opt,name=template,proto3" json:"template,omitempty"`
+	// dry_run, if set, validates the request (with defaults applied) without
+	// creating or updating the pipeline. Its input repos needn't exist yet.
+	DryRun               bool     `protobuf:"varint,50,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CreatePipelineRequest) Reset()         { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()    {}
 func (*CreatePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{49}
+	return fileDescriptor_dbf57f97f56369c0, []int{61}
 }
 func (m *CreatePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4743,6 +5866,13 @@ func (m *CreatePipelineRequest) GetDatumTries() int64 {
 	return 0
 }
 
+func (m *CreatePipelineRequest) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
 func (m *CreatePipelineRequest) GetSchedulingSpec() *SchedulingSpec {
 	if m != nil {
 		return m.SchedulingSpec
@@ -4778,6 +5908,20 @@ func (m *CreatePipelineRequest) GetMetadata() *Metadata {
 	return nil
 }
 
+func (m *CreatePipelineRequest) GetTemplate() *PipelineTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type InspectPipelineRequest struct {
 	Pipeline             *Pipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -4789,7 +5933,7 @@ func (m *InspectPipelineRequest) Reset()         { *m = InspectPipelineRequest{}
 func (m *InspectPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()    {}
 func (*InspectPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{50}
+	return fileDescriptor_dbf57f97f56369c0, []int{62}
 }
 func (m *InspectPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4849,7 +5993,7 @@ func (m *ListPipelineRequest) Reset()         { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()    {}
 func (*ListPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{51}
+	return fileDescriptor_dbf57f97f56369c0, []int{63}
 }
 func (m *ListPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4913,7 +6057,7 @@ func (m *DeletePipelineRequest) Reset()         { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()    {}
 func (*DeletePipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{52}
+	return fileDescriptor_dbf57f97f56369c0, []int{64}
 }
 func (m *DeletePipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4981,7 +6125,7 @@ func (m *StartPipelineRequest) Reset()         { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()    {}
 func (*StartPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{53}
+	return fileDescriptor_dbf57f97f56369c0, []int{65}
 }
 func (m *StartPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5028,7 +6172,7 @@ func (m *StopPipelineRequest) Reset()         { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()    {}
 func (*StopPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{54}
+	return fileDescriptor_dbf57f97f56369c0, []int{66}
 }
 func (m *StopPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5077,7 +6221,7 @@ func (m *RunPipelineRequest) Reset()         { *m = RunPipelineRequest{} }
 func (m *RunPipelineRequest) String() string { return proto.CompactTextString(m) }
 func (*RunPipelineRequest) ProtoMessage()    {}
 func (*RunPipelineRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{55}
+	return fileDescriptor_dbf57f97f56369c0, []int{67}
 }
 func (m *RunPipelineRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5138,7 +6282,7 @@ func (m *RunCronRequest) Reset()         { *m = RunCronRequest{} }
 func (m *RunCronRequest) String() string { return proto.CompactTextString(m) }
 func (*RunCronRequest) ProtoMessage()    {}
 func (*RunCronRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{56}
+	return fileDescriptor_dbf57f97f56369c0, []int{68}
 }
 func (m *RunCronRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5185,7 +6329,7 @@ func (m *CreateSecretRequest) Reset()         { *m = CreateSecretRequest{} }
 func (m *CreateSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateSecretRequest) ProtoMessage()    {}
 func (*CreateSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{57}
+	return fileDescriptor_dbf57f97f56369c0, []int{69}
 }
 func (m *CreateSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5232,7 +6376,7 @@ func (m *DeleteSecretRequest) Reset()         { *m = DeleteSecretRequest{} }
 func (m *DeleteSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSecretRequest) ProtoMessage()    {}
 func (*DeleteSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{58}
+	return fileDescriptor_dbf57f97f56369c0, []int{70}
 }
 func (m *DeleteSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5279,7 +6423,7 @@ func (m *InspectSecretRequest) Reset()         { *m = InspectSecretRequest{} }
 func (m *InspectSecretRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectSecretRequest) ProtoMessage()    {}
 func (*InspectSecretRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{59}
+	return fileDescriptor_dbf57f97f56369c0, []int{71}
 }
 func (m *InspectSecretRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5326,7 +6470,7 @@ func (m *Secret) Reset()         { *m = Secret{} }
 func (m *Secret) String() string { return proto.CompactTextString(m) }
 func (*Secret) ProtoMessage()    {}
 func (*Secret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{60}
+	return fileDescriptor_dbf57f97f56369c0, []int{72}
 }
 func (m *Secret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5375,7 +6519,7 @@ func (m *SecretInfo) Reset()         { *m = SecretInfo{} }
 func (m *SecretInfo) String() string { return proto.CompactTextString(m) }
 func (*SecretInfo) ProtoMessage()    {}
 func (*SecretInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{61}
+	return fileDescriptor_dbf57f97f56369c0, []int{73}
 }
 func (m *SecretInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5436,7 +6580,7 @@ func (m *SecretInfos) Reset()         { *m = SecretInfos{} }
 func (m *SecretInfos) String() string { return proto.CompactTextString(m) }
 func (*SecretInfos) ProtoMessage()    {}
 func (*SecretInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{62}
+	return fileDescriptor_dbf57f97f56369c0, []int{74}
 }
 func (m *SecretInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5486,7 +6630,7 @@ func (m *GarbageCollectRequest) Reset()         { *m = GarbageCollectRequest{} }
 func (m *GarbageCollectRequest) String() string { return proto.CompactTextString(m) }
 func (*GarbageCollectRequest) ProtoMessage()    {}
 func (*GarbageCollectRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{63}
+	return fileDescriptor_dbf57f97f56369c0, []int{75}
 }
 func (m *GarbageCollectRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5532,7 +6676,7 @@ func (m *GarbageCollectResponse) Reset()         { *m = GarbageCollectResponse{}
 func (m *GarbageCollectResponse) String() string { return proto.CompactTextString(m) }
 func (*GarbageCollectResponse) ProtoMessage()    {}
 func (*GarbageCollectResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{64}
+	return fileDescriptor_dbf57f97f56369c0, []int{76}
 }
 func (m *GarbageCollectResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5571,7 +6715,7 @@ func (m *ActivateAuthRequest) Reset()         { *m = ActivateAuthRequest{} }
 func (m *ActivateAuthRequest) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthRequest) ProtoMessage()    {}
 func (*ActivateAuthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{65}
+	return fileDescriptor_dbf57f97f56369c0, []int{77}
 }
 func (m *ActivateAuthRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5610,7 +6754,7 @@ func (m *ActivateAuthResponse) Reset()         { *m = ActivateAuthResponse{} }
 func (m *ActivateAuthResponse) String() string { return proto.CompactTextString(m) }
 func (*ActivateAuthResponse) ProtoMessage()    {}
 func (*ActivateAuthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dbf57f97f56369c0, []int{66}
+	return fileDescriptor_dbf57f97f56369c0, []int{78}
 }
 func (m *ActivateAuthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5644,18 +6788,27 @@ func init() {
 	proto.RegisterEnum("pps.DatumState", DatumState_name, DatumState_value)
 	proto.RegisterEnum("pps.WorkerState", WorkerState_name, WorkerState_value)
 	proto.RegisterEnum("pps.PipelineState", PipelineState_name, PipelineState_value)
+	proto.RegisterEnum("pps.SQLEgress_FileFormat", SQLEgress_FileFormat_name, SQLEgress_FileFormat_value)
+	proto.RegisterEnum("pps.IngestSpoutSource_Protocol", IngestSpoutSource_Protocol_name, IngestSpoutSource_Protocol_value)
+	proto.RegisterEnum("pps.ListUsageRequest_GroupBy", ListUsageRequest_GroupBy_name, ListUsageRequest_GroupBy_value)
 	proto.RegisterType((*SecretMount)(nil), "pps.SecretMount")
 	proto.RegisterType((*Transform)(nil), "pps.Transform")
 	proto.RegisterMapType((map[string]string)(nil), "pps.Transform.EnvEntry")
 	proto.RegisterType((*BuildSpec)(nil), "pps.BuildSpec")
 	proto.RegisterType((*TFJob)(nil), "pps.TFJob")
 	proto.RegisterType((*Egress)(nil), "pps.Egress")
+	proto.RegisterType((*SQLEgress)(nil), "pps.SQLEgress")
+	proto.RegisterType((*HTTPEgress)(nil), "pps.HTTPEgress")
+	proto.RegisterMapType((map[string]string)(nil), "pps.HTTPEgress.HeadersEntry")
 	proto.RegisterType((*Job)(nil), "pps.Job")
 	proto.RegisterType((*Metadata)(nil), "pps.Metadata")
 	proto.RegisterMapType((map[string]string)(nil), "pps.Metadata.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pps.Metadata.LabelsEntry")
 	proto.RegisterType((*Service)(nil), "pps.Service")
 	proto.RegisterType((*Spout)(nil), "pps.Spout")
+	proto.RegisterType((*KafkaSpoutSource)(nil), "pps.KafkaSpoutSource")
+	proto.RegisterType((*IngestSpoutSource)(nil), "pps.IngestSpoutSource")
+	proto.RegisterType((*SpoutBatch)(nil), "pps.SpoutBatch")
 	proto.RegisterType((*PFSInput)(nil), "pps.PFSInput")
 	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
 	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
@@ -5672,8 +6825,11 @@ func init() {
 	proto.RegisterType((*WorkerStatus)(nil), "pps.WorkerStatus")
 	proto.RegisterType((*ResourceSpec)(nil), "pps.ResourceSpec")
 	proto.RegisterType((*GPUSpec)(nil), "pps.GPUSpec")
+	proto.RegisterType((*ResourceUsage)(nil), "pps.ResourceUsage")
 	proto.RegisterType((*EtcdJobInfo)(nil), "pps.EtcdJobInfo")
 	proto.RegisterType((*JobInfo)(nil), "pps.JobInfo")
+	proto.RegisterType((*TimelineSpan)(nil), "pps.TimelineSpan")
+	proto.RegisterType((*JobTimeline)(nil), "pps.JobTimeline")
 	proto.RegisterType((*Worker)(nil), "pps.Worker")
 	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
 	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
@@ -5685,6 +6841,8 @@ func init() {
 	proto.RegisterType((*CreateJobRequest)(nil), "pps.CreateJobRequest")
 	proto.RegisterType((*InspectJobRequest)(nil), "pps.InspectJobRequest")
 	proto.RegisterType((*ListJobRequest)(nil), "pps.ListJobRequest")
+	proto.RegisterType((*ListUsageRequest)(nil), "pps.ListUsageRequest")
+	proto.RegisterType((*ListUsageResponse)(nil), "pps.ListUsageResponse")
 	proto.RegisterType((*FlushJobRequest)(nil), "pps.FlushJobRequest")
 	proto.RegisterType((*DeleteJobRequest)(nil), "pps.DeleteJobRequest")
 	proto.RegisterType((*StopJobRequest)(nil), "pps.StopJobRequest")
@@ -5697,6 +6855,9 @@ func init() {
 	proto.RegisterType((*ListDatumResponse)(nil), "pps.ListDatumResponse")
 	proto.RegisterType((*ListDatumStreamResponse)(nil), "pps.ListDatumStreamResponse")
 	proto.RegisterType((*ChunkSpec)(nil), "pps.ChunkSpec")
+	proto.RegisterType((*RetryPolicy)(nil), "pps.RetryPolicy")
+	proto.RegisterType((*PipelineTemplate)(nil), "pps.PipelineTemplate")
+	proto.RegisterMapType((map[string]string)(nil), "pps.PipelineTemplate.ArgsEntry")
 	proto.RegisterType((*SchedulingSpec)(nil), "pps.SchedulingSpec")
 	proto.RegisterMapType((map[string]string)(nil), "pps.SchedulingSpec.NodeSelectorEntry")
 	proto.RegisterType((*CreatePipelineRequest)(nil), "pps.CreatePipelineRequest")
@@ -5722,328 +6883,391 @@ func init() {
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptor_dbf57f97f56369c0) }
 
 var fileDescriptor_dbf57f97f56369c0 = []byte{
-	// 5124 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0xcd, 0x6f, 0x1b, 0x49,
-	0x76, 0x37, 0xc9, 0x26, 0xd9, 0x7c, 0xfc, 0x50, 0xab, 0xf4, 0x61, 0x9a, 0xb6, 0x25, 0xb9, 0xfd,
-	0x31, 0xb6, 0xc7, 0x23, 0x79, 0xe4, 0x9d, 0xc9, 0xae, 0x67, 0x32, 0x33, 0xfa, 0xb2, 0x57, 0x1c,
-	0x8d, 0xad, 0x6d, 0x5a, 0x13, 0x24, 0x17, 0xa2, 0x49, 0x16, 0xa9, 0xb6, 0x9a, 0xdd, 0xbd, 0xdd,
-	0x4d, 0x79, 0x34, 0x40, 0x90, 0x43, 0x90, 0xfb, 0x22, 0x01, 0x72, 0xc8, 0x21, 0xff, 0x41, 0x90,
-	0xfc, 0x01, 0x7b, 0xcc, 0x61, 0x81, 0x45, 0x80, 0x24, 0x40, 0xae, 0x46, 0x60, 0xec, 0xdf, 0x90,
-	0x43, 0x36, 0x87, 0xa0, 0x5e, 0x55, 0x37, 0xbb, 0x9b, 0x14, 0x49, 0x49, 0x8b, 0x3d, 0x08, 0xa8,
-	0x7a, 0xf5, 0xea, 0xeb, 0xd5, 0xab, 0xf7, 0x7e, 0xef, 0x55, 0x53, 0xb0, 0xd8, 0x36, 0x0d, 0x6a,
-	0xf9, 0x1b, 0x8e, 0xe3, 0xb1, 0xbf, 0x75, 0xc7, 0xb5, 0x7d, 0x9b, 0x64, 0x1c, 0xc7, 0xab, 0xdd,
-	0xec, 0xd9, 0x76, 0xcf, 0xa4, 0x1b, 0x48, 0x6a, 0x0d, 0xba, 0x1b, 0xb4, 0xef, 0xf8, 0x67, 0x9c,
-	0xa3, 0xb6, 0x9a, 0x6c, 0xf4, 0x8d, 0x3e, 0xf5, 0x7c, 0xbd, 0xef, 0x08, 0x86, 0x95, 0x24, 0x43,
-	0x67, 0xe0, 0xea, 0xbe, 0x61, 0x5b, 0xa2, 0x7d, 0xb1, 0x67, 0xf7, 0x6c, 0x2c, 0x6e, 0xb0, 0x52,
-	0x40, 0x0d, 0x96, 0xd3, 0xf5, 0xd8, 0x1f, 0xa7, 0xaa, 0x27, 0x50, 0x6c, 0xd0, 0xb6, 0x4b, 0xfd,
-	0xef, 0xec, 0x81, 0xe5, 0x13, 0x02, 0x92, 0xa5, 0xf7, 0x69, 0x35, 0xb5, 0x96, 0x7a, 0x58, 0xd0,
-	0xb0, 0x4c, 0x14, 0xc8, 0x9c, 0xd0, 0xb3, 0xaa, 0x84, 0x24, 0x56, 0x24, 0xb7, 0x01, 0xfa, 0x8c,
-	0xbd, 0xe9, 0xe8, 0xfe, 0x71, 0x35, 0x8d, 0x0d, 0x05, 0xa4, 0x1c, 0xea, 0xfe, 0x31, 0xb9, 0x0e,
-	0x79, 0x6a, 0x9d, 0x36, 0x4f, 0x75, 0xb7, 0x9a, 0xc1, 0xb6, 0x1c, 0xb5, 0x4e, 0xbf, 0xd7, 0x5d,
-	0xf5, 0xf7, 0x19, 0x28, 0xbc, 0x71, 0x75, 0xcb, 0xeb, 0xda, 0x6e, 0x9f, 0x2c, 0x42, 0xd6, 0xe8,
-	0xeb, 0xbd, 0x60, 0x32, 0x5e, 0x61, 0xb3, 0xb5, 0xfb, 0x9d, 0x6a, 0x7a, 0x2d, 0xc3, 0x66, 0x6b,
-	0xf7, 0x3b, 0x38, 0x9c, 0xeb, 0x36, 0x19, 0xb5, 0x8c, 0xd4, 0x1c, 0x75, 0xdd, 0x9d, 0x7e, 0x87,
-	0x3c, 0x82, 0x0c, 0xb5, 0x4e, 0xab, 0x99, 0xb5, 0xcc, 0xc3, 0xe2, 0xe6, 0xf5, 0x75, 0x26, 0xe3,
-	0x70, 0xf4, 0xf5, 0x3d, 0xeb, 0x74, 0xcf, 0xf2, 0xdd, 0x33, 0x8d, 0xf1, 0x90, 0xc7, 0x90, 0xf7,
-	0x70, 0x9b, 0x5e, 0x55, 0x42, 0x76, 0x05, 0xd9, 0x23, 0x5b, 0xd7, 0x02, 0x06, 0xf2, 0x04, 0x08,
-	0x2e, 0xa5, 0xe9, 0x0c, 0x4c, 0xb3, 0x19, 0x74, 0x2b, 0xe0, 0xd4, 0x0a, 0xb6, 0x1c, 0x0e, 0x4c,
-	0xb3, 0x21, 0xb8, 0x17, 0x21, 0xeb, 0xf9, 0x1d, 0xc3, 0xaa, 0x66, 0x91, 0x81, 0x57, 0xc8, 0x4d,
-	0x28, 0xb0, 0x35, 0xf3, 0x96, 0x0a, 0xb6, 0xc8, 0xd4, 0x75, 0x1b, 0xd8, 0xf8, 0x04, 0x88, 0xde,
-	0x6e, 0x53, 0xc7, 0x6f, 0xba, 0xd4, 0x1f, 0xb8, 0x56, 0xb3, 0x6d, 0x77, 0x68, 0x35, 0xb7, 0x96,
-	0x79, 0x98, 0xd1, 0x14, 0xde, 0xa2, 0x61, 0xc3, 0x8e, 0xdd, 0xa1, 0x6c, 0x82, 0x0e, 0x6d, 0x0d,
-	0x7a, 0xd5, 0xfc, 0x5a, 0xea, 0xa1, 0xac, 0xf1, 0x0a, 0x3b, 0xa8, 0x81, 0x47, 0xdd, 0x2a, 0xf0,
-	0x83, 0x62, 0x65, 0xb2, 0x0a, 0xc5, 0x77, 0xb6, 0x7b, 0x62, 0x58, 0xbd, 0x66, 0xc7, 0x70, 0xab,
-	0x45, 0x6c, 0x02, 0x41, 0xda, 0x35, 0x5c, 0xb2, 0x02, 0xd0, 0xb1, 0xdb, 0x27, 0xd4, 0xed, 0x1a,
-	0x26, 0xad, 0x96, 0x78, 0xfb, 0x90, 0x42, 0xee, 0x41, 0xb6, 0x35, 0x30, 0xcc, 0x4e, 0x75, 0x6e,
-	0x2d, 0xf5, 0xb0, 0xb8, 0x59, 0x41, 0x19, 0x6d, 0x33, 0x4a, 0xc3, 0xa1, 0x6d, 0x8d, 0x37, 0xd6,
-	0x3e, 0x07, 0x39, 0x10, 0x6e, 0xa0, 0x1b, 0xa9, 0xa1, 0x6e, 0x2c, 0x42, 0xf6, 0x54, 0x37, 0x07,
-	0x54, 0xa8, 0x05, 0xaf, 0x3c, 0x4f, 0xff, 0x34, 0xa5, 0xfe, 0x02, 0x0a, 0xe1, 0x58, 0x6c, 0xfd,
-	0xa8, 0x3c, 0x42, 0xd1, 0x58, 0x99, 0xd4, 0x40, 0x36, 0x75, 0xab, 0x37, 0x60, 0x3a, 0xc1, 0x7b,
-	0x87, 0xf5, 0xa1, 0xb2, 0x64, 0x22, 0xca, 0xa2, 0x3e, 0x82, 0xec, 0x9b, 0x17, 0x75, 0xbb, 0x45,
-	0xd6, 0x20, 0xe7, 0x77, 0x9b, 0x6f, 0xed, 0x16, 0x1f, 0x70, 0xbb, 0xf0, 0xe1, 0xfd, 0x2a, 0x6f,
-	0xd2, 0xb2, 0x7e, 0xb7, 0x6e, 0xb7, 0xd4, 0x1a, 0xe4, 0xf6, 0x7a, 0x2e, 0xf5, 0x3c, 0xb6, 0xe6,
-	0x23, 0xed, 0x20, 0x58, 0xf3, 0x91, 0x76, 0xa0, 0xde, 0x86, 0x0c, 0x1b, 0x64, 0x19, 0xd2, 0x46,
-	0x47, 0x0c, 0x90, 0xfb, 0xf0, 0x7e, 0x35, 0xbd, 0xbf, 0xab, 0xa5, 0x8d, 0x8e, 0xfa, 0xbf, 0x29,
-	0x90, 0xbf, 0xa3, 0xbe, 0xde, 0xd1, 0x7d, 0x9d, 0x7c, 0x03, 0x45, 0xdd, 0xb2, 0x6c, 0x1f, 0x2f,
-	0x9c, 0x57, 0x4d, 0xa1, 0x36, 0xad, 0xa0, 0xa4, 0x02, 0x9e, 0xf5, 0xad, 0x21, 0x03, 0xd7, 0xc1,
-	0x68, 0x17, 0xf2, 0x29, 0xe4, 0x4c, 0xbd, 0x45, 0x4d, 0x0f, 0x95, 0xbc, 0xb8, 0x79, 0x23, 0xde,
-	0xf9, 0x00, 0xdb, 0x78, 0x3f, 0xc1, 0x58, 0xfb, 0x0a, 0x94, 0xe4, 0x98, 0x17, 0x11, 0x7d, 0xed,
-	0x67, 0x50, 0x8c, 0x0c, 0x7b, 0xa1, 0x53, 0xfb, 0x2b, 0xc8, 0x37, 0xa8, 0x7b, 0x6a, 0xb4, 0x29,
-	0xb9, 0x0b, 0x65, 0xc3, 0xf2, 0xa9, 0x6b, 0xe9, 0x66, 0xd3, 0xb1, 0x5d, 0x1f, 0x07, 0xc8, 0x6a,
-	0xa5, 0x80, 0x78, 0x68, 0xbb, 0x3e, 0x63, 0xa2, 0x3f, 0x44, 0x99, 0xd2, 0x9c, 0x29, 0x20, 0x22,
-	0x13, 0x93, 0xb4, 0xc3, 0x8f, 0x52, 0x48, 0xfa, 0x50, 0x4b, 0x1b, 0x0e, 0xd3, 0x0a, 0xff, 0xcc,
-	0xa1, 0xc2, 0xd6, 0x60, 0x59, 0xa5, 0x90, 0x6d, 0x38, 0xf6, 0xc0, 0x27, 0xb7, 0xa0, 0x60, 0x9f,
-	0x52, 0xf7, 0x9d, 0x6b, 0xf8, 0xdc, 0x66, 0xc8, 0xda, 0x90, 0x40, 0x1e, 0xb0, 0x1b, 0x8e, 0xeb,
-	0xc4, 0x19, 0x8b, 0x9b, 0x25, 0x71, 0xc3, 0x91, 0xa6, 0x05, 0x8d, 0x64, 0x19, 0x72, 0x7d, 0xdd,
-	0x3d, 0xa1, 0xa1, 0x6d, 0xe2, 0x35, 0xf5, 0xff, 0x52, 0x20, 0x1f, 0xbe, 0x68, 0xec, 0x5b, 0xce,
-	0x60, 0xbc, 0x19, 0x24, 0x20, 0xb9, 0xd4, 0xb1, 0x85, 0x84, 0xb0, 0xcc, 0x06, 0x6b, 0xb9, 0xba,
-	0xd5, 0x3e, 0x0e, 0x06, 0xe3, 0x35, 0x46, 0x6f, 0xdb, 0xfd, 0xbe, 0xe1, 0x8b, 0x9d, 0x88, 0x1a,
-	0x1b, 0xa3, 0x67, 0xda, 0xad, 0x6a, 0x96, 0x8f, 0xc1, 0xca, 0xcc, 0xbc, 0xbd, 0xb5, 0x0d, 0xab,
-	0x69, 0x5b, 0x55, 0x99, 0x33, 0xb3, 0xea, 0x6b, 0x8b, 0x31, 0x9b, 0xfa, 0x8f, 0x67, 0xd5, 0x1c,
-	0x6e, 0x15, 0xcb, 0xec, 0x8a, 0xa3, 0xab, 0x68, 0xb2, 0xfb, 0xea, 0x09, 0x93, 0x00, 0x48, 0x7a,
-	0xc1, 0x28, 0xa4, 0x02, 0x69, 0xef, 0x59, 0xb5, 0x80, 0xf4, 0xb4, 0xf7, 0x8c, 0x89, 0xc5, 0x77,
-	0x8d, 0x5e, 0x4f, 0x98, 0x0a, 0x14, 0x4b, 0x97, 0xd9, 0x49, 0xa4, 0x69, 0x41, 0xa3, 0xfa, 0xcf,
-	0x29, 0x28, 0xec, 0xb8, 0xb6, 0x75, 0xe1, 0xfd, 0x8b, 0x7d, 0x66, 0x92, 0xfb, 0xf4, 0x1c, 0xda,
-	0x0e, 0xce, 0x91, 0x95, 0xe3, 0xc7, 0x97, 0x4b, 0x1e, 0xdf, 0x53, 0x66, 0x46, 0x75, 0xd7, 0x47,
-	0xd1, 0x14, 0x37, 0x6b, 0xeb, 0xdc, 0xc7, 0xad, 0x07, 0x3e, 0x6e, 0xfd, 0x4d, 0xe0, 0x04, 0x35,
-	0xce, 0xa8, 0x1a, 0x20, 0xbf, 0x34, 0xfc, 0xf3, 0xd7, 0x7b, 0x03, 0x32, 0x03, 0xd7, 0xe4, 0xcb,
-	0xdd, 0xce, 0x7f, 0x78, 0xbf, 0xca, 0xae, 0xba, 0xc6, 0x68, 0x17, 0x3d, 0x36, 0xf5, 0x3f, 0x52,
-	0x90, 0xe5, 0x13, 0xad, 0x42, 0xc6, 0xe9, 0x7a, 0xb8, 0xfc, 0xe2, 0x66, 0x19, 0x35, 0x2c, 0x50,
-	0x1a, 0x8d, 0xb5, 0x90, 0x15, 0x90, 0xd8, 0xf1, 0x55, 0xf3, 0x78, 0xb5, 0x01, 0x39, 0x78, 0x33,
-	0xd2, 0xc9, 0x1a, 0x64, 0xdb, 0xae, 0xed, 0x05, 0x77, 0x3f, 0xca, 0xc0, 0x1b, 0x18, 0xc7, 0xc0,
-	0x32, 0x6c, 0x4b, 0xf8, 0xb5, 0x18, 0x07, 0x36, 0x10, 0x15, 0xa4, 0xb6, 0x6b, 0x5b, 0xb8, 0xc8,
-	0xc0, 0x4a, 0x87, 0x67, 0xa7, 0x61, 0x1b, 0x5b, 0x68, 0xcf, 0x08, 0xa4, 0xc9, 0x17, 0x1a, 0x48,
-	0x4b, 0x63, 0x2d, 0xea, 0x09, 0xc8, 0x75, 0xbb, 0x15, 0x17, 0x9f, 0x14, 0x11, 0xdf, 0xdd, 0x50,
-	0x16, 0x29, 0x1c, 0xa3, 0x88, 0x7a, 0xb3, 0x83, 0xa4, 0x11, 0x7d, 0x4e, 0x47, 0xf4, 0x39, 0x50,
-	0xdb, 0xcc, 0x50, 0x6d, 0xd5, 0x23, 0x98, 0x3b, 0xd4, 0x5d, 0xdd, 0x34, 0xa9, 0x69, 0x78, 0x7d,
-	0x74, 0x00, 0x35, 0x90, 0xdb, 0xb6, 0xe5, 0xf9, 0xba, 0xc5, 0x4d, 0x84, 0xa4, 0x85, 0x75, 0xb2,
-	0x06, 0xc5, 0xb6, 0x4d, 0xbb, 0x5d, 0xa3, 0xcd, 0x10, 0x0b, 0x8e, 0x94, 0xd2, 0xa2, 0xa4, 0xba,
-	0x24, 0xa7, 0x94, 0xb4, 0xfa, 0x18, 0x4a, 0x3f, 0xd7, 0xbd, 0x63, 0xdf, 0xa5, 0x74, 0x64, 0xcc,
-	0x54, 0x7c, 0x4c, 0xf5, 0x19, 0x14, 0x70, 0xb3, 0xec, 0x9a, 0x84, 0xde, 0x47, 0x8a, 0x78, 0x1f,
-	0x02, 0xd2, 0xb1, 0xee, 0x1d, 0xa3, 0xc8, 0x4a, 0x1a, 0x96, 0xd5, 0x2f, 0x20, 0xbb, 0xab, 0xfb,
-	0x83, 0xfe, 0x79, 0xae, 0x81, 0xd4, 0x20, 0xf3, 0x56, 0xec, 0xbf, 0xb8, 0x29, 0xa3, 0x98, 0x99,
-	0xcf, 0x61, 0x44, 0xf5, 0x37, 0x29, 0x28, 0x60, 0xef, 0x7d, 0xab, 0x6b, 0xb3, 0x63, 0xed, 0xb0,
-	0x8a, 0x10, 0x27, 0x3f, 0x56, 0x6c, 0xd6, 0x78, 0x03, 0xb9, 0x8f, 0x57, 0xc0, 0xe7, 0xf6, 0xab,
-	0xb2, 0x39, 0x37, 0xe4, 0x68, 0x30, 0xb2, 0xc6, 0x5b, 0xc9, 0x47, 0x9c, 0xcd, 0x43, 0xb1, 0x14,
-	0x37, 0xe7, 0xb9, 0x12, 0xba, 0x76, 0x9b, 0x7a, 0x1e, 0x63, 0xf4, 0x38, 0xa3, 0x47, 0x1e, 0x40,
-	0xc1, 0xe9, 0x7a, 0x4d, 0x3e, 0x26, 0xd7, 0x95, 0x02, 0x1e, 0x22, 0x13, 0x81, 0x26, 0x3b, 0x5d,
-	0x64, 0xa7, 0xe4, 0x0e, 0x48, 0xcc, 0xf1, 0x20, 0x80, 0x41, 0x5d, 0x11, 0x2c, 0x6c, 0xd9, 0x1a,
-	0x36, 0xa9, 0xff, 0x92, 0x82, 0xc2, 0x56, 0xaf, 0xe7, 0xd2, 0x1e, 0xeb, 0xb0, 0x08, 0xd9, 0x36,
-	0x83, 0x4c, 0xb8, 0x95, 0x8c, 0xc6, 0x2b, 0x4c, 0x7e, 0x7d, 0xaa, 0x5b, 0xb8, 0xfa, 0x94, 0x86,
-	0x65, 0x76, 0xa1, 0x3c, 0xbf, 0xd3, 0xa1, 0xa7, 0xe2, 0x0c, 0x45, 0x8d, 0x3c, 0x02, 0xa5, 0x6b,
-	0x74, 0xfd, 0xe3, 0xa6, 0x43, 0xdd, 0x36, 0xb5, 0x7c, 0x06, 0x47, 0x24, 0xe4, 0x98, 0x43, 0xfa,
-	0x61, 0x48, 0x26, 0x9f, 0xc3, 0x75, 0xcb, 0xb0, 0x28, 0x9a, 0xbc, 0x44, 0x8f, 0x2c, 0xf6, 0x58,
-	0xe2, 0xcd, 0x2f, 0xe2, 0xfd, 0xd4, 0xbf, 0x4d, 0x43, 0x29, 0x2a, 0x15, 0xf2, 0x15, 0x94, 0x3b,
-	0xf6, 0x3b, 0xcb, 0xb4, 0xf5, 0x4e, 0x93, 0x21, 0x6a, 0x71, 0x10, 0x37, 0x46, 0x2c, 0xcd, 0xae,
-	0x40, 0xd3, 0x5a, 0x29, 0xe0, 0x67, 0xb6, 0x87, 0x7c, 0x09, 0x25, 0x87, 0x8f, 0xc7, 0xbb, 0xa7,
-	0xa7, 0x75, 0x2f, 0x0a, 0x76, 0xec, 0xfd, 0x1c, 0x8a, 0x03, 0x67, 0x38, 0x77, 0x66, 0x5a, 0x67,
-	0xe0, 0xdc, 0xd8, 0xf7, 0x3e, 0x54, 0xc2, 0x95, 0xb7, 0xce, 0x7c, 0xea, 0xa1, 0xac, 0x24, 0x2d,
-	0xdc, 0xcf, 0x36, 0x23, 0x92, 0x3b, 0x50, 0x12, 0x53, 0x70, 0xa6, 0x2c, 0x32, 0x89, 0x69, 0x91,
-	0x45, 0xfd, 0x87, 0x34, 0x2c, 0x85, 0xe7, 0x18, 0x93, 0xce, 0xb3, 0xf1, 0xd2, 0xe1, 0xc6, 0x25,
-	0xec, 0x92, 0x10, 0xc9, 0xa7, 0x63, 0x45, 0x92, 0xec, 0x13, 0x93, 0xc3, 0xc6, 0x38, 0x39, 0x24,
-	0x7b, 0x44, 0x37, 0xff, 0xd9, 0xd8, 0xcd, 0x8f, 0xf6, 0x49, 0x08, 0xe3, 0xd3, 0x31, 0xc2, 0x18,
-	0xb3, 0xb4, 0xa8, 0x70, 0x7e, 0x9b, 0x86, 0xd2, 0x9f, 0xd9, 0x0c, 0x0c, 0x30, 0x91, 0x0c, 0x3c,
-	0xf2, 0x08, 0x0a, 0xef, 0xb0, 0xde, 0x0c, 0xef, 0x7e, 0xe9, 0xc3, 0xfb, 0x55, 0x99, 0x33, 0xed,
-	0xef, 0x6a, 0x32, 0x6f, 0xde, 0xef, 0x30, 0xfc, 0xf9, 0xd6, 0x6e, 0x31, 0xbe, 0xf4, 0x10, 0x7f,
-	0x32, 0xfb, 0xba, 0xab, 0x65, 0xdf, 0xda, 0xad, 0xfd, 0x0e, 0x33, 0xda, 0x78, 0xcb, 0xb8, 0x55,
-	0xaf, 0x0c, 0xad, 0x3a, 0xde, 0x46, 0x6c, 0x23, 0x3f, 0x81, 0x3c, 0xfa, 0x36, 0xda, 0x11, 0x9b,
-	0x9c, 0xe4, 0x06, 0x03, 0xd6, 0xa1, 0x41, 0xc8, 0x4e, 0x31, 0x08, 0xb7, 0x01, 0x7e, 0x39, 0xa0,
-	0x03, 0xda, 0xf4, 0x8c, 0x1f, 0xb9, 0x0b, 0xce, 0x68, 0x05, 0xa4, 0x34, 0x8c, 0x1f, 0xb9, 0x9a,
-	0xe9, 0xbe, 0xde, 0x14, 0xc7, 0x45, 0x3b, 0x08, 0x2f, 0x32, 0x5a, 0x99, 0x51, 0x0f, 0x03, 0x62,
-	0xc8, 0xe6, 0xd2, 0x36, 0x73, 0xdf, 0xb4, 0x83, 0xb0, 0x45, 0xb0, 0x69, 0x01, 0x51, 0x75, 0xa1,
-	0xa4, 0x51, 0xcf, 0x1e, 0xb8, 0x6d, 0x6e, 0x9b, 0x59, 0x5c, 0xe7, 0x0c, 0x50, 0x8c, 0x69, 0x8d,
-	0x15, 0x11, 0x89, 0xd1, 0xbe, 0xed, 0x9e, 0x09, 0xf7, 0x21, 0x6a, 0x64, 0x05, 0x32, 0x3d, 0x67,
-	0x20, 0x76, 0xc3, 0x51, 0xdc, 0xcb, 0xc3, 0x23, 0x8c, 0x40, 0x58, 0x03, 0x33, 0x34, 0x1d, 0xc3,
-	0x3b, 0x09, 0x8c, 0x37, 0x2b, 0xd7, 0x25, 0x39, 0xa3, 0x48, 0xea, 0x67, 0x90, 0x17, 0x9c, 0x21,
-	0x92, 0x4c, 0x0d, 0x91, 0x24, 0x9b, 0xd0, 0x1a, 0xf4, 0x5b, 0xd4, 0xc5, 0x09, 0x33, 0x9a, 0xa8,
-	0xa9, 0xff, 0x25, 0x41, 0x71, 0xcf, 0x6f, 0x77, 0xd0, 0x1f, 0x76, 0xed, 0xc0, 0xa8, 0xa7, 0xc6,
-	0x18, 0x75, 0xf2, 0x08, 0x64, 0xc7, 0x70, 0xa8, 0x69, 0x58, 0x81, 0xba, 0x0b, 0x14, 0x20, 0x88,
-	0x5a, 0xd8, 0x4c, 0x9e, 0x42, 0xd9, 0x1e, 0xf8, 0xce, 0xc0, 0x6f, 0x46, 0x30, 0x52, 0xc2, 0x91,
-	0x96, 0x38, 0x07, 0xaf, 0x91, 0x2a, 0xe4, 0x5d, 0xca, 0x61, 0x10, 0xbf, 0xe1, 0x41, 0x75, 0xcc,
-	0xd9, 0x64, 0xc7, 0x9d, 0xcd, 0x1d, 0x28, 0x21, 0x9b, 0x77, 0x62, 0x38, 0x0e, 0xed, 0x88, 0x33,
-	0x2e, 0x32, 0x5a, 0x83, 0x93, 0x98, 0x12, 0x20, 0x8b, 0x6f, 0xfb, 0xba, 0x29, 0x4e, 0xb8, 0xc0,
-	0x28, 0x6f, 0x18, 0x81, 0x01, 0x4c, 0x6c, 0xee, 0xea, 0x86, 0x19, 0x1e, 0x2d, 0xf6, 0x78, 0x81,
-	0x94, 0x31, 0xc7, 0x3f, 0x37, 0xe6, 0xf8, 0x87, 0x4a, 0x59, 0x98, 0xa2, 0x94, 0xeb, 0x50, 0xc2,
-	0x42, 0x20, 0x24, 0x18, 0x15, 0x52, 0x11, 0x19, 0x84, 0x8c, 0xee, 0x06, 0x5e, 0xb2, 0x88, 0x5e,
-	0xb2, 0x1c, 0x1c, 0x4f, 0xcc, 0x47, 0x2e, 0x43, 0xce, 0xa5, 0xba, 0x67, 0x5b, 0x22, 0xc8, 0x15,
-	0xb5, 0xe8, 0x05, 0x2b, 0xcf, 0x7e, 0xc1, 0x3e, 0x07, 0xb9, 0x6b, 0x58, 0x86, 0x77, 0x4c, 0x3b,
-	0xd5, 0xca, 0xd4, 0x6e, 0x21, 0xaf, 0xfa, 0xbb, 0x32, 0xe4, 0x67, 0xd1, 0xa9, 0x27, 0x50, 0xf0,
-	0x83, 0xbc, 0x45, 0xcc, 0x86, 0x86, 0xd9, 0x0c, 0x6d, 0xc8, 0x10, 0xd3, 0xc0, 0xcc, 0x64, 0x0d,
-	0x7c, 0x04, 0x4a, 0x50, 0x6e, 0x9e, 0x52, 0xd7, 0x63, 0xa8, 0xb2, 0x8c, 0x8a, 0x35, 0x17, 0xd0,
-	0xbf, 0xe7, 0x64, 0xf2, 0x04, 0x8a, 0x0c, 0xa5, 0x07, 0xa7, 0xb0, 0x31, 0x7a, 0x0a, 0xc0, 0xda,
-	0xc5, 0x21, 0x7c, 0x0d, 0x8a, 0x33, 0xc4, 0x73, 0x4d, 0xc4, 0xfa, 0x25, 0xec, 0xb2, 0xc8, 0xd7,
-	0x12, 0x07, 0x7b, 0xda, 0x9c, 0x93, 0x40, 0x7f, 0x77, 0x21, 0x47, 0x31, 0x1a, 0x17, 0xa9, 0x86,
-	0x22, 0x76, 0xe3, 0x01, 0xba, 0x26, 0x9a, 0xc8, 0x47, 0x00, 0x8e, 0xee, 0x52, 0xcb, 0xc7, 0xc0,
-	0x3e, 0x97, 0x10, 0x5d, 0x81, 0xb7, 0xb1, 0xc0, 0x3d, 0x72, 0xac, 0xf9, 0xcb, 0x1d, 0xab, 0x3c,
-	0xfb, 0xb1, 0x8e, 0xde, 0xeb, 0xc2, 0xb4, 0x7b, 0x1d, 0xea, 0x2c, 0xcc, 0xa4, 0xb3, 0x77, 0x63,
-	0x3a, 0x1b, 0x09, 0x6c, 0x2b, 0x93, 0x02, 0xdb, 0x35, 0xc8, 0x7a, 0x2c, 0x4e, 0xae, 0x7e, 0x12,
-	0x01, 0x98, 0x18, 0x39, 0x6b, 0xbc, 0x81, 0x3c, 0x86, 0xa2, 0x58, 0x38, 0x06, 0x72, 0x24, 0x02,
-	0x09, 0x35, 0xea, 0xd8, 0x1a, 0xf0, 0x56, 0x56, 0x66, 0x61, 0xbc, 0xe0, 0x15, 0x91, 0xd2, 0x3c,
-	0x2e, 0x4a, 0xec, 0x6b, 0x9b, 0xc7, 0x4b, 0x11, 0x7b, 0xb5, 0x38, 0xcd, 0x5e, 0x2d, 0xcf, 0x62,
-	0xaf, 0x56, 0x46, 0xed, 0x55, 0xc2, 0x20, 0x3d, 0x9c, 0xc1, 0x20, 0xad, 0x8f, 0x33, 0x48, 0x71,
-	0xbb, 0x77, 0x3d, 0x69, 0xf7, 0x42, 0x7b, 0xb5, 0x3a, 0xc5, 0x5e, 0x7d, 0x0e, 0x65, 0x01, 0x0a,
-	0x3c, 0x44, 0x09, 0xd5, 0x2a, 0x3a, 0x74, 0xde, 0x21, 0x0a, 0x1f, 0xb4, 0xd2, 0xbb, 0x28, 0x98,
-	0xf8, 0x0a, 0xe6, 0x5d, 0xe1, 0x0f, 0x9b, 0x2e, 0xfd, 0xe5, 0x80, 0x7a, 0xbe, 0x57, 0xbd, 0x11,
-	0x99, 0x2c, 0xea, 0x2d, 0x35, 0x25, 0xe0, 0xd5, 0x04, 0x2b, 0x79, 0x0e, 0x73, 0x61, 0x7f, 0xd3,
-	0xe8, 0x1b, 0xbe, 0x57, 0xbd, 0x77, 0x5e, 0xef, 0x4a, 0xc0, 0x79, 0x80, 0x8c, 0x64, 0x1f, 0xae,
-	0x7b, 0x46, 0x87, 0xb6, 0x75, 0xb7, 0x99, 0x1c, 0xe3, 0xe9, 0x79, 0x63, 0x2c, 0x89, 0x1e, 0x5a,
-	0x7c, 0xa8, 0x35, 0xc8, 0x1a, 0x0c, 0xb5, 0x54, 0x6b, 0x11, 0x2d, 0x13, 0xd1, 0x29, 0x36, 0x90,
-	0x75, 0x00, 0x8b, 0xbe, 0x0b, 0xd4, 0xe6, 0x26, 0xb2, 0xcd, 0xa1, 0x92, 0x71, 0xad, 0xc1, 0xb0,
-	0xa2, 0x60, 0xd1, 0x77, 0x42, 0x89, 0x92, 0x0e, 0xe0, 0xf6, 0x14, 0x07, 0x70, 0x07, 0x4a, 0xd4,
-	0xd2, 0x5b, 0x26, 0x6d, 0xf2, 0x03, 0x5b, 0xc3, 0x38, 0xb3, 0xc8, 0x69, 0x1c, 0xcc, 0x12, 0x90,
-	0x3c, 0xdd, 0xf4, 0xab, 0x77, 0x44, 0xfa, 0x41, 0x37, 0x7d, 0xf2, 0x09, 0x40, 0xfb, 0x78, 0x60,
-	0x9d, 0x70, 0x63, 0x75, 0x3f, 0x1a, 0x3a, 0x33, 0x32, 0xee, 0xb9, 0xd0, 0x0e, 0x8a, 0x18, 0x2d,
-	0xb0, 0xd0, 0x0b, 0x61, 0x2a, 0xbb, 0x55, 0x0f, 0xa6, 0x47, 0x0b, 0x8c, 0xff, 0x0d, 0x67, 0x67,
-	0x78, 0x9f, 0x01, 0xc2, 0xa0, 0xf7, 0x47, 0x53, 0xf1, 0xfe, 0x5b, 0xbb, 0x15, 0xf4, 0xe5, 0x2a,
-	0xcf, 0xe6, 0x76, 0x0d, 0xea, 0x55, 0x1f, 0x85, 0x2a, 0x3f, 0xe8, 0xbf, 0x61, 0x14, 0xf2, 0x25,
-	0xcc, 0x79, 0xed, 0x63, 0xda, 0x19, 0x98, 0x86, 0xd5, 0xe3, 0x1b, 0x7a, 0x8c, 0x13, 0x2c, 0xf0,
-	0x4b, 0x1f, 0xb6, 0x71, 0x6d, 0xf0, 0x62, 0x75, 0x72, 0x03, 0x64, 0xc7, 0xee, 0xf0, 0x6e, 0x1f,
-	0xa3, 0x84, 0xf2, 0x8e, 0xcd, 0xb3, 0xb2, 0x37, 0xa1, 0xc0, 0x9a, 0x1c, 0xdd, 0x6f, 0x1f, 0x57,
-	0x9f, 0xf0, 0x14, 0xac, 0x63, 0x77, 0x0e, 0x59, 0xbd, 0x2e, 0xc9, 0x92, 0x92, 0xad, 0x4b, 0x72,
-	0x56, 0xc9, 0xd5, 0x25, 0xf9, 0x96, 0x72, 0xbb, 0x2e, 0xc9, 0xaa, 0x72, 0x57, 0xdd, 0x85, 0x1c,
-	0xd7, 0xfb, 0xb1, 0x69, 0x98, 0x07, 0xf1, 0xa8, 0x56, 0x49, 0xdc, 0x93, 0xc0, 0xfc, 0xa9, 0xcf,
-	0x44, 0x3e, 0xa2, 0x6b, 0x33, 0xc3, 0x2f, 0x23, 0x9a, 0xb6, 0xba, 0xb6, 0x48, 0xb0, 0x96, 0x02,
-	0x93, 0x89, 0xda, 0x93, 0x7f, 0xcb, 0x0b, 0xea, 0x0a, 0xc8, 0x81, 0xdb, 0x1b, 0x37, 0xb9, 0xfa,
-	0xfb, 0x34, 0x28, 0x0c, 0xd9, 0x05, 0x4c, 0xe8, 0x8a, 0x1f, 0x06, 0x2b, 0x4a, 0xe1, 0x8a, 0x48,
-	0xcc, 0x7b, 0x9e, 0x63, 0x92, 0xa5, 0x98, 0x49, 0x4e, 0x38, 0xcb, 0xf4, 0x64, 0x67, 0xb9, 0x03,
-	0xec, 0x70, 0x9b, 0x18, 0x25, 0x7b, 0x02, 0xff, 0xdf, 0xe3, 0xfe, 0x2e, 0xb1, 0x34, 0xb6, 0xc1,
-	0x1d, 0x64, 0xe3, 0xe9, 0xdf, 0xc2, 0xdb, 0xa0, 0xce, 0xcc, 0x97, 0x3e, 0xf0, 0x8f, 0x9b, 0xbe,
-	0x7d, 0x42, 0x2d, 0x91, 0x3f, 0x2c, 0x30, 0xca, 0x1b, 0x46, 0x20, 0xcf, 0xa0, 0x62, 0xea, 0x1e,
-	0x3a, 0x4a, 0x11, 0xf0, 0xe7, 0xc6, 0xb9, 0x9a, 0x12, 0x63, 0x0a, 0x6a, 0x64, 0x0d, 0x8a, 0x11,
-	0xbf, 0x8c, 0xae, 0x53, 0xd2, 0xa2, 0xa4, 0xda, 0x97, 0x50, 0x89, 0x2f, 0x29, 0x9a, 0x3a, 0xce,
-	0x8e, 0x49, 0x1d, 0x67, 0xa3, 0xa9, 0xe3, 0x7f, 0xad, 0x40, 0x29, 0x26, 0x79, 0x9e, 0x45, 0x99,
-	0x1f, 0xc9, 0xa2, 0x44, 0x21, 0x4d, 0x6a, 0x32, 0xa4, 0xa9, 0x42, 0x3e, 0x40, 0x32, 0x45, 0xee,
-	0x72, 0x4e, 0x43, 0x04, 0x73, 0x11, 0x14, 0xf5, 0x24, 0x7c, 0x30, 0x58, 0x8f, 0x18, 0x32, 0x7c,
-	0x31, 0x18, 0x7d, 0x3c, 0x18, 0x8b, 0x77, 0xe0, 0x22, 0x78, 0xe7, 0x73, 0x28, 0x1f, 0x8b, 0x4c,
-	0x55, 0xf4, 0xbe, 0x72, 0xbb, 0x1b, 0xcd, 0x61, 0x69, 0xa5, 0xe3, 0x68, 0x46, 0x6b, 0x26, 0x9c,
-	0xf4, 0x33, 0x80, 0xb6, 0x4b, 0x75, 0x9f, 0x76, 0x9a, 0xba, 0x2f, 0x70, 0xd2, 0x24, 0x28, 0x53,
-	0x10, 0xdc, 0x5b, 0xfe, 0xf0, 0x2e, 0xe4, 0xa7, 0xdd, 0x85, 0x2a, 0xc3, 0x58, 0x36, 0x7a, 0xe9,
-	0x07, 0x68, 0x71, 0x83, 0x2a, 0x33, 0xc8, 0x2e, 0x6d, 0x33, 0x98, 0x46, 0x5d, 0xd7, 0x76, 0x45,
-	0x16, 0xbb, 0xc8, 0x69, 0x7b, 0x8c, 0x44, 0x3e, 0x86, 0x79, 0xee, 0x0c, 0xbd, 0xc0, 0xf7, 0xd1,
-	0x4e, 0xf5, 0x53, 0xb4, 0x6b, 0x8a, 0x68, 0xd0, 0x02, 0x7a, 0x94, 0x59, 0x3f, 0xd5, 0x0d, 0x93,
-	0xd9, 0xf5, 0xea, 0x66, 0x8c, 0x79, 0x2b, 0xa0, 0x93, 0xaf, 0x63, 0x97, 0xab, 0x80, 0x97, 0x6b,
-	0x2d, 0xb6, 0x8b, 0x29, 0x17, 0x6b, 0xf4, 0xe6, 0x7c, 0x3c, 0xfd, 0xe6, 0x8c, 0xa0, 0x23, 0x65,
-	0x0c, 0x3a, 0x1a, 0xeb, 0xf1, 0x17, 0xae, 0xe4, 0xf1, 0x57, 0xff, 0x00, 0x1e, 0xff, 0xd9, 0x65,
-	0x3d, 0xfe, 0xe2, 0x79, 0x1e, 0x7f, 0x0d, 0x8a, 0x1d, 0xea, 0xb5, 0x5d, 0xc3, 0x61, 0xae, 0xac,
-	0xba, 0xc4, 0xcf, 0x3f, 0x42, 0x62, 0xd6, 0xab, 0xad, 0xb7, 0x8f, 0x45, 0xe6, 0xe1, 0x3a, 0xb7,
-	0x5e, 0x48, 0xc1, 0xcc, 0x43, 0xd2, 0xa5, 0x57, 0xcf, 0x77, 0xe9, 0x37, 0x22, 0x2e, 0x7d, 0x68,
-	0x9e, 0x6f, 0xc5, 0xcc, 0xf3, 0x3d, 0xa8, 0xf4, 0xf5, 0x1f, 0x9a, 0x91, 0x5c, 0xc7, 0x6d, 0xd4,
-	0x9e, 0x52, 0x5f, 0xff, 0xe1, 0x17, 0x61, 0xba, 0x23, 0x82, 0xab, 0x57, 0xae, 0x86, 0xab, 0xe3,
-	0xd0, 0x62, 0xed, 0xc2, 0xd0, 0xe2, 0xce, 0x95, 0xa0, 0x85, 0x7a, 0x11, 0x68, 0xb1, 0x01, 0xc5,
-	0x9e, 0xe1, 0x1f, 0xdb, 0xf6, 0x49, 0x73, 0xe0, 0x9a, 0x3c, 0xd2, 0xd8, 0xae, 0x7c, 0x78, 0xbf,
-	0x0a, 0x2f, 0x39, 0xf9, 0x48, 0x3b, 0xd0, 0x40, 0xb0, 0x1c, 0xb9, 0x66, 0xd2, 0xd5, 0xdd, 0x9b,
-	0xec, 0xea, 0xd0, 0x48, 0xe8, 0x56, 0xa7, 0x75, 0x86, 0x08, 0x0b, 0x8d, 0x04, 0x56, 0x93, 0x98,
-	0xe6, 0xa3, 0x59, 0x30, 0xcd, 0xc3, 0xcb, 0x61, 0x9a, 0x47, 0xb3, 0x63, 0x1a, 0xb2, 0x04, 0x39,
-	0xef, 0x59, 0x93, 0x89, 0x71, 0x83, 0xbf, 0xae, 0x7b, 0xcf, 0x5e, 0x0f, 0x7c, 0xe6, 0x90, 0xfa,
-	0xe2, 0x3d, 0x56, 0x20, 0xe4, 0x72, 0xec, 0x91, 0x56, 0x0b, 0x9b, 0xaf, 0xe6, 0x22, 0x79, 0xde,
-	0x2a, 0x44, 0x56, 0xcb, 0xca, 0xf5, 0xba, 0x24, 0xd7, 0x94, 0x9b, 0x75, 0x49, 0xbe, 0xa9, 0xdc,
-	0xaa, 0x4b, 0x32, 0x51, 0x16, 0xd4, 0x97, 0x50, 0x8e, 0xda, 0x32, 0x0c, 0x41, 0xc2, 0xb0, 0x3e,
-	0x82, 0x91, 0xe6, 0x47, 0xcc, 0x9e, 0x56, 0x72, 0x22, 0x35, 0xf5, 0xd7, 0x59, 0x50, 0x76, 0xd0,
-	0xf4, 0x33, 0xd7, 0xc6, 0xcd, 0xcc, 0x95, 0x12, 0x5a, 0x37, 0x2e, 0x90, 0xd0, 0xaa, 0x4d, 0x0b,
-	0x10, 0x6f, 0xce, 0x12, 0x20, 0xde, 0x9a, 0x96, 0xd0, 0xba, 0x3d, 0x25, 0xa1, 0xb5, 0x32, 0x43,
-	0xfc, 0xb8, 0x3a, 0x31, 0xa1, 0xb5, 0x76, 0xc1, 0x84, 0xd6, 0x9d, 0x59, 0x13, 0x5a, 0xea, 0x25,
-	0x92, 0x03, 0x91, 0xcc, 0xc7, 0xbd, 0xcb, 0x65, 0x3e, 0xee, 0xcf, 0x9e, 0xf9, 0x48, 0x68, 0x6b,
-	0x4a, 0x49, 0xd7, 0x25, 0x19, 0x94, 0x62, 0x5d, 0x92, 0xf3, 0x8a, 0x5c, 0x97, 0xe4, 0x82, 0x02,
-	0x75, 0x49, 0x96, 0x95, 0x42, 0x5d, 0x92, 0x4b, 0x4a, 0xb9, 0x2e, 0xc9, 0x45, 0xa5, 0x54, 0x97,
-	0xe4, 0xb2, 0x52, 0xa9, 0x4b, 0x72, 0x45, 0x99, 0xab, 0x4b, 0xf2, 0x92, 0xb2, 0x5c, 0x97, 0xe4,
-	0x39, 0x45, 0xa9, 0x4b, 0xb2, 0xa2, 0xcc, 0xd7, 0x25, 0x79, 0x5e, 0x21, 0x5c, 0xd3, 0xeb, 0x92,
-	0xbc, 0xa0, 0x2c, 0xd6, 0x25, 0x79, 0x51, 0x59, 0x0a, 0x6f, 0xc3, 0x75, 0xa5, 0x5a, 0x97, 0xe4,
-	0xaa, 0x72, 0x43, 0xfd, 0xfb, 0x14, 0xcc, 0xef, 0x5b, 0xec, 0x8a, 0xfb, 0x11, 0xfd, 0x9d, 0x94,
-	0x58, 0xbb, 0x78, 0x06, 0x76, 0x15, 0x8a, 0x2d, 0xd3, 0x6e, 0x9f, 0x34, 0x87, 0x31, 0x8b, 0xac,
-	0x01, 0x92, 0xb8, 0xe7, 0x27, 0x20, 0x75, 0x07, 0xa6, 0x89, 0x01, 0x81, 0xac, 0x61, 0x59, 0xfd,
-	0x6d, 0x0a, 0x2a, 0x07, 0x86, 0xe7, 0x9f, 0x73, 0xab, 0xa6, 0x20, 0xda, 0x75, 0x28, 0xa1, 0x1b,
-	0x1d, 0x46, 0x13, 0x99, 0x11, 0x7d, 0x41, 0x06, 0xb1, 0xc4, 0x4b, 0xa5, 0x95, 0x8f, 0x0d, 0xcf,
-	0xb7, 0x5d, 0xfe, 0x11, 0x57, 0x46, 0x0b, 0xaa, 0xe1, 0x6e, 0xb2, 0x91, 0xdd, 0xbc, 0x85, 0xb9,
-	0x17, 0xe6, 0xc0, 0x3b, 0x8e, 0xec, 0xe6, 0x3e, 0xe4, 0xf9, 0x5c, 0xc1, 0xf7, 0x2e, 0xb1, 0xc9,
-	0x82, 0x36, 0xf2, 0x14, 0x4a, 0xbe, 0xdd, 0x0c, 0x36, 0x16, 0x3c, 0x71, 0x27, 0x36, 0x5e, 0xf4,
-	0xed, 0xa0, 0xec, 0xa9, 0xeb, 0xa0, 0xec, 0x52, 0x93, 0xc6, 0x0c, 0xd2, 0x84, 0x03, 0x55, 0x9f,
-	0x40, 0xa5, 0xe1, 0xdb, 0xce, 0x8c, 0xdc, 0xbf, 0x4b, 0xc3, 0xd2, 0x91, 0xd3, 0xe1, 0xf6, 0x8e,
-	0x5f, 0xa7, 0x19, 0x94, 0xe6, 0x6e, 0x3c, 0x60, 0x9d, 0x76, 0x1f, 0x33, 0xb1, 0xfb, 0xf8, 0xc7,
-	0xc8, 0xe0, 0x27, 0x2c, 0x5a, 0x7e, 0x06, 0x8b, 0x26, 0x4f, 0xcf, 0x88, 0x15, 0xce, 0xcd, 0x88,
-	0xc1, 0x64, 0x83, 0xa7, 0xfe, 0x2a, 0x0d, 0x95, 0x97, 0xd4, 0x3f, 0xb0, 0x7b, 0xde, 0x25, 0x9c,
-	0xca, 0xa4, 0xa3, 0x08, 0x84, 0xd1, 0x35, 0x4c, 0x9f, 0xba, 0x3c, 0x76, 0x2e, 0x70, 0x61, 0xbc,
-	0xe0, 0xa4, 0xe1, 0xb3, 0x7a, 0xee, 0xbc, 0x67, 0x75, 0xfc, 0xe0, 0xc7, 0xf3, 0xa9, 0x2b, 0xb4,
-	0x5c, 0xd4, 0x18, 0xbd, 0x6b, 0x9b, 0xa6, 0xfd, 0x4e, 0x7c, 0x45, 0x23, 0x6a, 0xf8, 0x72, 0xa4,
-	0x1b, 0xa6, 0x90, 0x19, 0x96, 0xc9, 0x43, 0x50, 0x06, 0x1e, 0x6d, 0x9a, 0xf6, 0x89, 0xd1, 0x6c,
-	0xe9, 0xed, 0x13, 0x6a, 0x75, 0xc4, 0x37, 0x36, 0x95, 0x81, 0x47, 0x0f, 0xec, 0x13, 0x63, 0x9b,
-	0x53, 0xb9, 0x71, 0x54, 0x7f, 0x9d, 0x06, 0x38, 0xb0, 0x7b, 0xdf, 0x51, 0xcf, 0xd3, 0x7b, 0x18,
-	0x2e, 0x84, 0x0e, 0x3b, 0x92, 0xa3, 0x08, 0xbd, 0xf3, 0x2b, 0xbd, 0x4f, 0x23, 0x4f, 0x88, 0x99,
-	0x73, 0x9e, 0x10, 0x63, 0xef, 0x91, 0xf9, 0x89, 0xef, 0x91, 0x0f, 0x40, 0xe6, 0x70, 0xcb, 0xe0,
-	0x0b, 0x2d, 0x6c, 0x17, 0x3f, 0xbc, 0x5f, 0xcd, 0xf3, 0xcf, 0x11, 0x76, 0xb5, 0x3c, 0x36, 0xee,
-	0x77, 0x22, 0xc2, 0x81, 0x98, 0x70, 0x82, 0xd7, 0x4a, 0x69, 0xc2, 0x6b, 0x65, 0xf0, 0x09, 0xa2,
-	0xcc, 0x8d, 0x07, 0x7e, 0x82, 0xf8, 0x18, 0xd2, 0xe1, 0x43, 0xe4, 0x24, 0x9f, 0x92, 0xf6, 0x3d,
-	0x76, 0x57, 0xfa, 0x5c, 0x40, 0x78, 0x78, 0x05, 0x2d, 0xa8, 0xaa, 0x6f, 0x60, 0x41, 0xe3, 0xd7,
-	0x86, 0x9f, 0xe4, 0x0c, 0xb7, 0x36, 0xa9, 0x2a, 0xe9, 0x11, 0x55, 0x51, 0xff, 0x04, 0x16, 0x84,
-	0xfb, 0x88, 0x8d, 0x3a, 0xf5, 0xc3, 0x0c, 0xb5, 0x09, 0x0a, 0x33, 0xef, 0x33, 0xaf, 0x85, 0x21,
-	0x4e, 0xbd, 0x27, 0x42, 0x0f, 0xfe, 0xd4, 0x28, 0x33, 0x02, 0x86, 0x1d, 0xf8, 0xe9, 0x89, 0xf8,
-	0x8e, 0x31, 0xa3, 0x61, 0x59, 0x3d, 0x83, 0xf9, 0xc8, 0x04, 0x9e, 0x63, 0x5b, 0x1e, 0xbe, 0x94,
-	0x8b, 0x23, 0x64, 0xa0, 0x4f, 0x18, 0xde, 0xca, 0x70, 0x75, 0x08, 0xf0, 0x38, 0x82, 0xe6, 0xb0,
-	0x70, 0x15, 0x8a, 0x78, 0x95, 0x9b, 0x6c, 0x4c, 0x4f, 0x4c, 0x0c, 0x48, 0x3a, 0x64, 0x94, 0xb1,
-	0x53, 0xff, 0x25, 0x5c, 0x0f, 0xa7, 0x6e, 0xf8, 0x2e, 0xd5, 0x87, 0x0b, 0xf8, 0x04, 0x60, 0xb8,
-	0x80, 0xd8, 0xf7, 0x00, 0xc3, 0xf9, 0x0b, 0xe1, 0xfc, 0x97, 0x9b, 0x7e, 0x1b, 0x0a, 0x61, 0x8c,
-	0x14, 0x79, 0x9f, 0x4d, 0x45, 0xdf, 0x67, 0x99, 0xa1, 0x62, 0xa2, 0x14, 0x2f, 0xf9, 0x7c, 0xe0,
-	0x02, 0xa3, 0xf0, 0x77, 0xfb, 0x7f, 0x4b, 0x41, 0x25, 0x1e, 0x1e, 0x90, 0x3a, 0x94, 0x2d, 0xbb,
-	0x43, 0x9b, 0x1e, 0x35, 0x69, 0xdb, 0xb7, 0x5d, 0x21, 0xbd, 0xfb, 0x63, 0x42, 0x89, 0xf5, 0x57,
-	0x76, 0x87, 0x36, 0x04, 0x1f, 0xcf, 0x0e, 0x94, 0xac, 0x08, 0x89, 0xac, 0xc3, 0x82, 0xe3, 0x1a,
-	0xb6, 0x6b, 0xf8, 0x67, 0xcd, 0xb6, 0xa9, 0x7b, 0x1e, 0xbf, 0xc2, 0xfc, 0xcd, 0x7a, 0x3e, 0x68,
-	0xda, 0x61, 0x2d, 0xec, 0x1e, 0xd7, 0xbe, 0x86, 0xf9, 0x91, 0x21, 0x2f, 0xf4, 0xc5, 0xe5, 0x7f,
-	0x02, 0x2c, 0x71, 0x98, 0x1e, 0x9a, 0xcb, 0x8b, 0xa3, 0x8a, 0x61, 0x7e, 0xeb, 0xee, 0x0c, 0xf9,
-	0xad, 0x8b, 0xe5, 0xce, 0xc6, 0x65, 0xc3, 0xf2, 0x57, 0xca, 0x86, 0xad, 0x5e, 0x34, 0x1b, 0x56,
-	0x38, 0x3f, 0x1b, 0xb6, 0x0c, 0xb9, 0x01, 0x3a, 0xfd, 0xc0, 0xde, 0xf3, 0xda, 0x68, 0xce, 0x06,
-	0xc6, 0xe4, 0x6c, 0x86, 0xf1, 0xe0, 0xbd, 0x68, 0x3c, 0x38, 0x36, 0x95, 0x53, 0xba, 0x52, 0x2a,
-	0x67, 0xf9, 0x0f, 0x90, 0xca, 0xd9, 0xb8, 0x6c, 0x2a, 0xa7, 0x3c, 0x63, 0x2a, 0xa7, 0x32, 0x2d,
-	0x95, 0xa3, 0x4c, 0x4b, 0xe5, 0xcc, 0x8f, 0xa6, 0x72, 0x6e, 0x41, 0xc1, 0xa5, 0x02, 0x06, 0xe1,
-	0x23, 0xa4, 0xac, 0x0d, 0x09, 0x63, 0x92, 0x37, 0x8b, 0x93, 0x93, 0x37, 0x4b, 0x33, 0x25, 0x6f,
-	0xee, 0xcc, 0x96, 0xbc, 0xb9, 0x7e, 0xe1, 0xe4, 0x4d, 0xf5, 0x4a, 0xc9, 0x9b, 0x1b, 0x17, 0x49,
-	0xde, 0x04, 0x39, 0xb0, 0x5a, 0x24, 0x07, 0x16, 0xc9, 0xb8, 0xdc, 0x9c, 0x98, 0x71, 0xb9, 0x35,
-	0x4b, 0xc6, 0xe5, 0xf6, 0xe5, 0x32, 0x2e, 0x2b, 0x13, 0x32, 0x2e, 0x6b, 0x89, 0x8c, 0x4b, 0x22,
-	0xa1, 0xa4, 0x4e, 0x4e, 0x28, 0x45, 0x13, 0x31, 0xeb, 0x13, 0x13, 0x31, 0x89, 0xe0, 0x94, 0x07,
-	0x9e, 0x3c, 0xcc, 0x5c, 0x50, 0x16, 0xd5, 0x1d, 0x58, 0x16, 0xce, 0xff, 0xf2, 0x46, 0x55, 0xfd,
-	0x9b, 0x14, 0x2c, 0x30, 0x6f, 0x79, 0x05, 0xbb, 0x1c, 0x89, 0xc5, 0xd2, 0xf1, 0x58, 0xec, 0x11,
-	0x28, 0x3a, 0x03, 0xa0, 0x4d, 0xc3, 0x6a, 0xdb, 0x7d, 0x87, 0x45, 0x45, 0xe2, 0x1b, 0xda, 0x39,
-	0xa4, 0xef, 0x87, 0x64, 0xf5, 0xef, 0x52, 0xb0, 0xc4, 0xe3, 0xa6, 0x2b, 0xac, 0x44, 0x81, 0x8c,
-	0x1e, 0x06, 0xb2, 0xac, 0xc8, 0x5c, 0x52, 0xd7, 0x76, 0xdb, 0x81, 0xe1, 0xe4, 0x15, 0x76, 0x9a,
-	0x27, 0x94, 0x3a, 0xfc, 0x9b, 0x01, 0xfe, 0xdd, 0xb6, 0xcc, 0x08, 0x1a, 0x75, 0xec, 0xba, 0x24,
-	0xa7, 0x95, 0x8c, 0xf8, 0xfa, 0x6a, 0x0b, 0x16, 0x1b, 0x0c, 0xb3, 0x5d, 0x41, 0xc0, 0xdf, 0xc0,
-	0x02, 0x8b, 0xef, 0xae, 0x30, 0xc2, 0x3f, 0xa6, 0x80, 0x68, 0x03, 0xeb, 0x0a, 0x72, 0xf9, 0x0c,
-	0xc0, 0x71, 0xed, 0x53, 0x6a, 0xe9, 0x16, 0xfe, 0x96, 0x80, 0x01, 0x87, 0xa5, 0x88, 0x7e, 0x1e,
-	0x86, 0x8d, 0x5a, 0x84, 0x31, 0x02, 0xdf, 0xa5, 0xf1, 0xf0, 0x5d, 0x48, 0xe9, 0x0b, 0xa8, 0x68,
-	0x03, 0x6b, 0xc7, 0xb5, 0xad, 0x4b, 0xec, 0xee, 0x11, 0x2c, 0x70, 0x64, 0xc0, 0x7f, 0x7d, 0x14,
-	0x8c, 0xc0, 0xc2, 0x78, 0xc3, 0xe4, 0xbd, 0x4b, 0x1a, 0x96, 0xd5, 0xe7, 0xb0, 0xc0, 0x55, 0x24,
-	0xce, 0x7a, 0x17, 0x72, 0xfc, 0x17, 0x4d, 0xc3, 0xcf, 0xba, 0xc3, 0xdf, 0x41, 0x69, 0xa2, 0x49,
-	0xfd, 0x02, 0x16, 0xc5, 0x65, 0xb9, 0x44, 0xe7, 0x5b, 0x90, 0xe3, 0x94, 0xb1, 0x2f, 0xb2, 0xbf,
-	0x4a, 0x01, 0xf0, 0x66, 0x04, 0x8d, 0xb3, 0x8c, 0x18, 0x7e, 0xcb, 0x97, 0x8e, 0x7c, 0xcb, 0xb7,
-	0x0f, 0x04, 0x5f, 0xb1, 0x0c, 0xdb, 0x6a, 0x86, 0xbf, 0x8f, 0x13, 0xa9, 0x90, 0x49, 0x81, 0xc7,
-	0x7c, 0xd0, 0x2b, 0x24, 0xa9, 0x5f, 0x07, 0x3f, 0x81, 0xe3, 0x30, 0xfa, 0x29, 0x14, 0xf9, 0xbc,
-	0xd1, 0xdc, 0xea, 0x5c, 0x64, 0x5d, 0x1c, 0x78, 0x7b, 0x61, 0x59, 0x7d, 0x0e, 0x4b, 0x2f, 0x75,
-	0xb7, 0xa5, 0xf7, 0xe8, 0x8e, 0x6d, 0x32, 0xd4, 0x17, 0xc8, 0xeb, 0x0e, 0x94, 0xf8, 0x37, 0x8d,
-	0x02, 0xba, 0x72, 0x58, 0x5b, 0xe4, 0x34, 0x0e, 0x5e, 0xab, 0xb0, 0x9c, 0xec, 0xcb, 0xe1, 0xb7,
-	0xba, 0x04, 0x0b, 0x5b, 0x6d, 0xdf, 0x38, 0xd5, 0x7d, 0xba, 0x35, 0xf0, 0x8f, 0xc5, 0x98, 0xea,
-	0x32, 0x2c, 0xc6, 0xc9, 0x9c, 0xfd, 0xf1, 0x5f, 0xa7, 0xf0, 0x01, 0x9d, 0x67, 0xa9, 0x14, 0x28,
-	0xd5, 0x5f, 0x6f, 0x37, 0x1b, 0x6f, 0xb6, 0xb4, 0x37, 0xfb, 0xaf, 0x5e, 0x2a, 0xd7, 0xc8, 0x1c,
-	0x14, 0x19, 0x45, 0x3b, 0x7a, 0xf5, 0x8a, 0x11, 0x52, 0x01, 0xe1, 0xc5, 0xd6, 0xfe, 0xc1, 0x91,
-	0xb6, 0xa7, 0xa4, 0x03, 0x42, 0xe3, 0x68, 0x67, 0x67, 0xaf, 0xd1, 0x50, 0x32, 0xa4, 0x02, 0xc0,
-	0x08, 0xdf, 0xee, 0x1f, 0x1c, 0xec, 0xed, 0x2a, 0x52, 0xc0, 0xf0, 0xdd, 0x9e, 0xf6, 0x92, 0x0d,
-	0x91, 0x25, 0xf3, 0x50, 0x66, 0x84, 0xbd, 0x97, 0xda, 0x5e, 0xa3, 0xc1, 0x48, 0xb9, 0xc7, 0xaf,
-	0x01, 0x86, 0x5f, 0xac, 0x13, 0x80, 0x1c, 0x1b, 0x7f, 0x6f, 0x57, 0xb9, 0x46, 0x8a, 0x90, 0x0f,
-	0x86, 0x4e, 0x61, 0xe5, 0xdb, 0xfd, 0xc3, 0xc3, 0xbd, 0x5d, 0x25, 0x4d, 0x4a, 0x20, 0x87, 0x0b,
-	0xcd, 0x90, 0x32, 0x14, 0xb4, 0xbd, 0x9d, 0xd7, 0xdf, 0xef, 0x69, 0x6c, 0xd2, 0xc7, 0x5f, 0x43,
-	0x31, 0xf2, 0xb1, 0x00, 0x5b, 0xc3, 0xe1, 0xeb, 0xdd, 0x70, 0x1b, 0xd7, 0x02, 0xc2, 0x70, 0xe8,
-	0x0a, 0x00, 0x23, 0x88, 0x79, 0xd3, 0x8f, 0xff, 0x29, 0x35, 0x4c, 0x9f, 0xf3, 0x31, 0x96, 0x60,
-	0xfe, 0x70, 0xff, 0x70, 0xef, 0x60, 0xff, 0xd5, 0x5e, 0x54, 0x42, 0x8b, 0xa0, 0x84, 0xe4, 0xa1,
-	0x98, 0xae, 0xc3, 0xc2, 0x90, 0xba, 0x17, 0xb2, 0xa7, 0x63, 0xec, 0x81, 0x10, 0x33, 0x64, 0x01,
-	0xe6, 0x42, 0xea, 0xe1, 0xd6, 0x51, 0x03, 0x05, 0x17, 0x65, 0x6d, 0xbc, 0xd9, 0x7a, 0xb5, 0xbb,
-	0xfd, 0xe7, 0x4a, 0x36, 0xb6, 0x8c, 0x1d, 0x6d, 0xab, 0xf1, 0x73, 0x94, 0xe0, 0xe6, 0xff, 0x94,
-	0x21, 0xb3, 0x75, 0xb8, 0x4f, 0xd6, 0xa1, 0x10, 0xe6, 0xea, 0xc9, 0x92, 0xf8, 0x8d, 0x47, 0x3c,
-	0x77, 0x5f, 0x0b, 0xe3, 0x4e, 0xf5, 0x1a, 0xf9, 0x09, 0xc0, 0x30, 0x39, 0x4a, 0x96, 0x05, 0xb4,
-	0x4b, 0x64, 0x4b, 0x6b, 0xb1, 0xef, 0x28, 0xd4, 0x6b, 0x64, 0x03, 0xf2, 0x22, 0x73, 0x49, 0xb8,
-	0xd7, 0x8f, 0xe7, 0x31, 0x6b, 0xe5, 0x28, 0xbf, 0xa7, 0x5e, 0x63, 0xd0, 0x5d, 0xb0, 0xf0, 0x68,
-	0x71, 0x7c, 0xb7, 0xc4, 0x34, 0x4f, 0x53, 0x64, 0x13, 0xe4, 0x20, 0xab, 0x48, 0x78, 0x94, 0x90,
-	0x48, 0x32, 0x8e, 0xe9, 0xf3, 0x25, 0x14, 0xc2, 0xec, 0xa0, 0x10, 0x41, 0x32, 0x5b, 0x58, 0x5b,
-	0x1e, 0xb9, 0xeb, 0x7b, 0x7d, 0xc7, 0x3f, 0x53, 0xaf, 0x91, 0x9f, 0x42, 0x5e, 0xe4, 0x0a, 0xc5,
-	0x1a, 0xe3, 0x99, 0xc3, 0x09, 0x3d, 0x9f, 0x43, 0x29, 0x9a, 0x28, 0x20, 0xd5, 0xa8, 0x30, 0xa3,
-	0x59, 0x80, 0x5a, 0x22, 0x1c, 0x56, 0xaf, 0xb1, 0x35, 0x87, 0xf1, 0xb4, 0x58, 0x73, 0x32, 0x77,
-	0x50, 0x5b, 0x4e, 0x92, 0xc5, 0x8d, 0xbf, 0x46, 0xea, 0x30, 0x97, 0x88, 0xc6, 0xcf, 0x1b, 0xe3,
-	0x56, 0x9c, 0x1c, 0x0f, 0xdd, 0x51, 0x7a, 0xdb, 0xf8, 0x01, 0x76, 0x98, 0x44, 0x11, 0xbb, 0x18,
-	0x93, 0x57, 0x99, 0x20, 0x89, 0x17, 0x50, 0x89, 0x47, 0xa2, 0xa4, 0x16, 0xd1, 0xc4, 0x84, 0x93,
-	0x9d, 0x30, 0xce, 0x0e, 0xcc, 0x25, 0xd0, 0x17, 0xb9, 0x19, 0x15, 0x6a, 0x72, 0xa4, 0xd1, 0xa7,
-	0x2c, 0xf5, 0x1a, 0xf9, 0x0a, 0x4a, 0x51, 0xf0, 0x25, 0x36, 0x34, 0x06, 0x8f, 0xd5, 0xc8, 0x48,
-	0x77, 0x8f, 0x6f, 0x26, 0x0e, 0x9a, 0xc4, 0x66, 0xc6, 0x22, 0xa9, 0x09, 0x9b, 0xd9, 0x85, 0x72,
-	0x0c, 0xe7, 0x90, 0x1b, 0x42, 0xbd, 0x46, 0xb1, 0xcf, 0x84, 0x51, 0xb6, 0xa1, 0x14, 0x85, 0x3a,
-	0x62, 0x37, 0x63, 0xd0, 0xcf, 0x84, 0x31, 0xbe, 0x81, 0x62, 0x04, 0xeb, 0x10, 0xfe, 0x13, 0xe8,
-	0x51, 0xf4, 0x33, 0xf9, 0x92, 0x08, 0x34, 0x22, 0x2e, 0x49, 0x1c, 0x9b, 0x4c, 0x5e, 0x7f, 0x14,
-	0x8a, 0x88, 0xf5, 0x8f, 0x41, 0x27, 0x93, 0xc7, 0x88, 0x62, 0x14, 0x31, 0xc6, 0x18, 0xd8, 0x32,
-	0x71, 0x07, 0xc0, 0x54, 0x40, 0x8c, 0x70, 0x0e, 0x5f, 0x4d, 0x49, 0xf8, 0x6f, 0xa6, 0x0f, 0x7f,
-	0x0a, 0xe5, 0x18, 0xca, 0x11, 0xe7, 0x38, 0x0e, 0xf9, 0xd4, 0x92, 0xfe, 0x1f, 0xbb, 0x0b, 0xeb,
-	0xb4, 0x65, 0x9a, 0xe7, 0xce, 0x7b, 0xfe, 0xba, 0x9f, 0x41, 0x5e, 0x24, 0xcd, 0x85, 0xe4, 0xe3,
-	0x29, 0x74, 0x31, 0xe3, 0x30, 0x89, 0x8c, 0x77, 0xfa, 0x5b, 0xa8, 0xc4, 0xd1, 0x82, 0x50, 0xe1,
-	0xb1, 0xf0, 0xa3, 0x76, 0x73, 0x6c, 0x5b, 0x68, 0x6c, 0xf6, 0xa0, 0x14, 0x45, 0x12, 0x42, 0xfa,
-	0x63, 0x30, 0x47, 0xed, 0xc6, 0x98, 0x96, 0x70, 0x98, 0x17, 0x50, 0x89, 0x3f, 0xb2, 0x88, 0x35,
-	0x8d, 0x7d, 0x79, 0x39, 0x5f, 0x20, 0xdb, 0x5f, 0xfc, 0xe6, 0xc3, 0x4a, 0xea, 0xdf, 0x3f, 0xac,
-	0xa4, 0xfe, 0xfb, 0xc3, 0x4a, 0xea, 0x2f, 0x3e, 0xe9, 0x19, 0xfe, 0xf1, 0xa0, 0xb5, 0xde, 0xb6,
-	0xfb, 0x1b, 0x8e, 0xde, 0x3e, 0x3e, 0xeb, 0x50, 0x37, 0x5a, 0xf2, 0xdc, 0xf6, 0xc6, 0xf0, 0xff,
-	0x2b, 0xb4, 0x72, 0x38, 0xdc, 0xb3, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x6a, 0x84, 0x95,
-	0x74, 0x41, 0x00, 0x00,
+	// 6144 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x7c, 0x4b, 0x6c, 0x1b, 0x59,
+	0x76, 0xb6, 0xf9, 0x2e, 0x1e, 0x52, 0x54, 0xe9, 0xea, 0x61, 0x9a, 0xb6, 0x25, 0xb9, 0xdc, 0x76,
+	0xdb, 0x6e, 0xb7, 0xdc, 0x96, 0xa6, 0x3d, 0x33, 0xee, 0x9e, 0xee, 0xd1, 0xcb, 0x6e, 0xb1, 0xd5,
+	0x6e, 0x75, 0x51, 0xee, 0x1f, 0xff, 0xbf, 0xe1, 0x5f, 0x24, 0x2f, 0xa9, 0xb2, 0x8a, 0x55, 0xd5,
+	0xf5, 0x90, 0x5b, 0x03, 0xfc, 0xf8, 0x03, 0x24, 0xd9, 0x64, 0x93, 0x41, 0xb2, 0x09, 0xb2, 0xc8,
+	0x2e, 0xcb, 0x20, 0x41, 0x90, 0xec, 0x02, 0xcc, 0x2e, 0x18, 0x60, 0x10, 0x20, 0x41, 0x80, 0x2c,
+	0x8d, 0xc0, 0x59, 0x04, 0xd9, 0x66, 0x97, 0xc7, 0x22, 0x38, 0xf7, 0x51, 0xac, 0xa2, 0x28, 0x92,
+	0x92, 0x27, 0x59, 0x08, 0xa8, 0x7b, 0xce, 0xb9, 0xaf, 0x73, 0xef, 0x3d, 0x8f, 0xef, 0x5e, 0x0a,
+	0x16, 0xda, 0x96, 0x49, 0xed, 0xe0, 0x91, 0xeb, 0xfa, 0xf8, 0xb7, 0xe6, 0x7a, 0x4e, 0xe0, 0x90,
+	0x8c, 0xeb, 0xfa, 0xb5, 0xeb, 0x3d, 0xc7, 0xe9, 0x59, 0xf4, 0x11, 0x23, 0xb5, 0xc2, 0xee, 0x23,
+	0xda, 0x77, 0x83, 0x53, 0x2e, 0x51, 0x5b, 0x19, 0x66, 0x06, 0x66, 0x9f, 0xfa, 0x81, 0xd1, 0x77,
+	0x85, 0xc0, 0xf2, 0xb0, 0x40, 0x27, 0xf4, 0x8c, 0xc0, 0x74, 0x6c, 0xc1, 0x5f, 0xe8, 0x39, 0x3d,
+	0x87, 0x7d, 0x3e, 0xc2, 0x2f, 0x49, 0x95, 0xc3, 0xe9, 0xfa, 0xf8, 0xc7, 0xa9, 0xda, 0xef, 0xa4,
+	0xa0, 0xd4, 0xa0, 0x6d, 0x8f, 0x06, 0x5f, 0x39, 0xa1, 0x1d, 0x10, 0x02, 0x59, 0xdb, 0xe8, 0xd3,
+	0x6a, 0x6a, 0x35, 0x75, 0xaf, 0xa8, 0xb3, 0x6f, 0xa2, 0x42, 0xe6, 0x98, 0x9e, 0x56, 0xb3, 0x8c,
+	0x84, 0x9f, 0xe4, 0x26, 0x40, 0x1f, 0xc5, 0x9b, 0xae, 0x11, 0x1c, 0x55, 0xd3, 0x8c, 0x51, 0x64,
+	0x94, 0x03, 0x23, 0x38, 0x22, 0x57, 0xa1, 0x40, 0xed, 0x93, 0xe6, 0x89, 0xe1, 0x55, 0x33, 0x8c,
+	0x97, 0xa7, 0xf6, 0xc9, 0xb7, 0x86, 0x87, 0xf5, 0x4e, 0x8c, 0xd0, 0x12, 0xf5, 0x72, 0xbc, 0x1e,
+	0xa3, 0x60, 0x3d, 0xed, 0x3f, 0x32, 0x50, 0x3c, 0xf4, 0x0c, 0xdb, 0xef, 0x3a, 0x5e, 0x9f, 0x2c,
+	0x40, 0xce, 0xec, 0x1b, 0x3d, 0x39, 0x16, 0x5e, 0xc0, 0xc1, 0xb4, 0xfb, 0x9d, 0x6a, 0x7a, 0x35,
+	0x83, 0x83, 0x69, 0xf7, 0x3b, 0xac, 0x37, 0xcf, 0x6b, 0x22, 0x75, 0x86, 0x51, 0xf3, 0xd4, 0xf3,
+	0xb6, 0xfb, 0x1d, 0x72, 0x1f, 0x32, 0xd4, 0x3e, 0xa9, 0x66, 0x56, 0x33, 0xf7, 0x4a, 0xeb, 0x57,
+	0xd7, 0x70, 0x0d, 0xa2, 0xd6, 0xd7, 0x76, 0xed, 0x93, 0x5d, 0x3b, 0xf0, 0x4e, 0x75, 0x94, 0x21,
+	0x0f, 0xa0, 0xe0, 0x33, 0x2d, 0xf8, 0xd5, 0x2c, 0x13, 0x57, 0x99, 0x78, 0x4c, 0x33, 0xba, 0x14,
+	0x20, 0x0f, 0x81, 0xb0, 0xa1, 0x34, 0xdd, 0xd0, 0xb2, 0x9a, 0xb2, 0x5a, 0x91, 0x75, 0xad, 0x32,
+	0xce, 0x41, 0x68, 0x59, 0x0d, 0x21, 0xbd, 0x00, 0x39, 0x3f, 0xe8, 0x98, 0x76, 0x35, 0xc7, 0x04,
+	0x78, 0x81, 0x5c, 0x87, 0x22, 0x8e, 0x99, 0x73, 0x2a, 0x8c, 0xa3, 0x50, 0xcf, 0x6b, 0x30, 0xe6,
+	0x43, 0x20, 0x46, 0xbb, 0x4d, 0xdd, 0xa0, 0xe9, 0xd1, 0x20, 0xf4, 0xec, 0x66, 0xdb, 0xe9, 0xd0,
+	0x6a, 0x7e, 0x35, 0x73, 0x2f, 0xa3, 0xab, 0x9c, 0xa3, 0x33, 0xc6, 0xb6, 0xd3, 0xa1, 0xd8, 0x41,
+	0x87, 0xb6, 0xc2, 0x5e, 0xb5, 0xb0, 0x9a, 0xba, 0xa7, 0xe8, 0xbc, 0x80, 0xeb, 0x18, 0xfa, 0xd4,
+	0xab, 0x02, 0x5f, 0x47, 0xfc, 0x26, 0x2b, 0x50, 0x7a, 0xed, 0x78, 0xc7, 0xa6, 0xdd, 0x6b, 0x76,
+	0x4c, 0xaf, 0x5a, 0x62, 0x2c, 0x10, 0xa4, 0x1d, 0xd3, 0x23, 0xcb, 0x00, 0x1d, 0xa7, 0x7d, 0x4c,
+	0xbd, 0xae, 0x69, 0xd1, 0x6a, 0x99, 0xf3, 0x07, 0x14, 0xf2, 0x1e, 0xe4, 0x5a, 0xa1, 0x69, 0x75,
+	0xaa, 0xb3, 0xab, 0xa9, 0x7b, 0xa5, 0xf5, 0x0a, 0xd3, 0xd1, 0x16, 0x52, 0x1a, 0x2e, 0x6d, 0xeb,
+	0x9c, 0x59, 0x7b, 0x02, 0x8a, 0x54, 0xae, 0xdc, 0x3a, 0xa9, 0xc1, 0xd6, 0x59, 0x80, 0xdc, 0x89,
+	0x61, 0x85, 0x54, 0xec, 0x1a, 0x5e, 0x78, 0x9a, 0xfe, 0x51, 0x4a, 0xfb, 0x06, 0x8a, 0x51, 0x5b,
+	0x38, 0x7e, 0xb6, 0x47, 0xc4, 0x3e, 0xc4, 0x6f, 0x52, 0x03, 0xc5, 0x32, 0xec, 0x5e, 0x68, 0xf4,
+	0x64, 0xed, 0xa8, 0x3c, 0xd8, 0x2c, 0x99, 0xd8, 0x66, 0xd1, 0xee, 0x43, 0xee, 0xf0, 0x59, 0xdd,
+	0x69, 0x91, 0x55, 0xc8, 0x07, 0xdd, 0xe6, 0x2b, 0xa7, 0xc5, 0x1b, 0xdc, 0x2a, 0xbe, 0x7d, 0xb3,
+	0xc2, 0x59, 0x7a, 0x2e, 0xe8, 0xd6, 0x9d, 0x96, 0xe6, 0x41, 0x7e, 0xb7, 0xe7, 0x51, 0xdf, 0xc7,
+	0x31, 0xbf, 0xd4, 0xf7, 0xe5, 0x98, 0x5f, 0xea, 0xfb, 0xe4, 0x31, 0x94, 0xfd, 0xef, 0xac, 0x66,
+	0xc7, 0x08, 0x8c, 0x96, 0xe1, 0xf3, 0xce, 0xe5, 0xf4, 0x1b, 0xdf, 0xec, 0xf3, 0x7a, 0x7a, 0xc9,
+	0xff, 0xce, 0xda, 0x11, 0x22, 0xe4, 0x36, 0x64, 0x8f, 0x82, 0xc0, 0x65, 0xc3, 0x29, 0xad, 0xcf,
+	0x32, 0xd1, 0x2f, 0x0e, 0x0f, 0x0f, 0x84, 0x2c, 0x63, 0x6a, 0x7f, 0x9d, 0x82, 0x62, 0x54, 0x1f,
+	0xfb, 0x0d, 0x3d, 0x4b, 0xf6, 0x1b, 0x7a, 0x16, 0x79, 0x0a, 0x25, 0xd4, 0x7b, 0x13, 0x37, 0xac,
+	0x11, 0xb0, 0x6e, 0x2b, 0xeb, 0xd7, 0x92, 0xdd, 0xae, 0x3d, 0x33, 0x2d, 0xfa, 0x8c, 0x09, 0xe8,
+	0xd0, 0x8d, 0xbe, 0x51, 0x21, 0x81, 0xd1, 0xb2, 0x22, 0x85, 0xb0, 0x02, 0xb9, 0x07, 0xaa, 0x6b,
+	0xf8, 0xfe, 0x6b, 0xc7, 0xeb, 0x34, 0xe5, 0x11, 0xe5, 0xe7, 0xba, 0x22, 0xe9, 0xbb, 0xec, 0xa8,
+	0x6a, 0x1f, 0x00, 0x0c, 0x5a, 0x26, 0x05, 0xc8, 0x6c, 0x37, 0xbe, 0x55, 0xaf, 0x10, 0x05, 0xb2,
+	0xf5, 0xc6, 0xd7, 0x2f, 0xd4, 0x14, 0x01, 0xc8, 0x1f, 0x3c, 0xdf, 0x79, 0xf9, 0xd5, 0x81, 0x9a,
+	0xd6, 0x7e, 0x2f, 0x05, 0x30, 0x98, 0xdd, 0x88, 0x99, 0x3c, 0x81, 0xc2, 0x11, 0x35, 0x3a, 0xd4,
+	0xf3, 0xd9, 0xc9, 0x2d, 0xad, 0xdf, 0x18, 0xd2, 0xc8, 0xda, 0x17, 0x9c, 0xcd, 0xcf, 0xa4, 0x14,
+	0xae, 0x3d, 0x85, 0x72, 0x9c, 0x71, 0xa1, 0xfd, 0x74, 0x13, 0x32, 0xb8, 0xf4, 0x4b, 0x90, 0x36,
+	0x3b, 0x62, 0xd9, 0xf3, 0x6f, 0xdf, 0xac, 0xa4, 0xf7, 0x76, 0xf4, 0xb4, 0xd9, 0xd1, 0xfe, 0x3d,
+	0x05, 0xca, 0x57, 0x34, 0x30, 0x70, 0x55, 0xc9, 0x4f, 0xa1, 0x64, 0xd8, 0xb6, 0x13, 0x30, 0x33,
+	0xea, 0x57, 0x53, 0x6c, 0x8c, 0xcb, 0x6c, 0x8c, 0x52, 0x66, 0x6d, 0x73, 0x20, 0xc0, 0x47, 0x19,
+	0xaf, 0x42, 0x1e, 0x43, 0xde, 0x32, 0x5a, 0xd4, 0x92, 0x13, 0xbc, 0x96, 0xac, 0xbc, 0xcf, 0x78,
+	0xbc, 0x9e, 0x10, 0xac, 0x7d, 0x06, 0xea, 0x70, 0x9b, 0x17, 0x99, 0x60, 0xed, 0xc7, 0x50, 0x8a,
+	0x35, 0x7b, 0x21, 0xdd, 0xfc, 0x7f, 0x28, 0x34, 0xa8, 0x77, 0x62, 0xb6, 0x71, 0xa7, 0xce, 0x98,
+	0x76, 0x40, 0x3d, 0xdb, 0xb0, 0x9a, 0xae, 0xe3, 0x05, 0xac, 0x81, 0x9c, 0x5e, 0x96, 0xc4, 0x03,
+	0xc7, 0x0b, 0x50, 0x88, 0x7e, 0x1f, 0x17, 0x4a, 0x73, 0x21, 0xfa, 0x7d, 0x4c, 0x08, 0x35, 0xcd,
+	0x77, 0xbc, 0xd4, 0xf4, 0x81, 0x9e, 0x36, 0x5d, 0x3c, 0xcb, 0xc1, 0xa9, 0x4b, 0xc5, 0x46, 0x63,
+	0xdf, 0xda, 0xbf, 0xa4, 0x20, 0xd7, 0x70, 0x9d, 0x30, 0x20, 0x37, 0xa0, 0xe8, 0x9c, 0x50, 0xef,
+	0xb5, 0x67, 0x06, 0xdc, 0xd4, 0x2b, 0xfa, 0x80, 0x40, 0xee, 0xa2, 0x61, 0x66, 0x03, 0x15, 0xa7,
+	0xae, 0x2c, 0x0c, 0x33, 0xa3, 0xe9, 0x92, 0x49, 0x96, 0x20, 0xdf, 0x37, 0xbc, 0x63, 0x1a, 0x79,
+	0x1c, 0x5e, 0x22, 0x1f, 0x40, 0xee, 0xd8, 0xe8, 0x1e, 0x1b, 0xac, 0xf3, 0xd2, 0xfa, 0x22, 0xab,
+	0xfd, 0x25, 0x52, 0x58, 0xef, 0x0d, 0x27, 0xf4, 0xda, 0x54, 0xe7, 0x32, 0x64, 0x0d, 0xf2, 0xa6,
+	0xdd, 0xa3, 0x7e, 0xc0, 0x5c, 0x53, 0x69, 0x7d, 0x89, 0x49, 0xef, 0x31, 0x52, 0x5c, 0x5c, 0x48,
+	0x91, 0x3b, 0x90, 0x6b, 0x19, 0x41, 0xfb, 0xa8, 0x9a, 0x8f, 0x9d, 0x72, 0x26, 0xb8, 0x85, 0x64,
+	0x9d, 0x73, 0xb5, 0x2d, 0x50, 0x87, 0x7b, 0x24, 0x55, 0x28, 0xb4, 0x3c, 0xe7, 0x98, 0x7a, 0x7c,
+	0xb3, 0x15, 0x75, 0x59, 0x64, 0x07, 0xd7, 0x71, 0xcd, 0xb6, 0x5c, 0x34, 0x56, 0xd0, 0x1c, 0x98,
+	0x3b, 0x33, 0x0e, 0xf2, 0x09, 0x28, 0xcc, 0x8b, 0xb7, 0x1d, 0x7e, 0xd8, 0x2a, 0xeb, 0x2b, 0xa3,
+	0x47, 0xbc, 0x76, 0x20, 0xc4, 0xf4, 0xa8, 0x82, 0x76, 0x13, 0x14, 0x49, 0xc5, 0x53, 0x8d, 0x47,
+	0x51, 0xbd, 0x82, 0x07, 0xfd, 0x70, 0xfb, 0x40, 0x4d, 0x69, 0xbf, 0x95, 0x02, 0x18, 0x4c, 0x85,
+	0xdc, 0x82, 0x72, 0xdf, 0xf8, 0xbe, 0xd9, 0xa7, 0xbe, 0x6f, 0xf4, 0xa8, 0xcf, 0xba, 0xcb, 0xe8,
+	0xa5, 0xbe, 0xf1, 0xfd, 0x57, 0x82, 0x84, 0x3e, 0x0d, 0x45, 0x5a, 0xa7, 0x01, 0xf5, 0xd9, 0xe0,
+	0x33, 0xba, 0xd2, 0x37, 0xbe, 0xdf, 0xc2, 0x32, 0x79, 0xc2, 0x99, 0x1d, 0x6a, 0x19, 0xa7, 0xc2,
+	0x28, 0x5e, 0x5b, 0xe3, 0x71, 0xcc, 0x9a, 0x8c, 0x63, 0xd6, 0x76, 0x44, 0x1c, 0xc3, 0xea, 0xed,
+	0xa0, 0xa8, 0xf6, 0x9f, 0x29, 0x50, 0x0e, 0x9e, 0x35, 0xf6, 0x6c, 0x37, 0x1c, 0x1d, 0x9c, 0x10,
+	0xc8, 0x7a, 0xd4, 0x75, 0x84, 0xb6, 0xd8, 0x37, 0x6e, 0x86, 0x96, 0x67, 0xd8, 0xed, 0x23, 0xb9,
+	0x19, 0x78, 0x09, 0xe9, 0x6d, 0xa7, 0xdf, 0x37, 0x03, 0xb1, 0x15, 0x45, 0x09, 0xdb, 0xe8, 0x59,
+	0x4e, 0x4b, 0x04, 0x24, 0xec, 0x1b, 0xa3, 0x8a, 0x57, 0x8e, 0x69, 0x37, 0x1d, 0xbb, 0xaa, 0x70,
+	0x61, 0x2c, 0x7e, 0x6d, 0xa3, 0xb0, 0x65, 0xfc, 0xec, 0x94, 0xad, 0xb9, 0xa2, 0xb3, 0x6f, 0xf4,
+	0xac, 0x2c, 0x82, 0x6b, 0xa2, 0x01, 0xf6, 0x85, 0x27, 0x06, 0x46, 0x42, 0x23, 0xea, 0x93, 0x0a,
+	0xa4, 0xfd, 0x8d, 0x6a, 0x91, 0xd1, 0xd3, 0xfe, 0x06, 0x6e, 0xeb, 0xc0, 0x33, 0x7b, 0x3d, 0xe1,
+	0xa1, 0xd9, 0xb6, 0xee, 0x62, 0x78, 0xc2, 0x68, 0xba, 0x64, 0x6a, 0x7f, 0x9a, 0x82, 0xe2, 0xb6,
+	0xe7, 0xd8, 0x17, 0x9e, 0xbf, 0x98, 0x67, 0x66, 0x78, 0x9e, 0xbe, 0x4b, 0xdb, 0xf2, 0x20, 0xe2,
+	0x77, 0xf2, 0xf8, 0xe5, 0x87, 0x8f, 0xdf, 0x47, 0x18, 0xbd, 0x18, 0x9e, 0x3c, 0x10, 0xb5, 0x33,
+	0x4b, 0x76, 0x28, 0x63, 0x53, 0x9d, 0x0b, 0x6a, 0x26, 0x28, 0xcf, 0xcd, 0xe0, 0xfc, 0xf1, 0x5e,
+	0xe3, 0xbe, 0x81, 0x0d, 0x77, 0xab, 0xf0, 0xf6, 0xcd, 0x0a, 0x7a, 0x58, 0xee, 0x24, 0x2e, 0xb8,
+	0x6c, 0xda, 0xdf, 0xa5, 0x20, 0xc7, 0x3b, 0x5a, 0x81, 0x8c, 0xdb, 0xf5, 0xc5, 0x31, 0x9c, 0x61,
+	0x67, 0x40, 0x6e, 0x1a, 0x1d, 0x39, 0x64, 0x19, 0xb2, 0xb8, 0x7c, 0xd5, 0x02, 0xb3, 0xcd, 0x20,
+	0x4e, 0x09, 0xb2, 0x19, 0x9d, 0xac, 0x42, 0xae, 0xed, 0x39, 0xbe, 0x34, 0xde, 0x71, 0x01, 0xce,
+	0x40, 0x89, 0xd0, 0x36, 0x1d, 0xbb, 0x9a, 0x39, 0x2b, 0xc1, 0x18, 0x44, 0x83, 0x6c, 0xdb, 0x73,
+	0xec, 0x6a, 0x36, 0x16, 0x1d, 0x44, 0x6b, 0xa7, 0x33, 0x1e, 0x0e, 0xb4, 0x67, 0x4a, 0x6d, 0xf2,
+	0x81, 0x4a, 0x6d, 0xe9, 0xc8, 0xd1, 0x8e, 0x41, 0xa9, 0x3b, 0xad, 0xa4, 0xfa, 0xb2, 0x31, 0xf5,
+	0xdd, 0x8e, 0x74, 0x91, 0x62, 0x6d, 0x94, 0xd8, 0xbe, 0xd9, 0x66, 0xa4, 0x33, 0xfb, 0x39, 0x1d,
+	0xdb, 0xcf, 0x72, 0xdb, 0x66, 0x06, 0xdb, 0x56, 0x7b, 0x09, 0xb3, 0x07, 0x86, 0x67, 0x58, 0x16,
+	0xb5, 0x4c, 0xbf, 0xcf, 0xe2, 0xae, 0x1a, 0x28, 0x6d, 0xc7, 0xf6, 0x03, 0xc3, 0xe6, 0x36, 0x3e,
+	0xab, 0x47, 0x65, 0xb2, 0x0a, 0xa5, 0xb6, 0x43, 0xbb, 0x5d, 0xb3, 0x8d, 0x89, 0x04, 0x6b, 0x29,
+	0xa5, 0xc7, 0x49, 0xf5, 0xac, 0x92, 0x52, 0xd3, 0xda, 0x03, 0x28, 0x7f, 0x61, 0xf8, 0x47, 0x81,
+	0x47, 0xe9, 0x99, 0x36, 0x53, 0xc9, 0x36, 0xb5, 0x0d, 0x28, 0xb2, 0xc9, 0xe2, 0x31, 0x89, 0x82,
+	0xbe, 0x6c, 0x2c, 0xe8, 0x23, 0x90, 0x3d, 0x32, 0x7c, 0x9e, 0x2c, 0x94, 0x75, 0xf6, 0xad, 0x7d,
+	0x02, 0xb9, 0x1d, 0x23, 0x08, 0xfb, 0xe7, 0xf9, 0x76, 0x52, 0x83, 0xcc, 0x2b, 0x31, 0xff, 0xd2,
+	0xba, 0xc2, 0xd4, 0x8c, 0xa1, 0x1e, 0x12, 0xb5, 0x5f, 0xa6, 0xa0, 0xc8, 0x6a, 0xef, 0xd9, 0x5d,
+	0x07, 0x97, 0xb5, 0x83, 0x05, 0xa1, 0x4e, 0xbe, 0xac, 0x8c, 0xad, 0x73, 0x06, 0x1a, 0x79, 0x3f,
+	0x30, 0x02, 0x2a, 0xc2, 0xaf, 0xd9, 0x81, 0x44, 0x03, 0xc9, 0x3a, 0xe7, 0x92, 0xf7, 0xb9, 0x98,
+	0x2f, 0x8c, 0xdb, 0x1c, 0xdf, 0x84, 0x9e, 0xd3, 0xa6, 0xbe, 0x8f, 0x82, 0x3e, 0x17, 0xf4, 0xc9,
+	0x5d, 0x28, 0xba, 0x5d, 0xbf, 0xc9, 0xdb, 0xe4, 0x7b, 0xa5, 0xc8, 0x16, 0x11, 0x55, 0xa0, 0x2b,
+	0x6e, 0x97, 0x89, 0x53, 0x72, 0x0b, 0xb2, 0x18, 0x39, 0xb0, 0xbc, 0x81, 0xed, 0x15, 0x21, 0x82,
+	0xc3, 0xd6, 0x19, 0x4b, 0xfb, 0xb3, 0x14, 0x14, 0x37, 0x7b, 0x3d, 0x8f, 0xf6, 0xb0, 0xc2, 0x02,
+	0xe4, 0xda, 0x98, 0xa9, 0x08, 0xdb, 0xcc, 0x0b, 0xa8, 0xbf, 0x3e, 0x35, 0x6c, 0x36, 0xfa, 0x94,
+	0xce, 0xbe, 0xf1, 0x40, 0xf9, 0x41, 0xa7, 0x43, 0x4f, 0xc4, 0x1a, 0x8a, 0x12, 0xb9, 0x0f, 0x6a,
+	0xd7, 0xec, 0x06, 0x47, 0x4d, 0x97, 0x7a, 0x6d, 0x6a, 0x07, 0xa6, 0xc5, 0x47, 0x98, 0xd2, 0x67,
+	0x19, 0xfd, 0x20, 0x22, 0x93, 0x27, 0x70, 0xd5, 0x36, 0x6d, 0xca, 0x4c, 0xde, 0x50, 0x8d, 0x1c,
+	0xab, 0xb1, 0xc8, 0xd9, 0xcf, 0x92, 0xf5, 0xb4, 0x7f, 0x4a, 0x43, 0x39, 0xae, 0x15, 0xf2, 0x19,
+	0xcc, 0x74, 0x9c, 0xd7, 0xb6, 0xe5, 0x18, 0x9d, 0x26, 0x26, 0xba, 0xd5, 0xd4, 0x24, 0xe7, 0x50,
+	0x96, 0xf2, 0x68, 0x7b, 0xc8, 0xa7, 0x50, 0x76, 0x79, 0x7b, 0xbc, 0x7a, 0x7a, 0x52, 0xf5, 0x92,
+	0x10, 0x67, 0xb5, 0x9f, 0x42, 0x29, 0x74, 0x07, 0x7d, 0x4f, 0x74, 0x4c, 0xc0, 0xa5, 0x59, 0xdd,
+	0x3b, 0x50, 0x89, 0x46, 0xce, 0x9d, 0x5e, 0x96, 0x6d, 0xee, 0x68, 0x3e, 0xdc, 0xf3, 0xdd, 0x82,
+	0x72, 0xe8, 0xc6, 0x84, 0x72, 0x4c, 0x48, 0x74, 0xcb, 0x45, 0x56, 0xa0, 0xd4, 0x76, 0x43, 0x4c,
+	0x25, 0x1d, 0xbb, 0xc3, 0xcd, 0x58, 0x4a, 0x87, 0xb6, 0x1b, 0x36, 0x38, 0x85, 0xac, 0xc1, 0x7c,
+	0x9f, 0xf6, 0x1d, 0xef, 0x94, 0xb5, 0x11, 0x09, 0x16, 0x98, 0xe0, 0x1c, 0x67, 0x61, 0x53, 0x42,
+	0x5e, 0xfb, 0xc3, 0x34, 0x2c, 0x46, 0x1b, 0x23, 0xa1, 0xee, 0x8d, 0xd1, 0xea, 0xe6, 0xd6, 0x2a,
+	0xaa, 0x32, 0xa4, 0xe3, 0xc7, 0x23, 0x75, 0x3c, 0x5c, 0x27, 0xa1, 0xd8, 0x47, 0xa3, 0x14, 0x3b,
+	0x5c, 0x23, 0xae, 0xcd, 0x8f, 0x47, 0x6a, 0xf3, 0x6c, 0x9d, 0x21, 0xed, 0x3e, 0x1e, 0xa1, 0xdd,
+	0x11, 0x43, 0x8b, 0x69, 0x5b, 0xfb, 0x55, 0x1a, 0xca, 0xff, 0xcb, 0xc1, 0xe8, 0x10, 0x55, 0x12,
+	0xfa, 0xe4, 0x3e, 0x14, 0x5f, 0xb3, 0x72, 0x33, 0x32, 0x26, 0xe5, 0xb7, 0x6f, 0x56, 0x14, 0x2e,
+	0xb4, 0xb7, 0xa3, 0x2b, 0x9c, 0xbd, 0xd7, 0xc1, 0x3c, 0xf2, 0x95, 0xd3, 0x42, 0xb9, 0xf4, 0x20,
+	0x8f, 0x44, 0x83, 0xbd, 0xa3, 0xe7, 0x5e, 0x39, 0xad, 0xbd, 0x0e, 0x7a, 0x01, 0x76, 0x6c, 0xb9,
+	0x9b, 0xa8, 0x0c, 0xdc, 0x04, 0x3b, 0xde, 0x8c, 0x47, 0x7e, 0x00, 0x05, 0xe6, 0x2c, 0x69, 0xa7,
+	0x9a, 0x9d, 0xe8, 0x57, 0xa5, 0xe8, 0xc0, 0xc2, 0xe4, 0x26, 0x58, 0x98, 0x9b, 0x00, 0xdf, 0x85,
+	0x34, 0xa4, 0x4d, 0xdf, 0xfc, 0x19, 0xf7, 0xe9, 0x19, 0xbd, 0xc8, 0x28, 0x0d, 0xf3, 0x67, 0x7c,
+	0xdf, 0x1a, 0x81, 0xd1, 0x14, 0xcb, 0x45, 0x3b, 0x6c, 0x1f, 0x65, 0xf4, 0x19, 0xa4, 0x1e, 0x48,
+	0x62, 0x24, 0xe6, 0xd1, 0x36, 0xc6, 0x03, 0xb4, 0x53, 0x55, 0x06, 0x62, 0xba, 0x24, 0x6a, 0x1e,
+	0x94, 0x75, 0xea, 0xb3, 0x28, 0x93, 0x19, 0x7b, 0xc4, 0x67, 0xdc, 0x90, 0xa9, 0x31, 0xad, 0xe3,
+	0x27, 0x0b, 0xcd, 0xd9, 0x0e, 0x15, 0xfe, 0x48, 0x94, 0xc8, 0x32, 0x64, 0x7a, 0x6e, 0x58, 0xcd,
+	0xc5, 0xc2, 0xfa, 0xe7, 0x07, 0x2f, 0xb1, 0x11, 0x1d, 0x19, 0x68, 0xb9, 0x3a, 0xa6, 0x7f, 0x2c,
+	0xbd, 0x01, 0x7e, 0xd7, 0xb3, 0x4a, 0x46, 0xcd, 0x6a, 0x1f, 0x43, 0x41, 0x48, 0x46, 0xb9, 0x45,
+	0x6a, 0x90, 0x5b, 0x60, 0x87, 0x76, 0xd8, 0x6f, 0x51, 0x4f, 0x44, 0xa1, 0xa2, 0xa4, 0xfd, 0x22,
+	0x0d, 0x33, 0x72, 0xac, 0x2f, 0x7d, 0x81, 0x1a, 0xf4, 0x3c, 0x27, 0x74, 0x45, 0x75, 0x5e, 0xc0,
+	0x36, 0x5f, 0x39, 0x2d, 0x19, 0xc3, 0xb2, 0xef, 0xe1, 0x23, 0x9a, 0x99, 0xf6, 0x88, 0x66, 0xcf,
+	0x39, 0xa2, 0x23, 0xac, 0x47, 0x6e, 0x1a, 0xeb, 0x91, 0x3f, 0x6b, 0x3d, 0xa6, 0x5c, 0xcf, 0x5b,
+	0x50, 0x66, 0x62, 0xfe, 0xb1, 0xe9, 0xba, 0xd1, 0x6a, 0x96, 0x90, 0xd6, 0xe0, 0x24, 0x9c, 0x24,
+	0x13, 0xe9, 0x1a, 0xa6, 0x45, 0x3b, 0x2c, 0x5c, 0xcd, 0xe8, 0x80, 0xa4, 0x67, 0x8c, 0xa2, 0xfd,
+	0x46, 0x0e, 0x4a, 0xbb, 0x41, 0xbb, 0xc3, 0x42, 0x94, 0xae, 0x23, 0xfd, 0x6c, 0x6a, 0x84, 0x9f,
+	0x25, 0xf7, 0x41, 0x71, 0x4d, 0x97, 0x5a, 0xa6, 0x2d, 0x0d, 0x86, 0x08, 0xcc, 0x04, 0x51, 0x8f,
+	0xd8, 0xe4, 0x23, 0x98, 0x71, 0xc2, 0xc0, 0x0d, 0x83, 0x66, 0x2c, 0x6c, 0x1d, 0x8a, 0x6d, 0xca,
+	0x5c, 0x82, 0x97, 0x30, 0x7d, 0xf2, 0x28, 0x8f, 0x4c, 0xb9, 0xd1, 0x95, 0xc5, 0x11, 0xda, 0xc8,
+	0x4d, 0xa3, 0x8d, 0xfc, 0x59, 0x6d, 0xdc, 0x04, 0x36, 0xf5, 0x66, 0xe0, 0x04, 0x86, 0x25, 0x74,
+	0x5a, 0x44, 0xca, 0x21, 0x12, 0x86, 0x95, 0xa5, 0x0c, 0x2b, 0x6b, 0xc4, 0x01, 0x9a, 0x1d, 0x71,
+	0x80, 0xd0, 0xe9, 0x32, 0xb1, 0xef, 0x42, 0xc3, 0x33, 0xec, 0xc0, 0xb4, 0x69, 0xa7, 0xaa, 0x32,
+	0xc1, 0x59, 0xa4, 0x7f, 0x33, 0x20, 0x0f, 0x2c, 0x40, 0x71, 0x82, 0x05, 0x58, 0x83, 0x32, 0xfb,
+	0x90, 0xfa, 0x84, 0xb3, 0xfa, 0x2c, 0x31, 0x01, 0x5e, 0x20, 0xb7, 0x65, 0x8c, 0x53, 0x62, 0x31,
+	0xce, 0x8c, 0x5c, 0xc9, 0x44, 0x84, 0xb3, 0x04, 0x79, 0x8f, 0x1a, 0xbe, 0x63, 0x0b, 0x64, 0x50,
+	0x94, 0xe2, 0xd6, 0x6c, 0x66, 0x7a, 0x6b, 0xf6, 0x04, 0x94, 0xae, 0x69, 0x9b, 0xfe, 0x11, 0xed,
+	0x54, 0x2b, 0x13, 0xab, 0x45, 0xb2, 0xda, 0xbf, 0x56, 0xa0, 0x30, 0xcd, 0xf6, 0x7b, 0x08, 0xc5,
+	0x40, 0x82, 0xbd, 0x09, 0x87, 0x15, 0x41, 0xc0, 0xfa, 0x40, 0x20, 0xb1, 0x59, 0x33, 0xe3, 0x37,
+	0xeb, 0x7d, 0x50, 0xe5, 0x77, 0xf3, 0x84, 0x7a, 0x3e, 0xe6, 0x04, 0x33, 0x6c, 0x0f, 0xce, 0x4a,
+	0xfa, 0xb7, 0x9c, 0x4c, 0x1e, 0x42, 0x09, 0x73, 0x2c, 0xb9, 0x0a, 0x8f, 0xce, 0xae, 0x02, 0x20,
+	0x9f, 0x7f, 0x93, 0xcf, 0x11, 0x9b, 0x8b, 0xa2, 0xf1, 0x26, 0x72, 0x98, 0xa6, 0x4b, 0xeb, 0x0b,
+	0x7c, 0x2c, 0xc9, 0x50, 0x5d, 0x9f, 0x75, 0x93, 0x04, 0xcc, 0x0d, 0x28, 0x03, 0xd3, 0x04, 0x3e,
+	0x5b, 0x62, 0xd5, 0x04, 0xe2, 0x28, 0x58, 0xe4, 0x7d, 0x00, 0xd7, 0xf0, 0xa8, 0x1d, 0x30, 0x34,
+	0x34, 0x3f, 0xa4, 0xba, 0x22, 0xe7, 0x21, 0x6e, 0x16, 0x5b, 0xd6, 0xc2, 0xe5, 0x96, 0x55, 0x99,
+	0x7e, 0x59, 0xcf, 0x9a, 0x80, 0xe2, 0x24, 0x13, 0x10, 0xed, 0x59, 0x98, 0x6a, 0xcf, 0xde, 0x4e,
+	0xec, 0xd9, 0x18, 0xac, 0x54, 0x19, 0x07, 0x2b, 0xad, 0x42, 0xce, 0x77, 0x9d, 0x30, 0xa8, 0x7e,
+	0x18, 0x4b, 0x0f, 0x18, 0x2c, 0xa2, 0x73, 0x06, 0x79, 0x00, 0x25, 0x31, 0x70, 0x96, 0x86, 0x93,
+	0x58, 0x40, 0xaf, 0x53, 0xd7, 0xd1, 0x81, 0x73, 0xf1, 0x1b, 0x51, 0x34, 0x21, 0x2b, 0xf2, 0xdc,
+	0x39, 0x36, 0x28, 0x31, 0xaf, 0x2d, 0x46, 0x8b, 0x9b, 0xb6, 0x85, 0x49, 0xa6, 0x6d, 0x69, 0x1a,
+	0xd3, 0xb6, 0x3c, 0xd1, 0xd0, 0xdf, 0x9b, 0xc2, 0x76, 0xad, 0x4d, 0x6b, 0xbb, 0xd6, 0x47, 0xdb,
+	0xae, 0xa4, 0x35, 0xbd, 0x3a, 0x6c, 0x4d, 0x23, 0xd3, 0xb6, 0x32, 0xc1, 0xb4, 0x3d, 0x81, 0x19,
+	0x11, 0xac, 0xf9, 0x2c, 0x7a, 0xab, 0x56, 0x57, 0x33, 0x51, 0x85, 0x78, 0x58, 0xa7, 0x97, 0x5f,
+	0xc7, 0x4a, 0xe4, 0x33, 0x98, 0xf3, 0x84, 0xef, 0x6f, 0x7a, 0xf4, 0xbb, 0x90, 0xfa, 0x81, 0x5f,
+	0xbd, 0x16, 0xeb, 0x2c, 0x1e, 0xc5, 0xe8, 0xaa, 0x94, 0xd5, 0x85, 0x28, 0x79, 0x0a, 0xb3, 0x51,
+	0x7d, 0xcb, 0xec, 0x9b, 0x81, 0x5f, 0x7d, 0xef, 0xbc, 0xda, 0x15, 0x29, 0xb9, 0xcf, 0x04, 0xc9,
+	0x1e, 0x5c, 0xf5, 0xcd, 0x0e, 0x6d, 0x1b, 0x5e, 0x73, 0xb8, 0x8d, 0x8f, 0xce, 0x6b, 0x63, 0x51,
+	0xd4, 0xd0, 0x93, 0x4d, 0xad, 0x42, 0xce, 0xc4, 0x68, 0xb2, 0x5a, 0x8b, 0x6d, 0x48, 0x01, 0x43,
+	0x30, 0x06, 0x59, 0x03, 0xb0, 0xe9, 0x6b, 0xb9, 0xc3, 0xae, 0x4b, 0x64, 0xb2, 0xeb, 0xaf, 0xf1,
+	0x0d, 0xc6, 0xf2, 0xc7, 0xa2, 0x4d, 0x5f, 0xf3, 0xe2, 0x19, 0x5f, 0x71, 0x73, 0x82, 0xaf, 0xb8,
+	0x05, 0x65, 0x6a, 0xe3, 0x65, 0x42, 0x93, 0x2f, 0xd8, 0x2a, 0x03, 0x14, 0x4a, 0x9c, 0xc6, 0x93,
+	0x0c, 0xc4, 0x99, 0x0c, 0x2b, 0xa8, 0xde, 0x12, 0x38, 0x93, 0x61, 0x05, 0xe4, 0x43, 0x80, 0xf6,
+	0x51, 0x68, 0x1f, 0x73, 0xbb, 0x76, 0x27, 0x8e, 0x91, 0x20, 0x99, 0xcd, 0xb9, 0xd8, 0x96, 0x9f,
+	0x2c, 0x2d, 0xc4, 0x1c, 0x9b, 0xa5, 0x0f, 0x78, 0x00, 0xef, 0x4e, 0x4e, 0x0b, 0x51, 0xfe, 0x90,
+	0x8b, 0x63, 0x62, 0x87, 0x81, 0xba, 0xac, 0xfd, 0xfe, 0xa4, 0xda, 0xf0, 0xca, 0x69, 0xc9, 0xba,
+	0xfc, 0x74, 0x60, 0xdf, 0x9e, 0x49, 0xfd, 0xea, 0xfd, 0xe8, 0x74, 0x84, 0xfd, 0x43, 0xa4, 0x90,
+	0x0d, 0x28, 0x7b, 0x34, 0xf0, 0x4e, 0x9b, 0xae, 0x63, 0x99, 0xed, 0xd3, 0xea, 0xe3, 0xd5, 0x54,
+	0x74, 0x65, 0xa8, 0x23, 0xe3, 0x80, 0xd1, 0xf5, 0x92, 0x37, 0x28, 0x90, 0x4f, 0x61, 0xd6, 0x6f,
+	0x1f, 0xd1, 0x4e, 0x68, 0xe1, 0x05, 0x1c, 0xd3, 0xc2, 0x03, 0x56, 0x6f, 0x9e, 0x1b, 0x95, 0x88,
+	0xc7, 0xb7, 0x90, 0x9f, 0x28, 0x93, 0x6b, 0xa0, 0xb8, 0x4e, 0x87, 0x57, 0xfb, 0x80, 0xa9, 0xb5,
+	0xe0, 0x3a, 0xfc, 0xaa, 0xec, 0x3a, 0x14, 0x91, 0xe5, 0x32, 0x24, 0xfa, 0x21, 0xe3, 0xa1, 0xec,
+	0x01, 0x96, 0xc9, 0x43, 0x50, 0x50, 0x07, 0xcc, 0xb1, 0x6d, 0xc4, 0x86, 0x59, 0xe7, 0xd3, 0xe5,
+	0xbe, 0x4d, 0x4a, 0xd4, 0xb3, 0x4a, 0x56, 0xcd, 0xd5, 0xb3, 0x4a, 0x4e, 0xcd, 0xd7, 0xb3, 0xca,
+	0x0d, 0xf5, 0x66, 0x3d, 0xab, 0x68, 0xea, 0x6d, 0xed, 0x2f, 0xd3, 0x50, 0x96, 0xe2, 0x0d, 0xd7,
+	0xb0, 0xc9, 0x6d, 0x28, 0xf8, 0xae, 0x61, 0x0f, 0x12, 0x26, 0x78, 0xfb, 0x66, 0x25, 0x8f, 0xac,
+	0xbd, 0x1d, 0x3d, 0x8f, 0xac, 0x3d, 0xf4, 0x05, 0x15, 0xe1, 0x6a, 0xa4, 0x2c, 0x4f, 0x9a, 0xd4,
+	0xb7, 0x6f, 0x56, 0xca, 0x07, 0x8c, 0x23, 0x6a, 0x94, 0xdd, 0x41, 0xa9, 0x13, 0xe1, 0x5e, 0x99,
+	0x18, 0xee, 0xb5, 0x04, 0x79, 0x7e, 0x9c, 0x25, 0x06, 0xc8, 0x4b, 0xe4, 0x2e, 0x28, 0x7c, 0xad,
+	0x4c, 0x1e, 0xe8, 0x15, 0xb7, 0x4a, 0x6f, 0xdf, 0xac, 0x14, 0x38, 0xc0, 0xb3, 0xa3, 0x17, 0x18,
+	0x73, 0xaf, 0x33, 0x00, 0x32, 0xf3, 0x53, 0x02, 0x99, 0xe4, 0x21, 0xde, 0x1e, 0x4f, 0xe3, 0xfb,
+	0x50, 0x0c, 0x33, 0x09, 0xea, 0x79, 0x8e, 0x27, 0xc0, 0x62, 0x5e, 0xd0, 0xba, 0x50, 0x8a, 0x29,
+	0x9a, 0x19, 0x39, 0xd7, 0x88, 0xee, 0x97, 0xf8, 0xa9, 0x8f, 0xeb, 0x55, 0xe7, 0x7c, 0x4c, 0x26,
+	0xf8, 0xac, 0x58, 0xb1, 0xd9, 0xf1, 0x1c, 0x66, 0xc9, 0x79, 0x42, 0x32, 0xc7, 0x58, 0x28, 0xee,
+	0xef, 0x70, 0x86, 0xb6, 0x03, 0x79, 0x6e, 0xfa, 0x46, 0x42, 0xae, 0x77, 0x93, 0x08, 0x96, 0x3a,
+	0x64, 0x2a, 0xa5, 0xb3, 0xd4, 0x36, 0x04, 0xf6, 0xd8, 0x75, 0x30, 0x4c, 0x50, 0x58, 0xa2, 0x6b,
+	0x77, 0x1d, 0x31, 0xda, 0xb2, 0xdc, 0x37, 0x28, 0xa0, 0x17, 0x5e, 0xf1, 0x0f, 0x6d, 0x19, 0x14,
+	0x19, 0x24, 0x8d, 0xea, 0x5c, 0xfb, 0xe3, 0x0c, 0xa8, 0x98, 0x32, 0x48, 0x21, 0xac, 0x44, 0xee,
+	0xc9, 0x11, 0xf1, 0x5b, 0x0b, 0x92, 0x88, 0xb5, 0xce, 0x71, 0xe0, 0xd9, 0x84, 0x03, 0x1f, 0x0a,
+	0xad, 0xd2, 0xe3, 0x43, 0xab, 0x6d, 0xc0, 0xf3, 0xdd, 0x64, 0x88, 0x98, 0x2f, 0x52, 0xf3, 0xf7,
+	0x78, 0x74, 0x34, 0x34, 0x34, 0x9c, 0xe0, 0x36, 0x13, 0xe3, 0x77, 0x75, 0xc5, 0x57, 0xb2, 0x8c,
+	0x1e, 0xcc, 0x08, 0x83, 0xa3, 0x66, 0xe0, 0x1c, 0x53, 0x5b, 0x3e, 0x5e, 0x40, 0xca, 0x21, 0x12,
+	0xc8, 0x06, 0x54, 0x2c, 0xc3, 0x67, 0x61, 0x95, 0x00, 0xf7, 0xf2, 0xa3, 0x02, 0x93, 0x32, 0x0a,
+	0xc9, 0x12, 0x42, 0xaa, 0xb1, 0x28, 0x8e, 0x6d, 0xb6, 0xac, 0x1e, 0x27, 0xa1, 0xaa, 0x42, 0xcc,
+	0x55, 0x45, 0x34, 0x45, 0x12, 0x9e, 0x82, 0x65, 0xb1, 0x3a, 0x17, 0xa8, 0x7d, 0x0a, 0x95, 0xe4,
+	0xe0, 0xe3, 0x37, 0x82, 0xb9, 0x11, 0x37, 0x82, 0xb9, 0xf8, 0x8d, 0xe0, 0x3f, 0xcc, 0x42, 0x39,
+	0xb1, 0x46, 0x1c, 0x5b, 0x9d, 0x3b, 0x83, 0xad, 0xc6, 0x43, 0xe5, 0xd4, 0xf8, 0x50, 0xb9, 0x0a,
+	0x05, 0x19, 0x21, 0x97, 0x78, 0x28, 0x73, 0x12, 0x45, 0xc6, 0x17, 0x89, 0xce, 0x1f, 0x46, 0xb7,
+	0xf7, 0x6b, 0x31, 0xaf, 0xc7, 0xae, 0xef, 0xcf, 0xde, 0xe4, 0x8f, 0x8c, 0xa3, 0xe1, 0x22, 0x71,
+	0xf4, 0x13, 0x98, 0x39, 0x12, 0xf8, 0x75, 0xdc, 0x4e, 0xf3, 0xe3, 0x1a, 0x47, 0xb6, 0xf5, 0xf2,
+	0x51, 0xac, 0x34, 0x5d, 0xfc, 0xfd, 0x63, 0x80, 0xb6, 0x47, 0x8d, 0x80, 0x76, 0x9a, 0xc6, 0x34,
+	0xd6, 0xa8, 0x28, 0xa4, 0x37, 0x83, 0xc1, 0xa9, 0x29, 0x4c, 0x3a, 0x35, 0x55, 0x8c, 0xdd, 0xb9,
+	0xcd, 0xb8, 0xcb, 0xdc, 0xb3, 0x2c, 0xa2, 0xf7, 0xf6, 0x28, 0x82, 0xb1, 0xcd, 0xb8, 0xb9, 0x2a,
+	0x71, 0xda, 0x2e, 0x92, 0xc8, 0x07, 0x30, 0xc7, 0x8d, 0xab, 0x2f, 0x03, 0x25, 0xda, 0x61, 0x2e,
+	0x2e, 0xa3, 0xab, 0x82, 0xa1, 0x4b, 0x7a, 0x5c, 0xd8, 0x38, 0x31, 0x4c, 0x8b, 0x3d, 0x39, 0x58,
+	0x4f, 0x08, 0x6f, 0x4a, 0x3a, 0xf9, 0x3c, 0x71, 0x0c, 0x8b, 0xec, 0x18, 0xae, 0x26, 0x66, 0x31,
+	0xe1, 0x08, 0x9e, 0x3d, 0x63, 0x1f, 0x4c, 0x3e, 0x63, 0x67, 0xa2, 0x6e, 0x75, 0x44, 0xd4, 0x3d,
+	0x32, 0x3c, 0x9c, 0x7f, 0xa7, 0xf0, 0x70, 0xe5, 0xd7, 0x10, 0x1e, 0x6e, 0x5c, 0x36, 0x3c, 0x5c,
+	0x38, 0x2f, 0x3c, 0x5c, 0x85, 0x52, 0x87, 0xfa, 0x6d, 0xcf, 0x74, 0x31, 0xee, 0xa9, 0x2e, 0xf2,
+	0xf5, 0x8f, 0x91, 0xd0, 0xce, 0xb5, 0x8d, 0xf6, 0x91, 0x80, 0x0f, 0xaf, 0x72, 0x3b, 0xc7, 0x28,
+	0x0c, 0x3e, 0x1c, 0x8e, 0xff, 0xaa, 0xe7, 0xc7, 0x7f, 0xd7, 0x62, 0xf1, 0xdf, 0xc0, 0x90, 0xdf,
+	0x48, 0x18, 0xf2, 0xf7, 0xa0, 0x82, 0x17, 0xc3, 0x31, 0xc0, 0xf2, 0x26, 0xdb, 0x3d, 0x78, 0xdd,
+	0xfc, 0x4d, 0x84, 0x59, 0xc6, 0xf2, 0xb5, 0xe5, 0x77, 0xcb, 0xd7, 0x92, 0x71, 0xe8, 0xea, 0x85,
+	0xe3, 0xd0, 0x5b, 0xef, 0x14, 0x87, 0x6a, 0x17, 0x89, 0x43, 0x1f, 0x41, 0xa9, 0x67, 0x06, 0x47,
+	0x8e, 0x73, 0xdc, 0xc4, 0x2b, 0x53, 0x96, 0xc1, 0x6e, 0x55, 0xde, 0xbe, 0x59, 0x81, 0xe7, 0x9c,
+	0x8c, 0x37, 0xa7, 0x20, 0x44, 0x5e, 0x7a, 0xd6, 0xb0, 0x53, 0x7c, 0x6f, 0xbc, 0x53, 0x64, 0x46,
+	0xc2, 0xb0, 0x3b, 0xad, 0xd3, 0xea, 0x1d, 0x69, 0x24, 0x58, 0x71, 0x38, 0x00, 0x7e, 0x7f, 0x62,
+	0x00, 0xfc, 0x83, 0x4b, 0x06, 0xc0, 0xf7, 0x2e, 0x17, 0x00, 0xdf, 0xbf, 0x40, 0x00, 0xbc, 0x08,
+	0x79, 0x7f, 0xa3, 0xe9, 0x84, 0x1c, 0x7e, 0x51, 0xf4, 0x9c, 0xbf, 0xf1, 0x75, 0x18, 0xa0, 0x17,
+	0xeb, 0x8b, 0xb7, 0x39, 0x22, 0x07, 0x9b, 0x49, 0x3c, 0xd8, 0xd1, 0x23, 0x36, 0x79, 0x0c, 0x4a,
+	0x40, 0xfb, 0xae, 0x85, 0xe6, 0xe6, 0xe3, 0xd8, 0x2b, 0x12, 0x69, 0xb3, 0x0e, 0x05, 0x53, 0x8f,
+	0xc4, 0x06, 0x4e, 0xfb, 0xc9, 0x7f, 0xab, 0xd3, 0xe6, 0x70, 0x78, 0x14, 0xb5, 0x2f, 0xa9, 0x57,
+	0xeb, 0x59, 0xa5, 0xa6, 0x5e, 0xaf, 0x67, 0x95, 0xeb, 0xea, 0x8d, 0x7a, 0x56, 0x21, 0xea, 0xbc,
+	0xf6, 0x1c, 0x66, 0xe2, 0xd6, 0x95, 0x65, 0xd0, 0x11, 0x80, 0x15, 0x8b, 0xef, 0xe6, 0xce, 0x18,
+	0x62, 0xbd, 0xec, 0xc6, 0x4a, 0xda, 0x3f, 0xe7, 0x40, 0xdd, 0x66, 0xce, 0x08, 0x9d, 0x2d, 0x37,
+	0x7c, 0xef, 0x84, 0xf2, 0x5e, 0xbb, 0x00, 0xca, 0x5b, 0x9b, 0x04, 0x85, 0x5c, 0x9f, 0x06, 0x0a,
+	0xb9, 0x31, 0x09, 0xe5, 0xbd, 0x39, 0x01, 0xe5, 0x5d, 0x9e, 0x02, 0x29, 0x59, 0x99, 0x16, 0x29,
+	0xb9, 0x3b, 0x01, 0xe5, 0x5d, 0xbd, 0x20, 0xca, 0x7b, 0x6b, 0x5a, 0x94, 0x57, 0xbb, 0x04, 0x62,
+	0x16, 0x83, 0x03, 0xdf, 0xbb, 0x1c, 0x1c, 0x78, 0x67, 0x7a, 0x38, 0x70, 0x68, 0x63, 0xa7, 0xd4,
+	0x74, 0x3d, 0xab, 0x80, 0x5a, 0xaa, 0x67, 0x95, 0x82, 0xaa, 0xd4, 0xb3, 0x4a, 0x51, 0x85, 0x7a,
+	0x56, 0x51, 0xd4, 0x62, 0x3d, 0xab, 0x94, 0xd5, 0x99, 0x7a, 0x56, 0x29, 0xa9, 0xe5, 0x7a, 0x56,
+	0x99, 0x51, 0x2b, 0xf5, 0xac, 0x52, 0x51, 0x67, 0xeb, 0x59, 0x65, 0x51, 0x5d, 0xaa, 0x67, 0x95,
+	0x59, 0x55, 0xad, 0x67, 0x15, 0x55, 0x9d, 0xab, 0x67, 0x95, 0x39, 0x95, 0xf0, 0x43, 0x51, 0xcf,
+	0x2a, 0xf3, 0xea, 0x42, 0x3d, 0xab, 0x2c, 0xa8, 0x8b, 0xd1, 0xc1, 0xb9, 0xaa, 0x56, 0xeb, 0x59,
+	0xa5, 0xaa, 0x5e, 0xd3, 0xfe, 0x3c, 0x85, 0xaf, 0xad, 0xd0, 0xd4, 0x04, 0xb1, 0xad, 0x3e, 0x0e,
+	0x6d, 0xbe, 0xf8, 0x0d, 0xc6, 0x0a, 0x94, 0x5a, 0x96, 0xd3, 0x3e, 0x6e, 0x0e, 0x52, 0x33, 0x45,
+	0x07, 0x46, 0xe2, 0x61, 0x0b, 0x81, 0x6c, 0x37, 0xb4, 0x2c, 0x96, 0xf7, 0x28, 0x3a, 0xfb, 0xc6,
+	0x97, 0x14, 0x51, 0x36, 0x9f, 0x63, 0xf4, 0xa8, 0xac, 0xfd, 0x2a, 0x05, 0x95, 0x7d, 0xd3, 0x0f,
+	0xce, 0x39, 0x9c, 0x13, 0x42, 0xf5, 0x35, 0x28, 0x9b, 0x76, 0x6c, 0xfc, 0xfc, 0x1d, 0x4c, 0x72,
+	0x2f, 0x31, 0x01, 0x31, 0xfc, 0x4b, 0x5d, 0xd9, 0x1c, 0x99, 0x7e, 0x80, 0xf7, 0x80, 0x59, 0xb6,
+	0xf1, 0x65, 0x31, 0x9a, 0x69, 0x6e, 0x30, 0x53, 0xed, 0x17, 0x29, 0x50, 0x71, 0x36, 0xdc, 0x58,
+	0x8a, 0xf9, 0x60, 0x12, 0x6f, 0xda, 0x6d, 0x39, 0x99, 0xf1, 0x49, 0x3c, 0x0a, 0x92, 0x1f, 0x81,
+	0xc2, 0xee, 0xf4, 0x9a, 0xad, 0x53, 0x91, 0xfd, 0xde, 0x64, 0x1a, 0x18, 0x6e, 0x7a, 0xed, 0x39,
+	0x4a, 0x6d, 0x9d, 0xea, 0x85, 0x1e, 0xff, 0x40, 0xa7, 0xc2, 0x9e, 0x69, 0x36, 0xd1, 0x18, 0x67,
+	0xe4, 0x6b, 0xe3, 0x16, 0xb5, 0xbe, 0xa4, 0xa7, 0x9a, 0x06, 0x05, 0x51, 0x81, 0x94, 0x41, 0x39,
+	0xd8, 0x3b, 0xd8, 0xdd, 0xdf, 0x7b, 0xb1, 0xab, 0x5e, 0x21, 0x45, 0xc8, 0xed, 0x6f, 0x6e, 0xed,
+	0xee, 0xab, 0x29, 0xed, 0x27, 0x30, 0x17, 0xeb, 0xc5, 0x77, 0x1d, 0xdb, 0x8f, 0x39, 0x06, 0x6e,
+	0x73, 0xcf, 0x77, 0x0c, 0xda, 0x2b, 0x98, 0x7d, 0x66, 0x85, 0xfe, 0x51, 0x6c, 0x39, 0xef, 0x40,
+	0x81, 0x2b, 0x5b, 0x02, 0x08, 0x09, 0x6d, 0x4b, 0x1e, 0xf9, 0x08, 0xca, 0x81, 0xd3, 0x94, 0x2b,
+	0x2b, 0x9f, 0x34, 0x0d, 0xad, 0x7c, 0x29, 0x70, 0xe4, 0xb7, 0xaf, 0xad, 0x81, 0xba, 0x43, 0x2d,
+	0x1a, 0xd0, 0xe9, 0x76, 0xbb, 0xf6, 0x10, 0x2a, 0x8d, 0xc0, 0x71, 0xa7, 0x94, 0xfe, 0xdd, 0x0c,
+	0x2c, 0xbe, 0x74, 0x3b, 0xdc, 0x6f, 0x70, 0x5b, 0x33, 0xb9, 0xd6, 0xc0, 0x58, 0xa5, 0xa7, 0x32,
+	0x56, 0x99, 0x84, 0xb1, 0xfa, 0x9f, 0xb8, 0x1e, 0x1c, 0xf2, 0x0c, 0x85, 0x29, 0x3c, 0x83, 0x32,
+	0xad, 0x67, 0x28, 0x4d, 0x83, 0xa1, 0x17, 0xcf, 0xc5, 0xd0, 0x61, 0xbc, 0xe3, 0xd0, 0x7e, 0x9e,
+	0x86, 0xca, 0x73, 0x1a, 0xec, 0x3b, 0x3d, 0xff, 0x12, 0x7e, 0x7c, 0xdc, 0xaa, 0x49, 0xbd, 0x75,
+	0x4d, 0x2b, 0xa0, 0x1e, 0x87, 0x5a, 0x8a, 0x5c, 0x6f, 0xcf, 0x38, 0x69, 0xf0, 0xe2, 0x2a, 0x7f,
+	0xde, 0x8b, 0x2b, 0xf6, 0x96, 0xd7, 0x0f, 0xa8, 0x27, 0x2c, 0x82, 0x28, 0x21, 0xbd, 0xeb, 0x58,
+	0x96, 0xf3, 0x5a, 0x3c, 0xb0, 0x14, 0x25, 0xf6, 0x06, 0xc0, 0x30, 0x2d, 0xa1, 0x5e, 0xf6, 0x8d,
+	0x0f, 0xdd, 0x43, 0x9f, 0x36, 0x2d, 0xe7, 0xd8, 0x6c, 0xb6, 0x8c, 0xf6, 0x31, 0x42, 0x79, 0xfc,
+	0xf9, 0x65, 0x25, 0xf4, 0xe9, 0xbe, 0x73, 0x6c, 0x6e, 0x71, 0x2a, 0x77, 0x32, 0xda, 0x5f, 0xa5,
+	0x01, 0xf6, 0x9d, 0x9e, 0x78, 0xcc, 0x8a, 0x39, 0x63, 0x14, 0x23, 0xc5, 0x20, 0xad, 0x28, 0x20,
+	0x7a, 0x81, 0xb8, 0xda, 0xe0, 0x31, 0x48, 0xe6, 0x9c, 0xc7, 0x20, 0x89, 0x97, 0x25, 0x85, 0xb1,
+	0x2f, 0x4b, 0xe2, 0x40, 0x66, 0x71, 0x0c, 0x90, 0x39, 0x50, 0x0e, 0x24, 0x94, 0x23, 0xdf, 0x9d,
+	0x64, 0xc7, 0xbc, 0x3b, 0x91, 0x3f, 0x0a, 0x51, 0xb8, 0xa1, 0xc5, 0x6f, 0xf2, 0x00, 0xd2, 0xd1,
+	0x93, 0x92, 0x71, 0x06, 0x35, 0x1d, 0xf8, 0x78, 0xac, 0xc4, 0x03, 0x60, 0xb6, 0x78, 0x45, 0x5d,
+	0x16, 0xb5, 0x43, 0x98, 0xd7, 0xf9, 0x09, 0xe3, 0x2b, 0x39, 0xc5, 0x01, 0x1f, 0xde, 0x2a, 0xe9,
+	0x33, 0x5b, 0x45, 0xfb, 0x21, 0xcc, 0x0b, 0x37, 0x9c, 0x68, 0x75, 0xe2, 0x9b, 0x3d, 0xad, 0xc9,
+	0x9d, 0xc7, 0xd4, 0x63, 0xc1, 0x0c, 0xc2, 0xe8, 0x89, 0xfc, 0x53, 0x3c, 0x5d, 0x46, 0x02, 0xcb,
+	0x3d, 0xd9, 0xab, 0x44, 0xf1, 0xcb, 0x92, 0x8c, 0xce, 0xbe, 0xb5, 0x53, 0x98, 0x8b, 0x75, 0x20,
+	0x8c, 0xfb, 0x23, 0x99, 0x36, 0x61, 0x54, 0x2d, 0x6d, 0x74, 0x65, 0x30, 0x3a, 0x16, 0x53, 0x43,
+	0x47, 0x7e, 0xb2, 0x47, 0x25, 0xec, 0x28, 0x37, 0x5d, 0xf6, 0xa6, 0x9a, 0x77, 0x0c, 0x8c, 0x74,
+	0x80, 0x94, 0x91, 0x5d, 0xff, 0x3f, 0xb8, 0x1a, 0x75, 0xdd, 0x08, 0x3c, 0x6a, 0x0c, 0x06, 0xf0,
+	0x21, 0xc0, 0x60, 0x00, 0x89, 0x97, 0x5d, 0x83, 0xfe, 0x8b, 0x51, 0xff, 0x97, 0xeb, 0x7e, 0x0b,
+	0x8a, 0x51, 0xa2, 0x1c, 0x7b, 0x69, 0x93, 0x8a, 0xbf, 0xb4, 0x41, 0x43, 0x85, 0xaa, 0x4c, 0xbc,
+	0x05, 0x2f, 0x22, 0x85, 0xbf, 0xc0, 0xfa, 0xb7, 0x14, 0x94, 0x62, 0x69, 0x22, 0xd9, 0x82, 0x59,
+	0xd3, 0x36, 0x03, 0xd3, 0xb0, 0xd8, 0x59, 0x75, 0xba, 0xdd, 0xc9, 0xaf, 0x00, 0x2b, 0xa2, 0xc6,
+	0x16, 0xaf, 0x80, 0x89, 0x36, 0x7b, 0x7d, 0x2e, 0xea, 0x4f, 0x7c, 0x06, 0x08, 0xf8, 0x34, 0x5d,
+	0xd4, 0x5d, 0x06, 0xe8, 0x87, 0x56, 0x60, 0xba, 0x96, 0x29, 0x7e, 0x40, 0x90, 0xd2, 0x63, 0x14,
+	0xf2, 0x00, 0xe6, 0x78, 0xba, 0x1b, 0xff, 0x3d, 0x56, 0x96, 0xfd, 0x1e, 0x6b, 0x96, 0x31, 0x62,
+	0x3f, 0xc7, 0x5a, 0xc6, 0xc7, 0x57, 0xd2, 0x64, 0x0b, 0x03, 0x16, 0xa3, 0x68, 0x7f, 0x90, 0x02,
+	0x75, 0x38, 0x73, 0x44, 0x3d, 0xf2, 0x10, 0x40, 0xd8, 0x19, 0x51, 0x22, 0x1b, 0x90, 0x35, 0xbc,
+	0x9e, 0x74, 0xe1, 0x2b, 0x23, 0xd3, 0xce, 0xb5, 0x4d, 0xaf, 0x27, 0x90, 0x32, 0x26, 0x5c, 0xfb,
+	0x21, 0x14, 0x23, 0xd2, 0x85, 0x7e, 0x14, 0xf2, 0x37, 0x29, 0xa8, 0x24, 0xb3, 0x70, 0x52, 0x87,
+	0x19, 0xdb, 0xe9, 0xd0, 0xa6, 0x4f, 0x2d, 0xda, 0x0e, 0x1c, 0x4f, 0x6c, 0xea, 0x3b, 0x23, 0x32,
+	0xf6, 0xb5, 0x17, 0x4e, 0x87, 0x36, 0x84, 0x1c, 0x1f, 0x4f, 0xd9, 0x8e, 0x91, 0xf0, 0x52, 0xc3,
+	0xf5, 0x4c, 0xc7, 0x33, 0x83, 0xd3, 0x66, 0xdb, 0x32, 0x7c, 0x9f, 0x5b, 0x56, 0x3e, 0x8c, 0x39,
+	0xc9, 0xda, 0x46, 0x0e, 0x9a, 0xd7, 0xda, 0xe7, 0x30, 0x77, 0xa6, 0xc9, 0x0b, 0xcd, 0xe7, 0xef,
+	0x4b, 0xb0, 0xc8, 0x13, 0xd6, 0xc8, 0x8b, 0x5d, 0x3c, 0x30, 0x1e, 0x60, 0xcf, 0xb7, 0xa7, 0xc0,
+	0x9e, 0x2f, 0x86, 0x6b, 0x8f, 0x42, 0xaa, 0x0b, 0xef, 0x84, 0x54, 0xaf, 0x5c, 0x14, 0xa9, 0x2e,
+	0x9e, 0x8f, 0x54, 0x2f, 0x41, 0x3e, 0x64, 0x61, 0x9b, 0x74, 0xc3, 0xbc, 0x74, 0x16, 0x4f, 0x85,
+	0x11, 0x78, 0xea, 0x00, 0x76, 0x79, 0x2f, 0x0e, 0xbb, 0x8c, 0x84, 0x59, 0xcb, 0xef, 0x04, 0xb3,
+	0x2e, 0xfd, 0x1a, 0x60, 0xd6, 0x47, 0x97, 0x85, 0x59, 0x67, 0xa6, 0x84, 0x59, 0x2b, 0x93, 0x60,
+	0x56, 0x75, 0x12, 0xcc, 0x3a, 0x77, 0x16, 0x66, 0xbd, 0x01, 0x45, 0x8f, 0x8a, 0x40, 0x96, 0x3d,
+	0x3c, 0x51, 0xf4, 0x01, 0x61, 0x04, 0xb0, 0xba, 0x30, 0x1e, 0x58, 0x5d, 0x9c, 0x0a, 0x58, 0xbd,
+	0x35, 0x1d, 0xb0, 0x7a, 0xf5, 0xc2, 0xc0, 0x6a, 0xf5, 0x9d, 0x80, 0xd5, 0x6b, 0x17, 0x01, 0x56,
+	0x25, 0x3e, 0x5d, 0x8b, 0xe1, 0xd3, 0x31, 0x34, 0xf4, 0xfa, 0x58, 0x34, 0xf4, 0xc6, 0x44, 0x34,
+	0xf4, 0xa3, 0x4b, 0xa2, 0xa1, 0x37, 0x2f, 0x87, 0x86, 0x2e, 0x8f, 0x41, 0x43, 0x57, 0xcf, 0x3c,
+	0x07, 0x48, 0x20, 0xc4, 0xda, 0x78, 0x84, 0x38, 0x0e, 0x92, 0xae, 0x4d, 0x0f, 0x92, 0x3e, 0x9e,
+	0x0e, 0x24, 0xbd, 0x0a, 0x85, 0x0e, 0xfa, 0xd4, 0xd0, 0x66, 0x17, 0x46, 0x8a, 0x9e, 0xef, 0x78,
+	0xa7, 0x7a, 0x68, 0x0f, 0x81, 0x3f, 0x1c, 0xd8, 0xe1, 0x30, 0xce, 0xbc, 0xba, 0xa0, 0x6d, 0xc3,
+	0x92, 0x08, 0x0a, 0x2f, 0x6f, 0xd5, 0xb5, 0xdf, 0x4e, 0xc1, 0x3c, 0x46, 0x51, 0xef, 0xe0, 0x18,
+	0x62, 0x78, 0x46, 0x3a, 0x89, 0x67, 0xdc, 0x07, 0xd5, 0xc0, 0xc4, 0xa4, 0x69, 0xda, 0x6d, 0xa7,
+	0xef, 0x62, 0x62, 0x2d, 0x7e, 0x76, 0x33, 0xcb, 0xe8, 0x7b, 0x11, 0x59, 0xfb, 0xfd, 0x14, 0x2c,
+	0xf2, 0xd4, 0xfb, 0x1d, 0x46, 0xa2, 0x42, 0xc6, 0x88, 0x80, 0x22, 0xfc, 0x44, 0x9f, 0xd8, 0x75,
+	0xbc, 0xb6, 0xb4, 0xdc, 0xbc, 0x80, 0x3b, 0xe3, 0x98, 0x52, 0x97, 0x3f, 0x54, 0xe3, 0x3f, 0xf5,
+	0x52, 0x90, 0xa0, 0x53, 0xd7, 0xa9, 0x67, 0x95, 0xb4, 0x9a, 0x11, 0xef, 0xab, 0x37, 0x61, 0xa1,
+	0x81, 0xb1, 0xfc, 0x3b, 0x28, 0xf8, 0xa7, 0x30, 0x8f, 0x10, 0xc1, 0x3b, 0xb4, 0xf0, 0x47, 0x29,
+	0x20, 0x7a, 0x68, 0xbf, 0x83, 0x5e, 0x3e, 0x06, 0x70, 0x3d, 0xe7, 0x84, 0xda, 0x86, 0xcd, 0x7e,
+	0x3e, 0x9a, 0xe1, 0xbb, 0x32, 0xda, 0xeb, 0x07, 0x11, 0x53, 0x8f, 0x09, 0xc6, 0xd2, 0xba, 0xec,
+	0xe8, 0xb4, 0x4e, 0x68, 0xe9, 0x13, 0xa8, 0xe8, 0xa1, 0x8d, 0xbf, 0xf0, 0xba, 0xc4, 0xec, 0xee,
+	0xc3, 0x3c, 0x0f, 0x4d, 0xf8, 0xff, 0x09, 0x90, 0x2d, 0x20, 0x14, 0x66, 0x5a, 0xbc, 0x76, 0x59,
+	0x67, 0xdf, 0xda, 0x53, 0x98, 0xe7, 0x5b, 0x24, 0x29, 0x7a, 0x1b, 0xf2, 0xfc, 0x7f, 0x0f, 0x0c,
+	0x7e, 0x09, 0x16, 0xfd, 0xc7, 0x02, 0x5d, 0xb0, 0xb4, 0x4f, 0x60, 0x41, 0x1c, 0x96, 0x4b, 0x54,
+	0xbe, 0x01, 0x79, 0x4e, 0x19, 0xf9, 0xb0, 0xe3, 0xe7, 0xf8, 0x03, 0x51, 0xc6, 0x66, 0xc9, 0xc4,
+	0x34, 0x2d, 0x46, 0xaf, 0xf5, 0xd3, 0xb1, 0xd7, 0xfa, 0x7b, 0x40, 0xd8, 0x15, 0xb7, 0xe9, 0xd8,
+	0xcd, 0xe8, 0x3f, 0x5d, 0x54, 0x33, 0x13, 0x13, 0xd2, 0x39, 0x59, 0x2b, 0x22, 0x69, 0x9f, 0x43,
+	0x69, 0x30, 0x22, 0x04, 0xc2, 0x4a, 0xbc, 0xdf, 0xf8, 0x35, 0xc7, 0x6c, 0x6c, 0x5c, 0x3c, 0x21,
+	0xf3, 0xa3, 0x6f, 0xed, 0x29, 0x2c, 0x3e, 0x37, 0xbc, 0x96, 0xd1, 0xa3, 0xdb, 0x8e, 0x85, 0x61,
+	0xa7, 0xd4, 0x17, 0xfe, 0xfc, 0x75, 0xf0, 0xba, 0x7f, 0xf0, 0xf3, 0xd7, 0xe8, 0x59, 0xbf, 0xaf,
+	0x55, 0x61, 0x69, 0xb8, 0x2e, 0x4f, 0xcb, 0xb4, 0x45, 0x98, 0xdf, 0x6c, 0x07, 0xe6, 0x89, 0x11,
+	0xd0, 0xcd, 0x30, 0x38, 0x12, 0x6d, 0x6a, 0x4b, 0xb0, 0x90, 0x24, 0x73, 0xf1, 0x07, 0xbf, 0x99,
+	0x62, 0xef, 0x70, 0x38, 0x0a, 0xac, 0x42, 0xb9, 0xfe, 0xf5, 0x56, 0xb3, 0x71, 0xb8, 0xa9, 0x1f,
+	0xee, 0xbd, 0x78, 0xae, 0x5e, 0x21, 0xb3, 0x50, 0x42, 0x8a, 0xfe, 0xf2, 0xc5, 0x0b, 0x24, 0xa4,
+	0x24, 0xe1, 0xd9, 0xe6, 0xde, 0xfe, 0x4b, 0x7d, 0x57, 0x4d, 0x4b, 0x42, 0xe3, 0xe5, 0xf6, 0xf6,
+	0x6e, 0xa3, 0xa1, 0x66, 0x48, 0x05, 0x00, 0x09, 0x5f, 0xee, 0xed, 0xef, 0xef, 0xee, 0xa8, 0x59,
+	0x29, 0xf0, 0xd5, 0xae, 0xfe, 0x1c, 0x9b, 0xc8, 0x91, 0x39, 0x98, 0x41, 0xc2, 0xee, 0x73, 0x7d,
+	0xb7, 0xd1, 0x40, 0x52, 0xfe, 0xc1, 0xff, 0x05, 0x18, 0xfc, 0xc8, 0x0d, 0x7f, 0xec, 0x8f, 0xed,
+	0xef, 0xee, 0xa8, 0x57, 0x48, 0x09, 0x0a, 0xb2, 0xe9, 0x14, 0x2b, 0x7c, 0xb9, 0x77, 0x70, 0xb0,
+	0xbb, 0xa3, 0xa6, 0x11, 0x0b, 0x8d, 0x06, 0x9a, 0x21, 0x33, 0x50, 0xd4, 0x77, 0xb7, 0xbf, 0xfe,
+	0x76, 0x57, 0x97, 0x9d, 0x7e, 0xf3, 0x72, 0x53, 0xdf, 0x7c, 0x71, 0xb8, 0xf7, 0x62, 0x77, 0x47,
+	0xcd, 0x3d, 0xf8, 0x1c, 0x4a, 0xb1, 0x47, 0x48, 0xc8, 0x3f, 0xf8, 0x7a, 0x27, 0x9a, 0xd7, 0x15,
+	0x49, 0x18, 0xf4, 0x55, 0x01, 0x40, 0x82, 0x18, 0x48, 0xfa, 0xc1, 0x9f, 0xa4, 0x06, 0x57, 0x5b,
+	0xbc, 0x8d, 0x45, 0x98, 0x93, 0x60, 0x6c, 0x5c, 0x65, 0x0b, 0xa0, 0x46, 0xe4, 0x81, 0xde, 0xae,
+	0xc2, 0xfc, 0x80, 0xba, 0x1b, 0x89, 0xa7, 0x13, 0xe2, 0x52, 0xab, 0x19, 0x32, 0x0f, 0xb3, 0x11,
+	0xf5, 0x60, 0xf3, 0x65, 0x83, 0x4d, 0x2a, 0x2e, 0xda, 0x38, 0xdc, 0x7c, 0xb1, 0xb3, 0xf5, 0xbf,
+	0xd5, 0x5c, 0x62, 0x18, 0xdb, 0xfa, 0x66, 0xe3, 0x0b, 0xa6, 0xd2, 0xf5, 0xbf, 0xa8, 0x40, 0x66,
+	0xf3, 0x60, 0x8f, 0xac, 0x41, 0x91, 0x9f, 0x7d, 0xcc, 0x18, 0x16, 0xc5, 0xef, 0x44, 0x93, 0xf7,
+	0x6a, 0xb5, 0x08, 0xa0, 0xd0, 0xae, 0x90, 0x1f, 0x00, 0x0c, 0x6e, 0x23, 0x88, 0xfc, 0x51, 0xfa,
+	0xd0, 0xf5, 0x44, 0x2d, 0xf1, 0x3e, 0x4b, 0xbb, 0x42, 0x1e, 0x41, 0x41, 0x5c, 0x07, 0x90, 0xf9,
+	0x08, 0xf3, 0x8e, 0xc9, 0xcf, 0xc4, 0xe5, 0x7d, 0xed, 0x0a, 0x26, 0x13, 0x42, 0x84, 0xc3, 0x0a,
+	0xa3, 0xab, 0x0d, 0x75, 0xf3, 0x51, 0x8a, 0x7c, 0x0a, 0xc5, 0x08, 0xe8, 0x16, 0xd3, 0x19, 0x86,
+	0xd7, 0x6b, 0x4b, 0xc3, 0x64, 0x71, 0x34, 0xae, 0x90, 0x75, 0x50, 0x24, 0xce, 0x4d, 0x78, 0xd6,
+	0x33, 0x04, 0x7b, 0x8f, 0xee, 0x31, 0xc2, 0xab, 0x45, 0x8f, 0xc3, 0xf8, 0x75, 0x6d, 0xe9, 0x8c,
+	0xe9, 0xd8, 0xc5, 0x9f, 0x63, 0x6b, 0x57, 0xc8, 0x8f, 0xa0, 0x20, 0xd0, 0x6b, 0x31, 0xc3, 0x24,
+	0x96, 0x3d, 0xa6, 0xe6, 0x53, 0x28, 0xc7, 0xf1, 0x28, 0x52, 0x8d, 0x2f, 0x45, 0x1c, 0x6c, 0xaa,
+	0x0d, 0xa1, 0x2e, 0xda, 0x15, 0xa9, 0x25, 0x5e, 0x71, 0xa0, 0xa5, 0x44, 0xad, 0xa5, 0x61, 0x72,
+	0xa4, 0xa5, 0x3a, 0xcc, 0x0e, 0x81, 0x3e, 0xe7, 0xb5, 0x71, 0x23, 0x49, 0x4e, 0x22, 0x44, 0x4c,
+	0x7b, 0x5b, 0xec, 0x17, 0x5b, 0x11, 0x56, 0x27, 0x66, 0x31, 0x02, 0xbe, 0x1b, 0xa3, 0x89, 0x67,
+	0x50, 0x49, 0x66, 0xd6, 0xa4, 0x16, 0xdb, 0xc7, 0x43, 0x3e, 0x7b, 0x4c, 0x3b, 0xdb, 0x30, 0x3b,
+	0x14, 0xcc, 0x91, 0xeb, 0x71, 0xa5, 0x0e, 0xb7, 0x74, 0xf6, 0x92, 0x5a, 0xbb, 0x42, 0x3e, 0x83,
+	0x72, 0x3c, 0x96, 0x13, 0x13, 0x1a, 0x11, 0xde, 0xd5, 0xc8, 0x99, 0xea, 0x3e, 0x9f, 0x4c, 0x32,
+	0x06, 0x13, 0x93, 0x19, 0x19, 0x98, 0x8d, 0x99, 0xcc, 0x0e, 0xcc, 0x24, 0xc2, 0x26, 0x22, 0xfe,
+	0x55, 0xcb, 0x88, 0x50, 0x6a, 0x4c, 0x2b, 0x5b, 0x50, 0x8e, 0x47, 0x4e, 0x62, 0x36, 0x23, 0x82,
+	0xa9, 0x31, 0x6d, 0xfc, 0x14, 0x4a, 0xb1, 0xd0, 0x89, 0xf0, 0xff, 0x7d, 0x74, 0x36, 0x98, 0x1a,
+	0x7f, 0x48, 0x44, 0x70, 0x23, 0x0e, 0x49, 0x32, 0xd4, 0x19, 0x3f, 0xfe, 0x78, 0x64, 0x23, 0xc6,
+	0x3f, 0x22, 0xd8, 0x19, 0xdf, 0x46, 0x3c, 0xe4, 0x11, 0x6d, 0x8c, 0x88, 0x82, 0xc6, 0xce, 0x00,
+	0x70, 0x0b, 0x88, 0x16, 0xce, 0x91, 0xab, 0xa9, 0x43, 0xe1, 0x00, 0xee, 0x87, 0x9f, 0xc0, 0x4c,
+	0x22, 0x68, 0x12, 0xeb, 0x38, 0x2a, 0x90, 0xaa, 0x0d, 0x87, 0x13, 0xac, 0xba, 0xb0, 0x4e, 0x9b,
+	0x96, 0x75, 0x6e, 0xbf, 0xe7, 0x8f, 0x7b, 0x03, 0x0a, 0xe2, 0x6e, 0x46, 0x68, 0x3e, 0x79, 0x53,
+	0x23, 0x7a, 0x1c, 0xdc, 0x55, 0xb0, 0x33, 0xfd, 0x25, 0x54, 0x92, 0xc1, 0x87, 0xd8, 0xc2, 0x23,
+	0xa3, 0x99, 0xda, 0xf5, 0x91, 0xbc, 0xc8, 0xd8, 0xec, 0x42, 0x39, 0x1e, 0x98, 0x08, 0xed, 0x8f,
+	0x08, 0x61, 0x6a, 0xd7, 0x46, 0x70, 0xa2, 0x66, 0x9e, 0x41, 0x25, 0x79, 0xed, 0x27, 0xc6, 0x34,
+	0xf2, 0x2e, 0xf0, 0x7c, 0x85, 0x6c, 0x7d, 0xf2, 0xcb, 0xb7, 0xcb, 0xa9, 0xbf, 0x7d, 0xbb, 0x9c,
+	0xfa, 0xc7, 0xb7, 0xcb, 0xa9, 0xff, 0xf3, 0x21, 0xbe, 0x77, 0x0a, 0x5b, 0x6b, 0x6d, 0xa7, 0xff,
+	0xc8, 0x35, 0xda, 0x47, 0xa7, 0x1d, 0xea, 0xc5, 0xbf, 0x7c, 0xaf, 0xfd, 0x68, 0xf0, 0x8f, 0xd7,
+	0x5a, 0x79, 0xd6, 0xdc, 0xc6, 0x7f, 0x0d, 0x00, 0x02, 0x4c, 0xc8, 0xac, 0x8d, 0x4d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6065,6 +7289,11 @@ type APIClient interface {
 	ListJob(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (*JobInfos, error)
 	// ListJobStream returns information about current and past Pachyderm jobs.
 	ListJobStream(ctx context.Context, in *ListJobRequest, opts ...grpc.CallOption) (API_ListJobStreamClient, error)
+	// ListUsage returns the resources used by finished jobs, grouped by
+	// pipeline or by pipeline label. Without 'since', it reads the totals kept
+	// by each pipeline; with it, every job is read. Only the usage of pipelines
+	// whose output repos the caller can read is returned.
+	ListUsage(ctx context.Context, in *ListUsageRequest, opts ...grpc.CallOption) (*ListUsageResponse, error)
 	FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error)
 	DeleteJob(ctx context.Context, in *DeleteJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
 	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*types.Empty, error)
@@ -6166,6 +7395,15 @@ func (x *aPIListJobStreamClient) Recv() (*JobInfo, error) {
 	return m, nil
 }
 
+func (c *aPIClient) ListUsage(ctx context.Context, in *ListUsageRequest, opts ...grpc.CallOption) (*ListUsageResponse, error) {
+	out := new(ListUsageResponse)
+	err := c.cc.Invoke(ctx, "/pps.API/ListUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) FlushJob(ctx context.Context, in *FlushJobRequest, opts ...grpc.CallOption) (API_FlushJobClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/pps.API/FlushJob", opts...)
 	if err != nil {
@@ -6460,6 +7698,11 @@ type APIServer interface {
 	ListJob(context.Context, *ListJobRequest) (*JobInfos, error)
 	// ListJobStream returns information about current and past Pachyderm jobs.
 	ListJobStream(*ListJobRequest, API_ListJobStreamServer) error
+	// ListUsage returns the resources used by finished jobs, grouped by
+	// pipeline or by pipeline label. Without 'since', it reads the totals kept
+	// by each pipeline; with it, every job is read. Only the usage of pipelines
+	// whose output repos the caller can read is returned.
+	ListUsage(context.Context, *ListUsageRequest) (*ListUsageResponse, error)
 	FlushJob(*FlushJobRequest, API_FlushJobServer) error
 	DeleteJob(context.Context, *DeleteJobRequest) (*types.Empty, error)
 	StopJob(context.Context, *StopJobRequest) (*types.Empty, error)
@@ -6510,6 +7753,9 @@ func (*UnimplementedAPIServer) ListJob(ctx context.Context, req *ListJobRequest)
 func (*UnimplementedAPIServer) ListJobStream(req *ListJobRequest, srv API_ListJobStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method ListJobStream not implemented")
 }
+func (*UnimplementedAPIServer) ListUsage(ctx context.Context, req *ListUsageRequest) (*ListUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsage not implemented")
+}
 func (*UnimplementedAPIServer) FlushJob(req *FlushJobRequest, srv API_FlushJobServer) error {
 	return status.Errorf(codes.Unimplemented, "method FlushJob not implemented")
 }
@@ -6662,6 +7908,24 @@ func (x *aPIListJobStreamServer) Send(m *JobInfo) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_ListUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListUsage(ctx, req.(*ListUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_FlushJob_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(FlushJobRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -7119,6 +8383,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListJob",
 			Handler:    _API_ListJob_Handler,
 		},
+		{
+			MethodName: "ListUsage",
+			Handler:    _API_ListUsage_Handler,
+		},
 		{
 			MethodName: "DeleteJob",
 			Handler:    _API_DeleteJob_Handler,
@@ -7253,6 +8521,13 @@ func (m *SecretMount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.VaultPath) > 0 {
+		i -= len(m.VaultPath)
+		copy(dAtA[i:], m.VaultPath)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.VaultPath)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Key) > 0 {
 		i -= len(m.Key)
 		copy(dAtA[i:], m.Key)
@@ -7564,6 +8839,30 @@ func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Http != nil {
+		{
+			size, err := m.Http.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SqlDatabase != nil {
+		{
+			size, err := m.SqlDatabase.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.URL) > 0 {
 		i -= len(m.URL)
 		copy(dAtA[i:], m.URL)
@@ -7574,6 +8873,112 @@ func (m *Egress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SQLEgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SQLEgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SQLEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PasswordEnvVar) > 0 {
+		i -= len(m.PasswordEnvVar)
+		copy(dAtA[i:], m.PasswordEnvVar)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.PasswordEnvVar)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FileFormat != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.FileFormat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPEgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPEgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPEgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Headers) > 0 {
+		for k := range m.Headers {
+			v := m.Headers[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Job) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7748,6 +9153,42 @@ func (m *Spout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Batch != nil {
+		{
+			size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Ingest != nil {
+		{
+			size, err := m.Ingest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Marker) > 0 {
 		i -= len(m.Marker)
 		copy(dAtA[i:], m.Marker)
@@ -7780,7 +9221,7 @@ func (m *Spout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PFSInput) Marshal() (dAtA []byte, err error) {
+func (m *KafkaSpoutSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7790,12 +9231,12 @@ func (m *PFSInput) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PFSInput) MarshalTo(dAtA []byte) (int, error) {
+func (m *KafkaSpoutSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KafkaSpoutSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7804,94 +9245,218 @@ func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Trigger != nil {
-		{
-			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.S3 {
-		i--
-		if m.S3 {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.JoinOn) > 0 {
-		i -= len(m.JoinOn)
-		copy(dAtA[i:], m.JoinOn)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.JoinOn)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.EmptyFiles {
-		i--
-		if m.EmptyFiles {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.Lazy {
-		i--
-		if m.Lazy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Glob) > 0 {
-		i -= len(m.Glob)
-		copy(dAtA[i:], m.Glob)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Commit) > 0 {
-		i -= len(m.Commit)
-		copy(dAtA[i:], m.Commit)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Branch) > 0 {
-		i -= len(m.Branch)
-		copy(dAtA[i:], m.Branch)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Repo) > 0 {
-		i -= len(m.Repo)
-		copy(dAtA[i:], m.Repo)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+	if len(m.Topic) > 0 {
+		i -= len(m.Topic)
+		copy(dAtA[i:], m.Topic)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Topic)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.Brokers) > 0 {
+		for iNdEx := len(m.Brokers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Brokers[iNdEx])
+			copy(dAtA[i:], m.Brokers[iNdEx])
+			i = encodeVarintPps(dAtA, i, uint64(len(m.Brokers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *CronInput) Marshal() (dAtA []byte, err error) {
+func (m *IngestSpoutSource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IngestSpoutSource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IngestSpoutSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Protocol != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Protocol))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpoutBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpoutBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpoutBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxDelay != nil {
+		{
+			size, err := m.MaxDelay.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxMessages != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.MaxMessages))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PFSInput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PFSInput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PFSInput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.S3 {
+		i--
+		if m.S3 {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.JoinOn) > 0 {
+		i -= len(m.JoinOn)
+		copy(dAtA[i:], m.JoinOn)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JoinOn)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EmptyFiles {
+		i--
+		if m.EmptyFiles {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Lazy {
+		i--
+		if m.Lazy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Glob) > 0 {
+		i -= len(m.Glob)
+		copy(dAtA[i:], m.Glob)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Glob)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Branch) > 0 {
+		i -= len(m.Branch)
+		copy(dAtA[i:], m.Branch)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Branch)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronInput) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8510,6 +10075,18 @@ func (m *ProcessStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MemoryByteSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MemoryByteSeconds))))
+		i--
+		dAtA[i] = 0x39
+	}
+	if m.CpuSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuSeconds))))
+		i--
+		dAtA[i] = 0x31
+	}
 	if m.UploadBytes != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
 		i--
@@ -8838,6 +10415,82 @@ func (m *GPUSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResourceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResourceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResourceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DataFailed != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.DataSkipped != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataSkipped))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.DataProcessed != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataProcessed))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UploadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DownloadBytes != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MemoryByteSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MemoryByteSeconds))))
+		i--
+		dAtA[i] = 0x21
+	}
+	if m.CpuSeconds != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.CpuSeconds))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.Jobs != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.Jobs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Group) > 0 {
+		i -= len(m.Group)
+		copy(dAtA[i:], m.Group)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Group)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EtcdJobInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8862,6 +10515,13 @@ func (m *EtcdJobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.DataRecovered != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.DataRecovered))
 		i--
@@ -9015,9 +10675,9 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.SidecarResourceLimits != nil {
+	if m.Timeline != nil {
 		{
-			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Timeline.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -9027,7 +10687,42 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x9a
+	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SidecarResourceLimits != nil {
+		{
+			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
 	}
 	if m.SpecCommit != nil {
 		{
@@ -9466,6 +11161,145 @@ func (m *JobInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TimelineSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimelineSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimelineSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.End != nil {
+		{
+			size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Start != nil {
+		{
+			size, err := m.Start.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DatumID) > 0 {
+		i -= len(m.DatumID)
+		copy(dAtA[i:], m.DatumID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.DatumID)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Worker) > 0 {
+		i -= len(m.Worker)
+		copy(dAtA[i:], m.Worker)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Worker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ParentSpanID) > 0 {
+		i -= len(m.ParentSpanID)
+		copy(dAtA[i:], m.ParentSpanID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.ParentSpanID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpanID) > 0 {
+		i -= len(m.SpanID)
+		copy(dAtA[i:], m.SpanID)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.SpanID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobTimeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobTimeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobTimeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DatumSpansDropped != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumSpansDropped))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Spans) > 0 {
+		for iNdEx := len(m.Spans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Worker) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9604,6 +11438,18 @@ func (m *EtcdPipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.Parallelism != 0 {
 		i = encodeVarintPps(dAtA, i, uint64(m.Parallelism))
 		i--
@@ -9687,6 +11533,48 @@ func (m *PipelineInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0xa2
+	}
 	if m.SidecarResourceLimits != nil {
 		{
 			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
@@ -10207,6 +12095,13 @@ func (m *CreateJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xb0
+	}
 	if m.Finished != nil {
 		{
 			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
@@ -10374,6 +12269,16 @@ func (m *InspectJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timeline {
+		i--
+		if m.Timeline {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Full {
 		i--
 		if m.Full {
@@ -10501,7 +12406,7 @@ func (m *ListJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FlushJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListUsageRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10511,12 +12416,12 @@ func (m *FlushJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FlushJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListUsageRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FlushJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10525,38 +12430,34 @@ func (m *FlushJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ToPipelines) > 0 {
-		for iNdEx := len(m.ToPipelines) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ToPipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.LabelKey) > 0 {
+		i -= len(m.LabelKey)
+		copy(dAtA[i:], m.LabelKey)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.LabelKey)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Commits) > 0 {
-		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintPps(dAtA, i, uint64(size))
+	if m.GroupBy != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.GroupBy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Since != nil {
+		{
+			size, err := m.Since.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListUsageResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -10566,12 +12467,12 @@ func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListUsageResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ListUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -10580,14 +12481,110 @@ func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Job != nil {
-		{
-			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintPps(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlushJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlushJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlushJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ToPipelines) > 0 {
+		for iNdEx := len(m.ToPipelines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToPipelines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Commits) > 0 {
+		for iNdEx := len(m.Commits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPps(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteJobRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteJobRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
 		}
 		i--
 		dAtA[i] = 0xa
@@ -10658,6 +12655,11 @@ func (m *UpdateJobStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DataQuarantined != 0 {
+		i = encodeVarintPps(dAtA, i, uint64(m.DataQuarantined))
+		i--
+		dAtA[i] = 0x58
+	}
 	if m.Stats != nil {
 		{
 			size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
@@ -11215,6 +13217,145 @@ func (m *ChunkSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quarantine {
+		i--
+		if m.Quarantine {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RetryReturnCode) > 0 {
+		dAtA115 := make([]byte, len(m.RetryReturnCode)*10)
+		var j114 int
+		for _, num1 := range m.RetryReturnCode {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA115[j114] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j114++
+			}
+			dAtA115[j114] = uint8(num)
+			j114++
+		}
+		i -= j114
+		copy(dAtA[i:], dAtA115[:j114])
+		i = encodeVarintPps(dAtA, i, uint64(j114))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Multiplier != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Multiplier))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.MaxBackoff != nil {
+		{
+			size, err := m.MaxBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.InitialBackoff != nil {
+		{
+			size, err := m.InitialBackoff.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PipelineTemplate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineTemplate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PipelineTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for k := range m.Args {
+			v := m.Args[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintPps(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintPps(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintPps(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SchedulingSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11292,6 +13433,46 @@ func (m *CreatePipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Template != nil {
+		{
+			size, err := m.Template.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPps(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.SidecarResourceLimits != nil {
 		{
 			size, err := m.SidecarResourceLimits.MarshalToSizedBuffer(dAtA[:i])
@@ -12387,6 +14568,10 @@ func (m *SecretMount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	l = len(m.VaultPath)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12529,19 +14714,38 @@ func (m *Egress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.SqlDatabase != nil {
+		l = m.SqlDatabase.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Http != nil {
+		l = m.Http.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Job) Size() (n int) {
+func (m *SQLEgress) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.FileFormat != 0 {
+		n += 1 + sovPps(uint64(m.FileFormat))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.PasswordEnvVar)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
@@ -12551,13 +14755,53 @@ func (m *Job) Size() (n int) {
 	return n
 }
 
-func (m *Metadata) Size() (n int) {
+func (m *HTTPEgress) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Annotations) > 0 {
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Job) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Annotations) > 0 {
 		for k, v := range m.Annotations {
 			_ = k
 			_ = v
@@ -12622,6 +14866,77 @@ func (m *Spout) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Ingest != nil {
+		l = m.Ingest.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *KafkaSpoutSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Brokers) > 0 {
+		for _, s := range m.Brokers {
+			l = len(s)
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	l = len(m.Topic)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *IngestSpoutSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Protocol != 0 {
+		n += 1 + sovPps(uint64(m.Protocol))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpoutBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMessages != 0 {
+		n += 1 + sovPps(uint64(m.MaxMessages))
+	}
+	if m.MaxBytes != 0 {
+		n += 1 + sovPps(uint64(m.MaxBytes))
+	}
+	if m.MaxDelay != nil {
+		l = m.MaxDelay.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12966,6 +15281,12 @@ func (m *ProcessStats) Size() (n int) {
 	if m.UploadBytes != 0 {
 		n += 1 + sovPps(uint64(m.UploadBytes))
 	}
+	if m.CpuSeconds != 0 {
+		n += 9
+	}
+	if m.MemoryByteSeconds != 0 {
+		n += 9
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13093,6 +15414,46 @@ func (m *GPUSpec) Size() (n int) {
 	return n
 }
 
+func (m *ResourceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Jobs != 0 {
+		n += 1 + sovPps(uint64(m.Jobs))
+	}
+	if m.CpuSeconds != 0 {
+		n += 9
+	}
+	if m.MemoryByteSeconds != 0 {
+		n += 9
+	}
+	if m.DownloadBytes != 0 {
+		n += 1 + sovPps(uint64(m.DownloadBytes))
+	}
+	if m.UploadBytes != 0 {
+		n += 1 + sovPps(uint64(m.UploadBytes))
+	}
+	if m.DataProcessed != 0 {
+		n += 1 + sovPps(uint64(m.DataProcessed))
+	}
+	if m.DataSkipped != 0 {
+		n += 1 + sovPps(uint64(m.DataSkipped))
+	}
+	if m.DataFailed != 0 {
+		n += 1 + sovPps(uint64(m.DataFailed))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *EtcdJobInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13152,6 +15513,9 @@ func (m *EtcdJobInfo) Size() (n int) {
 	if m.DataRecovered != 0 {
 		n += 1 + sovPps(uint64(m.DataRecovered))
 	}
+	if m.DataQuarantined != 0 {
+		n += 2 + sovPps(uint64(m.DataQuarantined))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13316,6 +15680,82 @@ func (m *JobInfo) Size() (n int) {
 		l = m.SidecarResourceLimits.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DataQuarantined != 0 {
+		n += 2 + sovPps(uint64(m.DataQuarantined))
+	}
+	if m.Timeline != nil {
+		l = m.Timeline.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TimelineSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpanID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.ParentSpanID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.DatumID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Start != nil {
+		l = m.Start.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.End != nil {
+		l = m.End.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *JobTimeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spans) > 0 {
+		for _, e := range m.Spans {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.DatumSpansDropped != 0 {
+		n += 1 + sovPps(uint64(m.DatumSpansDropped))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13410,6 +15850,10 @@ func (m *EtcdPipelineInfo) Size() (n int) {
 	if m.Parallelism != 0 {
 		n += 1 + sovPps(uint64(m.Parallelism))
 	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13579,6 +16023,18 @@ func (m *PipelineInfo) Size() (n int) {
 		l = m.SidecarResourceLimits.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13658,6 +16114,9 @@ func (m *CreateJobRequest) Size() (n int) {
 		l = m.Finished.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DataQuarantined != 0 {
+		n += 2 + sovPps(uint64(m.DataQuarantined))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13684,6 +16143,9 @@ func (m *InspectJobRequest) Size() (n int) {
 	if m.Full {
 		n += 2
 	}
+	if m.Timeline {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -13722,6 +16184,47 @@ func (m *ListJobRequest) Size() (n int) {
 	return n
 }
 
+func (m *ListUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.GroupBy != 0 {
+		n += 1 + sovPps(uint64(m.GroupBy))
+	}
+	l = len(m.LabelKey)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		for _, e := range m.Usage {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *FlushJobRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -13817,6 +16320,9 @@ func (m *UpdateJobStateRequest) Size() (n int) {
 		l = m.Stats.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.DataQuarantined != 0 {
+		n += 1 + sovPps(uint64(m.DataQuarantined))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14037,42 +16543,99 @@ func (m *ChunkSpec) Size() (n int) {
 	return n
 }
 
-func (m *SchedulingSpec) Size() (n int) {
+func (m *RetryPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.NodeSelector) > 0 {
-		for k, v := range m.NodeSelector {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
-			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
-		}
+	if m.InitialBackoff != nil {
+		l = m.InitialBackoff.Size()
+		n += 1 + l + sovPps(uint64(l))
 	}
-	l = len(m.PriorityClassName)
-	if l > 0 {
+	if m.MaxBackoff != nil {
+		l = m.MaxBackoff.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Multiplier != 0 {
+		n += 9
+	}
+	if len(m.RetryReturnCode) > 0 {
+		l = 0
+		for _, e := range m.RetryReturnCode {
+			l += sovPps(uint64(e))
+		}
+		n += 1 + sovPps(uint64(l)) + l
+	}
+	if m.Quarantine {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *CreatePipelineRequest) Size() (n int) {
+func (m *PipelineTemplate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Pipeline != nil {
-		l = m.Pipeline.Size()
+	l = len(m.Source)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Transform != nil {
-		l = m.Transform.Size()
+	if len(m.Args) > 0 {
+		for k, v := range m.Args {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchedulingSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.NodeSelector) > 0 {
+		for k, v := range m.NodeSelector {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPps(uint64(len(k))) + 1 + len(v) + sovPps(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPps(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PriorityClassName)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreatePipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Transform != nil {
+		l = m.Transform.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	if m.Update {
@@ -14184,6 +16747,17 @@ func (m *CreatePipelineRequest) Size() (n int) {
 		l = m.SidecarResourceLimits.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.Template != nil {
+		l = m.Template.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.DryRun {
+		n += 3
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -14647,6 +17221,38 @@ func (m *SecretMount) Unmarshal(dAtA []byte) error {
 			}
 			m.Key = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15604,6 +18210,78 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 			}
 			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqlDatabase", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SqlDatabase == nil {
+				m.SqlDatabase = &SQLEgress{}
+			}
+			if err := m.SqlDatabase.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Http == nil {
+				m.Http = &HTTPEgress{}
+			}
+			if err := m.Http.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -15629,7 +18307,7 @@ func (m *Egress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Job) Unmarshal(dAtA []byte) error {
+func (m *SQLEgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15652,15 +18330,15 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Job: wiretype end group for non-group")
+			return fmt.Errorf("proto: SQLEgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SQLEgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15688,7 +18366,90 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileFormat", wireType)
+			}
+			m.FileFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileFormat |= SQLEgress_FileFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PasswordEnvVar", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PasswordEnvVar = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -15715,7 +18476,7 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Metadata) Unmarshal(dAtA []byte) error {
+func (m *HTTPEgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15738,17 +18499,17 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPEgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPEgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -15758,122 +18519,27 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPps
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPps
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthPps
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipPps(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthPps
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Annotations[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15900,8 +18566,8 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
 			}
 			var mapkey string
 			var mapvalue string
@@ -15996,7 +18662,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.Headers[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16023,7 +18689,7 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *Job) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16046,85 +18712,15 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: Job: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Job: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
-			}
-			m.InternalPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InternalPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
-			}
-			m.ExternalPort = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExternalPort |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IP = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16152,7 +18748,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16179,7 +18775,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Spout) Unmarshal(dAtA []byte) error {
+func (m *Metadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16202,35 +18798,15 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+			return fmt.Errorf("proto: Metadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Metadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Overwrite = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16257,45 +18833,1298 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
-			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Service) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			m.InternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			m.ExternalPort = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExternalPort |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Spout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Spout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Spout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Service == nil {
+				m.Service = &Service{}
+			}
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
 			m.Marker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaSpoutSource{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ingest == nil {
+				m.Ingest = &IngestSpoutSource{}
+			}
+			if err := m.Ingest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Batch == nil {
+				m.Batch = &SpoutBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaSpoutSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaSpoutSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaSpoutSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Brokers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Brokers = append(m.Brokers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IngestSpoutSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IngestSpoutSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IngestSpoutSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protocol", wireType)
+			}
+			m.Protocol = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Protocol |= IngestSpoutSource_Protocol(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpoutBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpoutBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpoutBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMessages", wireType)
+			}
+			m.MaxMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMessages |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+			}
+			m.MaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxDelay == nil {
+				m.MaxDelay = &types.Duration{}
+			}
+			if err := m.MaxDelay.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PFSInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Glob = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lazy = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmptyFiles = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.S3 = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &pfs.Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16321,7 +20150,7 @@ func (m *Spout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PFSInput) Unmarshal(dAtA []byte) error {
+func (m *CronInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16344,10 +20173,10 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PFSInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PFSInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16416,7 +20245,7 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16444,11 +20273,11 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16476,13 +20305,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Spec = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16492,27 +20321,31 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
+			}
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -16529,12 +20362,66 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmptyFiles", wireType)
+			m.Overwrite = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GitInput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16544,15 +20431,27 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EmptyFiles = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16580,13 +20479,13 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S3", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16596,17 +20495,29 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.S3 = bool(v != 0)
-		case 10:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16616,27 +20527,23 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Trigger == nil {
-				m.Trigger = &pfs.Trigger{}
-			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16663,7 +20570,7 @@ func (m *PFSInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CronInput) Unmarshal(dAtA []byte) error {
+func (m *Input) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16686,17 +20593,17 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CronInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CronInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16706,29 +20613,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Cross = append(m.Cross, &Input{})
+			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16738,29 +20647,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repo = string(dAtA[iNdEx:postIndex])
+			m.Union = append(m.Union, &Input{})
+			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16770,29 +20681,33 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			if m.Cron == nil {
+				m.Cron = &CronInput{}
+			}
+			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16802,27 +20717,31 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			if m.Git == nil {
+				m.Git = &GitInput{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16849,18 +20768,18 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Start == nil {
-				m.Start = &types.Timestamp{}
+			if m.Pfs == nil {
+				m.Pfs = &PFSInput{}
 			}
-			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16870,12 +20789,26 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16901,7 +20834,7 @@ func (m *CronInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitInput) Unmarshal(dAtA []byte) error {
+func (m *JobInput) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16924,17 +20857,17 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16944,27 +20877,31 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Commit == nil {
+				m.Commit = &pfs.Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -16992,13 +20929,13 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URL = string(dAtA[iNdEx:postIndex])
+			m.Glob = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17008,27 +20945,15 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Lazy = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17056,7 +20981,7 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commit = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17083,7 +21008,7 @@ func (m *GitInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Input) Unmarshal(dAtA []byte) error {
+func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17106,17 +21031,17 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Input: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Input: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cross", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
 			}
-			var msglen int
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17126,31 +21051,81 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Coefficient = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cross = append(m.Cross, &Input{})
-			if err := m.Cross[len(m.Cross)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Union", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashtreeSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashtreeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+			}
+			m.Constant = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17160,31 +21135,70 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Constant |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthPps
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Union = append(m.Union, &Input{})
-			if err := m.Union[len(m.Union)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cron", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17194,33 +21208,29 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cron == nil {
-				m.Cron = &CronInput{}
-			}
-			if err := m.Cron.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17230,33 +21240,85 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitInput{}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Datum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pfs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17266,31 +21328,27 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pfs == nil {
-				m.Pfs = &PFSInput{}
-			}
-			if err := m.Pfs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17317,8 +21375,10 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Join = append(m.Join, &Input{})
-			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17347,7 +21407,7 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobInput) Unmarshal(dAtA []byte) error {
+func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17370,15 +21430,15 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobInput: wiretype end group for non-group")
+			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInput: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17405,18 +21465,37 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &pfs.Commit{}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= DatumState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Glob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17426,29 +21505,33 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Glob = string(dAtA[iNdEx:postIndex])
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lazy", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17458,17 +21541,33 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Lazy = bool(v != 0)
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PfsState == nil {
+				m.PfsState = &pfs.File{}
+			}
+			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17478,23 +21577,25 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Data = append(m.Data, &pfs.FileInfo{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -17521,7 +21622,7 @@ func (m *JobInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
+func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17544,17 +21645,17 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParallelismSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParallelismSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			m.Constant = 0
+			m.Count = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17564,14 +21665,47 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				m.Count |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Mean = float64(math.Float64frombits(v))
 		case 3:
 			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Stddev = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.FifthPercentile = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
 			}
 			var v uint64
 			if (iNdEx + 8) > l {
@@ -17579,7 +21713,7 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 			}
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
-			m.Coefficient = float64(math.Float64frombits(v))
+			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17605,7 +21739,7 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
+func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17628,17 +21762,125 @@ func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HashtreeSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HashtreeSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DownloadTime == nil {
+				m.DownloadTime = &types.Duration{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &types.Duration{}
+			}
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UploadTime == nil {
+				m.UploadTime = &types.Duration{}
+			}
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Constant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			m.Constant = 0
+			m.DownloadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17648,11 +21890,52 @@ func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Constant |= uint64(b&0x7F) << shift
+				m.DownloadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+			}
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuSeconds = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryByteSeconds", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MemoryByteSeconds = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17678,7 +21961,7 @@ func (m *HashtreeSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InputFile) Unmarshal(dAtA []byte) error {
+func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17701,17 +21984,17 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17721,29 +22004,33 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			if m.DownloadTime == nil {
+				m.DownloadTime = &Aggregate{}
+			}
+			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17753,85 +22040,69 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
+			if m.ProcessTime == nil {
+				m.ProcessTime = &Aggregate{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Datum) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.UploadTime == nil {
+				m.UploadTime = &Aggregate{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Datum: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Datum: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17841,27 +22112,31 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			if m.DownloadBytes == nil {
+				m.DownloadBytes = &Aggregate{}
+			}
+			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17888,10 +22163,10 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.UploadBytes == nil {
+				m.UploadBytes = &Aggregate{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17920,7 +22195,7 @@ func (m *Datum) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DatumInfo) Unmarshal(dAtA []byte) error {
+func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17943,17 +22218,17 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DatumInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DatumInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17963,33 +22238,29 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
 			}
-			m.State = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17999,14 +22270,27 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= DatumState(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18033,16 +22317,14 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Data = append(m.Data, &InputFile{})
+			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PfsState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18069,16 +22351,16 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PfsState == nil {
-				m.PfsState = &pfs.File{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.PfsState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18105,70 +22387,18 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &pfs.FileInfo{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Aggregate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Aggregate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Aggregate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
 			}
-			m.Count = 0
+			m.QueueSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18178,55 +22408,49 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int64(b&0x7F) << shift
+				m.QueueSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mean", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Mean = float64(math.Float64frombits(v))
-		case 3:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stddev", wireType)
-			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Stddev = float64(math.Float64frombits(v))
-		case 4:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifthPercentile", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.DataProcessed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataProcessed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.FifthPercentile = float64(math.Float64frombits(v))
-		case 5:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NinetyFifthPercentile", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.NinetyFifthPercentile = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18252,7 +22476,7 @@ func (m *Aggregate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProcessStats) Unmarshal(dAtA []byte) error {
+func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18275,17 +22499,28 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.Cpu = float32(math.Float32frombits(v))
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18295,33 +22530,29 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &types.Duration{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18331,31 +22562,27 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &types.Duration{}
-			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Disk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18382,18 +22609,72 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UploadTime == nil {
-				m.UploadTime = &types.Duration{}
+			if m.Gpu == nil {
+				m.Gpu = &GPUSpec{}
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DownloadBytes = 0
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GPUSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18403,16 +22684,29 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DownloadBytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.UploadBytes = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18422,7 +22716,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadBytes |= uint64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -18452,7 +22746,7 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
+func (m *ResourceUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18475,17 +22769,17 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AggregateProcessStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResourceUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AggregateProcessStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResourceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18495,33 +22789,29 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DownloadTime == nil {
-				m.DownloadTime = &Aggregate{}
-			}
-			if err := m.DownloadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Group = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jobs", wireType)
 			}
-			var msglen int
+			m.Jobs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18531,33 +22821,38 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Jobs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuSeconds", wireType)
 			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProcessTime == nil {
-				m.ProcessTime = &Aggregate{}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.CpuSeconds = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryByteSeconds", wireType)
 			}
-			if err := m.ProcessTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadTime", wireType)
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MemoryByteSeconds = float64(math.Float64frombits(v))
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
 			}
-			var msglen int
+			m.DownloadBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18567,33 +22862,35 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DownloadBytes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UploadTime == nil {
-				m.UploadTime = &Aggregate{}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
 			}
-			if err := m.UploadTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.UploadBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadBytes", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18603,33 +22900,16 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.DownloadBytes == nil {
-				m.DownloadBytes = &Aggregate{}
-			}
-			if err := m.DownloadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadBytes", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			var msglen int
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18639,28 +22919,30 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.UploadBytes == nil {
-				m.UploadBytes = &Aggregate{}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			if err := m.UploadBytes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataFailed |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -18686,7 +22968,7 @@ func (m *AggregateProcessStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
+func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18709,17 +22991,17 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: EtcdJobInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EtcdJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18729,29 +23011,33 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerID = string(dAtA[iNdEx:postIndex])
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18761,27 +23047,31 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobID = string(dAtA[iNdEx:postIndex])
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18808,16 +23098,18 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data, &InputFile{})
-			if err := m.Data[len(m.Data)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
+			}
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18827,33 +23119,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
-			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18863,33 +23138,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			m.QueueSize = 0
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18899,16 +23157,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueueSize |= int64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			m.DataProcessed = 0
+			m.DataTotal = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18918,16 +23176,16 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
+				m.DataTotal |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			m.DataRecovered = 0
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -18937,81 +23195,52 @@ func (m *WorkerStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.Cpu = float32(math.Float32frombits(v))
-		case 2:
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19021,27 +23250,50 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
+			}
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= JobState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Disk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19069,11 +23321,11 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Disk = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19100,72 +23352,18 @@ func (m *ResourceSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Gpu == nil {
-				m.Gpu = &GPUSpec{}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
 			}
-			if err := m.Gpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GPUSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GPUSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GPUSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19175,29 +23373,33 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
 			}
-			m.Number = 0
+			m.DataRecovered = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19207,7 +23409,26 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -19237,7 +23458,7 @@ func (m *GPUSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
+func (m *JobInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19260,10 +23481,10 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EtcdJobInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EtcdJobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19304,7 +23525,7 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19331,16 +23552,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.Transform == nil {
+				m.Transform = &Transform{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19367,75 +23588,18 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
-			}
-			m.DataSkipped = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
 			}
-			m.DataTotal = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19445,16 +23609,33 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DataFailed = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentJob == nil {
+				m.ParentJob = &Job{}
+			}
+			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19464,14 +23645,31 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19498,16 +23696,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19534,14 +23732,14 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
+			if m.OutputCommit == nil {
+				m.OutputCommit = &pfs.Commit{}
 			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
@@ -19562,9 +23760,9 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			}
 		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19574,27 +23772,50 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.ParallelismSpec == nil {
+				m.ParallelismSpec = &ParallelismSpec{}
+			}
+			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+			}
+			m.PipelineVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PipelineVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19621,16 +23842,16 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.Service == nil {
+				m.Service = &Service{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19657,18 +23878,18 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Egress == nil {
+				m.Egress = &Egress{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
 			}
-			m.DataRecovered = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19678,68 +23899,27 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19766,18 +23946,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.OutputRepo == nil {
+				m.OutputRepo = &pfs.Repo{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transform", wireType)
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
 			}
-			var msglen int
+			m.Restart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19787,33 +23967,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Restart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Transform == nil {
-				m.Transform = &Transform{}
-			}
-			if err := m.Transform.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
 			}
-			var msglen int
+			m.DataProcessed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -19823,31 +23986,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataProcessed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.DataTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataTotal |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 6:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19874,16 +24039,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentJob == nil {
-				m.ParentJob = &Job{}
-			}
-			if err := m.ParentJob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
+			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19910,16 +24073,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &types.Timestamp{}
+			if m.ResourceRequests == nil {
+				m.ResourceRequests = &ResourceSpec{}
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19946,16 +24109,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &types.Timestamp{}
+			if m.Input == nil {
+				m.Input = &Input{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19982,35 +24145,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputCommit == nil {
-				m.OutputCommit = &pfs.Commit{}
+			if m.NewBranch == nil {
+				m.NewBranch = &pfs.BranchInfo{}
 			}
-			if err := m.OutputCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			m.State = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.State |= JobState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParallelismSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20037,18 +24181,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParallelismSpec == nil {
-				m.ParallelismSpec = &ParallelismSpec{}
+			if m.StatsCommit == nil {
+				m.StatsCommit = &pfs.Commit{}
 			}
-			if err := m.ParallelismSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 30:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
 			}
-			m.PipelineVersion = 0
+			m.DataSkipped = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20058,14 +24202,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PipelineVersion |= uint64(b&0x7F) << shift
+				m.DataSkipped |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20092,18 +24236,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Service == nil {
-				m.Service = &Service{}
+			if m.Stats == nil {
+				m.Stats = &ProcessStats{}
 			}
-			if err := m.Service.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20113,31 +24257,15 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Egress == nil {
-				m.Egress = &Egress{}
-			}
-			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 17:
+			m.EnableStats = bool(v != 0)
+		case 33:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20165,13 +24293,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OutputBranch = string(dAtA[iNdEx:postIndex])
+			m.Salt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 35:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutputRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20181,88 +24309,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OutputRepo == nil {
-				m.OutputRepo = &pfs.Repo{}
-			}
-			if err := m.OutputRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
-			}
-			m.Restart = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Restart |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 22:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataProcessed", wireType)
-			}
-			m.DataProcessed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataProcessed |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 23:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataTotal", wireType)
-			}
-			m.DataTotal = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DataTotal |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 24:
+		case 36:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20289,14 +24356,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerStatus = append(m.WorkerStatus, &WorkerStatus{})
-			if err := m.WorkerStatus[len(m.WorkerStatus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ResourceLimits == nil {
+				m.ResourceLimits = &ResourceSpec{}
+			}
+			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 37:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20323,16 +24392,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceRequests == nil {
-				m.ResourceRequests = &ResourceSpec{}
+			if m.ChunkSpec == nil {
+				m.ChunkSpec = &ChunkSpec{}
 			}
-			if err := m.ResourceRequests.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 38:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20359,16 +24428,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Input{}
+			if m.DatumTimeout == nil {
+				m.DatumTimeout = &types.Duration{}
 			}
-			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 27:
+		case 39:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20395,18 +24464,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewBranch == nil {
-				m.NewBranch = &pfs.BranchInfo{}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &types.Duration{}
 			}
-			if err := m.NewBranch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 29:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatsCommit", wireType)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
 			}
-			var msglen int
+			m.DataFailed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20416,33 +24485,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DataFailed |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StatsCommit == nil {
-				m.StatsCommit = &pfs.Commit{}
-			}
-			if err := m.StatsCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 30:
+		case 41:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSkipped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			m.DataSkipped = 0
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20452,14 +24504,14 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataSkipped |= int64(b&0x7F) << shift
+				m.DatumTries |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 31:
+		case 42:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20486,18 +24538,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &ProcessStats{}
+			if m.SchedulingSpec == nil {
+				m.SchedulingSpec = &SchedulingSpec{}
 			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 32:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableStats", wireType)
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20507,15 +24559,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableStats = bool(v != 0)
-		case 33:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 44:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20543,13 +24607,13 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.PodPatch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 35:
+		case 45:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20559,27 +24623,50 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if m.Spout == nil {
+				m.Spout = &Spout{}
+			}
+			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 36:
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+			}
+			m.DataRecovered = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataRecovered |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 47:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20606,16 +24693,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceLimits == nil {
-				m.ResourceLimits = &ResourceSpec{}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-			if err := m.ResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 37:
+		case 48:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChunkSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20642,16 +24729,16 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ChunkSpec == nil {
-				m.ChunkSpec = &ChunkSpec{}
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
 			}
-			if err := m.ChunkSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 38:
+		case 49:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20678,16 +24765,35 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumTimeout == nil {
-				m.DatumTimeout = &types.Duration{}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 39:
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20714,18 +24820,72 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &types.Duration{}
+			if m.Timeline == nil {
+				m.Timeline = &JobTimeline{}
 			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 40:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFailed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.DataFailed = 0
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimelineSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimelineSpan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimelineSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpanID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20735,16 +24895,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataFailed |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 41:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
 			}
-			m.DatumTries = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentSpanID", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20754,16 +24927,29 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DatumTries |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 42:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentSpanID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20773,31 +24959,27 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingSpec == nil {
-				m.SchedulingSpec = &SchedulingSpec{}
-			}
-			if err := m.SchedulingSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 43:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20825,11 +25007,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodSpec = string(dAtA[iNdEx:postIndex])
+			m.Worker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 44:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodPatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20857,11 +25039,11 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodPatch = string(dAtA[iNdEx:postIndex])
+			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 45:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20888,18 +25070,18 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spout == nil {
-				m.Spout = &Spout{}
+			if m.Start == nil {
+				m.Start = &types.Timestamp{}
 			}
-			if err := m.Spout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Start.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 46:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataRecovered", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.DataRecovered = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20909,16 +25091,33 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DataRecovered |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 47:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.End == nil {
+				m.End = &types.Timestamp{}
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -20928,31 +25127,81 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPps
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
-			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 48:
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobTimeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobTimeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobTimeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -20979,13 +25228,30 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
-			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Spans = append(m.Spans, &TimelineSpan{})
+			if err := m.Spans[len(m.Spans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumSpansDropped", wireType)
+			}
+			m.DatumSpansDropped = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumSpansDropped |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -21575,6 +25841,42 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &ResourceUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -22890,11 +27192,102 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 50:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkersAvailable", wireType)
+			}
+			m.WorkersAvailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkersAvailable |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SidecarResourceLimits == nil {
+				m.SidecarResourceLimits = &ResourceSpec{}
+			}
+			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.WorkersAvailable = 0
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -22904,14 +27297,31 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WorkersAvailable |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 51:
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &PipelineTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 54:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SidecarResourceLimits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -22938,10 +27348,10 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SidecarResourceLimits == nil {
-				m.SidecarResourceLimits = &ResourceSpec{}
+			if m.Usage == nil {
+				m.Usage = &ResourceUsage{}
 			}
-			if err := m.SidecarResourceLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -23468,6 +27878,25 @@ func (m *CreateJobRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23634,6 +28063,26 @@ func (m *InspectJobRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Full = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Timeline = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -23817,7 +28266,222 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			var v int
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Full = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &types.Timestamp{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupBy", wireType)
+			}
+			m.GroupBy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupBy |= ListUsageRequest_GroupBy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -23827,12 +28491,26 @@ func (m *ListJobRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, &ResourceUsage{})
+			if err := m.Usage[len(m.Usage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -24426,6 +29104,25 @@ func (m *UpdateJobStateRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataQuarantined", wireType)
+			}
+			m.DataQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25022,7 +29719,219 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Master = bool(v != 0)
+			m.Master = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Datum == nil {
+				m.Datum = &Datum{}
+			}
+			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25048,7 +29957,7 @@ func (m *LogMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25071,10 +29980,10 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RestartDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RestartDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -25114,10 +30023,10 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataFilters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			var stringLen uint64
+			m.PageSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25127,24 +30036,30 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PageSize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.DataFilters = append(m.DataFilters, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25170,7 +30085,7 @@ func (m *RestartDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25193,15 +30108,15 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Datum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25228,13 +30143,49 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Datum == nil {
-				m.Datum = &Datum{}
-			}
-			if err := m.Datum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
+			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25260,7 +30211,7 @@ func (m *InspectDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
+func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25283,15 +30234,15 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListDatumStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListDatumStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25318,18 +30269,18 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Job == nil {
-				m.Job = &Job{}
+			if m.DatumInfo == nil {
+				m.DatumInfo = &DatumInfo{}
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
 			}
-			m.PageSize = 0
+			m.TotalPages = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25339,7 +30290,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int64(b&0x7F) << shift
+				m.TotalPages |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -25388,7 +30339,7 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
+func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25411,51 +30362,17 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfos", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPps
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPps
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DatumInfos = append(m.DatumInfos, &DatumInfo{})
-			if err := m.DatumInfos[len(m.DatumInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.TotalPages = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25465,16 +30382,16 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalPages |= int64(b&0x7F) << shift
+				m.Number |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			m.Page = 0
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25484,7 +30401,7 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				m.SizeBytes |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -25514,7 +30431,7 @@ func (m *ListDatumResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25537,15 +30454,15 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListDatumStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListDatumStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatumInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25572,37 +30489,141 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DatumInfo == nil {
-				m.DatumInfo = &DatumInfo{}
+			if m.InitialBackoff == nil {
+				m.InitialBackoff = &types.Duration{}
 			}
-			if err := m.DatumInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InitialBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
 			}
-			m.TotalPages = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxBackoff == nil {
+				m.MaxBackoff = &types.Duration{}
+			}
+			if err := m.MaxBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Multiplier = float64(math.Float64frombits(v))
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryReturnCode = append(m.RetryReturnCode, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPps
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPps
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalPages |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RetryReturnCode) == 0 {
+					m.RetryReturnCode = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryReturnCode = append(m.RetryReturnCode, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryReturnCode", wireType)
 			}
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quarantine", wireType)
 			}
-			m.Page = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25612,11 +30633,12 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Page |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Quarantine = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -25642,7 +30664,7 @@ func (m *ListDatumStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
+func (m *PipelineTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25665,17 +30687,17 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChunkSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChunkSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.Number = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25685,16 +30707,29 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			m.SizeBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -25704,11 +30739,119 @@ func (m *ChunkSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SizeBytes |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Args == nil {
+				m.Args = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPps
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPps
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthPps
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPps(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPps
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Args[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -26954,6 +32097,98 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 48:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 49:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPps
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Template == nil {
+				m.Template = &PipelineTemplate{}
+			}
+			if err := m.Template.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 50:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/replication"
+)
+
+// CreateReplication starts mirroring the commits on 'branches' (or master, if
+// 'branches' is empty) of 'remoteRepo', in the cluster whose pachd is at
+// 'remoteAddress', into the local repo 'repo'. 'remoteToken' authenticates
+// reads from the remote cluster, and may be empty if auth isn't active there.
+func (c APIClient) CreateReplication(name, remoteAddress, remoteToken, remoteRepo string, branches []string, repo string, update bool) error {
+	_, err := c.ReplicationAPIClient.CreateReplication(
+		c.Ctx(),
+		&replication.CreateReplicationRequest{
+			Name: name,
+			Remote: &replication.Remote{
+				PachdAddress: remoteAddress,
+				AuthToken:    remoteToken,
+				Repo:         remoteRepo,
+			},
+			Branches: branches,
+			Repo:     repo,
+			Update:   update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListReplication returns the cluster's replications, along with their
+// statuses.
+func (c APIClient) ListReplication() ([]*replication.ReplicationInfo, error) {
+	resp, err := c.ReplicationAPIClient.ListReplication(c.Ctx(), &replication.ListReplicationRequest{})
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return resp.Replications, nil
+}
+
+// DeleteReplication stops the replication 'name'. The commits that it
+// replicated are left in the local repo.
+func (c APIClient) DeleteReplication(name string) error {
+	_, err := c.ReplicationAPIClient.DeleteReplication(
+		c.Ctx(),
+		&replication.DeleteReplicationRequest{Name: name},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
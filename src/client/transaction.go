@@ -311,6 +311,26 @@ func (c *ppsBuilderClient) UpdateJobState(ctx context.Context, req *pps.UpdateJo
 	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{UpdateJobState: req})
 	return nil, nil
 }
+func (c *pfsBuilderClient) DeleteFile(ctx context.Context, req *pfs.DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeleteFile: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{CreatePipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{DeletePipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StartPipeline: req})
+	return nil, nil
+}
+func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
+	c.tb.requests = append(c.tb.requests, &transaction.TransactionRequest{StopPipeline: req})
+	return nil, nil
+}
 
 // Boilerplate for making unsupported API requests error when used on a TransactionBuilder
 func unsupportedError(name string) error {
@@ -377,9 +397,6 @@ func (c *pfsBuilderClient) GlobFileStream(ctx context.Context, req *pfs.GlobFile
 func (c *pfsBuilderClient) DiffFile(ctx context.Context, req *pfs.DiffFileRequest, opts ...grpc.CallOption) (*pfs.DiffFileResponse, error) {
 	return nil, unsupportedError("DiffFile")
 }
-func (c *pfsBuilderClient) DeleteFile(ctx context.Context, req *pfs.DeleteFileRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("DeleteFile")
-}
 func (c *pfsBuilderClient) DeleteAll(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("DeleteAll")
 }
@@ -487,6 +504,9 @@ func (c *ppsBuilderClient) ListJob(ctx context.Context, req *pps.ListJobRequest,
 func (c *ppsBuilderClient) ListJobStream(ctx context.Context, req *pps.ListJobRequest, opts ...grpc.CallOption) (pps.API_ListJobStreamClient, error) {
 	return nil, unsupportedError("ListJobStream")
 }
+func (c *ppsBuilderClient) ListUsage(ctx context.Context, req *pps.ListUsageRequest, opts ...grpc.CallOption) (*pps.ListUsageResponse, error) {
+	return nil, unsupportedError("ListUsage")
+}
 func (c *ppsBuilderClient) FlushJob(ctx context.Context, req *pps.FlushJobRequest, opts ...grpc.CallOption) (pps.API_FlushJobClient, error) {
 	return nil, unsupportedError("FlushJob")
 }
@@ -508,24 +528,12 @@ func (c *ppsBuilderClient) ListDatumStream(ctx context.Context, req *pps.ListDat
 func (c *ppsBuilderClient) RestartDatum(ctx context.Context, req *pps.RestartDatumRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RestartDatum")
 }
-func (c *ppsBuilderClient) CreatePipeline(ctx context.Context, req *pps.CreatePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("CreatePipeline")
-}
 func (c *ppsBuilderClient) InspectPipeline(ctx context.Context, req *pps.InspectPipelineRequest, opts ...grpc.CallOption) (*pps.PipelineInfo, error) {
 	return nil, unsupportedError("InspectPipeline")
 }
 func (c *ppsBuilderClient) ListPipeline(ctx context.Context, req *pps.ListPipelineRequest, opts ...grpc.CallOption) (*pps.PipelineInfos, error) {
 	return nil, unsupportedError("ListPipeline")
 }
-func (c *ppsBuilderClient) DeletePipeline(ctx context.Context, req *pps.DeletePipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("DeletePipeline")
-}
-func (c *ppsBuilderClient) StartPipeline(ctx context.Context, req *pps.StartPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("StartPipeline")
-}
-func (c *ppsBuilderClient) StopPipeline(ctx context.Context, req *pps.StopPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
-	return nil, unsupportedError("StopPipeline")
-}
 func (c *ppsBuilderClient) RunPipeline(ctx context.Context, req *pps.RunPipelineRequest, opts ...grpc.CallOption) (*types.Empty, error) {
 	return nil, unsupportedError("RunPipeline")
 }
@@ -629,6 +637,48 @@ func (c *authBuilderClient) GetUsers(ctx context.Context, req *auth.GetUsersRequ
 func (c *authBuilderClient) GetOneTimePassword(ctx context.Context, req *auth.GetOneTimePasswordRequest, opts ...grpc.CallOption) (*auth.GetOneTimePasswordResponse, error) {
 	return nil, unsupportedError("GetOneTimePassword")
 }
+func (c *authBuilderClient) CreateRole(ctx context.Context, req *auth.CreateRoleRequest, opts ...grpc.CallOption) (*auth.CreateRoleResponse, error) {
+	return nil, unsupportedError("CreateRole")
+}
+func (c *authBuilderClient) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest, opts ...grpc.CallOption) (*auth.DeleteRoleResponse, error) {
+	return nil, unsupportedError("DeleteRole")
+}
+func (c *authBuilderClient) ListRoles(ctx context.Context, req *auth.ListRolesRequest, opts ...grpc.CallOption) (*auth.ListRolesResponse, error) {
+	return nil, unsupportedError("ListRoles")
+}
+func (c *authBuilderClient) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest, opts ...grpc.CallOption) (*auth.ModifyRoleBindingResponse, error) {
+	return nil, unsupportedError("ModifyRoleBinding")
+}
+func (c *authBuilderClient) GetRoleBindings(ctx context.Context, req *auth.GetRoleBindingsRequest, opts ...grpc.CallOption) (*auth.GetRoleBindingsResponse, error) {
+	return nil, unsupportedError("GetRoleBindings")
+}
+func (c *authBuilderClient) CreateRobot(ctx context.Context, req *auth.CreateRobotRequest, opts ...grpc.CallOption) (*auth.CreateRobotResponse, error) {
+	return nil, unsupportedError("CreateRobot")
+}
+func (c *authBuilderClient) ListRobots(ctx context.Context, req *auth.ListRobotsRequest, opts ...grpc.CallOption) (*auth.ListRobotsResponse, error) {
+	return nil, unsupportedError("ListRobots")
+}
+func (c *authBuilderClient) DeleteRobot(ctx context.Context, req *auth.DeleteRobotRequest, opts ...grpc.CallOption) (*auth.DeleteRobotResponse, error) {
+	return nil, unsupportedError("DeleteRobot")
+}
+func (c *authBuilderClient) CreateRobotKey(ctx context.Context, req *auth.CreateRobotKeyRequest, opts ...grpc.CallOption) (*auth.CreateRobotKeyResponse, error) {
+	return nil, unsupportedError("CreateRobotKey")
+}
+func (c *authBuilderClient) ListRobotKeys(ctx context.Context, req *auth.ListRobotKeysRequest, opts ...grpc.CallOption) (*auth.ListRobotKeysResponse, error) {
+	return nil, unsupportedError("ListRobotKeys")
+}
+func (c *authBuilderClient) DeleteRobotKey(ctx context.Context, req *auth.DeleteRobotKeyRequest, opts ...grpc.CallOption) (*auth.DeleteRobotKeyResponse, error) {
+	return nil, unsupportedError("DeleteRobotKey")
+}
+func (c *authBuilderClient) RotateRobotKey(ctx context.Context, req *auth.RotateRobotKeyRequest, opts ...grpc.CallOption) (*auth.RotateRobotKeyResponse, error) {
+	return nil, unsupportedError("RotateRobotKey")
+}
+func (c *authBuilderClient) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest, opts ...grpc.CallOption) (*auth.ListAuthTokensResponse, error) {
+	return nil, unsupportedError("ListAuthTokens")
+}
+func (c *authBuilderClient) RevokeAuthTokensForUser(ctx context.Context, req *auth.RevokeAuthTokensForUserRequest, opts ...grpc.CallOption) (*auth.RevokeAuthTokensForUserResponse, error) {
+	return nil, unsupportedError("RevokeAuthTokensForUser")
+}
 
 func (c *enterpriseBuilderClient) Activate(ctx context.Context, req *enterprise.ActivateRequest, opts ...grpc.CallOption) (*enterprise.ActivateResponse, error) {
 	return nil, unsupportedError("Activate")
@@ -653,6 +703,9 @@ func (c *adminBuilderClient) ExtractPipeline(ctx context.Context, req *admin.Ext
 func (c *adminBuilderClient) Restore(ctx context.Context, opts ...grpc.CallOption) (admin.API_RestoreClient, error) {
 	return nil, unsupportedError("Restore")
 }
+func (c *adminBuilderClient) RestoreDryRun(ctx context.Context, opts ...grpc.CallOption) (admin.API_RestoreDryRunClient, error) {
+	return nil, unsupportedError("RestoreDryRun")
+}
 func (c *adminBuilderClient) InspectCluster(ctx context.Context, req *types.Empty, opts ...grpc.CallOption) (*admin.ClusterInfo, error) {
 	return nil, unsupportedError("InspectCluster")
 }
@@ -682,6 +735,9 @@ func (c *transactionBuilderClient) DeleteAll(ctx context.Context, req *transacti
 func (c *debugBuilderClient) Profile(ctx context.Context, req *debug.ProfileRequest, opts ...grpc.CallOption) (debug.Debug_ProfileClient, error) {
 	return nil, unsupportedError("Profile")
 }
+func (c *debugBuilderClient) ProfileRange(ctx context.Context, req *debug.ProfileRangeRequest, opts ...grpc.CallOption) (debug.Debug_ProfileRangeClient, error) {
+	return nil, unsupportedError("ProfileRange")
+}
 func (c *debugBuilderClient) Binary(ctx context.Context, req *debug.BinaryRequest, opts ...grpc.CallOption) (debug.Debug_BinaryClient, error) {
 	return nil, unsupportedError("Binary")
 }
@@ -69,6 +69,65 @@ func (m *DeleteAllRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteAllRequest proto.InternalMessageInfo
 
+// PutFileRecordsRequest writes each of 'records' to the corresponding file in
+// 'files'. The records refer to data that was uploaded to object storage
+// when the request was made, so that only the (small) records are stored in
+// the transaction.
+type PutFileRecordsRequest struct {
+	Files                []*pfs.File           `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	Records              []*pfs.PutFileRecords `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PutFileRecordsRequest) Reset()         { *m = PutFileRecordsRequest{} }
+func (m *PutFileRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*PutFileRecordsRequest) ProtoMessage()    {}
+func (*PutFileRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_363f2adee3615c0c, []int{1}
+}
+func (m *PutFileRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PutFileRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PutFileRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PutFileRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutFileRecordsRequest.Merge(m, src)
+}
+func (m *PutFileRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PutFileRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutFileRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutFileRecordsRequest proto.InternalMessageInfo
+
+func (m *PutFileRecordsRequest) GetFiles() []*pfs.File {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *PutFileRecordsRequest) GetRecords() []*pfs.PutFileRecords {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
 type TransactionRequest struct {
 	// Exactly one of these fields should be set
 	CreateRepo           *pfs.CreateRepoRequest     `protobuf:"bytes,1,opt,name=create_repo,json=createRepo,proto3" json:"create_repo,omitempty"`
@@ -78,7 +137,13 @@ type TransactionRequest struct {
 	DeleteCommit         *pfs.DeleteCommitRequest   `protobuf:"bytes,5,opt,name=delete_commit,json=deleteCommit,proto3" json:"delete_commit,omitempty"`
 	CreateBranch         *pfs.CreateBranchRequest   `protobuf:"bytes,6,opt,name=create_branch,json=createBranch,proto3" json:"create_branch,omitempty"`
 	DeleteBranch         *pfs.DeleteBranchRequest   `protobuf:"bytes,7,opt,name=delete_branch,json=deleteBranch,proto3" json:"delete_branch,omitempty"`
+	DeleteFile           *pfs.DeleteFileRequest     `protobuf:"bytes,12,opt,name=delete_file,json=deleteFile,proto3" json:"delete_file,omitempty"`
+	PutFile              *PutFileRecordsRequest     `protobuf:"bytes,13,opt,name=put_file,json=putFile,proto3" json:"put_file,omitempty"`
 	UpdateJobState       *pps.UpdateJobStateRequest `protobuf:"bytes,11,opt,name=update_job_state,json=updateJobState,proto3" json:"update_job_state,omitempty"`
+	CreatePipeline       *pps.CreatePipelineRequest `protobuf:"bytes,14,opt,name=create_pipeline,json=createPipeline,proto3" json:"create_pipeline,omitempty"`
+	DeletePipeline       *pps.DeletePipelineRequest `protobuf:"bytes,15,opt,name=delete_pipeline,json=deletePipeline,proto3" json:"delete_pipeline,omitempty"`
+	StartPipeline        *pps.StartPipelineRequest  `protobuf:"bytes,16,opt,name=start_pipeline,json=startPipeline,proto3" json:"start_pipeline,omitempty"`
+	StopPipeline         *pps.StopPipelineRequest   `protobuf:"bytes,17,opt,name=stop_pipeline,json=stopPipeline,proto3" json:"stop_pipeline,omitempty"`
 	DeleteAll            *DeleteAllRequest          `protobuf:"bytes,10,opt,name=delete_all,json=deleteAll,proto3" json:"delete_all,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
@@ -89,7 +154,7 @@ func (m *TransactionRequest) Reset()         { *m = TransactionRequest{} }
 func (m *TransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*TransactionRequest) ProtoMessage()    {}
 func (*TransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{1}
+	return fileDescriptor_363f2adee3615c0c, []int{2}
 }
 func (m *TransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -167,6 +232,20 @@ func (m *TransactionRequest) GetDeleteBranch() *pfs.DeleteBranchRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetDeleteFile() *pfs.DeleteFileRequest {
+	if m != nil {
+		return m.DeleteFile
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetPutFile() *PutFileRecordsRequest {
+	if m != nil {
+		return m.PutFile
+	}
+	return nil
+}
+
 func (m *TransactionRequest) GetUpdateJobState() *pps.UpdateJobStateRequest {
 	if m != nil {
 		return m.UpdateJobState
@@ -174,6 +253,34 @@ func (m *TransactionRequest) GetUpdateJobState() *pps.UpdateJobStateRequest {
 	return nil
 }
 
+func (m *TransactionRequest) GetCreatePipeline() *pps.CreatePipelineRequest {
+	if m != nil {
+		return m.CreatePipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetDeletePipeline() *pps.DeletePipelineRequest {
+	if m != nil {
+		return m.DeletePipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStartPipeline() *pps.StartPipelineRequest {
+	if m != nil {
+		return m.StartPipeline
+	}
+	return nil
+}
+
+func (m *TransactionRequest) GetStopPipeline() *pps.StopPipelineRequest {
+	if m != nil {
+		return m.StopPipeline
+	}
+	return nil
+}
+
 func (m *TransactionRequest) GetDeleteAll() *DeleteAllRequest {
 	if m != nil {
 		return m.DeleteAll
@@ -193,7 +300,7 @@ func (m *TransactionResponse) Reset()         { *m = TransactionResponse{} }
 func (m *TransactionResponse) String() string { return proto.CompactTextString(m) }
 func (*TransactionResponse) ProtoMessage()    {}
 func (*TransactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{2}
+	return fileDescriptor_363f2adee3615c0c, []int{3}
 }
 func (m *TransactionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +347,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{3}
+	return fileDescriptor_363f2adee3615c0c, []int{4}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +397,7 @@ func (m *TransactionInfo) Reset()         { *m = TransactionInfo{} }
 func (m *TransactionInfo) String() string { return proto.CompactTextString(m) }
 func (*TransactionInfo) ProtoMessage()    {}
 func (*TransactionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{4}
+	return fileDescriptor_363f2adee3615c0c, []int{5}
 }
 func (m *TransactionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +465,7 @@ func (m *TransactionInfos) Reset()         { *m = TransactionInfos{} }
 func (m *TransactionInfos) String() string { return proto.CompactTextString(m) }
 func (*TransactionInfos) ProtoMessage()    {}
 func (*TransactionInfos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{5}
+	return fileDescriptor_363f2adee3615c0c, []int{6}
 }
 func (m *TransactionInfos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +512,7 @@ func (m *BatchTransactionRequest) Reset()         { *m = BatchTransactionRequest
 func (m *BatchTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*BatchTransactionRequest) ProtoMessage()    {}
 func (*BatchTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{6}
+	return fileDescriptor_363f2adee3615c0c, []int{7}
 }
 func (m *BatchTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,7 +558,7 @@ func (m *StartTransactionRequest) Reset()         { *m = StartTransactionRequest
 func (m *StartTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*StartTransactionRequest) ProtoMessage()    {}
 func (*StartTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{7}
+	return fileDescriptor_363f2adee3615c0c, []int{8}
 }
 func (m *StartTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +598,7 @@ func (m *InspectTransactionRequest) Reset()         { *m = InspectTransactionReq
 func (m *InspectTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*InspectTransactionRequest) ProtoMessage()    {}
 func (*InspectTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{8}
+	return fileDescriptor_363f2adee3615c0c, []int{9}
 }
 func (m *InspectTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -538,7 +645,7 @@ func (m *DeleteTransactionRequest) Reset()         { *m = DeleteTransactionReque
 func (m *DeleteTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTransactionRequest) ProtoMessage()    {}
 func (*DeleteTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{9}
+	return fileDescriptor_363f2adee3615c0c, []int{10}
 }
 func (m *DeleteTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +691,7 @@ func (m *ListTransactionRequest) Reset()         { *m = ListTransactionRequest{}
 func (m *ListTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*ListTransactionRequest) ProtoMessage()    {}
 func (*ListTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{10}
+	return fileDescriptor_363f2adee3615c0c, []int{11}
 }
 func (m *ListTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -624,7 +731,7 @@ func (m *FinishTransactionRequest) Reset()         { *m = FinishTransactionReque
 func (m *FinishTransactionRequest) String() string { return proto.CompactTextString(m) }
 func (*FinishTransactionRequest) ProtoMessage()    {}
 func (*FinishTransactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_363f2adee3615c0c, []int{11}
+	return fileDescriptor_363f2adee3615c0c, []int{12}
 }
 func (m *FinishTransactionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -662,6 +769,7 @@ func (m *FinishTransactionRequest) GetTransaction() *Transaction {
 
 func init() {
 	proto.RegisterType((*DeleteAllRequest)(nil), "transaction.DeleteAllRequest")
+	proto.RegisterType((*PutFileRecordsRequest)(nil), "transaction.PutFileRecordsRequest")
 	proto.RegisterType((*TransactionRequest)(nil), "transaction.TransactionRequest")
 	proto.RegisterType((*TransactionResponse)(nil), "transaction.TransactionResponse")
 	proto.RegisterType((*Transaction)(nil), "transaction.Transaction")
@@ -680,55 +788,65 @@ func init() {
 }
 
 var fileDescriptor_363f2adee3615c0c = []byte{
-	// 761 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0x4d, 0x6f, 0xd3, 0x4c,
-	0x10, 0xc7, 0xf3, 0xd2, 0x27, 0x7d, 0x32, 0x2e, 0x34, 0x5d, 0x50, 0xea, 0x06, 0xfa, 0x22, 0xb7,
-	0x45, 0x3d, 0x39, 0x52, 0x01, 0x21, 0x95, 0x17, 0xa9, 0x69, 0x00, 0x05, 0x71, 0x40, 0x6e, 0x69,
-	0x51, 0x41, 0x8a, 0x1c, 0x7b, 0x93, 0x18, 0x25, 0x5e, 0xe3, 0xdd, 0x1c, 0x7a, 0xe3, 0xb3, 0x71,
-	0xe2, 0xc8, 0x27, 0x40, 0x28, 0xe2, 0x83, 0xa0, 0x7d, 0x49, 0xba, 0x76, 0xe2, 0x02, 0xa2, 0x87,
-	0x48, 0xd6, 0x7f, 0xe6, 0x37, 0x33, 0x3b, 0x33, 0xbb, 0x2d, 0xec, 0x78, 0x83, 0x00, 0x87, 0xac,
-	0xce, 0x62, 0x37, 0xa4, 0xae, 0xc7, 0x02, 0x12, 0xea, 0xdf, 0x76, 0x14, 0x13, 0x46, 0x90, 0xa1,
-	0x49, 0xb5, 0x3b, 0x3d, 0x42, 0x7a, 0x03, 0x5c, 0x17, 0xa6, 0xce, 0xa8, 0x5b, 0xc7, 0xc3, 0x88,
-	0x5d, 0x48, 0xcf, 0xda, 0x66, 0xda, 0xc8, 0x82, 0x21, 0xa6, 0xcc, 0x1d, 0x46, 0xca, 0xe1, 0x76,
-	0x8f, 0xf4, 0x88, 0xf8, 0xac, 0xf3, 0xaf, 0x89, 0xaa, 0xca, 0x88, 0xba, 0x94, 0xff, 0xd2, 0x6a,
-	0x44, 0xf9, 0x4f, 0xaa, 0x16, 0x82, 0x4a, 0x13, 0x0f, 0x30, 0xc3, 0x87, 0x83, 0x81, 0x83, 0x3f,
-	0x8d, 0x30, 0x65, 0xd6, 0x97, 0x05, 0x40, 0x27, 0x97, 0x35, 0x2a, 0x19, 0x3d, 0x02, 0xc3, 0x8b,
-	0xb1, 0xcb, 0x70, 0x3b, 0xc6, 0x11, 0x31, 0xf3, 0x5b, 0xf9, 0x3d, 0x63, 0xbf, 0x6a, 0xf3, 0x0c,
-	0x47, 0x42, 0x77, 0x70, 0x44, 0x94, 0xb3, 0x03, 0xde, 0x54, 0xe2, 0xa0, 0x2f, 0x72, 0x48, 0xb0,
-	0xa0, 0x81, 0x32, 0x77, 0x02, 0xf4, 0xa7, 0x12, 0x3a, 0x80, 0x25, 0xca, 0xdc, 0x98, 0xb5, 0x3d,
-	0x32, 0x1c, 0x06, 0xcc, 0x2c, 0x0a, 0x72, 0x55, 0x90, 0xc7, 0xdc, 0x70, 0x24, 0xf4, 0x09, 0x6a,
-	0xd0, 0x4b, 0x0d, 0x3d, 0x85, 0x1b, 0xdd, 0x20, 0x0c, 0x68, 0x7f, 0x02, 0x2f, 0x08, 0xd8, 0x14,
-	0xf0, 0x0b, 0x61, 0x49, 0xd2, 0x4b, 0x5d, 0x4d, 0xe4, 0xb8, 0xaa, 0x59, 0xe1, 0xff, 0x69, 0xb8,
-	0xac, 0x3a, 0x85, 0xfb, 0x9a, 0xc8, 0x71, 0xd5, 0xab, 0x4e, 0xec, 0x86, 0x5e, 0xdf, 0x2c, 0x69,
-	0xb8, 0xec, 0x56, 0x43, 0x18, 0xa6, 0xb8, 0xa7, 0x89, 0x5a, 0x76, 0x85, 0x2f, 0xce, 0x64, 0x4f,
-	0xe1, 0xbe, 0x26, 0xa2, 0x26, 0x54, 0x46, 0x91, 0xcf, 0xb3, 0x7f, 0x24, 0x9d, 0x36, 0x65, 0x2e,
-	0xc3, 0xa6, 0x21, 0x22, 0xd4, 0x6c, 0x3e, 0xfa, 0xb7, 0xc2, 0xf8, 0x8a, 0x74, 0x8e, 0x99, 0x98,
-	0x91, 0x8c, 0x71, 0x73, 0x94, 0x90, 0xd1, 0x13, 0x50, 0xb3, 0x68, 0xbb, 0x83, 0x81, 0x09, 0x82,
-	0x5f, 0xb7, 0xf5, 0x7d, 0x4e, 0x6f, 0x8e, 0x53, 0xf6, 0x27, 0x8a, 0x75, 0x00, 0xb7, 0x12, 0x3b,
-	0x44, 0x23, 0x12, 0x52, 0x8c, 0xb6, 0xa1, 0xa4, 0x1a, 0x2a, 0xd7, 0xc0, 0x90, 0x1d, 0x91, 0xad,
-	0x54, 0x26, 0x6b, 0x17, 0x0c, 0x8d, 0x45, 0x55, 0x28, 0x04, 0xbe, 0xd8, 0xb7, 0x72, 0xa3, 0x34,
-	0xfe, 0xbe, 0x59, 0x68, 0x35, 0x9d, 0x42, 0xe0, 0x5b, 0x9f, 0x0b, 0xb0, 0xac, 0xf9, 0xb5, 0xc2,
-	0x2e, 0x5f, 0x19, 0xfd, 0x7a, 0xa9, 0x25, 0x35, 0x13, 0x55, 0xeb, 0x65, 0xe9, 0xce, 0xe8, 0x31,
-	0xfc, 0x1f, 0xcb, 0x83, 0x50, 0xb3, 0xb0, 0x55, 0xdc, 0x33, 0xf6, 0x37, 0x33, 0x41, 0x75, 0xe0,
-	0x29, 0x80, 0x9e, 0x41, 0x39, 0x56, 0x87, 0xa4, 0x66, 0x51, 0xd0, 0x5b, 0xd9, 0xb4, 0x74, 0x74,
-	0x2e, 0x11, 0xf4, 0x00, 0x16, 0xc5, 0xfa, 0x62, 0x5f, 0x6d, 0x6a, 0xcd, 0x96, 0xb7, 0xdf, 0x9e,
-	0xdc, 0x7e, 0xfb, 0x64, 0x72, 0xfb, 0x9d, 0x89, 0xab, 0xf5, 0x1e, 0x2a, 0xa9, 0x0e, 0x50, 0xf4,
-	0x12, 0x2a, 0x5a, 0xde, 0x76, 0x10, 0x76, 0xf9, 0x65, 0xe5, 0x05, 0xdd, 0xcd, 0x2a, 0x88, 0x83,
-	0xce, 0x32, 0x4b, 0x0a, 0xd6, 0x29, 0xac, 0x36, 0x5c, 0xe6, 0xf5, 0xe7, 0xbc, 0x05, 0x7a, 0xab,
-	0xf2, 0x7f, 0xd9, 0x2a, 0x6b, 0x0d, 0x56, 0xc5, 0xed, 0x9d, 0x75, 0xb2, 0xce, 0x60, 0xad, 0x15,
-	0xd2, 0x08, 0x7b, 0x73, 0x8c, 0xff, 0x32, 0x5b, 0xeb, 0x14, 0x4c, 0xb9, 0xad, 0xd7, 0x1c, 0xd7,
-	0x84, 0xea, 0xeb, 0x80, 0xce, 0x3b, 0xca, 0x29, 0x98, 0xf2, 0x99, 0xb9, 0xde, 0x8c, 0xfb, 0x3f,
-	0x17, 0xa0, 0x78, 0xf8, 0xa6, 0x85, 0xde, 0x41, 0x25, 0x3d, 0x1d, 0xb4, 0x93, 0x08, 0x91, 0x31,
-	0xbc, 0xda, 0x95, 0x6b, 0x60, 0xe5, 0xd0, 0x09, 0x54, 0xd2, 0xf3, 0x49, 0x45, 0xce, 0x18, 0x5f,
-	0x2d, 0xf3, 0x08, 0x56, 0x0e, 0x7d, 0x00, 0x34, 0x3b, 0x5a, 0x74, 0x2f, 0x41, 0x64, 0xce, 0xfe,
-	0x0f, 0x6a, 0x5e, 0x99, 0x99, 0x2f, 0xda, 0x9d, 0xf3, 0x5a, 0xcd, 0x89, 0x5d, 0x9d, 0xb9, 0x69,
-	0xcf, 0xf9, 0x1f, 0x61, 0x2b, 0x87, 0xce, 0x60, 0x39, 0x35, 0x5d, 0xb4, 0x9d, 0x88, 0x39, 0x7f,
-	0xf6, 0xb5, 0xf5, 0xab, 0xaa, 0xa5, 0x56, 0x0e, 0x9d, 0xc3, 0xca, 0xcc, 0x72, 0xa4, 0xca, 0xcd,
-	0x5a, 0x9e, 0xdf, 0xb6, 0xa2, 0x09, 0xe5, 0xe9, 0xc3, 0x8c, 0xae, 0x7e, 0xb0, 0xb3, 0x8f, 0xde,
-	0x38, 0xfa, 0x3a, 0xde, 0xc8, 0x7f, 0x1b, 0x6f, 0xe4, 0x7f, 0x8c, 0x37, 0xf2, 0xe7, 0x0f, 0x7b,
-	0x01, 0xeb, 0x8f, 0x3a, 0xb6, 0x47, 0x86, 0xf5, 0xc8, 0xf5, 0xfa, 0x17, 0x3e, 0x8e, 0xf5, 0x2f,
-	0x1a, 0x7b, 0xf5, 0xd9, 0x7f, 0x7e, 0x3a, 0x25, 0x11, 0xf6, 0xfe, 0xaf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xaf, 0xd5, 0x4d, 0xee, 0x19, 0x09, 0x00, 0x00,
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
+	0x14, 0x8d, 0x9d, 0x36, 0xae, 0xef, 0x26, 0xb1, 0x33, 0x85, 0x64, 0x63, 0x68, 0x12, 0x4d, 0x5b,
+	0xd4, 0x17, 0xd6, 0x52, 0x00, 0x21, 0x15, 0x8a, 0x68, 0x12, 0x40, 0x41, 0x3c, 0x44, 0xdb, 0x90,
+	0xa2, 0x82, 0x64, 0xad, 0x77, 0xc7, 0xf6, 0xa2, 0xf5, 0xce, 0xb0, 0x33, 0x7e, 0xe8, 0x1b, 0x7f,
+	0x0e, 0x89, 0x47, 0x7e, 0x01, 0x42, 0x16, 0x3f, 0x04, 0xcd, 0xc7, 0xae, 0x67, 0x77, 0xbd, 0x01,
+	0xd4, 0x3e, 0x58, 0x5a, 0x9d, 0x7b, 0xcf, 0x99, 0xb9, 0xf7, 0xdc, 0xb9, 0x32, 0x3c, 0x0a, 0x93,
+	0x98, 0xa4, 0x62, 0x28, 0xb2, 0x20, 0xe5, 0x41, 0x28, 0x62, 0x9a, 0xda, 0xdf, 0x1e, 0xcb, 0xa8,
+	0xa0, 0xc8, 0xb1, 0xa0, 0xc1, 0x7b, 0x53, 0x4a, 0xa7, 0x09, 0x19, 0xaa, 0xd0, 0x78, 0x31, 0x19,
+	0x92, 0x39, 0x13, 0xaf, 0x75, 0xe6, 0xe0, 0xb8, 0x1a, 0x14, 0xf1, 0x9c, 0x70, 0x11, 0xcc, 0x99,
+	0x49, 0x78, 0x67, 0x4a, 0xa7, 0x54, 0x7d, 0x0e, 0xe5, 0x57, 0x8e, 0x9a, 0x6b, 0xb0, 0x09, 0x97,
+	0xbf, 0x2a, 0xca, 0xb8, 0xfc, 0x69, 0x14, 0x23, 0xe8, 0x5f, 0x90, 0x84, 0x08, 0xf2, 0x3c, 0x49,
+	0x7c, 0xf2, 0xcb, 0x82, 0x70, 0x81, 0xa7, 0xf0, 0xee, 0xd5, 0x42, 0x7c, 0x1d, 0x27, 0xc4, 0x27,
+	0x21, 0xcd, 0x22, 0x6e, 0x02, 0xe8, 0x18, 0xee, 0x4e, 0xe2, 0x84, 0x70, 0xb7, 0x75, 0xb2, 0xf9,
+	0xc4, 0x39, 0xed, 0x7a, 0x52, 0x5d, 0xe5, 0x69, 0x1c, 0x7d, 0x08, 0x9d, 0x4c, 0x53, 0xdc, 0xb6,
+	0x4a, 0xb9, 0xaf, 0x52, 0x2a, 0x6a, 0x79, 0x0e, 0xfe, 0xad, 0x03, 0xe8, 0x7a, 0xd5, 0x8c, 0xfc,
+	0x98, 0x4f, 0xc1, 0x09, 0x33, 0x12, 0x08, 0x32, 0xca, 0x08, 0xa3, 0x6e, 0xeb, 0xa4, 0xf5, 0xc4,
+	0x39, 0xdd, 0x57, 0x4a, 0xe7, 0x0a, 0xf7, 0x09, 0xa3, 0x26, 0xd9, 0x87, 0xb0, 0x80, 0x24, 0x31,
+	0x52, 0xc5, 0x68, 0x62, 0xdb, 0x22, 0xea, 0x22, 0x4b, 0xc4, 0xa8, 0x80, 0xd0, 0x53, 0xd8, 0xe6,
+	0x22, 0xc8, 0xc4, 0x28, 0xa4, 0xf3, 0x79, 0x2c, 0xdc, 0x4d, 0xc5, 0x3c, 0x50, 0xcc, 0x17, 0x32,
+	0x70, 0xae, 0xf0, 0x9c, 0xea, 0xf0, 0x15, 0x86, 0x9e, 0xc1, 0xce, 0x24, 0x4e, 0x63, 0x3e, 0xcb,
+	0xc9, 0x77, 0x14, 0xd9, 0x35, 0xcd, 0x91, 0x91, 0x32, 0x7b, 0x7b, 0x62, 0x81, 0x92, 0x6e, 0xee,
+	0x6c, 0xe8, 0x77, 0x2d, 0xba, 0xbe, 0x75, 0x85, 0x1e, 0x59, 0xa0, 0xa4, 0x9b, 0x5e, 0x8d, 0xb3,
+	0x20, 0x0d, 0x67, 0xee, 0x96, 0x45, 0xd7, 0xdd, 0x3a, 0x53, 0x81, 0x82, 0x1e, 0x5a, 0xa0, 0x75,
+	0xba, 0xa1, 0x77, 0x6a, 0xa7, 0x57, 0xe8, 0x91, 0x05, 0x5a, 0x0d, 0x97, 0xfe, 0xbb, 0xdb, 0xb5,
+	0x86, 0x6b, 0xdb, 0x4b, 0x0d, 0x97, 0x10, 0x7a, 0x06, 0xf7, 0xd8, 0x42, 0x68, 0xd6, 0x8e, 0x62,
+	0x61, 0xcf, 0x7e, 0x29, 0x6b, 0xe7, 0xcf, 0xef, 0x30, 0x0d, 0xa3, 0x0b, 0xe8, 0x2f, 0x58, 0x24,
+	0xab, 0xfe, 0x99, 0x8e, 0x47, 0x5c, 0x04, 0x82, 0xb8, 0x8e, 0x92, 0x19, 0x78, 0x72, 0xb6, 0xbf,
+	0x57, 0xc1, 0x6f, 0xe9, 0xf8, 0x85, 0x50, 0xb3, 0xa1, 0xe9, 0xbb, 0x8b, 0x12, 0x8c, 0xce, 0xa1,
+	0x67, 0x7a, 0xc7, 0x62, 0x46, 0x92, 0x38, 0x25, 0xee, 0xae, 0x25, 0xa2, 0xbb, 0x77, 0x65, 0x42,
+	0x85, 0x48, 0x58, 0x82, 0xa5, 0x88, 0x69, 0x41, 0x21, 0xd2, 0xb3, 0x44, 0x74, 0x1b, 0x6a, 0x22,
+	0x51, 0x09, 0x46, 0x5f, 0xc2, 0xae, 0x9e, 0xbf, 0x42, 0xa3, 0xaf, 0x34, 0x0e, 0x95, 0x86, 0x9a,
+	0xc0, 0xaa, 0xc4, 0x0e, 0xb7, 0x51, 0x69, 0x24, 0x17, 0x94, 0xad, 0x04, 0xf6, 0x72, 0x23, 0x95,
+	0x00, 0x65, 0x55, 0xfe, 0x36, 0xb7, 0x40, 0xf4, 0x39, 0x18, 0x77, 0x46, 0x41, 0x92, 0xb8, 0xa0,
+	0xb8, 0x0f, 0x4a, 0x8e, 0x54, 0xb7, 0x84, 0xdf, 0x8d, 0x72, 0x04, 0x3f, 0x85, 0xfb, 0xa5, 0x67,
+	0xcc, 0x19, 0x4d, 0x39, 0x41, 0x0f, 0x61, 0xcb, 0xcc, 0xb4, 0x7e, 0x89, 0x8e, 0x1e, 0x4a, 0x05,
+	0xf9, 0x26, 0x84, 0x1f, 0x83, 0x63, 0x71, 0xd1, 0x3e, 0xb4, 0xe3, 0x48, 0x3d, 0xf9, 0xee, 0xd9,
+	0xd6, 0xf2, 0xcf, 0xe3, 0xf6, 0xe5, 0x85, 0xdf, 0x8e, 0x23, 0xfc, 0x6b, 0x1b, 0x7a, 0x56, 0xde,
+	0x65, 0x3a, 0x91, 0xaf, 0xd6, 0x5e, 0xa5, 0x66, 0x4f, 0xb8, 0xa5, 0x5b, 0xdb, 0xd7, 0xb2, 0x93,
+	0xd1, 0x67, 0x70, 0x2f, 0xd3, 0x85, 0xe4, 0xab, 0xea, 0xb8, 0x91, 0x68, 0x0a, 0x2e, 0x08, 0xe8,
+	0x0b, 0xe8, 0x66, 0xa6, 0x48, 0xee, 0x6e, 0x2a, 0xf6, 0x49, 0x33, 0x5b, 0x27, 0xfa, 0x2b, 0x0a,
+	0xfa, 0x18, 0x3a, 0xca, 0x3d, 0x12, 0x99, 0x65, 0x31, 0xf0, 0xf4, 0xa6, 0xf7, 0xf2, 0x4d, 0xef,
+	0x5d, 0xe7, 0x9b, 0xde, 0xcf, 0x53, 0xf1, 0x8f, 0xd0, 0xaf, 0x74, 0x80, 0xa3, 0x6f, 0xa0, 0x6f,
+	0x9d, 0x3b, 0x8a, 0xd3, 0x09, 0x35, 0xcb, 0xf9, 0xfd, 0xa6, 0x0b, 0x49, 0xa2, 0xdf, 0x13, 0x65,
+	0x00, 0xdf, 0xc0, 0xc1, 0x59, 0x20, 0xc2, 0xd9, 0x9a, 0x75, 0x6c, 0xb7, 0xaa, 0xf5, 0x3f, 0x5b,
+	0x85, 0x0f, 0xe1, 0x40, 0x8d, 0x6f, 0x3d, 0x09, 0xbf, 0x84, 0xc3, 0xcb, 0x94, 0x33, 0x12, 0xae,
+	0x09, 0xbe, 0x89, 0xb7, 0xf8, 0x06, 0x5c, 0x3d, 0xad, 0x6f, 0x59, 0xd7, 0x85, 0xfd, 0xef, 0x62,
+	0xbe, 0xae, 0x94, 0x1b, 0x70, 0xf5, 0xa6, 0x7f, 0xbb, 0x27, 0x9e, 0xfe, 0x7d, 0x07, 0x36, 0x9f,
+	0x5f, 0x5d, 0xa2, 0x1f, 0xa0, 0x5f, 0x75, 0x07, 0x3d, 0x2a, 0x49, 0x34, 0x98, 0x37, 0xb8, 0x75,
+	0x0c, 0xf0, 0x06, 0xba, 0x86, 0x7e, 0xd5, 0x9f, 0x8a, 0x72, 0x83, 0x7d, 0x83, 0xc6, 0x12, 0xf0,
+	0x06, 0xfa, 0x09, 0x50, 0xdd, 0x5a, 0xf4, 0x41, 0x89, 0xd1, 0xe8, 0xfd, 0x7f, 0xb8, 0xf3, 0x5e,
+	0xcd, 0x5f, 0xf4, 0x78, 0xcd, 0xb6, 0x5a, 0xa3, 0xbd, 0x5f, 0x7b, 0x69, 0x5f, 0xc9, 0x3f, 0x5c,
+	0x78, 0x03, 0xbd, 0x84, 0x5e, 0xc5, 0x5d, 0xf4, 0xb0, 0xa4, 0xb9, 0xde, 0xfb, 0xc1, 0x83, 0xdb,
+	0x6e, 0xcb, 0xf1, 0x06, 0x7a, 0x05, 0x7b, 0xb5, 0xe1, 0xa8, 0x5c, 0xb7, 0x69, 0x78, 0xfe, 0xb5,
+	0x15, 0x17, 0xd0, 0x2d, 0x16, 0x33, 0xba, 0x7d, 0x61, 0x37, 0x97, 0x7e, 0x76, 0xfe, 0xfb, 0xf2,
+	0xa8, 0xf5, 0xc7, 0xf2, 0xa8, 0xf5, 0xd7, 0xf2, 0xa8, 0xf5, 0xea, 0x93, 0x69, 0x2c, 0x66, 0x8b,
+	0xb1, 0x17, 0xd2, 0xf9, 0x90, 0x05, 0xe1, 0xec, 0x75, 0x44, 0x32, 0xfb, 0x8b, 0x67, 0xe1, 0xb0,
+	0xfe, 0x47, 0x77, 0xbc, 0xa5, 0x64, 0x3f, 0xfa, 0x67, 0x00, 0x96, 0xbe, 0x59, 0x40, 0x05, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1056,6 +1174,61 @@ func (m *DeleteAllRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PutFileRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PutFileRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PutFileRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Files) > 0 {
+		for iNdEx := len(m.Files) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Files[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransaction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TransactionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1080,6 +1253,82 @@ func (m *TransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.StopPipeline != nil {
+		{
+			size, err := m.StopPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.StartPipeline != nil {
+		{
+			size, err := m.StartPipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DeletePipeline != nil {
+		{
+			size, err := m.DeletePipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.CreatePipeline != nil {
+		{
+			size, err := m.CreatePipeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.PutFile != nil {
+		{
+			size, err := m.PutFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DeleteFile != nil {
+		{
+			size, err := m.DeleteFile.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransaction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.UpdateJobState != nil {
 		{
 			size, err := m.UpdateJobState.MarshalToSizedBuffer(dAtA[:i])
@@ -1619,6 +1868,30 @@ func (m *DeleteAllRequest) Size() (n int) {
 	return n
 }
 
+func (m *PutFileRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Files) > 0 {
+		for _, e := range m.Files {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTransaction(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *TransactionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1661,6 +1934,30 @@ func (m *TransactionRequest) Size() (n int) {
 		l = m.UpdateJobState.Size()
 		n += 1 + l + sovTransaction(uint64(l))
 	}
+	if m.DeleteFile != nil {
+		l = m.DeleteFile.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.PutFile != nil {
+		l = m.PutFile.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.CreatePipeline != nil {
+		l = m.CreatePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.DeletePipeline != nil {
+		l = m.DeletePipeline.Size()
+		n += 1 + l + sovTransaction(uint64(l))
+	}
+	if m.StartPipeline != nil {
+		l = m.StartPipeline.Size()
+		n += 2 + l + sovTransaction(uint64(l))
+	}
+	if m.StopPipeline != nil {
+		l = m.StopPipeline.Size()
+		n += 2 + l + sovTransaction(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1899,7 +2196,7 @@ func (m *DeleteAllRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1922,15 +2219,15 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1957,16 +2254,14 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateRepo == nil {
-				m.CreateRepo = &pfs.CreateRepoRequest{}
-			}
-			if err := m.CreateRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Files = append(m.Files, &pfs.File{})
+			if err := m.Files[len(m.Files)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1993,33 +2288,157 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRepo == nil {
-				m.DeleteRepo = &pfs.DeleteRepoRequest{}
-			}
-			if err := m.DeleteRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &pfs.PutFileRecords{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartCommit", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTransaction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthTransaction
 			}
-			if msglen < 0 {
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreateRepo == nil {
+				m.CreateRepo = &pfs.CreateRepoRequest{}
+			}
+			if err := m.CreateRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRepo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRepo == nil {
+				m.DeleteRepo = &pfs.DeleteRepoRequest{}
+			}
+			if err := m.DeleteRepo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTransaction
 			}
 			postIndex := iNdEx + msglen
@@ -2252,6 +2671,222 @@ func (m *TransactionRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteFile == nil {
+				m.DeleteFile = &pfs.DeleteFileRequest{}
+			}
+			if err := m.DeleteFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutFile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutFile == nil {
+				m.PutFile = &PutFileRecordsRequest{}
+			}
+			if err := m.PutFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatePipeline == nil {
+				m.CreatePipeline = &pps.CreatePipelineRequest{}
+			}
+			if err := m.CreatePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletePipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeletePipeline == nil {
+				m.DeletePipeline = &pps.DeletePipelineRequest{}
+			}
+			if err := m.DeletePipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartPipeline == nil {
+				m.StartPipeline = &pps.StartPipelineRequest{}
+			}
+			if err := m.StartPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopPipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StopPipeline == nil {
+				m.StopPipeline = &pps.StopPipelineRequest{}
+			}
+			if err := m.StopPipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransaction(dAtA[iNdEx:])
@@ -12,7 +12,8 @@ type backend struct {
 }
 
 // Factory is the function that the Pachyderm Vault plugin exports to let Vault
-// create/refresh/revoke Pachyderm tokens
+// create/refresh/revoke Pachyderm tokens, both for users (at login/<user>) and
+// for roles (at creds/<role>)
 func Factory(ctx context.Context, c *logical.BackendConfig) (logical.Backend, error) {
 	result := &backend{}
 	result.Backend = &framework.Backend{
@@ -24,6 +25,9 @@ func Factory(ctx context.Context, c *logical.BackendConfig) (logical.Backend, er
 			result.configPath(),
 			result.loginPath(),
 			result.versionPath(),
+			result.rolesListPath(),
+			result.rolesPath(),
+			result.credsPath(),
 		},
 		Secrets: []*framework.Secret{{
 			Type: "pachyderm_tokens",
@@ -0,0 +1,102 @@
+package pachyderm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+func (b *backend) credsPath() *framework.Path {
+	return &framework.Path{
+		Pattern:      "creds/" + framework.GenericNameRegex("name"),
+		HelpSynopsis: "Issue a Pachyderm token for a role",
+		Fields: map[string]*framework.FieldSchema{
+			"name": &framework.FieldSchema{
+				Type:        framework.TypeString,
+				Description: "Name of the role",
+			},
+		},
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.ReadOperation: b.pathCredsRead,
+		},
+	}
+}
+
+func (b *backend) pathCredsRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (resp *logical.Response, retErr error) {
+	b.Logger().Debug(fmt.Sprintf("(%s) %s received at %s", req.ID, req.Operation, req.Path))
+	defer func() {
+		b.Logger().Debug(fmt.Sprintf("(%s) %s finished at %s (success=%t)", req.ID, req.Operation, req.Path, retErr == nil && !resp.IsError()))
+	}()
+
+	name := data.Get("name").(string)
+	r, err := getRole(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return logical.ErrorResponse(fmt.Sprintf("unknown role %q", name)), nil
+	}
+	config, err := getConfig(ctx, req.Storage)
+	if err != nil {
+		return nil, err
+	}
+	if len(config.AdminToken) == 0 {
+		return nil, errors.New("plugin is missing admin_token")
+	}
+	if len(config.PachdAddress) == 0 {
+		return nil, errors.New("plugin is missing pachd_address")
+	}
+
+	// Use the role's TTL, if set, and otherwise the plugin's
+	ttlStr := r.TTL
+	if ttlStr == "" {
+		ttlStr = config.TTL
+	}
+	ttl, maxTTL, err := roleTTLs(ttlStr, r, b.System().MaxLeaseTTL())
+	if err != nil {
+		return nil, err
+	}
+	if ttl > maxTTL {
+		ttl = maxTTL
+	}
+
+	userToken, err := generateUserCredentials(ctx, config.PachdAddress, config.AdminToken, r.Subject, ttl, r.restrictions())
+	if err != nil {
+		return nil, err
+	}
+	return &logical.Response{
+		Secret: &logical.Secret{
+			InternalData: map[string]interface{}{
+				"user_token":  userToken,
+				"secret_type": "pachyderm_tokens",
+				"role":        name,
+			},
+			LeaseOptions: logical.LeaseOptions{
+				TTL:       ttl,
+				MaxTTL:    maxTTL,
+				Renewable: true,
+			},
+		},
+		Data: map[string]interface{}{
+			"user_token":    userToken,
+			"pachd_address": config.PachdAddress,
+		},
+	}, nil
+}
+
+// roleTTLs parses 'ttlStr' and returns it along with the max TTL of tokens
+// issued for 'r' (which is never longer than 'systemMaxTTL')
+func roleTTLs(ttlStr string, r *role, systemMaxTTL time.Duration) (ttl, maxTTL time.Duration, err error) {
+	ttl, maxTTL, err = sanitizeTTLStr(ttlStr, r.MaxTTL)
+	if err != nil {
+		return 0, 0, err
+	}
+	if maxTTL == 0 || maxTTL > systemMaxTTL {
+		maxTTL = systemMaxTTL
+	}
+	return ttl, maxTTL, nil
+}
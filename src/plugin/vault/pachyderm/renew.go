@@ -54,6 +54,25 @@ func (b *backend) Renew(ctx context.Context, req *logical.Request, d *framework.
 		}
 	}
 
+	// Tokens issued for a role may not be renewed past the role's max TTL, or
+	// at all once the role is deleted
+	if roleIface, ok := req.Secret.InternalData["role"]; ok {
+		roleName, ok := roleIface.(string)
+		if !ok {
+			return nil, errors.Errorf("secret.role has wrong type (expected string but was %T)", roleIface)
+		}
+		r, err := getRole(ctx, req.Storage, roleName)
+		if err != nil {
+			return nil, err
+		}
+		if r == nil {
+			return nil, errors.Errorf("role %q no longer exists", roleName)
+		}
+		if _, maxTTL, err = roleTTLs(ttl.String(), r, maxTTL); err != nil {
+			return nil, errors.Wrapf(err, "could not sanitize role TTL")
+		}
+	}
+
 	// Renew creds in Pachyderm
 	err = renewUserCredentials(ctx, config.PachdAddress, config.AdminToken, userToken, ttl)
 	if err != nil {
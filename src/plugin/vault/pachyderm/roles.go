@@ -0,0 +1,255 @@
+package pachyderm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/vault/logical"
+	"github.com/hashicorp/vault/logical/framework"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+const rolesPrefix = "roles/"
+
+// role describes the Pachyderm tokens that vault issues at creds/<role>. Roles
+// let operators give each pipeline (or other machine client) short-lived
+// tokens that can only access the repos it needs.
+type role struct {
+	// Subject is the Pachyderm robot user that tokens issued for this role
+	// belong to, e.g. "robot:edges"
+	Subject string `json:"subject" structs:"-"`
+
+	// Repos, if set, are the only repos that tokens issued for this role may
+	// access
+	Repos []string `json:"repos" structs:"-"`
+
+	// ReadOnly, if true, limits tokens issued for this role to reading data
+	ReadOnly bool `json:"read_only" structs:"-"`
+
+	// TTL is the initial lifetime of tokens issued for this role, and MaxTTL is
+	// the longest that they may be renewed for. Both are duration strings, e.g.
+	// "5m", and if unset, the plugin's TTL and vault's max lease TTL are used
+	TTL    string `json:"ttl" structs:"-"`
+	MaxTTL string `json:"max_ttl" structs:"-"`
+}
+
+// restrictions returns the Pachyderm token restrictions for tokens issued for
+// 'r'
+func (r *role) restrictions() *auth.TokenRestrictions {
+	if len(r.Repos) == 0 && !r.ReadOnly {
+		return nil
+	}
+	return &auth.TokenRestrictions{
+		Repos:    r.Repos,
+		ReadOnly: r.ReadOnly,
+	}
+}
+
+func (b *backend) rolesListPath() *framework.Path {
+	return &framework.Path{
+		Pattern:      "roles/?$",
+		HelpSynopsis: "List the configured roles",
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.ListOperation: b.pathRolesList,
+		},
+	}
+}
+
+func (b *backend) rolesPath() *framework.Path {
+	return &framework.Path{
+		Pattern:      rolesPrefix + framework.GenericNameRegex("name"),
+		HelpSynopsis: "Manage the roles that Pachyderm tokens can be issued for",
+		HelpDescription: `
+Read, write or delete a role. Reading creds/<role> issues a short-lived
+Pachyderm token for the role's robot user, which can only access the role's
+repos. For example:
+
+    $ vault write pachyderm/roles/edges \
+        subject="robot:edges" \
+        repos="images,edges" \
+        read_only=false \
+        ttl="10m" \
+        max_ttl="1h"
+    $ vault read pachyderm/creds/edges
+
+For more information and examples, please see the online documentation.
+`,
+		Fields: map[string]*framework.FieldSchema{
+			"name": &framework.FieldSchema{
+				Type:        framework.TypeString,
+				Description: "Name of the role",
+			},
+			"subject": &framework.FieldSchema{
+				Type:        framework.TypeString,
+				Description: "Pachyderm robot user that tokens are issued for, e.g. robot:edges",
+			},
+			"repos": &framework.FieldSchema{
+				Type:        framework.TypeCommaStringSlice,
+				Description: "If set, the only repos that tokens may access",
+			},
+			"read_only": &framework.FieldSchema{
+				Type:        framework.TypeBool,
+				Description: "If true, tokens may only be used to read data",
+			},
+			"ttl": &framework.FieldSchema{
+				Type:        framework.TypeDurationSecond,
+				Description: "Initial TTL of issued tokens",
+			},
+			"max_ttl": &framework.FieldSchema{
+				Type:        framework.TypeDurationSecond,
+				Description: "Max TTL of issued tokens (including renewals)",
+			},
+		},
+		ExistenceCheck: b.roleExists,
+		Callbacks: map[logical.Operation]framework.OperationFunc{
+			logical.CreateOperation: b.pathRoleWrite,
+			logical.UpdateOperation: b.pathRoleWrite,
+			logical.ReadOperation:   b.pathRoleRead,
+			logical.DeleteOperation: b.pathRoleDelete,
+		},
+	}
+}
+
+func (b *backend) roleExists(ctx context.Context, req *logical.Request, data *framework.FieldData) (bool, error) {
+	r, err := getRole(ctx, req.Storage, data.Get("name").(string))
+	if err != nil {
+		return false, err
+	}
+	return r != nil, nil
+}
+
+func (b *backend) pathRolesList(ctx context.Context, req *logical.Request, data *framework.FieldData) (resp *logical.Response, retErr error) {
+	b.Logger().Debug(fmt.Sprintf("(%s) %s received at %s", req.ID, req.Operation, req.Path))
+	defer func() {
+		b.Logger().Debug(fmt.Sprintf("(%s) %s finished at %s (success=%t)", req.ID, req.Operation, req.Path, retErr == nil && !resp.IsError()))
+	}()
+
+	roles, err := req.Storage.List(ctx, rolesPrefix)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list roles")
+	}
+	return logical.ListResponse(roles), nil
+}
+
+func (b *backend) pathRoleRead(ctx context.Context, req *logical.Request, data *framework.FieldData) (resp *logical.Response, retErr error) {
+	b.Logger().Debug(fmt.Sprintf("(%s) %s received at %s", req.ID, req.Operation, req.Path))
+	defer func() {
+		b.Logger().Debug(fmt.Sprintf("(%s) %s finished at %s (success=%t)", req.ID, req.Operation, req.Path, retErr == nil && !resp.IsError()))
+	}()
+
+	r, err := getRole(ctx, req.Storage, data.Get("name").(string))
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, nil // vault returns a 404
+	}
+	return &logical.Response{
+		Data: map[string]interface{}{
+			"subject":   r.Subject,
+			"repos":     r.Repos,
+			"read_only": r.ReadOnly,
+			"ttl":       r.TTL,
+			"max_ttl":   r.MaxTTL,
+		},
+	}, nil
+}
+
+func (b *backend) pathRoleWrite(ctx context.Context, req *logical.Request, data *framework.FieldData) (resp *logical.Response, retErr error) {
+	b.Logger().Debug(fmt.Sprintf("(%s) %s received at %s", req.ID, req.Operation, req.Path))
+	defer func() {
+		b.Logger().Debug(fmt.Sprintf("(%s) %s finished at %s (success=%t)", req.ID, req.Operation, req.Path, retErr == nil && !resp.IsError()))
+	}()
+
+	// Validate we didn't get extraneous fields
+	if err := validateFields(req, data); err != nil {
+		return nil, logical.CodedError(422, err.Error())
+	}
+
+	name := data.Get("name").(string)
+	r, err := getRole(ctx, req.Storage, name)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		r = &role{}
+	}
+
+	// Only the fields in the request are updated
+	if subject, ok := data.GetOk("subject"); ok {
+		r.Subject = subject.(string)
+		if !strings.HasPrefix(r.Subject, auth.RobotPrefix) {
+			r.Subject = auth.RobotPrefix + r.Subject
+		}
+	}
+	if r.Subject == auth.RobotPrefix || r.Subject == "" {
+		return errMissingField("subject"), nil
+	}
+	if repos, ok := data.GetOk("repos"); ok {
+		r.Repos = repos.([]string)
+	}
+	if readOnly, ok := data.GetOk("read_only"); ok {
+		r.ReadOnly = readOnly.(bool)
+	}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{{"ttl", &r.TTL}, {"max_ttl", &r.MaxTTL}} {
+		seconds, ok := data.GetOk(field.name)
+		if !ok {
+			continue
+		}
+		if seconds.(int) < 0 {
+			return logical.ErrorResponse(fmt.Sprintf("invalid %s (must be >= 0s)", field.name)), nil
+		}
+		*field.value = (time.Duration(seconds.(int)) * time.Second).String()
+	}
+	ttl, maxTTL, err := sanitizeTTLStr(r.TTL, r.MaxTTL)
+	if err != nil {
+		return logical.ErrorResponse(err.Error()), nil
+	}
+	if ttl > 0 && maxTTL > 0 && ttl > maxTTL {
+		return logical.ErrorResponse("ttl must not be greater than max_ttl"), nil
+	}
+
+	entry, err := logical.StorageEntryJSON(rolesPrefix+name, r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to generate storage entry")
+	}
+	if err := req.Storage.Put(ctx, entry); err != nil {
+		return nil, errors.Wrapf(err, "failed to write role to storage")
+	}
+	return &logical.Response{}, nil
+}
+
+func (b *backend) pathRoleDelete(ctx context.Context, req *logical.Request, data *framework.FieldData) (resp *logical.Response, retErr error) {
+	b.Logger().Debug(fmt.Sprintf("(%s) %s received at %s", req.ID, req.Operation, req.Path))
+	defer func() {
+		b.Logger().Debug(fmt.Sprintf("(%s) %s finished at %s (success=%t)", req.ID, req.Operation, req.Path, retErr == nil && !resp.IsError()))
+	}()
+
+	if err := req.Storage.Delete(ctx, rolesPrefix+data.Get("name").(string)); err != nil {
+		return nil, errors.Wrapf(err, "failed to delete role")
+	}
+	return &logical.Response{}, nil
+}
+
+// getRole reads the role 'name' from the storage backend. It returns nil if
+// the role doesn't exist.
+func getRole(ctx context.Context, s logical.Storage, name string) (*role, error) {
+	entry, err := s.Get(ctx, rolesPrefix+name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get role from storage")
+	}
+	if entry == nil || len(entry.Value) == 0 {
+		return nil, nil
+	}
+	var result role
+	if err := entry.DecodeJSON(&result); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode role")
+	}
+	return &result, nil
+}
@@ -513,3 +513,91 @@ func TestVersion(t *testing.T) {
 		t.Fatalf("got unexpected server version from Pachyderm plugin (client-only)")
 	}
 }
+
+// TestRoleCreds tests that the plugin issues restricted robot tokens for
+// roles at creds/<role>, and revokes them with their lease
+func TestRoleCreds(t *testing.T) {
+	vaultClientConfig := vault.DefaultConfig()
+	vaultClientConfig.Address = vaultAddress
+	v, err := vault.NewClient(vaultClientConfig)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	v.SetToken("root")
+	if err := configurePlugin(t, v, ""); err != nil {
+		t.Fatalf(err.Error())
+	}
+	vl := v.Logical()
+
+	// Reading creds for a role that doesn't exist fails
+	if _, err := vl.Read(fmt.Sprintf("/%v/creds/nonexistent", pluginName)); err == nil {
+		t.Fatalf("expected error reading creds for nonexistent role, got none")
+	}
+
+	// A role's ttl must not exceed its max_ttl
+	if _, err := vl.Write(fmt.Sprintf("/%v/roles/bad", pluginName), map[string]interface{}{
+		"subject": "bad",
+		"ttl":     "2h",
+		"max_ttl": "1h",
+	}); err == nil {
+		t.Fatalf("expected error writing role with ttl > max_ttl, got none")
+	}
+
+	if _, err := vl.Write(fmt.Sprintf("/%v/roles/edges", pluginName), map[string]interface{}{
+		"subject":   "edges",
+		"repos":     "images,edges",
+		"read_only": true,
+		"ttl":       "1m",
+		"max_ttl":   "2m",
+	}); err != nil {
+		t.Fatalf(err.Error())
+	}
+	role, err := vl.Read(fmt.Sprintf("/%v/roles/edges", pluginName))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if role.Data["subject"] != "robot:edges" {
+		t.Fatalf("expected role subject to be canonicalized to robot:edges, but was %v", role.Data["subject"])
+	}
+
+	secret, err := vl.Read(fmt.Sprintf("/%v/creds/edges", pluginName))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if secret.LeaseDuration <= 0 || secret.LeaseDuration > 60 {
+		t.Fatalf("expected lease duration in (0, 60], but was %d", secret.LeaseDuration)
+	}
+	pachToken, ok := secret.Data["user_token"].(string)
+	if !ok {
+		t.Fatalf("vault creds response did not contain user token")
+	}
+	c := testutil.GetPachClient(t).WithCtx(context.Background())
+	c.SetAuthToken(pachToken)
+	who, err := c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if who.Username != "robot:edges" {
+		t.Fatalf("expected token for robot:edges, but was for %s", who.Username)
+	}
+
+	// The token is restricted to the role's repos, and to reading
+	resp, err := c.Authorize(c.Ctx(), &auth.AuthorizeRequest{Repo: "other", Scope: auth.Scope_READER})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if resp.Authorized {
+		t.Fatalf("expected token to be restricted to the role's repos")
+	}
+
+	// Revoking the lease revokes the token
+	if _, err := vl.Write("/sys/leases/revoke", map[string]interface{}{"lease_id": secret.LeaseID}); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{}); err == nil {
+		t.Fatalf("expected error with revoked pach token, got none")
+	}
+	if _, err := vl.Delete(fmt.Sprintf("/%v/roles/edges", pluginName)); err != nil {
+		t.Fatalf(err.Error())
+	}
+}
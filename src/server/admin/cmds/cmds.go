@@ -3,11 +3,16 @@ package cmds
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/admin/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/golang/snappy"
 	"github.com/spf13/cobra"
 )
@@ -18,6 +23,14 @@ func Cmds() []*cobra.Command {
 
 	var noObjects bool
 	var url string
+	var manifest bool
+	var baseURL string
+	var resume bool
+	var repos []string
+	var pipelines []string
+	var branches []string
+	var commitRanges []string
+	var includeProvenance bool
 	extract := &cobra.Command{
 		Short: "Extract Pachyderm state to stdout or an object store bucket.",
 		Long:  "Extract Pachyderm state to stdout or an object store bucket.",
@@ -26,15 +39,53 @@ func Cmds() []*cobra.Command {
 $ {{alias}} > backup
 
 # Extract to s3:
-$ {{alias}} -u s3://bucket/backup`,
+$ {{alias}} -u s3://bucket/backup
+
+# Extract to s3 with a manifest, then extract what's changed since:
+$ {{alias}} -u s3://bucket/backup-0 --manifest
+$ {{alias}} -u s3://bucket/backup-1 --base s3://bucket/backup-0
+
+# Resume an interrupted extract:
+$ {{alias}} -u s3://bucket/backup-1 --base s3://bucket/backup-0 --resume
+
+# Extract the pipeline "model" along with everything upstream of it:
+$ {{alias}} -u s3://bucket/model --pipeline model --include-provenance
+
+# Extract the master branch of repo "images", and the commits of repo "labels"
+# after commit XXX up to and including commit YYY:
+$ {{alias}} -u s3://bucket/subset --branch images@master --commits labels@XXX..YYY`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
+			req := &admin.ExtractRequest{
+				URL:               url,
+				NoObjects:         noObjects,
+				Manifest:          manifest,
+				BaseURL:           baseURL,
+				Resume:            resume,
+				Repos:             repos,
+				Pipelines:         pipelines,
+				IncludeProvenance: includeProvenance,
+			}
+			var err error
+			if req.Branches, err = cmdutil.ParseBranches(branches); err != nil {
+				return err
+			}
+			for _, arg := range commitRanges {
+				commitRange, err := parseCommitRange(arg)
+				if err != nil {
+					return err
+				}
+				req.CommitRanges = append(req.CommitRanges, commitRange)
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
 			if url != "" {
-				return c.ExtractURL(url)
+				return c.ExtractURLWithRequest(req)
+			}
+			if manifest || baseURL != "" || resume {
+				return errors.Errorf("--manifest, --base and --resume require --url")
 			}
 			w := snappy.NewBufferedWriter(os.Stdout)
 			defer func() {
@@ -42,13 +93,27 @@ $ {{alias}} -u s3://bucket/backup`,
 					retErr = err
 				}
 			}()
-			return c.ExtractWriter(!noObjects, w)
+			return c.ExtractWriterWithRequest(req, w)
 		}),
 	}
 	extract.Flags().BoolVar(&noObjects, "no-objects", false, "don't extract from object storage, only extract data from etcd")
 	extract.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to extract to.")
+	extract.Flags().BoolVar(&manifest, "manifest", false, "Write the extract as resumable segments along with a manifest, which later extracts can be incremental to.")
+	extract.Flags().StringVar(&baseURL, "base", "", "The url of a previous extract (written with a manifest); only what's changed since it will be extracted.")
+	extract.Flags().BoolVar(&resume, "resume", false, "Resume an interrupted extract (written with a manifest) to --url.")
+	extract.Flags().StringSliceVar(&repos, "repo", nil, "Only extract these repos (and the pipelines and repos selected by other flags).")
+	extract.Flags().StringSliceVar(&pipelines, "pipeline", nil, "Only extract these pipelines, along with their output repos (and the repos selected by other flags).")
+	extract.Flags().StringSliceVar(&branches, "branch", nil, "Only extract these branches (of the form repo@branch), and the commits on them, from their repos.")
+	extract.Flags().StringSliceVar(&commitRanges, "commits", nil, "Only extract these ranges of commits (of the form repo@from..to, or repo@to for all of to's ancestors) from their repos.")
+	extract.Flags().BoolVar(&includeProvenance, "include-provenance", false, "Also extract every repo and pipeline upstream of the selected repos and pipelines.")
 	commands = append(commands, cmdutil.CreateAlias(extract, "extract"))
 
+	var incrementalURLs []string
+	var renameRepos map[string]string
+	var renamePipelines map[string]string
+	var onConflict string
+	var dryRun bool
+	var raw bool
 	restore := &cobra.Command{
 		Short: "Restore Pachyderm state from stdin or an object store.",
 		Long:  "Restore Pachyderm state from stdin or an object store.",
@@ -57,17 +122,55 @@ $ {{alias}} -u s3://bucket/backup`,
 $ {{alias}} < backup
 
 # Restore from s3:
-$ {{alias}} -u s3://bucket/backup`,
+$ {{alias}} -u s3://bucket/backup
+
+# Restore a full extract followed by incremental extracts:
+$ {{alias}} -u s3://bucket/backup-0 --incremental s3://bucket/backup-1,s3://bucket/backup-2
+
+# Restore, renaming the repo "images" to "images-v2" and the pipeline "edges"
+# to "edges-v2", and failing before restoring anything if a repo already exists:
+$ {{alias}} -u s3://bucket/backup --rename-repo images=images-v2 --rename-pipeline edges=edges-v2 --on-conflict fail
+
+# Check that a backup can be restored, without restoring anything:
+$ {{alias}} -u s3://bucket/backup --dry-run`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			policy, ok := admin.ConflictPolicy_value[strings.ToUpper(onConflict)]
+			if !ok {
+				return errors.Errorf("unrecognized conflict policy %q (must be merge, fail or skip)", onConflict)
+			}
+			req := &admin.RestoreRequest{
+				URL:             url,
+				IncrementalURLs: incrementalURLs,
+				Resume:          resume,
+				RenameRepos:     renameRepos,
+				RenamePipelines: renamePipelines,
+				OnConflict:      admin.ConflictPolicy(policy),
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer c.Close()
+			if dryRun {
+				var report *admin.RestoreReport
+				if url != "" {
+					report, err = c.RestoreDryRunURL(req)
+				} else if len(incrementalURLs) > 0 || resume {
+					return errors.Errorf("--incremental and --resume require --url")
+				} else {
+					report, err = c.RestoreDryRunReader(req, snappy.NewReader(os.Stdin))
+				}
+				if err != nil {
+					return err
+				}
+				return printRestoreReport(report, raw)
+			}
 			if url != "" {
-				err = c.RestoreURL(url)
+				err = c.RestoreURLWithRequest(req)
+			} else if len(incrementalURLs) > 0 || resume {
+				return errors.Errorf("--incremental and --resume require --url")
 			} else {
-				err = c.RestoreReader(snappy.NewReader(os.Stdin))
+				err = c.RestoreReaderWithRequest(req, snappy.NewReader(os.Stdin))
 			}
 			if err != nil {
 				return errors.Wrapf(err, "WARNING: Your cluster might be in an invalid "+
@@ -77,6 +180,13 @@ $ {{alias}} -u s3://bucket/backup`,
 		}),
 	}
 	restore.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to restore from.")
+	restore.Flags().StringSliceVar(&incrementalURLs, "incremental", nil, "The urls of incremental extracts to restore, in order, after --url.")
+	restore.Flags().BoolVar(&resume, "resume", false, "Skip segments that a previous, interrupted restore already restored.")
+	restore.Flags().StringToStringVar(&renameRepos, "rename-repo", nil, "Restore repos under new names (of the form old=new).")
+	restore.Flags().StringToStringVar(&renamePipelines, "rename-pipeline", nil, "Restore pipelines, and their output repos, under new names (of the form old=new).")
+	restore.Flags().BoolVar(&dryRun, "dry-run", false, "Don't restore anything; read, convert and validate every op, and report what restoring would do and any problems it would have.")
+	restore.Flags().BoolVar(&raw, "raw", false, "disable pretty printing of --dry-run reports, print raw json")
+	restore.Flags().StringVar(&onConflict, "on-conflict", "merge", "What to do with repos that already exist: 'merge' restores into them, 'fail' fails before restoring anything, and 'skip' restores everything else.")
 	commands = append(commands, cmdutil.CreateAlias(restore, "restore"))
 
 	inspectCluster := &cobra.Command{
@@ -100,3 +210,58 @@ $ {{alias}} -u s3://bucket/backup`,
 
 	return commands
 }
+
+// printRestoreReport prints the report of a restore dry run, and returns an
+// error if the report has any errors
+func printRestoreReport(report *admin.RestoreReport, raw bool) error {
+	if raw {
+		marshaller := &jsonpb.Marshaler{Indent: "  "}
+		if err := marshaller.Marshal(os.Stdout, report); err != nil {
+			return err
+		}
+		fmt.Println()
+	} else {
+		pretty.PrintRestoreReportSummary(os.Stdout, report)
+		if len(report.Issues) > 0 {
+			fmt.Println()
+			writer := tabwriter.NewWriter(os.Stdout, pretty.RestoreIssueHeader)
+			for _, issue := range report.Issues {
+				pretty.PrintRestoreIssue(writer, issue)
+			}
+			if err := writer.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+	var numErrors int
+	for _, issue := range report.Issues {
+		if issue.Severity == admin.RestoreIssue_ERROR {
+			numErrors++
+		}
+	}
+	if numErrors > 0 {
+		return errors.Errorf("restoring would fail with %d error(s)", numErrors)
+	}
+	return nil
+}
+
+// parseCommitRange takes an argument of the form "repo@from..to" or "repo@to"
+// and returns the corresponding *admin.CommitRange
+func parseCommitRange(arg string) (*admin.CommitRange, error) {
+	commit, err := cmdutil.ParseCommit(arg)
+	if err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(commit.ID, "..", 2)
+	if len(parts) == 1 {
+		parts = []string{"", parts[0]}
+	}
+	if parts[1] == "" {
+		return nil, errors.Errorf("invalid format \"%s\": the end of the range cannot be empty", arg)
+	}
+	result := &admin.CommitRange{To: client.NewCommit(commit.Repo.Name, parts[1])}
+	if parts[0] != "" {
+		result.From = client.NewCommit(commit.Repo.Name, parts[0])
+	}
+	return result, nil
+}
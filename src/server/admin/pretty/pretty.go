@@ -0,0 +1,43 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+)
+
+const (
+	// RestoreIssueHeader is the header for the issues in a restore report.
+	RestoreIssueHeader = "SEVERITY\tOP\tMESSAGE\t\n"
+)
+
+// PrintRestoreReportSummary pretty-prints the counts and sizes in a restore
+// report.
+func PrintRestoreReportSummary(w io.Writer, report *admin.RestoreReport) {
+	fmt.Fprintf(w, "Version: %s\n", report.Version)
+	fmt.Fprintf(w, "Ops: %d\n", report.Ops)
+	fmt.Fprintf(w, "Repos: %d\n", report.Repos)
+	fmt.Fprintf(w, "Commits: %d (%s)\n", report.Commits, pretty.Size(report.CommitBytes))
+	fmt.Fprintf(w, "Branches: %d\n", report.Branches)
+	fmt.Fprintf(w, "Pipelines: %d\n", report.Pipelines)
+	fmt.Fprintf(w, "Jobs: %d\n", report.Jobs)
+	fmt.Fprintf(w, "ACLs: %d\n", report.Acls)
+	fmt.Fprintf(w, "Tombstones: %d\n", report.Tombstones)
+	fmt.Fprintf(w, "Objects: %d\n", report.Objects)
+	fmt.Fprintf(w, "Blocks: %d\n", report.Blocks)
+	fmt.Fprintf(w, "Data: %s\n", pretty.Size(report.DataBytes))
+}
+
+// PrintRestoreIssue pretty-prints an issue in a restore report.
+func PrintRestoreIssue(w io.Writer, issue *admin.RestoreIssue) {
+	fmt.Fprintf(w, "%s\t", issue.Severity)
+	op := issue.Op
+	if op == "" {
+		op = "-"
+	}
+	fmt.Fprintf(w, "%s\t", op)
+	fmt.Fprintf(w, "%s\t", issue.Message)
+	fmt.Fprintln(w)
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
@@ -586,6 +587,52 @@ func TestExtractRestoreHeadlessBranches(t *testing.T) {
 	require.Equal(t, "headless", bis[0].Branch.Name)
 }
 
+// TestExtractFiltered tests that a filtered extract only includes the objects
+// of the selected repos, and rejects filters on repos that aren't selected
+func TestExtractFiltered(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := tu.GetPachClient(t)
+	require.NoError(t, c.DeleteAll())
+
+	repo1, repo2 := tu.UniqueString("TestExtractFiltered"), tu.UniqueString("TestExtractFiltered")
+	for _, repo := range []string{repo1, repo2} {
+		require.NoError(t, c.CreateRepo(repo))
+		_, err := c.PutFile(repo, "master", "/file", strings.NewReader(repo))
+		require.NoError(t, err)
+	}
+
+	countObjects := func(req *admin.ExtractRequest) (objects int, data string) {
+		var buf bytes.Buffer
+		require.NoError(t, c.ExtractWithRequest(req, func(op *admin.Op) error {
+			if op.Op1_12.CreateObject != nil {
+				objects++
+			}
+			if op.Op1_12.Block != nil {
+				buf.Write(op.Op1_12.Block.Value)
+			}
+			return nil
+		}))
+		return objects, buf.String()
+	}
+	allObjects, allData := countObjects(&admin.ExtractRequest{})
+	require.True(t, strings.Contains(allData, repo1))
+	require.True(t, strings.Contains(allData, repo2))
+	objects, data := countObjects(&admin.ExtractRequest{Repos: []string{repo1}})
+	require.True(t, objects < allObjects)
+	require.True(t, strings.Contains(data, repo1))
+	require.False(t, strings.Contains(data, repo2))
+
+	// A branch filter must be in a selected repo
+	err := c.ExtractWithRequest(&admin.ExtractRequest{
+		Repos:    []string{repo1},
+		Branches: []*pfs.Branch{client.NewBranch(repo2, "master")},
+	}, func(*admin.Op) error { return nil })
+	require.YesError(t, err)
+	require.Matches(t, "isn't extracted", err.Error())
+}
+
 func TestExtractVersion(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
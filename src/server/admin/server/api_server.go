@@ -11,18 +11,22 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 
+	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/golang/snappy"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
@@ -34,6 +38,9 @@ type apiServer struct {
 	log.Logger
 	address        string
 	storageRoot    string // for downloading/converting hashtrees
+	etcdClient     *etcd.Client
+	pfsEtcdPrefix  string
+	ppsEtcdPrefix  string
 	pachClient     *client.APIClient
 	pachClientOnce sync.Once
 	clusterInfo    *admin.ClusterInfo
@@ -98,64 +105,151 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 	ctx := extractServer.Context()
 	pachClient := a.getPachClient().WithCtx(ctx)
 	writeOp := extractServer.Send
+	// m tracks what's been extracted, if this extract is written with a
+	// manifest (otherwise it's nil, which records nothing)
+	var m *extractManifest
+	// d selects what's changed since the base extract, if this extract is
+	// incremental (otherwise it's nil, which selects everything)
+	var d *extractDelta
+	if (request.Manifest || request.BaseURL != "" || request.Resume) && request.URL == "" {
+		return errors.Errorf("extracts can only be written with a manifest to a URL")
+	}
 	if request.URL != "" {
-		url, err := obj.ParseURL(request.URL)
+		objClient, object, err := objClientFromURL(request.URL)
 		if err != nil {
-			return errors.Wrapf(err, "error parsing url %v", request.URL)
+			return err
 		}
-		if url.Object == "" {
-			return errors.Errorf("URL must be <svc>://<bucket>/<object> (no object in %s)", request.URL)
+		if request.Manifest || request.BaseURL != "" || request.Resume {
+			var base *admin.BackupManifest
+			if request.BaseURL != "" && !request.Resume {
+				baseObjClient, baseObject, err := objClientFromURL(request.BaseURL)
+				if err != nil {
+					return err
+				}
+				if base, err = readManifest(ctx, baseObjClient, baseObject); err != nil {
+					return err
+				}
+				if !base.Complete {
+					return errors.Errorf("base extract %s is incomplete (resume it first)", request.BaseURL)
+				}
+			}
+			revision, err := a.etcdRevision(ctx)
+			if err != nil {
+				return err
+			}
+			if m, err = newExtractManifest(ctx, objClient, object, request, base, revision); err != nil {
+				return err
+			}
+			if m.manifest.BaseID != "" {
+				if d, err = a.newExtractDelta(ctx, m.manifest.BaseEtcdRevision, revision); err != nil {
+					return err
+				}
+			}
+			writeOp = m.writeOp
+			defer func() {
+				if retErr == nil {
+					retErr = m.finish()
+				}
+			}()
+		} else {
+			objW, err := objClient.Writer(extractServer.Context(), object)
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := objW.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			snappyW := snappy.NewBufferedWriter(objW)
+			defer func() {
+				if err := snappyW.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			w := pbutil.NewWriter(snappyW)
+			writeOp = func(op *admin.Op) error {
+				_, err := w.Write(op)
+				return err
+			}
 		}
-		objClient, err := obj.NewClientFromURLAndSecret(url, false)
-		if err != nil {
-			return err
+	}
+	// ACLs are only extracted if auth is active
+	authActive := true
+	if _, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); auth.IsErrNotActivated(err) {
+		authActive = false
+	} else if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	// f selects what's extracted, if the request has filters (otherwise it's
+	// nil, which selects everything). Only the objects, blocks and tags that the
+	// selected commits refer to are extracted.
+	f, err := newExtractFilter(pachClient, request)
+	if err != nil {
+		return err
+	}
+	// Tombstones come first, so that they're restored before anything that
+	// replaces what they delete
+	for _, t := range d.tombstones(f, !request.NoRepos, !request.NoPipelines) {
+		if m.has(tombstoneEntry(t)) {
+			continue
 		}
-		objW, err := objClient.Writer(extractServer.Context(), url.Object)
-		if err != nil {
+		if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{Tombstone: t}}); err != nil {
 			return err
 		}
-		defer func() {
-			if err := objW.Close(); err != nil && retErr == nil {
-				retErr = err
-			}
-		}()
-		snappyW := snappy.NewBufferedWriter(objW)
-		defer func() {
-			if err := snappyW.Close(); err != nil && retErr == nil {
-				retErr = err
-			}
-		}()
-		w := pbutil.NewWriter(snappyW)
-		writeOp = func(op *admin.Op) error {
-			_, err := w.Write(op)
+		if err := m.done(tombstoneEntry(t)); err != nil {
 			return err
 		}
 	}
 	if !request.NoObjects {
+		if err := f.addReachable(pachClient, a.storageRoot); err != nil {
+			return err
+		}
+		if err := d.addReachable(pachClient, a.storageRoot); err != nil {
+			return err
+		}
 		if err := pachClient.ListBlock(func(block *pfs.Block) error {
+			if !f.block(block) || !d.block(block) || m.has(blockEntry(block)) {
+				return nil
+			}
 			w := &extractBlockWriter{f: writeOp, block: block}
 			if err := pachClient.GetBlock(block.Hash, w); err != nil {
 				return err
 			}
-			return w.Close()
+			if err := w.Close(); err != nil {
+				return err
+			}
+			return m.done(blockEntry(block))
 		}); err != nil {
 			return err
 		}
 		if err := pachClient.ListObject(func(oi *pfs.ObjectInfo) error {
-			return writeOp(&admin.Op{Op1_12: &admin.Op1_12{CreateObject: &pfs.CreateObjectRequest{
+			if !f.object(oi.Object) || !d.object(oi.Object) || m.has(objectEntry(oi.Object)) {
+				return nil
+			}
+			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{CreateObject: &pfs.CreateObjectRequest{
 				Object:   oi.Object,
 				BlockRef: oi.BlockRef,
-			}}})
+			}}}); err != nil {
+				return err
+			}
+			return m.done(objectEntry(oi.Object))
 		}); err != nil {
 			return err
 		}
 		if err := pachClient.ListTag(func(resp *pfs.ListTagsResponse) error {
-			return writeOp(&admin.Op{Op1_12: &admin.Op1_12{
+			if !f.object(resp.Object) || !d.object(resp.Object) || m.has(tagEntry(resp.Tag)) {
+				return nil
+			}
+			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{
 				Tag: &pfs.TagObjectRequest{
 					Object: resp.Object,
 					Tags:   []*pfs.Tag{resp.Tag},
 				},
-			}})
+			}}); err != nil {
+				return err
+			}
+			return m.done(tagEntry(resp.Tag))
 		}); err != nil {
 			return err
 		}
@@ -168,6 +262,9 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 		ris = append(ris, &pfs.RepoInfo{Repo: &pfs.Repo{Name: ppsconsts.SpecRepo}})
 		for i := range ris {
 			ri := ris[len(ris)-1-i]
+			if !f.repo(ri.Repo.Name) || !d.repo(ri.Repo.Name) || m.has(repoEntry(ri.Repo)) {
+				continue
+			}
 			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{
 				Repo: &pfs.CreateRepoRequest{
 					Repo:        ri.Repo,
@@ -176,18 +273,30 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 			}); err != nil {
 				return err
 			}
+			if err := m.done(repoEntry(ri.Repo)); err != nil {
+				return err
+			}
 		}
 		if err := pachClient.ListCommitF("", "", "", 0, true, func(ci *pfs.CommitInfo) error {
+			if !f.commit(ci.Commit) || !d.commit(ci.Commit) || m.has(commitEntry(ci.Commit)) {
+				return nil
+			}
 			if ci.ParentCommit == nil {
 				ci.ParentCommit = client.NewCommit(ci.Commit.Repo.Name, "")
 			}
 			// Restore must not create any open commits (which can interfere with
-			// restoring other commits), so started and finished are always set
-			if ci.Finished == nil {
+			// restoring other commits), so started and finished are always set.
+			// Unfinished commits aren't recorded in the manifest, so that a
+			// resumed extract includes them again.
+			finished := ci.Finished != nil
+			if !finished && d.placeholder(ci.Commit) {
+				return nil // still open, so the base extract's placeholder stands
+			}
+			if !finished {
 				logrus.Warnf("Commit %q is not finished, so its data cannot be extracted, and any data it contains will not be restored", ci.Commit.ID)
 				ci.Finished = types.TimestampNow()
 			}
-			return writeOp(&admin.Op{Op1_12: &admin.Op1_12{Commit: &pfs.BuildCommitRequest{
+			commit := &pfs.BuildCommitRequest{
 				Origin:     ci.Origin,
 				Parent:     ci.ParentCommit,
 				Tree:       ci.Tree,
@@ -198,7 +307,16 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 				Provenance: ci.Provenance,
 				Started:    ci.Started,
 				Finished:   ci.Finished,
-			}}})
+			}
+			f.pruneCommit(m, d, commit)
+			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{Commit: commit}}); err != nil {
+				return err
+			}
+			f.commitExtracted(ci.Commit)
+			if !finished {
+				return nil
+			}
+			return m.done(commitEntry(ci.Commit))
 		}); err != nil {
 			return err
 		}
@@ -212,16 +330,32 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 			return err
 		}
 		for _, bi := range bis.BranchInfo {
-			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{
-				Branch: &pfs.CreateBranchRequest{
-					Head:       bi.Head,
-					Branch:     bi.Branch,
-					Provenance: bi.DirectProvenance,
-				},
-			}}); err != nil {
+			if !f.branch(bi.Branch) || !d.branch(bi.Branch) || m.has(branchEntry(bi)) {
+				continue
+			}
+			branch := &pfs.CreateBranchRequest{
+				Head:       bi.Head,
+				Branch:     bi.Branch,
+				Provenance: bi.DirectProvenance,
+			}
+			f.pruneBranch(m, d, branch)
+			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{Branch: branch}}); err != nil {
+				return err
+			}
+			if err := m.done(branchEntry(bi)); err != nil {
 				return err
 			}
 		}
+		if authActive {
+			for _, ri := range ris {
+				if ri.Repo.Name == ppsconsts.SpecRepo || !f.repo(ri.Repo.Name) {
+					continue
+				}
+				if err := extractACL(pachClient, &auth.GetACLRequest{Repo: ri.Repo.Name}, writeOp); err != nil {
+					return err
+				}
+			}
+		}
 	}
 	if !request.NoPipelines {
 		pis, err := pachClient.ListPipeline()
@@ -230,28 +364,56 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 		}
 		pis = sortPipelineInfos(pis)
 		for _, pi := range pis {
-			cPR := ppsutil.PipelineReqFromInfo(pi)
-			cPR.SpecCommit = pi.SpecCommit
-			if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{Pipeline: cPR}}); err != nil {
-				return err
+			if !f.pipeline(pi.Pipeline.Name) {
+				continue
+			}
+			if d.pipeline(pi) && !m.has(pipelineVersionEntry(pi.Pipeline.Name, pi.SpecCommit)) {
+				cPR := ppsutil.PipelineReqFromInfo(pi)
+				cPR.SpecCommit = pi.SpecCommit
+				// A pipeline that was in the base extract, or that was written
+				// before this extract was resumed, is restored as an update
+				cPR.Update = m.has(pipelineEntry(pi.Pipeline.Name)) || d.pipelineInBase(pi.Pipeline.Name)
+				if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{Pipeline: cPR}}); err != nil {
+					return err
+				}
+				if err := m.done(pipelineEntry(pi.Pipeline.Name), pipelineVersionEntry(pi.Pipeline.Name, pi.SpecCommit)); err != nil {
+					return err
+				}
+			}
+			if authActive {
+				if err := extractACL(pachClient, &auth.GetACLRequest{Pipeline: pi.Pipeline.Name}, writeOp); err != nil {
+					return err
+				}
 			}
 			if err := pachClient.ListJobF(pi.Pipeline.Name, nil, nil, -1, false, func(ji *pps.JobInfo) error {
-				return writeOp(&admin.Op{Op1_12: &admin.Op1_12{Job: &pps.CreateJobRequest{
-					Pipeline:      pi.Pipeline,
-					OutputCommit:  ji.OutputCommit,
-					Restart:       ji.Restart,
-					DataProcessed: ji.DataProcessed,
-					DataSkipped:   ji.DataSkipped,
-					DataTotal:     ji.DataTotal,
-					DataFailed:    ji.DataFailed,
-					DataRecovered: ji.DataRecovered,
-					Stats:         ji.Stats,
-					StatsCommit:   ji.StatsCommit,
-					State:         ji.State,
-					Reason:        ji.Reason,
-					Started:       ji.Started,
-					Finished:      ji.Finished,
-				}}})
+				if !f.wasExtracted(m, d, ji.OutputCommit) || !d.job(ji.Job) || m.has(jobEntry(ji.OutputCommit)) {
+					return nil
+				}
+				if err := writeOp(&admin.Op{Op1_12: &admin.Op1_12{Job: &pps.CreateJobRequest{
+					Pipeline:        pi.Pipeline,
+					OutputCommit:    ji.OutputCommit,
+					Restart:         ji.Restart,
+					DataProcessed:   ji.DataProcessed,
+					DataSkipped:     ji.DataSkipped,
+					DataTotal:       ji.DataTotal,
+					DataFailed:      ji.DataFailed,
+					DataRecovered:   ji.DataRecovered,
+					DataQuarantined: ji.DataQuarantined,
+					Stats:           ji.Stats,
+					StatsCommit:     ji.StatsCommit,
+					State:           ji.State,
+					Reason:          ji.Reason,
+					Started:         ji.Started,
+					Finished:        ji.Finished,
+				}}}); err != nil {
+					return err
+				}
+				// Only finished jobs are recorded in the manifest, so that a
+				// resumed extract includes running jobs again
+				if !ppsutil.IsTerminal(ji.State) {
+					return nil
+				}
+				return m.done(jobEntry(ji.OutputCommit))
 			}); err != nil {
 				return err
 			}
@@ -260,6 +422,51 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 	return nil
 }
 
+// objClientFromURL returns an object client for the bucket in 'reqURL', and
+// the object in that bucket that 'reqURL' refers to
+func objClientFromURL(reqURL string) (obj.Client, string, error) {
+	url, err := obj.ParseURL(reqURL)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "error parsing url %v", reqURL)
+	}
+	if url.Object == "" {
+		return nil, "", errors.Errorf("URL must be <svc>://<bucket>/<object> (no object in %s)", reqURL)
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(url, false)
+	if err != nil {
+		return nil, "", err
+	}
+	return objClient, url.Object, nil
+}
+
+// etcdRevision returns the current revision of pachd's etcd cluster
+func (a *apiServer) etcdRevision(ctx context.Context) (int64, error) {
+	resp, err := a.etcdClient.Get(ctx, a.pfsEtcdPrefix, etcd.WithCountOnly())
+	if err != nil {
+		return 0, errors.EnsureStack(err)
+	}
+	return resp.Header.Revision, nil
+}
+
+// extractACL writes an op that restores the ACL requested by 'req', unless the
+// ACL is empty
+func extractACL(pachClient *client.APIClient, req *auth.GetACLRequest, writeOp func(*admin.Op) error) error {
+	resp, err := pachClient.GetACL(pachClient.Ctx(), req)
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	entries := append(resp.Entries, resp.RobotEntries...)
+	if len(entries) == 0 && len(resp.BranchEntries) == 0 {
+		return nil
+	}
+	return writeOp(&admin.Op{Op1_12: &admin.Op1_12{Acl: &auth.SetACLRequest{
+		Repo:          req.Repo,
+		Pipeline:      req.Pipeline,
+		Entries:       entries,
+		BranchEntries: resp.BranchEntries,
+	}}})
+}
+
 func (a *apiServer) ExtractPipeline(ctx context.Context, request *admin.ExtractPipelineRequest) (response *admin.Op, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -310,24 +517,70 @@ func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error
 		}
 	}()
 
-	// Determine if we're restoring from a URL or not
-	r := &restoreCtx{
+	return a.newRestoreCtx(restoreServer).run()
+}
+
+func (a *apiServer) RestoreDryRun(restoreServer admin.API_RestoreDryRunServer) (retErr error) {
+	func() { a.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
+	r := a.newRestoreCtx(restoreServer)
+	r.dryRun = newRestoreDryRun()
+	if err := r.run(); err != nil {
+		return err
+	}
+	for {
+		if _, err := restoreServer.Recv(); err != nil {
+			break
+		}
+	}
+	report, err := r.finishDryRun()
+	if err != nil {
+		return err
+	}
+	return restoreServer.SendAndClose(report)
+}
+
+func (a *apiServer) newRestoreCtx(restoreServer restoreStream) *restoreCtx {
+	return &restoreCtx{
 		a:             a,
 		restoreServer: restoreServer,
 		// TODO(msteffen): refactor admin apiServer to use serviceenv
 		pachClient: a.getPachClient().WithCtx(restoreServer.Context()),
+		skipRepos:     make(map[string]bool),
+		restoredRepos: make(map[string]bool),
 	}
-	req, err := restoreServer.Recv()
+}
+
+// restoreStream is the part of a Restore or RestoreDryRun stream that
+// restoreCtx reads from
+type restoreStream interface {
+	Recv() (*admin.RestoreRequest, error)
+	Context() context.Context
+}
+
+// run reads the first request of the stream, and restores from its URLs or
+// from the rest of the stream
+func (r *restoreCtx) run() error {
+	// Determine if we're restoring from a URL or not
+	req, err := r.restoreServer.Recv()
 	if err != nil {
 		if errors.Is(err, io.EOF) {
 			return nil
 		}
 		return err
 	}
+	r.renames = restoreRenames{repos: req.RenameRepos, pipelines: req.RenamePipelines}
+	r.onConflict = req.OnConflict
 	if req.URL != "" {
-		return r.startFromURL(req.URL)
+		err = r.startFromURLs(append([]string{req.URL}, req.IncrementalURLs...), req.Resume)
+	} else {
+		err = r.start(req.Op)
+	}
+	if err != nil {
+		return err
 	}
-	return r.start(req.Op)
+	// Create any repos at the end of the stream
+	return r.flushRepos()
 }
 
 // restoreCtx holds the partial results needed to restore a stream of ops to
@@ -339,7 +592,7 @@ func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error
 // |         │                   | restoreCtx |                                       |
 // |         │                   +------------+                                       |
 // |         ↓                                                                        |
-// | start/startFromURL // (reads ops from stream in a loop)                          |
+// | start/startFromURLs // (reads ops from stream in a loop)                         |
 // |         ↓                                                                        |
 // | validateAndApplyOp ──┬───────────-┬─────────────┬─────────────╮                  |
 // |         ↓            ↓            ↓             ↓             ↓                  |
@@ -348,7 +601,7 @@ type restoreCtx struct {
 	a *apiServer
 
 	// invariant: pachClient.Ctx() == restoreServer.Context()
-	restoreServer admin.API_RestoreServer
+	restoreServer restoreStream
 	pachClient    *client.APIClient
 
 	r pbutil.Reader // set iff restoring from URL
@@ -357,6 +610,21 @@ type restoreCtx struct {
 	// be the same). streamVersion is set in validateAndApplyOp from first op's
 	// version
 	streamVersion opVersion
+
+	// renames and onConflict are set from the first RestoreRequest
+	renames    restoreRenames
+	onConflict admin.ConflictPolicy
+	// pendingRepos holds repos that haven't been created yet (see addRepo)
+	pendingRepos []*pfs.CreateRepoRequest
+	// skipRepos holds repos that aren't restored because they already existed
+	skipRepos map[string]bool
+	// restoredRepos holds repos that this restore created, which don't
+	// conflict with the same repos in later incremental extracts
+	restoredRepos map[string]bool
+
+	// dryRun is set iff this is a dry run (see RestoreDryRun), in which case
+	// ops are checked and recorded in it rather than restored
+	dryRun *restoreDryRun
 }
 
 func (r *restoreCtx) start(initial *admin.Op) error {
@@ -380,23 +648,111 @@ func (r *restoreCtx) start(initial *admin.Op) error {
 	}
 }
 
-func (r *restoreCtx) startFromURL(reqURL string) error {
-	// Initialize object client from URL
-	url, err := obj.ParseURL(reqURL)
-	if err != nil {
-		return errors.Wrapf(err, "error parsing url %v", reqURL)
+// startFromURLs restores the extract at urls[0], followed by the incremental
+// extracts at urls[1:]. An extract written without a manifest is restored from
+// a single object, and can't be followed by incremental extracts.
+func (r *restoreCtx) startFromURLs(urls []string, resume bool) error {
+	ctx := r.pachClient.Ctx()
+	objClients := make([]obj.Client, len(urls))
+	objects := make([]string, len(urls))
+	manifests := make([]*admin.BackupManifest, len(urls))
+	for i, reqURL := range urls {
+		var err error
+		objClients[i], objects[i], err = objClientFromURL(reqURL)
+		if err != nil {
+			return err
+		}
+		if !objClients[i].Exists(ctx, manifestObject(objects[i])) {
+			if len(urls) > 1 {
+				return errors.Errorf("%s has no manifest, so it can't be restored along with incremental extracts", reqURL)
+			}
+			return r.restoreObject(objClients[i], objects[i])
+		}
+		// Validate the whole chain of extracts before restoring any of them
+		if manifests[i], err = readManifest(ctx, objClients[i], objects[i]); err != nil {
+			return err
+		}
+		if !manifests[i].Complete {
+			return errors.Errorf("extract to %s is incomplete (resume it before restoring)", reqURL)
+		}
+		if i > 0 && manifests[i].BaseID != manifests[i-1].ID {
+			return errors.Errorf("%s is not incremental to %s", reqURL, urls[i-1])
+		}
 	}
-	if url.Object == "" {
-		return errors.Errorf("URL must be <svc>://<bucket>/<object> (no object in %s)", reqURL)
+	for i := range urls {
+		if err := r.restoreSegments(objClients[i], objects[i], manifests[i], resume); err != nil {
+			return err
+		}
 	}
-	objClient, err := obj.NewClientFromURLAndSecret(url, false)
-	if err != nil {
-		return err
+	return nil
+}
+
+// restoreSegments restores each segment of the extract at 'object', and
+// checkpoints its progress after each one. If 'resume' is set, segments that
+// were restored by a previous restore are skipped.
+func (r *restoreCtx) restoreSegments(objClient obj.Client, object string, manifest *admin.BackupManifest, resume bool) error {
+	ctx := r.pachClient.Ctx()
+	checkpointObject := restoreCheckpointObject(object, r.a.clusterInfo.DeploymentID)
+	checkpoint := &admin.RestoreCheckpoint{ID: manifest.ID}
+	if resume && objClient.Exists(ctx, checkpointObject) {
+		prev := &admin.RestoreCheckpoint{}
+		if err := readProto(ctx, objClient, checkpointObject, prev); err != nil {
+			return errors.Wrapf(err, "could not read restore checkpoint")
+		}
+		if prev.ID == manifest.ID {
+			checkpoint.Segments = prev.Segments
+		}
+	}
+	for ; checkpoint.Segments < manifest.Segments; checkpoint.Segments++ {
+		if err := r.restoreObject(objClient, segmentObject(object, checkpoint.Segments)); err != nil {
+			return err
+		}
+		if r.dryRun != nil {
+			continue
+		}
+		// Failing to checkpoint doesn't fail the restore, it only means that it
+		// can't be resumed from this segment
+		done := &admin.RestoreCheckpoint{ID: manifest.ID, Segments: checkpoint.Segments + 1}
+		if err := writeProto(ctx, objClient, checkpointObject, done); err != nil {
+			logrus.Warnf("could not checkpoint restore of %s: %v", object, err)
+		}
 	}
-	objR, err := objClient.Reader(r.pachClient.Ctx(), url.Object, 0, 0)
+	return nil
+}
+
+// putObject restores the object read from 'objR'
+func (r *restoreCtx) putObject(objR io.Reader) error {
+	if r.dryRun != nil {
+		return r.dryRun.putObject(objR)
+	}
+	if _, _, err := r.pachClient.PutObject(objR); err != nil {
+		return errors.Wrapf(err, "error putting object")
+	}
+	return nil
+}
+
+// putBlock restores the block 'hash', read from 'blockR'
+func (r *restoreCtx) putBlock(hash string, blockR io.Reader) error {
+	if r.dryRun != nil {
+		return r.dryRun.putBlock(hash, blockR)
+	}
+	if _, err := r.pachClient.PutBlock(hash, blockR); err != nil {
+		return errors.Wrapf(err, "error putting block")
+	}
+	return nil
+}
+
+// restoreObject restores the stream of ops in 'object'
+func (r *restoreCtx) restoreObject(objClient obj.Client, object string) (retErr error) {
+	objR, err := objClient.Reader(r.pachClient.Ctx(), object, 0, 0)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		if err := objR.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
 	snappyR := snappy.NewReader(objR)
 	r.r = pbutil.NewReader(snappyR)
 	var op admin.Op
@@ -414,10 +770,21 @@ func (r *restoreCtx) startFromURL(reqURL string) error {
 	}
 }
 
-// validateAndApplyOp is a helper called by start() and startFromURL(), which
+// validateAndApplyOp is a helper called by start() and restoreObject(), which
 // validates the top-level 'op' and then delegates to the right version of
-// 'applyOp':
+// 'applyOp'. Dry runs record any error as an issue with 'op' and continue.
 func (r *restoreCtx) validateAndApplyOp(op *admin.Op) error {
+	if r.dryRun != nil {
+		r.dryRun.report.Ops++
+		if err := r.applyVersionedOp(op); err != nil {
+			r.dryRun.issue(admin.RestoreIssue_ERROR, fmt.Sprintf("op %d", r.dryRun.report.Ops), "%v", err)
+		}
+		return nil
+	}
+	return r.applyVersionedOp(op)
+}
+
+func (r *restoreCtx) applyVersionedOp(op *admin.Op) error {
 	// validate op version
 	opVersion := version(op)
 	if r.streamVersion == undefined {
@@ -452,9 +819,11 @@ func (r *restoreCtx) applyOp1_7(op *admin.Op1_7) error {
 			version:               v1_7,
 		}
 		extractReader.buf.Write(op.Object.Value)
-		if _, _, err := r.pachClient.PutObject(extractReader); err != nil {
-			return errors.Wrapf(err, "error putting object")
-		}
+		return r.putObject(extractReader)
+	}
+	if r.dryRun != nil && op.Commit != nil {
+		// Converting a 1.7 commit's hashtree writes a new one to object storage
+		r.dryRun.commit1_7(op.Commit)
 		return nil
 	}
 	newOp1_8, err := convert1_7Op(r.pachClient, r.a.storageRoot, op)
@@ -472,10 +841,7 @@ func (r *restoreCtx) applyOp1_8(op *admin.Op1_8) error {
 			version:               v1_8,
 		}
 		extractReader.buf.Write(op.Object.Value)
-		if _, _, err := r.pachClient.PutObject(extractReader); err != nil {
-			return errors.Wrapf(err, "error putting object")
-		}
-		return nil
+		return r.putObject(extractReader)
 	}
 	newOp1_9, err := convert1_8Op(op)
 	if err != nil {
@@ -493,10 +859,7 @@ func (r *restoreCtx) applyOp1_9(op *admin.Op1_9) error {
 			version:               v1_9,
 		}
 		extractReader.buf.Write(op.Object.Value)
-		if _, _, err := r.pachClient.PutObject(extractReader); err != nil {
-			return errors.Wrapf(err, "error putting object")
-		}
-		return nil
+		return r.putObject(extractReader)
 	case op.Block != nil && len(op.Block.Value) > 0:
 		extractReader := &extractBlockReader{
 			adminAPIRestoreServer: r.restoreServer,
@@ -504,16 +867,10 @@ func (r *restoreCtx) applyOp1_9(op *admin.Op1_9) error {
 			version:               v1_9,
 		}
 		extractReader.buf.Write(op.Block.Value)
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, extractReader); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, extractReader)
 	case op.Block != nil && len(op.Block.Value) == 0:
 		// Empty block
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, bytes.NewReader(nil)); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, bytes.NewReader(nil))
 	default:
 		newOp, err := convert1_9Op(op)
 		if err != nil {
@@ -535,10 +892,7 @@ func (r *restoreCtx) applyOp1_10(op *admin.Op1_10) error {
 			version:               v1_10,
 		}
 		extractReader.buf.Write(op.Object.Value)
-		if _, _, err := r.pachClient.PutObject(extractReader); err != nil {
-			return errors.Wrapf(err, "error putting object")
-		}
-		return nil
+		return r.putObject(extractReader)
 	case op.Block != nil && len(op.Block.Value) > 0:
 		extractReader := &extractBlockReader{
 			adminAPIRestoreServer: r.restoreServer,
@@ -546,16 +900,10 @@ func (r *restoreCtx) applyOp1_10(op *admin.Op1_10) error {
 			version:               v1_10,
 		}
 		extractReader.buf.Write(op.Block.Value)
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, extractReader); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, extractReader)
 	case op.Block != nil && len(op.Block.Value) == 0:
 		// Empty block
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, bytes.NewReader(nil)); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, bytes.NewReader(nil))
 	default:
 		newOp, err := convert1_10Op(op)
 		if err != nil {
@@ -577,10 +925,7 @@ func (r *restoreCtx) applyOp1_11(op *admin.Op1_11) error {
 			version:               v1_11,
 		}
 		extractReader.buf.Write(op.Object.Value)
-		if _, _, err := r.pachClient.PutObject(extractReader); err != nil {
-			return errors.Wrapf(err, "error putting object")
-		}
-		return nil
+		return r.putObject(extractReader)
 	case op.Block != nil && len(op.Block.Value) > 0:
 		extractReader := &extractBlockReader{
 			adminAPIRestoreServer: r.restoreServer,
@@ -588,16 +933,10 @@ func (r *restoreCtx) applyOp1_11(op *admin.Op1_11) error {
 			version:               v1_11,
 		}
 		extractReader.buf.Write(op.Block.Value)
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, extractReader); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, extractReader)
 	case op.Block != nil && len(op.Block.Value) == 0:
 		// Empty block
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, bytes.NewReader(nil)); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, bytes.NewReader(nil))
 	default:
 		newOp, err := convert1_11Op(op)
 		if err != nil {
@@ -619,10 +958,7 @@ func (r *restoreCtx) applyOp1_12(op *admin.Op1_12) error {
 			version:               v1_12,
 		}
 		extractReader.buf.Write(op.Object.Value)
-		if _, _, err := r.pachClient.PutObject(extractReader); err != nil {
-			return errors.Wrapf(err, "error putting object")
-		}
-		return nil
+		return r.putObject(extractReader)
 	case op.Block != nil && len(op.Block.Value) > 0:
 		extractReader := &extractBlockReader{
 			adminAPIRestoreServer: r.restoreServer,
@@ -630,16 +966,10 @@ func (r *restoreCtx) applyOp1_12(op *admin.Op1_12) error {
 			version:               v1_12,
 		}
 		extractReader.buf.Write(op.Block.Value)
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, extractReader); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, extractReader)
 	case op.Block != nil && len(op.Block.Value) == 0:
 		// Empty block
-		if _, err := r.pachClient.PutBlock(op.Block.Block.Hash, bytes.NewReader(nil)); err != nil {
-			return errors.Wrapf(err, "error putting block")
-		}
-		return nil
+		return r.putBlock(op.Block.Block.Hash, bytes.NewReader(nil))
 	default:
 		return r.applyOp(op)
 	}
@@ -648,6 +978,18 @@ func (r *restoreCtx) applyOp1_12(op *admin.Op1_12) error {
 func (r *restoreCtx) applyOp(op *admin.Op1_12) error {
 	c := r.pachClient
 	ctx := r.pachClient.Ctx()
+	r.renames.op(op)
+	if op.Repo == nil {
+		if err := r.flushRepos(); err != nil {
+			return err
+		}
+		if r.skipOp(op) {
+			return nil
+		}
+	}
+	if r.dryRun != nil {
+		return r.checkOp(op)
+	}
 	switch {
 	case op.CreateObject != nil:
 		if _, err := c.ObjectAPIClient.CreateObject(ctx, op.CreateObject); err != nil {
@@ -659,9 +1001,7 @@ func (r *restoreCtx) applyOp(op *admin.Op1_12) error {
 		}
 	case op.Repo != nil:
 		op.Repo.Repo.Name = ancestry.SanitizeName(op.Repo.Repo.Name)
-		if _, err := c.PfsAPIClient.CreateRepo(ctx, op.Repo); err != nil && !errutil.IsAlreadyExistError(err) {
-			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating repo")
-		}
+		r.addRepo(op.Repo)
 	case op.Commit != nil:
 		if op.Commit.Finished == nil {
 			// Never allow Restore() to create an unfinished commit. They can only
@@ -670,8 +1010,15 @@ func (r *restoreCtx) applyOp(op *admin.Op1_12) error {
 			// commits and corrupt the entire cluster.
 			op.Commit.Finished = types.TimestampNow()
 		}
-		if _, err := c.PfsAPIClient.BuildCommit(ctx, op.Commit); err != nil && !errutil.IsAlreadyExistError(err) {
-			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating commit")
+		if _, err := c.PfsAPIClient.BuildCommit(ctx, op.Commit); err != nil {
+			if !errutil.IsAlreadyExistError(err) {
+				return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating commit")
+			}
+			// An incremental extract has the commits that were open when its
+			// base extract was taken, which replace their placeholders
+			if err := r.replacePlaceholderCommit(op.Commit); err != nil {
+				return err
+			}
 		}
 	case op.Branch != nil:
 		if op.Branch.Branch == nil {
@@ -689,6 +1036,97 @@ func (r *restoreCtx) applyOp(op *admin.Op1_12) error {
 		if _, err := c.PpsAPIClient.CreateJob(ctx, op.Job); err != nil && !errutil.IsAlreadyExistError(err) {
 			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating job")
 		}
+	case op.Acl != nil:
+		if op.Acl.Repo != "" {
+			op.Acl.Repo = ancestry.SanitizeName(op.Acl.Repo)
+		}
+		if op.Acl.Pipeline != "" {
+			op.Acl.Pipeline = ancestry.SanitizeName(op.Acl.Pipeline)
+		}
+		if _, err := c.AuthAPIClient.SetACL(ctx, op.Acl); err != nil {
+			if auth.IsErrNotActivated(err) {
+				logrus.Warnf("auth is not activated, so the ACL of %q will not be restored", op.Acl.Repo+op.Acl.Pipeline)
+				return nil
+			}
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error setting ACL")
+		}
+	case op.Tombstone != nil:
+		return r.applyTombstone(op.Tombstone)
+	}
+	return nil
+}
+
+// replacePlaceholderCommit gives the commit that 'req' builds the data in
+// 'req', if the commit was restored from an extract that was taken while it
+// was open (and so was restored finished, without any data). Commits that
+// have data are left as they are.
+func (r *restoreCtx) replacePlaceholderCommit(req *pfs.BuildCommitRequest) error {
+	if req.Tree == nil && len(req.Trees) == 0 {
+		return nil
+	}
+	repo := req.Parent.Repo.Name
+	commits := pfsdb.Commits(r.a.etcdClient, r.a.pfsEtcdPrefix, repo)
+	branches := pfsdb.Branches(r.a.etcdClient, r.a.pfsEtcdPrefix, repo)
+	repos := pfsdb.Repos(r.a.etcdClient, r.a.pfsEtcdPrefix)
+	_, err := col.NewSTM(r.pachClient.Ctx(), r.a.etcdClient, func(stm col.STM) error {
+		ci := &pfs.CommitInfo{}
+		if err := commits.ReadWrite(stm).Get(req.ID, ci); err != nil {
+			return err
+		}
+		if ci.Tree != nil || len(ci.Trees) > 0 || ci.Finished == nil {
+			return nil
+		}
+		ci.Tree = req.Tree
+		ci.Trees = req.Trees
+		ci.Datums = req.Datums
+		ci.SizeBytes = req.SizeBytes
+		if req.Finished != nil {
+			ci.Finished = req.Finished
+		}
+		if err := commits.ReadWrite(stm).Put(req.ID, ci); err != nil {
+			return err
+		}
+		// update the repo size if this is the head of master (as FinishCommit
+		// does)
+		bi := &pfs.BranchInfo{}
+		if err := branches.ReadWrite(stm).Get("master", bi); err != nil {
+			if col.IsErrNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		if bi.Head == nil || bi.Head.ID != req.ID {
+			return nil
+		}
+		ri := &pfs.RepoInfo{}
+		if err := repos.ReadWrite(stm).Get(repo, ri); err != nil {
+			return err
+		}
+		ri.SizeBytes = ci.SizeBytes
+		return repos.ReadWrite(stm).Put(repo, ri)
+	})
+	return errors.Wrapf(err, "could not replace placeholder of commit %s@%s", repo, req.ID)
+}
+
+// applyTombstone deletes what 't' refers to, if it exists. A deleted
+// pipeline's output repo has its own tombstone, so it's kept here.
+func (r *restoreCtx) applyTombstone(t *admin.Tombstone) error {
+	c := r.pachClient
+	ctx := r.pachClient.Ctx()
+	var err error
+	switch {
+	case t.Pipeline != nil:
+		_, err = c.PpsAPIClient.DeletePipeline(ctx, &pps.DeletePipelineRequest{Pipeline: t.Pipeline, Force: true, KeepRepo: true})
+	case t.Branch != nil:
+		_, err = c.PfsAPIClient.DeleteBranch(ctx, &pfs.DeleteBranchRequest{Branch: t.Branch, Force: true})
+	case t.Commit != nil:
+		_, err = c.PfsAPIClient.DeleteCommit(ctx, &pfs.DeleteCommitRequest{Commit: t.Commit})
+	case t.Repo != nil:
+		_, err = c.PfsAPIClient.DeleteRepo(ctx, &pfs.DeleteRepoRequest{Repo: t.Repo, Force: true})
+		delete(r.restoredRepos, t.Repo.Name)
+	}
+	if err != nil && !errutil.IsNotFoundError(err) {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error applying tombstone")
 	}
 	return nil
 }
@@ -716,7 +1154,9 @@ func (a *apiServer) getPachClient() *client.APIClient {
 	return a.pachClient
 }
 
-type adminAPIRestoreServer admin.API_RestoreServer
+type adminAPIRestoreServer interface {
+	Recv() (*admin.RestoreRequest, error)
+}
 
 type extractObjectReader struct {
 	// One of these two must be set (whether user is restoring over the wire or
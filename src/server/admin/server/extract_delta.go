@@ -0,0 +1,303 @@
+package server
+
+import (
+	"path"
+	"strings"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"golang.org/x/net/context"
+)
+
+// These are the etcd prefixes (under PFS's and PPS's etcd prefixes) of the
+// collections that incremental extracts compare (see pfsdb and ppsdb)
+const (
+	reposEtcdPrefix     = "repos"
+	commitsEtcdPrefix   = "commits"
+	branchesEtcdPrefix  = "branches"
+	pipelinesEtcdPrefix = "pipelines"
+	jobsEtcdPrefix      = "jobs"
+
+	// openCommitsEtcdPrefix is the prefix of PFS's open commits, which are
+	// extracted as placeholders (see extractDelta.placeholders)
+	openCommitsEtcdPrefix = "openCommits"
+
+	// etcdIndexMarker is in the keys of collection indexes, which aren't
+	// compared (see collection.indexRoot)
+	etcdIndexMarker = "__index_"
+)
+
+// collectionDelta holds the keys of an etcd collection that changed between
+// the revision of a base extract and the revision of an incremental extract
+type collectionDelta struct {
+	// changed holds the keys that have been put since the base revision
+	changed map[string]bool
+	// created holds the keys that have been created since the base revision
+	created map[string]bool
+	// deleted holds the keys that existed at the base revision and have since
+	// been deleted (or deleted and created again), in order
+	deleted []string
+}
+
+// newCollectionDelta compares the keys under 'prefix' at 'baseRevision' with
+// those at 'revision'. Keys are relative to 'prefix', e.g. "<repo>/<id>" for
+// commits.
+func newCollectionDelta(ctx context.Context, etcdClient *etcd.Client, prefix string, baseRevision, revision int64) (*collectionDelta, error) {
+	prefix += "/"
+	d := &collectionDelta{
+		changed: make(map[string]bool),
+		created: make(map[string]bool),
+	}
+	resp, err := etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithKeysOnly(), etcd.WithRev(revision))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	current := make(map[string]bool)
+	for _, kv := range resp.Kvs {
+		key := strings.TrimPrefix(string(kv.Key), prefix)
+		if strings.Contains(key, etcdIndexMarker) {
+			continue
+		}
+		current[key] = true
+		if kv.ModRevision > baseRevision {
+			d.changed[key] = true
+		}
+		if kv.CreateRevision > baseRevision {
+			d.created[key] = true
+		}
+	}
+	resp, err = etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithKeysOnly(), etcd.WithRev(baseRevision))
+	if err != nil {
+		if errors.Is(err, rpctypes.ErrCompacted) {
+			return nil, errors.Errorf("etcd has been compacted past the base extract's revision (%d), so an incremental extract can't be taken (take a full extract instead)", baseRevision)
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	for _, kv := range resp.Kvs {
+		key := strings.TrimPrefix(string(kv.Key), prefix)
+		if strings.Contains(key, etcdIndexMarker) {
+			continue
+		}
+		if !current[key] || d.created[key] {
+			d.deleted = append(d.deleted, key)
+		}
+	}
+	return d, nil
+}
+
+// extractDelta holds what's changed since the extract that an incremental
+// extract is based on, which is all that the incremental extract includes. A
+// nil *extractDelta selects everything, which is what Extract uses when the
+// extract isn't incremental.
+//
+// Repos, commits, branches, pipelines and jobs are compared by their etcd
+// revisions. Objects, blocks and tags aren't in etcd, so the ones included are
+// those that the new commits refer to and their parents don't (see
+// addReachable).
+type extractDelta struct {
+	repos     *collectionDelta
+	commits   *collectionDelta
+	branches  *collectionDelta
+	pipelines *collectionDelta
+	jobs      *collectionDelta
+
+	// placeholders holds the commits ("<repo>/<id>") that were open when the
+	// base extract was taken, and have changed since. Extract writes open
+	// commits as finished commits without any data, so these are extracted
+	// again, and Restore replaces the placeholders with them.
+	placeholders map[string]bool
+
+	objects map[string]bool
+	blocks  map[string]bool
+}
+
+// newExtractDelta returns what's changed in etcd between 'baseRevision' and
+// 'revision'
+func (a *apiServer) newExtractDelta(ctx context.Context, baseRevision, revision int64) (*extractDelta, error) {
+	d := &extractDelta{
+		placeholders: make(map[string]bool),
+		objects:      make(map[string]bool),
+		blocks:       make(map[string]bool),
+	}
+	for _, c := range []struct {
+		delta  **collectionDelta
+		prefix string
+	}{
+		{&d.repos, path.Join(a.pfsEtcdPrefix, reposEtcdPrefix)},
+		{&d.commits, path.Join(a.pfsEtcdPrefix, commitsEtcdPrefix)},
+		{&d.branches, path.Join(a.pfsEtcdPrefix, branchesEtcdPrefix)},
+		{&d.pipelines, path.Join(a.ppsEtcdPrefix, pipelinesEtcdPrefix)},
+		{&d.jobs, path.Join(a.ppsEtcdPrefix, jobsEtcdPrefix)},
+	} {
+		var err error
+		if *c.delta, err = newCollectionDelta(ctx, a.etcdClient, c.prefix, baseRevision, revision); err != nil {
+			return nil, err
+		}
+	}
+	prefix := path.Join(a.pfsEtcdPrefix, openCommitsEtcdPrefix) + "/"
+	resp, err := a.etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithRev(baseRevision))
+	if err != nil {
+		return nil, errors.EnsureStack(err)
+	}
+	for _, kv := range resp.Kvs {
+		commit := &pfs.Commit{}
+		if err := commit.Unmarshal(kv.Value); err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal open commit %q", kv.Key)
+		}
+		if key := path.Join(commit.Repo.Name, commit.ID); d.commits.changed[key] {
+			d.placeholders[key] = true
+		}
+	}
+	return d, nil
+}
+
+// addReachable adds the objects and blocks that new commits (and commits that
+// replace placeholders) refer to, and that their parents (which are in the
+// base extract) don't, to 'd'
+func (d *extractDelta) addReachable(pachClient *client.APIClient, storageRoot string) error {
+	if d == nil {
+		return nil
+	}
+	var parents []*pfs.Commit
+	if err := pachClient.ListCommitF("", "", "", 0, true, func(ci *pfs.CommitInfo) error {
+		if !d.commit(ci.Commit) {
+			return nil
+		}
+		if ci.ParentCommit != nil && !d.commit(ci.ParentCommit) {
+			parents = append(parents, ci.ParentCommit)
+		}
+		return addCommitObjects(pachClient, storageRoot, ci, d.objects, d.blocks)
+	}); err != nil {
+		return err
+	}
+	baseObjects, baseBlocks := make(map[string]bool), make(map[string]bool)
+	for _, parent := range parents {
+		ci, err := pachClient.InspectCommit(parent.Repo.Name, parent.ID)
+		if err != nil {
+			return err
+		}
+		if err := addCommitObjects(pachClient, storageRoot, ci, baseObjects, baseBlocks); err != nil {
+			return err
+		}
+	}
+	for hash := range baseObjects {
+		delete(d.objects, hash)
+	}
+	for hash := range baseBlocks {
+		delete(d.blocks, hash)
+	}
+	return addObjectBlocks(pachClient, d.objects, d.blocks)
+}
+
+func (d *extractDelta) object(o *pfs.Object) bool {
+	return d == nil || d.objects[o.Hash]
+}
+
+func (d *extractDelta) block(b *pfs.Block) bool {
+	return d == nil || d.blocks[b.Hash]
+}
+
+// repo returns true if the repo 'name' was created since the base extract
+func (d *extractDelta) repo(name string) bool {
+	return d == nil || d.repos.created[name]
+}
+
+// commit returns true if 'c' was created since the base extract, or if it
+// replaces a placeholder in the base extract
+func (d *extractDelta) commit(c *pfs.Commit) bool {
+	return d == nil || d.commits.created[path.Join(c.Repo.Name, c.ID)] || d.placeholder(c)
+}
+
+// placeholder returns true if 'c' was open when the base extract was taken
+// (so the base extract has a placeholder for it), and has changed since
+func (d *extractDelta) placeholder(c *pfs.Commit) bool {
+	return d != nil && d.placeholders[path.Join(c.Repo.Name, c.ID)]
+}
+
+// inBase returns true if 'c' existed when the base extract was taken
+func (d *extractDelta) inBase(c *pfs.Commit) bool {
+	return d != nil && !d.commits.created[path.Join(c.Repo.Name, c.ID)]
+}
+
+// branch returns true if 'b' has been created or moved since the base extract
+func (d *extractDelta) branch(b *pfs.Branch) bool {
+	return d == nil || d.branches.changed[path.Join(b.Repo.Name, b.Name)]
+}
+
+// pipeline returns true if the pipeline in 'pi' was created, or has a new
+// version, since the base extract
+func (d *extractDelta) pipeline(pi *pps.PipelineInfo) bool {
+	if d == nil || d.pipelines.created[pi.Pipeline.Name] {
+		return true
+	}
+	return pi.SpecCommit != nil && d.commits.created[path.Join(ppsconsts.SpecRepo, pi.SpecCommit.ID)]
+}
+
+// pipelineInBase returns true if the pipeline 'name' existed when the base
+// extract was taken (and so is restored as an update)
+func (d *extractDelta) pipelineInBase(name string) bool {
+	return d != nil && !d.pipelines.created[name]
+}
+
+// job returns true if 'job' has been created or updated since the base extract
+func (d *extractDelta) job(job *pps.Job) bool {
+	return d == nil || d.jobs.changed[job.ID]
+}
+
+// tombstones returns tombstones for the pipelines, branches, commits and repos
+// (in that order, which is the order they're deleted in) that were deleted
+// since the base extract and are selected by 'f'. The branches and commits of
+// deleted repos are deleted along with them, so they don't get tombstones.
+func (d *extractDelta) tombstones(f *extractFilter, repos, pipelines bool) []*admin.Tombstone {
+	if d == nil {
+		return nil
+	}
+	var result []*admin.Tombstone
+	if pipelines {
+		for _, name := range d.pipelines.deleted {
+			if f.pipeline(name) {
+				result = append(result, &admin.Tombstone{Pipeline: &pps.Pipeline{Name: name}})
+			}
+		}
+	}
+	if !repos {
+		return result
+	}
+	deletedRepos := make(map[string]bool)
+	for _, name := range d.repos.deleted {
+		deletedRepos[name] = true
+	}
+	// split splits a key of the commits or branches collection into its repo
+	// and the commit ID or branch name
+	split := func(key string) (string, string) {
+		i := strings.Index(key, "/")
+		if i < 0 {
+			return "", key
+		}
+		return key[:i], key[i+1:]
+	}
+	for _, key := range d.branches.deleted {
+		repo, name := split(key)
+		if b := client.NewBranch(repo, name); !deletedRepos[repo] && f.branch(b) {
+			result = append(result, &admin.Tombstone{Branch: b})
+		}
+	}
+	for _, key := range d.commits.deleted {
+		repo, id := split(key)
+		if c := client.NewCommit(repo, id); !deletedRepos[repo] && f.commit(c) {
+			result = append(result, &admin.Tombstone{Commit: c})
+		}
+	}
+	for _, name := range d.repos.deleted {
+		if name != ppsconsts.SpecRepo && f.repo(name) {
+			result = append(result, &admin.Tombstone{Repo: client.NewRepo(name)})
+		}
+	}
+	return result
+}
@@ -0,0 +1,188 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testetcd"
+	"golang.org/x/net/context"
+)
+
+func TestExtractDelta(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(e *testetcd.Env) error {
+		ctx := context.Background()
+		a := &apiServer{etcdClient: e.EtcdClient, pfsEtcdPrefix: "pfs", ppsEtcdPrefix: "pps"}
+		repos := pfsdb.Repos(e.EtcdClient, "pfs")
+		pipelines := ppsdb.Pipelines(e.EtcdClient, "pps")
+		openCommits := pfsdb.OpenCommits(e.EtcdClient, "pfs")
+		commits := func(repo string) col.Collection { return pfsdb.Commits(e.EtcdClient, "pfs", repo) }
+		branches := func(repo string) col.Collection { return pfsdb.Branches(e.EtcdClient, "pfs", repo) }
+		putCommit := func(stm col.STM, repo, id string) error {
+			// Provenance is indexed, and the index's keys aren't compared
+			return commits(repo).ReadWrite(stm).Put(id, &pfs.CommitInfo{
+				Commit:     client.NewCommit(repo, id),
+				Provenance: []*pfs.CommitProvenance{client.NewCommitProvenance("upstream", "master", "p")},
+			})
+		}
+		run := func(f func(stm col.STM) error) {
+			_, err := col.NewSTM(ctx, e.EtcdClient, f)
+			require.NoError(t, err)
+		}
+
+		// The base extract
+		run(func(stm col.STM) error {
+			for _, repo := range []string{"images", "old"} {
+				if err := repos.ReadWrite(stm).Put(repo, &pfs.RepoInfo{Repo: client.NewRepo(repo)}); err != nil {
+					return err
+				}
+			}
+			for _, c := range [][2]string{{"images", "a"}, {"images", "b"}, {"old", "x"}} {
+				if err := putCommit(stm, c[0], c[1]); err != nil {
+					return err
+				}
+			}
+			// "open" is open during the base extract, and finished after it
+			for _, id := range []string{"open", "still-open"} {
+				if err := putCommit(stm, "images", id); err != nil {
+					return err
+				}
+				if err := openCommits.ReadWrite(stm).Put(id, client.NewCommit("images", id)); err != nil {
+					return err
+				}
+			}
+			if err := branches("images").ReadWrite(stm).Put("master", &pfs.BranchInfo{Branch: client.NewBranch("images", "master")}); err != nil {
+				return err
+			}
+			return pipelines.ReadWrite(stm).Put("edges", &pps.EtcdPipelineInfo{})
+		})
+		baseRevision, err := a.etcdRevision(ctx)
+		require.NoError(t, err)
+
+		// Changes since the base extract
+		run(func(stm col.STM) error {
+			if err := repos.ReadWrite(stm).Put("new", &pfs.RepoInfo{Repo: client.NewRepo("new")}); err != nil {
+				return err
+			}
+			if err := putCommit(stm, "images", "c"); err != nil {
+				return err
+			}
+			if err := commits("images").ReadWrite(stm).Put("open", &pfs.CommitInfo{
+				Commit:   client.NewCommit("images", "open"),
+				Finished: types.TimestampNow(),
+			}); err != nil {
+				return err
+			}
+			if err := openCommits.ReadWrite(stm).Delete("open"); err != nil {
+				return err
+			}
+			if err := commits("images").ReadWrite(stm).Delete("a"); err != nil {
+				return err
+			}
+			if err := branches("images").ReadWrite(stm).Put("master", &pfs.BranchInfo{Branch: client.NewBranch("images", "master"), Head: client.NewCommit("images", "c")}); err != nil {
+				return err
+			}
+			if err := commits("old").ReadWrite(stm).Delete("x"); err != nil {
+				return err
+			}
+			if err := repos.ReadWrite(stm).Delete("old"); err != nil {
+				return err
+			}
+			return pipelines.ReadWrite(stm).Delete("edges")
+		})
+		revision, err := a.etcdRevision(ctx)
+		require.NoError(t, err)
+
+		d, err := a.newExtractDelta(ctx, baseRevision, revision)
+		require.NoError(t, err)
+		require.True(t, d.repo("new"))
+		require.False(t, d.repo("images"))
+		require.True(t, d.commit(client.NewCommit("images", "c")))
+		require.False(t, d.commit(client.NewCommit("images", "b")))
+		require.True(t, d.inBase(client.NewCommit("images", "b")))
+		// Commits that were open during the base extract replace their
+		// placeholders once they've changed
+		require.True(t, d.commit(client.NewCommit("images", "open")))
+		require.True(t, d.placeholder(client.NewCommit("images", "open")))
+		require.True(t, d.inBase(client.NewCommit("images", "open")))
+		require.False(t, d.commit(client.NewCommit("images", "still-open")))
+		require.False(t, d.placeholder(client.NewCommit("images", "c")))
+		require.True(t, d.branch(client.NewBranch("images", "master")))
+		// The commits and branches of deleted repos are deleted with them
+		require.Equal(t, []*admin.Tombstone{
+			{Pipeline: &pps.Pipeline{Name: "edges"}},
+			{Commit: client.NewCommit("images", "a")},
+			{Repo: client.NewRepo("old")},
+		}, d.tombstones(nil, true, true))
+		require.Equal(t, []*admin.Tombstone{
+			{Pipeline: &pps.Pipeline{Name: "edges"}},
+		}, d.tombstones(nil, false, true))
+
+		// A full extract is needed once etcd no longer has the base revision
+		_, err = e.EtcdClient.Compact(ctx, revision)
+		require.NoError(t, err)
+		_, err = a.newExtractDelta(ctx, baseRevision, revision)
+		require.YesError(t, err)
+		require.Matches(t, "take a full extract", err.Error())
+		return nil
+	}))
+}
+
+func TestReplacePlaceholderCommit(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(e *testetcd.Env) error {
+		ctx := context.Background()
+		a := &apiServer{etcdClient: e.EtcdClient, pfsEtcdPrefix: "pfs"}
+		r := &restoreCtx{a: a, pachClient: &client.APIClient{}}
+		repos := pfsdb.Repos(e.EtcdClient, "pfs")
+		commits := pfsdb.Commits(e.EtcdClient, "pfs", "images")
+		_, err := col.NewSTM(ctx, e.EtcdClient, func(stm col.STM) error {
+			if err := repos.ReadWrite(stm).Put("images", &pfs.RepoInfo{Repo: client.NewRepo("images")}); err != nil {
+				return err
+			}
+			if err := pfsdb.Branches(e.EtcdClient, "pfs", "images").ReadWrite(stm).Put("master", &pfs.BranchInfo{
+				Branch: client.NewBranch("images", "master"),
+				Head:   client.NewCommit("images", "placeholder"),
+			}); err != nil {
+				return err
+			}
+			for _, id := range []string{"placeholder", "restored"} {
+				ci := &pfs.CommitInfo{Commit: client.NewCommit("images", id), Finished: types.TimestampNow()}
+				if id == "restored" {
+					ci.Tree = &pfs.Object{Hash: "tree"}
+				}
+				if err := commits.ReadWrite(stm).Put(id, ci); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		require.NoError(t, err)
+
+		for _, id := range []string{"placeholder", "restored"} {
+			require.NoError(t, r.replacePlaceholderCommit(&pfs.BuildCommitRequest{
+				Parent:    client.NewCommit("images", ""),
+				ID:        id,
+				Tree:      &pfs.Object{Hash: "new-tree"},
+				SizeBytes: 10,
+			}))
+		}
+		ci := &pfs.CommitInfo{}
+		require.NoError(t, commits.ReadOnly(ctx).Get("placeholder", ci))
+		require.Equal(t, "new-tree", ci.Tree.Hash)
+		require.Equal(t, uint64(10), ci.SizeBytes)
+		ri := &pfs.RepoInfo{}
+		require.NoError(t, repos.ReadOnly(ctx).Get("images", ri))
+		require.Equal(t, uint64(10), ri.SizeBytes)
+		// Commits that were restored with their data are left as they are
+		require.NoError(t, commits.ReadOnly(ctx).Get("restored", ci))
+		require.Equal(t, "tree", ci.Tree.Hash)
+		return nil
+	}))
+}
@@ -0,0 +1,365 @@
+package server
+
+import (
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+
+	"github.com/sirupsen/logrus"
+)
+
+// extractFilter selects the parts of the cluster that an ExtractRequest with
+// filters extracts. A nil *extractFilter selects everything, which is what
+// Extract uses when the request has no filters.
+type extractFilter struct {
+	repos     map[string]bool
+	pipelines map[string]bool
+	// branches holds the branches to extract, by repo. Repos that aren't in
+	// 'branches' have all of their branches extracted.
+	branches map[string]map[string]bool
+	// commits holds the IDs of the commits to extract, by repo. Repos that
+	// aren't in 'commits' have all of their commits extracted.
+	commits map[string]map[string]bool
+	// extracted holds the commits that have been extracted so far, so that
+	// references to commits that weren't extracted can be dropped
+	extracted map[string]bool
+	// objects and blocks hold the hashes of the objects and blocks that the
+	// selected commits refer to (see addReachable), which are the only ones
+	// extracted
+	objects map[string]bool
+	blocks  map[string]bool
+}
+
+// newExtractFilter returns the filter for 'request', or nil if 'request' has
+// no filters
+func newExtractFilter(pachClient *client.APIClient, request *admin.ExtractRequest) (*extractFilter, error) {
+	if len(request.Repos) == 0 && len(request.Pipelines) == 0 &&
+		len(request.Branches) == 0 && len(request.CommitRanges) == 0 {
+		if request.IncludeProvenance {
+			return nil, errors.Errorf("include_provenance requires repos or pipelines to be selected")
+		}
+		return nil, nil
+	}
+	pis, err := pachClient.ListPipeline()
+	if err != nil {
+		return nil, err
+	}
+	f := &extractFilter{
+		repos:     make(map[string]bool),
+		pipelines: make(map[string]bool),
+		branches:  make(map[string]map[string]bool),
+		commits:   make(map[string]map[string]bool),
+		extracted: make(map[string]bool),
+		objects:   make(map[string]bool),
+		blocks:    make(map[string]bool),
+	}
+	piMap := make(map[string]*pps.PipelineInfo)
+	for _, pi := range pis {
+		piMap[pi.Pipeline.Name] = pi
+	}
+
+	// Select the requested repos and pipelines. If neither are set (only
+	// branches or commit ranges are) then every repo and pipeline is selected.
+	if len(request.Repos) == 0 && len(request.Pipelines) == 0 {
+		ris, err := pachClient.ListRepo()
+		if err != nil {
+			return nil, err
+		}
+		for _, ri := range ris {
+			f.repos[ri.Repo.Name] = true
+		}
+		for _, pi := range pis {
+			f.pipelines[pi.Pipeline.Name] = true
+		}
+	}
+	var queue []string // repos whose provenance hasn't been added yet
+	for _, repo := range request.Repos {
+		if _, err := pachClient.InspectRepo(repo); err != nil {
+			return nil, err
+		}
+		f.repos[repo] = true
+		queue = append(queue, repo)
+	}
+	for _, pipeline := range request.Pipelines {
+		if _, ok := piMap[pipeline]; !ok {
+			return nil, errors.Errorf("pipeline %q not found", pipeline)
+		}
+		f.pipelines[pipeline] = true
+		f.repos[pipeline] = true // the pipeline's output repo
+		queue = append(queue, pipeline)
+	}
+	if request.IncludeProvenance {
+		for len(queue) > 0 {
+			repo := queue[0]
+			queue = queue[1:]
+			add := func(upstream string) {
+				if !f.repos[upstream] {
+					f.repos[upstream] = true
+					queue = append(queue, upstream)
+				}
+			}
+			// The pipeline that produces a repo (if any) and the pipeline's inputs
+			if pi, ok := piMap[repo]; ok {
+				f.pipelines[repo] = true
+				pps.VisitInput(pi.Input, func(input *pps.Input) {
+					switch {
+					case input.Pfs != nil:
+						add(input.Pfs.Repo)
+					case input.Cron != nil:
+						add(input.Cron.Repo)
+					case input.Git != nil:
+						add(input.Git.Name)
+					}
+				})
+			}
+			// Repos that the repo's branches are provenant on
+			bis, err := pachClient.ListBranch(repo)
+			if err != nil {
+				return nil, err
+			}
+			for _, bi := range bis {
+				for _, b := range bi.Provenance {
+					if b.Repo.Name != ppsconsts.SpecRepo {
+						add(b.Repo.Name)
+					}
+				}
+			}
+		}
+	}
+
+	// Restoring a pipeline requires its spec commits, which are on its branch
+	// in the spec repo
+	f.repos[ppsconsts.SpecRepo] = true
+	f.branches[ppsconsts.SpecRepo] = make(map[string]bool)
+	for pipeline := range f.pipelines {
+		f.branches[ppsconsts.SpecRepo][pipeline] = true
+	}
+
+	// Limit the branches and commits of repos with branch filters
+	for _, b := range request.Branches {
+		if b.Repo == nil || b.Name == "" {
+			return nil, errors.Errorf("branch filters must have a repo and a name")
+		}
+		if !f.repos[b.Repo.Name] {
+			return nil, errors.Errorf("branch filter %s@%s is in repo %q, which isn't extracted", b.Repo.Name, b.Name, b.Repo.Name)
+		}
+		if f.branches[b.Repo.Name] == nil {
+			f.branches[b.Repo.Name] = make(map[string]bool)
+		}
+		f.branches[b.Repo.Name][b.Name] = true
+	}
+	for repo, branches := range f.branches {
+		for branch := range branches {
+			if err := f.addCommits(pachClient, repo, branch, ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Commit ranges replace any commits selected by a repo's branches
+	rangeRepos := make(map[string]bool)
+	for _, r := range request.CommitRanges {
+		if r.To == nil || r.To.Repo == nil {
+			return nil, errors.Errorf("commit ranges must have a 'to' commit")
+		}
+		repo := r.To.Repo.Name
+		if !f.repos[repo] {
+			return nil, errors.Errorf("commit range to %s@%s is in repo %q, which isn't extracted", repo, r.To.ID, repo)
+		}
+		var from string
+		if r.From != nil {
+			if r.From.Repo == nil || r.From.Repo.Name != repo {
+				return nil, errors.Errorf("the commits of a commit range must be in the same repo")
+			}
+			from = r.From.ID
+		}
+		if !rangeRepos[repo] {
+			rangeRepos[repo] = true
+			delete(f.commits, repo)
+		}
+		if err := f.addCommits(pachClient, repo, r.To.ID, from); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// addCommits selects the commits in 'repo' that ListCommit returns for 'to'
+// and 'from'
+func (f *extractFilter) addCommits(pachClient *client.APIClient, repo, to, from string) error {
+	if f.commits[repo] == nil {
+		f.commits[repo] = make(map[string]bool)
+	}
+	return pachClient.ListCommitF(repo, to, from, 0, false, func(ci *pfs.CommitInfo) error {
+		f.commits[repo][ci.Commit.ID] = true
+		return nil
+	})
+}
+
+// addReachable adds the objects and blocks that the selected commits refer to
+// to 'f'
+func (f *extractFilter) addReachable(pachClient *client.APIClient, storageRoot string) error {
+	if f == nil {
+		return nil
+	}
+	if err := pachClient.ListCommitF("", "", "", 0, true, func(ci *pfs.CommitInfo) error {
+		if !f.commit(ci.Commit) {
+			return nil
+		}
+		return addCommitObjects(pachClient, storageRoot, ci, f.objects, f.blocks)
+	}); err != nil {
+		return err
+	}
+	return addObjectBlocks(pachClient, f.objects, f.blocks)
+}
+
+// addCommitObjects adds the objects and blocks that 'ci' refers to (its trees
+// and datums, and the contents of its files) to 'objects' and 'blocks'
+func addCommitObjects(pachClient *client.APIClient, storageRoot string, ci *pfs.CommitInfo, objects, blocks map[string]bool) error {
+	addFile := func(_ string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil {
+			return nil
+		}
+		for _, object := range node.FileNode.Objects {
+			objects[object.Hash] = true
+		}
+		for _, blockRef := range node.FileNode.BlockRefs {
+			blocks[blockRef.Block.Hash] = true
+		}
+		return nil
+	}
+	if ci.Datums != nil {
+		objects[ci.Datums.Hash] = true
+	}
+	if ci.Tree != nil {
+		objects[ci.Tree.Hash] = true
+		tree, err := hashtree.GetHashTreeObject(pachClient, storageRoot, ci.Tree)
+		if err != nil {
+			return err
+		}
+		err = tree.Walk("/", addFile)
+		if err := tree.Destroy(); err != nil {
+			logrus.Warnf("could not destroy hashtree of commit %s@%s: %v", ci.Commit.Repo.Name, ci.Commit.ID, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for _, object := range ci.Trees {
+		objects[object.Hash] = true
+		r, err := pachClient.GetObjectReader(object.Hash)
+		if err != nil {
+			return err
+		}
+		err = hashtree.Walk([]io.ReadCloser{r}, "/", addFile)
+		if err := r.Close(); err != nil {
+			logrus.Warnf("could not close hashtree of commit %s@%s: %v", ci.Commit.Repo.Name, ci.Commit.ID, err)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addObjectBlocks adds the blocks that 'objects' are stored in to 'blocks'
+func addObjectBlocks(pachClient *client.APIClient, objects, blocks map[string]bool) error {
+	return pachClient.ListObject(func(oi *pfs.ObjectInfo) error {
+		if objects[oi.Object.Hash] && oi.BlockRef != nil {
+			blocks[oi.BlockRef.Block.Hash] = true
+		}
+		return nil
+	})
+}
+
+func (f *extractFilter) object(o *pfs.Object) bool {
+	return f == nil || f.objects[o.Hash]
+}
+
+func (f *extractFilter) block(b *pfs.Block) bool {
+	return f == nil || f.blocks[b.Hash]
+}
+
+func (f *extractFilter) repo(name string) bool {
+	return f == nil || f.repos[name]
+}
+
+func (f *extractFilter) pipeline(name string) bool {
+	return f == nil || f.pipelines[name]
+}
+
+func (f *extractFilter) branch(b *pfs.Branch) bool {
+	if f == nil {
+		return true
+	}
+	if !f.repos[b.Repo.Name] {
+		return false
+	}
+	branches, ok := f.branches[b.Repo.Name]
+	return !ok || branches[b.Name]
+}
+
+func (f *extractFilter) commit(c *pfs.Commit) bool {
+	if f == nil {
+		return true
+	}
+	if !f.repos[c.Repo.Name] {
+		return false
+	}
+	commits, ok := f.commits[c.Repo.Name]
+	return !ok || commits[c.ID]
+}
+
+// commitExtracted records that 'c' was extracted
+func (f *extractFilter) commitExtracted(c *pfs.Commit) {
+	if f != nil {
+		f.extracted[c.Repo.Name+"@"+c.ID] = true
+	}
+}
+
+// wasExtracted returns true if 'c' was extracted (by this extract, before it
+// was resumed, or by the extract that this one is incremental to)
+func (f *extractFilter) wasExtracted(m *extractManifest, d *extractDelta, c *pfs.Commit) bool {
+	return f == nil || f.extracted[c.Repo.Name+"@"+c.ID] || m.has(commitEntry(c)) || d.inBase(c)
+}
+
+// pruneCommit removes references to commits that weren't extracted from 'req'
+// (its parent, if the parent wasn't extracted, becomes the root of its repo)
+func (f *extractFilter) pruneCommit(m *extractManifest, d *extractDelta, req *pfs.BuildCommitRequest) {
+	if f == nil {
+		return
+	}
+	if req.Parent.ID != "" && !f.wasExtracted(m, d, req.Parent) {
+		req.Parent = client.NewCommit(req.Parent.Repo.Name, "")
+	}
+	provenance := req.Provenance[:0]
+	for _, p := range req.Provenance {
+		if f.wasExtracted(m, d, p.Commit) && f.branch(p.Branch) {
+			provenance = append(provenance, p)
+		}
+	}
+	req.Provenance = provenance
+}
+
+// pruneBranch removes references to commits and branches that weren't
+// extracted from 'req'
+func (f *extractFilter) pruneBranch(m *extractManifest, d *extractDelta, req *pfs.CreateBranchRequest) {
+	if f == nil {
+		return
+	}
+	if req.Head != nil && !f.wasExtracted(m, d, req.Head) {
+		req.Head = nil
+	}
+	provenance := req.Provenance[:0]
+	for _, b := range req.Provenance {
+		if f.branch(b) {
+			provenance = append(provenance, b)
+		}
+	}
+	req.Provenance = provenance
+}
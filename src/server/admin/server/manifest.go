@@ -0,0 +1,277 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/snappy"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	"golang.org/x/net/context"
+)
+
+// segmentBytes is the (approximate, uncompressed) size at which an extract
+// written with a manifest moves on to a new segment. Each completed segment is
+// a checkpoint that an interrupted extract or restore can resume from.
+const segmentBytes = 256 * 1024 * 1024
+
+func manifestObject(object string) string {
+	return object + ".manifest"
+}
+
+func segmentObject(object string, segment int64) string {
+	return fmt.Sprintf("%s.%d", object, segment)
+}
+
+func restoreCheckpointObject(object, deploymentID string) string {
+	return fmt.Sprintf("%s.restore-%s", object, deploymentID)
+}
+
+// Manifest entries identify each thing that an in-progress extract has
+// written, so that a resumed extract can skip it. Branches and pipelines also
+// get an entry for their current state (head commit and provenance, or spec
+// commit) so that they're extracted again if it changes before the extract is
+// resumed.
+func blockEntry(block *pfs.Block) string       { return "block/" + block.Hash }
+func objectEntry(object *pfs.Object) string    { return "object/" + object.Hash }
+func tagEntry(tag *pfs.Tag) string             { return "tag/" + tag.Name }
+func repoEntry(repo *pfs.Repo) string          { return "repo/" + repo.Name }
+func commitEntry(commit *pfs.Commit) string    { return "commit/" + commit.Repo.Name + "@" + commit.ID }
+func jobEntry(outputCommit *pfs.Commit) string { return "job/" + outputCommit.ID }
+func pipelineEntry(name string) string         { return "pipeline/" + name }
+
+func pipelineVersionEntry(name string, specCommit *pfs.Commit) string {
+	return "pipeline/" + name + "=" + specCommit.ID
+}
+
+func tombstoneEntry(t *admin.Tombstone) string {
+	switch {
+	case t.Repo != nil:
+		return "tombstone/repo/" + t.Repo.Name
+	case t.Commit != nil:
+		return "tombstone/commit/" + t.Commit.Repo.Name + "@" + t.Commit.ID
+	case t.Branch != nil:
+		return "tombstone/branch/" + t.Branch.Repo.Name + "@" + t.Branch.Name
+	default:
+		return "tombstone/pipeline/" + t.Pipeline.Name
+	}
+}
+
+func branchEntry(bi *pfs.BranchInfo) string {
+	var head string
+	if bi.Head != nil {
+		head = bi.Head.ID
+	}
+	provenance := make([]string, 0, len(bi.DirectProvenance))
+	for _, b := range bi.DirectProvenance {
+		provenance = append(provenance, b.Repo.Name+"@"+b.Name)
+	}
+	return fmt.Sprintf("branch/%s@%s=%s[%s]", bi.Branch.Repo.Name, bi.Branch.Name, head, strings.Join(provenance, ","))
+}
+
+// extractManifest writes an extract to object storage as a series of segments,
+// and tracks what's been written in a BackupManifest, which is rewritten each
+// time a segment is completed. What an incremental extract includes is decided
+// by comparing etcd with the base extract's revision (see extractDelta), so
+// the manifest only records what's been written until the extract is
+// complete. A nil *extractManifest is valid, and records
+// nothing (all of its methods are no-ops), which is what Extract uses when it
+// isn't writing a manifest.
+type extractManifest struct {
+	ctx       context.Context
+	objClient obj.Client
+	object    string
+
+	manifest *admin.BackupManifest
+	// entries holds manifest.Entries, plus the entries of the current segment
+	entries map[string]bool
+	// pending holds the entries of the current segment, which are added to
+	// manifest.Entries once the segment is complete
+	pending []string
+
+	// The current segment (nil until the first op is written to it)
+	objW    io.WriteCloser
+	snappyW *snappy.Writer
+	w       pbutil.Writer
+	written int64
+}
+
+// newExtractManifest starts (or, if 'request.Resume' is set, resumes) an extract
+// to 'object' that's written with a manifest, at 'etcdRevision'. If 'base' is
+// set, the new extract is incremental to it.
+func newExtractManifest(ctx context.Context, objClient obj.Client, object string, request *admin.ExtractRequest, base *admin.BackupManifest, etcdRevision int64) (*extractManifest, error) {
+	m := &extractManifest{
+		ctx:       ctx,
+		objClient: objClient,
+		object:    object,
+		entries:   make(map[string]bool),
+	}
+	if request.Resume {
+		manifest, err := readManifest(ctx, objClient, object)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not resume extract")
+		}
+		if manifest.Complete {
+			return nil, errors.Errorf("extract to %s is already complete", request.URL)
+		}
+		m.manifest = manifest
+	} else {
+		if objClient.Exists(ctx, manifestObject(object)) {
+			return nil, errors.Errorf("%s already has a manifest (set resume to continue an interrupted extract)", request.URL)
+		}
+		m.manifest = &admin.BackupManifest{
+			ID:           uuid.NewWithoutDashes(),
+			EtcdRevision: etcdRevision,
+			Started:      types.TimestampNow(),
+		}
+		if base != nil {
+			m.manifest.BaseID = base.ID
+			m.manifest.BaseEtcdRevision = base.EtcdRevision
+		}
+	}
+	for _, e := range m.manifest.Entries {
+		m.entries[e] = true
+	}
+	return m, nil
+}
+
+// has returns true if 'entry' has already been written by this extract
+func (m *extractManifest) has(entry string) bool {
+	if m == nil {
+		return false
+	}
+	return m.entries[entry]
+}
+
+// writeOp writes 'op' to the current segment, starting a new segment if needed
+func (m *extractManifest) writeOp(op *admin.Op) error {
+	if m.w == nil {
+		name := segmentObject(m.object, m.manifest.Segments)
+		// Clear out any partial segment left by an interrupted extract
+		if m.objClient.Exists(m.ctx, name) {
+			if err := m.objClient.Delete(m.ctx, name); err != nil {
+				return err
+			}
+		}
+		objW, err := m.objClient.Writer(m.ctx, name)
+		if err != nil {
+			return err
+		}
+		m.objW = objW
+		m.snappyW = snappy.NewBufferedWriter(objW)
+		m.w = pbutil.NewWriter(m.snappyW)
+		m.written = 0
+	}
+	n, err := m.w.Write(op)
+	m.written += n
+	return err
+}
+
+// done records that 'entries' have been completely written to the current
+// segment, and completes the segment if it's full
+func (m *extractManifest) done(entries ...string) error {
+	if m == nil {
+		return nil
+	}
+	for _, e := range entries {
+		m.entries[e] = true
+	}
+	m.pending = append(m.pending, entries...)
+	if m.written < segmentBytes {
+		return nil
+	}
+	return m.completeSegment()
+}
+
+// completeSegment closes the current segment (if any) and writes a manifest
+// that includes it
+func (m *extractManifest) completeSegment() error {
+	if m.w == nil {
+		return nil
+	}
+	if err := m.snappyW.Close(); err != nil {
+		return err
+	}
+	if err := m.objW.Close(); err != nil {
+		return err
+	}
+	m.w, m.snappyW, m.objW = nil, nil, nil
+	m.manifest.Segments++
+	m.manifest.Entries = append(m.manifest.Entries, m.pending...)
+	m.pending = nil
+	return writeProto(m.ctx, m.objClient, manifestObject(m.object), m.manifest)
+}
+
+// finish completes the last segment and marks the extract as complete
+func (m *extractManifest) finish() error {
+	if m == nil {
+		return nil
+	}
+	if err := m.completeSegment(); err != nil {
+		return err
+	}
+	m.manifest.Complete = true
+	m.manifest.Finished = types.TimestampNow()
+	m.manifest.Entries = nil
+	return writeProto(m.ctx, m.objClient, manifestObject(m.object), m.manifest)
+}
+
+// readManifest reads the manifest of the extract at 'object'
+func readManifest(ctx context.Context, objClient obj.Client, object string) (*admin.BackupManifest, error) {
+	manifest := &admin.BackupManifest{}
+	if err := readProto(ctx, objClient, manifestObject(object), manifest); err != nil {
+		return nil, errors.Wrapf(err, "could not read manifest of %s", object)
+	}
+	return manifest, nil
+}
+
+// readProto reads 'pb' from 'name' in object storage
+func readProto(ctx context.Context, objClient obj.Client, name string, pb proto.Message) (retErr error) {
+	r, err := objClient.Reader(ctx, name, 0, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	return errors.EnsureStack(proto.Unmarshal(data, pb))
+}
+
+// writeProto writes 'pb' to 'name' in object storage, replacing any existing
+// object
+func writeProto(ctx context.Context, objClient obj.Client, name string, pb proto.Message) (retErr error) {
+	data, err := proto.Marshal(pb)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	if objClient.Exists(ctx, name) {
+		if err := objClient.Delete(ctx, name); err != nil {
+			return err
+		}
+	}
+	w, err := objClient.Writer(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	_, err = w.Write(data)
+	return errors.EnsureStack(err)
+}
@@ -0,0 +1,76 @@
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"golang.org/x/net/context"
+)
+
+func TestExtractManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "extract-manifest")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	objClient, err := obj.NewLocalClient(dir)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Write a full extract with a manifest
+	repo := client.NewRepo("images")
+	request := &admin.ExtractRequest{URL: "local://backup-0", Manifest: true}
+	m, err := newExtractManifest(ctx, objClient, "backup-0", request, nil, 10)
+	require.NoError(t, err)
+	require.NoError(t, m.writeOp(&admin.Op{Op1_12: &admin.Op1_12{Repo: &pfs.CreateRepoRequest{Repo: repo}}}))
+	require.NoError(t, m.done(repoEntry(repo)))
+	require.True(t, m.has(repoEntry(repo)))
+	require.NoError(t, m.finish())
+
+	base, err := readManifest(ctx, objClient, "backup-0")
+	require.NoError(t, err)
+	require.True(t, base.Complete)
+	require.Equal(t, int64(1), base.Segments)
+	require.Equal(t, int64(10), base.EtcdRevision)
+	// Entries are only needed to resume an extract, so a complete one has none
+	require.Equal(t, 0, len(base.Entries))
+	require.True(t, objClient.Exists(ctx, segmentObject("backup-0", 0)))
+
+	// Complete extracts can't be resumed or overwritten
+	request.Resume = true
+	_, err = newExtractManifest(ctx, objClient, "backup-0", request, nil, 10)
+	require.YesError(t, err)
+	request.Resume = false
+	_, err = newExtractManifest(ctx, objClient, "backup-0", request, nil, 10)
+	require.YesError(t, err)
+
+	// An incremental extract records its base's revision (rather than its
+	// entries), and an interrupted one can be resumed from its last complete
+	// segment
+	commit := client.NewCommit("images", "abc")
+	request = &admin.ExtractRequest{URL: "local://backup-1", BaseURL: "local://backup-0"}
+	m, err = newExtractManifest(ctx, objClient, "backup-1", request, base, 20)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), m.manifest.BaseEtcdRevision)
+	require.False(t, m.has(repoEntry(repo)))
+	require.NoError(t, m.writeOp(&admin.Op{Op1_12: &admin.Op1_12{Repo: &pfs.CreateRepoRequest{Repo: repo}}}))
+	require.NoError(t, m.done(repoEntry(repo)))
+	require.NoError(t, m.writeOp(&admin.Op{Op1_12: &admin.Op1_12{Commit: &pfs.BuildCommitRequest{Parent: commit}}}))
+	require.NoError(t, m.completeSegment())
+	require.NoError(t, m.writeOp(&admin.Op{Op1_12: &admin.Op1_12{Commit: &pfs.BuildCommitRequest{Parent: commit}}}))
+	require.NoError(t, m.done(commitEntry(commit)))
+
+	request.Resume = true
+	m, err = newExtractManifest(ctx, objClient, "backup-1", request, nil, 30)
+	require.NoError(t, err)
+	require.Equal(t, base.ID, m.manifest.BaseID)
+	require.Equal(t, int64(10), m.manifest.BaseEtcdRevision)
+	require.Equal(t, int64(20), m.manifest.EtcdRevision)
+	require.Equal(t, int64(1), m.manifest.Segments)
+	require.True(t, m.has(repoEntry(repo)))
+	require.False(t, m.has(commitEntry(commit)))
+}
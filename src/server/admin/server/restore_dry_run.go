@@ -0,0 +1,336 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	pfs1_7 "github.com/pachyderm/pachyderm/src/client/admin/v1_7/pfs"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+// restoreDryRun records what a restore would do, and the problems that it
+// would have, without restoring anything
+type restoreDryRun struct {
+	report *admin.RestoreReport
+	// objects, blocks, repos, commits and pipelines hold what's in the extract
+	// (commits are keyed by "repo@id")
+	objects   map[string]bool
+	blocks    map[string]bool
+	repos     map[string]bool
+	commits   map[string]bool
+	pipelines map[string]bool
+	// objectRefs and blockRefs map the objects and blocks that ops refer to,
+	// to the first op that refers to each. They're checked once every op has
+	// been read, as objects aren't always extracted before what refers to them.
+	objectRefs map[string]string
+	blockRefs  map[string]string
+	// warned holds warnings that are only reported once per dry run
+	warned map[string]bool
+	// authActive is nil until it's been checked
+	authActive *bool
+}
+
+func newRestoreDryRun() *restoreDryRun {
+	return &restoreDryRun{
+		report:     &admin.RestoreReport{},
+		objects:    make(map[string]bool),
+		blocks:     make(map[string]bool),
+		repos:      make(map[string]bool),
+		commits:    make(map[string]bool),
+		pipelines:  make(map[string]bool),
+		objectRefs: make(map[string]string),
+		blockRefs:  make(map[string]string),
+		warned:     make(map[string]bool),
+	}
+}
+
+func (d *restoreDryRun) issue(severity admin.RestoreIssue_Severity, op string, format string, args ...interface{}) {
+	d.report.Issues = append(d.report.Issues, &admin.RestoreIssue{
+		Severity: severity,
+		Op:       op,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// warnOnce reports a warning about the extract as a whole, unless it's already
+// been reported
+func (d *restoreDryRun) warnOnce(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if !d.warned[msg] {
+		d.warned[msg] = true
+		d.issue(admin.RestoreIssue_WARNING, "", "%s", msg)
+	}
+}
+
+func (d *restoreDryRun) refObject(object *pfs.Object, op string) {
+	if object == nil || object.Hash == "" {
+		return
+	}
+	if _, ok := d.objectRefs[object.Hash]; !ok {
+		d.objectRefs[object.Hash] = op
+	}
+}
+
+// putObject reads an object from 'r', and records its hash and size
+func (d *restoreDryRun) putObject(r io.Reader) error {
+	hash := pfs.NewHash()
+	n, err := io.Copy(hash, r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	d.objects[pfs.EncodeHash(hash.Sum(nil))] = true
+	d.report.Objects++
+	d.report.DataBytes += uint64(n)
+	return nil
+}
+
+// putBlock reads the block 'hash' from 'r', and records its size
+func (d *restoreDryRun) putBlock(hash string, r io.Reader) error {
+	n, err := io.Copy(ioutil.Discard, r)
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	d.blocks[hash] = true
+	d.report.Blocks++
+	d.report.DataBytes += uint64(n)
+	return nil
+}
+
+// commit1_7 records a commit from a 1.7 extract. Its hashtree is converted
+// during a restore, which a dry run can't do.
+func (d *restoreDryRun) commit1_7(commit *pfs1_7.BuildCommitRequest) {
+	d.report.Commits++
+	if commit.Tree != nil {
+		d.refObject(&pfs.Object{Hash: commit.Tree.Hash}, "commit "+commit.ID)
+	}
+	d.warnOnce("the hashtrees of 1.7 commits are converted during a restore, and a dry run doesn't check them")
+}
+
+// checkOp checks that 'op' can be restored, without restoring it
+func (r *restoreCtx) checkOp(op *admin.Op1_12) error {
+	d := r.dryRun
+	c := r.pachClient
+	// hasRepo and hasCommit return true if a repo or commit is in the extract
+	// or in the cluster
+	hasRepo := func(name string) (bool, error) {
+		if d.repos[name] || name == ppsconsts.SpecRepo {
+			return true, nil
+		}
+		if _, err := c.InspectRepo(name); err != nil {
+			if errutil.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+	hasCommit := func(commit *pfs.Commit) (bool, error) {
+		if commit.ID == "" || d.commits[commit.Repo.Name+"@"+commit.ID] {
+			return true, nil
+		}
+		if _, err := c.InspectCommit(commit.Repo.Name, commit.ID); err != nil {
+			if errutil.IsNotFoundError(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+	switch {
+	case op.CreateObject != nil:
+		d.objects[op.CreateObject.Object.Hash] = true
+		d.report.Objects++
+		if ref := op.CreateObject.BlockRef; ref != nil && ref.Block != nil {
+			if _, ok := d.blockRefs[ref.Block.Hash]; !ok {
+				d.blockRefs[ref.Block.Hash] = "object " + op.CreateObject.Object.Hash
+			}
+		}
+	case op.Tag != nil:
+		d.refObject(op.Tag.Object, "tag")
+	case op.Repo != nil:
+		name := ancestry.SanitizeName(op.Repo.Repo.Name)
+		desc := "repo " + name
+		d.repos[name] = true
+		d.report.Repos++
+		if name == ppsconsts.SpecRepo {
+			return nil
+		}
+		if err := ancestry.ValidateName(name); err != nil {
+			d.issue(admin.RestoreIssue_ERROR, desc, "invalid repo name: %v", err)
+		}
+		if _, err := c.InspectRepo(name); err == nil {
+			switch r.onConflict {
+			case admin.ConflictPolicy_MERGE:
+				d.issue(admin.RestoreIssue_WARNING, desc, "repo already exists, and will be restored into")
+			case admin.ConflictPolicy_FAIL:
+				d.issue(admin.RestoreIssue_ERROR, desc, "repo already exists")
+			case admin.ConflictPolicy_SKIP:
+				d.issue(admin.RestoreIssue_WARNING, desc, "repo already exists, so it (and everything in it) will not be restored")
+				r.skipRepos[name] = true
+			}
+		} else if !errutil.IsNotFoundError(err) {
+			return err
+		}
+	case op.Commit != nil:
+		commit := op.Commit
+		if commit.Parent == nil || commit.Parent.Repo == nil {
+			return errors.Errorf("commit %s has no parent (or repo)", commit.ID)
+		}
+		desc := fmt.Sprintf("commit %s@%s", commit.Parent.Repo.Name, commit.ID)
+		d.commits[commit.Parent.Repo.Name+"@"+commit.ID] = true
+		d.report.Commits++
+		d.report.CommitBytes += commit.SizeBytes
+		if ok, err := hasRepo(commit.Parent.Repo.Name); err != nil {
+			return err
+		} else if !ok {
+			d.issue(admin.RestoreIssue_ERROR, desc, "repo %q is not in the extract or the cluster", commit.Parent.Repo.Name)
+		}
+		if ok, err := hasCommit(commit.Parent); err != nil {
+			return err
+		} else if !ok {
+			d.issue(admin.RestoreIssue_ERROR, desc, "parent commit %s is not in the extract (before this commit) or the cluster", commit.Parent.ID)
+		}
+		for _, p := range commit.Provenance {
+			if ok, err := hasCommit(p.Commit); err != nil {
+				return err
+			} else if !ok {
+				d.issue(admin.RestoreIssue_ERROR, desc, "provenant commit %s@%s is not in the extract (before this commit) or the cluster", p.Commit.Repo.Name, p.Commit.ID)
+			}
+		}
+		d.refObject(commit.Tree, desc)
+		for _, tree := range commit.Trees {
+			d.refObject(tree, desc)
+		}
+		d.refObject(commit.Datums, desc)
+	case op.Branch != nil:
+		d.report.Branches++
+		if op.Branch.Head != nil {
+			desc := fmt.Sprintf("branch %s@%s", op.Branch.Head.Repo.Name, op.Branch.Head.ID)
+			if op.Branch.Branch != nil {
+				desc = fmt.Sprintf("branch %s@%s", op.Branch.Branch.Repo.Name, op.Branch.Branch.Name)
+			}
+			if ok, err := hasCommit(op.Branch.Head); err != nil {
+				return err
+			} else if !ok {
+				d.issue(admin.RestoreIssue_ERROR, desc, "head commit %s is not in the extract or the cluster", op.Branch.Head.ID)
+			}
+		}
+	case op.Pipeline != nil:
+		sanitizePipeline(op.Pipeline)
+		desc := "pipeline " + op.Pipeline.Pipeline.Name
+		d.pipelines[op.Pipeline.Pipeline.Name] = true
+		d.report.Pipelines++
+		// Validate the spec as CreatePipeline would (except that its inputs
+		// needn't exist yet, as they may be in the extract)
+		req := *op.Pipeline
+		req.DryRun = true
+		if _, err := c.PpsAPIClient.CreatePipeline(c.Ctx(), &req); err != nil {
+			d.issue(admin.RestoreIssue_ERROR, desc, "invalid pipeline spec: %v", grpcutil.ScrubGRPC(err))
+		}
+		var inputs []string
+		for _, b := range pipelineInputRepos(op.Pipeline) {
+			if ok, err := hasRepo(b); err != nil {
+				return err
+			} else if !ok {
+				inputs = append(inputs, b)
+			}
+		}
+		if len(inputs) > 0 {
+			sort.Strings(inputs)
+			d.issue(admin.RestoreIssue_ERROR, desc, "input repos %v are not in the extract or the cluster", inputs)
+		}
+	case op.Job != nil:
+		d.report.Jobs++
+		name := op.Job.Pipeline.Name
+		if !d.pipelines[name] {
+			if _, err := c.InspectPipeline(name); err != nil {
+				d.issue(admin.RestoreIssue_ERROR, fmt.Sprintf("job %s@%s", name, op.Job.OutputCommit.ID),
+					"pipeline %q is not in the extract (before this job) or the cluster", name)
+			}
+		}
+	case op.Acl != nil:
+		d.report.Acls++
+		if d.authActive == nil {
+			_, err := c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{})
+			if err != nil && !auth.IsErrNotActivated(err) {
+				return grpcutil.ScrubGRPC(err)
+			}
+			active := err == nil
+			d.authActive = &active
+		}
+		if !*d.authActive {
+			d.warnOnce("auth is not activated, so ACLs will not be restored")
+		}
+	case op.Tombstone != nil:
+		d.report.Tombstones++
+	}
+	return nil
+}
+
+// pipelineInputRepos returns the input repos of the pipeline in 'req'
+func pipelineInputRepos(req *pps.CreatePipelineRequest) []string {
+	var repos []string
+	pps.VisitInput(req.Input, func(input *pps.Input) {
+		if input.Pfs != nil {
+			repos = append(repos, input.Pfs.Repo)
+		}
+	})
+	return repos
+}
+
+// finishDryRun checks that every object and block referred to by the extract
+// is in the extract or the cluster, and returns the dry run's report
+func (r *restoreCtx) finishDryRun() (*admin.RestoreReport, error) {
+	d := r.dryRun
+	c := r.pachClient
+	d.report.Version = r.streamVersion.String()
+	var missing []string
+	for hash := range d.objectRefs {
+		if d.objects[hash] {
+			continue
+		}
+		resp, err := c.ObjectAPIClient.CheckObject(c.Ctx(), &pfs.CheckObjectRequest{Object: &pfs.Object{Hash: hash}})
+		if err != nil {
+			return nil, grpcutil.ScrubGRPC(err)
+		}
+		if !resp.Exists {
+			missing = append(missing, hash)
+		}
+	}
+	sort.Strings(missing)
+	for _, hash := range missing {
+		d.issue(admin.RestoreIssue_ERROR, d.objectRefs[hash], "object %s is not in the extract or the cluster", hash)
+	}
+	missing = missing[:0]
+	for hash := range d.blockRefs {
+		if !d.blocks[hash] {
+			missing = append(missing, hash)
+		}
+	}
+	if len(missing) > 0 {
+		// Blocks that aren't in the extract may already be in the cluster
+		if err := c.ListBlock(func(block *pfs.Block) error {
+			d.blocks[block.Hash] = true
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(missing)
+	for _, hash := range missing {
+		if !d.blocks[hash] {
+			d.issue(admin.RestoreIssue_ERROR, d.blockRefs[hash], "block %s is not in the extract or the cluster", hash)
+		}
+	}
+	return d.report, nil
+}
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	pfs1_7 "github.com/pachyderm/pachyderm/src/client/admin/v1_7/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestRestoreDryRunRecordsData(t *testing.T) {
+	d := newRestoreDryRun()
+
+	// Objects are identified by the hash of their content, as PutObject does
+	require.NoError(t, d.putObject(strings.NewReader("foo")))
+	hash := pfs.NewHash()
+	hash.Write([]byte("foo"))
+	require.True(t, d.objects[pfs.EncodeHash(hash.Sum(nil))])
+
+	require.NoError(t, d.putBlock("block", bytes.NewReader(make([]byte, 10))))
+	require.True(t, d.blocks["block"])
+	require.Equal(t, int64(1), d.report.Objects)
+	require.Equal(t, int64(1), d.report.Blocks)
+	require.Equal(t, uint64(13), d.report.DataBytes)
+
+	// 1.7 commits refer to their trees, and warn (once) that the trees aren't
+	// checked
+	for _, id := range []string{"a", "b"} {
+		d.commit1_7(&pfs1_7.BuildCommitRequest{
+			ID:   id,
+			Tree: &pfs1_7.Object{Hash: "tree-" + id},
+		})
+	}
+	require.Equal(t, int64(2), d.report.Commits)
+	require.Equal(t, "commit a", d.objectRefs["tree-a"])
+	require.Equal(t, 1, len(d.report.Issues))
+}
@@ -0,0 +1,243 @@
+package server
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+
+	"github.com/sirupsen/logrus"
+)
+
+// restoreRenames maps the names of repos and pipelines in an extract to the
+// names that they're restored as
+type restoreRenames struct {
+	repos     map[string]string
+	pipelines map[string]string
+}
+
+// repo returns the name that the repo 'name' is restored as. A pipeline's
+// output repo is renamed along with the pipeline.
+func (rn *restoreRenames) repo(name string) string {
+	if newName, ok := rn.repos[name]; ok {
+		return newName
+	}
+	return rn.pipeline(name)
+}
+
+// pipeline returns the name that the pipeline 'name' is restored as
+func (rn *restoreRenames) pipeline(name string) string {
+	if newName, ok := rn.pipelines[name]; ok {
+		return newName
+	}
+	return name
+}
+
+// commit and branch replace (rather than modify) the repo of what they
+// rename, as commits and branches may share a *pfs.Repo
+func (rn *restoreRenames) commit(c *pfs.Commit) {
+	if c != nil && c.Repo != nil {
+		c.Repo = &pfs.Repo{Name: rn.repo(c.Repo.Name)}
+	}
+}
+
+// Branches in the spec repo are named after pipelines, so they're renamed along
+// with the pipeline
+func (rn *restoreRenames) branch(b *pfs.Branch) {
+	if b == nil || b.Repo == nil {
+		return
+	}
+	if b.Repo.Name == ppsconsts.SpecRepo {
+		b.Name = rn.pipeline(b.Name)
+	}
+	b.Repo = &pfs.Repo{Name: rn.repo(b.Repo.Name)}
+}
+
+// op renames everything that 'op' refers to
+func (rn *restoreRenames) op(op *admin.Op1_12) {
+	if len(rn.repos) == 0 && len(rn.pipelines) == 0 {
+		return
+	}
+	switch {
+	case op.Repo != nil:
+		op.Repo.Repo.Name = rn.repo(op.Repo.Repo.Name)
+	case op.Commit != nil:
+		if op.Commit.Parent != nil && op.Commit.Parent.Repo.Name == ppsconsts.SpecRepo && op.Commit.Branch != "" {
+			op.Commit.Branch = rn.pipeline(op.Commit.Branch)
+		}
+		rn.commit(op.Commit.Parent)
+		for _, p := range op.Commit.Provenance {
+			rn.commit(p.Commit)
+			rn.branch(p.Branch)
+		}
+	case op.Branch != nil:
+		rn.commit(op.Branch.Head)
+		rn.branch(op.Branch.Branch)
+		for _, b := range op.Branch.Provenance {
+			rn.branch(b)
+		}
+	case op.Pipeline != nil:
+		rn.pipelineRequest(op.Pipeline)
+	case op.Job != nil:
+		op.Job.Pipeline.Name = rn.pipeline(op.Job.Pipeline.Name)
+		rn.commit(op.Job.OutputCommit)
+		rn.commit(op.Job.StatsCommit)
+	case op.Acl != nil:
+		if op.Acl.Repo != "" {
+			op.Acl.Repo = rn.repo(op.Acl.Repo)
+		}
+		if op.Acl.Pipeline != "" {
+			op.Acl.Pipeline = rn.pipeline(op.Acl.Pipeline)
+		}
+	case op.Tombstone != nil:
+		t := op.Tombstone
+		if t.Repo != nil {
+			t.Repo = &pfs.Repo{Name: rn.repo(t.Repo.Name)}
+		}
+		if t.Pipeline != nil {
+			t.Pipeline = &pps.Pipeline{Name: rn.pipeline(t.Pipeline.Name)}
+		}
+		rn.commit(t.Commit)
+		rn.branch(t.Branch)
+	}
+}
+
+// pipelineRequest renames the pipeline in 'req' and its inputs. Renamed inputs
+// keep their old names inside the pipeline's containers, so that the
+// pipeline's code doesn't need to change.
+func (rn *restoreRenames) pipelineRequest(req *pps.CreatePipelineRequest) {
+	renamed := false
+	rename := func(name string) string {
+		if newName := rn.repo(name); newName != name {
+			renamed = true
+			return newName
+		}
+		return name
+	}
+	if newName := rn.pipeline(req.Pipeline.Name); newName != req.Pipeline.Name {
+		req.Pipeline.Name = newName
+		renamed = true
+	}
+	pps.VisitInput(req.Input, func(input *pps.Input) {
+		switch {
+		case input.Pfs != nil:
+			if input.Pfs.Name == "" {
+				input.Pfs.Name = input.Pfs.Repo
+			}
+			input.Pfs.Repo = rename(input.Pfs.Repo)
+		case input.Cron != nil:
+			input.Cron.Repo = rename(input.Cron.Repo)
+		case input.Git != nil:
+			input.Git.Name = rename(input.Git.Name)
+		}
+	})
+	// The spec commit of a renamed pipeline still refers to its old names, so
+	// it's restored with a new spec commit (as if it had been extracted
+	// without one)
+	if renamed {
+		req.SpecCommit = nil
+	}
+}
+
+// addRepo buffers 'req' so that it's created along with the repos around it in
+// the stream, which lets conflicts be detected before any of them are created
+func (r *restoreCtx) addRepo(req *pfs.CreateRepoRequest) {
+	r.pendingRepos = append(r.pendingRepos, req)
+}
+
+// flushRepos creates the repos buffered by addRepo, handling repos that
+// already exist according to the restore's conflict policy
+func (r *restoreCtx) flushRepos() error {
+	if len(r.pendingRepos) == 0 {
+		return nil
+	}
+	c := r.pachClient
+	pending := r.pendingRepos
+	r.pendingRepos = nil
+	if r.onConflict != admin.ConflictPolicy_MERGE {
+		var conflicts []string
+		for _, req := range pending {
+			// The spec repo always exists, and repos restored from earlier
+			// extracts in this restore aren't conflicts
+			if req.Repo.Name == ppsconsts.SpecRepo || r.restoredRepos[req.Repo.Name] {
+				continue
+			}
+			if _, err := c.InspectRepo(req.Repo.Name); err == nil {
+				conflicts = append(conflicts, req.Repo.Name)
+			} else if !errutil.IsNotFoundError(err) {
+				return err
+			}
+		}
+		if len(conflicts) > 0 && r.onConflict == admin.ConflictPolicy_FAIL {
+			sort.Strings(conflicts)
+			return errors.Errorf("cannot restore, as these repos already exist: %s (rename them or set a different conflict policy)",
+				strings.Join(conflicts, ", "))
+		}
+		for _, name := range conflicts {
+			logrus.Warnf("repo %q already exists, so it (and everything in it) will not be restored", name)
+			r.skipRepos[name] = true
+		}
+	}
+	for _, req := range pending {
+		if r.skipRepos[req.Repo.Name] {
+			continue
+		}
+		if _, err := c.PfsAPIClient.CreateRepo(c.Ctx(), req); err != nil && !errutil.IsAlreadyExistError(err) {
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error creating repo")
+		}
+		r.restoredRepos[req.Repo.Name] = true
+	}
+	return nil
+}
+
+// skipOp returns true if 'op' refers to a repo that isn't being restored
+// because it already existed. Provenance on skipped repos is removed from ops
+// that aren't skipped.
+func (r *restoreCtx) skipOp(op *admin.Op1_12) bool {
+	if len(r.skipRepos) == 0 {
+		return false
+	}
+	skipCommit := func(c *pfs.Commit) bool { return c != nil && c.Repo != nil && r.skipRepos[c.Repo.Name] }
+	skipBranch := func(b *pfs.Branch) bool { return b != nil && b.Repo != nil && r.skipRepos[b.Repo.Name] }
+	switch {
+	case op.Commit != nil:
+		if skipCommit(op.Commit.Parent) {
+			return true
+		}
+		provenance := op.Commit.Provenance[:0]
+		for _, p := range op.Commit.Provenance {
+			if !skipCommit(p.Commit) {
+				provenance = append(provenance, p)
+			}
+		}
+		op.Commit.Provenance = provenance
+	case op.Branch != nil:
+		if skipBranch(op.Branch.Branch) || skipCommit(op.Branch.Head) {
+			return true
+		}
+		provenance := op.Branch.Provenance[:0]
+		for _, b := range op.Branch.Provenance {
+			if !skipBranch(b) {
+				provenance = append(provenance, b)
+			}
+		}
+		op.Branch.Provenance = provenance
+	case op.Pipeline != nil:
+		return r.skipRepos[op.Pipeline.Pipeline.Name]
+	case op.Job != nil:
+		return r.skipRepos[op.Job.Pipeline.Name]
+	case op.Acl != nil:
+		return r.skipRepos[op.Acl.Repo] || r.skipRepos[op.Acl.Pipeline]
+	case op.Tombstone != nil:
+		t := op.Tombstone
+		return (t.Repo != nil && r.skipRepos[t.Repo.Name]) || skipCommit(t.Commit) ||
+			skipBranch(t.Branch) || (t.Pipeline != nil && r.skipRepos[t.Pipeline.Name])
+	}
+	return false
+}
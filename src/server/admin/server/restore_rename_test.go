@@ -0,0 +1,75 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+func TestRestoreRenames(t *testing.T) {
+	rn := &restoreRenames{
+		repos:     map[string]string{"images": "images-v2"},
+		pipelines: map[string]string{"edges": "edges-v2"},
+	}
+	require.Equal(t, "images-v2", rn.repo("images"))
+	require.Equal(t, "edges-v2", rn.repo("edges"))
+	require.Equal(t, "other", rn.repo("other"))
+
+	// Commit provenance on a renamed repo and a renamed pipeline's spec branch
+	commit := &admin.Op1_12{Commit: &pfs.BuildCommitRequest{
+		Parent: client.NewCommit("edges", ""),
+		Provenance: []*pfs.CommitProvenance{
+			client.NewCommitProvenance("images", "master", "abc"),
+			client.NewCommitProvenance(ppsconsts.SpecRepo, "edges", "def"),
+		},
+	}}
+	rn.op(commit)
+	require.Equal(t, "edges-v2", commit.Commit.Parent.Repo.Name)
+	require.Equal(t, "images-v2", commit.Commit.Provenance[0].Commit.Repo.Name)
+	require.Equal(t, "images-v2", commit.Commit.Provenance[0].Branch.Repo.Name)
+	require.Equal(t, ppsconsts.SpecRepo, commit.Commit.Provenance[1].Branch.Repo.Name)
+	require.Equal(t, "edges-v2", commit.Commit.Provenance[1].Branch.Name)
+
+	// Renamed inputs keep their old names inside the pipeline, and the
+	// pipeline gets a new spec commit
+	pipeline := &admin.Op1_12{Pipeline: &pps.CreatePipelineRequest{
+		Pipeline:   client.NewPipeline("edges"),
+		Input:      client.NewCrossInput(client.NewPFSInput("images", "/*"), client.NewPFSInput("other", "/")),
+		SpecCommit: client.NewCommit(ppsconsts.SpecRepo, "def"),
+	}}
+	rn.op(pipeline)
+	require.Equal(t, "edges-v2", pipeline.Pipeline.Pipeline.Name)
+	require.Equal(t, "images-v2", pipeline.Pipeline.Input.Cross[0].Pfs.Repo)
+	require.Equal(t, "images", pipeline.Pipeline.Input.Cross[0].Pfs.Name)
+	require.Equal(t, "other", pipeline.Pipeline.Input.Cross[1].Pfs.Repo)
+	require.Nil(t, pipeline.Pipeline.SpecCommit)
+
+	// Unrenamed pipelines keep their spec commit
+	pipeline = &admin.Op1_12{Pipeline: &pps.CreatePipelineRequest{
+		Pipeline:   client.NewPipeline("stats"),
+		Input:      client.NewPFSInput("other", "/*"),
+		SpecCommit: client.NewCommit(ppsconsts.SpecRepo, "ghi"),
+	}}
+	rn.op(pipeline)
+	require.NotNil(t, pipeline.Pipeline.SpecCommit)
+}
+
+func TestRestoreSkipRepos(t *testing.T) {
+	r := &restoreCtx{skipRepos: map[string]bool{"images": true}}
+	require.True(t, r.skipOp(&admin.Op1_12{Commit: &pfs.BuildCommitRequest{Parent: client.NewCommit("images", "")}}))
+	require.True(t, r.skipOp(&admin.Op1_12{Pipeline: &pps.CreatePipelineRequest{Pipeline: client.NewPipeline("images")}}))
+
+	// Provenance on skipped repos is dropped from ops that aren't skipped
+	branch := &admin.Op1_12{Branch: &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch("edges", "master"),
+		Provenance: []*pfs.Branch{client.NewBranch("images", "master"), client.NewBranch("other", "master")},
+	}}
+	require.False(t, r.skipOp(branch))
+	require.Equal(t, 1, len(branch.Branch.Provenance))
+	require.Equal(t, "other", branch.Branch.Provenance[0].Repo.Name)
+}
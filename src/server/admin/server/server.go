@@ -3,6 +3,8 @@ package server
 import (
 	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+
+	etcd "github.com/coreos/etcd/clientv3"
 )
 
 // APIServer represents and APIServer
@@ -10,12 +12,17 @@ type APIServer interface {
 	admin.APIServer
 }
 
-// NewAPIServer returns a new admin.APIServer
-func NewAPIServer(address string, storageRoot string, clusterInfo *admin.ClusterInfo) APIServer {
+// NewAPIServer returns a new admin.APIServer. 'pfsEtcdPrefix' and
+// 'ppsEtcdPrefix' are where PFS and PPS store their metadata in etcd, which
+// incremental extracts compare with that of the extract they're based on.
+func NewAPIServer(address string, storageRoot string, clusterInfo *admin.ClusterInfo, etcdClient *etcd.Client, pfsEtcdPrefix, ppsEtcdPrefix string) APIServer {
 	return &apiServer{
-		Logger:      log.NewLogger("admin.API"),
-		address:     address,
-		storageRoot: storageRoot,
-		clusterInfo: clusterInfo,
+		Logger:        log.NewLogger("admin.API"),
+		address:       address,
+		storageRoot:   storageRoot,
+		clusterInfo:   clusterInfo,
+		etcdClient:    etcdClient,
+		pfsEtcdPrefix: pfsEtcdPrefix,
+		ppsEtcdPrefix: ppsEtcdPrefix,
 	}
 }
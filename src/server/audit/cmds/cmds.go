@@ -0,0 +1,86 @@
+package cmds
+
+import (
+	"os"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/server/audit/pretty"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
+	"github.com/spf13/cobra"
+)
+
+// Cmds returns a slice containing audit commands.
+func Cmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	var user string
+	var resource string
+	var since string
+	var raw bool
+	var fullTimestamps bool
+	listEvents := &cobra.Command{
+		Short: "Return the audit log of mutating API calls.",
+		Long: "Return the audit log of mutating API calls, oldest first. Only " +
+			"cluster admins may read the audit log, and pachd must be configured " +
+			"with a readable audit sink (the \"repo\" sink or a file).",
+		Example: `
+# Return every audited call
+$ {{alias}}
+
+# Return the calls made by alice in the last day
+$ {{alias}} --user github:alice --since 24h
+
+# Return the calls that modified the "images" repo, or its commits, branches
+# or files
+$ {{alias}} --resource images
+
+# Return the calls that modified the master branch of "images"
+$ {{alias}} --resource branch:images@master`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			var sinceTime time.Time
+			if since != "" {
+				var err error
+				if sinceTime, err = cmdutil.ParseSince(since); err != nil {
+					return err
+				}
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			if raw {
+				marshaller := &jsonpb.Marshaler{Indent: "  "}
+				return c.ListAuditEvents(user, resource, sinceTime, func(e *audit.Event) error {
+					return marshaller.Marshal(os.Stdout, e)
+				})
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.EventHeader)
+			if err := c.ListAuditEvents(user, resource, sinceTime, func(e *audit.Event) error {
+				pretty.PrintEvent(writer, e, fullTimestamps)
+				return nil
+			}); err != nil {
+				return err
+			}
+			return writer.Flush()
+		}),
+	}
+	listEvents.Flags().StringVarP(&user, "user", "u", "", "Only return calls made by this principal (e.g. github:alice).")
+	listEvents.Flags().StringVarP(&resource, "resource", "r", "", "Only return calls that targeted this resource, either a repo or pipeline name, or a qualified resource such as repo:images or commit:images@master.")
+	listEvents.Flags().StringVar(&since, "since", "", "Only return calls made after this time, either a duration before now (e.g. 24h) or an RFC 3339 timestamp.")
+	listEvents.Flags().BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	listEvents.Flags().BoolVar(&fullTimestamps, "full-timestamps", false, "Return absolute timestamps (as opposed to the default, relative timestamps).")
+	commands = append(commands, cmdutil.CreateAlias(listEvents, "audit list"))
+
+	auditDocs := &cobra.Command{
+		Short: "Audit commands for reviewing the calls made to the cluster.",
+		Long:  "Audit commands for reviewing the calls made to the cluster.",
+	}
+	commands = append(commands, cmdutil.CreateAlias(auditDocs, "audit"))
+
+	return commands
+}
@@ -0,0 +1,37 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+)
+
+const (
+	// EventHeader is the header for audit events.
+	EventHeader = "TIME\tPRINCIPAL\tRPC\tRESOURCES\tRESULT\t\n"
+)
+
+// PrintEvent pretty-prints an audit event.
+func PrintEvent(w io.Writer, event *audit.Event, fullTimestamps bool) {
+	if fullTimestamps {
+		fmt.Fprintf(w, "%s\t", event.Time.String())
+	} else {
+		fmt.Fprintf(w, "%s\t", pretty.Ago(event.Time))
+	}
+	principal := event.Principal
+	if principal == "" {
+		principal = "-"
+	}
+	fmt.Fprintf(w, "%s\t", principal)
+	fmt.Fprintf(w, "%s\t", event.RPC)
+	fmt.Fprintf(w, "%s\t", strings.Join(event.Resources, ", "))
+	if event.Error != "" {
+		fmt.Fprintf(w, "%s: %s\t", event.Code, event.Error)
+	} else {
+		fmt.Fprintf(w, "%s\t", event.Code)
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// eventBufferSize is the number of events that may be queued for the
+	// sinks. Events recorded while the queue is full are dropped (see record).
+	eventBufferSize = 1000
+
+	// maxBatchSize is the maximum number of events written to the sinks at
+	// once
+	maxBatchSize = 100
+
+	// flushInterval is the maximum amount of time that an event is queued
+	// before it's written to the sinks
+	flushInterval = time.Second
+)
+
+type apiServer struct {
+	// dropped is the number of events dropped since writeEvents last logged
+	// them. It's accessed atomically, so it's first (for alignment).
+	dropped int64
+
+	log.Logger
+	env    *serviceenv.ServiceEnv
+	sinks  []sink
+	events chan *audit.Event
+
+	// ppsToken returns PPS's superuser token, which identifies the repo
+	// sink's writes (see isSinkWrite)
+	ppsToken func(context.Context) (string, error)
+}
+
+func newAPIServer(env *serviceenv.ServiceEnv, sinks string) (*apiServer, error) {
+	s, err := parseSinks(env, sinks)
+	if err != nil {
+		return nil, err
+	}
+	a := &apiServer{
+		Logger: log.NewLogger("audit.API"),
+		env:    env,
+		sinks:  s,
+		events: make(chan *audit.Event, eventBufferSize),
+		ppsToken: func(ctx context.Context) (string, error) {
+			return ppsToken(ctx, env)
+		},
+	}
+	if len(a.sinks) > 0 {
+		registerMetrics()
+		go a.writeEvents()
+	}
+	return a, nil
+}
+
+// writeEvents writes queued events to the sinks in batches. It runs for the
+// lifetime of pachd.
+func (a *apiServer) writeEvents() {
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	var batch []*audit.Event
+	for {
+		select {
+		case e := <-a.events:
+			batch = append(batch, e)
+			if len(batch) < maxBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if n := atomic.SwapInt64(&a.dropped, 0); n > 0 {
+				logrus.Errorf("dropped %d audit event(s), as the audit sinks couldn't keep up", n)
+			}
+			if len(batch) == 0 {
+				continue
+			}
+		}
+		for _, s := range a.sinks {
+			if err := s.write(batch); err != nil {
+				logrus.Errorf("could not write %d audit event(s) to %T: %v", len(batch), s, err)
+			}
+		}
+		batch = nil
+	}
+}
+
+// ListEvents implements the protobuf audit.ListEvents RPC
+func (a *apiServer) ListEvents(req *audit.ListEventsRequest, server audit.API_ListEventsServer) (retErr error) {
+	func() { a.Log(req, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(req, nil, retErr, time.Since(start)) }(time.Now())
+	ctx := server.Context()
+
+	// Only cluster admins may read the audit log (if auth is active)
+	pachClient := a.env.GetPachClient(ctx)
+	whoAmI, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return err
+	}
+	if err == nil && !whoAmI.IsAdmin {
+		return &auth.ErrNotAuthorized{
+			Subject: whoAmI.Username,
+			AdminOp: "ListEvents",
+		}
+	}
+
+	var r reader
+	for _, s := range a.sinks {
+		if sr, ok := s.(reader); ok {
+			r = sr
+			break
+		}
+	}
+	if r == nil {
+		return errors.Errorf("the audit log can't be listed, as no readable audit sink " +
+			"is configured (AUDIT_SINKS must include \"repo\" or \"file:<path>\")")
+	}
+	var since time.Time
+	if req.Since != nil {
+		if since, err = types.TimestampFromProto(req.Since); err != nil {
+			return err
+		}
+	}
+	return r.read(ctx, since, func(e *audit.Event) error {
+		if !e.Matches(req) {
+			return nil
+		}
+		return server.Send(e)
+	})
+}
@@ -0,0 +1,352 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxRequestSummary is the maximum length of an event's request summary
+	maxRequestSummary = 1024
+
+	// maxResources is the maximum number of resources recorded per event
+	maxResources = 100
+
+	// maxResourceDepth is the maximum depth of nested messages in a request
+	// that are searched for resources
+	maxResourceDepth = 4
+)
+
+// mutatingRPCs are the RPCs for which audit events are recorded
+var mutatingRPCs = map[string]bool{
+	"/pfs.API/CreateRepo":      true,
+	"/pfs.API/DeleteRepo":      true,
+	"/pfs.API/StartCommit":     true,
+	"/pfs.API/FinishCommit":    true,
+	"/pfs.API/DeleteCommit":    true,
+	"/pfs.API/BuildCommit":     true,
+	"/pfs.API/CreateBranch":    true,
+	"/pfs.API/DeleteBranch":    true,
+	"/pfs.API/PutFile":         true,
+	"/pfs.API/CopyFile":        true,
+	"/pfs.API/DeleteFile":      true,
+	"/pfs.API/DeleteAll":       true,
+	"/pfs.API/Fsck":            true,
+	"/pfs.API/FileOperationV2": true,
+	"/pfs.API/ClearCommitV2":   true,
+
+	"/pps.API/CreateJob":      true,
+	"/pps.API/DeleteJob":      true,
+	"/pps.API/StopJob":        true,
+	"/pps.API/UpdateJobState": true,
+	"/pps.API/RestartDatum":   true,
+	"/pps.API/CreatePipeline": true,
+	"/pps.API/DeletePipeline": true,
+	"/pps.API/StartPipeline":  true,
+	"/pps.API/StopPipeline":   true,
+	"/pps.API/RunPipeline":    true,
+	"/pps.API/RunCron":        true,
+	"/pps.API/CreateSecret":   true,
+	"/pps.API/DeleteSecret":   true,
+	"/pps.API/DeleteAll":      true,
+	"/pps.API/GarbageCollect": true,
+	"/pps.API/ActivateAuth":   true,
+
+	"/auth.API/Activate":                 true,
+	"/auth.API/Deactivate":               true,
+	"/auth.API/SetConfiguration":         true,
+	"/auth.API/ModifyAdmins":             true,
+	"/auth.API/ModifyClusterRoleBinding": true,
+	"/auth.API/Authenticate":             true,
+	"/auth.API/SetScope":                 true,
+	"/auth.API/SetACL":                   true,
+	"/auth.API/CreateRole":               true,
+	"/auth.API/DeleteRole":               true,
+	"/auth.API/ModifyRoleBinding":        true,
+	"/auth.API/GetAuthToken":             true,
+	"/auth.API/ExtendAuthToken":          true,
+	"/auth.API/RevokeAuthToken":          true,
+	"/auth.API/SetGroupsForUser":         true,
+	"/auth.API/ModifyMembers":            true,
+	"/auth.API/GetOneTimePassword":       true,
+
+	"/admin.API/Restore": true,
+
+	"/transaction.API/BatchTransaction":  true,
+	"/transaction.API/StartTransaction":  true,
+	"/transaction.API/FinishTransaction": true,
+	"/transaction.API/DeleteTransaction": true,
+	"/transaction.API/DeleteAll":         true,
+}
+
+// unsummarizedRPCs are mutating RPCs whose requests are not summarized in
+// their audit events, either because they may contain credentials or secrets,
+// or because they contain bulk data
+var unsummarizedRPCs = map[string]bool{
+	"/auth.API/Activate":         true,
+	"/auth.API/SetConfiguration": true,
+	"/auth.API/Authenticate":     true,
+	"/auth.API/ExtendAuthToken":  true,
+	"/auth.API/RevokeAuthToken":  true,
+	"/pps.API/CreateSecret":      true,
+	"/pfs.API/FileOperationV2":   true,
+	"/admin.API/Restore":         true,
+}
+
+// Interceptor implements the corresponding method of APIServer
+func (a *apiServer) Interceptor() grpcutil.Interceptor {
+	if len(a.sinks) == 0 {
+		return grpcutil.Interceptor{}
+	}
+	return grpcutil.Interceptor{
+		Unary:  a.unaryInterceptor,
+		Stream: a.streamInterceptor,
+	}
+}
+
+func (a *apiServer) unaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !mutatingRPCs[info.FullMethod] || a.isSinkWrite(ctx, req) {
+		return handler(ctx, req)
+	}
+	// Look up the principal before calling 'handler', as some RPCs (e.g.
+	// Deactivate) invalidate the caller's token
+	principal := a.principal(ctx)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	a.record(start, principal, info.FullMethod, req, err)
+	return resp, err
+}
+
+func (a *apiServer) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !mutatingRPCs[info.FullMethod] {
+		return handler(srv, ss)
+	}
+	principal := a.principal(ss.Context())
+	start := time.Now()
+	stream := &recordingStream{ServerStream: ss}
+	err := handler(srv, stream)
+	// The first message of a stream isn't available until the handler reads
+	// it, so the repo sink's PutFile streams are only recognized here
+	if !a.isSinkWrite(ss.Context(), stream.first) {
+		a.record(start, principal, info.FullMethod, stream.first, err)
+	}
+	return err
+}
+
+// recordingStream is a grpc.ServerStream that remembers the first message
+// that it receives, which identifies the resources targeted by a streaming
+// RPC (e.g. the file targeted by PutFile)
+type recordingStream struct {
+	grpc.ServerStream
+	first interface{}
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.first == nil {
+		s.first = m
+	}
+	return err
+}
+
+// isSinkWrite returns true if 'req' is one of the repo sink's own writes to
+// audit.Repo, which aren't audited (auditing them would record an event for
+// every batch of events that the sink writes). These are the RPCs made with
+// PPS's superuser token that only touch audit.Repo. Users' RPCs that touch
+// audit.Repo, such as deleting it or using it as a pipeline input, are
+// audited like any others.
+func (a *apiServer) isSinkWrite(ctx context.Context, req interface{}) bool {
+	rs := resources(req)
+	if len(rs) == 0 {
+		return false
+	}
+	for _, r := range rs {
+		if resourceRepo(r) != audit.Repo {
+			return false
+		}
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(auth.ContextTokenKey)) != 1 || a.ppsToken == nil {
+		return false
+	}
+	token, err := a.ppsToken(ctx)
+	if err != nil || token == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(md.Get(auth.ContextTokenKey)[0]), []byte(token)) == 1
+}
+
+// resourceRepo returns the repo of a resource returned by resources(), or ""
+// if the resource isn't in a repo (e.g. a pipeline)
+func resourceRepo(r string) string {
+	kind, name := r, ""
+	if i := strings.Index(r, ":"); i >= 0 {
+		kind, name = r[:i], r[i+1:]
+	}
+	switch kind {
+	case "repo":
+		return name
+	case "commit", "branch", "file":
+		if i := strings.Index(name, "@"); i >= 0 {
+			return name[:i]
+		}
+	}
+	return ""
+}
+
+// principal returns the user that made the RPC with context 'ctx', or "" if
+// the RPC isn't authenticated (e.g. because auth isn't active)
+func (a *apiServer) principal(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(auth.ContextTokenKey)) == 0 {
+		return ""
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	resp, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		return ""
+	}
+	return resp.Username
+}
+
+// record queues an audit event for the RPC 'method'. If the queue is full
+// (e.g. because a sink is unavailable), the event is dropped and counted,
+// rather than blocking the RPC.
+func (a *apiServer) record(start time.Time, principal, method string, req interface{}, err error) {
+	event := &audit.Event{
+		Principal: principal,
+		RPC:       method,
+		Resources: resources(req),
+		Code:      status.Code(err).String(),
+		Duration:  types.DurationProto(time.Since(start)),
+	}
+	event.Time, _ = types.TimestampProto(start)
+	if !unsummarizedRPCs[method] {
+		event.Request = summarize(req)
+	}
+	if err != nil {
+		event.Error = grpcutil.ScrubGRPC(err).Error()
+	}
+	select {
+	case a.events <- event:
+	default:
+		atomic.AddInt64(&a.dropped, 1)
+		droppedEventsCount.Inc()
+	}
+}
+
+// summarize returns a truncated text representation of 'req'
+func summarize(req interface{}) string {
+	if r, ok := req.(*pfs.PutFileRequest); ok {
+		// Don't serialize file contents
+		c := *r
+		c.Value = nil
+		req = &c
+	}
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	summary := proto.CompactTextString(msg)
+	if len(summary) > maxRequestSummary {
+		summary = summary[:maxRequestSummary] + "..."
+	}
+	return summary
+}
+
+// resources returns the repos, commits, branches, files, pipelines and jobs
+// referenced by 'req'. Nested messages (e.g. a pipeline's inputs, or the
+// requests in a transaction) are included.
+func resources(req interface{}) []string {
+	var result []string
+	seen := make(map[string]bool)
+	add := func(kind, name string) {
+		r := kind + ":" + name
+		if name == "" || seen[r] || len(result) >= maxResources {
+			return
+		}
+		seen[r] = true
+		result = append(result, r)
+	}
+	var walk func(v reflect.Value, depth int)
+	walk = func(v reflect.Value, depth int) {
+		if depth > maxResourceDepth || !v.IsValid() {
+			return
+		}
+		switch v.Kind() {
+		case reflect.Interface:
+			walk(v.Elem(), depth)
+		case reflect.Ptr:
+			if v.IsNil() {
+				return
+			}
+			switch x := v.Interface().(type) {
+			case *pfs.Repo:
+				add("repo", x.Name)
+			case *pfs.Commit:
+				if x.Repo != nil {
+					add("commit", x.Repo.Name+"@"+x.ID)
+				}
+			case *pfs.Branch:
+				if x.Repo != nil {
+					add("branch", x.Repo.Name+"@"+x.Name)
+				}
+			case *pfs.File:
+				if x.Commit != nil && x.Commit.Repo != nil {
+					add("file", x.Commit.Repo.Name+"@"+x.Commit.ID+":"+x.Path)
+				}
+			case *pps.Pipeline:
+				add("pipeline", x.Name)
+			case *pps.Job:
+				add("job", x.ID)
+			default:
+				walk(v.Elem(), depth)
+			}
+		case reflect.Struct:
+			t := v.Type()
+			for i := 0; i < v.NumField(); i++ {
+				field := t.Field(i)
+				if field.PkgPath != "" {
+					continue // unexported
+				}
+				fv := v.Field(i)
+				// Some requests (e.g. auth's SetScopeRequest) identify repos and
+				// pipelines by name
+				if fv.Kind() == reflect.String {
+					switch field.Name {
+					case "Repo":
+						add("repo", fv.String())
+					case "Pipeline":
+						add("pipeline", fv.String())
+					}
+					continue
+				}
+				walk(fv, depth+1)
+			}
+		case reflect.Slice:
+			if v.Type().Elem().Kind() == reflect.Uint8 {
+				return
+			}
+			for i := 0; i < v.Len() && len(result) < maxResources; i++ {
+				walk(v.Index(i), depth)
+			}
+		}
+	}
+	walk(reflect.ValueOf(req), 0)
+	return result
+}
@@ -0,0 +1,148 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/transaction"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestResources(t *testing.T) {
+	require.Equal(t, []string{"commit:images@master"}, resources(&pfs.DeleteCommitRequest{
+		Commit: client.NewCommit("images", "master"),
+	}))
+	require.Equal(t, []string{"file:images@master:/a.png"}, resources(&pfs.PutFileRequest{
+		File:  client.NewFile("images", "master", "/a.png"),
+		Value: []byte("data"),
+	}))
+	require.Equal(t, []string{"repo:images", "pipeline:edges"}, resources(&auth.SetScopeRequest{
+		Repo:     "images",
+		Pipeline: "edges",
+		Username: "alice",
+	}))
+	require.Equal(t, []string{"pipeline:edges", "repo:images"}, resources(&pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("edges"),
+		Input:    client.NewPFSInput("images", "/*"),
+	}))
+	require.Equal(t, []string{"repo:images", "branch:images@master"}, resources(&transaction.BatchTransactionRequest{
+		Requests: []*transaction.TransactionRequest{
+			{CreateRepo: &pfs.CreateRepoRequest{Repo: client.NewRepo("images")}},
+			{CreateBranch: &pfs.CreateBranchRequest{Branch: client.NewBranch("images", "master")}},
+		},
+	}))
+	require.Equal(t, 0, len(resources(nil)))
+}
+
+func TestSummarize(t *testing.T) {
+	summary := summarize(&pfs.PutFileRequest{
+		File:  client.NewFile("images", "master", "/a.png"),
+		Value: []byte("secret data"),
+	})
+	require.True(t, len(summary) > 0)
+	require.False(t, bytes.Contains([]byte(summary), []byte("secret data")))
+	require.Equal(t, "", summarize(nil))
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	a := &apiServer{
+		sinks:  []sink{&writerSink{w: &bytes.Buffer{}}},
+		events: make(chan *audit.Event, 10),
+		ppsToken: func(context.Context) (string, error) {
+			return "pps-token", nil
+		},
+	}
+	intercept := a.Interceptor().Unary
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, errors.New("no such repo")
+	}
+
+	// Non-mutating RPCs aren't recorded
+	_, err := intercept(context.Background(), &pfs.InspectRepoRequest{}, &grpc.UnaryServerInfo{FullMethod: "/pfs.API/InspectRepo"}, handler)
+	require.YesError(t, err)
+	require.Equal(t, 0, len(a.events))
+
+	// Mutating RPCs are recorded, along with their result
+	req := &pfs.DeleteRepoRequest{Repo: client.NewRepo("images")}
+	_, err = intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/pfs.API/DeleteRepo"}, handler)
+	require.YesError(t, err)
+	require.Equal(t, 1, len(a.events))
+	e := <-a.events
+	require.Equal(t, "", e.Principal)
+	require.Equal(t, "/pfs.API/DeleteRepo", e.RPC)
+	require.Equal(t, []string{"repo:images"}, e.Resources)
+	require.Equal(t, "Unknown", e.Code)
+	require.Equal(t, "no such repo", e.Error)
+
+	// The repo sink's writes to the audit repo aren't recorded
+	sinkCtx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(auth.ContextTokenKey, "pps-token"))
+	_, err = intercept(sinkCtx, &pfs.CreateRepoRequest{Repo: client.NewRepo(audit.Repo)}, &grpc.UnaryServerInfo{FullMethod: "/pfs.API/CreateRepo"}, handler)
+	require.YesError(t, err)
+	require.Equal(t, 0, len(a.events))
+
+	// ...but users' RPCs that touch the audit repo are
+	req = &pfs.DeleteRepoRequest{Repo: client.NewRepo(audit.Repo)}
+	_, err = intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/pfs.API/DeleteRepo"}, handler)
+	require.YesError(t, err)
+	require.Equal(t, 1, len(a.events))
+	require.Equal(t, []string{"repo:" + audit.Repo}, (<-a.events).Resources)
+	pipelineReq := &pps.CreatePipelineRequest{
+		Pipeline: client.NewPipeline("tamper"),
+		Input:    client.NewPFSInput(audit.Repo, "/*"),
+	}
+	_, err = intercept(context.Background(), pipelineReq, &grpc.UnaryServerInfo{FullMethod: "/pps.API/CreatePipeline"}, handler)
+	require.YesError(t, err)
+	require.Equal(t, 1, len(a.events))
+	<-a.events
+	require.False(t, a.isSinkWrite(sinkCtx, pipelineReq))
+
+	// Events are dropped, rather than blocking RPCs, when the queue is full
+	for i := 0; i < cap(a.events)+5; i++ {
+		_, err = intercept(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: "/pfs.API/DeleteRepo"}, handler)
+		require.YesError(t, err)
+	}
+	require.Equal(t, cap(a.events), len(a.events))
+	require.Equal(t, int64(5), a.dropped)
+}
+
+func TestParseSinks(t *testing.T) {
+	sinks, err := parseSinks(nil, "")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(sinks))
+
+	sinks, err = parseSinks(nil, "stdout, webhook:https://example.com/audit")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sinks))
+	require.Equal(t, "https://example.com/audit", sinks[1].(*webhookSink).url)
+
+	_, err = parseSinks(nil, "file:")
+	require.YesError(t, err)
+	_, err = parseSinks(nil, "webhook:example.com")
+	require.YesError(t, err)
+	_, err = parseSinks(nil, "syslog")
+	require.YesError(t, err)
+}
+
+func TestMarshalEvents(t *testing.T) {
+	events := []*audit.Event{
+		{Principal: "github:alice", RPC: "/pfs.API/DeleteRepo", Resources: []string{"repo:images"}},
+		{Principal: "github:bob", RPC: "/pps.API/DeletePipeline", Resources: []string{"pipeline:edges"}},
+	}
+	data, err := marshalEvents(events)
+	require.NoError(t, err)
+	var result []*audit.Event
+	require.NoError(t, readEvents(bytes.NewReader(data), func(e *audit.Event) error {
+		result = append(result, e)
+		return nil
+	}))
+	require.Equal(t, events, result)
+}
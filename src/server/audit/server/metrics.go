@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+// droppedEventsCount is the number of audit events that were dropped because
+// the queue of events for the sinks was full
+var droppedEventsCount = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Namespace: "pachyderm",
+		Subsystem: "audit",
+		Name:      "dropped_events_count",
+		Help:      "Cumulative number of audit events dropped because the audit sinks couldn't keep up",
+	},
+)
+
+func registerMetrics() {
+	if err := prometheus.Register(droppedEventsCount); err != nil {
+		// metrics may be redundantly registered; ignore these errors
+		if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+			logrus.Infof("error registering prometheus metric: %v", err)
+		}
+	}
+}
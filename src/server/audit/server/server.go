@@ -0,0 +1,28 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+)
+
+// APIServer represents an audit API server
+type APIServer interface {
+	audit.APIServer
+
+	// Interceptor returns the gRPC interceptors that record an audit event for
+	// every mutating RPC. It should be passed to each grpcutil.NewServer that
+	// serves PFS, PPS, auth, admin or transaction RPCs.
+	Interceptor() grpcutil.Interceptor
+}
+
+// NewAPIServer returns a new audit APIServer. 'sinks' is a comma-separated
+// list of the destinations to which audit events are written:
+//   - "repo": the append-only system repo audit.Repo
+//   - "stdout": pachd's stdout, as JSON lines
+//   - "file:<path>": the file at <path>, as JSON lines
+//   - "webhook:<url>": <url>, which receives a POST of JSON lines per batch
+// If 'sinks' is empty, no audit events are recorded.
+func NewAPIServer(env *serviceenv.ServiceEnv, sinks string) (APIServer, error) {
+	return newAPIServer(env, sinks)
+}
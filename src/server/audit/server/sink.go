@@ -0,0 +1,291 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/audit"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+)
+
+const (
+	// dayLayout is the format of the names of the files in audit.Repo, which
+	// each contain the events recorded on one (UTC) day
+	dayLayout = "2006-01-02"
+
+	// maxEventSize is the largest serialized event that a file or repo sink
+	// can read back
+	maxEventSize = 1024 * 1024
+)
+
+// sink is a destination to which audit events are written
+type sink interface {
+	write(events []*audit.Event) error
+}
+
+// reader is implemented by sinks whose events can be read back (to serve
+// ListEvents)
+type reader interface {
+	// read calls 'f' with each event in the sink that may have occurred at or
+	// after 'since', oldest first
+	read(ctx context.Context, since time.Time, f func(*audit.Event) error) error
+}
+
+// parseSinks parses a comma-separated list of sink specs (see NewAPIServer)
+func parseSinks(env *serviceenv.ServiceEnv, spec string) ([]sink, error) {
+	var sinks []sink
+	for _, s := range strings.Split(spec, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		kind, arg := s, ""
+		if i := strings.Index(s, ":"); i >= 0 {
+			kind, arg = s[:i], s[i+1:]
+		}
+		switch kind {
+		case "repo":
+			sinks = append(sinks, &repoSink{env: env})
+		case "stdout":
+			sinks = append(sinks, &writerSink{w: os.Stdout})
+		case "file":
+			if arg == "" {
+				return nil, errors.Errorf("audit sink %q must include a path", s)
+			}
+			f, err := os.OpenFile(arg, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not open audit log file")
+			}
+			sinks = append(sinks, &fileSink{writerSink: writerSink{w: f}, path: arg})
+		case "webhook":
+			if !strings.HasPrefix(arg, "http://") && !strings.HasPrefix(arg, "https://") {
+				return nil, errors.Errorf("audit sink %q must include an http(s) URL", s)
+			}
+			sinks = append(sinks, &webhookSink{url: arg, client: &http.Client{Timeout: 30 * time.Second}})
+		default:
+			return nil, errors.Errorf("unrecognized audit sink %q (must be one of "+
+				"\"repo\", \"stdout\", \"file:<path>\" or \"webhook:<url>\")", s)
+		}
+	}
+	return sinks, nil
+}
+
+// marshalEvents serializes 'events' as JSON lines
+func marshalEvents(events []*audit.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	marshaler := &jsonpb.Marshaler{}
+	for _, e := range events {
+		if err := marshaler.Marshal(&buf, e); err != nil {
+			return nil, err
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// readEvents parses the JSON lines in 'r' and calls 'f' with each event
+func readEvents(r io.Reader, f func(*audit.Event) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), maxEventSize)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		e := &audit.Event{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(line), e); err != nil {
+			return errors.Wrapf(err, "could not parse audit event")
+		}
+		if err := f(e); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// writerSink writes events to an io.Writer (e.g. stdout) as JSON lines
+type writerSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func (s *writerSink) write(events []*audit.Event) error {
+	data, err := marshalEvents(events)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(data)
+	return err
+}
+
+// fileSink appends events to a local file as JSON lines
+type fileSink struct {
+	writerSink
+	path string
+}
+
+func (s *fileSink) read(ctx context.Context, since time.Time, f func(*audit.Event) error) error {
+	file, err := os.Open(s.path)
+	if err != nil {
+		return errors.Wrapf(err, "could not open audit log file")
+	}
+	defer file.Close()
+	return readEvents(file, f)
+}
+
+// webhookSink POSTs each batch of events to a URL as JSON lines
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+func (s *webhookSink) write(events []*audit.Event) error {
+	data, err := marshalEvents(events)
+	if err != nil {
+		return err
+	}
+	b := backoff.NewExponentialBackOff()
+	b.MaxElapsedTime = time.Minute
+	return backoff.Retry(func() error {
+		resp, err := s.client.Post(s.url, "application/x-ndjson", bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 300 {
+			return errors.Errorf("audit webhook returned %s", resp.Status)
+		}
+		return nil
+	}, b)
+}
+
+// repoSink appends events to the system repo audit.Repo, in one file per day.
+// Events are written with PPS's superuser token, so that only cluster admins
+// can read or modify the repo once auth is activated, and so that the sink's
+// own writes can be told apart from users' (see isSinkWrite).
+type repoSink struct {
+	env *serviceenv.ServiceEnv
+
+	mu    sync.Mutex
+	token string
+}
+
+// ppsToken returns PPS's superuser token, which the repo sink writes events
+// with
+func ppsToken(ctx context.Context, env *serviceenv.ServiceEnv) (string, error) {
+	tokenCol := col.NewCollection(env.GetEtcdClient(), ppsconsts.PPSTokenKey, nil, &types.StringValue{}, nil, nil)
+	var result types.StringValue
+	if err := tokenCol.ReadOnly(ctx).Get("", &result); err != nil {
+		return "", errors.Wrapf(err, "could not get superuser token")
+	}
+	return result.Value, nil
+}
+
+// getClient returns a pach client authenticated with PPS's superuser token
+func (s *repoSink) getClient(ctx context.Context) (*client.APIClient, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token == "" {
+		token, err := ppsToken(ctx, s.env)
+		if err != nil {
+			return nil, err
+		}
+		s.token = token
+	}
+	pachClient := s.env.GetPachClient(ctx)
+	pachClient.SetAuthToken(s.token)
+	return pachClient, nil
+}
+
+func (s *repoSink) write(events []*audit.Event) error {
+	pachClient, err := s.getClient(context.Background())
+	if err != nil {
+		return err
+	}
+	if err := pachClient.CreateRepo(audit.Repo); err != nil && !isAlreadyExistsErr(err) {
+		return errors.Wrapf(err, "could not create audit repo")
+	}
+	// Group events by day, so that each file holds one day's events
+	byDay := make(map[string][]*audit.Event)
+	for _, e := range events {
+		t, err := types.TimestampFromProto(e.Time)
+		if err != nil {
+			return err
+		}
+		day := t.UTC().Format(dayLayout)
+		byDay[day] = append(byDay[day], e)
+	}
+	for day, dayEvents := range byDay {
+		data, err := marshalEvents(dayEvents)
+		if err != nil {
+			return err
+		}
+		if _, err := pachClient.PutFile(audit.Repo, "master", "/"+day+".jsonl", bytes.NewReader(data)); err != nil {
+			return errors.Wrapf(err, "could not write audit events")
+		}
+	}
+	return nil
+}
+
+func (s *repoSink) read(ctx context.Context, since time.Time, f func(*audit.Event) error) error {
+	pachClient, err := s.getClient(ctx)
+	if err != nil {
+		return err
+	}
+	fileInfos, err := pachClient.ListFile(audit.Repo, "master", "/")
+	if err != nil {
+		if isNotFoundErr(err) {
+			return nil // no events have been written yet
+		}
+		return err
+	}
+	var files []string
+	for _, fi := range fileInfos {
+		name := path.Base(fi.File.Path)
+		day, err := time.Parse(dayLayout, strings.TrimSuffix(name, ".jsonl"))
+		if err != nil {
+			continue // not written by repoSink
+		}
+		if !since.IsZero() && day.Add(24*time.Hour).Before(since) {
+			continue
+		}
+		files = append(files, fi.File.Path)
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		var buf bytes.Buffer
+		if err := pachClient.GetFile(audit.Repo, "master", file, 0, 0, &buf); err != nil {
+			return errors.Wrapf(err, "could not read audit events")
+		}
+		if err := readEvents(&buf, f); err != nil {
+			return errors.Wrapf(err, "error reading %s", file)
+		}
+	}
+	return nil
+}
+
+func isAlreadyExistsErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "already exists")
+}
+
+func isNotFoundErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "not found")
+}
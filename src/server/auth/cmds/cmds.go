@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
@@ -169,6 +171,7 @@ func DeactivateCmd() *cobra.Command {
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd() *cobra.Command {
 	var useOTP bool
+	var username string
 	login := &cobra.Command{
 		Short: "Log in to Pachyderm",
 		Long: "Login to Pachyderm. Any resources that have been restricted to " +
@@ -194,6 +197,18 @@ func LoginCmd() *cobra.Command {
 				resp, authErr = c.Authenticate(
 					c.Ctx(),
 					&auth.AuthenticateRequest{OneTimePassword: code})
+			} else if username != "" {
+				// Exchange LDAP credentials for Pachyderm token
+				password, err := cmdutil.ReadPassword("Password:")
+				if err != nil {
+					return errors.Wrapf(err, "error reading password")
+				}
+				resp, authErr = c.Authenticate(
+					c.Ctx(),
+					&auth.AuthenticateRequest{
+						LDAPUsername: username,
+						LDAPPassword: strings.TrimRight(password, "\r\n"), // drop trailing newline
+					})
 			} else if state, err := requestOIDCLogin(c); err == nil {
 				// Exchange OIDC token for Pachyderm token
 				fmt.Println("Retrieving Pachyderm token...")
@@ -234,6 +249,9 @@ func LoginCmd() *cobra.Command {
 	login.PersistentFlags().BoolVarP(&useOTP, "one-time-password", "o", false,
 		"If set, authenticate with a Dash-provided One-Time Password, rather than "+
 			"via GitHub")
+	login.PersistentFlags().StringVarP(&username, "username", "u", "",
+		"If set, authenticate as this user with a password (which is read from "+
+			"stdin), using the cluster's LDAP or Active Directory ID provider")
 	return cmdutil.CreateAlias(login, "auth login")
 }
 
@@ -256,7 +274,7 @@ func LogoutCmd() *cobra.Command {
 			if err != nil {
 				return errors.Wrapf(err, "error getting the active context")
 			}
-			context.SessionToken = ""
+			context.ClearSessionToken()
 			return cfg.Write()
 		}),
 	}
@@ -267,6 +285,7 @@ func LogoutCmd() *cobra.Command {
 // credential, logging you out of your cluster. Note that this is not necessary
 // to do before logging in as another user, but is useful for testing.
 func WhoamiCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
 	whoami := &cobra.Command{
 		Short: "Print your Pachyderm identity",
 		Long:  "Print your Pachyderm identity.",
@@ -280,22 +299,28 @@ func WhoamiCmd() *cobra.Command {
 			if err != nil {
 				return errors.Wrapf(grpcutil.ScrubGRPC(err), "error")
 			}
-			fmt.Printf("You are \"%s\"\n", resp.Username)
-			if resp.TTL > 0 {
-				fmt.Printf("session expires: %v\n", time.Now().Add(time.Duration(resp.TTL)*time.Second).Format(time.RFC822))
-			}
-			if resp.IsAdmin {
-				fmt.Println("You are an administrator of this Pachyderm cluster")
-			}
-			return nil
+			return output.Print(os.Stdout, cmdutil.Table{
+				PrintDetailed: func(proto.Message) error {
+					fmt.Printf("You are \"%s\"\n", resp.Username)
+					if resp.TTL > 0 {
+						fmt.Printf("session expires: %v\n", time.Now().Add(time.Duration(resp.TTL)*time.Second).Format(time.RFC822))
+					}
+					if resp.IsAdmin {
+						fmt.Println("You are an administrator of this Pachyderm cluster")
+					}
+					return nil
+				},
+			}, resp)
 		}),
 	}
+	whoami.Flags().AddFlagSet(outputFlags)
 	return cmdutil.CreateAlias(whoami, "auth whoami")
 }
 
 // CheckCmd returns a cobra command that sends an "Authorize" RPC to Pachd, to
 // determine whether the specified user has access to the specified repo.
 func CheckCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
 	check := &cobra.Command{
 		Use:   "{{alias}} (none|reader|writer|owner) <repo>",
 		Short: "Check whether you have reader/writer/etc-level access to 'repo'",
@@ -323,16 +348,22 @@ func CheckCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
-			fmt.Printf("%t\n", resp.Authorized)
-			return nil
+			return output.Print(os.Stdout, cmdutil.Table{
+				PrintDetailed: func(proto.Message) error {
+					fmt.Printf("%t\n", resp.Authorized)
+					return nil
+				},
+			}, resp)
 		}),
 	}
+	check.Flags().AddFlagSet(outputFlags)
 	return cmdutil.CreateAlias(check, "auth check")
 }
 
 // GetCmd returns a cobra command that gets either the ACL for a Pachyderm
 // repo or another user's scope of access to that repo
 func GetCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
 	get := &cobra.Command{
 		Use:   "{{alias}} [<username>] <repo>",
 		Short: "Get the ACL for 'repo' or the access that 'username' has to 'repo'",
@@ -357,9 +388,7 @@ func GetCmd() *cobra.Command {
 				if err != nil {
 					return grpcutil.ScrubGRPC(err)
 				}
-				t := template.Must(template.New("ACLEntries").Parse(
-					"{{range .}}{{.Username }}: {{.Scope}}\n{{end}}"))
-				return t.Execute(os.Stdout, resp.Entries)
+				return output.Print(os.Stdout, aclTable(resp), resp)
 			}
 			// Get User's scope on an acl
 			username, repo := args[0], args[1]
@@ -370,16 +399,50 @@ func GetCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
-			fmt.Println(resp.Scopes[0].String())
-			return nil
+			return output.Print(os.Stdout, cmdutil.Table{
+				PrintDetailed: func(proto.Message) error {
+					fmt.Println(resp.Scopes[0].String())
+					return nil
+				},
+			}, resp)
 		}),
 	}
+	get.Flags().AddFlagSet(outputFlags)
 	return cmdutil.CreateAlias(get, "auth get")
 }
 
+// GetPipelineCmd returns a cobra command that gets the ACL for a Pachyderm
+// pipeline
+func GetPipelineCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
+	getPipeline := &cobra.Command{
+		Use:   "{{alias}} <pipeline>",
+		Short: "Get the ACL for 'pipeline'",
+		Long: "Get the ACL for 'pipeline'. If the pipeline has no ACL, access " +
+			"to it is governed by the ACL of its output repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetACL(c.Ctx(), &auth.GetACLRequest{
+				Pipeline: args[0],
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return output.Print(os.Stdout, aclTable(resp), resp)
+		}),
+	}
+	getPipeline.Flags().AddFlagSet(outputFlags)
+	return cmdutil.CreateAlias(getPipeline, "auth get pipeline")
+}
+
 // SetScopeCmd returns a cobra command that lets a user set the level of access
 // that another user has to a repo
 func SetScopeCmd() *cobra.Command {
+	var branch string
 	setScope := &cobra.Command{
 		Use:   "{{alias}} <username> (none|reader|writer|owner) <repo>",
 		Short: "Set the scope of access that 'username' has to 'repo'",
@@ -390,7 +453,12 @@ func SetScopeCmd() *cobra.Command {
 			"private-data' would let \"github-alice\" read from \"private-data\" but " +
 			"not create commits (writer) or modify the repo's access permissions " +
 			"(owner). Currently all Pachyderm authentication uses GitHub OAuth, so " +
-			"'username' must be a GitHub username",
+			"'username' must be a GitHub username. If --branch is set, " +
+			"'username' is granted access to the branches of 'repo' matching the " +
+			"given name or glob pattern (e.g. 'dev/*'). Once a branch matches a " +
+			"pattern, only the repo's owners and the principals granted writer " +
+			"access to a matching pattern may write to it, even if no principals " +
+			"are left in the pattern's ACL (see 'auth unprotect-branch').",
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			scope, err := auth.ParseScope(args[1])
 			if err != nil {
@@ -404,17 +472,248 @@ func SetScopeCmd() *cobra.Command {
 			defer c.Close()
 			_, err = c.SetScope(c.Ctx(), &auth.SetScopeRequest{
 				Repo:     repo,
+				Branch:   branch,
 				Scope:    scope,
 				Username: username,
 			})
 			return grpcutil.ScrubGRPC(err)
 		}),
 	}
+	setScope.Flags().StringVar(&branch, "branch", "", "Set the scope of access to the branches matching this name or glob pattern, rather than to the whole repo.")
 	return cmdutil.CreateAlias(setScope, "auth set")
 }
 
+// UnprotectBranchCmd returns a cobra command that removes one of a repo's
+// branch ACLs
+func UnprotectBranchCmd() *cobra.Command {
+	unprotectBranch := &cobra.Command{
+		Use:   "{{alias}} <repo> <branch-pattern>",
+		Short: "Remove the branch ACL for 'branch-pattern' from 'repo'",
+		Long: "Remove the branch ACL for 'branch-pattern' (a branch name or glob " +
+			"pattern previously passed to 'auth set --branch') from 'repo', so that " +
+			"the branches it matches can be written by any writer of the repo again.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.SetScope(c.Ctx(), &auth.SetScopeRequest{
+				Repo:   args[0],
+				Branch: args[1],
+				Scope:  auth.Scope_NONE,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(unprotectBranch, "auth unprotect-branch")
+}
+
+// SetPipelineScopeCmd returns a cobra command that lets a user set the level
+// of access that another user has to a pipeline
+func SetPipelineScopeCmd() *cobra.Command {
+	setPipelineScope := &cobra.Command{
+		Use:   "{{alias}} <username> (none|reader|writer|owner) <pipeline>",
+		Short: "Set the scope of access that 'username' has to 'pipeline'",
+		Long: "Set the scope of access that 'username' has to 'pipeline'. " +
+			"Readers may read the pipeline's logs and datums, writers may also " +
+			"update, stop, start and restart datums of the pipeline, and owners may " +
+			"also delete the pipeline and modify its access permissions. Once a " +
+			"pipeline has an ACL, it's used instead of the ACL of the pipeline's " +
+			"output repo for these operations.",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			scope, err := auth.ParseScope(args[1])
+			if err != nil {
+				return err
+			}
+			username, pipeline := args[0], args[2]
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.SetScope(c.Ctx(), &auth.SetScopeRequest{
+				Pipeline: pipeline,
+				Scope:    scope,
+				Username: username,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(setPipelineScope, "auth set pipeline")
+}
+
+// CreateRoleCmd returns a cobra command that creates a custom role
+func CreateRoleCmd() *cobra.Command {
+	var description string
+	createRole := &cobra.Command{
+		Use:   "{{alias}} <role> <permission>...",
+		Short: "Create a custom role from a set of permissions",
+		Long: "Create a custom role from a set of permissions. Permissions are " +
+			"one of repo-read, repo-write, repo-delete, pipeline-logs, " +
+			"pipeline-update, pipeline-delete and auth-modify-acl. Only cluster " +
+			"admins may create roles.",
+		Run: cmdutil.RunMinimumArgs(2, func(args []string) error {
+			role := &auth.Role{Name: args[0], Description: description}
+			for _, arg := range args[1:] {
+				permission, err := auth.ParsePermission(arg)
+				if err != nil {
+					return err
+				}
+				role.Permissions = append(role.Permissions, permission)
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.CreateRole(c.Ctx(), &auth.CreateRoleRequest{Role: role})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createRole.Flags().StringVarP(&description, "description", "d", "", "A description of the role.")
+	return cmdutil.CreateAlias(createRole, "auth create-role")
+}
+
+// DeleteRoleCmd returns a cobra command that deletes a custom role
+func DeleteRoleCmd() *cobra.Command {
+	deleteRole := &cobra.Command{
+		Use:   "{{alias}} <role>",
+		Short: "Delete a custom role",
+		Long: "Delete a custom role. Existing bindings of the role are kept, but " +
+			"no longer grant any permissions.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.DeleteRole(c.Ctx(), &auth.DeleteRoleRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteRole, "auth delete-role")
+}
+
+// ListRolesCmd returns a cobra command that lists the built-in and custom
+// roles
+func ListRolesCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
+	listRoles := &cobra.Command{
+		Short: "List the built-in and custom roles",
+		Long:  "List the built-in and custom roles, and their permissions.",
+		Run: cmdutil.Run(func([]string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListRoles(c.Ctx(), &auth.ListRolesRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := output.NewPrinter(os.Stdout, cmdutil.Table{
+				PrintDetailed: func(item proto.Message) error {
+					role := item.(*auth.Role)
+					var permissions []string
+					for _, p := range role.Permissions {
+						permissions = append(permissions, strings.ToLower(p.String()))
+					}
+					name := role.Name
+					if role.Builtin {
+						name += " (built-in)"
+					}
+					fmt.Printf("%s: %s\n", name, strings.Join(permissions, ", "))
+					return nil
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, role := range resp.Roles {
+				if err := printer.Print(role); err != nil {
+					return err
+				}
+			}
+			return printer.Close()
+		}),
+	}
+	listRoles.Flags().AddFlagSet(outputFlags)
+	return cmdutil.CreateAlias(listRoles, "auth list-roles")
+}
+
+// SetRoleBindingCmd returns a cobra command that sets the roles bound to a
+// principal on a repo or on the cluster
+func SetRoleBindingCmd() *cobra.Command {
+	var repo string
+	setRoleBinding := &cobra.Command{
+		Use:   "{{alias}} <principal> [<role>...]",
+		Short: "Set the roles bound to 'principal'",
+		Long: "Set the roles bound to 'principal' on 'repo', or on the whole " +
+			"cluster if --repo isn't set. Passing no roles removes all of the " +
+			"principal's role bindings. Binding roles on a repo requires the " +
+			"auth-modify-acl permission on it, and binding roles on the cluster " +
+			"requires being a cluster admin.",
+		Run: cmdutil.RunMinimumArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.ModifyRoleBinding(c.Ctx(), &auth.ModifyRoleBindingRequest{
+				Principal: args[0],
+				Repo:      repo,
+				Roles:     args[1:],
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	setRoleBinding.Flags().StringVarP(&repo, "repo", "r", "", "The repo on which roles are bound (default: the whole cluster).")
+	return cmdutil.CreateAlias(setRoleBinding, "auth set-role-binding")
+}
+
+// GetRoleBindingsCmd returns a cobra command that prints the role bindings on
+// a repo or on the cluster
+func GetRoleBindingsCmd() *cobra.Command {
+	var repo string
+	output, outputFlags := cmdutil.NewOutputFlags()
+	getRoleBindings := &cobra.Command{
+		Short: "Get the role bindings on a repo or the cluster",
+		Long: "Get the role bindings on 'repo', or on the whole cluster if " +
+			"--repo isn't set.",
+		Run: cmdutil.Run(func([]string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.GetRoleBindings(c.Ctx(), &auth.GetRoleBindingsRequest{Repo: repo})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			return output.Print(os.Stdout, cmdutil.Table{
+				PrintDetailed: func(proto.Message) error {
+					var principals []string
+					for principal := range resp.Bindings.Entries {
+						principals = append(principals, principal)
+					}
+					sort.Strings(principals)
+					for _, principal := range principals {
+						fmt.Printf("%s: %s\n", principal, strings.Join(resp.Bindings.Entries[principal].Names, ", "))
+					}
+					return nil
+				},
+			}, resp)
+		}),
+	}
+	getRoleBindings.Flags().AddFlagSet(outputFlags)
+	getRoleBindings.Flags().StringVarP(&repo, "repo", "r", "", "The repo whose role bindings are printed (default: the whole cluster).")
+	return cmdutil.CreateAlias(getRoleBindings, "auth get-role-bindings")
+}
+
 // ListAdminsCmd returns a cobra command that lists the current cluster admins
 func ListAdminsCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
 	listAdmins := &cobra.Command{
 		Short: "List the current cluster admins",
 		Long:  "List the current cluster admins",
@@ -428,12 +727,17 @@ func ListAdminsCmd() *cobra.Command {
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
-			for _, user := range resp.Admins {
-				fmt.Println(user)
-			}
-			return nil
+			return output.Print(os.Stdout, cmdutil.Table{
+				PrintDetailed: func(proto.Message) error {
+					for _, user := range resp.Admins {
+						fmt.Println(user)
+					}
+					return nil
+				},
+			}, resp)
 		}),
 	}
+	listAdmins.Flags().AddFlagSet(outputFlags)
 	return cmdutil.CreateAlias(listAdmins, "auth list-admins")
 }
 
@@ -610,14 +914,47 @@ func Cmds() []*cobra.Command {
 	commands = append(commands, WhoamiCmd())
 	commands = append(commands, CheckCmd())
 	commands = append(commands, SetScopeCmd())
+	commands = append(commands, UnprotectBranchCmd())
+	commands = append(commands, SetPipelineScopeCmd())
 	commands = append(commands, GetCmd())
+	commands = append(commands, GetPipelineCmd())
+	commands = append(commands, CreateRoleCmd())
+	commands = append(commands, DeleteRoleCmd())
+	commands = append(commands, ListRolesCmd())
+	commands = append(commands, SetRoleBindingCmd())
+	commands = append(commands, GetRoleBindingsCmd())
 	commands = append(commands, ListAdminsCmd())
 	commands = append(commands, ModifyAdminsCmd())
 	commands = append(commands, GetAuthTokenCmd())
 	commands = append(commands, UseAuthTokenCmd())
+	commands = append(commands, ListTokensCmd())
+	commands = append(commands, RevokeCmd())
 	commands = append(commands, GetConfigCmd())
 	commands = append(commands, SetConfigCmd())
 	commands = append(commands, GetOneTimePasswordCmd())
+	commands = append(commands, CreateRobotCmd())
+	commands = append(commands, ListRobotsCmd())
+	commands = append(commands, DeleteRobotCmd())
+	commands = append(commands, CreateRobotKeyCmd())
+	commands = append(commands, ListRobotKeysCmd())
+	commands = append(commands, DeleteRobotKeyCmd())
+	commands = append(commands, RotateRobotKeyCmd())
 
 	return commands
 }
+
+// aclTable pretty prints the ACL of a repo or pipeline
+func aclTable(resp *auth.GetACLResponse) cmdutil.Table {
+	return cmdutil.Table{
+		PrintDetailed: func(proto.Message) error {
+			t := template.Must(template.New("ACLEntries").Parse(
+				"{{range .}}{{.Username }}: {{.Scope}}\n{{end}}"))
+			if err := t.Execute(os.Stdout, resp.Entries); err != nil {
+				return err
+			}
+			t = template.Must(template.New("BranchACLEntries").Parse(
+				"{{range .}}{{if .Username}}{{.Username }}: {{.Scope}}{{else}}(no writers){{end}} (branch {{.Branch}})\n{{end}}"))
+			return t.Execute(os.Stdout, resp.BranchEntries)
+		},
+	}
+}
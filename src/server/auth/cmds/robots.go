@@ -0,0 +1,341 @@
+package cmds
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	robotHeader    = "NAME\tCREATED\tCREATED BY\tKEYS\tDESCRIPTION\t\n"
+	robotKeyHeader = "NAME\tCREATED\tEXPIRES\tRESTRICTIONS\t\n"
+)
+
+var (
+	robotCSVHeader    = []string{"name", "created", "created_by", "keys", "description"}
+	robotKeyCSVHeader = []string{"name", "created", "expiration", "repos", "read_only"}
+)
+
+// parseSeconds parses 'd' (a golang duration) into a number of seconds, or
+// returns 0 if 'd' is empty
+func parseSeconds(d string) (int64, error) {
+	if d == "" {
+		return 0, nil
+	}
+	duration, err := time.ParseDuration(d)
+	if err != nil {
+		return 0, errors.Wrapf(err, "could not parse duration %q", d)
+	}
+	return int64(duration.Seconds()), nil
+}
+
+func printRobotKey(w io.Writer, key *auth.RobotKey) {
+	expires := "never"
+	if key.Expiration != nil {
+		t, err := types.TimestampFromProto(key.Expiration)
+		if err == nil {
+			expires = t.Local().Format(time.RFC822)
+		}
+	}
+	var restrictions []string
+	if r := key.Restrictions; r != nil {
+		if len(r.Repos) > 0 {
+			restrictions = append(restrictions, "repos: "+strings.Join(r.Repos, ","))
+		}
+		if r.ReadOnly {
+			restrictions = append(restrictions, "read-only")
+		}
+	}
+	if len(restrictions) == 0 {
+		restrictions = append(restrictions, "none")
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", key.Name, pretty.Ago(key.Created), expires, strings.Join(restrictions, "; "))
+}
+
+// robotKeyCSVRow returns the csv record of 'key', whose columns are
+// robotKeyCSVHeader
+func robotKeyCSVRow(key *auth.RobotKey) []string {
+	return []string{key.Name, cmdutil.CSVTimestamp(key.Created),
+		cmdutil.CSVTimestamp(key.Expiration),
+		strings.Join(key.Restrictions.GetRepos(), ","),
+		strconv.FormatBool(key.Restrictions.GetReadOnly())}
+}
+
+// printNewRobotKey prints a robot key's token, which can't be retrieved again
+func printNewRobotKey(robot, token string, quiet bool) {
+	if quiet {
+		fmt.Println(token)
+		return
+	}
+	fmt.Printf("New API key for %s%s (this is the only time it will be shown):\n  Token: %s\n", auth.RobotPrefix, robot, token)
+}
+
+// CreateRobotCmd returns a cobra command that creates a robot service account
+func CreateRobotCmd() *cobra.Command {
+	var description string
+	createRobot := &cobra.Command{
+		Use:   "{{alias}} <robot>",
+		Short: "Create a robot service account",
+		Long: "Create a robot service account, whose subject is \"robot:<robot>\". " +
+			"Robots authenticate with API keys (see 'create-robot-key'), and are " +
+			"granted access like any other user. Only cluster admins may create " +
+			"robots.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.CreateRobot(c.Ctx(), &auth.CreateRobotRequest{
+				Name:        args[0],
+				Description: description,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	createRobot.Flags().StringVarP(&description, "description", "d", "", "A description of the robot.")
+	return cmdutil.CreateAlias(createRobot, "auth create-robot")
+}
+
+// ListRobotsCmd returns a cobra command that lists robot service accounts
+func ListRobotsCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
+	listRobots := &cobra.Command{
+		Short: "List robot service accounts",
+		Long:  "List robot service accounts. Only cluster admins may list robots.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListRobots(c.Ctx(), &auth.ListRobotsRequest{})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := output.NewPrinter(os.Stdout, cmdutil.Table{
+				Header: robotHeader,
+				PrintRow: func(w io.Writer, item proto.Message) {
+					robot := item.(*auth.Robot)
+					fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t\n", robot.Name,
+						pretty.Ago(robot.Created), robot.CreatedBy, len(robot.Keys), robot.Description)
+				},
+				CSVHeader: robotCSVHeader,
+				CSVRow: func(item proto.Message) []string {
+					robot := item.(*auth.Robot)
+					return []string{robot.Name, cmdutil.CSVTimestamp(robot.Created),
+						robot.CreatedBy, strconv.Itoa(len(robot.Keys)), robot.Description}
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, robot := range resp.Robots {
+				if err := printer.Print(robot); err != nil {
+					return err
+				}
+			}
+			return printer.Close()
+		}),
+	}
+	listRobots.Flags().AddFlagSet(outputFlags)
+	return cmdutil.CreateAlias(listRobots, "auth list-robots")
+}
+
+// DeleteRobotCmd returns a cobra command that deletes a robot service account
+func DeleteRobotCmd() *cobra.Command {
+	deleteRobot := &cobra.Command{
+		Use:   "{{alias}} <robot>",
+		Short: "Delete a robot service account",
+		Long: "Delete a robot service account, and revoke all of its API keys. " +
+			"Only cluster admins may delete robots.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.DeleteRobot(c.Ctx(), &auth.DeleteRobotRequest{Name: args[0]})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteRobot, "auth delete-robot")
+}
+
+// CreateRobotKeyCmd returns a cobra command that creates an API key for a
+// robot
+func CreateRobotKeyCmd() *cobra.Command {
+	var ttl string
+	var repos []string
+	var readOnly, quiet bool
+	createRobotKey := &cobra.Command{
+		Use:   "{{alias}} <robot> <key>",
+		Short: "Create a named API key for a robot",
+		Long: "Create a named API key for a robot. The key is printed once, and " +
+			"can't be retrieved again. Keys may be restricted to a set of repos " +
+			"(--repo) or to reading data (--read-only), in which case they never " +
+			"carry cluster admin privileges. Only cluster admins may create keys.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			seconds, err := parseSeconds(ttl)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			req := &auth.CreateRobotKeyRequest{
+				Robot: args[0],
+				Name:  args[1],
+				TTL:   seconds,
+			}
+			if len(repos) > 0 || readOnly {
+				req.Restrictions = &auth.TokenRestrictions{Repos: repos, ReadOnly: readOnly}
+			}
+			resp, err := c.CreateRobotKey(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printNewRobotKey(args[0], resp.Token, quiet)
+			return nil
+		}),
+	}
+	createRobotKey.Flags().StringVar(&ttl, "ttl", "", "The lifetime of the key, "+
+		"as a golang duration (e.g. \"720h\"). If unset, the key never expires.")
+	createRobotKey.Flags().StringSliceVarP(&repos, "repo", "r", nil, "Restrict "+
+		"the key to the given repo. May be repeated.")
+	createRobotKey.Flags().BoolVar(&readOnly, "read-only", false, "Restrict the "+
+		"key to reading data and logs.")
+	createRobotKey.Flags().BoolVarP(&quiet, "quiet", "q", false, "Only print the key.")
+	return cmdutil.CreateAlias(createRobotKey, "auth create-robot-key")
+}
+
+// ListRobotKeysCmd returns a cobra command that lists a robot's API keys
+func ListRobotKeysCmd() *cobra.Command {
+	output, outputFlags := cmdutil.NewOutputFlags()
+	listRobotKeys := &cobra.Command{
+		Use:   "{{alias}} <robot>",
+		Short: "List a robot's API keys",
+		Long: "List the names, expirations and restrictions of a robot's API keys " +
+			"(but not the keys themselves). Only cluster admins may list keys.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListRobotKeys(c.Ctx(), &auth.ListRobotKeysRequest{Robot: args[0]})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := output.NewPrinter(os.Stdout, cmdutil.Table{
+				Header: robotKeyHeader,
+				PrintRow: func(w io.Writer, item proto.Message) {
+					printRobotKey(w, item.(*auth.RobotKey))
+				},
+				CSVHeader: robotKeyCSVHeader,
+				CSVRow: func(item proto.Message) []string {
+					return robotKeyCSVRow(item.(*auth.RobotKey))
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, key := range resp.Keys {
+				if err := printer.Print(key); err != nil {
+					return err
+				}
+			}
+			return printer.Close()
+		}),
+	}
+	listRobotKeys.Flags().AddFlagSet(outputFlags)
+	return cmdutil.CreateAlias(listRobotKeys, "auth list-robot-keys")
+}
+
+// DeleteRobotKeyCmd returns a cobra command that revokes one of a robot's API
+// keys
+func DeleteRobotKeyCmd() *cobra.Command {
+	deleteRobotKey := &cobra.Command{
+		Use:   "{{alias}} <robot> <key>",
+		Short: "Delete one of a robot's API keys",
+		Long: "Delete one of a robot's API keys, revoking it immediately. Only " +
+			"cluster admins may delete keys.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			_, err = c.DeleteRobotKey(c.Ctx(), &auth.DeleteRobotKeyRequest{
+				Robot: args[0],
+				Name:  args[1],
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return cmdutil.CreateAlias(deleteRobotKey, "auth delete-robot-key")
+}
+
+// RotateRobotKeyCmd returns a cobra command that replaces the token of one of
+// a robot's API keys
+func RotateRobotKeyCmd() *cobra.Command {
+	var ttl, overlap string
+	var quiet bool
+	rotateRobotKey := &cobra.Command{
+		Use:   "{{alias}} <robot> <key>",
+		Short: "Replace one of a robot's API keys with a new one",
+		Long: "Replace one of a robot's API keys with a new one, keeping its name " +
+			"and restrictions. The old key remains valid for --overlap, so that " +
+			"clients can switch to the new key without downtime. Only cluster " +
+			"admins may rotate keys.",
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			ttlSeconds, err := parseSeconds(ttl)
+			if err != nil {
+				return err
+			}
+			overlapSeconds, err := parseSeconds(overlap)
+			if err != nil {
+				return err
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.RotateRobotKey(c.Ctx(), &auth.RotateRobotKeyRequest{
+				Robot:   args[0],
+				Name:    args[1],
+				TTL:     ttlSeconds,
+				Overlap: overlapSeconds,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printNewRobotKey(args[0], resp.Token, quiet)
+			return nil
+		}),
+	}
+	rotateRobotKey.Flags().StringVar(&ttl, "ttl", "", "The lifetime of the new "+
+		"key, as a golang duration (e.g. \"720h\"). If unset, the key never expires.")
+	rotateRobotKey.Flags().StringVar(&overlap, "overlap", "", "How long the old "+
+		"key remains valid, as a golang duration (e.g. \"1h\"). If unset, the old "+
+		"key is revoked immediately.")
+	rotateRobotKey.Flags().BoolVarP(&quiet, "quiet", "q", false, "Only print the new key.")
+	return cmdutil.CreateAlias(rotateRobotKey, "auth rotate-robot-key")
+}
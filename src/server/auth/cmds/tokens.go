@@ -0,0 +1,172 @@
+package cmds
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	tokenHeader = "ID\tSUBJECT\tSOURCE\tID PROVIDER\tCREATED\tEXPIRES\t\n"
+
+	// shortTokenIDLen is the number of characters of a token's hash that
+	// 'list-tokens' prints (unless --full-id is set)
+	shortTokenIDLen = 12
+)
+
+var tokenCSVHeader = []string{"hashed_token", "subject", "source", "robot_key",
+	"id_provider", "created", "expiration"}
+
+func printAuthToken(w io.Writer, token *auth.AuthTokenDescription, fullID bool) {
+	id := token.HashedToken
+	if !fullID && len(id) > shortTokenIDLen {
+		id = id[:shortTokenIDLen]
+	}
+	info := token.Info
+	if info == nil {
+		info = &auth.TokenInfo{}
+	}
+	source := strings.ToLower(info.Source.String())
+	if info.RobotKey != "" {
+		source = fmt.Sprintf("%s (%s)", source, info.RobotKey)
+	}
+	idp := info.IDProvider
+	if idp == "" {
+		idp = "-"
+	}
+	created := pretty.Ago(info.Created)
+	if created == "" {
+		created = "-"
+	}
+	expires := "never"
+	if token.Expiration != nil {
+		t, err := types.TimestampFromProto(token.Expiration)
+		if err == nil {
+			expires = t.Local().Format(time.RFC822)
+		}
+	}
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t\n", id, info.Subject, source, idp, created, expires)
+}
+
+// authTokenCSVRow returns the csv record of 'token', whose columns are
+// tokenCSVHeader. Unlike the table, it always includes the full hash.
+func authTokenCSVRow(token *auth.AuthTokenDescription) []string {
+	info := token.Info
+	if info == nil {
+		info = &auth.TokenInfo{}
+	}
+	return []string{token.HashedToken, info.Subject, info.Source.String(),
+		info.RobotKey, info.IDProvider, cmdutil.CSVTimestamp(info.Created),
+		cmdutil.CSVTimestamp(token.Expiration)}
+}
+
+// ListTokensCmd returns a cobra command that lists active auth tokens
+func ListTokensCmd() *cobra.Command {
+	var user string
+	var fullID bool
+	output, outputFlags := cmdutil.NewOutputFlags()
+	listTokens := &cobra.Command{
+		Short: "List active auth tokens",
+		Long: "List active auth tokens, identified by their hashes (the tokens " +
+			"themselves are never shown). Users may list their own tokens, and " +
+			"cluster admins may list any user's tokens, or every token in the " +
+			"cluster if --user is unset.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			resp, err := c.ListAuthTokens(c.Ctx(), &auth.ListAuthTokensRequest{Subject: user})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := output.NewPrinter(os.Stdout, cmdutil.Table{
+				Header: tokenHeader,
+				PrintRow: func(w io.Writer, item proto.Message) {
+					printAuthToken(w, item.(*auth.AuthTokenDescription), fullID)
+				},
+				CSVHeader: tokenCSVHeader,
+				CSVRow: func(item proto.Message) []string {
+					return authTokenCSVRow(item.(*auth.AuthTokenDescription))
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, token := range resp.Tokens {
+				if err := printer.Print(token); err != nil {
+					return err
+				}
+			}
+			return printer.Close()
+		}),
+	}
+	listTokens.Flags().AddFlagSet(outputFlags)
+	listTokens.Flags().StringVarP(&user, "user", "u", "", "Only list the "+
+		"tokens of this user.")
+	listTokens.Flags().BoolVar(&fullID, "full-id", false, "Print the full hash "+
+		"of each token.")
+	return cmdutil.CreateAlias(listTokens, "auth list-tokens")
+}
+
+// RevokeCmd returns a cobra command that revokes auth tokens
+func RevokeCmd() *cobra.Command {
+	var user string
+	var token bool
+	revoke := &cobra.Command{
+		Short: "Revoke auth tokens",
+		Long: "Revoke auth tokens. With --user, revoke every token (and one-time " +
+			"password) of a user, e.g. to end all of their sessions. With " +
+			"--token, read a single token from stdin and revoke it. Users may " +
+			"revoke their own tokens, and cluster admins may revoke any user's " +
+			"tokens.",
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			if (user == "") == !token {
+				return errors.Errorf("exactly one of --user or --token must be set")
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return errors.Wrapf(err, "could not connect")
+			}
+			defer c.Close()
+			if token {
+				fmt.Println("Please paste the auth token to revoke:")
+				t, err := cmdutil.ReadPassword("")
+				if err != nil {
+					return errors.Wrapf(err, "error reading token")
+				}
+				_, err = c.RevokeAuthToken(c.Ctx(), &auth.RevokeAuthTokenRequest{
+					Token: strings.TrimSpace(t), // drop trailing newline
+				})
+				return grpcutil.ScrubGRPC(err)
+			}
+			resp, err := c.RevokeAuthTokensForUser(c.Ctx(), &auth.RevokeAuthTokensForUserRequest{
+				Subject: user,
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			fmt.Printf("Revoked %d token(s)\n", resp.NumberRevoked)
+			return nil
+		}),
+	}
+	revoke.Flags().StringVarP(&user, "user", "u", "", "Revoke all tokens of "+
+		"this user.")
+	revoke.Flags().BoolVar(&token, "token", false, "Read a token from stdin and "+
+		"revoke it.")
+	return cmdutil.CreateAlias(revoke, "auth revoke")
+}
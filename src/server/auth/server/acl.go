@@ -0,0 +1,221 @@
+package server
+
+import (
+	"path"
+	"sort"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	enterpriseclient "github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+
+	"golang.org/x/net/context"
+)
+
+// validateBranchPattern returns an error if 'pattern' isn't a valid branch
+// name or glob pattern for a branch ACL
+func validateBranchPattern(pattern string) error {
+	if pattern == "" {
+		return errors.Errorf("invalid branch pattern: must not be empty")
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return errors.Wrapf(err, "invalid branch pattern %q", pattern)
+	}
+	return nil
+}
+
+// matchingBranchACLs returns the branch ACLs in 'acl' whose pattern matches
+// 'branch'
+func matchingBranchACLs(acl *auth.ACL, branch string) []*auth.BranchACL {
+	var result []*auth.BranchACL
+	for pattern, branchACL := range acl.Branches {
+		if ok, err := path.Match(pattern, branch); err == nil && ok {
+			result = append(result, branchACL)
+		}
+	}
+	return result
+}
+
+// getBranchScope returns the scope that 'subject' has to 'branch' in the
+// branch ACLs of 'acl'. 'matched' is false if no branch ACL applies to
+// 'branch', in which case the repo's scope applies instead.
+func (a *apiServer) getBranchScope(ctx context.Context, subject string, acl *auth.ACL, branch string) (scope auth.Scope, matched bool, retErr error) {
+	for _, branchACL := range matchingBranchACLs(acl, branch) {
+		matched = true
+		branchScope, err := a.getScope(ctx, subject, &auth.ACL{Entries: branchACL.Entries})
+		if err != nil {
+			return auth.Scope_NONE, false, err
+		}
+		if scope < branchScope {
+			scope = branchScope
+		}
+	}
+	return scope, matched, nil
+}
+
+// setBranchScope sets the scope of 'principal' in the branch ACL for
+// 'pattern' in 'acl', creating the branch ACL if necessary. The branch ACL is
+// kept even if it's left with no entries, so that removing the last principal
+// doesn't unprotect the branches it matches (see deleteBranchACL). If
+// 'principal' is empty, the branch ACL is only created.
+func setBranchScope(acl *auth.ACL, pattern, principal string, scope auth.Scope) {
+	if acl.Branches == nil {
+		acl.Branches = make(map[string]*auth.BranchACL)
+	}
+	branchACL, ok := acl.Branches[pattern]
+	if !ok {
+		branchACL = &auth.BranchACL{Entries: make(map[string]auth.Scope)}
+		acl.Branches[pattern] = branchACL
+	}
+	if principal == "" {
+		return
+	}
+	if scope != auth.Scope_NONE {
+		branchACL.Entries[principal] = scope
+	} else {
+		delete(branchACL.Entries, principal)
+	}
+}
+
+// deleteBranchACL removes the branch ACL for 'pattern' from 'acl', so that the
+// branches it matches are governed by the repo's ACL again
+func deleteBranchACL(acl *auth.ACL, pattern string) {
+	delete(acl.Branches, pattern)
+}
+
+// branchACLEntries flattens the branch ACLs in 'acl' into a list of entries,
+// sorted by branch pattern and then by principal
+func branchACLEntries(acl *auth.ACL) []*auth.BranchACLEntry {
+	var result []*auth.BranchACLEntry
+	for pattern, branchACL := range acl.Branches {
+		if len(branchACL.Entries) == 0 {
+			// A branch ACL with no entries still protects its branches
+			result = append(result, &auth.BranchACLEntry{Branch: pattern})
+		}
+		for principal, scope := range branchACL.Entries {
+			result = append(result, &auth.BranchACLEntry{
+				Branch:   pattern,
+				Username: principal,
+				Scope:    scope,
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Branch != result[j].Branch {
+			return result[i].Branch < result[j].Branch
+		}
+		return result[i].Username < result[j].Username
+	})
+	return result
+}
+
+// getPipelineACL reads the ACL of 'pipeline'. If the pipeline has no ACL, the
+// returned ACL has no entries.
+func (a *apiServer) getPipelineACL(txnCtx *txnenv.TransactionContext, pipeline string) (*auth.ACL, error) {
+	acl := &auth.ACL{}
+	if err := a.pipelineACLs.ReadWrite(txnCtx.Stm).Get(pipeline, acl); err != nil && !col.IsErrNotFound(err) {
+		return nil, errors.Wrapf(err, "error getting ACL for pipeline \"%s\"", pipeline)
+	}
+	if acl.Entries == nil {
+		acl.Entries = make(map[string]auth.Scope)
+	}
+	return acl, nil
+}
+
+// authorizePipelineACLChange checks that the caller may modify the ACL of
+// 'pipeline'. Admins and the pipeline's OWNERs may, as may the OWNERs of the
+// pipeline's output repo if the pipeline doesn't have an ACL yet.
+func (a *apiServer) authorizePipelineACLChange(txnCtx *txnenv.TransactionContext, callerInfo *auth.TokenInfo, isAdmin bool, pipeline string, acl *auth.ACL) error {
+	if isAdmin {
+		return nil
+	}
+
+	// Check if the cluster's enterprise token is expired (fail if so)
+	state, err := a.getEnterpriseTokenState()
+	if err != nil {
+		return errors.Wrapf(err, "error confirming Pachyderm Enterprise token")
+	}
+	if state != enterpriseclient.State_ACTIVE {
+		return errors.Errorf("Pachyderm Enterprise is not active in this " +
+			"cluster (only a cluster admin can modify an ACL)")
+	}
+
+	aclToCheck, repo := acl, ""
+	if len(acl.Entries) == 0 {
+		// The pipeline has no ACL. Check that it exists (via its output repo),
+		// and fall back to the output repo's ACL
+		if _, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx,
+			&pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: pipeline}},
+		); err != nil {
+			return err
+		}
+		aclToCheck, repo = &auth.ACL{}, pipeline
+		if err := a.acls.ReadWrite(txnCtx.Stm).Get(pipeline, aclToCheck); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+	}
+	ok, err := a.hasPermission(txnCtx, callerInfo.Subject, repo, aclToCheck, auth.Permission_AUTH_MODIFY_ACL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return &auth.ErrNotAuthorized{
+			Subject:  callerInfo.Subject,
+			Pipeline: pipeline,
+			Required: auth.Scope_OWNER,
+		}
+	}
+	return nil
+}
+
+// putPipelineACL writes 'acl' as the ACL of 'pipeline', or deletes the
+// pipeline's ACL if 'acl' is empty
+func (a *apiServer) putPipelineACL(txnCtx *txnenv.TransactionContext, pipeline string, acl *auth.ACL) error {
+	pipelineACLs := a.pipelineACLs.ReadWrite(txnCtx.Stm)
+	if len(acl.Entries) == 0 {
+		if err := pipelineACLs.Delete(pipeline); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	return pipelineACLs.Put(pipeline, acl)
+}
+
+// setPipelineScope implements SetScope for requests that set a principal's
+// scope on a pipeline
+func (a *apiServer) setPipelineScope(txnCtx *txnenv.TransactionContext, callerInfo *auth.TokenInfo, isAdmin bool, req *auth.SetScopeRequest) error {
+	acl, err := a.getPipelineACL(txnCtx, req.Pipeline)
+	if err != nil {
+		return err
+	}
+	if err := a.authorizePipelineACLChange(txnCtx, callerInfo, isAdmin, req.Pipeline, acl); err != nil {
+		return err
+	}
+	principal, err := a.canonicalizeSubject(txnCtx.ClientContext, req.Username)
+	if err != nil {
+		return err
+	}
+	if req.Scope != auth.Scope_NONE {
+		acl.Entries[principal] = req.Scope
+	} else {
+		delete(acl.Entries, principal)
+	}
+	return a.putPipelineACL(txnCtx, req.Pipeline, acl)
+}
+
+// setPipelineACL implements SetACL for requests that set a pipeline's ACL
+func (a *apiServer) setPipelineACL(txnCtx *txnenv.TransactionContext, callerInfo *auth.TokenInfo, isAdmin bool, req *auth.SetACLRequest, newACL *auth.ACL) error {
+	acl, err := a.getPipelineACL(txnCtx, req.Pipeline)
+	if err != nil {
+		return err
+	}
+	if len(acl.Entries) == 0 && len(newACL.Entries) == 0 {
+		return nil // nothing to delete (e.g. DeletePipeline of a pipeline w/o an ACL)
+	}
+	if err := a.authorizePipelineACLChange(txnCtx, callerInfo, isAdmin, req.Pipeline, acl); err != nil {
+		return err
+	}
+	return a.putPipelineACL(txnCtx, req.Pipeline, newACL)
+}
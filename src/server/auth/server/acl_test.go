@@ -0,0 +1,28 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestSetBranchScope(t *testing.T) {
+	acl := &auth.ACL{}
+	setBranchScope(acl, "dev/*", "github:alice", auth.Scope_WRITER)
+	require.Equal(t, 1, len(matchingBranchACLs(acl, "dev/feature")))
+	require.Equal(t, 0, len(matchingBranchACLs(acl, "master")))
+
+	// Removing the last principal keeps the branches protected
+	setBranchScope(acl, "dev/*", "github:alice", auth.Scope_NONE)
+	require.Equal(t, 1, len(matchingBranchACLs(acl, "dev/feature")))
+	require.Equal(t, []*auth.BranchACLEntry{{Branch: "dev/*"}}, branchACLEntries(acl))
+
+	// An empty principal only creates the branch ACL
+	setBranchScope(acl, "master", "", auth.Scope_NONE)
+	require.Equal(t, 1, len(matchingBranchACLs(acl, "master")))
+
+	deleteBranchACL(acl, "dev/*")
+	require.Equal(t, 0, len(matchingBranchACLs(acl, "dev/feature")))
+	require.Equal(t, []*auth.BranchACLEntry{{Branch: "master"}}, branchACLEntries(acl))
+}
@@ -9,6 +9,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client/auth"
@@ -27,6 +28,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
+	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/crewjam/saml"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
@@ -44,17 +46,24 @@ const (
 	// pachyderm token for any username in the AuthenticateRequest.GitHubToken field
 	DisableAuthenticationEnvVar = "PACHYDERM_AUTHENTICATION_DISABLED_FOR_TESTING"
 
-	allClusterUsersSubject = "allClusterUsers"
-
-	tokensPrefix           = "/tokens"
-	oneTimePasswordsPrefix = "/auth-codes"
-	aclsPrefix             = "/acls"
-	adminsPrefix           = "/admins"
-	fsAdminsPrefix         = "/fs-admins"
-	membersPrefix          = "/members"
-	groupsPrefix           = "/groups"
-	configPrefix           = "/config"
-	oidcAuthnPrefix        = "/oidc-authns"
+	allClusterUsersSubject = auth.AllClusterUsersSubject
+
+	tokensPrefix            = "/tokens"
+	oneTimePasswordsPrefix  = "/auth-codes"
+	aclsPrefix              = "/acls"
+	pipelineACLsPrefix      = "/pipeline-acls"
+	rolesPrefix             = "/roles"
+	roleBindingsPrefix      = "/role-bindings"
+	robotsPrefix            = "/robots"
+	scimUsersPrefix         = "/scim-users"
+	scimGroupsPrefix        = "/scim-groups"
+	scimDeprovisionedPrefix = "/scim-deprovisioned"
+	adminsPrefix            = "/admins"
+	fsAdminsPrefix          = "/fs-admins"
+	membersPrefix           = "/members"
+	groupsPrefix            = "/groups"
+	configPrefix            = "/config"
+	oidcAuthnPrefix         = "/oidc-authns"
 
 	// defaultSessionTTLSecs is the lifetime of an auth token from Authenticate,
 	// and the default lifetime of an auth token from GetAuthToken.
@@ -99,8 +108,21 @@ const (
 
 	// OidcPort is the port where OIDC ID Providers can send auth assertions
 	OidcPort = 657
+
+	// ScimPort is the port where ID providers can provision users and groups
+	// via SCIM
+	ScimPort = 658
+
+	// githubIDPName is recorded as the ID provider of tokens issued to GitHub
+	// users
+	githubIDPName = "github"
 )
 
+// tokensSubjectIndex indexes the tokens collection by the subject that each
+// token authenticates. Tokens issued before the index was added are added to
+// it by indexTokens.
+var tokensSubjectIndex = &col.Index{Field: "Subject"}
+
 // DefaultAuthConfig is the default config for the auth API server
 var DefaultAuthConfig = auth.AuthConfig{
 	LiveConfigVersion: 1,
@@ -159,15 +181,44 @@ type apiServer struct {
 	oidcSP   *InternalOIDCProvider // object representing the OIDC provider
 	oidcSPMu sync.Mutex            // guard 'oidcSP'. Always lock after 'configMu' (if using both)
 
+	// certTokens caches the tokens issued to callers that authenticate with a
+	// client certificate (see clientCertToken)
+	certTokens   map[string]certToken
+	certTokensMu sync.Mutex // guard 'certTokens'
+
 	// tokens is a collection of hashedToken -> TokenInfo mappings. These tokens are
 	// returned to users by Authenticate()
 	tokens col.Collection
+	// tokensIndexed is set (atomically) to 1 once indexTokens has added every
+	// token to tokensSubjectIndex
+	tokensIndexed int32
 	// oneTimePasswords is a collection of hash(code) -> TokenInfo mappings.
 	// These codes are generated internally, and converted to regular tokens by
 	// Authenticate()
 	oneTimePasswords col.Collection
 	// acls is a collection of repoName -> ACL mappings.
 	acls col.Collection
+	// pipelineACLs is a collection of pipelineName -> ACL mappings. Pipelines
+	// without an ACL are governed by the ACL of their output repo.
+	pipelineACLs col.Collection
+	// roles is a collection of roleName -> Role mappings, containing custom
+	// roles (the built-in roles aren't stored)
+	roles col.Collection
+	// roleBindings is a collection of repoName -> RoleBindings mappings. The
+	// cluster's role bindings are stored under clusterRoleBindingsKey.
+	roleBindings col.Collection
+	// robots is a collection of robotName -> Robot mappings, containing the
+	// cluster's service accounts and the hashes of their API keys
+	robots col.Collection
+	// scimUsers and scimGroups are collections of ID -> SCIMUser and
+	// ID -> SCIMGroup mappings, containing the users and groups provisioned
+	// through the SCIM server
+	scimUsers  col.Collection
+	scimGroups col.Collection
+	// scimDeprovisioned is a collection of subject -> SCIMUser mappings,
+	// containing the last record of each SCIM user that was deleted or
+	// renamed, so that their subject still can't log in
+	scimDeprovisioned col.Collection
 	// admins is a collection of username -> Empty mappings (keys indicate which
 	// github users are cluster admins)
 	admins col.Collection
@@ -229,10 +280,11 @@ func NewAuthServer(
 		txnEnv:     txnEnv,
 		pachLogger: log.NewLogger("auth.API"),
 		adminCache: make(map[string]auth.ClusterRoles),
+		certTokens: make(map[string]certToken),
 		tokens: col.NewCollection(
 			env.GetEtcdClient(),
 			path.Join(etcdPrefix, tokensPrefix),
-			nil,
+			[]*col.Index{tokensSubjectIndex},
 			&auth.TokenInfo{},
 			nil,
 			nil,
@@ -253,6 +305,62 @@ func NewAuthServer(
 			nil,
 			nil,
 		),
+		pipelineACLs: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, pipelineACLsPrefix),
+			nil,
+			&auth.ACL{},
+			nil,
+			nil,
+		),
+		roles: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, rolesPrefix),
+			nil,
+			&auth.Role{},
+			nil,
+			nil,
+		),
+		roleBindings: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, roleBindingsPrefix),
+			nil,
+			&auth.RoleBindings{},
+			nil,
+			nil,
+		),
+		robots: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, robotsPrefix),
+			nil,
+			&auth.Robot{},
+			nil,
+			nil,
+		),
+		scimUsers: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, scimUsersPrefix),
+			nil,
+			&auth.SCIMUser{},
+			nil,
+			nil,
+		),
+		scimGroups: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, scimGroupsPrefix),
+			nil,
+			&auth.SCIMGroup{},
+			nil,
+			nil,
+		),
+		scimDeprovisioned: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, scimDeprovisionedPrefix),
+			nil,
+			&auth.SCIMUser{},
+			nil,
+			nil,
+		),
 		admins: col.NewCollection(
 			env.GetEtcdClient(),
 			path.Join(etcdPrefix, adminsPrefix),
@@ -296,13 +404,15 @@ func NewAuthServer(
 		public: public,
 	}
 	go s.retrieveOrGeneratePPSToken()
+	go s.indexTokens()
 	go s.watchAdmins(path.Join(etcdPrefix, adminsPrefix), path.Join(etcdPrefix, fsAdminsPrefix))
 
 	if public {
-		// start SAML and OIDC services
+		// start SAML, OIDC and SCIM services
 		// (won't respond to anything until config is set)
 		go waitForError("SAML HTTP Server", requireNoncriticalServers, s.serveSAML)
 		go waitForError("OIDC HTTP Server", requireNoncriticalServers, s.serveOIDC)
+		go waitForError("SCIM HTTP Server", requireNoncriticalServers, s.serveSCIM)
 	}
 
 	// Watch for new auth config options
@@ -606,12 +716,13 @@ func (a *apiServer) Activate(ctx context.Context, req *auth.ActivateRequest) (re
 		if err := admins.Put(req.Subject, epsilon); err != nil {
 			return err
 		}
+		var idp string
+		if strings.HasPrefix(req.Subject, auth.GitHubPrefix) {
+			idp = githubIDPName
+		}
 		return tokens.PutTTL(
 			hashToken(pachToken),
-			&auth.TokenInfo{
-				Subject: req.Subject,
-				Source:  auth.TokenInfo_AUTHENTICATE,
-			},
+			newSessionTokenInfo(req.Subject, idp),
 			ttlSecs,
 		)
 	}); err != nil {
@@ -685,6 +796,13 @@ func (a *apiServer) Deactivate(ctx context.Context, req *auth.DeactivateRequest)
 	}
 	_, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 		a.acls.ReadWrite(stm).DeleteAll()
+		a.pipelineACLs.ReadWrite(stm).DeleteAll()
+		a.roles.ReadWrite(stm).DeleteAll()
+		a.roleBindings.ReadWrite(stm).DeleteAll()
+		a.robots.ReadWrite(stm).DeleteAll()
+		a.scimUsers.ReadWrite(stm).DeleteAll()
+		a.scimGroups.ReadWrite(stm).DeleteAll()
+		a.scimDeprovisioned.ReadWrite(stm).DeleteAll()
 		a.tokens.ReadWrite(stm).DeleteAll()
 		a.admins.ReadWrite(stm).DeleteAll()   // watchAdmins() will see the write
 		a.fsAdmins.ReadWrite(stm).DeleteAll() // watchAdmins() will see the write
@@ -1010,16 +1128,16 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		if err := a.expiredClusterAdminCheck(ctx, username); err != nil {
 			return nil, err
 		}
+		if err := a.checkNotDeprovisioned(ctx, username); err != nil {
+			return nil, err
+		}
 
 		// Generate a new Pachyderm token and write it
 		pachToken = uuid.NewWithoutDashes()
 		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 			tokens := a.tokens.ReadWrite(stm)
 			return tokens.PutTTL(hashToken(pachToken),
-				&auth.TokenInfo{
-					Subject: username,
-					Source:  auth.TokenInfo_AUTHENTICATE,
-				},
+				newSessionTokenInfo(username, githubIDPName),
 				defaultSessionTTLSecs)
 		}); err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
@@ -1048,16 +1166,16 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		if err := a.expiredClusterAdminCheck(ctx, username); err != nil {
 			return nil, err
 		}
+		if err := a.checkNotDeprovisioned(ctx, username); err != nil {
+			return nil, err
+		}
 
 		// Generate a new Pachyderm token and write it
 		pachToken = uuid.NewWithoutDashes()
 		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 			tokens := a.tokens.ReadWrite(stm)
 			return tokens.PutTTL(hashToken(pachToken),
-				&auth.TokenInfo{
-					Subject: username,
-					Source:  auth.TokenInfo_AUTHENTICATE,
-				},
+				newSessionTokenInfo(username, oidcSP.Prefix),
 				defaultSessionTTLSecs)
 		}); err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
@@ -1082,6 +1200,9 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 			if err := a.expiredClusterAdminCheck(ctx, otpInfo.Subject); err != nil {
 				return err
 			}
+			if err := a.checkNotDeprovisioned(ctx, otpInfo.Subject); err != nil {
+				return err
+			}
 
 			// Determine new token's TTL
 			ttl := int64(defaultSessionTTLSecs)
@@ -1105,10 +1226,8 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 
 			// write long-lived pachyderm token
 			pachToken = uuid.NewWithoutDashes()
-			return a.tokens.ReadWrite(stm).PutTTL(hashToken(pachToken), &auth.TokenInfo{
-				Subject: otpInfo.Subject,
-				Source:  auth.TokenInfo_AUTHENTICATE,
-			}, ttl)
+			return a.tokens.ReadWrite(stm).PutTTL(hashToken(pachToken),
+				newSessionTokenInfo(otpInfo.Subject, otpInfo.IDProvider), ttl)
 		}); err != nil {
 			return nil, err
 		}
@@ -1135,6 +1254,9 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		if err := a.expiredClusterAdminCheck(ctx, username); err != nil {
 			return nil, err
 		}
+		if err := a.checkNotDeprovisioned(ctx, username); err != nil {
+			return nil, err
+		}
 
 		// Sync the user's group membership from the groups claim
 		if err := oidcSP.syncGroupMembership(ctx, claims); err != nil {
@@ -1155,14 +1277,57 @@ func (a *apiServer) Authenticate(ctx context.Context, req *auth.AuthenticateRequ
 		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 			tokens := a.tokens.ReadWrite(stm)
 			return tokens.PutTTL(hashToken(pachToken),
-				&auth.TokenInfo{
-					Subject: username,
-					Source:  auth.TokenInfo_AUTHENTICATE,
-				},
+				newSessionTokenInfo(username, oidcSP.Prefix),
 				expirationSecs)
 		}); err != nil {
 			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
 		}
+	case req.LDAPUsername != "":
+		// confirm LDAP has been configured
+		var ldapIDP *canonicalIDPConfig
+		for _, idp := range a.getCacheConfig().IDPs {
+			if idp.LDAP != nil {
+				idp := idp
+				ldapIDP = &idp
+				break
+			}
+		}
+		if ldapIDP == nil {
+			return nil, errors.Errorf("error authenticating LDAP user: no LDAP ID provider is configured")
+		}
+
+		// Verify the user's credentials against the directory
+		username, groups, err := authenticateLDAP(ldapIDP.Name, ldapIDP.LDAP, req.LDAPUsername, req.LDAPPassword)
+		if err != nil {
+			return nil, err
+		}
+
+		// If the cluster's enterprise token is expired, only admins may log in.
+		// Check if 'username' is an admin
+		if err := a.expiredClusterAdminCheck(ctx, username); err != nil {
+			return nil, err
+		}
+		if err := a.checkNotDeprovisioned(ctx, username); err != nil {
+			return nil, err
+		}
+
+		// Sync the user's group membership from the directory, if configured
+		if ldapIDP.LDAP.GroupSearchBase != "" {
+			if err := a.setGroupsForUserInternal(ctx, username, groups); err != nil {
+				return nil, errors.Wrapf(err, "could not sync group membership")
+			}
+		}
+
+		// Generate a new Pachyderm token and write it
+		pachToken = uuid.NewWithoutDashes()
+		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+			tokens := a.tokens.ReadWrite(stm)
+			return tokens.PutTTL(hashToken(pachToken),
+				newSessionTokenInfo(username, ldapIDP.Name),
+				defaultSessionTTLSecs)
+		}); err != nil {
+			return nil, errors.Wrapf(err, "error storing auth token for user \"%s\"", username)
+		}
 	default:
 		return nil, errors.Errorf("unrecognized authentication mechanism (old pachd?)")
 	}
@@ -1213,6 +1378,10 @@ func (a *apiServer) GetOneTimePassword(ctx context.Context, req *auth.GetOneTime
 	if err != nil {
 		return nil, err
 	}
+	// A restricted token must not be exchanged for an unrestricted one
+	if err := checkUnrestricted(callerInfo, "get a new token"); err != nil {
+		return nil, err
+	}
 	isAdmin, err := a.hasClusterRole(ctx, callerInfo.Subject, auth.ClusterRole_SUPER)
 	if err != nil {
 		return nil, err
@@ -1283,7 +1452,7 @@ func (a *apiServer) GetOneTimePassword(ctx context.Context, req *auth.GetOneTime
 	}
 
 	// Generate authentication code with same (or slightly shorter) expiration
-	code, err := a.getOneTimePassword(ctx, req.Subject, req.TTL, sessionExpiration)
+	code, err := a.getOneTimePassword(ctx, req.Subject, "", req.TTL, sessionExpiration)
 	if err != nil {
 		return nil, err
 	}
@@ -1303,18 +1472,19 @@ func (a *apiServer) GetOneTimePassword(ctx context.Context, req *auth.GetOneTime
 
 // getOneTimePassword contains the implementation of GetOneTimePassword,
 // but is also called directly by handleSAMLResponse. It generates a
-// short-lived one-time password for 'username', writes it to
-// a.oneTimePasswords, and returns it
+// short-lived one-time password for 'username' (who was authenticated by the
+// ID provider 'idp', if any), writes it to a.oneTimePasswords, and returns it
 //
 // Note: if sessionExpiration is 0, then Authenticate() will give the caller the
 // default session TTL, rather than an indefinite token, so this is relatively
 // safe. 'sessionExpiration' should typically be set, though, so that expiration
 // time is measured from when the OTP is issued, rather than from when it's
 // converted.
-func (a *apiServer) getOneTimePassword(ctx context.Context, username string, otpTTL int64, sessionExpiration time.Time) (code string, err error) {
+func (a *apiServer) getOneTimePassword(ctx context.Context, username, idp string, otpTTL int64, sessionExpiration time.Time) (code string, err error) {
 	// Create OTPInfo that will be stored
 	otpInfo := &auth.OTPInfo{
-		Subject: username,
+		Subject:    username,
+		IDProvider: idp,
 	}
 	if !sessionExpiration.IsZero() {
 		sessionExpirationProto, err := types.TimestampProto(sessionExpiration)
@@ -1350,6 +1520,11 @@ func (a *apiServer) AuthorizeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	// Restricted tokens (e.g. robot API keys) may only authorize what their
+	// restrictions allow, whatever the caller's privileges
+	if !restrictionsAllow(callerInfo.Restrictions, req) {
+		return &auth.AuthorizeResponse{Authorized: false}, nil
+	}
 	// Check for FS admin or SUPER admin role
 	isAdmin, err := a.hasClusterRole(txnCtx.ClientContext, callerInfo.Subject, auth.ClusterRole_FS)
 	if err != nil {
@@ -1361,11 +1536,13 @@ func (a *apiServer) AuthorizeInTransaction(
 		return &auth.AuthorizeResponse{Authorized: true}, nil
 	}
 
+	required := requiredPermissions(req)
 	if req.Repo == ppsconsts.SpecRepo {
 		// All users are authorized to read from the spec repo, but only admins are
 		// authorized to write to it (writing to it may break your cluster)
+		readOnly := permissionSet{auth.Permission_REPO_READ: true}
 		return &auth.AuthorizeResponse{
-			Authorized: req.Scope == auth.Scope_READER,
+			Authorized: len(required) > 0 && readOnly.hasAll(required),
 		}, nil
 	}
 
@@ -1382,6 +1559,30 @@ func (a *apiServer) AuthorizeInTransaction(
 			"auth is deactivated, only cluster admins can perform any operations)")
 	}
 
+	// If the pipeline being operated on has its own ACL, it takes precedence
+	// over the output repo's ACL
+	if req.Pipeline != "" {
+		pipelineACL, err := a.getPipelineACL(txnCtx, req.Pipeline)
+		if err != nil {
+			return nil, err
+		}
+		if len(pipelineACL.Entries) > 0 {
+			scope, err := a.getScope(txnCtx.ClientContext, callerInfo.Subject, pipelineACL)
+			if err != nil {
+				return nil, err
+			}
+			// Role bindings on the output repo don't apply to the pipeline, but
+			// cluster-wide role bindings do
+			perms, err := a.getPermissions(txnCtx, callerInfo.Subject, "", scope)
+			if err != nil {
+				return nil, err
+			}
+			return &auth.AuthorizeResponse{
+				Authorized: perms.hasAll(required),
+			}, nil
+		}
+	}
+
 	// Get ACL to check
 	var acl auth.ACL
 	if err := a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
@@ -1392,8 +1593,30 @@ func (a *apiServer) AuthorizeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	perms, err := a.getPermissions(txnCtx, callerInfo.Subject, req.Repo, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// Writes to a protected branch are authorized by the branch's ACLs, which
+	// override the repo's ACL (so a reader of the repo may be a writer of some
+	// branches), unless the caller may modify the repo's ACL (e.g. owns the
+	// repo). A pipeline may always write to its own output repo.
+	if req.Branch != "" && requires(required, auth.Permission_REPO_WRITE) && !perms[auth.Permission_AUTH_MODIFY_ACL] &&
+		callerInfo.Subject != auth.PipelinePrefix+req.Repo {
+		branchScope, matched, err := a.getBranchScope(txnCtx.ClientContext, callerInfo.Subject, &acl, req.Branch)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			delete(perms, auth.Permission_REPO_WRITE)
+			if role, ok := builtinRoles[branchScope]; ok {
+				perms.add(role.Permissions...)
+			}
+		}
+	}
 	return &auth.AuthorizeResponse{
-		Authorized: scope >= req.Scope,
+		Authorized: perms.hasAll(required),
 	}, nil
 }
 
@@ -1433,13 +1656,15 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	ttl := int64(-1) // value returned by etcd for keys w/ no lease (no TTL)
 	if callerInfo.Subject != ppsUser {
 		token, err := getAuthToken(ctx)
-		if err != nil {
+		if err == nil {
+			ttl, err = a.tokens.ReadOnly(ctx).TTL(hashToken(token)) // lookup token TTL
+			if err != nil {
+				return nil, errors.Wrapf(err, "error looking up TTL for token")
+			}
+		} else if callerInfo.Source != auth.TokenInfo_CLIENT_CERT {
+			// callers with client certificates have no token
 			return nil, err
 		}
-		ttl, err = a.tokens.ReadOnly(ctx).TTL(hashToken(token)) // lookup token TTL
-		if err != nil {
-			return nil, errors.Wrapf(err, "error looking up TTL for token")
-		}
 	}
 
 	a.adminMu.Lock()
@@ -1447,7 +1672,8 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 	var adminRoles auth.ClusterRoles
 	var isAdmin bool
 
-	if _, ok := a.adminCache[callerInfo.Subject]; ok {
+	// Restricted tokens never carry cluster roles (see hasClusterRole)
+	if _, ok := a.adminCache[callerInfo.Subject]; ok && callerInfo.Restrictions == nil {
 		adminRoles.Roles = a.adminCache[callerInfo.Subject].Roles
 		for _, role := range adminRoles.Roles {
 			if role == auth.ClusterRole_SUPER {
@@ -1467,12 +1693,24 @@ func (a *apiServer) WhoAmI(ctx context.Context, req *auth.WhoAmIRequest) (resp *
 }
 
 func validateSetScopeRequest(ctx context.Context, req *auth.SetScopeRequest) error {
-	if req.Username == "" {
+	// An empty username with scope NONE deletes a branch ACL
+	if req.Username == "" && (req.Branch == "" || req.Scope != auth.Scope_NONE) {
 		return errors.Errorf("invalid request: must set username")
 	}
+	if req.Pipeline != "" {
+		if req.Repo != "" || req.Branch != "" {
+			return errors.Errorf("invalid request: cannot set both pipeline and repo")
+		}
+		return nil
+	}
 	if req.Repo == "" {
 		return errors.Errorf("invalid request: must set repo")
 	}
+	if req.Branch != "" {
+		if err := validateBranchPattern(req.Branch); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -1481,6 +1719,10 @@ func (a *apiServer) hasClusterRole(ctx context.Context, subject string, role aut
 	if subject == ppsUser {
 		return true, nil
 	}
+	// Restricted tokens never carry cluster roles
+	if a.callerRestrictions(ctx, subject) != nil {
+		return false, nil
+	}
 	a.adminMu.Lock()
 	defer a.adminMu.Unlock()
 	if roles, ok := a.adminCache[subject]; ok {
@@ -1530,6 +1772,12 @@ func (a *apiServer) SetScopeInTransaction(
 	if err != nil {
 		return nil, err
 	}
+	if req.Pipeline != "" {
+		if err := a.setPipelineScope(txnCtx, callerInfo, isAdmin, req); err != nil {
+			return nil, err
+		}
+		return &auth.SetScopeResponse{}, nil
+	}
 
 	acls := a.acls.ReadWrite(txnCtx.Stm)
 	var acl auth.ACL
@@ -1547,6 +1795,8 @@ func (a *apiServer) SetScopeInTransaction(
 		}
 
 		// Repo exists, but has no ACL. Create default (empty) ACL
+	}
+	if acl.Entries == nil {
 		acl.Entries = make(map[string]auth.Scope)
 	}
 
@@ -1567,15 +1817,8 @@ func (a *apiServer) SetScopeInTransaction(
 				"cluster (only a cluster admin can set a scope)")
 		}
 
-		// Check if the user or one of their groups is on the ACL directly
-		scope, err := a.getScope(txnCtx.ClientContext, callerInfo.Subject, &acl)
-		if err != nil {
-			return false, err
-		}
-		if scope == auth.Scope_OWNER {
-			return true, nil
-		}
-		return false, nil
+		// Check if the user or one of their groups may modify the ACL
+		return a.hasPermission(txnCtx, callerInfo.Subject, req.Repo, &acl, auth.Permission_AUTH_MODIFY_ACL)
 	}()
 	if err != nil {
 		return nil, err
@@ -1589,16 +1832,26 @@ func (a *apiServer) SetScopeInTransaction(
 	}
 
 	// Scope change is authorized. Make the change
-	principal, err := a.canonicalizeSubject(txnCtx.ClientContext, req.Username)
-	if err != nil {
-		return nil, err
+	var principal string
+	if req.Username != "" {
+		principal, err = a.canonicalizeSubject(txnCtx.ClientContext, req.Username)
+		if err != nil {
+			return nil, err
+		}
 	}
-	if req.Scope != auth.Scope_NONE {
+	if req.Branch != "" && principal == "" {
+		deleteBranchACL(&acl, req.Branch)
+	} else if req.Branch != "" {
+		setBranchScope(&acl, req.Branch, principal, req.Scope)
+	} else if req.Scope != auth.Scope_NONE {
 		acl.Entries[principal] = req.Scope
 	} else {
 		delete(acl.Entries, principal)
 	}
-	if len(acl.Entries) == 0 {
+	if err := a.checkPublicACL(txnCtx, req.Repo, &acl); err != nil {
+		return nil, err
+	}
+	if len(acl.Entries) == 0 && len(acl.Branches) == 0 {
 		err = acls.Delete(req.Repo)
 	} else {
 		err = acls.Put(req.Repo, &acl)
@@ -1718,6 +1971,7 @@ func (a *apiServer) GetScopeInTransaction(
 			if err != nil {
 				return nil, err
 			}
+			callerScope = restrictScope(callerInfo.Restrictions, repo, callerScope)
 			if callerScope < auth.Scope_READER {
 				return nil, &auth.ErrNotAuthorized{
 					Subject:  callerInfo.Subject,
@@ -1732,6 +1986,10 @@ func (a *apiServer) GetScopeInTransaction(
 		if err != nil {
 			return nil, err
 		}
+		if targetSubject == callerInfo.Subject {
+			// The caller's own scope is limited by their token's restrictions
+			targetScope = restrictScope(callerInfo.Restrictions, repo, targetScope)
+		}
 		response.Scopes = append(response.Scopes, targetScope)
 	}
 
@@ -1765,7 +2023,7 @@ func (a *apiServer) GetACLInTransaction(
 	}
 
 	// Validate request
-	if req.Repo == "" {
+	if req.Repo == "" && req.Pipeline == "" {
 		return nil, errors.Errorf("invalid request: must provide name of repo to get that repo's ACL")
 	}
 
@@ -1778,9 +2036,13 @@ func (a *apiServer) GetACLInTransaction(
 		return nil, err
 	}
 
-	// Read repo ACL from etcd
+	// Read repo (or pipeline) ACL from etcd
 	acl := &auth.ACL{}
-	if err = a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, acl); err != nil && !col.IsErrNotFound(err) {
+	if req.Pipeline != "" {
+		if acl, err = a.getPipelineACL(txnCtx, req.Pipeline); err != nil {
+			return nil, err
+		}
+	} else if err = a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, acl); err != nil && !col.IsErrNotFound(err) {
 		return nil, err
 	}
 	response := &auth.GetACLResponse{
@@ -1792,6 +2054,7 @@ func (a *apiServer) GetACLInTransaction(
 			Scope:    scope,
 		})
 	}
+	response.BranchEntries = branchACLEntries(acl)
 	// For now, no access is require to read a repo's ACL
 	// https://github.com/pachyderm/pachyderm/issues/2353
 	return response, nil
@@ -1824,9 +2087,18 @@ func (a *apiServer) SetACLInTransaction(
 	}
 
 	// Validate request
-	if req.Repo == "" {
+	if req.Pipeline != "" {
+		if req.Repo != "" || len(req.BranchEntries) > 0 {
+			return nil, errors.Errorf("invalid request: cannot set both pipeline and repo")
+		}
+	} else if req.Repo == "" {
 		return nil, errors.Errorf("invalid request: must provide name of repo you want to modify")
 	}
+	for _, entry := range req.BranchEntries {
+		if err := validateBranchPattern(entry.Branch); err != nil {
+			return nil, err
+		}
+	}
 
 	// Get calling user
 	callerInfo, err := a.getAuthenticatedUser(txnCtx.ClientContext)
@@ -1863,9 +2135,33 @@ func (a *apiServer) SetACLInTransaction(
 			return nil
 		})
 	}
+	for _, entry := range req.BranchEntries {
+		branch, user, scope := entry.Branch, entry.Username, entry.Scope
+		eg.Go(func() error {
+			// An entry with no username protects 'branch' without granting
+			// access to it
+			var principal string
+			if user != "" {
+				var err error
+				if principal, err = a.canonicalizeSubject(txnCtx.ClientContext, user); err != nil {
+					return err
+				}
+			}
+			aclMu.Lock()
+			defer aclMu.Unlock()
+			setBranchScope(newACL, branch, principal, scope)
+			return nil
+		})
+	}
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
+	if req.Pipeline != "" {
+		if err := a.setPipelineACL(txnCtx, callerInfo, isAdmin, req, newACL); err != nil {
+			return nil, err
+		}
+		return &auth.SetACLResponse{}, nil
+	}
 
 	// Read repo ACL from etcd
 	acls := a.acls.ReadWrite(txnCtx.Stm)
@@ -1895,14 +2191,7 @@ func (a *apiServer) SetACLInTransaction(
 		}
 		if len(acl.Entries) > 0 {
 			// ACL is present; caller must be authorized directly
-			scope, err := a.getScope(txnCtx.ClientContext, callerInfo.Subject, &acl)
-			if err != nil {
-				return false, err
-			}
-			if scope == auth.Scope_OWNER {
-				return true, nil
-			}
-			return false, nil
+			return a.hasPermission(txnCtx, callerInfo.Subject, req.Repo, &acl, auth.Permission_AUTH_MODIFY_ACL)
 		}
 
 		// No ACL -- check if the repo being modified exists
@@ -1910,15 +2199,19 @@ func (a *apiServer) SetACLInTransaction(
 		if err == nil {
 			// Repo exists -- user isn't authorized
 			return false, nil
-		} else if !strings.HasSuffix(err.Error(), "not found") {
+		} else if !col.IsErrNotFound(err) {
 			// Unclear if repo exists -- return error
 			return false, errors.Wrapf(err, "could not inspect \"%s\"", req.Repo)
 		} else if len(newACL.Entries) == 1 &&
 			newACL.Entries[callerInfo.Subject] == auth.Scope_OWNER {
 			// Special case: Repo doesn't exist, but user is creating a new Repo, and
 			// making themself the owner, e.g. for CreateRepo or CreatePipeline, then
-			// the request is authorized
-			return true, nil
+			// the request is authorized (unless the caller's token may not own
+			// the repo)
+			return restrictionsAllow(callerInfo.Restrictions, &auth.AuthorizeRequest{
+				Repo:  req.Repo,
+				Scope: auth.Scope_OWNER,
+			}), nil
 		}
 		return false, err
 	}()
@@ -1933,13 +2226,34 @@ func (a *apiServer) SetACLInTransaction(
 		}
 	}
 
+	// Preserve the repo's branch ACLs unless the request replaces them or
+	// deletes the ACL entirely
+	if len(req.BranchEntries) == 0 && len(newACL.Entries) > 0 {
+		var acl auth.ACL
+		if err := acls.Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		newACL.Branches = acl.Branches
+	}
+
 	// Set new ACL
-	if len(newACL.Entries) == 0 {
+	if len(newACL.Entries) == 0 && len(newACL.Branches) == 0 {
 		err := acls.Delete(req.Repo)
 		if err != nil && !col.IsErrNotFound(err) {
 			return nil, err
 		}
+		// If the repo has been deleted, delete its role bindings as well
+		if _, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx,
+			&pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: req.Repo}},
+		); col.IsErrNotFound(err) {
+			if err := a.roleBindings.ReadWrite(txnCtx.Stm).Delete(req.Repo); err != nil && !col.IsErrNotFound(err) {
+				return nil, err
+			}
+		}
 	} else {
+		if err := a.checkPublicACL(txnCtx, req.Repo, newACL); err != nil {
+			return nil, err
+		}
 		err = acls.Put(req.Repo, newACL)
 		if err != nil {
 			return nil, errors.Wrapf(err, "could not put new ACL")
@@ -2004,6 +2318,20 @@ func (a *apiServer) authorizeNewToken(ctx context.Context, callerInfo *auth.Toke
 func (a *apiServer) GetAuthToken(ctx context.Context, req *auth.GetAuthTokenRequest) (resp *auth.GetAuthTokenResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		var err error
+		resp, err = a.GetAuthTokenInTransaction(txnCtx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAuthTokenInTransaction is identical to GetAuthToken except that it can
+// run inside an existing etcd STM transaction.  This is not an RPC.
+func (a *apiServer) GetAuthTokenInTransaction(txnCtx *txnenv.TransactionContext, req *auth.GetAuthTokenRequest) (*auth.GetAuthTokenResponse, error) {
+	ctx := txnCtx.ClientContext
 	if a.activationState() == none {
 		// GetAuthToken must work in the partially-activated state so that PPS can
 		// get tokens for all existing pipelines during activation
@@ -2019,6 +2347,10 @@ func (a *apiServer) GetAuthToken(ctx context.Context, req *auth.GetAuthTokenRequ
 	if err != nil {
 		return nil, err
 	}
+	// A restricted token must not be exchanged for an unrestricted one
+	if err := checkUnrestricted(callerInfo, "get a new token"); err != nil {
+		return nil, err
+	}
 	isAdmin, err := a.hasClusterRole(ctx, callerInfo.Subject, auth.ClusterRole_SUPER)
 	if err != nil {
 		return nil, err
@@ -2027,6 +2359,9 @@ func (a *apiServer) GetAuthToken(ctx context.Context, req *auth.GetAuthTokenRequ
 	if req.TTL < 0 && !isAdmin {
 		return nil, errors.Errorf("GetAuthTokenRequest.TTL must be >= 0")
 	}
+	if err := validateRestrictions(req.Restrictions); err != nil {
+		return nil, err
+	}
 
 	// check if this request is auhorized
 	req.Subject, err = a.authorizeNewToken(ctx, callerInfo, isAdmin, req.Subject)
@@ -2071,16 +2406,21 @@ func (a *apiServer) GetAuthToken(ctx context.Context, req *auth.GetAuthTokenRequ
 		// lifetime.
 		req.TTL = defaultSessionTTLSecs
 	}
+	// Only robot tokens are checked for restrictions (see callerRestrictions)
+	if req.Restrictions != nil && !strings.HasPrefix(req.Subject, auth.RobotPrefix) {
+		return nil, errors.Errorf("cannot restrict token for %q (only robot tokens "+
+			"may be restricted)", req.Subject)
+	}
 	tokenInfo := auth.TokenInfo{
-		Source:  auth.TokenInfo_GET_TOKEN,
-		Subject: req.Subject,
+		Source:       auth.TokenInfo_GET_TOKEN,
+		Subject:      req.Subject,
+		Created:      types.TimestampNow(),
+		Restrictions: req.Restrictions,
 	}
 
 	// generate new token, and write to etcd
 	token := uuid.NewWithoutDashes()
-	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		return a.tokens.ReadWrite(stm).PutTTL(hashToken(token), &tokenInfo, req.TTL)
-	}); err != nil {
+	if err := a.tokens.ReadWrite(txnCtx.Stm).PutTTL(hashToken(token), &tokenInfo, req.TTL); err != nil {
 		if tokenInfo.Subject != ppsUser {
 			return nil, errors.Wrapf(err, "error storing token for user \"%s\"", tokenInfo.Subject)
 		}
@@ -2186,6 +2526,20 @@ func (a *apiServer) ExtendAuthToken(ctx context.Context, req *auth.ExtendAuthTok
 func (a *apiServer) RevokeAuthToken(ctx context.Context, req *auth.RevokeAuthTokenRequest) (resp *auth.RevokeAuthTokenResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		var err error
+		resp, err = a.RevokeAuthTokenInTransaction(txnCtx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RevokeAuthTokenInTransaction is identical to RevokeAuthToken except that it
+// can run inside an existing etcd STM transaction.  This is not an RPC.
+func (a *apiServer) RevokeAuthTokenInTransaction(txnCtx *txnenv.TransactionContext, req *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error) {
+	ctx := txnCtx.ClientContext
 	if a.activationState() != full {
 		return nil, auth.ErrNotActivated
 	}
@@ -2201,26 +2555,244 @@ func (a *apiServer) RevokeAuthToken(ctx context.Context, req *auth.RevokeAuthTok
 		return nil, err
 	}
 
-	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-		tokens := a.tokens.ReadWrite(stm)
-		var tokenInfo auth.TokenInfo
-		if err := tokens.Get(hashToken(req.Token), &tokenInfo); err != nil {
+	tokens := a.tokens.ReadWrite(txnCtx.Stm)
+	var tokenInfo auth.TokenInfo
+	if err := tokens.Get(hashToken(req.Token), &tokenInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return &auth.RevokeAuthTokenResponse{}, nil
+		}
+		return nil, err
+	}
+	if !isAdmin && tokenInfo.Subject != callerInfo.Subject {
+		return nil, &auth.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "RevokeAuthToken on another user's token",
+		}
+	}
+	if err := tokens.Delete(hashToken(req.Token)); err != nil {
+		return nil, err
+	}
+	return &auth.RevokeAuthTokenResponse{}, nil
+}
+
+// authorizeTokenAdmin checks that the caller may list or revoke the tokens of
+// 'subject' (or of all subjects, if 'subject' is empty). Users may manage
+// their own tokens, and admins may manage any user's tokens.
+func (a *apiServer) authorizeTokenAdmin(ctx context.Context, subject, op string) error {
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+	if subject == callerInfo.Subject {
+		return nil
+	}
+	isAdmin, err := a.hasClusterRole(ctx, callerInfo.Subject, auth.ClusterRole_SUPER)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &auth.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: op,
+		}
+	}
+	return nil
+}
+
+// ListAuthTokens implements the protobuf auth.ListAuthTokens RPC
+func (a *apiServer) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (resp *auth.ListAuthTokensResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, auth.ErrNotActivated
+	}
+	subject := req.Subject
+	if subject != "" {
+		var err error
+		if subject, err = a.canonicalizeSubject(ctx, subject); err != nil {
+			return nil, err
+		}
+	}
+	if err := a.authorizeTokenAdmin(ctx, subject, "ListAuthTokens on another user's tokens"); err != nil {
+		return nil, err
+	}
+
+	tokens := a.tokens.ReadOnly(ctx)
+	resp = &auth.ListAuthTokensResponse{}
+	var tokenInfo auth.TokenInfo
+	addToken := func(key string) error {
+		ttl, err := tokens.TTL(key)
+		if err != nil {
 			if col.IsErrNotFound(err) {
-				return nil
+				return nil // token expired or was revoked
 			}
 			return err
 		}
-		if !isAdmin && tokenInfo.Subject != callerInfo.Subject {
-			return &auth.ErrNotAuthorized{
-				Subject: callerInfo.Subject,
-				AdminOp: "RevokeAuthToken on another user's token",
+		desc := &auth.AuthTokenDescription{
+			HashedToken: key,
+			Info:        proto.Clone(&tokenInfo).(*auth.TokenInfo),
+		}
+		if desc.Expiration, err = expiration(ttl); err != nil {
+			return err
+		}
+		resp.Tokens = append(resp.Tokens, desc)
+		return nil
+	}
+	if subject == "" {
+		if err := tokens.List(&tokenInfo, col.DefaultOptions, func(key string) error {
+			return addToken(key)
+		}); err != nil {
+			return nil, err
+		}
+	} else if err := a.tokensForSubject(tokens, subject, &tokenInfo, addToken); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RevokeAuthTokensForUser implements the protobuf auth.RevokeAuthTokensForUser
+// RPC
+func (a *apiServer) RevokeAuthTokensForUser(ctx context.Context, req *auth.RevokeAuthTokensForUserRequest) (resp *auth.RevokeAuthTokensForUserResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() != full {
+		return nil, auth.ErrNotActivated
+	}
+	if req.Subject == "" {
+		return nil, errors.Errorf("must set subject")
+	}
+	subject, err := a.canonicalizeSubject(ctx, req.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.authorizeTokenAdmin(ctx, subject, "RevokeAuthTokensForUser on another user's tokens"); err != nil {
+		return nil, err
+	}
+	n, err := a.revokeTokensForSubject(ctx, subject)
+	if err != nil {
+		return nil, err
+	}
+	return &auth.RevokeAuthTokensForUserResponse{NumberRevoked: int64(n)}, nil
+}
+
+// tokensForSubject calls 'f' with the key of each token in 'tokens' that
+// authenticates 'subject', after reading the token into 'tokenInfo'
+func (a *apiServer) tokensForSubject(tokens col.ReadonlyCollection, subject string, tokenInfo *auth.TokenInfo, f func(key string) error) error {
+	// index keys are prefixes of one another (e.g. "github:a" and
+	// "github:ab"), so double-check the subject
+	filter := func(key string) error {
+		if tokenInfo.Subject != subject {
+			return nil
+		}
+		return f(key)
+	}
+	if atomic.LoadInt32(&a.tokensIndexed) == 0 {
+		// Some tokens may not be indexed yet, so check them all
+		return tokens.List(tokenInfo, col.DefaultOptions, filter)
+	}
+	return tokens.GetByIndex(tokensSubjectIndex, subject, tokenInfo, col.DefaultOptions, filter)
+}
+
+// indexTokens adds the tokens issued before tokensSubjectIndex existed to the
+// index, and then sets a.tokensIndexed
+func (a *apiServer) indexTokens() {
+	backoff.RetryNotify(func() error {
+		return indexTokens(context.Background(), a.env.GetEtcdClient(), a.tokens)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logrus.Errorf("error indexing auth tokens: %v; retrying in %v", err, d)
+		return nil
+	})
+	atomic.StoreInt32(&a.tokensIndexed, 1)
+}
+
+// indexTokens re-puts (with their remaining TTL) the tokens in 'tokens' that
+// are missing from tokensSubjectIndex, which adds them to the index
+func indexTokens(ctx context.Context, etcdClient *etcd.Client, tokens col.Collection) error {
+	var tokenInfo auth.TokenInfo
+	var keys []string
+	subjects := make(map[string]bool)
+	if err := tokens.ReadOnly(ctx).List(&tokenInfo, col.DefaultOptions, func(key string) error {
+		keys = append(keys, key)
+		subjects[tokenInfo.Subject] = true
+		return nil
+	}); err != nil {
+		return err
+	}
+	indexed := make(map[string]bool)
+	for subject := range subjects {
+		if err := tokens.ReadOnly(ctx).GetByIndex(tokensSubjectIndex, subject, &tokenInfo, col.DefaultOptions, func(key string) error {
+			indexed[key] = true
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	for _, key := range keys {
+		if indexed[key] {
+			continue
+		}
+		if _, err := col.NewSTM(ctx, etcdClient, func(stm col.STM) error {
+			tokens := tokens.ReadWrite(stm)
+			var tokenInfo auth.TokenInfo
+			if err := tokens.Get(key, &tokenInfo); err != nil {
+				if col.IsErrNotFound(err) {
+					return nil // token expired or was revoked
+				}
+				return err
 			}
+			ttl, err := tokens.TTL(key)
+			if err != nil {
+				return err
+			}
+			if ttl < 0 {
+				return nil // token's lease has expired
+			}
+			return tokens.PutTTL(key, &tokenInfo, ttl)
+		}); err != nil {
+			return err
 		}
-		return tokens.Delete(hashToken(req.Token))
+	}
+	return nil
+}
+
+// revokeTokensForSubject deletes all tokens and one-time passwords that
+// authenticate 'subject', and returns the number of tokens deleted
+func (a *apiServer) revokeTokensForSubject(ctx context.Context, subject string) (int, error) {
+	var tokenKeys, otpKeys []string
+	var tokenInfo auth.TokenInfo
+	if err := a.tokensForSubject(a.tokens.ReadOnly(ctx), subject, &tokenInfo, func(key string) error {
+		tokenKeys = append(tokenKeys, key)
+		return nil
 	}); err != nil {
-		return nil, err
+		return 0, err
 	}
-	return &auth.RevokeAuthTokenResponse{}, nil
+	// One-time passwords are short-lived, so they aren't indexed
+	var otpInfo auth.OTPInfo
+	if err := a.oneTimePasswords.ReadOnly(ctx).List(&otpInfo, col.DefaultOptions, func(key string) error {
+		if otpInfo.Subject == subject {
+			otpKeys = append(otpKeys, key)
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		tokens, otps := a.tokens.ReadWrite(stm), a.oneTimePasswords.ReadWrite(stm)
+		for _, key := range tokenKeys {
+			if err := tokens.Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		for _, key := range otpKeys {
+			if err := otps.Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return len(tokenKeys), nil
 }
 
 // setGroupsForUserInternal is a helper function used by SetGroupsForUser, and
@@ -2528,6 +3100,17 @@ func setToList(set map[string]bool) []string {
 	return list
 }
 
+// newSessionTokenInfo returns the TokenInfo of a token issued by Authenticate
+// to 'subject', who was authenticated by the ID provider 'idp' (if any)
+func newSessionTokenInfo(subject, idp string) *auth.TokenInfo {
+	return &auth.TokenInfo{
+		Subject:    subject,
+		Source:     auth.TokenInfo_AUTHENTICATE,
+		Created:    types.TimestampNow(),
+		IDProvider: idp,
+	}
+}
+
 // hashToken converts a token to a cryptographic hash.
 // We don't want to store tokens verbatim in the database, as then whoever
 // that has access to the database has access to all tokens.
@@ -2555,7 +3138,16 @@ func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*auth.TokenInfo,
 	// themselves at the beginning of a request. Don't want to look up the same
 	// token -> username entry twice.
 	token, err := getAuthToken(ctx)
-	if err != nil {
+	if err == auth.ErrNotSignedIn || err == auth.ErrNoMetadata {
+		// The caller may have presented a client certificate instead of a token
+		callerInfo, _, certErr := a.clientCertCaller(ctx)
+		if certErr != nil {
+			return nil, certErr
+		} else if callerInfo != nil {
+			return callerInfo, nil
+		}
+		return nil, err
+	} else if err != nil {
 		return nil, err
 	}
 	if token == a.ppsToken {
@@ -2718,7 +3310,7 @@ func (a *apiServer) GetConfiguration(ctx context.Context, req *auth.GetConfigura
 		}
 	}
 	return &auth.GetConfigurationResponse{
-		Configuration: &currentCfg,
+		Configuration: redactLDAPBindPasswords(&currentCfg),
 	}, nil
 }
 
@@ -2781,8 +3373,12 @@ func (a *apiServer) SetConfiguration(ctx context.Context, req *auth.SetConfigura
 				return errors.Errorf("expected live config version %d, but new live config has version %d",
 					liveConfigVersion, req.Configuration.LiveConfigVersion)
 			}
+			// LDAP bind passwords aren't returned by GetConfiguration, so LDAP ID
+			// providers that are set without one keep their current password
+			newConfig := proto.Clone(configToStore).(*auth.AuthConfig)
+			keepLDAPBindPasswords(newConfig, &liveConfig)
 			liveConfig.Reset()
-			liveConfig = *configToStore
+			liveConfig = *newConfig
 			liveConfig.LiveConfigVersion = liveConfigVersion + 1
 			return nil
 		})
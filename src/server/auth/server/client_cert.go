@@ -0,0 +1,363 @@
+package server
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+
+	logrus "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// The certificate fields that client certificate mapping rules may match
+	certFieldCommonName = "common_name"
+	certFieldDNSSAN     = "dns_san"
+	certFieldEmailSAN   = "email_san"
+	certFieldURISAN     = "uri_san"
+
+	// clientCertTokenTTL is the longest lifetime of the tokens that pachd
+	// issues to callers that authenticate with a client certificate (tokens
+	// never outlive the certificate)
+	clientCertTokenTTL = time.Hour
+	// clientCertTokenRefresh is how long before a cached client certificate
+	// token expires that pachd replaces it
+	clientCertTokenRefresh = 5 * time.Minute
+)
+
+// reservedCertSubjectPrefixes are the subject prefixes that pachd assigns to
+// its own identities. A client certificate may not map to a subject with one
+// of them, or anyone who can get a certificate from the CA could pose as a
+// pipeline or robot user
+var reservedCertSubjectPrefixes = []string{auth.PipelinePrefix, auth.RobotPrefix, "pach:"}
+
+// reservedCertSubjectPrefix returns the reserved prefix that 'subject' has, or
+// "" if it has none
+func reservedCertSubjectPrefix(subject string) string {
+	for _, prefix := range reservedCertSubjectPrefixes {
+		if strings.HasPrefix(subject, prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// certMappingRule is an auth.IDProvider_CertOptions_MappingRule, compiled
+type certMappingRule struct {
+	field   string
+	match   *regexp.Regexp
+	subject string
+}
+
+// certToken is a token that pachd issued to a caller with a client
+// certificate, cached so that every RPC doesn't create a new token
+type certToken struct {
+	token   string
+	expires time.Time
+}
+
+func validateIDPCert(idp *auth.IDProvider) (*canonicalIDPConfig, error) {
+	newIDP := &canonicalIDPConfig{}
+	newIDP.Name = idp.Name
+	newIDP.Description = idp.Description
+	opts := idp.Cert
+	newIDP.Cert = &canonicalCertIDP{
+		ClientCAs: opts.ClientCAs,
+		Rules:     opts.Rules,
+		clientCAs: x509.NewCertPool(),
+	}
+	if !newIDP.Cert.clientCAs.AppendCertsFromPEM([]byte(opts.ClientCAs)) {
+		return nil, errors.New("could not parse any certificates from client_cas")
+	}
+	if len(opts.Rules) == 0 {
+		return nil, errors.New("client certificate ID provider must have at least one mapping rule")
+	}
+	for i, rule := range opts.Rules {
+		switch rule.Field {
+		case certFieldCommonName, certFieldDNSSAN, certFieldEmailSAN, certFieldURISAN:
+		default:
+			return nil, errors.Errorf("mapping rule %d has invalid field %q (must be one of %q, %q, %q or %q)",
+				i, rule.Field, certFieldCommonName, certFieldDNSSAN, certFieldEmailSAN, certFieldURISAN)
+		}
+		// anchor 'match', so that it must match the whole field
+		match, err := regexp.Compile("^(?:" + rule.Match + ")$")
+		if err != nil {
+			return nil, errors.Wrapf(err, "mapping rule %d has invalid match %q", i, rule.Match)
+		}
+		if rule.Subject == "" {
+			return nil, errors.Errorf("mapping rule %d has no subject", i)
+		}
+		if prefix := reservedCertSubjectPrefix(rule.Subject); prefix != "" {
+			return nil, errors.Errorf("mapping rule %d has subject %q with the reserved prefix %q", i, rule.Subject, prefix)
+		}
+		newIDP.Cert.rules = append(newIDP.Cert.rules, certMappingRule{
+			field:   rule.Field,
+			match:   match,
+			subject: rule.Subject,
+		})
+	}
+	return newIDP, nil
+}
+
+// certFieldValues returns the values of 'field' (one of the certField*
+// constants) in 'cert'
+func certFieldValues(cert *x509.Certificate, field string) []string {
+	switch field {
+	case certFieldCommonName:
+		if cert.Subject.CommonName != "" {
+			return []string{cert.Subject.CommonName}
+		}
+	case certFieldDNSSAN:
+		return cert.DNSNames
+	case certFieldEmailSAN:
+		return cert.EmailAddresses
+	case certFieldURISAN:
+		var uris []string
+		for _, u := range cert.URIs {
+			uris = append(uris, u.String())
+		}
+		return uris
+	}
+	return nil
+}
+
+// subjectForCert verifies the client certificate chain 'chain' (leaf first)
+// against the ID provider's CAs, and returns the Pachyderm subject that the
+// first matching mapping rule assigns to it
+func (c *canonicalCertIDP) subjectForCert(idpName string, chain []*x509.Certificate) (string, error) {
+	if len(chain) == 0 {
+		return "", errors.New("no client certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range chain[1:] {
+		intermediates.AddCert(cert)
+	}
+	leaf := chain[0]
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         c.clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return "", errors.Wrapf(err, "client certificate %q is not trusted", leaf.Subject.CommonName)
+	}
+	for _, rule := range c.rules {
+		for _, value := range certFieldValues(leaf, rule.field) {
+			m := rule.match.FindStringSubmatchIndex(value)
+			if m == nil {
+				continue
+			}
+			subject := string(rule.match.ExpandString(nil, rule.subject, value, m))
+			if subject == "" {
+				continue
+			}
+			if prefix := reservedCertSubjectPrefix(subject); prefix != "" {
+				return "", errors.Errorf("client certificate %q maps to subject %q with the reserved prefix %q",
+					leaf.Subject.CommonName, subject, prefix)
+			}
+			if !strings.Contains(subject, ":") {
+				subject = idpName + ":" + subject
+			}
+			return subject, nil
+		}
+	}
+	return "", errors.Errorf("client certificate %q matches no mapping rule", leaf.Subject.CommonName)
+}
+
+// peerCertificates returns the certificate chain that the caller presented
+// when connecting to pachd over TLS (or nil, if the caller didn't present
+// one)
+func peerCertificates(ctx context.Context) []*x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil
+	}
+	return tlsInfo.State.PeerCertificates
+}
+
+// getCertIDP returns the cluster's client certificate ID provider, or nil if
+// none is configured
+func (a *apiServer) getCertIDP() *canonicalIDPConfig {
+	for _, idp := range a.getCacheConfig().IDPs {
+		if idp.Cert != nil {
+			idp := idp
+			return &idp
+		}
+	}
+	return nil
+}
+
+// clientCertCaller authenticates the caller by the client certificate that
+// they presented, if any. It returns nil (and no error) if the caller didn't
+// present a certificate or no client certificate ID provider is configured.
+func (a *apiServer) clientCertCaller(ctx context.Context) (*auth.TokenInfo, *x509.Certificate, error) {
+	chain := peerCertificates(ctx)
+	if len(chain) == 0 {
+		return nil, nil, nil
+	}
+	idp := a.getCertIDP()
+	if idp == nil {
+		return nil, nil, nil
+	}
+	subject, err := idp.Cert.subjectForCert(idp.Name, chain)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &auth.TokenInfo{
+		Subject:    subject,
+		Source:     auth.TokenInfo_CLIENT_CERT,
+		IDProvider: idp.Name,
+	}, chain[0], nil
+}
+
+// clientCertToken returns a token for the caller, who has presented a client
+// certificate. Tokens are cached by certificate, so that every RPC doesn't
+// write a new token. It returns "" (and no error) if the caller can't be
+// authenticated by a client certificate.
+func (a *apiServer) clientCertToken(ctx context.Context) (string, error) {
+	callerInfo, cert, err := a.clientCertCaller(ctx)
+	if err != nil || callerInfo == nil {
+		return "", err
+	}
+	sum := sha256.Sum256(cert.Raw)
+	cacheKey := fmt.Sprintf("%x/%s", sum, callerInfo.Subject)
+
+	a.certTokensMu.Lock()
+	defer a.certTokensMu.Unlock()
+	if cached, ok := a.certTokens[cacheKey]; ok && time.Until(cached.expires) > clientCertTokenRefresh {
+		// Make sure the token hasn't been revoked
+		var tokenInfo auth.TokenInfo
+		err := a.tokens.ReadOnly(ctx).Get(hashToken(cached.token), &tokenInfo)
+		if err == nil {
+			return cached.token, nil
+		} else if !col.IsErrNotFound(err) {
+			return "", err
+		}
+	}
+
+	if err := a.expiredClusterAdminCheck(ctx, callerInfo.Subject); err != nil {
+		return "", err
+	}
+	if err := a.checkNotDeprovisioned(ctx, callerInfo.Subject); err != nil {
+		return "", err
+	}
+	ttl := clientCertTokenTTL
+	if untilExpiry := time.Until(cert.NotAfter); untilExpiry < ttl {
+		ttl = untilExpiry
+	}
+	if ttl < minSessionTTL {
+		return "", errors.Errorf("client certificate %q expires too soon", cert.Subject.CommonName)
+	}
+	callerInfo.Created = types.TimestampNow()
+	token := uuid.NewWithoutDashes()
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.tokens.ReadWrite(stm).PutTTL(hashToken(token), callerInfo, int64(ttl/time.Second))
+	}); err != nil {
+		return "", errors.Wrapf(err, "error storing token for %q", callerInfo.Subject)
+	}
+
+	// Drop expired tokens from the cache while it's locked
+	for key, cached := range a.certTokens {
+		if time.Now().After(cached.expires) {
+			delete(a.certTokens, key)
+		}
+	}
+	a.certTokens[cacheKey] = certToken{token: token, expires: time.Now().Add(ttl)}
+	return token, nil
+}
+
+// ClientCertInterceptor authenticates callers that present a client
+// certificate (and no auth token) to a gRPC server. It exchanges the
+// certificate for a short-lived auth token, which it adds to the RPC's
+// incoming metadata, so that the caller's identity is propagated to any
+// internal RPCs made on its behalf (e.g. from PFS to the auth API).
+type ClientCertInterceptor struct {
+	mu     sync.RWMutex
+	server *apiServer
+}
+
+// NewClientCertInterceptor returns a ClientCertInterceptor. Its interceptors
+// do nothing until SetAuthServer is called, so that they can be installed
+// before the auth server is created.
+func NewClientCertInterceptor() *ClientCertInterceptor {
+	return &ClientCertInterceptor{}
+}
+
+// SetAuthServer sets the auth server that authenticates client certificates
+func (c *ClientCertInterceptor) SetAuthServer(s APIServer) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.server, _ = s.(*apiServer)
+}
+
+// authenticate returns 'ctx' with an auth token for the caller added to its
+// incoming metadata, if the caller presented a client certificate and no
+// token. Otherwise (including if the certificate can't be authenticated), it
+// returns 'ctx' unchanged, and the RPC proceeds as usual.
+func (c *ClientCertInterceptor) authenticate(ctx context.Context) context.Context {
+	c.mu.RLock()
+	a := c.server
+	c.mu.RUnlock()
+	if a == nil || a.activationState() != full || len(peerCertificates(ctx)) == 0 {
+		return ctx
+	}
+	if _, err := getAuthToken(ctx); err != auth.ErrNotSignedIn && err != auth.ErrNoMetadata {
+		return ctx // the caller presented a token, which takes precedence
+	}
+	token, err := a.clientCertToken(ctx)
+	if err != nil {
+		logrus.Infof("could not authenticate client certificate: %v", err)
+		return ctx
+	} else if token == "" {
+		return ctx
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+	md.Set(auth.ContextTokenKey, token)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// certAuthenticatedStream is a grpc.ServerStream whose context has been
+// replaced by ClientCertInterceptor
+type certAuthenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *certAuthenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+// Interceptor returns the gRPC interceptors that authenticate client
+// certificates
+func (c *ClientCertInterceptor) Interceptor() grpcutil.Interceptor {
+	return grpcutil.Interceptor{
+		Unary: func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(c.authenticate(ctx), req)
+		},
+		Stream: func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			return handler(srv, &certAuthenticatedStream{
+				ServerStream: ss,
+				ctx:          c.authenticate(ss.Context()),
+			})
+		},
+	}
+}
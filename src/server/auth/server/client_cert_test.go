@@ -0,0 +1,128 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cert"
+)
+
+func certIDPConfig(t *testing.T, ca *tls.Certificate, rules ...*auth.IDProvider_CertOptions_MappingRule) *auth.IDProvider {
+	return &auth.IDProvider{
+		Name: "corp",
+		Cert: &auth.IDProvider_CertOptions{
+			ClientCAs: string(cert.PublicCertToPEM(ca)),
+			Rules:     rules,
+		},
+	}
+}
+
+func TestValidateIDPCert(t *testing.T) {
+	ca, err := cert.GenerateCA("Test CA")
+	require.NoError(t, err)
+	rule := &auth.IDProvider_CertOptions_MappingRule{
+		Field:   "common_name",
+		Match:   "(.*)",
+		Subject: "$1",
+	}
+	_, err = validateIDP(certIDPConfig(t, ca, rule), external)
+	require.NoError(t, err)
+
+	for _, modify := range []func(*auth.IDProvider_CertOptions){
+		func(o *auth.IDProvider_CertOptions) { o.ClientCAs = "not a certificate" },
+		func(o *auth.IDProvider_CertOptions) { o.Rules = nil },
+		func(o *auth.IDProvider_CertOptions) { o.Rules[0].Field = "organization" },
+		func(o *auth.IDProvider_CertOptions) { o.Rules[0].Match = "(" },
+		func(o *auth.IDProvider_CertOptions) { o.Rules[0].Subject = "" },
+		func(o *auth.IDProvider_CertOptions) { o.Rules[0].Subject = "robot:$1" },
+		func(o *auth.IDProvider_CertOptions) { o.Rules[0].Subject = "pipeline:$1" },
+		func(o *auth.IDProvider_CertOptions) { o.Rules[0].Subject = "pach:$1" },
+	} {
+		config := certIDPConfig(t, ca, &auth.IDProvider_CertOptions_MappingRule{
+			Field:   rule.Field,
+			Match:   rule.Match,
+			Subject: rule.Subject,
+		})
+		modify(config.Cert)
+		_, err := validateIDP(config, external)
+		require.YesError(t, err)
+	}
+
+	// Only one client certificate ID provider may be configured
+	_, err = validateConfig(&auth.AuthConfig{
+		IDProviders: []*auth.IDProvider{certIDPConfig(t, ca, rule), certIDPConfig(t, ca, rule)},
+	}, external)
+	require.YesError(t, err)
+}
+
+func TestSubjectForCert(t *testing.T) {
+	ca, err := cert.GenerateCA("Test CA")
+	require.NoError(t, err)
+	idp, err := validateIDP(certIDPConfig(t, ca,
+		&auth.IDProvider_CertOptions_MappingRule{
+			Field:   "uri_san",
+			Match:   "spiffe://example.com/robots/([a-z]+)",
+			Subject: "ci:$1",
+		},
+		&auth.IDProvider_CertOptions_MappingRule{
+			Field:   "email_san",
+			Match:   "([a-z]+)@example.com",
+			Subject: "$1",
+		},
+		&auth.IDProvider_CertOptions_MappingRule{
+			Field:   "common_name",
+			Match:   "([a-z]+:[a-z]+)",
+			Subject: "$1",
+		},
+	), external)
+	require.NoError(t, err)
+	subjectFor := func(c *tls.Certificate) (string, error) {
+		return idp.Cert.subjectForCert(idp.Name, []*x509.Certificate{c.Leaf})
+	}
+
+	// The first matching rule applies, and subjects without a prefix get the
+	// ID provider's name
+	robot, err := cert.GenerateClientCert(ca, "ci", "alice@example.com", "spiffe://example.com/robots/ci")
+	require.NoError(t, err)
+	subject, err := subjectFor(robot)
+	require.NoError(t, err)
+	require.Equal(t, "ci:ci", subject)
+	alice, err := cert.GenerateClientCert(ca, "alice", "alice@example.com")
+	require.NoError(t, err)
+	subject, err = subjectFor(alice)
+	require.NoError(t, err)
+	require.Equal(t, "corp:alice", subject)
+
+	// Certificates can't map to subjects with a prefix that pachd reserves for
+	// its own identities
+	for _, cn := range []string{"robot:admin", "pipeline:edges", "pach:root"} {
+		reserved, err := cert.GenerateClientCert(ca, cn)
+		require.NoError(t, err)
+		_, err = subjectFor(reserved)
+		require.YesError(t, err)
+		require.Matches(t, "reserved prefix", err.Error())
+	}
+
+	// Rules must match the whole field
+	partial, err := cert.GenerateClientCert(ca, "bob", "bob@example.com.evil.com")
+	require.NoError(t, err)
+	_, err = subjectFor(partial)
+	require.YesError(t, err)
+	require.Matches(t, "matches no mapping rule", err.Error())
+
+	// Certificates from other CAs, and CA certificates themselves, are rejected
+	otherCA, err := cert.GenerateCA("Other CA")
+	require.NoError(t, err)
+	untrusted, err := cert.GenerateClientCert(otherCA, "alice", "alice@example.com")
+	require.NoError(t, err)
+	_, err = subjectFor(untrusted)
+	require.YesError(t, err)
+	require.Matches(t, "not trusted", err.Error())
+	_, err = subjectFor(ca)
+	require.YesError(t, err)
+	_, err = idp.Cert.subjectForCert(idp.Name, nil)
+	require.YesError(t, err)
+}
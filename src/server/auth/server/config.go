@@ -2,18 +2,23 @@ package server
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/coreos/go-oidc"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	"github.com/crewjam/saml"
@@ -49,6 +54,33 @@ type canonicalOIDCIDP struct {
 	IgnoreEmailVerified bool
 }
 
+type canonicalLDAPIDP struct {
+	URL                *url.URL
+	BindDN             string
+	BindPassword       string
+	UserSearchBase     string
+	UserSearchFilter   string
+	UsernameAttribute  string
+	GroupSearchBase    string
+	GroupSearchFilter  string
+	GroupNameAttribute string
+	StartTLS           bool
+	RootCAs            string
+	InsecureSkipVerify bool
+
+	// rootCAs is RootCAs, parsed
+	rootCAs *x509.CertPool
+}
+
+type canonicalCertIDP struct {
+	ClientCAs string
+	Rules     []*auth.IDProvider_CertOptions_MappingRule
+
+	// clientCAs is ClientCAs, parsed, and rules is Rules, compiled
+	clientCAs *x509.CertPool
+	rules     []certMappingRule
+}
+
 type canonicalIDPConfig struct {
 	Name        string
 	Description string
@@ -56,6 +88,8 @@ type canonicalIDPConfig struct {
 	SAML   *canonicalSAMLIDP
 	GitHub *canonicalGitHubIDP
 	OIDC   *canonicalOIDCIDP
+	LDAP   *canonicalLDAPIDP
+	Cert   *canonicalCertIDP
 }
 
 type canonicalSAMLSvcConfig struct {
@@ -78,6 +112,9 @@ type canonicalConfig struct {
 
 	// SAMLSvc must be set if and only if there is a SAML ID provider
 	SAMLSvc *canonicalSAMLSvcConfig
+
+	// DataPolicies restrict how labelled data may flow through the cluster
+	DataPolicies []*auth.DataPolicy
 }
 
 func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
@@ -129,8 +166,36 @@ func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
 			}
 
 			idpProtos = append(idpProtos, oidcIDP)
+		} else if idp.LDAP != nil {
+			idpProtos = append(idpProtos, &auth.IDProvider{
+				Name:        idp.Name,
+				Description: idp.Description,
+				LDAP: &auth.IDProvider_LDAPOptions{
+					URL:                idp.LDAP.URL.String(),
+					BindDN:             idp.LDAP.BindDN,
+					BindPassword:       idp.LDAP.BindPassword,
+					UserSearchBase:     idp.LDAP.UserSearchBase,
+					UserSearchFilter:   idp.LDAP.UserSearchFilter,
+					UsernameAttribute:  idp.LDAP.UsernameAttribute,
+					GroupSearchBase:    idp.LDAP.GroupSearchBase,
+					GroupSearchFilter:  idp.LDAP.GroupSearchFilter,
+					GroupNameAttribute: idp.LDAP.GroupNameAttribute,
+					StartTLS:           idp.LDAP.StartTLS,
+					RootCAs:            idp.LDAP.RootCAs,
+					InsecureSkipVerify: idp.LDAP.InsecureSkipVerify,
+				},
+			})
+		} else if idp.Cert != nil {
+			idpProtos = append(idpProtos, &auth.IDProvider{
+				Name:        idp.Name,
+				Description: idp.Description,
+				Cert: &auth.IDProvider_CertOptions{
+					ClientCAs: idp.Cert.ClientCAs,
+					Rules:     idp.Cert.Rules,
+				},
+			})
 		} else {
-			return nil, errors.Errorf("could not marshal non-SAML, non-OIDC, non-GitHub ID provider %q", idp.Name)
+			return nil, errors.Errorf("could not marshal non-SAML, non-OIDC, non-GitHub, non-LDAP, non-cert ID provider %q", idp.Name)
 		}
 	}
 
@@ -151,11 +216,42 @@ func (c *canonicalConfig) ToProto() (*auth.AuthConfig, error) {
 	return &auth.AuthConfig{
 		IDProviders:        idpProtos,
 		SAMLServiceOptions: svcCfgProto,
+		DataPolicies:       c.DataPolicies,
 	}, nil
 }
 
+// redactLDAPBindPasswords returns a copy of 'c' without the bind passwords
+// of its LDAP ID providers, which GetConfiguration doesn't return
+func redactLDAPBindPasswords(c *auth.AuthConfig) *auth.AuthConfig {
+	c = proto.Clone(c).(*auth.AuthConfig)
+	for _, idp := range c.IDProviders {
+		if idp.LDAP != nil {
+			idp.LDAP.BindPassword = ""
+		}
+	}
+	return c
+}
+
+// keepLDAPBindPasswords sets the bind password of each LDAP ID provider in
+// 'c' that has a bind DN but no bind password to the bind password of the LDAP
+// ID provider with the same name in 'live', so that a configuration read with
+// GetConfiguration (which redacts bind passwords) can be set again as is
+func keepLDAPBindPasswords(c, live *auth.AuthConfig) {
+	livePasswords := make(map[string]string)
+	for _, idp := range live.IDProviders {
+		if idp.LDAP != nil {
+			livePasswords[idp.Name] = idp.LDAP.BindPassword
+		}
+	}
+	for _, idp := range c.IDProviders {
+		if idp.LDAP != nil && idp.LDAP.BindDN != "" && idp.LDAP.BindPassword == "" {
+			idp.LDAP.BindPassword = livePasswords[idp.Name]
+		}
+	}
+}
+
 func (c *canonicalConfig) IsEmpty() bool {
-	return c == nil || len(c.IDPs) == 0
+	return c == nil || (len(c.IDPs) == 0 && len(c.DataPolicies) == 0)
 }
 
 // fetchRawIDPMetadata is a helper of validateIDP, below. It takes the URL of a
@@ -220,12 +316,13 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 		return nil, errors.Errorf("cannot configure ID provider with reserved prefix %q", auth.PipelinePrefix)
 	}
 
-	// Check if the IDP is a known type (right now the only types of IDPs are SAML, OIDC and GitHub)
+	// Check if the IDP is a known type (right now the only types of IDPs are
+	// SAML, OIDC, GitHub, LDAP and client certificates)
 	newIDP := &canonicalIDPConfig{}
 	newIDP.Name = idp.Name
 	newIDP.Description = idp.Description
 	switch {
-	case idp.SAML == nil && idp.GitHub == nil && idp.OIDC == nil:
+	case idp.SAML == nil && idp.GitHub == nil && idp.OIDC == nil && idp.LDAP == nil && idp.Cert == nil:
 		// render ID provider as json for error message
 		idpConfigAsJSON, err := json.MarshalIndent(idp, "", "  ")
 		idpConfigMsg := string(idpConfigAsJSON)
@@ -240,6 +337,10 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 		return nil, errors.New("cannot configure ID provider for both SAML and OIDC")
 	case idp.OIDC != nil && idp.GitHub != nil:
 		return nil, errors.New("cannot configure ID provider for both OIDC and GitHub")
+	case idp.LDAP != nil && (idp.SAML != nil || idp.OIDC != nil || idp.GitHub != nil):
+		return nil, errors.New("cannot configure ID provider for both LDAP and another type")
+	case idp.Cert != nil && (idp.SAML != nil || idp.OIDC != nil || idp.GitHub != nil || idp.LDAP != nil):
+		return nil, errors.New("cannot configure ID provider for both client certificates and another type")
 
 	case idp.GitHub != nil:
 		newIDP.GitHub = &canonicalGitHubIDP{}
@@ -249,6 +350,10 @@ func validateIDP(idp *auth.IDProvider, src configSource) (*canonicalIDPConfig, e
 		return validateIDPSAML(idp, src)
 	case idp.OIDC != nil:
 		return validateIDPOIDC(idp, src)
+	case idp.LDAP != nil:
+		return validateIDPLDAP(idp)
+	case idp.Cert != nil:
+		return validateIDPCert(idp)
 	}
 
 	return nil, nil
@@ -376,6 +481,83 @@ func validateIDPOIDC(idp *auth.IDProvider, src configSource) (*canonicalIDPConfi
 	return newIDP, nil
 }
 
+func validateIDPLDAP(idp *auth.IDProvider) (*canonicalIDPConfig, error) {
+	newIDP := &canonicalIDPConfig{}
+	newIDP.Name = idp.Name
+	newIDP.Description = idp.Description
+	opts := idp.LDAP
+	newIDP.LDAP = &canonicalLDAPIDP{
+		BindDN:             opts.BindDN,
+		BindPassword:       opts.BindPassword,
+		UserSearchBase:     opts.UserSearchBase,
+		UserSearchFilter:   opts.UserSearchFilter,
+		UsernameAttribute:  opts.UsernameAttribute,
+		GroupSearchBase:    opts.GroupSearchBase,
+		GroupSearchFilter:  opts.GroupSearchFilter,
+		GroupNameAttribute: opts.GroupNameAttribute,
+		StartTLS:           opts.StartTLS,
+		RootCAs:            opts.RootCAs,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	var err error
+	if newIDP.LDAP.URL, err = url.Parse(opts.URL); err != nil {
+		return nil, errors.Wrapf(err, "could not parse LDAP URL (%q)", opts.URL)
+	}
+	switch newIDP.LDAP.URL.Scheme {
+	case "ldap":
+	case "ldaps":
+		if opts.StartTLS {
+			return nil, errors.Errorf("cannot use start_tls with the ldaps:// URL %q", opts.URL)
+		}
+	default:
+		return nil, errors.Errorf("LDAP URL %q is invalid (scheme must be ldap or ldaps)", opts.URL)
+	}
+	if newIDP.LDAP.URL.Host == "" {
+		return nil, errors.Errorf("LDAP URL %q is invalid (no host)", opts.URL)
+	}
+	if opts.BindDN == "" && opts.BindPassword != "" {
+		return nil, errors.New("cannot set an LDAP bind_password without a bind_dn")
+	}
+
+	if opts.UserSearchBase == "" {
+		return nil, errors.New("LDAP configuration must have a non-empty user_search_base")
+	}
+	if newIDP.LDAP.UserSearchFilter == "" {
+		newIDP.LDAP.UserSearchFilter = defaultLDAPUserSearchFilter
+	}
+	if !strings.Contains(newIDP.LDAP.UserSearchFilter, ldapUsernamePlaceholder) {
+		return nil, errors.Errorf("LDAP user_search_filter %q must contain %q",
+			newIDP.LDAP.UserSearchFilter, ldapUsernamePlaceholder)
+	}
+	if _, err := ldap.CompileFilter(expandLDAPFilter(newIDP.LDAP.UserSearchFilter, "user", "")); err != nil {
+		return nil, errors.Wrapf(err, "invalid LDAP user_search_filter")
+	}
+
+	if opts.GroupSearchBase != "" {
+		if newIDP.LDAP.GroupSearchFilter == "" {
+			newIDP.LDAP.GroupSearchFilter = defaultLDAPGroupSearchFilter
+		}
+		if newIDP.LDAP.GroupNameAttribute == "" {
+			newIDP.LDAP.GroupNameAttribute = defaultLDAPGroupNameAttribute
+		}
+		if _, err := ldap.CompileFilter(expandLDAPFilter(newIDP.LDAP.GroupSearchFilter, "user", "cn=user")); err != nil {
+			return nil, errors.Wrapf(err, "invalid LDAP group_search_filter")
+		}
+	} else if opts.GroupSearchFilter != "" || opts.GroupNameAttribute != "" {
+		return nil, errors.New("cannot set an LDAP group_search_filter or " +
+			"group_name_attribute without a group_search_base")
+	}
+
+	if opts.RootCAs != "" {
+		newIDP.LDAP.rootCAs = x509.NewCertPool()
+		if !newIDP.LDAP.rootCAs.AppendCertsFromPEM([]byte(opts.RootCAs)) {
+			return nil, errors.New("could not parse any certificates from LDAP root_cas")
+		}
+	}
+	return newIDP, nil
+}
+
 // validateConfig converts an auth.AuthConfig proto from an RPC into a
 // canonicalized config (with all URLs parsed, SAML metadata fetched and
 // persisted, etc.)
@@ -392,6 +574,8 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 	// providers)
 	var samlIDP string
 	var oidcIDP string
+	var ldapIDP string
+	var certIDP string
 	for _, idp := range config.IDProviders {
 		if idp.SAML != nil {
 			// confirm that there is only one SAML IDP (requirement for now)
@@ -409,6 +593,23 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 			}
 			oidcIDP = idp.Name
 		}
+		if idp.LDAP != nil {
+			// confirm that there is only one LDAP IDP (requirement for now)
+			if ldapIDP != "" {
+				return nil, errors.Errorf("two LDAP providers found in config, %q and %q, "+
+					"but only one is allowed", idp.Name, ldapIDP)
+			}
+			ldapIDP = idp.Name
+		}
+		if idp.Cert != nil {
+			// confirm that there is only one client certificate IDP, so that
+			// each certificate maps to at most one subject
+			if certIDP != "" {
+				return nil, errors.Errorf("two client certificate providers found in "+
+					"config, %q and %q, but only one is allowed", idp.Name, certIDP)
+			}
+			certIDP = idp.Name
+		}
 		canonicalIDP, err := validateIDP(idp, src)
 		if err != nil {
 			return nil, err
@@ -471,6 +672,12 @@ func validateConfig(config *auth.AuthConfig, src configSource) (*canonicalConfig
 		}
 	}
 
+	// Validate data policies
+	if err := datapolicy.Validate(config.DataPolicies); err != nil {
+		return nil, err
+	}
+	c.DataPolicies = config.DataPolicies
+
 	return c, nil
 }
 
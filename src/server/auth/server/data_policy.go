@@ -0,0 +1,54 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+)
+
+// GetDataPoliciesInTransaction returns the data policies in the cluster's
+// auth config. It reads the config inside the transaction, so that the
+// transaction conflicts with any concurrent change to the policies. This is
+// not an RPC.
+func (a *apiServer) GetDataPoliciesInTransaction(txnCtx *txnenv.TransactionContext) ([]*auth.DataPolicy, error) {
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	var config auth.AuthConfig
+	if err := a.authConfig.ReadWrite(txnCtx.Stm).Get(configKey, &config); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "could not read auth config")
+	}
+	return config.DataPolicies, nil
+}
+
+// checkPublicACL returns an error if 'acl' would make 'repo' readable by all
+// users in the cluster, but the repo carries a label whose data policy
+// forbids that
+func (a *apiServer) checkPublicACL(txnCtx *txnenv.TransactionContext, repo string, acl *auth.ACL) error {
+	if acl.Entries[allClusterUsersSubject] == auth.Scope_NONE {
+		return nil
+	}
+	policies, err := a.GetDataPoliciesInTransaction(txnCtx)
+	if err != nil || len(policies) == 0 {
+		return err
+	}
+	repoInfo, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
+		Repo: &pfs.Repo{Name: repo},
+	})
+	if err != nil {
+		if col.IsErrNotFound(err) {
+			return nil // the repo is being created, and has no labels yet
+		}
+		return errors.Wrapf(err, "could not inspect %q", repo)
+	}
+	if err := datapolicy.New(policies).CheckPublic(datapolicy.Labels(repoInfo)); err != nil {
+		return errors.Wrapf(err, "cannot grant %q access to %q", allClusterUsersSubject, repo)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestValidateDataPolicies(t *testing.T) {
+	// A config with data policies but no ID providers isn't empty
+	policies := []*auth.DataPolicy{{Label: "pii", Inherit: true, DenyEgress: true}}
+	c, err := validateConfig(&auth.AuthConfig{DataPolicies: policies}, external)
+	require.NoError(t, err)
+	config, err := c.ToProto()
+	require.NoError(t, err)
+	require.Equal(t, policies, config.DataPolicies)
+
+	_, err = validateConfig(&auth.AuthConfig{
+		DataPolicies: []*auth.DataPolicy{{Label: "pii"}, {Label: "pii"}},
+	}, external)
+	require.YesError(t, err)
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+const (
+	// ldapUsernamePlaceholder and ldapDNPlaceholder are replaced in LDAP search
+	// filters by the escaped username and DN of the user logging in
+	ldapUsernamePlaceholder = "{username}"
+	ldapDNPlaceholder       = "{dn}"
+
+	defaultLDAPUserSearchFilter   = "(uid={username})"
+	defaultLDAPGroupSearchFilter  = "(member={dn})"
+	defaultLDAPGroupNameAttribute = "cn"
+
+	// ldapTimeout bounds connecting to, and each request made of, the LDAP
+	// server
+	ldapTimeout = 10 * time.Second
+)
+
+// errInvalidLDAPCredentials is returned for any failed LDAP login, so that
+// callers can't discover which usernames exist
+var errInvalidLDAPCredentials = errors.New("invalid username or password")
+
+// ldapConn is the subset of *ldap.Conn used by pachd. It exists so that tests
+// can stand in for an LDAP server.
+type ldapConn interface {
+	Bind(username, password string) error
+	Search(req *ldap.SearchRequest) (*ldap.SearchResult, error)
+	Close()
+}
+
+// dialLDAP connects to the LDAP server configured in 'idp' (and upgrades the
+// connection to TLS if configured). It's a variable so that tests can replace
+// it.
+var dialLDAP = func(idp *canonicalLDAPIDP) (ldapConn, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         idp.URL.Hostname(),
+		RootCAs:            idp.rootCAs,
+		InsecureSkipVerify: idp.InsecureSkipVerify,
+	}
+	conn, err := ldap.DialURL(idp.URL.String(),
+		ldap.DialWithDialer(&net.Dialer{Timeout: ldapTimeout}),
+		ldap.DialWithTLSConfig(tlsConfig))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to LDAP server")
+	}
+	conn.SetTimeout(ldapTimeout)
+	if idp.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, errors.Wrapf(err, "could not start TLS with LDAP server")
+		}
+	}
+	return conn, nil
+}
+
+// expandLDAPFilter replaces the placeholders in the search filter 'filter'
+// with 'username' and 'dn', escaping them so that they can't alter the filter
+func expandLDAPFilter(filter, username, dn string) string {
+	return strings.NewReplacer(
+		ldapUsernamePlaceholder, ldap.EscapeFilter(username),
+		ldapDNPlaceholder, ldap.EscapeFilter(dn),
+	).Replace(filter)
+}
+
+// bindServiceAccount binds 'conn' as the service account configured in 'idp',
+// if any (otherwise 'conn' remains anonymous or bound as the user)
+func bindServiceAccount(conn ldapConn, idp *canonicalLDAPIDP) error {
+	if idp.BindDN == "" {
+		return nil
+	}
+	if err := conn.Bind(idp.BindDN, idp.BindPassword); err != nil {
+		return errors.Wrapf(err, "could not bind to LDAP server as %q", idp.BindDN)
+	}
+	return nil
+}
+
+// authenticateLDAP verifies 'username' and 'password' against the LDAP ID
+// provider 'idpName', and returns the user's Pachyderm subject along with
+// the Pachyderm groups that they belong to (if group search is configured).
+func authenticateLDAP(idpName string, idp *canonicalLDAPIDP, username, password string) (subject string, groups []string, retErr error) {
+	// An empty password makes the bind below "unauthenticated", which many
+	// LDAP servers accept, so reject it explicitly
+	if username == "" || password == "" {
+		return "", nil, errInvalidLDAPCredentials
+	}
+	conn, err := dialLDAP(idp)
+	if err != nil {
+		return "", nil, err
+	}
+	defer conn.Close()
+
+	// Find the user's entry
+	if err := bindServiceAccount(conn, idp); err != nil {
+		return "", nil, err
+	}
+	var attributes []string
+	if idp.UsernameAttribute != "" {
+		attributes = []string{idp.UsernameAttribute}
+	}
+	users, err := conn.Search(ldap.NewSearchRequest(
+		idp.UserSearchBase, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		2, int(ldapTimeout/time.Second), false,
+		expandLDAPFilter(idp.UserSearchFilter, username, ""),
+		attributes, nil))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return "", nil, errors.Wrapf(err, "could not search for LDAP user")
+	}
+	if users == nil || len(users.Entries) != 1 {
+		// either no user or several users matched
+		return "", nil, errInvalidLDAPCredentials
+	}
+	user := users.Entries[0]
+
+	// Verify the user's password by binding as them
+	if err := conn.Bind(user.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return "", nil, errInvalidLDAPCredentials
+		}
+		return "", nil, errors.Wrapf(err, "could not bind to LDAP server as user")
+	}
+
+	name := username
+	if idp.UsernameAttribute != "" {
+		if name = user.GetAttributeValue(idp.UsernameAttribute); name == "" {
+			return "", nil, errors.Errorf("LDAP user %q has no %q attribute", user.DN, idp.UsernameAttribute)
+		}
+	}
+	subject = idpName + ":" + name
+	if idp.GroupSearchBase == "" {
+		return subject, nil, nil
+	}
+
+	// Look up the user's groups (as the service account, as users often can't
+	// search groups themselves)
+	if err := bindServiceAccount(conn, idp); err != nil {
+		return "", nil, err
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		idp.GroupSearchBase, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases,
+		0, int(ldapTimeout/time.Second), false,
+		expandLDAPFilter(idp.GroupSearchFilter, username, user.DN),
+		[]string{idp.GroupNameAttribute}, nil))
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "could not search for LDAP groups")
+	}
+	for _, entry := range result.Entries {
+		if g := entry.GetAttributeValue(idp.GroupNameAttribute); g != "" {
+			groups = append(groups, fmt.Sprintf("group/%s:%s", idpName, g))
+		}
+	}
+	return subject, groups, nil
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// fakeDirectory is an in-process stand-in for an LDAP server. It only
+// supports equality filters, e.g. "(uid=alice)".
+type fakeDirectory struct {
+	passwords map[string]string            // DN -> password
+	entries   map[string]map[string]string // DN -> attribute -> value
+	binds     []string                     // DNs bound, in order
+}
+
+var equalityFilter = regexp.MustCompile(`^\(([a-zA-Z]+)=(.*)\)$`)
+
+func (d *fakeDirectory) Bind(username, password string) error {
+	if password == "" || d.passwords[username] != password {
+		return ldap.NewError(ldap.LDAPResultInvalidCredentials, errors.New("invalid credentials"))
+	}
+	d.binds = append(d.binds, username)
+	return nil
+}
+
+func (d *fakeDirectory) Search(req *ldap.SearchRequest) (*ldap.SearchResult, error) {
+	m := equalityFilter.FindStringSubmatch(req.Filter)
+	if m == nil {
+		return nil, errors.Errorf("unsupported filter %q", req.Filter)
+	}
+	result := &ldap.SearchResult{}
+	for dn, attrs := range d.entries {
+		if !strings.HasSuffix(dn, req.BaseDN) || attrs[m[1]] != m[2] {
+			continue
+		}
+		entry := &ldap.Entry{DN: dn}
+		for _, a := range req.Attributes {
+			entry.Attributes = append(entry.Attributes, ldap.NewEntryAttribute(a, []string{attrs[a]}))
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	return result, nil
+}
+
+func (d *fakeDirectory) Close() {}
+
+// newFakeDirectory replaces dialLDAP with a function returning a new
+// fakeDirectory. The caller should call the returned function to restore it.
+func newFakeDirectory() (*fakeDirectory, func()) {
+	d := &fakeDirectory{
+		passwords: map[string]string{
+			"cn=pachd,dc=example,dc=com":           "service-password",
+			"uid=alice,ou=users,dc=example,dc=com": "alice-password",
+		},
+		entries: map[string]map[string]string{
+			"uid=alice,ou=users,dc=example,dc=com": {"uid": "alice", "mail": "alice@example.com"},
+			"uid=bob,ou=users,dc=example,dc=com":   {"uid": "bob", "mail": "bob@example.com"},
+			"cn=eng,ou=groups,dc=example,dc=com":   {"cn": "eng", "member": "uid=alice,ou=users,dc=example,dc=com"},
+			"cn=ops,ou=groups,dc=example,dc=com":   {"cn": "ops", "member": "uid=bob,ou=users,dc=example,dc=com"},
+		},
+	}
+	prevDial := dialLDAP
+	dialLDAP = func(*canonicalLDAPIDP) (ldapConn, error) { return d, nil }
+	return d, func() { dialLDAP = prevDial }
+}
+
+func validLDAPConfig() *auth.IDProvider {
+	return &auth.IDProvider{
+		Name: "ad",
+		LDAP: &auth.IDProvider_LDAPOptions{
+			URL:             "ldaps://ldap.example.com",
+			BindDN:          "cn=pachd,dc=example,dc=com",
+			BindPassword:    "service-password",
+			UserSearchBase:  "ou=users,dc=example,dc=com",
+			GroupSearchBase: "ou=groups,dc=example,dc=com",
+		},
+	}
+}
+
+func TestValidateIDPLDAP(t *testing.T) {
+	idp, err := validateIDP(validLDAPConfig(), external)
+	require.NoError(t, err)
+	require.Equal(t, defaultLDAPUserSearchFilter, idp.LDAP.UserSearchFilter)
+	require.Equal(t, defaultLDAPGroupSearchFilter, idp.LDAP.GroupSearchFilter)
+	require.Equal(t, defaultLDAPGroupNameAttribute, idp.LDAP.GroupNameAttribute)
+
+	for _, modify := range []func(*auth.IDProvider_LDAPOptions){
+		func(o *auth.IDProvider_LDAPOptions) { o.URL = "https://ldap.example.com" },
+		func(o *auth.IDProvider_LDAPOptions) { o.URL = "ldap://" },
+		func(o *auth.IDProvider_LDAPOptions) { o.StartTLS = true },
+		func(o *auth.IDProvider_LDAPOptions) { o.BindDN = "" },
+		func(o *auth.IDProvider_LDAPOptions) { o.UserSearchBase = "" },
+		func(o *auth.IDProvider_LDAPOptions) { o.UserSearchFilter = "(uid=alice)" },
+		func(o *auth.IDProvider_LDAPOptions) { o.UserSearchFilter = "(uid={username}" },
+		func(o *auth.IDProvider_LDAPOptions) { o.GroupSearchBase, o.GroupNameAttribute = "", "cn" },
+		func(o *auth.IDProvider_LDAPOptions) { o.RootCAs = "not a certificate" },
+	} {
+		config := validLDAPConfig()
+		modify(config.LDAP)
+		_, err := validateIDP(config, external)
+		require.YesError(t, err)
+	}
+
+	// Only one LDAP ID provider may be configured
+	_, err = validateConfig(&auth.AuthConfig{
+		IDProviders: []*auth.IDProvider{validLDAPConfig(), validLDAPConfig()},
+	}, external)
+	require.YesError(t, err)
+}
+
+// TestLDAPBindPasswordRoundTrip tests that GetConfiguration doesn't return
+// the bind password, and that setting the returned configuration keeps it
+func TestLDAPBindPasswordRoundTrip(t *testing.T) {
+	live := &auth.AuthConfig{IDProviders: []*auth.IDProvider{validLDAPConfig()}}
+	redacted := redactLDAPBindPasswords(live)
+	require.Equal(t, "", redacted.IDProviders[0].LDAP.BindPassword)
+	require.Equal(t, "service-password", live.IDProviders[0].LDAP.BindPassword)
+
+	keepLDAPBindPasswords(redacted, live)
+	require.Equal(t, "service-password", redacted.IDProviders[0].LDAP.BindPassword)
+
+	// A new bind password replaces the live one
+	changed := redactLDAPBindPasswords(live)
+	changed.IDProviders[0].LDAP.BindPassword = "new-password"
+	keepLDAPBindPasswords(changed, live)
+	require.Equal(t, "new-password", changed.IDProviders[0].LDAP.BindPassword)
+
+	// Other ID providers' bind passwords aren't used
+	renamed := redactLDAPBindPasswords(live)
+	renamed.IDProviders[0].Name = "other"
+	keepLDAPBindPasswords(renamed, live)
+	require.Equal(t, "", renamed.IDProviders[0].LDAP.BindPassword)
+}
+
+func TestAuthenticateLDAP(t *testing.T) {
+	d, restore := newFakeDirectory()
+	defer restore()
+	idp, err := validateIDP(validLDAPConfig(), external)
+	require.NoError(t, err)
+
+	// Logging in binds as the service account to find the user, as the user
+	// to check their password, and as the service account to find their groups
+	subject, groups, err := authenticateLDAP(idp.Name, idp.LDAP, "alice", "alice-password")
+	require.NoError(t, err)
+	require.Equal(t, "ad:alice", subject)
+	require.Equal(t, []string{"group/ad:eng"}, groups)
+	require.Equal(t, []string{
+		"cn=pachd,dc=example,dc=com",
+		"uid=alice,ou=users,dc=example,dc=com",
+		"cn=pachd,dc=example,dc=com",
+	}, d.binds)
+
+	// Bad credentials all fail with the same error
+	for _, creds := range [][2]string{
+		{"alice", "wrong-password"},
+		{"alice", ""},
+		{"carol", "alice-password"},
+		{"*", "alice-password"}, // escaped, so it doesn't match every user
+		{"", ""},
+	} {
+		_, _, err := authenticateLDAP(idp.Name, idp.LDAP, creds[0], creds[1])
+		require.YesError(t, err)
+		require.Equal(t, errInvalidLDAPCredentials, err)
+	}
+
+	// Usernames may come from an attribute, and group search is optional
+	idp.LDAP.UsernameAttribute = "mail"
+	idp.LDAP.GroupSearchBase = ""
+	subject, groups, err = authenticateLDAP(idp.Name, idp.LDAP, "alice", "alice-password")
+	require.NoError(t, err)
+	require.Equal(t, "ad:alice@example.com", subject)
+	require.Equal(t, 0, len(groups))
+}
+
+func TestExpandLDAPFilter(t *testing.T) {
+	require.Equal(t, `(&(member=cn=a\28b\29,dc=x)(uid=\2a))`,
+		expandLDAPFilter("(&(member={dn})(uid={username}))", "*", "cn=a(b),dc=x"))
+}
@@ -0,0 +1,444 @@
+package server
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+
+	"golang.org/x/net/context"
+)
+
+// validRobotName matches valid robot and robot key names
+var validRobotName = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+
+func validateRobotName(kind, name string) error {
+	if !validRobotName.MatchString(name) {
+		return errors.Errorf("invalid %s name %q: may only contain alphanumeric "+
+			"characters, underscores, dashes and dots", kind, name)
+	}
+	return nil
+}
+
+func validateRestrictions(r *auth.TokenRestrictions) error {
+	if r == nil {
+		return nil
+	}
+	for _, repo := range r.Repos {
+		if repo == "" {
+			return errors.Errorf("invalid restrictions: repo names must not be empty")
+		}
+	}
+	return nil
+}
+
+// restrictionsAllow returns true if a token with restrictions 'r' may be used
+// to authorize 'req'
+func restrictionsAllow(r *auth.TokenRestrictions, req *auth.AuthorizeRequest) bool {
+	if r == nil {
+		return true
+	}
+	if len(r.Repos) > 0 {
+		allowed := false
+		for _, repo := range r.Repos {
+			if repo == req.Repo {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	if r.ReadOnly {
+		readOnly := permissionSet{
+			auth.Permission_REPO_READ:     true,
+			auth.Permission_PIPELINE_LOGS: true,
+		}
+		if !readOnly.hasAll(requiredPermissions(req)) {
+			return false
+		}
+	}
+	return true
+}
+
+// restrictScope returns the highest scope no greater than 's' that a token
+// with restrictions 'r' may exercise on 'repo'
+func restrictScope(r *auth.TokenRestrictions, repo string, s auth.Scope) auth.Scope {
+	for ; s > auth.Scope_NONE; s-- {
+		if restrictionsAllow(r, &auth.AuthorizeRequest{Repo: repo, Scope: s}) {
+			break
+		}
+	}
+	return s
+}
+
+// callerRestrictions returns the restrictions of the token in 'ctx', if it
+// belongs to 'subject' (and nil otherwise). Only robots have restricted
+// tokens.
+func (a *apiServer) callerRestrictions(ctx context.Context, subject string) *auth.TokenRestrictions {
+	if !strings.HasPrefix(subject, auth.RobotPrefix) {
+		return nil
+	}
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil || callerInfo.Subject != subject {
+		return nil // 'ctx' doesn't identify 'subject'
+	}
+	return callerInfo.Restrictions
+}
+
+// checkUnrestricted returns an error if 'callerInfo' describes a restricted
+// token, which may not be used for 'op'
+func checkUnrestricted(callerInfo *auth.TokenInfo, op string) error {
+	if callerInfo.Restrictions != nil {
+		return &auth.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: op + " with a restricted token",
+		}
+	}
+	return nil
+}
+
+// redactRobot returns a copy of 'robot' without its keys' hashed tokens
+func redactRobot(robot *auth.Robot) *auth.Robot {
+	result := proto.Clone(robot).(*auth.Robot)
+	for _, key := range result.Keys {
+		redactRobotKey(key)
+	}
+	return result
+}
+
+func redactRobotKey(key *auth.RobotKey) *auth.RobotKey {
+	key.HashedToken = ""
+	key.PreviousHashedToken = ""
+	return key
+}
+
+// expiration returns the time 'ttl' seconds from now, or nil if 'ttl' is 0
+func expiration(ttl int64) (*types.Timestamp, error) {
+	if ttl <= 0 {
+		return nil, nil
+	}
+	return types.TimestampProto(time.Now().Add(time.Duration(ttl) * time.Second))
+}
+
+// putRobotKeyToken generates a new token for 'key' of 'robot', valid for 'ttl'
+// seconds (or forever if 'ttl' is 0), and records its hash in 'key'
+func (a *apiServer) putRobotKeyToken(stm col.STM, robot string, key *auth.RobotKey, ttl int64) (string, error) {
+	if ttl < 0 {
+		return "", errors.Errorf("invalid TTL %d: must be >= 0", ttl)
+	}
+	token := uuid.NewWithoutDashes()
+	if err := a.tokens.ReadWrite(stm).PutTTL(hashToken(token), &auth.TokenInfo{
+		Subject:      auth.RobotPrefix + robot,
+		Source:       auth.TokenInfo_ROBOT_KEY,
+		RobotKey:     key.Name,
+		Restrictions: key.Restrictions,
+		Created:      types.TimestampNow(),
+	}, ttl); err != nil {
+		return "", errors.Wrapf(err, "error storing token for robot %q", robot)
+	}
+	var err error
+	key.HashedToken = hashToken(token)
+	if key.Expiration, err = expiration(ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// deleteRobotKeyTokens revokes the current and previous tokens of 'key'
+func (a *apiServer) deleteRobotKeyTokens(stm col.STM, key *auth.RobotKey) error {
+	tokens := a.tokens.ReadWrite(stm)
+	for _, hash := range []string{key.HashedToken, key.PreviousHashedToken} {
+		if hash == "" {
+			continue
+		}
+		if err := tokens.Delete(hash); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) getRobot(stm col.STM, name string) (*auth.Robot, error) {
+	robot := &auth.Robot{}
+	if err := a.robots.ReadWrite(stm).Get(name, robot); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("robot %q not found", name)
+		}
+		return nil, err
+	}
+	if robot.Keys == nil {
+		robot.Keys = make(map[string]*auth.RobotKey)
+	}
+	return robot, nil
+}
+
+// CreateRobot implements the protobuf auth.CreateRobot RPC
+func (a *apiServer) CreateRobot(ctx context.Context, req *auth.CreateRobotRequest) (resp *auth.CreateRobotResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.checkSuperAdmin(ctx, "CreateRobot"); err != nil {
+		return nil, err
+	}
+	if err := validateRobotName("robot", req.Name); err != nil {
+		return nil, err
+	}
+	robot := &auth.Robot{
+		Name:        req.Name,
+		Description: req.Description,
+		Created:     types.TimestampNow(),
+		CreatedBy:   callerInfo.Subject,
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.robots.ReadWrite(stm).Create(robot.Name, robot)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRobotResponse{Robot: robot}, nil
+}
+
+// ListRobots implements the protobuf auth.ListRobots RPC
+func (a *apiServer) ListRobots(ctx context.Context, req *auth.ListRobotsRequest) (resp *auth.ListRobotsResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "ListRobots"); err != nil {
+		return nil, err
+	}
+	resp = &auth.ListRobotsResponse{}
+	robot := &auth.Robot{}
+	if err := a.robots.ReadOnly(ctx).List(robot, col.DefaultOptions, func(string) error {
+		resp.Robots = append(resp.Robots, redactRobot(robot))
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(resp.Robots, func(i, j int) bool {
+		return resp.Robots[i].Name < resp.Robots[j].Name
+	})
+	return resp, nil
+}
+
+// DeleteRobot implements the protobuf auth.DeleteRobot RPC. It revokes all of
+// the robot's API keys.
+func (a *apiServer) DeleteRobot(ctx context.Context, req *auth.DeleteRobotRequest) (resp *auth.DeleteRobotResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "DeleteRobot"); err != nil {
+		return nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		robot, err := a.getRobot(stm, req.Name)
+		if err != nil {
+			return err
+		}
+		for _, key := range robot.Keys {
+			if err := a.deleteRobotKeyTokens(stm, key); err != nil {
+				return err
+			}
+		}
+		return a.robots.ReadWrite(stm).Delete(req.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRobotResponse{}, nil
+}
+
+// CreateRobotKey implements the protobuf auth.CreateRobotKey RPC
+func (a *apiServer) CreateRobotKey(ctx context.Context, req *auth.CreateRobotKeyRequest) (resp *auth.CreateRobotKeyResponse, retErr error) {
+	a.LogReq(req)
+	// Don't log response to avoid logging the new token
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "CreateRobotKey"); err != nil {
+		return nil, err
+	}
+	if err := validateRobotName("key", req.Name); err != nil {
+		return nil, err
+	}
+	if err := validateRestrictions(req.Restrictions); err != nil {
+		return nil, err
+	}
+	var token string
+	var key *auth.RobotKey
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		robot, err := a.getRobot(stm, req.Robot)
+		if err != nil {
+			return err
+		}
+		if _, ok := robot.Keys[req.Name]; ok {
+			return errors.Errorf("robot %q already has a key named %q", req.Robot, req.Name)
+		}
+		key = &auth.RobotKey{
+			Name:         req.Name,
+			Created:      types.TimestampNow(),
+			Restrictions: req.Restrictions,
+		}
+		if token, err = a.putRobotKeyToken(stm, req.Robot, key, req.TTL); err != nil {
+			return err
+		}
+		robot.Keys[key.Name] = key
+		return a.robots.ReadWrite(stm).Put(robot.Name, robot)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRobotKeyResponse{
+		Token: token,
+		Key:   redactRobotKey(key),
+	}, nil
+}
+
+// ListRobotKeys implements the protobuf auth.ListRobotKeys RPC. The keys'
+// tokens are not returned.
+func (a *apiServer) ListRobotKeys(ctx context.Context, req *auth.ListRobotKeysRequest) (resp *auth.ListRobotKeysResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "ListRobotKeys"); err != nil {
+		return nil, err
+	}
+	robot := &auth.Robot{}
+	if err := a.robots.ReadOnly(ctx).Get(req.Robot, robot); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("robot %q not found", req.Robot)
+		}
+		return nil, err
+	}
+	resp = &auth.ListRobotKeysResponse{}
+	for _, key := range redactRobot(robot).Keys {
+		resp.Keys = append(resp.Keys, key)
+	}
+	sort.Slice(resp.Keys, func(i, j int) bool {
+		return resp.Keys[i].Name < resp.Keys[j].Name
+	})
+	return resp, nil
+}
+
+// DeleteRobotKey implements the protobuf auth.DeleteRobotKey RPC
+func (a *apiServer) DeleteRobotKey(ctx context.Context, req *auth.DeleteRobotKeyRequest) (resp *auth.DeleteRobotKeyResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "DeleteRobotKey"); err != nil {
+		return nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		robot, err := a.getRobot(stm, req.Robot)
+		if err != nil {
+			return err
+		}
+		key, ok := robot.Keys[req.Name]
+		if !ok {
+			return errors.Errorf("robot %q has no key named %q", req.Robot, req.Name)
+		}
+		if err := a.deleteRobotKeyTokens(stm, key); err != nil {
+			return err
+		}
+		delete(robot.Keys, req.Name)
+		return a.robots.ReadWrite(stm).Put(robot.Name, robot)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRobotKeyResponse{}, nil
+}
+
+// RotateRobotKey implements the protobuf auth.RotateRobotKey RPC. The key
+// gets a new token, and its old token remains valid for req.Overlap seconds
+// (any token left over from an earlier rotation is revoked immediately).
+func (a *apiServer) RotateRobotKey(ctx context.Context, req *auth.RotateRobotKeyRequest) (resp *auth.RotateRobotKeyResponse, retErr error) {
+	a.LogReq(req)
+	// Don't log response to avoid logging the new token
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "RotateRobotKey"); err != nil {
+		return nil, err
+	}
+	if req.Overlap < 0 {
+		return nil, errors.Errorf("invalid overlap %d: must be >= 0", req.Overlap)
+	}
+	var token string
+	var key *auth.RobotKey
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		robot, err := a.getRobot(stm, req.Robot)
+		if err != nil {
+			return err
+		}
+		var ok bool
+		if key, ok = robot.Keys[req.Name]; !ok {
+			return errors.Errorf("robot %q has no key named %q", req.Robot, req.Name)
+		}
+		tokens := a.tokens.ReadWrite(stm)
+		if key.PreviousHashedToken != "" {
+			if err := tokens.Delete(key.PreviousHashedToken); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		key.PreviousHashedToken, key.PreviousExpiration = "", nil
+
+		// Shorten the old token's lifetime to the overlap (unless it expires
+		// sooner anyway)
+		oldHash := key.HashedToken
+		var oldInfo auth.TokenInfo
+		if err := tokens.Get(oldHash, &oldInfo); err != nil && !col.IsErrNotFound(err) {
+			return err
+		} else if err == nil {
+			if req.Overlap == 0 {
+				if err := tokens.Delete(oldHash); err != nil {
+					return err
+				}
+			} else {
+				overlap := req.Overlap
+				if ttl, err := tokens.TTL(oldHash); err != nil {
+					return err
+				} else if ttl > 0 && ttl < overlap {
+					overlap = ttl
+				}
+				if err := tokens.PutTTL(oldHash, &oldInfo, overlap); err != nil {
+					return err
+				}
+				key.PreviousHashedToken = oldHash
+				if key.PreviousExpiration, err = expiration(overlap); err != nil {
+					return err
+				}
+			}
+		}
+		if token, err = a.putRobotKeyToken(stm, req.Robot, key, req.TTL); err != nil {
+			return err
+		}
+		return a.robots.ReadWrite(stm).Put(robot.Name, robot)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.RotateRobotKeyResponse{
+		Token: token,
+		Key:   redactRobotKey(key),
+	}, nil
+}
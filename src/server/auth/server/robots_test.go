@@ -0,0 +1,22 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestRestrictScope(t *testing.T) {
+	require.Equal(t, auth.Scope_OWNER, restrictScope(nil, "a", auth.Scope_OWNER))
+
+	readOnly := &auth.TokenRestrictions{Repos: []string{"a"}, ReadOnly: true}
+	require.Equal(t, auth.Scope_READER, restrictScope(readOnly, "a", auth.Scope_OWNER))
+	require.Equal(t, auth.Scope_NONE, restrictScope(readOnly, "b", auth.Scope_OWNER))
+	require.False(t, restrictionsAllow(readOnly, &auth.AuthorizeRequest{Repo: "a", Scope: auth.Scope_OWNER}))
+
+	// A key restricted to some repos may only own those repos
+	repos := &auth.TokenRestrictions{Repos: []string{"a"}}
+	require.Equal(t, auth.Scope_OWNER, restrictScope(repos, "a", auth.Scope_OWNER))
+	require.False(t, restrictionsAllow(repos, &auth.AuthorizeRequest{Repo: "b", Scope: auth.Scope_OWNER}))
+}
@@ -0,0 +1,426 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+
+	"golang.org/x/net/context"
+)
+
+// clusterRoleBindingsKey is the key in the 'roleBindings' collection under
+// which cluster-wide role bindings are stored. Repo role bindings are stored
+// under the repo's name, and ':' can't appear in repo names.
+const clusterRoleBindingsKey = ":cluster"
+
+// builtinRoles are the roles corresponding to the READER, WRITER and OWNER
+// scopes. Every entry in a repo's ACL is equivalent to binding the built-in
+// role for the entry's scope on the repo.
+var builtinRoles = map[auth.Scope]*auth.Role{
+	auth.Scope_READER: {
+		Name:        "repoReader",
+		Description: "Read a repo, and the logs and datums of its pipeline (READER scope)",
+		Permissions: []auth.Permission{
+			auth.Permission_REPO_READ,
+			auth.Permission_PIPELINE_LOGS,
+		},
+		Builtin: true,
+	},
+	auth.Scope_WRITER: {
+		Name:        "repoWriter",
+		Description: "Read and write a repo, and update its pipeline (WRITER scope)",
+		Permissions: []auth.Permission{
+			auth.Permission_REPO_READ,
+			auth.Permission_REPO_WRITE,
+			auth.Permission_PIPELINE_LOGS,
+			auth.Permission_PIPELINE_UPDATE,
+		},
+		Builtin: true,
+	},
+	auth.Scope_OWNER: {
+		Name:        "repoOwner",
+		Description: "Full control of a repo, its pipeline and its ACL (OWNER scope)",
+		Permissions: []auth.Permission{
+			auth.Permission_REPO_READ,
+			auth.Permission_REPO_WRITE,
+			auth.Permission_REPO_DELETE,
+			auth.Permission_PIPELINE_LOGS,
+			auth.Permission_PIPELINE_UPDATE,
+			auth.Permission_PIPELINE_DELETE,
+			auth.Permission_AUTH_MODIFY_ACL,
+		},
+		Builtin: true,
+	},
+}
+
+// scopePermissions maps the scope in an AuthorizeRequest that doesn't set
+// 'permissions' to the permissions that the caller needs. OWNER requires every
+// permission of the built-in OWNER role, so that no custom role short of full
+// control of a repo passes an OWNER check.
+var scopePermissions = map[auth.Scope][]auth.Permission{
+	auth.Scope_READER: {auth.Permission_REPO_READ},
+	auth.Scope_WRITER: {auth.Permission_REPO_WRITE},
+	auth.Scope_OWNER:  builtinRoles[auth.Scope_OWNER].Permissions,
+}
+
+// permissionSet is a set of permissions
+type permissionSet map[auth.Permission]bool
+
+func (s permissionSet) add(permissions ...auth.Permission) {
+	for _, p := range permissions {
+		s[p] = true
+	}
+}
+
+// hasAll returns true if 's' contains every permission in 'permissions'
+func (s permissionSet) hasAll(permissions []auth.Permission) bool {
+	for _, p := range permissions {
+		if !s[p] {
+			return false
+		}
+	}
+	return true
+}
+
+// requiredPermissions returns the permissions that the caller needs to be
+// authorized by 'req'
+func requiredPermissions(req *auth.AuthorizeRequest) []auth.Permission {
+	if len(req.Permissions) > 0 {
+		return req.Permissions
+	}
+	return scopePermissions[req.Scope]
+}
+
+// requires returns true if 'required' (as returned by requiredPermissions)
+// includes 'permission'
+func requires(required []auth.Permission, permission auth.Permission) bool {
+	for _, p := range required {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// builtinRole returns the built-in role named 'name', or nil if there isn't
+// one
+func builtinRole(name string) *auth.Role {
+	for _, role := range builtinRoles {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
+// getRole returns the built-in or custom role named 'name', or nil if there's
+// no such role (e.g. because it was deleted after being bound)
+func (a *apiServer) getRole(txnCtx *txnenv.TransactionContext, name string) (*auth.Role, error) {
+	if role := builtinRole(name); role != nil {
+		return role, nil
+	}
+	role := &auth.Role{}
+	if err := a.roles.ReadWrite(txnCtx.Stm).Get(name, role); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return role, nil
+}
+
+// getPermissions returns the permissions that 'subject' has on 'repo': the
+// permissions of the built-in role for 'scope' (the subject's scope in the
+// relevant ACL), plus those of any roles bound to the subject (or one of its
+// groups) on 'repo' or on the cluster. If 'repo' is empty, only the cluster's
+// role bindings are considered.
+func (a *apiServer) getPermissions(txnCtx *txnenv.TransactionContext, subject string, repo string, scope auth.Scope) (permissionSet, error) {
+	perms := make(permissionSet)
+	if role, ok := builtinRoles[scope]; ok {
+		perms.add(role.Permissions...)
+	}
+	groups, err := a.getGroups(txnCtx.ClientContext, subject)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not retrieve caller's group memberships")
+	}
+	principals := append([]string{allClusterUsersSubject, subject}, groups...)
+	keys := []string{clusterRoleBindingsKey}
+	if repo != "" {
+		keys = append(keys, repo)
+	}
+	roleBindings := a.roleBindings.ReadWrite(txnCtx.Stm)
+	for _, key := range keys {
+		var bindings auth.RoleBindings
+		if err := roleBindings.Get(key, &bindings); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, principal := range principals {
+			for _, name := range bindings.Entries[principal].GetNames() {
+				role, err := a.getRole(txnCtx, name)
+				if err != nil {
+					return nil, err
+				}
+				if role != nil {
+					perms.add(role.Permissions...)
+				}
+			}
+		}
+	}
+	return perms, nil
+}
+
+// hasPermission returns true if 'subject' has permission 'p' on 'repo', given
+// 'acl' (which is the ACL of either 'repo' or a pipeline)
+func (a *apiServer) hasPermission(txnCtx *txnenv.TransactionContext, subject string, repo string, acl *auth.ACL, p auth.Permission) (bool, error) {
+	if !restrictionsAllow(a.callerRestrictions(txnCtx.ClientContext, subject), &auth.AuthorizeRequest{
+		Repo:        repo,
+		Permissions: []auth.Permission{p},
+	}) {
+		return false, nil
+	}
+	scope, err := a.getScope(txnCtx.ClientContext, subject, acl)
+	if err != nil {
+		return false, err
+	}
+	perms, err := a.getPermissions(txnCtx, subject, repo, scope)
+	if err != nil {
+		return false, err
+	}
+	return perms[p], nil
+}
+
+// checkSuperAdmin returns an error unless the caller is a cluster admin
+func (a *apiServer) checkSuperAdmin(ctx context.Context, op string) error {
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return err
+	}
+	isAdmin, err := a.hasClusterRole(ctx, callerInfo.Subject, auth.ClusterRole_SUPER)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		return &auth.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: op,
+		}
+	}
+	return nil
+}
+
+func validateRole(role *auth.Role) error {
+	if role == nil || role.Name == "" {
+		return errors.Errorf("invalid role: must set name")
+	}
+	if builtinRole(role.Name) != nil {
+		return errors.Errorf("invalid role: %q is a built-in role", role.Name)
+	}
+	if len(role.Permissions) == 0 {
+		return errors.Errorf("invalid role: role %q has no permissions", role.Name)
+	}
+	for _, p := range role.Permissions {
+		if _, ok := auth.Permission_name[int32(p)]; !ok || p == auth.Permission_PERMISSION_UNKNOWN {
+			return errors.Errorf("invalid role: unknown permission %v", p)
+		}
+	}
+	return nil
+}
+
+// CreateRole implements the protobuf auth.CreateRole RPC
+func (a *apiServer) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (resp *auth.CreateRoleResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "CreateRole"); err != nil {
+		return nil, err
+	}
+	if err := validateRole(req.Role); err != nil {
+		return nil, err
+	}
+	role := &auth.Role{
+		Name:        req.Role.Name,
+		Description: req.Role.Description,
+		Permissions: req.Role.Permissions,
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.roles.ReadWrite(stm).Create(role.Name, role)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.CreateRoleResponse{}, nil
+}
+
+// DeleteRole implements the protobuf auth.DeleteRole RPC. Bindings of the
+// deleted role are left in place, but grant no permissions.
+func (a *apiServer) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (resp *auth.DeleteRoleResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if err := a.checkSuperAdmin(ctx, "DeleteRole"); err != nil {
+		return nil, err
+	}
+	if builtinRole(req.Name) != nil {
+		return nil, errors.Errorf("cannot delete built-in role %q", req.Name)
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.roles.ReadWrite(stm).Delete(req.Name)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.DeleteRoleResponse{}, nil
+}
+
+// ListRoles implements the protobuf auth.ListRoles RPC
+func (a *apiServer) ListRoles(ctx context.Context, req *auth.ListRolesRequest) (resp *auth.ListRolesResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if _, err := a.getAuthenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+	resp = &auth.ListRolesResponse{}
+	for _, scope := range []auth.Scope{auth.Scope_READER, auth.Scope_WRITER, auth.Scope_OWNER} {
+		resp.Roles = append(resp.Roles, builtinRoles[scope])
+	}
+	var custom []*auth.Role
+	role := &auth.Role{}
+	if err := a.roles.ReadOnly(ctx).List(role, col.DefaultOptions, func(string) error {
+		custom = append(custom, &auth.Role{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.Permissions,
+		})
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(custom, func(i, j int) bool { return custom[i].Name < custom[j].Name })
+	resp.Roles = append(resp.Roles, custom...)
+	return resp, nil
+}
+
+// ModifyRoleBinding implements the protobuf auth.ModifyRoleBinding RPC
+func (a *apiServer) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest) (resp *auth.ModifyRoleBindingResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	if req.Principal == "" {
+		return nil, errors.Errorf("invalid request: must set principal")
+	}
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	principal, err := a.canonicalizeSubject(ctx, req.Principal)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		key := clusterRoleBindingsKey
+		if req.Repo == "" {
+			// Only cluster admins may bind roles on the whole cluster
+			if err := a.checkSuperAdmin(ctx, "ModifyRoleBinding"); err != nil {
+				return err
+			}
+		} else {
+			key = req.Repo
+			if _, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx,
+				&pfs.InspectRepoRequest{Repo: &pfs.Repo{Name: req.Repo}},
+			); err != nil {
+				return err
+			}
+			isAdmin, err := a.hasClusterRole(ctx, callerInfo.Subject, auth.ClusterRole_FS)
+			if err != nil {
+				return err
+			}
+			if !isAdmin {
+				var acl auth.ACL
+				if err := a.acls.ReadWrite(txnCtx.Stm).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
+					return err
+				}
+				ok, err := a.hasPermission(txnCtx, callerInfo.Subject, req.Repo, &acl, auth.Permission_AUTH_MODIFY_ACL)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return &auth.ErrNotAuthorized{
+						Subject:  callerInfo.Subject,
+						Repo:     req.Repo,
+						Required: auth.Scope_OWNER,
+					}
+				}
+			}
+		}
+		for _, name := range req.Roles {
+			role, err := a.getRole(txnCtx, name)
+			if err != nil {
+				return err
+			}
+			if role == nil {
+				return errors.Errorf("role %q does not exist", name)
+			}
+		}
+
+		roleBindings := a.roleBindings.ReadWrite(txnCtx.Stm)
+		var bindings auth.RoleBindings
+		if err := roleBindings.Get(key, &bindings); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		if bindings.Entries == nil {
+			bindings.Entries = make(map[string]*auth.RoleNames)
+		}
+		if len(req.Roles) > 0 {
+			bindings.Entries[principal] = &auth.RoleNames{Names: req.Roles}
+		} else {
+			delete(bindings.Entries, principal)
+		}
+		if len(bindings.Entries) == 0 {
+			if err := roleBindings.Delete(key); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			return nil
+		}
+		return roleBindings.Put(key, &bindings)
+	}); err != nil {
+		return nil, err
+	}
+	return &auth.ModifyRoleBindingResponse{}, nil
+}
+
+// GetRoleBindings implements the protobuf auth.GetRoleBindings RPC
+func (a *apiServer) GetRoleBindings(ctx context.Context, req *auth.GetRoleBindingsRequest) (resp *auth.GetRoleBindingsResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if a.activationState() == none {
+		return nil, auth.ErrNotActivated
+	}
+	// As with GetACL, no access is required to read a repo's role bindings
+	if _, err := a.getAuthenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+	key := clusterRoleBindingsKey
+	if req.Repo != "" {
+		key = req.Repo
+	}
+	bindings := &auth.RoleBindings{}
+	if err := a.roleBindings.ReadOnly(ctx).Get(key, bindings); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	return &auth.GetRoleBindingsResponse{Bindings: bindings}, nil
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestRequiredPermissions(t *testing.T) {
+	// Explicit permissions take precedence over the scope
+	require.Equal(t, []auth.Permission{auth.Permission_PIPELINE_LOGS}, requiredPermissions(&auth.AuthorizeRequest{
+		Scope:       auth.Scope_OWNER,
+		Permissions: []auth.Permission{auth.Permission_PIPELINE_LOGS},
+	}))
+	require.Equal(t, []auth.Permission{auth.Permission_REPO_READ},
+		requiredPermissions(&auth.AuthorizeRequest{Scope: auth.Scope_READER}))
+
+	// OWNER requires every permission of the built-in OWNER role
+	owner := requiredPermissions(&auth.AuthorizeRequest{Scope: auth.Scope_OWNER})
+	require.ElementsEqual(t, builtinRoles[auth.Scope_OWNER].Permissions, owner)
+	perms := make(permissionSet)
+	perms.add(auth.Permission_REPO_DELETE)
+	require.False(t, perms.hasAll(owner))
+	perms.add(builtinRoles[auth.Scope_WRITER].Permissions...)
+	require.False(t, perms.hasAll(owner))
+	perms.add(auth.Permission_PIPELINE_DELETE, auth.Permission_AUTH_MODIFY_ACL)
+	require.True(t, perms.hasAll(owner))
+}
+
+func TestValidateRole(t *testing.T) {
+	require.YesError(t, validateRole(nil))
+	require.YesError(t, validateRole(&auth.Role{Name: "repoOwner", Permissions: []auth.Permission{auth.Permission_REPO_READ}}))
+	require.YesError(t, validateRole(&auth.Role{Name: "deleter"}))
+	require.YesError(t, validateRole(&auth.Role{Name: "deleter", Permissions: []auth.Permission{auth.Permission_PERMISSION_UNKNOWN}}))
+	require.NoError(t, validateRole(&auth.Role{Name: "deleter", Permissions: []auth.Permission{auth.Permission_REPO_DELETE}}))
+}
@@ -71,7 +71,7 @@ func (a *apiServer) handleSAMLResponseInternal(cfg *canonicalConfig, sp *saml.Se
 	if cfg.SAMLSvc.SessionDuration != 0 {
 		expiration = time.Now().Add(cfg.SAMLSvc.SessionDuration)
 	}
-	authCode, err := a.getOneTimePassword(req.Context(), subject,
+	authCode, err := a.getOneTimePassword(req.Context(), subject, samlIDP.Name,
 		defaultOTPTTLSecs, expiration)
 	if err != nil {
 		return "", "", errutil.NewHTTPError(http.StatusInternalServerError, err.Error())
@@ -0,0 +1,1026 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+	logrus "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+// pachd's SCIM 2.0 server (RFC 7643 and RFC 7644) lets ID providers push user
+// and group changes to pachd, rather than pachd learning about them when
+// users log in. It serves:
+//
+//   /scim/<ID provider>/v2/Users[/<id>]
+//   /scim/<ID provider>/v2/Groups[/<id>]
+//   /scim/<ID provider>/v2/ServiceProviderConfig
+//
+// where <ID provider> is the name of a configured ID provider, which
+// determines the prefix of the provisioned users' subjects. Requests must
+// carry a cluster admin's Pachyderm token as a bearer token.
+
+const (
+	scimUserSchema      = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema     = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListSchema      = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema     = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimSPConfigSchema  = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimContentType     = "application/scim+json"
+	scimMaxRequestBytes = 1024 * 1024
+	scimMaxResults      = 200
+)
+
+// scimError is an error returned to a SCIM client, with an HTTP status and
+// (optionally) a SCIM error type
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+func scimErrorf(status int, scimType, format string, args ...interface{}) error {
+	return &scimError{status: status, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func scimNotFound(resource, id string) error {
+	return scimErrorf(http.StatusNotFound, "", "%s %q not found", resource, id)
+}
+
+// scimMeta, scimUser, scimGroup and scimMember are the JSON representations
+// of SCIM resources. Attributes that pachd doesn't store (e.g. a user's
+// emails) are ignored.
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Created      string `json:"created,omitempty"`
+	LastModified string `json:"lastModified,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string  `json:"schemas"`
+	ID          string    `json:"id,omitempty"`
+	ExternalID  string    `json:"externalId,omitempty"`
+	UserName    string    `json:"userName"`
+	DisplayName string    `json:"displayName,omitempty"`
+	Active      *bool     `json:"active,omitempty"`
+	Meta        *scimMeta `json:"meta,omitempty"`
+}
+
+type scimMember struct {
+	Value string `json:"value"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type scimPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimPatch struct {
+	Schemas    []string      `json:"schemas"`
+	Operations []scimPatchOp `json:"Operations"`
+}
+
+func formatSCIMTime(t *types.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	tt, err := types.TimestampFromProto(t)
+	if err != nil {
+		return ""
+	}
+	return tt.UTC().Format(time.RFC3339)
+}
+
+func toSCIMUser(u *auth.SCIMUser) *scimUser {
+	active := u.Active
+	return &scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          u.ID,
+		ExternalID:  u.ExternalID,
+		UserName:    u.UserName,
+		DisplayName: u.DisplayName,
+		Active:      &active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      formatSCIMTime(u.Created),
+			LastModified: formatSCIMTime(u.LastModified),
+		},
+	}
+}
+
+func toSCIMGroup(g *auth.SCIMGroup) *scimGroup {
+	members := make([]scimMember, len(g.Members))
+	for i, m := range g.Members {
+		members[i] = scimMember{Value: m}
+	}
+	return &scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          g.ID,
+		ExternalID:  g.ExternalID,
+		DisplayName: g.DisplayName,
+		Members:     members,
+		Meta: &scimMeta{
+			ResourceType: "Group",
+			Created:      formatSCIMTime(g.Created),
+			LastModified: formatSCIMTime(g.LastModified),
+		},
+	}
+}
+
+// scimSubject returns the Pachyderm subject of the SCIM user 'u'
+func scimSubject(u *auth.SCIMUser) string {
+	return u.IDProvider + ":" + u.UserName
+}
+
+// scimGroupName returns the Pachyderm group name of the SCIM group 'g'
+func scimGroupName(g *auth.SCIMGroup) string {
+	return fmt.Sprintf("group/%s:%s", g.IDProvider, g.DisplayName)
+}
+
+var scimFilterRegex = regexp.MustCompile(`^\s*([A-Za-z.]+)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// parseSCIMFilter parses a SCIM filter, of which pachd only supports
+// equality comparisons of a single attribute (e.g. 'userName eq "alice"'),
+// which is what ID providers send. 'attributes' are the filterable
+// attributes, and the (canonically-cased) attribute is returned.
+func parseSCIMFilter(filter string, attributes ...string) (attribute, value string, retErr error) {
+	m := scimFilterRegex.FindStringSubmatch(filter)
+	if m == nil {
+		return "", "", scimErrorf(http.StatusBadRequest, "invalidFilter",
+			"unsupported filter %q (only '<attribute> eq \"<value>\"' is supported)", filter)
+	}
+	for _, a := range attributes {
+		if strings.EqualFold(a, m[1]) {
+			attribute = a
+		}
+	}
+	if attribute == "" {
+		return "", "", scimErrorf(http.StatusBadRequest, "invalidFilter",
+			"cannot filter on attribute %q (must be one of %s)", m[1], strings.Join(attributes, ", "))
+	}
+	if err := json.Unmarshal([]byte(m[2]), &value); err != nil {
+		return "", "", scimErrorf(http.StatusBadRequest, "invalidFilter", "invalid filter value %s", m[2])
+	}
+	return attribute, value, nil
+}
+
+// parseSCIMString and parseSCIMBool parse attribute values in PATCH
+// requests. Some ID providers send booleans as strings (e.g. "False").
+func parseSCIMString(attribute string, value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", scimErrorf(http.StatusBadRequest, "invalidValue", "%s must be a string", attribute)
+	}
+	return s, nil
+}
+
+func parseSCIMBool(attribute string, value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		if b, err := strconv.ParseBool(strings.ToLower(s)); err == nil {
+			return b, nil
+		}
+	}
+	return false, scimErrorf(http.StatusBadRequest, "invalidValue", "%s must be a boolean", attribute)
+}
+
+// setSCIMUserAttribute sets the attribute 'attribute' of 'u' to 'value'.
+// Attributes that pachd doesn't store are ignored.
+func setSCIMUserAttribute(u *auth.SCIMUser, attribute string, value json.RawMessage) (retErr error) {
+	switch strings.ToLower(attribute) {
+	case "username":
+		u.UserName, retErr = parseSCIMString(attribute, value)
+		if retErr == nil && u.UserName == "" {
+			return scimErrorf(http.StatusBadRequest, "invalidValue", "userName must not be empty")
+		}
+	case "externalid":
+		u.ExternalID, retErr = parseSCIMString(attribute, value)
+	case "displayname":
+		u.DisplayName, retErr = parseSCIMString(attribute, value)
+	case "active":
+		u.Active, retErr = parseSCIMBool(attribute, value)
+	}
+	return retErr
+}
+
+// applySCIMUserPatch applies the PATCH operations 'ops' to 'u'
+func applySCIMUserPatch(u *auth.SCIMUser, ops []scimPatchOp) error {
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path != "" {
+				if err := setSCIMUserAttribute(u, op.Path, op.Value); err != nil {
+					return err
+				}
+				continue
+			}
+			var attributes map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &attributes); err != nil {
+				return scimErrorf(http.StatusBadRequest, "invalidValue", "value of %q operation without a path must be an object", op.Op)
+			}
+			for attribute, value := range attributes {
+				if err := setSCIMUserAttribute(u, attribute, value); err != nil {
+					return err
+				}
+			}
+		case "remove":
+			switch strings.ToLower(op.Path) {
+			case "externalid":
+				u.ExternalID = ""
+			case "displayname":
+				u.DisplayName = ""
+			case "":
+				return scimErrorf(http.StatusBadRequest, "noTarget", "remove operations must have a path")
+			}
+		default:
+			return scimErrorf(http.StatusBadRequest, "invalidSyntax", "unrecognized operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+// parseSCIMMembers parses the value of a group's "members" attribute
+func parseSCIMMembers(value json.RawMessage) ([]string, error) {
+	var members []scimMember
+	if err := json.Unmarshal(value, &members); err != nil {
+		return nil, scimErrorf(http.StatusBadRequest, "invalidValue", "members must be a list of objects with a \"value\"")
+	}
+	result := make([]string, 0, len(members))
+	for _, m := range members {
+		result = append(result, m.Value)
+	}
+	return result, nil
+}
+
+var scimMemberPathRegex = regexp.MustCompile(`^(?i:members)\[\s*(?i:value)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*\]$`)
+
+// applySCIMGroupPatch applies the PATCH operations 'ops' to 'g'
+func applySCIMGroupPatch(g *auth.SCIMGroup, ops []scimPatchOp) error {
+	setAttribute := func(attribute string, value json.RawMessage, add bool) (retErr error) {
+		switch strings.ToLower(attribute) {
+		case "displayname":
+			g.DisplayName, retErr = parseSCIMString(attribute, value)
+			if retErr == nil && g.DisplayName == "" {
+				return scimErrorf(http.StatusBadRequest, "invalidValue", "displayName must not be empty")
+			}
+		case "externalid":
+			g.ExternalID, retErr = parseSCIMString(attribute, value)
+		case "members":
+			members, err := parseSCIMMembers(value)
+			if err != nil {
+				return err
+			}
+			if !add {
+				g.Members = nil
+			}
+			g.Members = mergeMembers(g.Members, members, nil)
+		}
+		return retErr
+	}
+	for _, op := range ops {
+		switch opName := strings.ToLower(op.Op); opName {
+		case "add", "replace":
+			if op.Path != "" {
+				if err := setAttribute(op.Path, op.Value, opName == "add"); err != nil {
+					return err
+				}
+				continue
+			}
+			var attributes map[string]json.RawMessage
+			if err := json.Unmarshal(op.Value, &attributes); err != nil {
+				return scimErrorf(http.StatusBadRequest, "invalidValue", "value of %q operation without a path must be an object", op.Op)
+			}
+			for attribute, value := range attributes {
+				if err := setAttribute(attribute, value, opName == "add"); err != nil {
+					return err
+				}
+			}
+		case "remove":
+			if m := scimMemberPathRegex.FindStringSubmatch(op.Path); m != nil {
+				var member string
+				if err := json.Unmarshal([]byte(m[1]), &member); err != nil {
+					return scimErrorf(http.StatusBadRequest, "invalidPath", "invalid path %q", op.Path)
+				}
+				g.Members = mergeMembers(g.Members, nil, []string{member})
+				continue
+			}
+			switch strings.ToLower(op.Path) {
+			case "members":
+				// Remove the members in 'value', or all members if it's unset
+				if len(op.Value) == 0 || string(op.Value) == "null" {
+					g.Members = nil
+					continue
+				}
+				members, err := parseSCIMMembers(op.Value)
+				if err != nil {
+					return err
+				}
+				g.Members = mergeMembers(g.Members, nil, members)
+			case "externalid":
+				g.ExternalID = ""
+			case "":
+				return scimErrorf(http.StatusBadRequest, "noTarget", "remove operations must have a path")
+			}
+		default:
+			return scimErrorf(http.StatusBadRequest, "invalidSyntax", "unrecognized operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+// mergeMembers returns the sorted, de-duplicated union of 'members' and
+// 'add', minus 'remove'
+func mergeMembers(members, add, remove []string) []string {
+	set := removeFromSet(addToSet(addToSet(nil, members...), add...), remove...)
+	result := make([]string, 0, len(set))
+	for m := range set {
+		result = append(result, m)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (a *apiServer) serveSCIM() error {
+	scimMux := http.NewServeMux()
+	scimMux.HandleFunc("/scim/", a.handleSCIM)
+	return http.ListenAndServe(fmt.Sprintf(":%d", a.env.ScimPort), scimMux)
+}
+
+func (a *apiServer) handleSCIM(w http.ResponseWriter, req *http.Request) {
+	status, resp, err := a.handleSCIMInternal(req)
+	if err != nil {
+		scimErr, ok := err.(*scimError)
+		if !ok {
+			logrus.Errorf("auth.SCIM %s %s: %v", req.Method, req.URL.Path, err)
+			scimErr = &scimError{status: http.StatusInternalServerError, detail: "internal error (pachd logs may contain more information)"}
+		}
+		status = scimErr.status
+		resp = map[string]interface{}{
+			"schemas":  []string{scimErrorSchema},
+			"status":   strconv.Itoa(scimErr.status),
+			"scimType": scimErr.scimType,
+			"detail":   scimErr.detail,
+		}
+	}
+	logrus.Infof("auth.SCIM %s %s: %d", req.Method, req.URL.Path, status)
+	if resp == nil {
+		w.WriteHeader(status)
+		return
+	}
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logrus.Errorf("could not write SCIM response: %v", err)
+	}
+}
+
+// authenticateSCIM returns a context carrying the bearer token in 'req', and
+// checks that it belongs to a cluster admin
+func (a *apiServer) authenticateSCIM(req *http.Request) (context.Context, error) {
+	token := strings.TrimSpace(req.Header.Get("Authorization"))
+	if len(token) < len("Bearer ") || !strings.EqualFold(token[:len("Bearer ")], "Bearer ") {
+		return nil, scimErrorf(http.StatusUnauthorized, "", "requests must include a Pachyderm token as a bearer token")
+	}
+	ctx := metadata.NewIncomingContext(req.Context(),
+		metadata.Pairs(auth.ContextTokenKey, strings.TrimSpace(token[len("Bearer "):])))
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, scimErrorf(http.StatusUnauthorized, "", "invalid Pachyderm token")
+	}
+	isAdmin, err := a.hasClusterRole(ctx, callerInfo.Subject, auth.ClusterRole_SUPER)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, scimErrorf(http.StatusForbidden, "", "%q is not a cluster admin", callerInfo.Subject)
+	}
+	return ctx, nil
+}
+
+// handleSCIMInternal routes a SCIM request, and returns the HTTP status and
+// body of the response
+func (a *apiServer) handleSCIMInternal(req *http.Request) (int, interface{}, error) {
+	if a.activationState() != full {
+		return 0, nil, scimErrorf(http.StatusServiceUnavailable, "", "auth is not active")
+	}
+	// Parse /scim/<ID provider>/v2/<resource>[/<id>]
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(req.URL.Path, "/scim/"), "/"), "/")
+	if len(parts) < 3 || len(parts) > 4 || parts[1] != "v2" {
+		return 0, nil, scimErrorf(http.StatusNotFound, "", "unrecognized path %q", req.URL.Path)
+	}
+	idp, resource, id := parts[0], parts[2], ""
+	if len(parts) == 4 {
+		id = parts[3]
+	}
+	var configured bool
+	for _, c := range a.getCacheConfig().IDPs {
+		configured = configured || c.Name == idp
+	}
+	if !configured {
+		return 0, nil, scimErrorf(http.StatusNotFound, "", "no ID provider named %q is configured", idp)
+	}
+	ctx, err := a.authenticateSCIM(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	body := io.LimitReader(req.Body, scimMaxRequestBytes)
+
+	switch {
+	case resource == "ServiceProviderConfig" && id == "" && req.Method == http.MethodGet:
+		return http.StatusOK, scimServiceProviderConfig(), nil
+
+	case resource == "Users" && id == "" && req.Method == http.MethodGet:
+		return a.listSCIMUsersHandler(ctx, idp, req)
+	case resource == "Users" && id == "" && req.Method == http.MethodPost:
+		return a.createSCIMUser(ctx, idp, body)
+	case resource == "Users" && id != "" && req.Method == http.MethodGet:
+		u, err := a.getSCIMUser(ctx, idp, id)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toSCIMUser(u), nil
+	case resource == "Users" && id != "" && (req.Method == http.MethodPut || req.Method == http.MethodPatch):
+		return a.modifySCIMUser(ctx, idp, id, req.Method, body)
+	case resource == "Users" && id != "" && req.Method == http.MethodDelete:
+		return a.deleteSCIMUser(ctx, idp, id)
+
+	case resource == "Groups" && id == "" && req.Method == http.MethodGet:
+		return a.listSCIMGroupsHandler(ctx, idp, req)
+	case resource == "Groups" && id == "" && req.Method == http.MethodPost:
+		return a.createSCIMGroup(ctx, idp, body)
+	case resource == "Groups" && id != "" && req.Method == http.MethodGet:
+		g, err := a.getSCIMGroup(ctx, idp, id)
+		if err != nil {
+			return 0, nil, err
+		}
+		return http.StatusOK, toSCIMGroup(g), nil
+	case resource == "Groups" && id != "" && (req.Method == http.MethodPut || req.Method == http.MethodPatch):
+		return a.modifySCIMGroup(ctx, idp, id, req.Method, body)
+	case resource == "Groups" && id != "" && req.Method == http.MethodDelete:
+		return a.deleteSCIMGroup(ctx, idp, id)
+	}
+	return 0, nil, scimErrorf(http.StatusNotFound, "", "unsupported request %s %s", req.Method, req.URL.Path)
+}
+
+func scimServiceProviderConfig() interface{} {
+	supported := func(s bool) map[string]interface{} { return map[string]interface{}{"supported": s} }
+	return map[string]interface{}{
+		"schemas":        []string{scimSPConfigSchema},
+		"patch":          supported(true),
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": scimMaxResults},
+		"changePassword": supported(false),
+		"sort":           supported(false),
+		"etag":           supported(false),
+		"authenticationSchemes": []interface{}{map[string]interface{}{
+			"type":        "oauthbearertoken",
+			"name":        "Pachyderm token",
+			"description": "A cluster admin's Pachyderm token, sent as a bearer token",
+		}},
+	}
+}
+
+func decodeSCIMRequest(body io.Reader, v interface{}) error {
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return scimErrorf(http.StatusBadRequest, "invalidSyntax", "could not parse request: %v", err)
+	}
+	return nil
+}
+
+// listSCIMPage returns the page of 'resources' requested by 'req' (with the
+// 1-based "startIndex" and "count" query parameters)
+func listSCIMPage(req *http.Request, resources []interface{}) *scimListResponse {
+	startIndex, count := 1, scimMaxResults
+	if s, err := strconv.Atoi(req.URL.Query().Get("startIndex")); err == nil && s > 1 {
+		startIndex = s
+	}
+	if c, err := strconv.Atoi(req.URL.Query().Get("count")); err == nil && c >= 0 && c < count {
+		count = c
+	}
+	resp := &scimListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		Resources:    []interface{}{},
+	}
+	for i := startIndex - 1; i < len(resources) && len(resp.Resources) < count; i++ {
+		resp.Resources = append(resp.Resources, resources[i])
+	}
+	resp.ItemsPerPage = len(resp.Resources)
+	return resp
+}
+
+// listSCIMUsers returns the SCIM users provisioned by 'idp', sorted by
+// userName
+func (a *apiServer) listSCIMUsers(ctx context.Context, idp string) ([]*auth.SCIMUser, error) {
+	var users []*auth.SCIMUser
+	var u auth.SCIMUser
+	if err := a.scimUsers.ReadOnly(ctx).List(&u, col.DefaultOptions, func(string) error {
+		if u.IDProvider == idp {
+			users = append(users, proto.Clone(&u).(*auth.SCIMUser))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].UserName < users[j].UserName })
+	return users, nil
+}
+
+// listSCIMGroups returns the SCIM groups provisioned by 'idp', sorted by
+// displayName
+func (a *apiServer) listSCIMGroups(ctx context.Context, idp string) ([]*auth.SCIMGroup, error) {
+	var groups []*auth.SCIMGroup
+	var g auth.SCIMGroup
+	if err := a.scimGroups.ReadOnly(ctx).List(&g, col.DefaultOptions, func(string) error {
+		if g.IDProvider == idp {
+			groups = append(groups, proto.Clone(&g).(*auth.SCIMGroup))
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].DisplayName < groups[j].DisplayName })
+	return groups, nil
+}
+
+func (a *apiServer) getSCIMUser(ctx context.Context, idp, id string) (*auth.SCIMUser, error) {
+	var u auth.SCIMUser
+	if err := a.scimUsers.ReadOnly(ctx).Get(id, &u); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, scimNotFound("user", id)
+		}
+		return nil, err
+	}
+	if u.IDProvider != idp {
+		return nil, scimNotFound("user", id)
+	}
+	return &u, nil
+}
+
+func (a *apiServer) getSCIMGroup(ctx context.Context, idp, id string) (*auth.SCIMGroup, error) {
+	var g auth.SCIMGroup
+	if err := a.scimGroups.ReadOnly(ctx).Get(id, &g); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, scimNotFound("group", id)
+		}
+		return nil, err
+	}
+	if g.IDProvider != idp {
+		return nil, scimNotFound("group", id)
+	}
+	return &g, nil
+}
+
+// checkSCIMUserName returns an error if a user other than 'id' provisioned by
+// 'idp' has the userName 'userName'
+func (a *apiServer) checkSCIMUserName(ctx context.Context, idp, id, userName string) error {
+	users, err := a.listSCIMUsers(ctx, idp)
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		if u.ID != id && strings.EqualFold(u.UserName, userName) {
+			return scimErrorf(http.StatusConflict, "uniqueness", "a user with userName %q already exists", userName)
+		}
+	}
+	return nil
+}
+
+// checkSCIMGroup returns an error if a group other than 'g' provisioned by
+// the same ID provider has the same displayName, or if any of the members of
+// 'g' don't exist
+func (a *apiServer) checkSCIMGroup(ctx context.Context, g *auth.SCIMGroup) error {
+	groups, err := a.listSCIMGroups(ctx, g.IDProvider)
+	if err != nil {
+		return err
+	}
+	for _, other := range groups {
+		if other.ID != g.ID && strings.EqualFold(other.DisplayName, g.DisplayName) {
+			return scimErrorf(http.StatusConflict, "uniqueness", "a group with displayName %q already exists", g.DisplayName)
+		}
+	}
+	for _, m := range g.Members {
+		if _, err := a.getSCIMUser(ctx, g.IDProvider, m); err != nil {
+			if _, ok := err.(*scimError); ok {
+				return scimErrorf(http.StatusBadRequest, "invalidValue", "member %q is not a user", m)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *apiServer) listSCIMUsersHandler(ctx context.Context, idp string, req *http.Request) (int, interface{}, error) {
+	users, err := a.listSCIMUsers(ctx, idp)
+	if err != nil {
+		return 0, nil, err
+	}
+	var attribute, value string
+	if filter := req.URL.Query().Get("filter"); filter != "" {
+		if attribute, value, err = parseSCIMFilter(filter, "userName", "externalId", "id"); err != nil {
+			return 0, nil, err
+		}
+	}
+	var resources []interface{}
+	for _, u := range users {
+		switch {
+		case attribute == "userName" && !strings.EqualFold(u.UserName, value),
+			attribute == "externalId" && u.ExternalID != value,
+			attribute == "id" && u.ID != value:
+			continue
+		}
+		resources = append(resources, toSCIMUser(u))
+	}
+	return http.StatusOK, listSCIMPage(req, resources), nil
+}
+
+func (a *apiServer) listSCIMGroupsHandler(ctx context.Context, idp string, req *http.Request) (int, interface{}, error) {
+	groups, err := a.listSCIMGroups(ctx, idp)
+	if err != nil {
+		return 0, nil, err
+	}
+	var attribute, value string
+	if filter := req.URL.Query().Get("filter"); filter != "" {
+		if attribute, value, err = parseSCIMFilter(filter, "displayName", "externalId", "id"); err != nil {
+			return 0, nil, err
+		}
+	}
+	var resources []interface{}
+	for _, g := range groups {
+		switch {
+		case attribute == "displayName" && !strings.EqualFold(g.DisplayName, value),
+			attribute == "externalId" && g.ExternalID != value,
+			attribute == "id" && g.ID != value:
+			continue
+		}
+		resources = append(resources, toSCIMGroup(g))
+	}
+	return http.StatusOK, listSCIMPage(req, resources), nil
+}
+
+func (a *apiServer) createSCIMUser(ctx context.Context, idp string, body io.Reader) (int, interface{}, error) {
+	var req scimUser
+	if err := decodeSCIMRequest(body, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.UserName == "" {
+		return 0, nil, scimErrorf(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+	if err := a.checkSCIMUserName(ctx, idp, "", req.UserName); err != nil {
+		return 0, nil, err
+	}
+	now := types.TimestampNow()
+	u := &auth.SCIMUser{
+		ID:           uuid.NewWithoutDashes(),
+		IDProvider:   idp,
+		UserName:     req.UserName,
+		ExternalID:   req.ExternalID,
+		DisplayName:  req.DisplayName,
+		Active:       req.Active == nil || *req.Active,
+		Created:      now,
+		LastModified: now,
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		// A user who was deleted may be provisioned again
+		if err := a.scimDeprovisioned.ReadWrite(stm).Delete(deprovisionedKey(scimSubject(u))); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return a.scimUsers.ReadWrite(stm).Create(u.ID, u)
+	}); err != nil {
+		return 0, nil, err
+	}
+	if err := a.syncSCIMUsers(ctx, idp, u.ID); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, toSCIMUser(u), nil
+}
+
+func (a *apiServer) modifySCIMUser(ctx context.Context, idp, id, method string, body io.Reader) (int, interface{}, error) {
+	prev, err := a.getSCIMUser(ctx, idp, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	u := proto.Clone(prev).(*auth.SCIMUser)
+	if method == http.MethodPut {
+		var req scimUser
+		if err := decodeSCIMRequest(body, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.UserName == "" {
+			return 0, nil, scimErrorf(http.StatusBadRequest, "invalidValue", "userName is required")
+		}
+		u.UserName, u.ExternalID, u.DisplayName = req.UserName, req.ExternalID, req.DisplayName
+		u.Active = req.Active == nil || *req.Active
+	} else {
+		var req scimPatch
+		if err := decodeSCIMRequest(body, &req); err != nil {
+			return 0, nil, err
+		}
+		if err := applySCIMUserPatch(u, req.Operations); err != nil {
+			return 0, nil, err
+		}
+	}
+	if u.UserName != prev.UserName {
+		if err := a.checkSCIMUserName(ctx, idp, id, u.UserName); err != nil {
+			return 0, nil, err
+		}
+	}
+	u.LastModified = types.TimestampNow()
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		deprovisioned := a.scimDeprovisioned.ReadWrite(stm)
+		if scimSubject(u) != scimSubject(prev) {
+			if err := putDeprovisioned(deprovisioned, prev); err != nil {
+				return err
+			}
+			if err := deprovisioned.Delete(deprovisionedKey(scimSubject(u))); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		return a.scimUsers.ReadWrite(stm).Put(id, u)
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	// If the user was renamed, their previous subject is deprovisioned
+	if scimSubject(u) != scimSubject(prev) {
+		if err := a.deprovisionSubject(ctx, scimSubject(prev)); err != nil {
+			return 0, nil, err
+		}
+	}
+	if err := a.syncSCIMUsers(ctx, idp, id); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toSCIMUser(u), nil
+}
+
+func (a *apiServer) deleteSCIMUser(ctx context.Context, idp, id string) (int, interface{}, error) {
+	u, err := a.getSCIMUser(ctx, idp, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	groups, err := a.listSCIMGroups(ctx, idp)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		scimGroups := a.scimGroups.ReadWrite(stm)
+		for _, g := range groups {
+			if i := sort.SearchStrings(g.Members, id); i == len(g.Members) || g.Members[i] != id {
+				continue
+			}
+			var group auth.SCIMGroup
+			if err := scimGroups.Update(g.ID, &group, func() error {
+				group.Members = mergeMembers(group.Members, nil, []string{id})
+				return nil
+			}); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+		if err := putDeprovisioned(a.scimDeprovisioned.ReadWrite(stm), u); err != nil {
+			return err
+		}
+		return a.scimUsers.ReadWrite(stm).Delete(id)
+	}); err != nil {
+		return 0, nil, err
+	}
+	if err := a.deprovisionSubject(ctx, scimSubject(u)); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+func (a *apiServer) createSCIMGroup(ctx context.Context, idp string, body io.Reader) (int, interface{}, error) {
+	var req scimGroup
+	if err := decodeSCIMRequest(body, &req); err != nil {
+		return 0, nil, err
+	}
+	if req.DisplayName == "" {
+		return 0, nil, scimErrorf(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	now := types.TimestampNow()
+	g := &auth.SCIMGroup{
+		ID:           uuid.NewWithoutDashes(),
+		IDProvider:   idp,
+		DisplayName:  req.DisplayName,
+		ExternalID:   req.ExternalID,
+		Created:      now,
+		LastModified: now,
+	}
+	for _, m := range req.Members {
+		g.Members = append(g.Members, m.Value)
+	}
+	g.Members = mergeMembers(g.Members, nil, nil)
+	if err := a.checkSCIMGroup(ctx, g); err != nil {
+		return 0, nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.scimGroups.ReadWrite(stm).Create(g.ID, g)
+	}); err != nil {
+		return 0, nil, err
+	}
+	if err := a.syncSCIMUsers(ctx, idp, g.Members...); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusCreated, toSCIMGroup(g), nil
+}
+
+func (a *apiServer) modifySCIMGroup(ctx context.Context, idp, id, method string, body io.Reader) (int, interface{}, error) {
+	prev, err := a.getSCIMGroup(ctx, idp, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	g := proto.Clone(prev).(*auth.SCIMGroup)
+	if method == http.MethodPut {
+		var req scimGroup
+		if err := decodeSCIMRequest(body, &req); err != nil {
+			return 0, nil, err
+		}
+		if req.DisplayName == "" {
+			return 0, nil, scimErrorf(http.StatusBadRequest, "invalidValue", "displayName is required")
+		}
+		g.DisplayName, g.ExternalID, g.Members = req.DisplayName, req.ExternalID, nil
+		for _, m := range req.Members {
+			g.Members = append(g.Members, m.Value)
+		}
+		g.Members = mergeMembers(g.Members, nil, nil)
+	} else {
+		var req scimPatch
+		if err := decodeSCIMRequest(body, &req); err != nil {
+			return 0, nil, err
+		}
+		if err := applySCIMGroupPatch(g, req.Operations); err != nil {
+			return 0, nil, err
+		}
+	}
+	if err := a.checkSCIMGroup(ctx, g); err != nil {
+		return 0, nil, err
+	}
+	g.LastModified = types.TimestampNow()
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.scimGroups.ReadWrite(stm).Put(id, g)
+	}); err != nil {
+		return 0, nil, err
+	}
+
+	// Sync the groups of the group's previous and current members (all of
+	// them if the group was renamed)
+	affected := mergeMembers(prev.Members, g.Members, nil)
+	if g.DisplayName == prev.DisplayName {
+		affected = mergeMembers(
+			mergeMembers(prev.Members, nil, g.Members),
+			mergeMembers(g.Members, nil, prev.Members), nil)
+	}
+	if err := a.syncSCIMUsers(ctx, idp, affected...); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusOK, toSCIMGroup(g), nil
+}
+
+func (a *apiServer) deleteSCIMGroup(ctx context.Context, idp, id string) (int, interface{}, error) {
+	g, err := a.getSCIMGroup(ctx, idp, id)
+	if err != nil {
+		return 0, nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		return a.scimGroups.ReadWrite(stm).Delete(id)
+	}); err != nil {
+		return 0, nil, err
+	}
+	if err := a.syncSCIMUsers(ctx, idp, g.Members...); err != nil {
+		return 0, nil, err
+	}
+	return http.StatusNoContent, nil, nil
+}
+
+// syncSCIMUsers sets the Pachyderm groups of the SCIM users 'ids' to the SCIM
+// groups that they belong to. Users that are inactive are deprovisioned.
+func (a *apiServer) syncSCIMUsers(ctx context.Context, idp string, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	groups, err := a.listSCIMGroups(ctx, idp)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		u, err := a.getSCIMUser(ctx, idp, id)
+		if err != nil {
+			if _, ok := err.(*scimError); ok {
+				continue // user was deleted
+			}
+			return err
+		}
+		if !u.Active {
+			if err := a.deprovisionSubject(ctx, scimSubject(u)); err != nil {
+				return err
+			}
+			continue
+		}
+		var names []string
+		for _, g := range groups {
+			i := sort.SearchStrings(g.Members, id)
+			if i < len(g.Members) && g.Members[i] == id {
+				names = append(names, scimGroupName(g))
+			}
+		}
+		if err := a.setGroupsForUserInternal(ctx, scimSubject(u), names); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deprovisionSubject removes 'subject' from all groups and revokes all of
+// their tokens
+func (a *apiServer) deprovisionSubject(ctx context.Context, subject string) error {
+	if err := a.setGroupsForUserInternal(ctx, subject, nil); err != nil {
+		return err
+	}
+	n, err := a.revokeTokensForSubject(ctx, subject)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("auth.SCIM: deprovisioned %q and revoked %d token(s)", subject, n)
+	return nil
+}
+
+// deprovisionedKey returns the key of 'subject' in the scimDeprovisioned
+// collection. User names are compared case-insensitively.
+func deprovisionedKey(subject string) string {
+	return strings.ToLower(subject)
+}
+
+// putDeprovisioned records that the SCIM user 'u' was deleted (or renamed),
+// so that their subject can't log in
+func putDeprovisioned(deprovisioned col.ReadWriteCollection, u *auth.SCIMUser) error {
+	u = proto.Clone(u).(*auth.SCIMUser)
+	u.Active = false
+	u.LastModified = types.TimestampNow()
+	return deprovisioned.Put(deprovisionedKey(scimSubject(u)), u)
+}
+
+// checkNotDeprovisioned returns an error if 'subject' is an inactive SCIM
+// user, or a SCIM user who was deleted or renamed, who may not log in
+func (a *apiServer) checkNotDeprovisioned(ctx context.Context, subject string) error {
+	colonIdx := strings.Index(subject, ":")
+	if colonIdx < 0 {
+		return nil
+	}
+	var u auth.SCIMUser
+	if err := a.scimDeprovisioned.ReadOnly(ctx).Get(deprovisionedKey(subject), &u); err == nil {
+		return errors.Errorf("%q has been deprovisioned by their ID provider", subject)
+	} else if !col.IsErrNotFound(err) {
+		return err
+	}
+	users, err := a.listSCIMUsers(ctx, subject[:colonIdx])
+	if err != nil {
+		return err
+	}
+	for _, u := range users {
+		if !u.Active && strings.EqualFold(u.UserName, subject[colonIdx+1:]) {
+			return errors.Errorf("%q has been deprovisioned by their ID provider", subject)
+		}
+	}
+	return nil
+}
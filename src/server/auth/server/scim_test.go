@@ -0,0 +1,141 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testetcd"
+
+	"golang.org/x/net/context"
+)
+
+func parsePatch(t *testing.T, ops string) []scimPatchOp {
+	var patch scimPatch
+	require.NoError(t, json.Unmarshal([]byte(`{"Operations": `+ops+`}`), &patch))
+	return patch.Operations
+}
+
+func TestParseSCIMFilter(t *testing.T) {
+	attribute, value, err := parseSCIMFilter(`username EQ "alice@example.com"`, "userName", "id")
+	require.NoError(t, err)
+	require.Equal(t, "userName", attribute)
+	require.Equal(t, "alice@example.com", value)
+
+	_, value, err = parseSCIMFilter(`displayName eq "a \"quoted\" name"`, "displayName")
+	require.NoError(t, err)
+	require.Equal(t, `a "quoted" name`, value)
+
+	for _, filter := range []string{
+		`userName sw "a"`,
+		`userName eq "a" and active eq true`,
+		`emails eq "alice@example.com"`,
+		`userName eq alice`,
+	} {
+		_, _, err := parseSCIMFilter(filter, "userName")
+		require.YesError(t, err)
+	}
+}
+
+func TestApplySCIMUserPatch(t *testing.T) {
+	u := &auth.SCIMUser{UserName: "alice", Active: true}
+
+	// Okta sends attributes in an object, and Azure AD sends a path, with
+	// booleans as strings. Unknown attributes are ignored.
+	require.NoError(t, applySCIMUserPatch(u, parsePatch(t, `[
+		{"op": "replace", "value": {"displayName": "Alice", "name": {"givenName": "Alice"}}},
+		{"op": "Replace", "path": "active", "value": "False"}
+	]`)))
+	require.Equal(t, "Alice", u.DisplayName)
+	require.False(t, u.Active)
+
+	require.NoError(t, applySCIMUserPatch(u, parsePatch(t, `[
+		{"op": "replace", "value": {"active": true, "userName": "alice2"}},
+		{"op": "remove", "path": "displayName"}
+	]`)))
+	require.True(t, u.Active)
+	require.Equal(t, "alice2", u.UserName)
+	require.Equal(t, "", u.DisplayName)
+
+	require.YesError(t, applySCIMUserPatch(u, parsePatch(t, `[{"op": "replace", "path": "userName", "value": ""}]`)))
+	require.YesError(t, applySCIMUserPatch(u, parsePatch(t, `[{"op": "replace", "path": "active", "value": "maybe"}]`)))
+	require.YesError(t, applySCIMUserPatch(u, parsePatch(t, `[{"op": "move", "path": "active"}]`)))
+}
+
+func TestApplySCIMGroupPatch(t *testing.T) {
+	g := &auth.SCIMGroup{DisplayName: "eng", Members: []string{"a"}}
+
+	require.NoError(t, applySCIMGroupPatch(g, parsePatch(t, `[
+		{"op": "add", "path": "members", "value": [{"value": "c"}, {"value": "b"}]}
+	]`)))
+	require.Equal(t, []string{"a", "b", "c"}, g.Members)
+
+	require.NoError(t, applySCIMGroupPatch(g, parsePatch(t, `[
+		{"op": "remove", "path": "members[value eq \"a\"]"},
+		{"op": "remove", "path": "members", "value": [{"value": "b"}]}
+	]`)))
+	require.Equal(t, []string{"c"}, g.Members)
+
+	require.NoError(t, applySCIMGroupPatch(g, parsePatch(t, `[
+		{"op": "replace", "value": {"displayName": "engineering", "members": [{"value": "d"}]}}
+	]`)))
+	require.Equal(t, "engineering", g.DisplayName)
+	require.Equal(t, []string{"d"}, g.Members)
+
+	require.NoError(t, applySCIMGroupPatch(g, parsePatch(t, `[{"op": "remove", "path": "members"}]`)))
+	require.Equal(t, 0, len(g.Members))
+
+	require.YesError(t, applySCIMGroupPatch(g, parsePatch(t, `[{"op": "replace", "path": "displayName", "value": ""}]`)))
+	require.YesError(t, applySCIMGroupPatch(g, parsePatch(t, `[{"op": "add", "path": "members", "value": "d"}]`)))
+}
+
+func TestListSCIMPage(t *testing.T) {
+	resources := []interface{}{1, 2, 3, 4, 5}
+	resp := listSCIMPage(httptest.NewRequest("GET", "/scim/okta/v2/Users?startIndex=2&count=2", nil), resources)
+	require.Equal(t, 5, resp.TotalResults)
+	require.Equal(t, 2, resp.StartIndex)
+	require.Equal(t, 2, resp.ItemsPerPage)
+	require.Equal(t, []interface{}{2, 3}, resp.Resources)
+
+	resp = listSCIMPage(httptest.NewRequest("GET", "/scim/okta/v2/Users?startIndex=10", nil), resources)
+	require.Equal(t, 0, resp.ItemsPerPage)
+	require.Equal(t, []interface{}{}, resp.Resources)
+}
+
+// TestCheckNotDeprovisioned tests that inactive SCIM users, and SCIM users
+// who were deleted or renamed, can't log in
+func TestCheckNotDeprovisioned(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(e *testetcd.Env) error {
+		ctx := context.Background()
+		a := &apiServer{
+			scimUsers:         col.NewCollection(e.EtcdClient, scimUsersPrefix, nil, &auth.SCIMUser{}, nil, nil),
+			scimDeprovisioned: col.NewCollection(e.EtcdClient, scimDeprovisionedPrefix, nil, &auth.SCIMUser{}, nil, nil),
+		}
+		if _, err := col.NewSTM(ctx, e.EtcdClient, func(stm col.STM) error {
+			users := a.scimUsers.ReadWrite(stm)
+			if err := users.Put("1", &auth.SCIMUser{ID: "1", IDProvider: "okta", UserName: "alice", Active: true}); err != nil {
+				return err
+			}
+			if err := users.Put("2", &auth.SCIMUser{ID: "2", IDProvider: "okta", UserName: "bob"}); err != nil {
+				return err
+			}
+			// carol has been deleted
+			return putDeprovisioned(a.scimDeprovisioned.ReadWrite(stm), &auth.SCIMUser{ID: "3", IDProvider: "okta", UserName: "Carol", Active: true})
+		}); err != nil {
+			return err
+		}
+		require.NoError(t, a.checkNotDeprovisioned(ctx, "okta:alice"))
+		require.NoError(t, a.checkNotDeprovisioned(ctx, "okta:dave"))
+		require.NoError(t, a.checkNotDeprovisioned(ctx, "github:carol"))
+		require.NoError(t, a.checkNotDeprovisioned(ctx, "robot:carol"))
+		for _, subject := range []string{"okta:bob", "okta:carol", "okta:CAROL"} {
+			err := a.checkNotDeprovisioned(ctx, subject)
+			require.YesError(t, err)
+			require.Matches(t, "deprovisioned", err.Error())
+		}
+		return nil
+	}))
+}
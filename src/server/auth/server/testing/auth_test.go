@@ -22,6 +22,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	authserver "github.com/pachyderm/pachyderm/src/server/auth/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
 	tu "github.com/pachyderm/pachyderm/src/server/pkg/testutil"
 )
 
@@ -2004,6 +2005,106 @@ func TestAuthorizedEveryone(t *testing.T) {
 	require.True(t, resp.Authorized)
 }
 
+// TestCustomRoles tests that a custom role bound on a repo grants exactly its
+// permissions, and that a role short of full control doesn't satisfy an OWNER
+// check
+func TestCustomRoles(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	defer deleteAll(t)
+
+	alice := tu.UniqueString("alice")
+	aliceClient, adminClient := getPachClient(t, alice), getPachClient(t, admin)
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, adminClient.CreateRepo(repo))
+
+	// admin creates a role that may only delete repos, and binds it to alice
+	role := tu.UniqueString("deleter")
+	_, err := adminClient.CreateRole(adminClient.Ctx(), &auth.CreateRoleRequest{
+		Role: &auth.Role{
+			Name:        role,
+			Permissions: []auth.Permission{auth.Permission_REPO_DELETE},
+		},
+	})
+	require.NoError(t, err)
+	_, err = adminClient.ModifyRoleBinding(adminClient.Ctx(), &auth.ModifyRoleBindingRequest{
+		Repo:      repo,
+		Principal: alice,
+		Roles:     []string{role},
+	})
+	require.NoError(t, err)
+
+	// alice has the role's permission, but not the OWNER scope
+	resp, err := aliceClient.Authorize(aliceClient.Ctx(), &auth.AuthorizeRequest{
+		Repo:        repo,
+		Permissions: []auth.Permission{auth.Permission_REPO_DELETE},
+	})
+	require.NoError(t, err)
+	require.True(t, resp.Authorized)
+	resp, err = aliceClient.Authorize(aliceClient.Ctx(), &auth.AuthorizeRequest{
+		Repo:  repo,
+		Scope: auth.Scope_OWNER,
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Authorized)
+
+	// alice can't change the repo's ACL, which OWNER would allow
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Username: alice,
+		Scope:    auth.Scope_OWNER,
+	})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// alice can delete a repo that the role is bound to
+	deletable := tu.UniqueString(t.Name())
+	require.NoError(t, adminClient.CreateRepo(deletable))
+	_, err = adminClient.ModifyRoleBinding(adminClient.Ctx(), &auth.ModifyRoleBindingRequest{
+		Repo:      deletable,
+		Principal: alice,
+		Roles:     []string{role},
+	})
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.DeleteRepo(deletable, false))
+
+	// Once the role is deleted, its binding grants nothing
+	_, err = adminClient.DeleteRole(adminClient.Ctx(), &auth.DeleteRoleRequest{Name: role})
+	require.NoError(t, err)
+	resp, err = aliceClient.Authorize(aliceClient.Ctx(), &auth.AuthorizeRequest{
+		Repo:        repo,
+		Permissions: []auth.Permission{auth.Permission_REPO_DELETE},
+	})
+	require.NoError(t, err)
+	require.False(t, resp.Authorized)
+	err = aliceClient.DeleteRepo(repo, false)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// Branch ACLs still override the repo's ACL: a reader of the repo who is a
+	// writer of a branch pattern may write to the branches it matches
+	_, err = adminClient.SetScope(adminClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Username: alice,
+		Scope:    auth.Scope_READER,
+	})
+	require.NoError(t, err)
+	_, err = adminClient.SetScope(adminClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Branch:   "staging*",
+		Username: alice,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+	_, err = aliceClient.PutFile(repo, "staging-1", "/file", strings.NewReader("1"))
+	require.NoError(t, err)
+	_, err = aliceClient.PutFile(repo, "master", "/file", strings.NewReader("1"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+}
+
 // TestDeleteAll tests that you must be a cluster admin to call DeleteAll
 func TestDeleteAll(t *testing.T) {
 	if testing.Short() {
@@ -3311,3 +3412,468 @@ func TestDeactivateFSAdmin(t *testing.T) {
 	require.YesError(t, err)
 	require.Matches(t, "not authorized", err.Error())
 }
+
+// TestRobotKeys tests creating, restricting, rotating and deleting the API keys
+// of a robot service account
+func TestRobotKeys(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	defer deleteAll(t)
+	adminClient := getPachClient(t, admin)
+	robot := tu.UniqueString("ci")
+
+	// admin creates two repos, and makes the robot a writer on both
+	repo1, repo2 := tu.UniqueString(t.Name()), tu.UniqueString(t.Name())
+	for _, repo := range []string{repo1, repo2} {
+		require.NoError(t, adminClient.CreateRepo(repo))
+		_, err := adminClient.SetScope(adminClient.Ctx(), &auth.SetScopeRequest{
+			Repo:     repo,
+			Username: auth.RobotPrefix + robot,
+			Scope:    auth.Scope_WRITER,
+		})
+		require.NoError(t, err)
+	}
+	_, err := adminClient.PutFile(repo1, "master", "/file", strings.NewReader("data"))
+	require.NoError(t, err)
+
+	// admin creates the robot and a read-only key for repo1
+	_, err = adminClient.CreateRobot(adminClient.Ctx(), &auth.CreateRobotRequest{Name: robot})
+	require.NoError(t, err)
+	keyResp, err := adminClient.CreateRobotKey(adminClient.Ctx(), &auth.CreateRobotKeyRequest{
+		Robot: robot,
+		Name:  "build",
+		Restrictions: &auth.TokenRestrictions{
+			Repos:    []string{repo1},
+			ReadOnly: true,
+		},
+	})
+	require.NoError(t, err)
+	robotClient := adminClient.WithCtx(context.Background())
+	robotClient.SetAuthToken(keyResp.Token)
+	who, err := robotClient.WhoAmI(robotClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+	require.Equal(t, auth.RobotPrefix+robot, who.Username)
+
+	// The key can read repo1, but not write to it or access repo2
+	var buf bytes.Buffer
+	require.NoError(t, robotClient.GetFile(repo1, "master", "/file", 0, 0, &buf))
+	require.Equal(t, "data", buf.String())
+	_, err = robotClient.PutFile(repo1, "master", "/file2", strings.NewReader("data"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = robotClient.ListCommit(repo2, "master", "", 0)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// The key can't create repos (and so become their owner), and its scope
+	// reflects its restrictions
+	err = robotClient.CreateRepo(tu.UniqueString(t.Name()))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	scopeResp, err := robotClient.GetScope(robotClient.Ctx(), &auth.GetScopeRequest{
+		Repos: []string{repo1, repo2},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []auth.Scope{auth.Scope_READER, auth.Scope_NONE}, scopeResp.Scopes)
+
+	// The restricted key can't be exchanged for an unrestricted token
+	_, err = robotClient.GetAuthToken(robotClient.Ctx(), &auth.GetAuthTokenRequest{})
+	require.YesError(t, err)
+
+	// Listing keys doesn't reveal them
+	listResp, err := adminClient.ListRobotKeys(adminClient.Ctx(), &auth.ListRobotKeysRequest{Robot: robot})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Keys))
+	require.Equal(t, "build", listResp.Keys[0].Name)
+	require.Equal(t, "", listResp.Keys[0].HashedToken)
+
+	// Rotating the key with an overlap leaves the old token valid
+	rotateResp, err := adminClient.RotateRobotKey(adminClient.Ctx(), &auth.RotateRobotKeyRequest{
+		Robot:   robot,
+		Name:    "build",
+		Overlap: 60,
+	})
+	require.NoError(t, err)
+	newClient := adminClient.WithCtx(context.Background())
+	newClient.SetAuthToken(rotateResp.Token)
+	for _, c := range []*client.APIClient{robotClient, newClient} {
+		_, err = c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{})
+		require.NoError(t, err)
+	}
+
+	// Rotating it again without an overlap revokes the previous tokens
+	rotateResp, err = adminClient.RotateRobotKey(adminClient.Ctx(), &auth.RotateRobotKeyRequest{
+		Robot: robot,
+		Name:  "build",
+	})
+	require.NoError(t, err)
+	for _, c := range []*client.APIClient{robotClient, newClient} {
+		_, err = c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{})
+		require.YesError(t, err)
+	}
+	newClient.SetAuthToken(rotateResp.Token)
+	_, err = newClient.WhoAmI(newClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+
+	// Deleting the robot revokes its keys
+	_, err = adminClient.DeleteRobot(adminClient.Ctx(), &auth.DeleteRobotRequest{Name: robot})
+	require.NoError(t, err)
+	_, err = newClient.WhoAmI(newClient.Ctx(), &auth.WhoAmIRequest{})
+	require.YesError(t, err)
+}
+
+// TestListAndRevokeAuthTokens tests that users and admins can list and revoke
+// the active tokens of a user
+func TestListAndRevokeAuthTokens(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	defer deleteAll(t)
+	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
+	aliceClient, bobClient := getPachClient(t, alice), getPachClient(t, bob)
+	adminClient := getPachClient(t, admin)
+	defer delete(tokenMap, gh(alice))
+
+	// alice logs in a second time
+	authResp, err := aliceClient.Authenticate(context.Background(),
+		&auth.AuthenticateRequest{GitHubToken: alice})
+	require.NoError(t, err)
+	aliceClient2 := aliceClient.WithCtx(context.Background())
+	aliceClient2.SetAuthToken(authResp.PachToken)
+
+	// alice can list her tokens, which don't include bob's
+	listResp, err := aliceClient.ListAuthTokens(aliceClient.Ctx(),
+		&auth.ListAuthTokensRequest{Subject: gh(alice)})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(listResp.Tokens))
+	for _, token := range listResp.Tokens {
+		require.Equal(t, gh(alice), token.Info.Subject)
+		require.Equal(t, auth.TokenInfo_AUTHENTICATE, token.Info.Source)
+		require.Equal(t, "github", token.Info.IDProvider)
+		require.NotNil(t, token.Info.Created)
+		require.NotNil(t, token.Expiration)
+		require.NotEqual(t, authResp.PachToken, token.HashedToken)
+	}
+
+	// bob can't list or revoke alice's tokens, or list all tokens
+	_, err = bobClient.ListAuthTokens(bobClient.Ctx(),
+		&auth.ListAuthTokensRequest{Subject: gh(alice)})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = bobClient.ListAuthTokens(bobClient.Ctx(), &auth.ListAuthTokensRequest{})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = bobClient.RevokeAuthTokensForUser(bobClient.Ctx(),
+		&auth.RevokeAuthTokensForUserRequest{Subject: gh(alice)})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// the admin revokes all of alice's tokens, after which neither works
+	revokeResp, err := adminClient.RevokeAuthTokensForUser(adminClient.Ctx(),
+		&auth.RevokeAuthTokensForUserRequest{Subject: alice})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), revokeResp.NumberRevoked)
+	for _, c := range []*client.APIClient{aliceClient, aliceClient2} {
+		_, err = c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{})
+		require.YesError(t, err)
+		require.True(t, auth.IsErrBadToken(err), err.Error())
+	}
+	listResp, err = adminClient.ListAuthTokens(adminClient.Ctx(),
+		&auth.ListAuthTokensRequest{Subject: gh(alice)})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(listResp.Tokens))
+
+	// bob's token is unaffected
+	_, err = bobClient.WhoAmI(bobClient.Ctx(), &auth.WhoAmIRequest{})
+	require.NoError(t, err)
+}
+
+// TestDataPolicies tests that data classification labels propagate to
+// downstream repos, and that their policies are enforced
+func TestDataPolicies(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	defer deleteAll(t)
+	alice := tu.UniqueString("alice")
+	aliceClient, adminClient := getPachClient(t, alice), getPachClient(t, admin)
+
+	config := authserver.DefaultAuthConfig
+	config.LiveConfigVersion = 0 // blind write
+	config.DataPolicies = []*auth.DataPolicy{
+		{Label: "pii", Inherit: true, DenyEgress: true, DenyPublic: true},
+	}
+	_, err := adminClient.SetConfiguration(adminClient.Ctx(),
+		&auth.SetConfigurationRequest{Configuration: &config})
+	require.NoError(t, err)
+
+	// alice creates a PII repo, which can't be made world-readable
+	repo := tu.UniqueString("TestDataPolicies")
+	_, err = aliceClient.PfsAPIClient.CreateRepo(aliceClient.Ctx(), &pfs.CreateRepoRequest{
+		Repo:   client.NewRepo(repo),
+		Labels: []string{"pii"},
+	})
+	require.NoError(t, err)
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Username: auth.AllClusterUsersSubject,
+		Scope:    auth.Scope_READER,
+	})
+	require.YesError(t, err)
+	require.True(t, datapolicy.IsErrPolicyViolation(err))
+
+	// The output repo of a pipeline reading the PII repo inherits its label
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: ubuntu:16.04
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+	repoInfo, err := aliceClient.InspectRepo(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pii"}, repoInfo.InheritedLabels)
+	_, err = aliceClient.SetACL(aliceClient.Ctx(), &auth.SetACLRequest{
+		Repo: pipeline,
+		Entries: []*auth.ACLEntry{
+			{Username: gh(alice), Scope: auth.Scope_OWNER},
+			{Username: auth.AllClusterUsersSubject, Scope: auth.Scope_READER},
+		},
+	})
+	require.YesError(t, err)
+	require.True(t, datapolicy.IsErrPolicyViolation(err))
+
+	// alice can't create a pipeline that egresses PII
+	_, err = aliceClient.PpsAPIClient.CreatePipeline(aliceClient.Ctx(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(tu.UniqueString("egress")),
+		Transform: &pps.Transform{Cmd: []string{"bash"}, Stdin: []string{"cp /pfs/*/* /pfs/out/"}},
+		Input:     client.NewPFSInput(pipeline, "/*"),
+		Egress:    &pps.Egress{URL: "s3://bucket/dir"},
+	})
+	require.YesError(t, err)
+	require.True(t, datapolicy.IsErrPolicyViolation(err))
+
+	// Unlabelled repos aren't affected
+	other := tu.UniqueString("TestDataPolicies")
+	require.NoError(t, aliceClient.CreateRepo(other))
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     other,
+		Username: auth.AllClusterUsersSubject,
+		Scope:    auth.Scope_READER,
+	})
+	require.NoError(t, err)
+
+	// Only the repo's owners may change its labels
+	bob := tu.UniqueString("bob")
+	bobClient := getPachClient(t, bob)
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Username: bob,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+	_, err = bobClient.PfsAPIClient.CreateRepo(bobClient.Ctx(), &pfs.CreateRepoRequest{
+		Repo:        client.NewRepo(repo),
+		Update:      true,
+		ClearLabels: true,
+	})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// Labels added to a repo propagate to the repos already downstream of it,
+	// and stop the egress of their data
+	upstream := tu.UniqueString("TestDataPolicies")
+	require.NoError(t, aliceClient.CreateRepo(upstream))
+	egress := tu.UniqueString("egress")
+	_, err = aliceClient.PpsAPIClient.CreatePipeline(aliceClient.Ctx(), &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(egress),
+		Transform: &pps.Transform{Cmd: []string{"bash"}, Stdin: []string{"cp /pfs/*/* /pfs/out/"}},
+		Input:     client.NewPFSInput(upstream, "/*"),
+		Egress:    &pps.Egress{URL: "s3://bucket/dir"},
+	})
+	require.NoError(t, err)
+	_, err = aliceClient.PfsAPIClient.CreateRepo(aliceClient.Ctx(), &pfs.CreateRepoRequest{
+		Repo:   client.NewRepo(upstream),
+		Update: true,
+		Labels: []string{"pii"},
+	})
+	require.NoError(t, err)
+	repoInfo, err = aliceClient.InspectRepo(egress)
+	require.NoError(t, err)
+	require.Equal(t, []string{"pii"}, repoInfo.InheritedLabels)
+	commit, err := aliceClient.StartCommit(upstream, "master")
+	require.NoError(t, err)
+	_, err = aliceClient.PutFile(upstream, commit.ID, "/file", strings.NewReader("data"))
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.FinishCommit(upstream, commit.ID))
+	jobInfos, err := aliceClient.FlushJobAll([]*pfs.Commit{commit}, []string{egress})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobInfos))
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfos[0].State)
+	require.True(t, datapolicy.IsErrPolicyViolation(errors.New(jobInfos[0].Reason)), jobInfos[0].Reason)
+}
+
+func TestBranchACLs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	defer deleteAll(t)
+	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
+	aliceClient, bobClient := getPachClient(t, alice), getPachClient(t, bob)
+
+	// alice creates a repo and makes bob a writer
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	_, err := aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Username: bob,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+	_, err = bobClient.PutFile(repo, "master", "/file1", strings.NewReader("1"))
+	require.NoError(t, err)
+
+	// alice protects master, without granting bob access to it
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Branch:   "master",
+		Username: alice,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+
+	// bob can't write to master, whether the branch or its commit is named
+	_, err = bobClient.PutFile(repo, "master", "/file2", strings.NewReader("2"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	commit, err := aliceClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = bobClient.PutFile(repo, commit.ID, "/file2", strings.NewReader("2"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	require.YesError(t, bobClient.FinishCommit(repo, commit.ID))
+	require.NoError(t, aliceClient.FinishCommit(repo, commit.ID))
+
+	// bob can still write to branches that match no pattern
+	_, err = bobClient.PutFile(repo, "dev", "/file2", strings.NewReader("2"))
+	require.NoError(t, err)
+
+	// removing alice from master's branch ACL leaves master protected
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Branch:   "master",
+		Username: alice,
+		Scope:    auth.Scope_NONE,
+	})
+	require.NoError(t, err)
+	_, err = bobClient.PutFile(repo, "master", "/file2", strings.NewReader("2"))
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// once master is unprotected, bob can write to it again
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:   repo,
+		Branch: "master",
+		Scope:  auth.Scope_NONE,
+	})
+	require.NoError(t, err)
+	_, err = bobClient.PutFile(repo, "master", "/file2", strings.NewReader("2"))
+	require.NoError(t, err)
+
+	// bob can write to master once granted access to a matching pattern
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Branch:   "mast*",
+		Username: bob,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+	_, err = bobClient.PutFile(repo, "master", "/file3", strings.NewReader("3"))
+	require.NoError(t, err)
+}
+
+func TestPipelineACLs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	defer deleteAll(t)
+	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
+	aliceClient, bobClient := getPachClient(t, alice), getPachClient(t, bob)
+
+	// alice creates a pipeline, and makes bob a writer on its output repo
+	repo := tu.UniqueString(t.Name())
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, aliceClient.CreatePipeline(
+		pipeline,
+		"", // default image: ubuntu:16.04
+		[]string{"bash"},
+		[]string{"cp /pfs/*/* /pfs/out/"},
+		&pps.ParallelismSpec{Constant: 1},
+		client.NewPFSInput(repo, "/*"),
+		"", // default output branch: master
+		false,
+	))
+	_, err := aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     pipeline,
+		Username: bob,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+
+	// Without a pipeline ACL, the output repo's ACL lets bob stop the pipeline
+	require.NoError(t, bobClient.StopPipeline(pipeline))
+	require.NoError(t, aliceClient.StartPipeline(pipeline))
+
+	// alice gives the pipeline its own ACL, in which bob is only a reader
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Pipeline: pipeline,
+		Username: alice,
+		Scope:    auth.Scope_OWNER,
+	})
+	require.NoError(t, err)
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Pipeline: pipeline,
+		Username: bob,
+		Scope:    auth.Scope_READER,
+	})
+	require.NoError(t, err)
+
+	// bob can no longer stop the pipeline or change its ACL...
+	err = bobClient.StopPipeline(pipeline)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+	_, err = bobClient.SetScope(bobClient.Ctx(), &auth.SetScopeRequest{
+		Pipeline: pipeline,
+		Username: bob,
+		Scope:    auth.Scope_OWNER,
+	})
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+
+	// ...but alice can, and once bob is a writer, bob can stop it too
+	require.NoError(t, aliceClient.StopPipeline(pipeline))
+	require.NoError(t, aliceClient.StartPipeline(pipeline))
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Pipeline: pipeline,
+		Username: bob,
+		Scope:    auth.Scope_WRITER,
+	})
+	require.NoError(t, err)
+	require.NoError(t, bobClient.StopPipeline(pipeline))
+
+	// bob still can't delete the pipeline, which requires OWNER
+	err = bobClient.DeletePipeline(pipeline, false)
+	require.YesError(t, err)
+	require.True(t, auth.IsErrNotAuthorized(err), err.Error())
+}
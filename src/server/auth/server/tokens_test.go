@@ -0,0 +1,63 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testetcd"
+
+	"golang.org/x/net/context"
+)
+
+// TestIndexTokens tests that tokens written before tokensSubjectIndex existed
+// can be found and revoked by subject, before and after indexTokens runs
+func TestIndexTokens(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(e *testetcd.Env) error {
+		ctx := context.Background()
+		// 'unindexed' is the tokens collection as it was before the index
+		unindexed := col.NewCollection(e.EtcdClient, tokensPrefix, nil, &auth.TokenInfo{}, nil, nil)
+		a := &apiServer{
+			tokens: col.NewCollection(e.EtcdClient, tokensPrefix, []*col.Index{tokensSubjectIndex}, &auth.TokenInfo{}, nil, nil),
+		}
+		if _, err := col.NewSTM(ctx, e.EtcdClient, func(stm col.STM) error {
+			if err := unindexed.ReadWrite(stm).PutTTL("old", &auth.TokenInfo{Subject: "github:alice"}, 3600); err != nil {
+				return err
+			}
+			if err := a.tokens.ReadWrite(stm).Put("new", &auth.TokenInfo{Subject: "github:alice"}); err != nil {
+				return err
+			}
+			return a.tokens.ReadWrite(stm).Put("other", &auth.TokenInfo{Subject: "github:alicia"})
+		}); err != nil {
+			return err
+		}
+		keysFor := func(subject string) []string {
+			var keys []string
+			var tokenInfo auth.TokenInfo
+			require.NoError(t, a.tokensForSubject(a.tokens.ReadOnly(ctx), subject, &tokenInfo, func(key string) error {
+				keys = append(keys, key)
+				return nil
+			}))
+			return keys
+		}
+
+		// Until the tokens are indexed, every token is checked
+		require.ElementsEqual(t, []string{"old", "new"}, keysFor("github:alice"))
+
+		// indexTokens adds the old token to the index, keeping its TTL
+		require.NoError(t, indexTokens(ctx, e.EtcdClient, a.tokens))
+		a.tokensIndexed = 1
+		require.ElementsEqual(t, []string{"old", "new"}, keysFor("github:alice"))
+		require.ElementsEqual(t, []string{"other"}, keysFor("github:alicia"))
+		if _, err := col.NewSTM(ctx, e.EtcdClient, func(stm col.STM) error {
+			ttl, err := a.tokens.ReadWrite(stm).TTL("old")
+			require.NoError(t, err)
+			require.True(t, ttl > 0 && ttl <= 3600)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return nil
+	}))
+}
@@ -108,11 +108,23 @@ func (a *InactiveAPIServer) SetACLInTransaction(*txnenv.TransactionContext, *aut
 	return nil, auth.ErrNotActivated
 }
 
+// GetDataPoliciesInTransaction returns the cluster's data policies for use
+// inside a running transaction.  It also returns a NotActivatedError.
+func (a *InactiveAPIServer) GetDataPoliciesInTransaction(*txnenv.TransactionContext) ([]*auth.DataPolicy, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // GetAuthToken implements the GetAuthToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetAuthToken(context.Context, *auth.GetAuthTokenRequest) (*auth.GetAuthTokenResponse, error) {
 	return nil, auth.ErrNotActivated
 }
 
+// GetAuthTokenInTransaction is the same as the GetAuthToken RPC but for use
+// inside a running transaction.  It also returns a NotActivatedError.
+func (a *InactiveAPIServer) GetAuthTokenInTransaction(*txnenv.TransactionContext, *auth.GetAuthTokenRequest) (*auth.GetAuthTokenResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // GetOIDCLogin implements the GetOIDCLogin RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetOIDCLogin(context.Context, *auth.GetOIDCLoginRequest) (*auth.GetOIDCLoginResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -128,6 +140,12 @@ func (a *InactiveAPIServer) RevokeAuthToken(context.Context, *auth.RevokeAuthTok
 	return nil, auth.ErrNotActivated
 }
 
+// RevokeAuthTokenInTransaction is the same as the RevokeAuthToken RPC but for
+// use inside a running transaction.  It also returns a NotActivatedError.
+func (a *InactiveAPIServer) RevokeAuthTokenInTransaction(*txnenv.TransactionContext, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
 // SetGroupsForUser implements the SetGroupsForUser RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) SetGroupsForUser(context.Context, *auth.SetGroupsForUserRequest) (*auth.SetGroupsForUserResponse, error) {
 	return nil, auth.ErrNotActivated
@@ -162,3 +180,73 @@ func (a *InactiveAPIServer) GetConfiguration(context.Context, *auth.GetConfigura
 func (a *InactiveAPIServer) GetOneTimePassword(context.Context, *auth.GetOneTimePasswordRequest) (*auth.GetOneTimePasswordResponse, error) {
 	return nil, auth.ErrNotActivated
 }
+
+// CreateRole implements the CreateRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) CreateRole(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteRole implements the DeleteRole RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteRole(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListRoles implements the ListRoles RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListRoles(context.Context, *auth.ListRolesRequest) (*auth.ListRolesResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ModifyRoleBinding implements the ModifyRoleBinding RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ModifyRoleBinding(context.Context, *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// GetRoleBindings implements the GetRoleBindings RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetRoleBindings(context.Context, *auth.GetRoleBindingsRequest) (*auth.GetRoleBindingsResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// CreateRobot implements the CreateRobot RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) CreateRobot(context.Context, *auth.CreateRobotRequest) (*auth.CreateRobotResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListRobots implements the ListRobots RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListRobots(context.Context, *auth.ListRobotsRequest) (*auth.ListRobotsResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteRobot implements the DeleteRobot RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteRobot(context.Context, *auth.DeleteRobotRequest) (*auth.DeleteRobotResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// CreateRobotKey implements the CreateRobotKey RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) CreateRobotKey(context.Context, *auth.CreateRobotKeyRequest) (*auth.CreateRobotKeyResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListRobotKeys implements the ListRobotKeys RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListRobotKeys(context.Context, *auth.ListRobotKeysRequest) (*auth.ListRobotKeysResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteRobotKey implements the DeleteRobotKey RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteRobotKey(context.Context, *auth.DeleteRobotKeyRequest) (*auth.DeleteRobotKeyResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// RotateRobotKey implements the RotateRobotKey RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) RotateRobotKey(context.Context, *auth.RotateRobotKeyRequest) (*auth.RotateRobotKeyResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// ListAuthTokens implements the ListAuthTokens RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ListAuthTokens(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	return nil, auth.ErrNotActivated
+}
+
+// RevokeAuthTokensForUser implements the RevokeAuthTokensForUser RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) RevokeAuthTokensForUser(context.Context, *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error) {
+	return nil, auth.ErrNotActivated
+}
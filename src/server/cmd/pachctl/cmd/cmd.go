@@ -20,6 +20,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 	admincmds "github.com/pachyderm/pachyderm/src/server/admin/cmds"
+	auditcmds "github.com/pachyderm/pachyderm/src/server/audit/cmds"
 	authcmds "github.com/pachyderm/pachyderm/src/server/auth/cmds"
 	"github.com/pachyderm/pachyderm/src/server/cmd/pachctl/shell"
 	configcmds "github.com/pachyderm/pachyderm/src/server/config"
@@ -31,6 +32,7 @@ import (
 	logutil "github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	ppscmds "github.com/pachyderm/pachyderm/src/server/pps/cmds"
+	replicationcmds "github.com/pachyderm/pachyderm/src/server/replication/cmds"
 	txncmds "github.com/pachyderm/pachyderm/src/server/transaction/cmds"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -347,6 +349,10 @@ Environment variables:
 				))
 				cmdutil.PrintErrorStacks = true
 			}
+
+			if err := cmdutil.ApplyContextOutputFormat(cmd); err != nil {
+				cmdutil.ErrorAndExit("%v", err)
+			}
 		},
 		BashCompletionFunction: bashCompletionFunc,
 	}
@@ -552,7 +558,13 @@ This resets the cluster to its initial state.`,
 				return errors.New("port forwarding appears to already be running for this context")
 			}
 
-			fw, err := client.NewPortForwarder(context, namespace)
+			// Forward ports using the namespace (and kubernetes context)
+			// that the active context may inherit from its parents
+			_, resolvedContext, err := cfg.ActiveResolvedContext(true)
+			if err != nil {
+				return err
+			}
+			fw, err := client.NewPortForwarder(resolvedContext, namespace)
 			if err != nil {
 				return err
 			}
@@ -830,6 +842,8 @@ This resets the cluster to its initial state.`,
 	subcommands = append(subcommands, enterprisecmds.Cmds()...)
 	subcommands = append(subcommands, admincmds.Cmds()...)
 	subcommands = append(subcommands, debugcmds.Cmds()...)
+	subcommands = append(subcommands, auditcmds.Cmds()...)
+	subcommands = append(subcommands, replicationcmds.Cmds()...)
 	subcommands = append(subcommands, txncmds.Cmds()...)
 	subcommands = append(subcommands, configcmds.Cmds()...)
 
@@ -895,6 +909,7 @@ func applyRootUsageFunc(rootCmd *cobra.Command) {
 			"garbage-collect",
 			"update-dash",
 			"auth",
+			"audit",
 			"enterprise":
 			admin = append(admin, subcmd)
 		default:
@@ -13,6 +13,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/src/client"
 	adminclient "github.com/pachyderm/pachyderm/src/client/admin"
+	auditclient "github.com/pachyderm/pachyderm/src/client/audit"
 	authclient "github.com/pachyderm/pachyderm/src/client/auth"
 	debugclient "github.com/pachyderm/pachyderm/src/client/debug"
 	eprsclient "github.com/pachyderm/pachyderm/src/client/enterprise"
@@ -24,11 +25,14 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	replicationclient "github.com/pachyderm/pachyderm/src/client/replication"
 	transactionclient "github.com/pachyderm/pachyderm/src/client/transaction"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 	adminserver "github.com/pachyderm/pachyderm/src/server/admin/server"
+	auditserver "github.com/pachyderm/pachyderm/src/server/audit/server"
 	authserver "github.com/pachyderm/pachyderm/src/server/auth/server"
+	debugprofiler "github.com/pachyderm/pachyderm/src/server/debug/profiler"
 	debugserver "github.com/pachyderm/pachyderm/src/server/debug/server"
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
@@ -49,6 +53,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	pps_server "github.com/pachyderm/pachyderm/src/server/pps/server"
 	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
+	replicationserver "github.com/pachyderm/pachyderm/src/server/replication/server"
 	txnserver "github.com/pachyderm/pachyderm/src/server/transaction/server"
 
 	etcd "github.com/coreos/etcd/clientv3"
@@ -121,6 +126,10 @@ func doSidecarMode(config interface{}) (retErr error) {
 	} else {
 		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
 	}
+	profiler, err := debugprofiler.StartWithServiceEnv(env)
+	if err != nil {
+		return errors.Wrapf(err, "debugprofiler.StartWithServiceEnv")
+	}
 	if env.EtcdPrefix == "" {
 		env.EtcdPrefix = col.DefaultPrefix
 	}
@@ -143,7 +152,11 @@ func doSidecarMode(config interface{}) (retErr error) {
 	if err != nil {
 		return errors.Wrapf(err, "lru.New")
 	}
-	server, err := grpcutil.NewServer(context.Background(), false)
+	auditAPIServer, err := auditserver.NewAPIServer(env, env.AuditSinks)
+	if err != nil {
+		return err
+	}
+	server, err := grpcutil.NewServer(context.Background(), false, auditAPIServer.Interceptor())
 	if err != nil {
 		return err
 	}
@@ -261,6 +274,7 @@ func doSidecarMode(config interface{}) (retErr error) {
 			env,
 			env.PachdPodName,
 			nil,
+			profiler,
 		))
 		return nil
 	}); err != nil {
@@ -307,6 +321,10 @@ func doFullMode(config interface{}) (retErr error) {
 	} else {
 		log.Printf("no Jaeger collector found (JAEGER_COLLECTOR_SERVICE_HOST not set)")
 	}
+	profiler, err := debugprofiler.StartWithServiceEnv(env)
+	if err != nil {
+		return errors.Wrapf(err, "debugprofiler.StartWithServiceEnv")
+	}
 	if env.EtcdPrefix == "" {
 		env.EtcdPrefix = col.DefaultPrefix
 	}
@@ -356,8 +374,18 @@ func doFullMode(config interface{}) (retErr error) {
 	}
 	kubeNamespace := env.Namespace
 	requireNoncriticalServers := !env.RequireCriticalServersOnly
+	// Setup the audit log, which records every mutating RPC received by either
+	// the external or the internal pachd GRPC server
+	auditAPIServer, err := auditserver.NewAPIServer(env, env.AuditSinks)
+	if err != nil {
+		return err
+	}
+	// Callers of the external server may authenticate with a client
+	// certificate, which is exchanged for a token before the RPC is audited
+	clientCertInterceptor := authserver.NewClientCertInterceptor()
 	// Setup External Pachd GRPC Server.
-	externalServer, err := grpcutil.NewServer(context.Background(), true)
+	externalServer, err := grpcutil.NewServer(context.Background(), true,
+		clientCertInterceptor.Interceptor(), auditAPIServer.Interceptor())
 	if err != nil {
 		return err
 	}
@@ -438,6 +466,7 @@ func doFullMode(config interface{}) (retErr error) {
 				return err
 			}
 			authclient.RegisterAPIServer(externalServer.Server, authAPIServer)
+			clientCertInterceptor.SetAuthServer(authAPIServer)
 			return nil
 		}); err != nil {
 			return err
@@ -472,7 +501,7 @@ func doFullMode(config interface{}) (retErr error) {
 			adminclient.RegisterAPIServer(externalServer.Server, adminserver.NewAPIServer(address, env.StorageRoot, &adminclient.ClusterInfo{
 				ID:           clusterID,
 				DeploymentID: env.DeploymentID,
-			}))
+			}, env.GetEtcdClient(), path.Join(env.EtcdPrefix, env.PFSEtcdPrefix), path.Join(env.EtcdPrefix, env.PPSEtcdPrefix)))
 			return nil
 		}); err != nil {
 			return err
@@ -495,6 +524,22 @@ func doFullMode(config interface{}) (retErr error) {
 				env,
 				env.PachdPodName,
 				nil,
+				profiler,
+			))
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := logGRPCServerSetup("Audit API", func() error {
+			auditclient.RegisterAPIServer(externalServer.Server, auditAPIServer)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if err := logGRPCServerSetup("Replication API", func() error {
+			replicationclient.RegisterAPIServer(externalServer.Server, replicationserver.NewAPIServer(
+				env,
+				path.Join(env.EtcdPrefix, env.ReplicationEtcdPrefix),
 			))
 			return nil
 		}); err != nil {
@@ -510,7 +555,7 @@ func doFullMode(config interface{}) (retErr error) {
 		return err
 	}
 	// Setup Internal Pachd GRPC Server.
-	internalServer, err := grpcutil.NewServer(context.Background(), false)
+	internalServer, err := grpcutil.NewServer(context.Background(), false, auditAPIServer.Interceptor())
 	if err != nil {
 		return err
 	}
@@ -659,7 +704,7 @@ func doFullMode(config interface{}) (retErr error) {
 			adminclient.RegisterAPIServer(internalServer.Server, adminserver.NewAPIServer(address, env.StorageRoot, &adminclient.ClusterInfo{
 				ID:           clusterID,
 				DeploymentID: env.DeploymentID,
-			}))
+			}, env.GetEtcdClient(), path.Join(env.EtcdPrefix, env.PFSEtcdPrefix), path.Join(env.EtcdPrefix, env.PPSEtcdPrefix)))
 			return nil
 		}); err != nil {
 			return err
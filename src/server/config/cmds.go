@@ -2,13 +2,18 @@ package cmds
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"sort"
+	"time"
 
+	pachdclient "github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
@@ -24,6 +29,19 @@ const (
 	listContextHeader = "ACTIVE\tNAME"
 )
 
+// absPath returns the absolute form of the path 'p' (or "" if 'p' is empty),
+// so that paths stored in the config work from any directory
+func absPath(p string) (string, error) {
+	if p == "" {
+		return "", nil
+	}
+	abs, err := filepath.Abs(p)
+	if err != nil {
+		return "", errors.Wrapf(err, "could not resolve %q", p)
+	}
+	return abs, nil
+}
+
 // Cmds returns a slice containing admin commands.
 func Cmds() []*cobra.Command {
 	marshaller := &jsonpb.Marshaler{
@@ -197,7 +215,13 @@ func Cmds() []*cobra.Command {
 				}
 			}
 
+			if err := validateOutputFormat(context.OutputFormat); err != nil {
+				return err
+			}
 			cfg.V2.Contexts[name] = &context
+			if _, err := cfg.ResolvedContext(name); err != nil {
+				return err
+			}
 			return cfg.Write()
 		}),
 	}
@@ -210,7 +234,10 @@ func Cmds() []*cobra.Command {
 	var clusterName string
 	var authInfo string
 	var serverCAs string
+	var clientCert, clientKey string
 	var namespace string
+	var parent string
+	var defaultRepo, defaultBranch, outputFormat string
 	var removeClusterDeploymentID bool
 	var updateContext *cobra.Command // standalone declaration so Run() can refer
 	updateContext = &cobra.Command{
@@ -266,13 +293,50 @@ func Cmds() []*cobra.Command {
 			if updateContext.Flags().Changed("server-cas") {
 				context.ServerCAs = serverCAs
 			}
+			if updateContext.Flags().Changed("client-cert") {
+				if context.ClientCert, err = absPath(clientCert); err != nil {
+					return err
+				}
+			}
+			if updateContext.Flags().Changed("client-key") {
+				if context.ClientKey, err = absPath(clientKey); err != nil {
+					return err
+				}
+			}
 			if updateContext.Flags().Changed("namespace") {
 				context.Namespace = namespace
 			}
+			if updateContext.Flags().Changed("default-repo") {
+				context.DefaultRepo = defaultRepo
+			}
+			if updateContext.Flags().Changed("default-branch") {
+				context.DefaultBranch = defaultBranch
+			}
+			if updateContext.Flags().Changed("output-format") {
+				if err := validateOutputFormat(outputFormat); err != nil {
+					return err
+				}
+				context.OutputFormat = outputFormat
+			}
+			if updateContext.Flags().Changed("parent") {
+				if parent != "" {
+					if _, ok := cfg.V2.Contexts[parent]; !ok {
+						return errors.Errorf("context does not exist: %s", parent)
+					}
+				}
+				context.Parent = parent
+			}
 			if removeClusterDeploymentID {
 				context.ClusterDeploymentID = ""
 			}
 
+			// Check that the context (and any context inheriting from it)
+			// can still be resolved, e.g. that --parent didn't create a cycle
+			for name := range cfg.V2.Contexts {
+				if _, err := cfg.ResolvedContext(name); err != nil {
+					return err
+				}
+			}
 			return cfg.Write()
 		}),
 	}
@@ -280,7 +344,13 @@ func Cmds() []*cobra.Command {
 	updateContext.Flags().StringVar(&clusterName, "cluster-name", "", "Set a new cluster name.")
 	updateContext.Flags().StringVar(&authInfo, "auth-info", "", "Set a new k8s auth info.")
 	updateContext.Flags().StringVar(&serverCAs, "server-cas", "", "Set new trusted CA certs.")
+	updateContext.Flags().StringVar(&clientCert, "client-cert", "", "Set the path of a client certificate to present to pachd (mutual TLS).")
+	updateContext.Flags().StringVar(&clientKey, "client-key", "", "Set the path of the client certificate's private key.")
 	updateContext.Flags().StringVar(&namespace, "namespace", "", "Set a new namespace.")
+	updateContext.Flags().StringVar(&parent, "parent", "", "Set the context that this context inherits unset values from.")
+	updateContext.Flags().StringVar(&defaultRepo, "default-repo", "", "Set the repo used by arguments that omit it (e.g. '@master:/file').")
+	updateContext.Flags().StringVar(&defaultBranch, "default-branch", "", "Set the branch used by arguments that omit it (e.g. 'repo@:/file').")
+	updateContext.Flags().StringVar(&outputFormat, "output-format", "", "Set the default --output format of commands, e.g. \"json\", \"yaml\" or \"jsonl\" (or \"\" to pretty print).")
 	updateContext.Flags().BoolVar(&removeClusterDeploymentID, "remove-cluster-deployment-id", false, "Remove the cluster deployment ID field, which will be repopulated on the next `pachctl` call using this context.")
 	shell.RegisterCompletionFunc(updateContext, contextCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateContext, "config update context"))
@@ -299,6 +369,11 @@ func Cmds() []*cobra.Command {
 			if cfg.V2.ActiveContext == args[0] {
 				return errors.New("cannot delete an active context")
 			}
+			for name, context := range cfg.V2.Contexts {
+				if context.Parent == args[0] {
+					return errors.Errorf("cannot delete context %q, as context %q inherits from it", args[0], name)
+				}
+			}
 			delete(cfg.V2.Contexts, args[0])
 			return cfg.Write()
 		}),
@@ -341,6 +416,58 @@ func Cmds() []*cobra.Command {
 	}
 	commands = append(commands, cmdutil.CreateAlias(listContext, "config list context"))
 
+	var offline bool
+	useContext := &cobra.Command{
+		Use:   "{{alias}} <context>",
+		Short: "Switch to a context.",
+		Long: "Makes the given context the active context, and then connects to " +
+			"its cluster to show whether the cluster is healthy and which user " +
+			"you're logged in as.",
+		Example: `
+# switch to the context 'prod' and check its cluster
+$ {{alias}} prod
+
+# switch contexts without connecting to the cluster
+$ {{alias}} prod --offline`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			cfg, err := config.Read(false)
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.V2.Contexts[args[0]]; !ok {
+				return errors.Errorf("context does not exist: %s", args[0])
+			}
+			context, err := cfg.ResolvedContext(args[0])
+			if err != nil {
+				return err
+			}
+			cfg.V2.ActiveContext = args[0]
+			if err := cfg.Write(); err != nil {
+				return err
+			}
+			if envContext, ok := os.LookupEnv("PACH_CONTEXT"); ok && envContext != args[0] {
+				fmt.Fprintf(os.Stderr, "WARNING: $PACH_CONTEXT is set, so context %q will be used instead until it's unset\n", envContext)
+			}
+			printContextSummary(os.Stdout, args[0], context)
+			if offline {
+				return nil
+			}
+			printClusterStatus(os.Stdout)
+			return nil
+		}),
+	}
+	useContext.Flags().BoolVar(&offline, "offline", false, "Don't connect to the context's cluster.")
+	shell.RegisterCompletionFunc(useContext, contextCompletion)
+	commands = append(commands, cmdutil.CreateAlias(useContext, "context use"))
+
+	contextDocs := &cobra.Command{
+		Short: "Switch between contexts.",
+		Long: "Contexts configure the cluster that pachctl connects to, along " +
+			"with defaults (such as a default repo and branch) for commands. " +
+			"Contexts are managed with the 'pachctl config' commands.",
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(contextDocs, "context", "^pachctl context "))
+
 	configDocs := &cobra.Command{
 		Short: "Manages the pachyderm config.",
 		Long:  "Gets/sets pachyderm config values.",
@@ -380,6 +507,83 @@ func Cmds() []*cobra.Command {
 	return commands
 }
 
+// contextStatusTimeout bounds how long 'context use' waits for the cluster
+const contextStatusTimeout = 10 * time.Second
+
+// validateOutputFormat checks a context's default output format, which may
+// be any value of --output
+func validateOutputFormat(format string) error {
+	_, err := cmdutil.ParseOutputFormat(format)
+	return err
+}
+
+// printField prints one line of the summary printed by 'context use'
+func printField(w io.Writer, label, value string) {
+	fmt.Fprintf(w, "  %-16s%s\n", label+":", value)
+}
+
+// printContextSummary prints the settings of a (resolved) context that
+// affect the commands run in it
+func printContextSummary(w io.Writer, name string, context *config.Context) {
+	if context.Parent != "" {
+		fmt.Fprintf(w, "Switched to context %q (inherits from %q)\n", name, context.Parent)
+	} else {
+		fmt.Fprintf(w, "Switched to context %q\n", name)
+	}
+	if context.PachdAddress != "" {
+		printField(w, "pachd address", context.PachdAddress)
+	}
+	if context.Namespace != "" {
+		printField(w, "namespace", context.Namespace)
+	}
+	if context.DefaultRepo != "" {
+		printField(w, "default repo", context.DefaultRepo)
+	}
+	if context.DefaultBranch != "" {
+		printField(w, "default branch", context.DefaultBranch)
+	}
+	if context.OutputFormat != "" {
+		printField(w, "output format", context.OutputFormat)
+	}
+}
+
+// printClusterStatus connects to the active context's cluster and prints its
+// health and the user that pachctl is authenticated as. Failures are printed
+// rather than returned, as the context has already been switched.
+func printClusterStatus(w io.Writer) {
+	c, err := pachdclient.NewOnUserMachine("user", pachdclient.WithDialTimeout(contextStatusTimeout))
+	if err != nil {
+		printField(w, "cluster", fmt.Sprintf("unreachable (%v)", err))
+		return
+	}
+	defer c.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), contextStatusTimeout)
+	defer cancel()
+	c = c.WithCtx(ctx)
+
+	if err := c.Health(); err != nil {
+		printField(w, "cluster", fmt.Sprintf("unhealthy (%v)", err))
+	} else if version, err := c.Version(); err != nil {
+		printField(w, "cluster", "healthy")
+	} else {
+		printField(w, "cluster", fmt.Sprintf("healthy (pachd %s)", version))
+	}
+
+	resp, err := c.WhoAmI(c.Ctx(), &auth.WhoAmIRequest{})
+	switch {
+	case auth.IsErrNotActivated(err):
+		printField(w, "user", "none (auth is not activated)")
+	case auth.IsErrNotSignedIn(err):
+		printField(w, "user", "not logged in (run 'pachctl auth login')")
+	case err != nil:
+		printField(w, "user", fmt.Sprintf("unknown (%v)", grpcutil.ScrubGRPC(err)))
+	case resp.IsAdmin:
+		printField(w, "user", fmt.Sprintf("%s (cluster admin)", resp.Username))
+	default:
+		printField(w, "user", resp.Username)
+	}
+}
+
 func contextCompletion(_, text string, maxCompletions int64) ([]prompt.Suggest, shell.CacheFunc) {
 	cfg, err := config.Read(false)
 	if err != nil {
@@ -156,3 +156,38 @@ func TestConfigListContext(t *testing.T) {
 		pachctl config list context | match "	foo"
 	`))
 }
+
+func TestContextInheritance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	require.YesError(t, run(t, `
+		echo '{}' | pachctl config set context foo
+		pachctl config update context foo --parent=bar
+	`))
+
+	require.YesError(t, run(t, `
+		echo '{}' | pachctl config set context foo
+		echo '{"parent": "foo"}' | pachctl config set context bar
+		pachctl config update context foo --parent=bar
+	`))
+
+	require.YesError(t, run(t, `
+		echo '{}' | pachctl config set context foo
+		pachctl config update context foo --output-format=xml
+	`))
+
+	require.NoError(t, run(t, `
+		echo '{"pachd_address": "foobar:9000", "namespace": "prod"}' | pachctl config set context foo
+		echo '{}' | pachctl config set context bar
+		pachctl config update context bar --parent=foo --default-repo=images --default-branch=staging --output-format=yaml
+		pachctl config get context bar | match '"default_repo": "images"'
+		(pachctl config delete context foo 2>&1 || true) | match "inherits from it"
+		pachctl context use bar --offline | match 'Switched to context "bar" \(inherits from "foo"\)'
+		pachctl context use bar --offline | match "pachd address: *grpc://foobar:9000"
+		pachctl context use bar --offline | match "namespace: *prod"
+		pachctl context use bar --offline | match "output format: *yaml"
+		pachctl config get active-context | match bar
+	`))
+}
@@ -1,18 +1,30 @@
 package cmds
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
+	"path"
 	"time"
 
 	"github.com/gogo/protobuf/types"
+	"github.com/google/pprof/profile"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/debug"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	debugdump "github.com/pachyderm/pachyderm/src/server/debug/dump"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 	"github.com/spf13/cobra"
 )
 
+const findingHeader = "CHECK\tSUBJECT\tFINDING\t\n"
+
 // Cmds returns a slice containing debug commands.
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
@@ -54,6 +66,69 @@ func Cmds() []*cobra.Command {
 	profile.Flags().StringVarP(&worker, "worker", "w", "", "Only collect the profile from the given worker pod.")
 	commands = append(commands, cmdutil.CreateAlias(profile, "debug profile"))
 
+	var from string
+	var to string
+	var merge bool
+	profileRange := &cobra.Command{
+		Use:   "{{alias}} <profile> <file>",
+		Short: "Collect the continuously sampled profiles for a time range.",
+		Long: `Collect the continuously sampled profiles for a time range.
+
+Pachd and the workers continuously sample "cpu" and "heap" profiles and keep
+a rolling window of them, so a slowdown can be diagnosed after the fact. The
+samples in the time range are merged into a single profile per container,
+which can be viewed with 'go tool pprof'. CPU samples are summed, while heap
+samples are averaged over the range.`,
+		Example: `
+# Collect the CPU profile of every container for the last hour
+$ {{alias}} cpu profiles.tgz --from 1h
+
+# Collect a single heap profile for the workers of pipeline "edges", for a
+# half hour range, and view it as a flamegraph
+$ {{alias}} heap edges.pb.gz -p edges --from 2020-06-01T12:00:00Z --to 2020-06-01T12:30:00Z --merge
+$ go tool pprof -http :8080 edges.pb.gz`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			fromTime, err := cmdutil.ParseTimeFlag("from", from)
+			if err != nil {
+				return err
+			}
+			toTime := time.Now()
+			if to != "" {
+				if toTime, err = cmdutil.ParseTimeFlag("to", to); err != nil {
+					return err
+				}
+			}
+			client, err := client.NewOnUserMachine("debug-profile-range")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+			filter, err := createFilter(pachd, pipeline, worker)
+			if err != nil {
+				return err
+			}
+			if !merge {
+				return withFile(args[1], func(f *os.File) error {
+					return client.ProfileRange(args[0], fromTime, toTime, filter, f)
+				})
+			}
+			buf := &bytes.Buffer{}
+			if err := client.ProfileRange(args[0], fromTime, toTime, filter, buf); err != nil {
+				return err
+			}
+			return withFile(args[1], func(f *os.File) error {
+				return mergeProfiles(buf, f)
+			})
+		}),
+	}
+	profileRange.Flags().StringVar(&from, "from", "1h", "The start of the time range, either a duration before now (e.g. 1h) or an RFC 3339 timestamp.")
+	profileRange.Flags().StringVar(&to, "to", "", "The end of the time range, either a duration before now (e.g. 30m) or an RFC 3339 timestamp (default now).")
+	profileRange.Flags().BoolVar(&merge, "merge", false, "Merge the profiles of all containers into a single profile, rather than writing a tar of one profile per container.")
+	profileRange.Flags().BoolVar(&pachd, "pachd", false, "Only collect the profile from pachd.")
+	profileRange.Flags().StringVarP(&pipeline, "pipeline", "p", "", "Only collect the profile from the worker pods for the given pipeline.")
+	profileRange.Flags().StringVarP(&worker, "worker", "w", "", "Only collect the profile from the given worker pod.")
+	commands = append(commands, cmdutil.CreateAlias(profileRange, "debug profile-range"))
+
 	binary := &cobra.Command{
 		Use:   "{{alias}} <file>",
 		Short: "Collect a set of binaries.",
@@ -102,6 +177,44 @@ func Cmds() []*cobra.Command {
 	dump.Flags().StringVarP(&worker, "worker", "w", "", "Only collect the dump from the given worker pod.")
 	commands = append(commands, cmdutil.CreateAlias(dump, "debug dump"))
 
+	var stuckJobThreshold time.Duration
+	var openCommitThreshold time.Duration
+	var restartThreshold int32
+	analyze := &cobra.Command{
+		Use:   "{{alias}} <file>",
+		Short: "Analyze a debug dump offline.",
+		Long: `Analyze a debug dump offline.
+
+Runs a set of built-in checks against the cluster state in a dump collected
+by 'pachctl debug dump' and prints what they find. The checks detect stuck
+jobs, crashlooping workers, orphaned open commits, branch provenance cycles
+and garbage collector lag. No connection to a cluster is needed.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) (retErr error) {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer func() {
+				if err := f.Close(); retErr == nil {
+					retErr = err
+				}
+			}()
+			report, err := debugdump.Analyze(f, &debugdump.Options{
+				StuckJobThreshold:   stuckJobThreshold,
+				OpenCommitThreshold: openCommitThreshold,
+				RestartThreshold:    restartThreshold,
+			})
+			if err != nil {
+				return err
+			}
+			return printReport(os.Stdout, report)
+		}),
+	}
+	analyze.Flags().DurationVar(&stuckJobThreshold, "stuck-job-threshold", debugdump.DefaultOptions.StuckJobThreshold, "Report jobs that have been running for longer than this.")
+	analyze.Flags().DurationVar(&openCommitThreshold, "open-commit-threshold", debugdump.DefaultOptions.OpenCommitThreshold, "Report input commits that have been open for longer than this.")
+	analyze.Flags().Int32Var(&restartThreshold, "restart-threshold", debugdump.DefaultOptions.RestartThreshold, "Report worker containers that have restarted at least this many times.")
+	commands = append(commands, cmdutil.CreateAlias(analyze, "debug analyze"))
+
 	debug := &cobra.Command{
 		Short: "Debug commands for analyzing a running cluster.",
 		Long:  "Debug commands for analyzing a running cluster.",
@@ -111,6 +224,65 @@ func Cmds() []*cobra.Command {
 	return commands
 }
 
+func printReport(w io.Writer, report *debugdump.Report) error {
+	fmt.Fprintf(w, "Analyzed cluster snapshot from %s\n", report.Time.Format(time.RFC3339))
+	for _, skipped := range report.Skipped {
+		fmt.Fprintf(w, "Skipped %s\n", skipped)
+	}
+	if len(report.Findings) == 0 {
+		fmt.Fprintln(w, "No issues found.")
+		return nil
+	}
+	fmt.Fprintln(w)
+	writer := tabwriter.NewWriter(w, findingHeader)
+	for _, f := range report.Findings {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t\n", f.Check, f.Subject, f.Message)
+	}
+	return writer.Flush()
+}
+
+// mergeProfiles merges the profiles in a tar collected by ProfileRange into a
+// single profile. Containers that failed to produce a profile are reported,
+// but don't prevent the rest from being merged.
+func mergeProfiles(r io.Reader, w io.Writer) error {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gr)
+	var profiles []*profile.Profile
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return err
+		}
+		if path.Base(hdr.Name) == "error" {
+			msg, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "no profile from %s: %s", path.Dir(hdr.Name), msg)
+			continue
+		}
+		p, err := profile.Parse(tr)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse profile %s", hdr.Name)
+		}
+		profiles = append(profiles, p)
+	}
+	if len(profiles) == 0 {
+		return errors.Errorf("no profiles were collected")
+	}
+	merged, err := profile.Merge(profiles)
+	if err != nil {
+		return err
+	}
+	return merged.Write(w)
+}
+
 func createFilter(pachd bool, pipeline, worker string) (*debug.Filter, error) {
 	var f *debug.Filter
 	if pachd {
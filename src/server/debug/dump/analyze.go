@@ -0,0 +1,576 @@
+package dump
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/api/core/v1"
+)
+
+const (
+	gcLastPassMetric   = "pachyderm_storage_gc_last_pass_timestamp_seconds"
+	gcPollingMetric    = "pachyderm_storage_gc_polling_seconds"
+	processStartMetric = "process_start_time_seconds"
+	// gcLagPolls is the number of polling intervals that the garbage collector
+	// may go without completing a pass before it's considered lagging
+	gcLagPolls = 2
+	// defaultGCPolling is the garbage collector's default polling interval,
+	// used if a dump doesn't include it
+	defaultGCPolling = 5 * time.Minute
+)
+
+// Options configures the thresholds used by the checks in Analyze.
+type Options struct {
+	// StuckJobThreshold is how long a job may run before it's reported as
+	// stuck.
+	StuckJobThreshold time.Duration
+	// OpenCommitThreshold is how long a commit in an input repo may stay open
+	// before it's reported as orphaned.
+	OpenCommitThreshold time.Duration
+	// RestartThreshold is the number of times a worker container may restart
+	// before it's reported as crashlooping.
+	RestartThreshold int32
+}
+
+// DefaultOptions are the Options used by 'pachctl debug analyze' by default.
+var DefaultOptions = &Options{
+	StuckJobThreshold:   time.Hour,
+	OpenCommitThreshold: 24 * time.Hour,
+	RestartThreshold:    3,
+}
+
+// Finding is a problem detected by one of Analyze's checks.
+type Finding struct {
+	// Check is the name of the check that detected the problem.
+	Check string
+	// Subject is the object (job, pod, commit, etc) with the problem.
+	Subject string
+	// Message describes the problem.
+	Message string
+}
+
+// Report is the result of analyzing a debug dump.
+type Report struct {
+	// Time is the time at which the dump's cluster snapshot was taken.
+	Time time.Time
+	// Findings are the problems detected by all checks, in check order.
+	Findings []*Finding
+	// Skipped describes the checks that couldn't run, because the data they
+	// need is missing from the dump.
+	Skipped []string
+}
+
+// snapshot is the cluster state read from a debug dump
+type snapshot struct {
+	time      time.Time
+	pipelines map[string]*pps.EtcdPipelineInfo
+	jobs      map[string]*pps.EtcdJobInfo
+	commits   map[string]*pfs.CommitInfo
+	branches  map[string]*pfs.BranchInfo
+	pods      []*v1.Pod
+	events    []*v1.Event
+	// metrics maps the directory of each metrics file to its contents
+	metrics map[string]map[string]*dto.MetricFamily
+	// missing maps each snapshot file that couldn't be read to the reason
+	missing map[string]string
+}
+
+type check struct {
+	name  string
+	needs []string
+	run   func(*snapshot, *Options) []*Finding
+}
+
+var checks = []check{
+	{"stuck jobs", []string{JobsFile, PipelinesFile, CommitsFile}, checkStuckJobs},
+	{"crashlooping workers", []string{PodsFile, EventsFile}, checkCrashloopingWorkers},
+	{"orphaned open commits", []string{CommitsFile, JobsFile, PipelinesFile}, checkOrphanedCommits},
+	{"branch provenance cycles", []string{BranchesFile}, checkProvenanceCycles},
+	{"gc lag", []string{MetricsFile}, checkGCLag},
+}
+
+// Analyze reads a debug dump (as written by debug.Dump) from r and runs a
+// set of built-in checks against the cluster state it contains.
+func Analyze(r io.Reader, opts *Options) (*Report, error) {
+	if opts == nil {
+		opts = DefaultOptions
+	}
+	s, err := readSnapshot(r)
+	if err != nil {
+		return nil, err
+	}
+	report := &Report{Time: s.time}
+	for _, c := range checks {
+		var missing []string
+		for _, file := range c.needs {
+			if reason, ok := s.missing[file]; ok {
+				missing = append(missing, fmt.Sprintf("%s (%s)", file, reason))
+			}
+		}
+		if len(missing) > 0 {
+			report.Skipped = append(report.Skipped, fmt.Sprintf("%s: dump has no %s", c.name, strings.Join(missing, ", ")))
+			continue
+		}
+		for _, f := range c.run(s, opts) {
+			f.Check = c.name
+			report.Findings = append(report.Findings, f)
+		}
+	}
+	return report, nil
+}
+
+func readSnapshot(r io.Reader) (*snapshot, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading dump")
+	}
+	defer gr.Close()
+	s := &snapshot{
+		pipelines: make(map[string]*pps.EtcdPipelineInfo),
+		jobs:      make(map[string]*pps.EtcdJobInfo),
+		commits:   make(map[string]*pfs.CommitInfo),
+		branches:  make(map[string]*pfs.BranchInfo),
+		metrics:   make(map[string]map[string]*dto.MetricFamily),
+		missing:   make(map[string]string),
+	}
+	seen := make(map[string]bool)
+	etcdPrefix := path.Join(ClusterPrefix, EtcdPrefix)
+	k8sPrefix := path.Join(ClusterPrefix, K8sPrefix)
+	tr := tar.NewReader(gr)
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, errors.Wrapf(err, "error reading dump")
+		}
+		dir, file := path.Split(hdr.Name)
+		dir = strings.TrimSuffix(dir, "/")
+		switch {
+		case hdr.Name == path.Join(ClusterPrefix, TimeFile):
+			buf, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			if s.time, err = time.Parse(time.RFC3339, strings.TrimSpace(string(buf))); err != nil {
+				return nil, errors.Wrapf(err, "error parsing snapshot time")
+			}
+		case file == "error" && (path.Dir(dir) == etcdPrefix || path.Dir(dir) == k8sPrefix):
+			// The file couldn't be collected, record why
+			buf, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			s.missing[path.Base(dir)] = strings.TrimSpace(string(buf))
+		case hdr.Name == path.Join(k8sPrefix, "error"):
+			// The worker pods couldn't be listed, so neither file was collected
+			buf, err := ioutil.ReadAll(tr)
+			if err != nil {
+				return nil, err
+			}
+			s.missing[PodsFile] = strings.TrimSpace(string(buf))
+			s.missing[EventsFile] = strings.TrimSpace(string(buf))
+		case dir == etcdPrefix:
+			seen[file] = true
+			if err := readEntries(tr, file, s); err != nil {
+				return nil, err
+			}
+		case dir == k8sPrefix:
+			seen[file] = true
+			if err := readK8sObjects(tr, file, s); err != nil {
+				return nil, err
+			}
+		case file == MetricsFile:
+			seen[file] = true
+			var parser expfmt.TextParser
+			families, err := parser.TextToMetricFamilies(tr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error parsing %s", hdr.Name)
+			}
+			s.metrics[dir] = families
+		}
+	}
+	for _, file := range []string{PipelinesFile, JobsFile, CommitsFile, BranchesFile, PodsFile, EventsFile, MetricsFile} {
+		if _, ok := s.missing[file]; !ok && !seen[file] {
+			s.missing[file] = "not collected"
+		}
+	}
+	if s.time.IsZero() {
+		s.time = time.Now()
+	}
+	return s, nil
+}
+
+func readEntries(r io.Reader, file string, s *snapshot) error {
+	dec := json.NewDecoder(r)
+	for {
+		var entry Entry
+		if err := dec.Decode(&entry); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrapf(err, "error reading %s snapshot", file)
+		}
+		var val proto.Message
+		switch file {
+		case PipelinesFile:
+			pipelinePtr := &pps.EtcdPipelineInfo{}
+			s.pipelines[entry.Key] = pipelinePtr
+			val = pipelinePtr
+		case JobsFile:
+			jobPtr := &pps.EtcdJobInfo{}
+			s.jobs[entry.Key] = jobPtr
+			val = jobPtr
+		case CommitsFile:
+			commitInfo := &pfs.CommitInfo{}
+			s.commits[entry.Key] = commitInfo
+			val = commitInfo
+		case BranchesFile:
+			branchInfo := &pfs.BranchInfo{}
+			s.branches[entry.Key] = branchInfo
+			val = branchInfo
+		default:
+			// Snapshots from newer versions may contain other collections
+			return nil
+		}
+		if err := jsonpb.Unmarshal(bytes.NewReader(entry.Value), val); err != nil {
+			return errors.Wrapf(err, "error reading %s snapshot entry %q", file, entry.Key)
+		}
+	}
+}
+
+func readK8sObjects(r io.Reader, file string, s *snapshot) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var err error
+		switch file {
+		case PodsFile:
+			pod := &v1.Pod{}
+			if err = dec.Decode(pod); err == nil {
+				s.pods = append(s.pods, pod)
+			}
+		case EventsFile:
+			event := &v1.Event{}
+			if err = dec.Decode(event); err == nil {
+				s.events = append(s.events, event)
+			}
+		default:
+			return nil
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return errors.Wrapf(err, "error reading %s snapshot", file)
+		}
+	}
+}
+
+func timestamp(ts *types.Timestamp) time.Time {
+	t, err := types.TimestampFromProto(ts)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+func commitKey(commit *pfs.Commit) string {
+	return path.Join(commit.Repo.Name, commit.ID)
+}
+
+func branchKey(branch *pfs.Branch) string {
+	return path.Join(branch.Repo.Name, branch.Name)
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]*pps.EtcdJobInfo:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*pfs.CommitInfo:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]*pfs.BranchInfo:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]map[string]*dto.MetricFamily:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkStuckJobs reports jobs that have been running for longer than
+// opts.StuckJobThreshold, or whose state is inconsistent with their output
+// commit or pipeline.
+func checkStuckJobs(s *snapshot, opts *Options) []*Finding {
+	var findings []*Finding
+	for _, id := range sortedKeys(s.jobs) {
+		jobPtr := s.jobs[id]
+		if ppsutil.IsTerminal(jobPtr.State) {
+			continue
+		}
+		subject := fmt.Sprintf("job %s (pipeline %s)", id, jobPtr.Pipeline.Name)
+		state := strings.ToLower(strings.TrimPrefix(jobPtr.State.String(), "JOB_"))
+		pipelinePtr, ok := s.pipelines[jobPtr.Pipeline.Name]
+		if !ok {
+			findings = append(findings, &Finding{
+				Subject: subject,
+				Message: fmt.Sprintf("job is %s, but its pipeline no longer exists", state),
+			})
+			continue
+		}
+		if jobPtr.OutputCommit != nil {
+			if commitInfo, ok := s.commits[commitKey(jobPtr.OutputCommit)]; ok && commitInfo.Finished != nil {
+				findings = append(findings, &Finding{
+					Subject: subject,
+					Message: fmt.Sprintf("job is %s, but its output commit %s was finished at %s", state, jobPtr.OutputCommit.ID, timestamp(commitInfo.Finished).Format(time.RFC3339)),
+				})
+				continue
+			}
+		}
+		if jobPtr.Started == nil {
+			continue
+		}
+		if running := s.time.Sub(timestamp(jobPtr.Started)); running > opts.StuckJobThreshold {
+			message := fmt.Sprintf("job has been %s for %s (%d of %d datums processed)", state, running.Round(time.Second), jobPtr.DataProcessed+jobPtr.DataSkipped, jobPtr.DataTotal)
+			if pipelinePtr.State != pps.PipelineState_PIPELINE_RUNNING {
+				message += fmt.Sprintf("; pipeline is %s", strings.ToLower(strings.TrimPrefix(pipelinePtr.State.String(), "PIPELINE_")))
+				if pipelinePtr.Reason != "" {
+					message += ": " + pipelinePtr.Reason
+				}
+			}
+			findings = append(findings, &Finding{Subject: subject, Message: message})
+		}
+	}
+	return findings
+}
+
+// checkCrashloopingWorkers reports worker containers that are in
+// CrashLoopBackOff or have restarted at least opts.RestartThreshold times.
+func checkCrashloopingWorkers(s *snapshot, opts *Options) []*Finding {
+	backOffs := make(map[string]int32)
+	for _, event := range s.events {
+		if event.Reason == "BackOff" {
+			backOffs[event.InvolvedObject.Name] += event.Count
+		}
+	}
+	var findings []*Finding
+	sort.Slice(s.pods, func(i, j int) bool { return s.pods[i].Name < s.pods[j].Name })
+	for _, pod := range s.pods {
+		subject := fmt.Sprintf("pod %s", pod.Name)
+		if pipeline, ok := pod.Labels["pipelineName"]; ok {
+			subject = fmt.Sprintf("pod %s (pipeline %s)", pod.Name, pipeline)
+		}
+		for _, status := range pod.Status.ContainerStatuses {
+			crashLooping := status.State.Waiting != nil && status.State.Waiting.Reason == "CrashLoopBackOff"
+			if !crashLooping && status.RestartCount < opts.RestartThreshold {
+				continue
+			}
+			message := fmt.Sprintf("container %q has restarted %d times", status.Name, status.RestartCount)
+			if crashLooping {
+				message += "; waiting: CrashLoopBackOff"
+			}
+			if terminated := status.LastTerminationState.Terminated; terminated != nil {
+				message += fmt.Sprintf("; last exit code %d (%s)", terminated.ExitCode, terminated.Reason)
+				if terminated.Message != "" {
+					message += ": " + strings.TrimSpace(terminated.Message)
+				}
+			}
+			if n := backOffs[pod.Name]; n > 0 {
+				message += fmt.Sprintf("; %d back-off events", n)
+			}
+			findings = append(findings, &Finding{Subject: subject, Message: message})
+		}
+	}
+	return findings
+}
+
+// checkOrphanedCommits reports open commits that nothing will finish: output
+// commits of pipelines with no running job for them, and commits in other
+// repos that have been open for longer than opts.OpenCommitThreshold.
+func checkOrphanedCommits(s *snapshot, opts *Options) []*Finding {
+	jobCommits := make(map[string]bool)
+	for _, jobPtr := range s.jobs {
+		if ppsutil.IsTerminal(jobPtr.State) {
+			continue
+		}
+		if jobPtr.OutputCommit != nil {
+			jobCommits[commitKey(jobPtr.OutputCommit)] = true
+		}
+		if jobPtr.StatsCommit != nil {
+			jobCommits[commitKey(jobPtr.StatsCommit)] = true
+		}
+	}
+	var findings []*Finding
+	for _, key := range sortedKeys(s.commits) {
+		commitInfo := s.commits[key]
+		if commitInfo.Finished != nil || commitInfo.Commit.Repo.Name == ppsconsts.SpecRepo {
+			continue
+		}
+		subject := fmt.Sprintf("commit %s@%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+		open := s.time.Sub(timestamp(commitInfo.Started)).Round(time.Second)
+		if _, ok := s.pipelines[commitInfo.Commit.Repo.Name]; ok {
+			if !jobCommits[key] {
+				findings = append(findings, &Finding{
+					Subject: subject,
+					Message: fmt.Sprintf("output commit has been open for %s, but no running job will finish it", open),
+				})
+			}
+			continue
+		}
+		if open > opts.OpenCommitThreshold {
+			message := fmt.Sprintf("commit has been open for %s", open)
+			if len(commitInfo.Subvenance) > 0 {
+				message += "; downstream pipelines are waiting for it"
+			}
+			findings = append(findings, &Finding{Subject: subject, Message: message})
+		}
+	}
+	return findings
+}
+
+// checkProvenanceCycles reports cycles in the branch provenance graph, which
+// pachd should never allow.
+func checkProvenanceCycles(s *snapshot, opts *Options) []*Finding {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	seenCycles := make(map[string]bool)
+	var stack []string
+	var findings []*Finding
+	var visit func(key string)
+	visit = func(key string) {
+		state[key] = visiting
+		stack = append(stack, key)
+		if branchInfo, ok := s.branches[key]; ok {
+			for _, prov := range branchInfo.DirectProvenance {
+				provKey := branchKey(prov)
+				switch state[provKey] {
+				case unvisited:
+					visit(provKey)
+				case visiting:
+					// Found a cycle: the part of the stack from provKey to key
+					var start int
+					for start = len(stack) - 1; stack[start] != provKey; start-- {
+					}
+					cycle := append([]string{}, stack[start:]...)
+					// Rotate the cycle so that it starts at its smallest branch,
+					// so that each cycle is only reported once
+					min := 0
+					for i := range cycle {
+						if cycle[i] < cycle[min] {
+							min = i
+						}
+					}
+					cycle = append(cycle[min:], cycle[:min]...)
+					id := strings.Join(cycle, " ")
+					if seenCycles[id] {
+						continue
+					}
+					seenCycles[id] = true
+					var names []string
+					for _, b := range append(cycle, cycle[0]) {
+						names = append(names, strings.Replace(b, "/", "@", 1))
+					}
+					findings = append(findings, &Finding{
+						Subject: fmt.Sprintf("branch %s", names[0]),
+						Message: fmt.Sprintf("provenance cycle: %s", strings.Join(names, " <- ")),
+					})
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[key] = visited
+	}
+	for _, key := range sortedKeys(s.branches) {
+		if state[key] == unvisited {
+			visit(key)
+		}
+	}
+	return findings
+}
+
+// gaugeValue returns the value of the (unlabelled) gauge named name in
+// families, if present
+func gaugeValue(families map[string]*dto.MetricFamily, name string) (float64, bool) {
+	family, ok := families[name]
+	if !ok || len(family.Metric) == 0 || family.Metric[0].Gauge == nil {
+		return 0, false
+	}
+	return family.Metric[0].Gauge.GetValue(), true
+}
+
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
+
+// checkGCLag reports garbage collectors that haven't completed a pass in
+// gcLagPolls polling intervals.
+func checkGCLag(s *snapshot, opts *Options) []*Finding {
+	var findings []*Finding
+	for _, dir := range sortedKeys(s.metrics) {
+		families := s.metrics[dir]
+		lastPass, ok := gaugeValue(families, gcLastPassMetric)
+		if !ok {
+			// This process doesn't run the garbage collector
+			continue
+		}
+		polling := defaultGCPolling
+		if seconds, ok := gaugeValue(families, gcPollingMetric); ok && seconds > 0 {
+			polling = time.Duration(seconds * float64(time.Second))
+		}
+		maxLag := gcLagPolls * polling
+		subject := fmt.Sprintf("garbage collector in %s", dir)
+		if lastPass == 0 {
+			// No pass has completed yet; this is only a problem if the
+			// process has been running for long enough to complete one
+			if start, ok := gaugeValue(families, processStartMetric); ok {
+				if running := s.time.Sub(unixTime(start)); running > maxLag {
+					findings = append(findings, &Finding{
+						Subject: subject,
+						Message: fmt.Sprintf("no pass has completed since the process started %s ago (polling every %s)", running.Round(time.Second), polling),
+					})
+				}
+			}
+			continue
+		}
+		if lag := s.time.Sub(unixTime(lastPass)); lag > maxLag {
+			findings = append(findings, &Finding{
+				Subject: subject,
+				Message: fmt.Sprintf("last pass completed %s ago (polling every %s)", lag.Round(time.Second), polling),
+			})
+		}
+	}
+	return findings
+}
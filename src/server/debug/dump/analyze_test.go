@@ -0,0 +1,224 @@
+package dump
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testDump struct {
+	tw *tar.Writer
+}
+
+func (d *testDump) write(t *testing.T, name string, content []byte) {
+	require.NoError(t, d.tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(content)), Mode: 0777}))
+	_, err := d.tw.Write(content)
+	require.NoError(t, err)
+}
+
+func (d *testDump) writeEntries(t *testing.T, file string, entries map[string]interface{}) {
+	var buf bytes.Buffer
+	w := NewEntryWriter(&buf)
+	for key, val := range entries {
+		switch val := val.(type) {
+		case *pps.EtcdPipelineInfo:
+			require.NoError(t, w.Write(key, val))
+		case *pps.EtcdJobInfo:
+			require.NoError(t, w.Write(key, val))
+		case *pfs.CommitInfo:
+			require.NoError(t, w.Write(key, val))
+		case *pfs.BranchInfo:
+			require.NoError(t, w.Write(key, val))
+		}
+	}
+	d.write(t, path.Join(ClusterPrefix, EtcdPrefix, file), buf.Bytes())
+}
+
+func (d *testDump) writeObjects(t *testing.T, file string, objects ...interface{}) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, o := range objects {
+		require.NoError(t, enc.Encode(o))
+	}
+	d.write(t, path.Join(ClusterPrefix, K8sPrefix, file), buf.Bytes())
+}
+
+func withTestDump(t *testing.T, cb func(*testDump)) *bytes.Buffer {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	d := &testDump{tw: tar.NewWriter(gw)}
+	cb(d)
+	require.NoError(t, d.tw.Close())
+	require.NoError(t, gw.Close())
+	return &buf
+}
+
+func ts(t *testing.T, tm time.Time) *types.Timestamp {
+	result, err := types.TimestampProto(tm)
+	require.NoError(t, err)
+	return result
+}
+
+func findings(report *Report, check string) []string {
+	var result []string
+	for _, f := range report.Findings {
+		if f.Check == check {
+			result = append(result, fmt.Sprintf("%s: %s", f.Subject, f.Message))
+		}
+	}
+	return result
+}
+
+func TestAnalyze(t *testing.T) {
+	now := time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)
+	dump := withTestDump(t, func(d *testDump) {
+		d.write(t, path.Join(ClusterPrefix, TimeFile), []byte(now.Format(time.RFC3339)+"\n"))
+		d.writeEntries(t, PipelinesFile, map[string]interface{}{
+			"edges": &pps.EtcdPipelineInfo{State: pps.PipelineState_PIPELINE_CRASHING, Reason: "image pull failed"},
+		})
+		d.writeEntries(t, JobsFile, map[string]interface{}{
+			"j1": &pps.EtcdJobInfo{
+				Job:          client.NewJob("j1"),
+				Pipeline:     client.NewPipeline("edges"),
+				OutputCommit: client.NewCommit("edges", "c1"),
+				State:        pps.JobState_JOB_RUNNING,
+				Started:      ts(t, now.Add(-3*time.Hour)),
+				DataTotal:    10,
+			},
+			"j2": &pps.EtcdJobInfo{
+				Job:          client.NewJob("j2"),
+				Pipeline:     client.NewPipeline("edges"),
+				OutputCommit: client.NewCommit("edges", "c5"),
+				State:        pps.JobState_JOB_RUNNING,
+				Started:      ts(t, now.Add(-time.Minute)),
+			},
+			"j3": &pps.EtcdJobInfo{
+				Job:      client.NewJob("j3"),
+				Pipeline: client.NewPipeline("deleted"),
+				State:    pps.JobState_JOB_STARTING,
+			},
+			"j4": &pps.EtcdJobInfo{
+				Job:          client.NewJob("j4"),
+				Pipeline:     client.NewPipeline("edges"),
+				OutputCommit: client.NewCommit("edges", "c6"),
+				State:        pps.JobState_JOB_SUCCESS,
+				Started:      ts(t, now.Add(-5*time.Hour)),
+			},
+		})
+		d.writeEntries(t, CommitsFile, map[string]interface{}{
+			"edges/c1":  &pfs.CommitInfo{Commit: client.NewCommit("edges", "c1"), Started: ts(t, now.Add(-3*time.Hour))},
+			"edges/c2":  &pfs.CommitInfo{Commit: client.NewCommit("edges", "c2"), Started: ts(t, now.Add(-2*time.Hour))},
+			"edges/c5":  &pfs.CommitInfo{Commit: client.NewCommit("edges", "c5"), Started: ts(t, now.Add(-time.Minute)), Finished: ts(t, now)},
+			"edges/c6":  &pfs.CommitInfo{Commit: client.NewCommit("edges", "c6"), Started: ts(t, now.Add(-5*time.Hour)), Finished: ts(t, now)},
+			"images/c3": &pfs.CommitInfo{Commit: client.NewCommit("images", "c3"), Started: ts(t, now.Add(-48*time.Hour))},
+			"images/c4": &pfs.CommitInfo{Commit: client.NewCommit("images", "c4"), Started: ts(t, now.Add(-48*time.Hour)), Finished: ts(t, now)},
+			"images/c7": &pfs.CommitInfo{Commit: client.NewCommit("images", "c7"), Started: ts(t, now.Add(-time.Hour))},
+		})
+		d.writeEntries(t, BranchesFile, map[string]interface{}{
+			"a/master": &pfs.BranchInfo{Branch: client.NewBranch("a", "master"), DirectProvenance: []*pfs.Branch{client.NewBranch("b", "master")}},
+			"b/master": &pfs.BranchInfo{Branch: client.NewBranch("b", "master"), DirectProvenance: []*pfs.Branch{client.NewBranch("a", "master")}},
+			"c/master": &pfs.BranchInfo{Branch: client.NewBranch("c", "master"), DirectProvenance: []*pfs.Branch{client.NewBranch("a", "master")}},
+			"d/master": &pfs.BranchInfo{Branch: client.NewBranch("d", "master")},
+		})
+		d.writeObjects(t, PodsFile,
+			&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pipeline-edges-v1-abcde", Labels: map[string]string{"pipelineName": "edges"}},
+				Status: v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{
+					{
+						Name:         "user",
+						RestartCount: 5,
+						State:        v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+						LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{
+							ExitCode: 1,
+							Reason:   "Error",
+						}},
+					},
+					{Name: "storage", RestartCount: 1},
+				}},
+			},
+			&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pipeline-other-v1-fghij", Labels: map[string]string{"pipelineName": "other"}},
+				Status:     v1.PodStatus{ContainerStatuses: []v1.ContainerStatus{{Name: "user"}}},
+			},
+		)
+		d.writeObjects(t, EventsFile,
+			&v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "pipeline-edges-v1-abcde"}, Reason: "BackOff", Count: 4},
+			&v1.Event{InvolvedObject: v1.ObjectReference{Kind: "Pod", Name: "pipeline-edges-v1-abcde"}, Reason: "Pulled", Count: 6},
+		)
+		d.write(t, "pachd/pachd-1/pachd/metrics", []byte(fmt.Sprintf(`# TYPE pachyderm_storage_gc_last_pass_timestamp_seconds gauge
+pachyderm_storage_gc_last_pass_timestamp_seconds %d
+# TYPE pachyderm_storage_gc_polling_seconds gauge
+pachyderm_storage_gc_polling_seconds 300
+`, now.Add(-time.Hour).Unix())))
+		d.write(t, "pachd/pachd-2/pachd/metrics", []byte(fmt.Sprintf(`# TYPE pachyderm_storage_gc_last_pass_timestamp_seconds gauge
+pachyderm_storage_gc_last_pass_timestamp_seconds %d
+`, now.Add(-time.Minute).Unix())))
+	})
+
+	data := dump.Bytes()
+	report, err := Analyze(bytes.NewReader(data), nil)
+	require.NoError(t, err)
+	require.True(t, now.Equal(report.Time))
+	require.Equal(t, 0, len(report.Skipped))
+	require.Equal(t, []string{
+		"job j1 (pipeline edges): job has been running for 3h0m0s (0 of 10 datums processed); pipeline is crashing: image pull failed",
+		"job j2 (pipeline edges): job is running, but its output commit c5 was finished at 2020-06-01T12:00:00Z",
+		"job j3 (pipeline deleted): job is starting, but its pipeline no longer exists",
+	}, findings(report, "stuck jobs"))
+	require.Equal(t, []string{
+		`pod pipeline-edges-v1-abcde (pipeline edges): container "user" has restarted 5 times; waiting: CrashLoopBackOff; last exit code 1 (Error); 4 back-off events`,
+	}, findings(report, "crashlooping workers"))
+	require.Equal(t, []string{
+		"commit edges@c2: output commit has been open for 2h0m0s, but no running job will finish it",
+		"commit images@c3: commit has been open for 48h0m0s",
+	}, findings(report, "orphaned open commits"))
+	require.Equal(t, []string{
+		"branch a@master: provenance cycle: a@master <- b@master <- a@master",
+	}, findings(report, "branch provenance cycles"))
+	require.Equal(t, []string{
+		"garbage collector in pachd/pachd-1/pachd: last pass completed 1h0m0s ago (polling every 5m0s)",
+	}, findings(report, "gc lag"))
+
+	// Stricter thresholds report more
+	report, err = Analyze(bytes.NewReader(data), &Options{
+		StuckJobThreshold:   time.Hour,
+		OpenCommitThreshold: 30 * time.Minute,
+		RestartThreshold:    1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(findings(report, "crashlooping workers")))
+	require.Equal(t, 3, len(findings(report, "orphaned open commits")))
+}
+
+func TestAnalyzeMissingSnapshot(t *testing.T) {
+	// A dump filtered to a single pipeline has no cluster snapshot, and a
+	// failure to collect part of the snapshot is recorded in the dump
+	dump := withTestDump(t, func(d *testDump) {
+		d.write(t, path.Join(ClusterPrefix, EtcdPrefix, JobsFile, "error"), []byte("etcdserver: request timed out\n"))
+		d.writeEntries(t, BranchesFile, map[string]interface{}{
+			"a/master": &pfs.BranchInfo{Branch: client.NewBranch("a", "master")},
+		})
+	})
+	report, err := Analyze(dump, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(report.Findings))
+	require.Equal(t, []string{
+		"stuck jobs: dump has no jobs (etcdserver: request timed out), pipelines (not collected), commits (not collected)",
+		"crashlooping workers: dump has no pods (not collected), events (not collected)",
+		"orphaned open commits: dump has no commits (not collected), jobs (etcdserver: request timed out), pipelines (not collected)",
+		"gc lag: dump has no metrics (not collected)",
+	}, report.Skipped)
+}
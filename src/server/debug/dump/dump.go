@@ -0,0 +1,78 @@
+// Package dump describes the snapshot of cluster state that is included in
+// debug dumps, and implements the offline checks run by
+// 'pachctl debug analyze'.
+package dump
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	// ClusterPrefix is the directory of a debug dump that holds the snapshot
+	// of cluster state.
+	ClusterPrefix = "cluster"
+	// TimeFile holds the time at which the snapshot was taken, in RFC3339
+	// format.
+	TimeFile = "time"
+
+	// EtcdPrefix is the directory (under ClusterPrefix) that holds snapshots
+	// of etcd collections.  Each file contains one JSON-encoded Entry per
+	// line.
+	EtcdPrefix = "etcd"
+	// PipelinesFile holds the EtcdPipelineInfos of all pipelines.
+	PipelinesFile = "pipelines"
+	// JobsFile holds the EtcdJobInfos of all jobs.
+	JobsFile = "jobs"
+	// CommitsFile holds the CommitInfos of all commits, keyed by
+	// "<repo>/<commit ID>".
+	CommitsFile = "commits"
+	// BranchesFile holds the BranchInfos of all branches, keyed by
+	// "<repo>/<branch>".
+	BranchesFile = "branches"
+
+	// K8sPrefix is the directory (under ClusterPrefix) that holds snapshots
+	// of kubernetes objects.  Each file contains one JSON-encoded object per
+	// line.
+	K8sPrefix = "k8s"
+	// PodsFile holds the metadata and status of all worker pods.
+	PodsFile = "pods"
+	// EventsFile holds the kubernetes events involving worker pods.
+	EventsFile = "events"
+
+	// MetricsFile is the name of the Prometheus metrics snapshot (in the text
+	// exposition format) that is collected from pachd and each worker.
+	MetricsFile = "metrics"
+)
+
+// Entry is a single key-value pair from an etcd snapshot.
+type Entry struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// EntryWriter writes etcd snapshot entries, one per line.
+type EntryWriter struct {
+	enc        *json.Encoder
+	marshaller *jsonpb.Marshaler
+}
+
+// NewEntryWriter returns an EntryWriter that writes to w.
+func NewEntryWriter(w io.Writer) *EntryWriter {
+	return &EntryWriter{
+		enc:        json.NewEncoder(w),
+		marshaller: &jsonpb.Marshaler{},
+	}
+}
+
+// Write writes the entry for key and val.
+func (w *EntryWriter) Write(key string, val proto.Message) error {
+	value, err := w.marshaller.MarshalToString(val)
+	if err != nil {
+		return err
+	}
+	return w.enc.Encode(&Entry{Key: key, Value: json.RawMessage(value)})
+}
@@ -0,0 +1,80 @@
+package profiler
+
+import (
+	"context"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+	log "github.com/sirupsen/logrus"
+)
+
+// Option configures the profiler.
+type Option func(p *Profiler)
+
+// WithDir sets the directory that profiles are stored in.
+func WithDir(dir string) Option {
+	return func(p *Profiler) {
+		p.dir = dir
+	}
+}
+
+// WithInterval sets how often profiles are sampled.
+func WithInterval(interval time.Duration) Option {
+	return func(p *Profiler) {
+		p.interval = interval
+	}
+}
+
+// WithCPUDuration sets how long each CPU profile sample runs for.
+func WithCPUDuration(cpuDuration time.Duration) Option {
+	return func(p *Profiler) {
+		p.cpuDuration = cpuDuration
+	}
+}
+
+// WithRetention sets how long sampled profiles are kept for.
+func WithRetention(retention time.Duration) Option {
+	return func(p *Profiler) {
+		p.retention = retention
+	}
+}
+
+// ServiceEnvToOptions converts a service environment configuration
+// (specifically the profiling configuration) to a set of options.
+func ServiceEnvToOptions(env *serviceenv.ServiceEnv) ([]Option, error) {
+	var opts []Option
+	for _, d := range []struct {
+		value  string
+		option func(time.Duration) Option
+	}{
+		{env.ProfilingInterval, WithInterval},
+		{env.ProfilingCPUDuration, WithCPUDuration},
+		{env.ProfilingRetention, WithRetention},
+	} {
+		if d.value == "" {
+			continue
+		}
+		duration, err := time.ParseDuration(d.value)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, d.option(duration))
+	}
+	return opts, nil
+}
+
+// StartWithServiceEnv creates a profiler configured by the service
+// environment, and runs it in the background for the life of the process.
+func StartWithServiceEnv(env *serviceenv.ServiceEnv) (*Profiler, error) {
+	opts, err := ServiceEnvToOptions(env)
+	if err != nil {
+		return nil, err
+	}
+	p := NewProfiler(opts...)
+	go func() {
+		if err := p.Run(context.Background()); err != nil {
+			log.Errorf("error from continuous profiler: %v", err)
+		}
+	}()
+	return p, nil
+}
@@ -0,0 +1,277 @@
+// Package profiler continuously samples CPU and heap profiles at a low duty
+// cycle and keeps a rolling window of them on disk, so that a process's
+// profile can be inspected for a time range after the fact (e.g. to diagnose
+// a slowdown that happened an hour ago).
+package profiler
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// CPU is the name of the continuously sampled CPU profile.
+	CPU = "cpu"
+	// Heap is the name of the continuously sampled heap profile.
+	Heap = "heap"
+
+	defaultInterval    = time.Minute
+	defaultCPUDuration = 10 * time.Second
+	defaultRetention   = 6 * time.Hour
+	sampleExt          = ".pb.gz"
+)
+
+// cpuMu serializes CPU profiles, as only one can run in a process at a time.
+var cpuMu sync.Mutex
+
+// WriteCPUProfile writes a CPU profile of the given duration to w. Both
+// on-demand profiles and continuous samples go through this function, so
+// that they wait for each other rather than failing.
+func WriteCPUProfile(ctx context.Context, w io.Writer, duration time.Duration) error {
+	cpuMu.Lock()
+	defer cpuMu.Unlock()
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	defer pprof.StopCPUProfile()
+	select {
+	case <-time.After(duration):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Profiler continuously samples profiles for the current process.
+type Profiler struct {
+	dir         string
+	interval    time.Duration
+	cpuDuration time.Duration
+	retention   time.Duration
+}
+
+// NewProfiler creates a new profiler. A CPU profile is sampled for
+// cpuDuration out of every interval, and a heap profile once every interval.
+// An interval of zero disables continuous profiling.
+func NewProfiler(opts ...Option) *Profiler {
+	p := &Profiler{
+		dir:         filepath.Join(os.TempDir(), "pachyderm_profiles"),
+		interval:    defaultInterval,
+		cpuDuration: defaultCPUDuration,
+		retention:   defaultRetention,
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	if p.cpuDuration > p.interval {
+		p.cpuDuration = p.interval
+	}
+	return p
+}
+
+// Run samples profiles until the context is canceled.
+func (p *Profiler) Run(ctx context.Context) error {
+	if p.interval <= 0 {
+		return nil
+	}
+	if err := os.MkdirAll(p.dir, 0700); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		if err := p.sample(ctx); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Errorf("error sampling profiles: %v", err)
+		}
+		if err := p.prune(time.Now()); err != nil {
+			log.Errorf("error pruning profiles: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (p *Profiler) sample(ctx context.Context) error {
+	if err := p.writeSample(Heap, func(w io.Writer) error {
+		return pprof.Lookup("heap").WriteTo(w, 0)
+	}); err != nil {
+		return err
+	}
+	return p.writeSample(CPU, func(w io.Writer) error {
+		return WriteCPUProfile(ctx, w, p.cpuDuration)
+	})
+}
+
+// writeSample writes a sample to a temporary file, and then renames it to a
+// name that records the time range that the sample covers, so that partial
+// samples are never merged.
+func (p *Profiler) writeSample(name string, cb func(io.Writer) error) (retErr error) {
+	f, err := ioutil.TempFile(p.dir, "."+name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			os.Remove(f.Name())
+		}
+	}()
+	start := time.Now()
+	if err := cb(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	end := time.Now()
+	if name == Heap {
+		// A heap profile is a snapshot at a point in time.
+		start = end
+	}
+	return os.Rename(f.Name(), filepath.Join(p.dir, sampleName(name, start, end)))
+}
+
+type sample struct {
+	path       string
+	start, end time.Time
+}
+
+func sampleName(name string, start, end time.Time) string {
+	return strings.Join([]string{
+		name,
+		strconv.FormatInt(start.UnixNano(), 10),
+		strconv.FormatInt(end.UnixNano(), 10),
+	}, "-") + sampleExt
+}
+
+// listSamples lists the samples of the named profile (or of all profiles if
+// name is empty), ordered by start time.
+func (p *Profiler) listSamples(name string) ([]*sample, error) {
+	fis, err := ioutil.ReadDir(p.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var samples []*sample
+	for _, fi := range fis {
+		parts := strings.Split(strings.TrimSuffix(fi.Name(), sampleExt), "-")
+		if !strings.HasSuffix(fi.Name(), sampleExt) || len(parts) != 3 {
+			continue
+		}
+		if name != "" && parts[0] != name {
+			continue
+		}
+		start, err := strconv.ParseInt(parts[1], 10, 64)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			continue
+		}
+		samples = append(samples, &sample{
+			path:  filepath.Join(p.dir, fi.Name()),
+			start: time.Unix(0, start),
+			end:   time.Unix(0, end),
+		})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return samples[i].start.Before(samples[j].start)
+	})
+	return samples, nil
+}
+
+// prune deletes the samples that ended before the retention window.
+func (p *Profiler) prune(now time.Time) error {
+	samples, err := p.listSamples("")
+	if err != nil {
+		return err
+	}
+	for _, s := range samples {
+		if now.Sub(s.end) <= p.retention {
+			continue
+		}
+		if err := os.Remove(s.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merge writes the samples of the named profile that overlap the time range
+// [from, to] to w as a single profile. CPU samples are summed, while heap
+// samples are averaged, so a merged heap profile shows the mean heap usage
+// over the range.
+func (p *Profiler) Merge(w io.Writer, name string, from, to time.Time) error {
+	if name != CPU && name != Heap {
+		return errors.Errorf("profile %q is not continuously collected, only %q and %q are", name, CPU, Heap)
+	}
+	if p.interval <= 0 {
+		return errors.Errorf("continuous profiling is disabled")
+	}
+	samples, err := p.listSamples(name)
+	if err != nil {
+		return err
+	}
+	var profiles []*profile.Profile
+	for _, s := range samples {
+		if s.end.Before(from) || s.start.After(to) {
+			continue
+		}
+		prof, err := readProfile(s.path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				// The sample was pruned after it was listed.
+				continue
+			}
+			return err
+		}
+		profiles = append(profiles, prof)
+	}
+	if len(profiles) == 0 {
+		return errors.Errorf("no %s profiles were collected between %s and %s (profiles are kept for %v)",
+			name, from.Format(time.RFC3339), to.Format(time.RFC3339), p.retention)
+	}
+	merged, err := profile.Merge(profiles)
+	if err != nil {
+		return errors.Wrapf(err, "could not merge %s profiles", name)
+	}
+	if name == Heap {
+		merged.Scale(1 / float64(len(profiles)))
+	}
+	return merged.Write(w)
+}
+
+func readProfile(path string) (retProf *profile.Profile, retErr error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); retErr == nil {
+			retErr = err
+		}
+	}()
+	return profile.Parse(f)
+}
@@ -0,0 +1,79 @@
+package profiler
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/pprof/profile"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func withTestProfiler(t *testing.T, cb func(*Profiler), opts ...Option) {
+	dir, err := ioutil.TempDir("", "profiler_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cb(NewProfiler(append([]Option{WithDir(dir)}, opts...)...))
+}
+
+func TestMerge(t *testing.T) {
+	withTestProfiler(t, func(p *Profiler) {
+		start := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+		defer cancel()
+		require.YesError(t, p.Run(ctx))
+		end := time.Now()
+
+		samples, err := p.listSamples(CPU)
+		require.NoError(t, err)
+		require.True(t, len(samples) > 1)
+		for _, s := range samples {
+			require.True(t, !s.start.Before(start) && !s.end.After(end))
+			require.True(t, s.end.Sub(s.start) >= 20*time.Millisecond)
+		}
+
+		merge := func(name string, from, to time.Time) *profile.Profile {
+			buf := &bytes.Buffer{}
+			require.NoError(t, p.Merge(buf, name, from, to))
+			merged, err := profile.Parse(buf)
+			require.NoError(t, err)
+			require.NoError(t, merged.CheckValid())
+			return merged
+		}
+		merge(Heap, start, end)
+		// CPU samples are summed, and only the samples in the range are merged
+		all := merge(CPU, start, end)
+		first := merge(CPU, samples[0].start, samples[0].end)
+		require.True(t, all.DurationNanos > first.DurationNanos)
+
+		require.YesError(t, p.Merge(&bytes.Buffer{}, CPU, start.Add(-time.Hour), start.Add(-time.Minute)))
+		require.YesError(t, p.Merge(&bytes.Buffer{}, "goroutine", start, end))
+	}, WithInterval(100*time.Millisecond), WithCPUDuration(20*time.Millisecond))
+}
+
+func TestPrune(t *testing.T) {
+	withTestProfiler(t, func(p *Profiler) {
+		now := time.Now()
+		require.NoError(t, os.MkdirAll(p.dir, 0700))
+		for _, end := range []time.Time{now.Add(-3 * time.Hour), now.Add(-90 * time.Minute), now.Add(-time.Minute)} {
+			name := sampleName(CPU, end.Add(-10*time.Second), end)
+			require.NoError(t, ioutil.WriteFile(filepath.Join(p.dir, name), nil, 0600))
+		}
+		require.NoError(t, p.prune(now))
+		samples, err := p.listSamples("")
+		require.NoError(t, err)
+		require.Equal(t, 1, len(samples))
+		require.True(t, samples[0].end.Equal(time.Unix(0, now.Add(-time.Minute).UnixNano())))
+	}, WithRetention(time.Hour))
+}
+
+func TestDisabled(t *testing.T) {
+	withTestProfiler(t, func(p *Profiler) {
+		require.NoError(t, p.Run(context.Background()))
+		require.YesError(t, p.Merge(&bytes.Buffer{}, CPU, time.Time{}, time.Now()))
+	}, WithInterval(0))
+}
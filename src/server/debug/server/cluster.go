@@ -0,0 +1,208 @@
+package server
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"path"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/debug/dump"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// collectCluster collects a snapshot of the cluster's state (etcd metadata
+// and kubernetes objects), for offline analysis by 'pachctl debug analyze'.
+// Errors are recorded in the dump rather than returned, so that a partial
+// snapshot is still collected. The snapshot includes every repo's commits and
+// every pipeline's spec, so if auth is active, only cluster admins (the
+// caller in 'ctx') may collect it.
+func (s *debugServer) collectCluster(ctx context.Context, tw *tar.Writer) error {
+	pachClient := s.env.GetPachClient(ctx)
+	me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return writeErrorFile(tw, err, dump.ClusterPrefix)
+	}
+	if err == nil && !me.IsAdmin {
+		return writeErrorFile(tw, &auth.ErrNotAuthorized{
+			Subject: me.Username,
+			AdminOp: "Dump (for the cluster snapshot)",
+		}, dump.ClusterPrefix)
+	}
+	if err := collectDebugFile(tw, dump.TimeFile, func(w io.Writer) error {
+		_, err := io.WriteString(w, time.Now().UTC().Format(time.RFC3339)+"\n")
+		return err
+	}, dump.ClusterPrefix); err != nil {
+		return err
+	}
+	etcdPrefix := join(dump.ClusterPrefix, dump.EtcdPrefix)
+	for _, f := range []struct {
+		name    string
+		collect func(*dump.EntryWriter) error
+	}{
+		{dump.PipelinesFile, s.collectPipelines},
+		{dump.JobsFile, s.collectJobs},
+		{dump.CommitsFile, s.collectCommits},
+		{dump.BranchesFile, s.collectBranches},
+	} {
+		collect := f.collect
+		if err := collectDebugFile(tw, f.name, func(w io.Writer) error {
+			return collect(dump.NewEntryWriter(w))
+		}, etcdPrefix); err != nil {
+			return err
+		}
+	}
+	k8sPrefix := join(dump.ClusterPrefix, dump.K8sPrefix)
+	pods, err := s.env.GetKubeClient().CoreV1().Pods(s.env.Namespace).List(metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(metav1.SetAsLabelSelector(
+			map[string]string{
+				"component": "worker",
+			})),
+	})
+	if err != nil {
+		return writeErrorFile(tw, err, k8sPrefix)
+	}
+	if err := collectDebugFile(tw, dump.PodsFile, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+		for _, pod := range pods.Items {
+			// Only the pod's status is collected, as the spec may contain
+			// secrets passed to the pipeline through environment variables
+			if err := enc.Encode(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:              pod.Name,
+					Labels:            pod.Labels,
+					CreationTimestamp: pod.CreationTimestamp,
+				},
+				Status: pod.Status,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, k8sPrefix); err != nil {
+		return err
+	}
+	return collectDebugFile(tw, dump.EventsFile, func(w io.Writer) error {
+		workerPods := make(map[string]bool)
+		for _, pod := range pods.Items {
+			workerPods[pod.Name] = true
+		}
+		events, err := s.env.GetKubeClient().CoreV1().Events(s.env.Namespace).List(metav1.ListOptions{
+			FieldSelector: "involvedObject.kind=Pod",
+		})
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(w)
+		for _, event := range events.Items {
+			if !workerPods[event.InvolvedObject.Name] {
+				continue
+			}
+			if err := enc.Encode(&event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, k8sPrefix)
+}
+
+func (s *debugServer) ppsEtcdPrefix() string {
+	return path.Join(s.env.EtcdPrefix, s.env.PPSEtcdPrefix)
+}
+
+func (s *debugServer) pfsEtcdPrefix() string {
+	return path.Join(s.env.EtcdPrefix, s.env.PFSEtcdPrefix)
+}
+
+func (s *debugServer) collectPipelines(w *dump.EntryWriter) error {
+	return writePipelines(ppsdb.Pipelines(s.env.GetEtcdClient(), s.ppsEtcdPrefix()).ReadOnly(context.Background()), w)
+}
+
+// writePipelines writes the pipelines in 'pipelines' to 'w', without their
+// auth tokens (debug dumps are shared outside the cluster)
+func writePipelines(pipelines col.ReadonlyCollection, w *dump.EntryWriter) error {
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	return pipelines.List(pipelinePtr, col.DefaultOptions, func(name string) error {
+		redacted := proto.Clone(pipelinePtr).(*pps.EtcdPipelineInfo)
+		redacted.AuthToken = ""
+		return w.Write(name, redacted)
+	})
+}
+
+func (s *debugServer) collectJobs(w *dump.EntryWriter) error {
+	jobPtr := &pps.EtcdJobInfo{}
+	return ppsdb.Jobs(s.env.GetEtcdClient(), s.ppsEtcdPrefix()).ReadOnly(context.Background()).List(jobPtr, col.DefaultOptions, func(id string) error {
+		return w.Write(id, jobPtr)
+	})
+}
+
+func (s *debugServer) listRepos() ([]string, error) {
+	var repos []string
+	repoInfo := &pfs.RepoInfo{}
+	if err := pfsdb.Repos(s.env.GetEtcdClient(), s.pfsEtcdPrefix()).ReadOnly(context.Background()).List(repoInfo, col.DefaultOptions, func(name string) error {
+		repos = append(repos, name)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+func (s *debugServer) collectCommits(w *dump.EntryWriter) error {
+	repos, err := s.listRepos()
+	if err != nil {
+		return err
+	}
+	commitInfo := &pfs.CommitInfo{}
+	for _, repo := range repos {
+		if err := pfsdb.Commits(s.env.GetEtcdClient(), s.pfsEtcdPrefix(), repo).ReadOnly(context.Background()).List(commitInfo, col.DefaultOptions, func(id string) error {
+			return w.Write(join(repo, id), commitInfo)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *debugServer) collectBranches(w *dump.EntryWriter) error {
+	repos, err := s.listRepos()
+	if err != nil {
+		return err
+	}
+	branchInfo := &pfs.BranchInfo{}
+	for _, repo := range repos {
+		if err := pfsdb.Branches(s.env.GetEtcdClient(), s.pfsEtcdPrefix(), repo).ReadOnly(context.Background()).List(branchInfo, col.DefaultOptions, func(name string) error {
+			return w.Write(join(repo, name), branchInfo)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func collectMetrics(tw *tar.Writer, prefix ...string) error {
+	return collectDebugFile(tw, dump.MetricsFile, func(w io.Writer) error {
+		families, err := prometheus.DefaultGatherer.Gather()
+		if err != nil {
+			return err
+		}
+		enc := expfmt.NewEncoder(w, expfmt.FmtText)
+		for _, family := range families {
+			if err := enc.Encode(family); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, prefix...)
+}
@@ -0,0 +1,37 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/debug/dump"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testetcd"
+)
+
+func TestWritePipelinesRedactsAuthTokens(t *testing.T) {
+	require.NoError(t, testetcd.WithEnv(func(e *testetcd.Env) error {
+		pipelines := ppsdb.Pipelines(e.EtcdClient, "")
+		if _, err := col.NewSTM(context.Background(), e.EtcdClient, func(stm col.STM) error {
+			return pipelines.ReadWrite(stm).Put("edges", &pps.EtcdPipelineInfo{
+				State:      pps.PipelineState_PIPELINE_RUNNING,
+				SpecCommit: client.NewCommit("__spec__", "abc"),
+				AuthToken:  "secret-token",
+			})
+		}); err != nil {
+			return err
+		}
+		var buf bytes.Buffer
+		require.NoError(t, writePipelines(pipelines.ReadOnly(context.Background()), dump.NewEntryWriter(&buf)))
+		require.True(t, bytes.Contains(buf.Bytes(), []byte("edges")), buf.String())
+		require.True(t, bytes.Contains(buf.Bytes(), []byte("PIPELINE_RUNNING")), buf.String())
+		require.False(t, bytes.Contains(buf.Bytes(), []byte("secret-token")), buf.String())
+		require.False(t, bytes.Contains(buf.Bytes(), []byte("authToken")), buf.String())
+		return nil
+	}))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/debug/profiler"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	"github.com/pachyderm/pachyderm/src/server/pps/pretty"
@@ -30,12 +31,15 @@ const (
 	podPrefix       = "pods"
 )
 
-// NewDebugServer creates a new server that serves the debug api over GRPC
-func NewDebugServer(env *serviceenv.ServiceEnv, name string, sidecarClient *client.APIClient) debug.DebugServer {
+// NewDebugServer creates a new server that serves the debug api over GRPC.
+// The profiler is the process's continuous profiler, which serves
+// ProfileRange requests.
+func NewDebugServer(env *serviceenv.ServiceEnv, name string, sidecarClient *client.APIClient, profiler *profiler.Profiler) debug.DebugServer {
 	return &debugServer{
 		env:           env,
 		name:          name,
 		sidecarClient: sidecarClient,
+		profiler:      profiler,
 	}
 }
 
@@ -43,6 +47,7 @@ type debugServer struct {
 	env           *serviceenv.ServiceEnv
 	name          string
 	sidecarClient *client.APIClient
+	profiler      *profiler.Profiler
 }
 
 type collectPipelineFunc func(*tar.Writer, *pps.PipelineInfo, ...string) error
@@ -238,9 +243,6 @@ func collectProfile(tw *tar.Writer, profile *debug.Profile, prefix ...string) er
 
 func writeProfile(w io.Writer, profile *debug.Profile) error {
 	if profile.Name == "cpu" {
-		if err := pprof.StartCPUProfile(w); err != nil {
-			return err
-		}
 		duration := defaultDuration
 		if profile.Duration != nil {
 			var err error
@@ -249,9 +251,7 @@ func writeProfile(w io.Writer, profile *debug.Profile) error {
 				return err
 			}
 		}
-		time.Sleep(duration)
-		pprof.StopCPUProfile()
-		return nil
+		return profiler.WriteCPUProfile(context.Background(), w, duration)
 	}
 	p := pprof.Lookup(profile.Name)
 	if p == nil {
@@ -273,6 +273,56 @@ func redirectProfileFunc(ctx context.Context, profile *debug.Profile) redirectFu
 	}
 }
 
+func (s *debugServer) ProfileRange(request *debug.ProfileRangeRequest, server debug.Debug_ProfileRangeServer) error {
+	return s.handleRedirect(
+		grpcutil.NewStreamingBytesWriter(server),
+		request.Filter,
+		s.collectProfileRangeFunc(request),
+		nil,
+		nil,
+		redirectProfileRangeFunc(server.Context(), request),
+		s.collectProfileRangeFunc(request),
+	)
+}
+
+func (s *debugServer) collectProfileRangeFunc(request *debug.ProfileRangeRequest) collectFunc {
+	return func(tw *tar.Writer, prefix ...string) error {
+		return collectDebugFile(tw, request.Name, func(w io.Writer) error {
+			// An unset start or end leaves the range open on that side.
+			var from time.Time
+			to := time.Now()
+			if request.From != nil {
+				var err error
+				if from, err = types.TimestampFromProto(request.From); err != nil {
+					return err
+				}
+			}
+			if request.To != nil {
+				var err error
+				if to, err = types.TimestampFromProto(request.To); err != nil {
+					return err
+				}
+			}
+			return s.profiler.Merge(w, request.Name, from, to)
+		}, prefix...)
+	}
+}
+
+func redirectProfileRangeFunc(ctx context.Context, request *debug.ProfileRangeRequest) redirectFunc {
+	return func(c debug.DebugClient, filter *debug.Filter) (io.Reader, error) {
+		profileC, err := c.ProfileRange(ctx, &debug.ProfileRangeRequest{
+			Name:   request.Name,
+			From:   request.From,
+			To:     request.To,
+			Filter: filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return grpcutil.NewStreamingBytesReader(profileC, nil), nil
+	}
+}
+
 func (s *debugServer) Binary(request *debug.BinaryRequest, server debug.Debug_BinaryServer) error {
 	return s.handleRedirect(
 		grpcutil.NewStreamingBytesWriter(server),
@@ -315,7 +365,9 @@ func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpSe
 	return s.handleRedirect(
 		grpcutil.NewStreamingBytesWriter(server),
 		request.Filter,
-		s.collectPachdDump,
+		func(tw *tar.Writer, prefix ...string) error {
+			return s.collectPachdDump(server.Context(), tw, prefix...)
+		},
 		s.collectPipelineSpec,
 		s.collectWorkerDump,
 		redirectDumpFunc(server.Context()),
@@ -323,7 +375,7 @@ func (s *debugServer) Dump(request *debug.DumpRequest, server debug.Debug_DumpSe
 	)
 }
 
-func (s *debugServer) collectPachdDump(tw *tar.Writer, prefix ...string) error {
+func (s *debugServer) collectPachdDump(ctx context.Context, tw *tar.Writer, prefix ...string) error {
 	// Collect the pachd version.
 	if err := s.collectPachdVersion(tw, prefix...); err != nil {
 		return err
@@ -333,7 +385,11 @@ func (s *debugServer) collectPachdDump(tw *tar.Writer, prefix ...string) error {
 		return err
 	}
 	// Collect the pachd container dump.
-	return collectDump(tw, prefix...)
+	if err := collectDump(tw, prefix...); err != nil {
+		return err
+	}
+	// Collect a snapshot of the cluster state.
+	return s.collectCluster(ctx, tw)
 }
 
 func (s *debugServer) collectPachdVersion(tw *tar.Writer, prefix ...string) error {
@@ -367,7 +423,10 @@ func collectDump(tw *tar.Writer, prefix ...string) error {
 	if err := collectProfile(tw, &debug.Profile{Name: "goroutine"}, prefix...); err != nil {
 		return err
 	}
-	return collectProfile(tw, &debug.Profile{Name: "heap"}, prefix...)
+	if err := collectProfile(tw, &debug.Profile{Name: "heap"}, prefix...); err != nil {
+		return err
+	}
+	return collectMetrics(tw, prefix...)
 }
 
 func (s *debugServer) collectPipelineSpec(tw *tar.Writer, pipelineInfo *pps.PipelineInfo, prefix ...string) error {
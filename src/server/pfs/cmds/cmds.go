@@ -10,11 +10,13 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	gosync "sync"
 
 	prompt "github.com/c-bata/go-prompt"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
@@ -45,9 +47,9 @@ const (
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
 
-	raw := false
-	rawFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
-	rawFlags.BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	output, outputFlags := cmdutil.NewOutputFlags()
+	// --raw (without --output) prints json the way it always has
+	output.RawMarshaler = &jsonpb.Marshaler{Indent: "  "}
 
 	fullTimestamps := false
 	fullTimestampsFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -57,8 +59,6 @@ func Cmds() []*cobra.Command {
 	noPagerFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
 	noPagerFlags.BoolVar(&noPager, "no-pager", false, "Don't pipe output into a pager (i.e. less).")
 
-	marshaller := &jsonpb.Marshaler{Indent: "  "}
-
 	repoDocs := &cobra.Command{
 		Short: "Docs for repos.",
 		Long: `Repos, short for repository, are the top level data objects in Pachyderm.
@@ -69,6 +69,7 @@ or type (e.g. csv, binary, images, etc).`,
 	commands = append(commands, cmdutil.CreateDocsAlias(repoDocs, "repo", " repo$"))
 
 	var description string
+	var labels []string
 	createRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Create a new repo.",
@@ -86,6 +87,7 @@ or type (e.g. csv, binary, images, etc).`,
 					&pfsclient.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Labels:      labels,
 					},
 				)
 				return err
@@ -94,13 +96,18 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	createRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	createRepo.Flags().StringSliceVarP(&labels, "label", "l", nil, "A data classification label (e.g. 'pii') to set on the repo. Data policies in the auth config determine what each label implies.")
 	commands = append(commands, cmdutil.CreateAlias(createRepo, "create repo"))
 
+	var clearLabels bool
 	updateRepo := &cobra.Command{
 		Use:   "{{alias}} <repo>",
 		Short: "Update a repo.",
 		Long:  "Update a repo.",
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if clearLabels && len(labels) > 0 {
+				return errors.Errorf("cannot set both --label and --clear-labels")
+			}
 			c, err := client.NewOnUserMachine("user")
 			if err != nil {
 				return err
@@ -113,6 +120,8 @@ or type (e.g. csv, binary, images, etc).`,
 					&pfsclient.CreateRepoRequest{
 						Repo:        client.NewRepo(args[0]),
 						Description: description,
+						Labels:      labels,
+						ClearLabels: clearLabels,
 						Update:      true,
 					},
 				)
@@ -122,6 +131,8 @@ or type (e.g. csv, binary, images, etc).`,
 		}),
 	}
 	updateRepo.Flags().StringVarP(&description, "description", "d", "", "A description of the repo.")
+	updateRepo.Flags().StringSliceVarP(&labels, "label", "l", nil, "A data classification label to set on the repo. If set, the given labels replace the repo's existing labels.")
+	updateRepo.Flags().BoolVar(&clearLabels, "clear-labels", false, "Remove all of the repo's labels (labels the repo has inherited from its provenance are not removed).")
 	shell.RegisterCompletionFunc(updateRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(updateRepo, "update repo"))
 
@@ -142,17 +153,17 @@ or type (e.g. csv, binary, images, etc).`,
 			if repoInfo == nil {
 				return errors.Errorf("repo %s not found", args[0])
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, repoInfo)
-			}
-			ri := &pretty.PrintableRepoInfo{
-				RepoInfo:       repoInfo,
-				FullTimestamps: fullTimestamps,
+			table := repoTable(repoInfo.AuthInfo != nil, fullTimestamps)
+			table.PrintDetailed = func(proto.Message) error {
+				return pretty.PrintDetailedRepoInfo(&pretty.PrintableRepoInfo{
+					RepoInfo:       repoInfo,
+					FullTimestamps: fullTimestamps,
+				})
 			}
-			return pretty.PrintDetailedRepoInfo(ri)
+			return output.Print(os.Stdout, table, repoInfo)
 		}),
 	}
-	inspectRepo.Flags().AddFlagSet(rawFlags)
+	inspectRepo.Flags().AddFlagSet(outputFlags)
 	inspectRepo.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectRepo, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectRepo, "inspect repo"))
@@ -170,27 +181,20 @@ or type (e.g. csv, binary, images, etc).`,
 			if err != nil {
 				return err
 			}
-			if raw {
-				for _, repoInfo := range repoInfos {
-					if err := marshaller.Marshal(os.Stdout, repoInfo); err != nil {
-						return err
-					}
-				}
-				return nil
-			}
-
-			header := pretty.RepoHeader
-			if (len(repoInfos) > 0) && (repoInfos[0].AuthInfo != nil) {
-				header = pretty.RepoAuthHeader
+			authInfo := len(repoInfos) > 0 && repoInfos[0].AuthInfo != nil
+			printer, err := output.NewPrinter(os.Stdout, repoTable(authInfo, fullTimestamps))
+			if err != nil {
+				return err
 			}
-			writer := tabwriter.NewWriter(os.Stdout, header)
 			for _, repoInfo := range repoInfos {
-				pretty.PrintRepoInfo(writer, repoInfo, fullTimestamps)
+				if err := printer.Print(repoInfo); err != nil {
+					return err
+				}
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
-	listRepo.Flags().AddFlagSet(rawFlags)
+	listRepo.Flags().AddFlagSet(outputFlags)
 	listRepo.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(listRepo, "list repo"))
 
@@ -356,17 +360,17 @@ $ {{alias}} test -p XXX`,
 			if commitInfo == nil {
 				return errors.Errorf("commit %s not found", commit.ID)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, commitInfo)
-			}
-			ci := &pretty.PrintableCommitInfo{
-				CommitInfo:     commitInfo,
-				FullTimestamps: fullTimestamps,
+			table := commitTable(fullTimestamps)
+			table.PrintDetailed = func(proto.Message) error {
+				return pretty.PrintDetailedCommitInfo(&pretty.PrintableCommitInfo{
+					CommitInfo:     commitInfo,
+					FullTimestamps: fullTimestamps,
+				})
 			}
-			return pretty.PrintDetailedCommitInfo(ci)
+			return output.Print(os.Stdout, table, commitInfo)
 		}),
 	}
-	inspectCommit.Flags().AddFlagSet(rawFlags)
+	inspectCommit.Flags().AddFlagSet(outputFlags)
 	inspectCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectCommit, "inspect commit"))
@@ -401,45 +405,31 @@ $ {{alias}} foo@master --from XXX`,
 				return err
 			}
 
-			if raw {
-				return c.ListCommitF(branch.Repo.Name, branch.Name, from, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
-					return marshaller.Marshal(os.Stdout, ci)
-				})
+			printer, err := output.NewPrinter(os.Stdout, commitTable(fullTimestamps))
+			if err != nil {
+				return err
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
 			if err := c.ListCommitF(branch.Repo.Name, branch.Name, from, uint64(number), false, func(ci *pfsclient.CommitInfo) error {
-				pretty.PrintCommitInfo(writer, ci, fullTimestamps)
-				return nil
+				return printer.Print(ci)
 			}); err != nil {
 				return err
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
 	listCommit.Flags().StringVarP(&from, "from", "f", "", "list all commits since this commit")
 	listCommit.Flags().IntVarP(&number, "number", "n", 0, "list only this many commits; if set to zero, list all commits")
 	listCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
-	listCommit.Flags().AddFlagSet(rawFlags)
+	listCommit.Flags().AddFlagSet(outputFlags)
 	listCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(listCommit, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listCommit, "list commit"))
 
 	printCommitIter := func(commitIter client.CommitInfoIterator) error {
-		if raw {
-			for {
-				commitInfo, err := commitIter.Next()
-				if errors.Is(err, io.EOF) {
-					return nil
-				}
-				if err != nil {
-					return err
-				}
-				if err := marshaller.Marshal(os.Stdout, commitInfo); err != nil {
-					return err
-				}
-			}
+		printer, err := output.NewPrinter(os.Stdout, commitTable(fullTimestamps))
+		if err != nil {
+			return err
 		}
-		writer := tabwriter.NewWriter(os.Stdout, pretty.CommitHeader)
 		for {
 			commitInfo, err := commitIter.Next()
 			if errors.Is(err, io.EOF) {
@@ -448,9 +438,11 @@ $ {{alias}} foo@master --from XXX`,
 			if err != nil {
 				return err
 			}
-			pretty.PrintCommitInfo(writer, commitInfo, fullTimestamps)
+			if err := printer.Print(commitInfo); err != nil {
+				return err
+			}
 		}
-		return writer.Flush()
+		return printer.Close()
 	}
 
 	var repos cmdutil.RepeatedStringArg
@@ -491,7 +483,7 @@ $ {{alias}} foo@XXX -r bar -r baz`,
 	}
 	flushCommit.Flags().VarP(&repos, "repos", "r", "Wait only for commits leading to a specific set of repos")
 	flushCommit.MarkFlagCustom("repos", "__pachctl_get_repo")
-	flushCommit.Flags().AddFlagSet(rawFlags)
+	flushCommit.Flags().AddFlagSet(outputFlags)
 	flushCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(flushCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(flushCommit, "flush commit"))
@@ -551,7 +543,7 @@ $ {{alias}} test@master --new`,
 	subscribeCommit.Flags().StringVar(&pipeline, "pipeline", "", "subscribe to all commits created by this pipeline")
 	subscribeCommit.MarkFlagCustom("from", "__pachctl_get_commit $(__parse_repo ${nouns[0]})")
 	subscribeCommit.Flags().BoolVar(&newCommits, "new", false, "subscribe to only new commits created from now on")
-	subscribeCommit.Flags().AddFlagSet(rawFlags)
+	subscribeCommit.Flags().AddFlagSet(outputFlags)
 	subscribeCommit.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(subscribeCommit, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(subscribeCommit, "subscribe commit"))
@@ -660,14 +652,14 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			if branchInfo == nil {
 				return errors.Errorf("branch %s not found", args[0])
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, branchInfo)
+			table := branchTable()
+			table.PrintDetailed = func(proto.Message) error {
+				return pretty.PrintDetailedBranchInfo(branchInfo)
 			}
-
-			return pretty.PrintDetailedBranchInfo(branchInfo)
+			return output.Print(os.Stdout, table, branchInfo)
 		}),
 	}
-	inspectBranch.Flags().AddFlagSet(rawFlags)
+	inspectBranch.Flags().AddFlagSet(outputFlags)
 	inspectBranch.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectBranch, shell.BranchCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectBranch, "inspect branch"))
@@ -686,22 +678,19 @@ Any pachctl command that can take a Commit ID, can take a branch name instead.`,
 			if err != nil {
 				return err
 			}
-			if raw {
-				for _, branch := range branches {
-					if err := marshaller.Marshal(os.Stdout, branch); err != nil {
-						return err
-					}
-				}
-				return nil
+			printer, err := output.NewPrinter(os.Stdout, branchTable())
+			if err != nil {
+				return err
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.BranchHeader)
 			for _, branch := range branches {
-				pretty.PrintBranch(writer, branch)
+				if err := printer.Print(branch); err != nil {
+					return err
+				}
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
-	listBranch.Flags().AddFlagSet(rawFlags)
+	listBranch.Flags().AddFlagSet(outputFlags)
 	shell.RegisterCompletionFunc(listBranch, shell.RepoCompletion)
 	commands = append(commands, cmdutil.CreateAlias(listBranch, "list branch"))
 
@@ -808,90 +797,92 @@ $ {{alias}} repo@branch -i http://host/path`,
 			}
 			defer c.Close()
 
-			// load data into pachyderm
-			pfc, err := c.NewPutFileClient()
-			if err != nil {
-				return err
-			}
-			defer func() {
-				if err := pfc.Close(); err != nil && retErr == nil {
-					retErr = err
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) (retErr error) {
+				// load data into pachyderm
+				pfc, err := c.NewPutFileClient()
+				if err != nil {
+					return err
 				}
-			}()
-			if putFileCommit {
-				fmt.Fprintf(os.Stderr, "flag --commit / -c is deprecated; as of 1.7.2, you will get the same behavior without it\n")
-			}
-
-			limiter := limit.New(int(parallelism))
-			var sources []string
-			if inputFile != "" {
-				// User has provided a file listing sources, one per line. Read sources
-				var r io.Reader
-				if inputFile == "-" {
-					r = os.Stdin
-				} else if url, err := url.Parse(inputFile); err == nil && url.Scheme != "" {
-					resp, err := http.Get(url.String())
-					if err != nil {
-						return err
+				defer func() {
+					if err := pfc.Close(); err != nil && retErr == nil {
+						retErr = err
 					}
-					defer func() {
-						if err := resp.Body.Close(); err != nil && retErr == nil {
-							retErr = err
+				}()
+				if putFileCommit {
+					fmt.Fprintf(os.Stderr, "flag --commit / -c is deprecated; as of 1.7.2, you will get the same behavior without it\n")
+				}
+
+				limiter := limit.New(int(parallelism))
+				var sources []string
+				if inputFile != "" {
+					// User has provided a file listing sources, one per line. Read sources
+					var r io.Reader
+					if inputFile == "-" {
+						r = os.Stdin
+					} else if url, err := url.Parse(inputFile); err == nil && url.Scheme != "" {
+						resp, err := http.Get(url.String())
+						if err != nil {
+							return err
 						}
-					}()
-					r = resp.Body
-				} else {
-					inputFile, err := os.Open(inputFile)
-					if err != nil {
-						return err
+						defer func() {
+							if err := resp.Body.Close(); err != nil && retErr == nil {
+								retErr = err
+							}
+						}()
+						r = resp.Body
+					} else {
+						inputFile, err := os.Open(inputFile)
+						if err != nil {
+							return err
+						}
+						defer func() {
+							if err := inputFile.Close(); err != nil && retErr == nil {
+								retErr = err
+							}
+						}()
+						r = inputFile
 					}
-					defer func() {
-						if err := inputFile.Close(); err != nil && retErr == nil {
-							retErr = err
+					// scan line by line
+					scanner := bufio.NewScanner(r)
+					for scanner.Scan() {
+						if filePath := scanner.Text(); filePath != "" {
+							sources = append(sources, filePath)
 						}
-					}()
-					r = inputFile
-				}
-				// scan line by line
-				scanner := bufio.NewScanner(r)
-				for scanner.Scan() {
-					if filePath := scanner.Text(); filePath != "" {
-						sources = append(sources, filePath)
 					}
+				} else {
+					// User has provided a single source
+					sources = filePaths
 				}
-			} else {
-				// User has provided a single source
-				sources = filePaths
-			}
-
-			// Arguments parsed; create putFileHelper and begin copying data
-			var eg errgroup.Group
-			filesPut := &gosync.Map{}
-			for _, source := range sources {
-				source := source
-				if file.Path == "" {
-					// The user has not specified a path so we use source as path.
-					if source == "-" {
-						return errors.Errorf("must specify filename when reading data from stdin")
+
+				// Arguments parsed; create putFileHelper and begin copying data
+				var eg errgroup.Group
+				filesPut := &gosync.Map{}
+				for _, source := range sources {
+					source := source
+					if file.Path == "" {
+						// The user has not specified a path so we use source as path.
+						if source == "-" {
+							return errors.Errorf("must specify filename when reading data from stdin")
+						}
+						eg.Go(func() error {
+							return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths("", source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, headerRecords, filesPut)
+						})
+					} else if len(sources) == 1 {
+						// We have a single source and the user has specified a path,
+						// we use the path and ignore source (in terms of naming the file).
+						eg.Go(func() error {
+							return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, headerRecords, filesPut)
+						})
+					} else {
+						// We have multiple sources and the user has specified a path,
+						// we use that path as a prefix for the filepaths.
+						eg.Go(func() error {
+							return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths(file.Path, source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, headerRecords, filesPut)
+						})
 					}
-					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths("", source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, headerRecords, filesPut)
-					})
-				} else if len(sources) == 1 {
-					// We have a single source and the user has specified a path,
-					// we use the path and ignore source (in terms of naming the file).
-					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, file.Path, source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, headerRecords, filesPut)
-					})
-				} else {
-					// We have multiple sources and the user has specified a path,
-					// we use that path as a prefix for the filepaths.
-					eg.Go(func() error {
-						return putFileHelper(c, pfc, file.Commit.Repo.Name, file.Commit.ID, joinPaths(file.Path, source), source, recursive, overwrite, limiter, split, targetFileDatums, targetFileBytes, headerRecords, filesPut)
-					})
 				}
-			}
-			return eg.Wait()
+				return eg.Wait()
+			})
 		}),
 	}
 	putFile.Flags().StringSliceVarP(&filePaths, "file", "f", []string{"-"}, "The file to be put, it can be a local file or a URL.")
@@ -1023,13 +1014,14 @@ $ {{alias}} foo@master^2:XXX`,
 			if fileInfo == nil {
 				return errors.Errorf("file %s not found", file.Path)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, fileInfo)
+			table := fileTable(false, fullTimestamps)
+			table.PrintDetailed = func(proto.Message) error {
+				return pretty.PrintDetailedFileInfo(fileInfo)
 			}
-			return pretty.PrintDetailedFileInfo(fileInfo)
+			return output.Print(os.Stdout, table, fileInfo)
 		}),
 	}
-	inspectFile.Flags().AddFlagSet(rawFlags)
+	inspectFile.Flags().AddFlagSet(outputFlags)
 	shell.RegisterCompletionFunc(inspectFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(inspectFile, "inspect file"))
 
@@ -1072,26 +1064,19 @@ $ {{alias}} foo@master --history all`,
 				return err
 			}
 			defer c.Close()
-			if raw {
-				return c.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
-					return marshaller.Marshal(os.Stdout, fi)
-				})
-			}
-			header := pretty.FileHeader
-			if history != 0 {
-				header = pretty.FileHeaderWithCommit
+			printer, err := output.NewPrinter(os.Stdout, fileTable(history != 0, fullTimestamps))
+			if err != nil {
+				return err
 			}
-			writer := tabwriter.NewWriter(os.Stdout, header)
 			if err := c.ListFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, history, func(fi *pfsclient.FileInfo) error {
-				pretty.PrintFileInfo(writer, fi, fullTimestamps, history != 0)
-				return nil
+				return printer.Print(fi)
 			}); err != nil {
 				return err
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
-	listFile.Flags().AddFlagSet(rawFlags)
+	listFile.Flags().AddFlagSet(outputFlags)
 	listFile.Flags().AddFlagSet(fullTimestampsFlags)
 	listFile.Flags().StringVar(&history, "history", "none", "Return revision history for files.")
 	shell.RegisterCompletionFunc(listFile, shell.FileCompletion)
@@ -1119,26 +1104,19 @@ $ {{alias}} "foo@master:data/*"`,
 				return err
 			}
 			defer c.Close()
-			fileInfos, err := c.GlobFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+			printer, err := output.NewPrinter(os.Stdout, fileTable(false, fullTimestamps))
 			if err != nil {
 				return err
 			}
-			if raw {
-				for _, fileInfo := range fileInfos {
-					if err := marshaller.Marshal(os.Stdout, fileInfo); err != nil {
-						return err
-					}
-				}
-				return nil
-			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.FileHeader)
-			for _, fileInfo := range fileInfos {
-				pretty.PrintFileInfo(writer, fileInfo, fullTimestamps, false)
+			if err := c.GlobFileF(file.Commit.Repo.Name, file.Commit.ID, file.Path, func(fi *pfsclient.FileInfo) error {
+				return printer.Print(fi)
+			}); err != nil {
+				return err
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
-	globFile.Flags().AddFlagSet(rawFlags)
+	globFile.Flags().AddFlagSet(outputFlags)
 	globFile.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(globFile, shell.FileCompletion)
 	commands = append(commands, cmdutil.CreateAlias(globFile, "glob file"))
@@ -1262,7 +1240,9 @@ $ {{alias}} foo@master:path1 bar@master:path2`,
 			}
 			defer c.Close()
 
-			return c.DeleteFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+			return txncmds.WithActiveTransaction(c, func(c *client.APIClient) error {
+				return c.DeleteFile(file.Commit.Repo.Name, file.Commit.ID, file.Path)
+			})
 		}),
 	}
 	shell.RegisterCompletionFunc(deleteFile, shell.FileCompletion)
@@ -1547,3 +1527,113 @@ func forEachDiffFile(newFiles, oldFiles []*pfsclient.FileInfo, f func(newFile, o
 		}
 	}
 }
+
+// repoTable returns the table printed by 'list repo', which includes users'
+// access levels if auth is active
+func repoTable(authInfo, fullTimestamps bool) cmdutil.Table {
+	header := pretty.RepoHeader
+	if authInfo {
+		header = pretty.RepoAuthHeader
+	}
+	csvHeader := []string{"name", "created", "size_bytes", "description"}
+	if authInfo {
+		csvHeader = []string{"name", "created", "size_bytes", "access_level", "description"}
+	}
+	return cmdutil.Table{
+		Header: header,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintRepoInfo(w, item.(*pfsclient.RepoInfo), fullTimestamps)
+		},
+		CSVHeader: csvHeader,
+		CSVRow: func(item proto.Message) []string {
+			ri := item.(*pfsclient.RepoInfo)
+			row := []string{ri.Repo.Name, cmdutil.CSVTimestamp(ri.Created), strconv.FormatUint(ri.SizeBytes, 10)}
+			if authInfo {
+				var accessLevel string
+				if ri.AuthInfo != nil {
+					accessLevel = ri.AuthInfo.AccessLevel.String()
+				}
+				row = append(row, accessLevel)
+			}
+			return append(row, ri.Description)
+		},
+	}
+}
+
+// commitTable returns the table printed by 'list commit'
+func commitTable(fullTimestamps bool) cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.CommitHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintCommitInfo(w, item.(*pfsclient.CommitInfo), fullTimestamps)
+		},
+		CSVHeader: []string{"repo", "branch", "commit", "finished", "size_bytes",
+			"subvenant_commits_success", "subvenant_commits_failure",
+			"subvenant_commits_total", "description"},
+		CSVRow: func(item proto.Message) []string {
+			ci := item.(*pfsclient.CommitInfo)
+			var branch string
+			if ci.Branch != nil {
+				branch = ci.Branch.Name
+			}
+			return []string{ci.Commit.Repo.Name, branch, ci.Commit.ID,
+				cmdutil.CSVTimestamp(ci.Finished), strconv.FormatUint(ci.SizeBytes, 10),
+				strconv.FormatInt(ci.SubvenantCommitsSuccess, 10),
+				strconv.FormatInt(ci.SubvenantCommitsFailure, 10),
+				strconv.FormatInt(ci.SubvenantCommitsTotal, 10), ci.Description}
+		},
+	}
+}
+
+// branchTable returns the table printed by 'list branch'
+func branchTable() cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.BranchHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintBranch(w, item.(*pfsclient.BranchInfo))
+		},
+		CSVHeader: []string{"branch", "head", "trigger_branch", "trigger_all",
+			"trigger_cron_spec", "trigger_size", "trigger_commits"},
+		CSVRow: func(item proto.Message) []string {
+			bi := item.(*pfsclient.BranchInfo)
+			row := []string{bi.Branch.Name, ""}
+			if bi.Head != nil {
+				row[1] = bi.Head.ID
+			}
+			if t := bi.Trigger; t != nil {
+				return append(row, t.Branch, strconv.FormatBool(t.All), t.CronSpec,
+					t.Size_, strconv.FormatInt(t.Commits, 10))
+			}
+			return append(row, "", "", "", "", "")
+		},
+	}
+}
+
+// fileTable returns the table printed by 'list file', whose rows include the
+// files' commits when listing their history
+func fileTable(withCommit, fullTimestamps bool) cmdutil.Table {
+	header := pretty.FileHeader
+	if withCommit {
+		header = pretty.FileHeaderWithCommit
+	}
+	csvHeader := []string{"path", "file_type", "size_bytes"}
+	if withCommit {
+		csvHeader = []string{"commit", "path", "file_type", "committed", "size_bytes"}
+	}
+	return cmdutil.Table{
+		Header: header,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintFileInfo(w, item.(*pfsclient.FileInfo), fullTimestamps, withCommit)
+		},
+		CSVHeader: csvHeader,
+		CSVRow: func(item proto.Message) []string {
+			fi := item.(*pfsclient.FileInfo)
+			size := strconv.FormatUint(fi.SizeBytes, 10)
+			if withCommit {
+				return []string{fi.File.Commit.ID, fi.File.Path, fi.FileType.String(),
+					cmdutil.CSVTimestamp(fi.Committed), size}
+			}
+			return []string{fi.File.Path, fi.FileType.String(), size}
+		},
+	}
+}
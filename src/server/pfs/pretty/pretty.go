@@ -67,7 +67,9 @@ func PrintDetailedRepoInfo(repoInfo *PrintableRepoInfo) error {
 Description: {{.Description}}{{end}}{{if .FullTimestamps}}
 Created: {{.Created}}{{else}}
 Created: {{prettyAgo .Created}}{{end}}
-Size of HEAD on master: {{prettySize .SizeBytes}}{{if .AuthInfo}}
+Size of HEAD on master: {{prettySize .SizeBytes}}{{if .Labels}}
+Labels: {{join .Labels ", "}}{{end}}{{if .InheritedLabels}}
+Inherited labels: {{join .InheritedLabels ", "}}{{end}}{{if .AuthInfo}}
 Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 `)
 	if err != nil {
@@ -270,6 +272,7 @@ var funcMap = template.FuncMap{
 	"prettySize":   pretty.Size,
 	"fileType":     fileType,
 	"printTrigger": printTrigger,
+	"join":         strings.Join,
 }
 
 // CompactPrintBranch renders 'b' as a compact string, e.g.
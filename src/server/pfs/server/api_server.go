@@ -12,6 +12,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	"github.com/pachyderm/pachyderm/src/client/transaction"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
@@ -64,7 +65,7 @@ func (a *apiServer) CreateRepoInTransaction(
 	txnCtx *txnenv.TransactionContext,
 	request *pfs.CreateRepoRequest,
 ) error {
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Labels, request.ClearLabels, request.Update)
 }
 
 // CreateRepo implements the protobuf pfs.CreateRepo RPC
@@ -244,6 +245,16 @@ func (a *apiServer) FinishCommit(ctx context.Context, request *pfs.FinishCommitR
 	return &types.Empty{}, nil
 }
 
+// InspectCommitInTransaction is identical to InspectCommit except that it can
+// run inside an existing etcd STM transaction, and it never blocks.  This is
+// not an RPC.
+func (a *apiServer) InspectCommitInTransaction(
+	txnCtx *txnenv.TransactionContext,
+	request *pfs.InspectCommitRequest,
+) (*pfs.CommitInfo, error) {
+	return a.driver.inspectCommitInTransaction(txnCtx, request.Commit)
+}
+
 // InspectCommit implements the protobuf pfs.InspectCommit RPC
 func (a *apiServer) InspectCommit(ctx context.Context, request *pfs.InspectCommitRequest) (response *pfs.CommitInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -301,6 +312,15 @@ func (a *apiServer) CreateBranch(ctx context.Context, request *pfs.CreateBranchR
 	return &types.Empty{}, nil
 }
 
+// InspectBranchInTransaction is identical to InspectBranch except that it can
+// run inside an existing etcd STM transaction.  This is not an RPC.
+func (a *apiServer) InspectBranchInTransaction(
+	txnCtx *txnenv.TransactionContext,
+	request *pfs.InspectBranchRequest,
+) (*pfs.BranchInfo, error) {
+	return a.driver.inspectBranch(txnCtx, request.Branch)
+}
+
 // InspectBranch implements the protobuf pfs.InspectBranch RPC
 func (a *apiServer) InspectBranch(ctx context.Context, request *pfs.InspectBranchRequest) (response *pfs.BranchInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -413,6 +433,16 @@ func (a *apiServer) PutFile(putFileServer pfs.API_PutFileServer) (retErr error)
 	return a.driver.putFiles(pachClient, s)
 }
 
+// PutFileInTransaction writes the records of files whose contents have
+// already been uploaded inside an existing etcd STM transaction.  This is not
+// an RPC.
+func (a *apiServer) PutFileInTransaction(
+	txnCtx *txnenv.TransactionContext,
+	request *transaction.PutFileRecordsRequest,
+) error {
+	return a.driver.putFileRecordsInTransaction(txnCtx, request.Files, request.Records)
+}
+
 // CopyFile implements the protobuf pfs.CopyFile RPC
 func (a *apiServer) CopyFile(ctx context.Context, request *pfs.CopyFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -569,13 +599,23 @@ func (a *apiServer) DiffFile(ctx context.Context, request *pfs.DiffFileRequest)
 	}, nil
 }
 
+// DeleteFileInTransaction is identical to DeleteFile except that it can run
+// inside an existing etcd STM transaction.  This is not an RPC.
+func (a *apiServer) DeleteFileInTransaction(
+	txnCtx *txnenv.TransactionContext,
+	request *pfs.DeleteFileRequest,
+) error {
+	return a.driver.deleteFile(txnCtx, request.File)
+}
+
 // DeleteFile implements the protobuf pfs.DeleteFile RPC
 func (a *apiServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	err := a.driver.deleteFile(a.env.GetPachClient(ctx), request.File)
-	if err != nil {
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.DeleteFile(request)
+	}); err != nil {
 		return nil, err
 	}
 	return &types.Empty{}, nil
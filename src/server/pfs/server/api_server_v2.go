@@ -9,6 +9,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/transaction"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
 	"github.com/pachyderm/pachyderm/src/server/pkg/storage/fileset"
@@ -76,6 +77,11 @@ func (a *apiServerV2) PutFile(_ pfs.API_PutFileServer) error {
 	return errV1NotImplemented
 }
 
+// PutFileInTransaction is not implemented in V2.
+func (a *apiServerV2) PutFileInTransaction(_ *txnenv.TransactionContext, _ *transaction.PutFileRecordsRequest) error {
+	return errV1NotImplemented
+}
+
 // CopyFile implements the protobuf pfs.CopyFile RPC
 func (a *apiServerV2) CopyFile(ctx context.Context, request *pfs.CopyFileRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -142,6 +148,11 @@ func (a *apiServerV2) DeleteFile(ctx context.Context, request *pfs.DeleteFileReq
 	return nil, errV1NotImplemented
 }
 
+// DeleteFileInTransaction is not implemented in V2.
+func (a *apiServerV2) DeleteFileInTransaction(_ *txnenv.TransactionContext, _ *pfs.DeleteFileRequest) error {
+	return errV1NotImplemented
+}
+
 // DeleteAll implements the protobuf pfs.DeleteAll RPC
 func (a *apiServerV2) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	err := a.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
@@ -317,5 +328,5 @@ func (a *apiServerV2) CreateRepoInTransaction(
 	if repo := request.GetRepo(); repo != nil && repo.Name == tmpRepo {
 		return errors.Errorf("%s is a reserved name", tmpRepo)
 	}
-	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Update)
+	return a.driver.createRepo(txnCtx, request.Repo, request.Description, request.Labels, request.ClearLabels, request.Update)
 }
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"path"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+)
+
+// dataPolicies returns the cluster's data policies, or nil if auth isn't
+// active (in which case labels have no effect)
+func (d *driver) dataPolicies(txnCtx *txnenv.TransactionContext) (*datapolicy.Engine, error) {
+	policies, err := txnCtx.Auth().GetDataPoliciesInTransaction(txnCtx)
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get data policies")
+	}
+	return datapolicy.New(policies), nil
+}
+
+// checkPublicRepo returns an error if 'repoInfo' carries a label whose data
+// policy forbids it from being readable by all users, and the repo's ACL
+// grants all users access
+func (d *driver) checkPublicRepo(txnCtx *txnenv.TransactionContext, policies *datapolicy.Engine, repoInfo *pfs.RepoInfo) error {
+	violation := policies.CheckPublic(datapolicy.Labels(repoInfo))
+	if violation == nil {
+		return nil
+	}
+	resp, err := txnCtx.Auth().GetACLInTransaction(txnCtx, &auth.GetACLRequest{
+		Repo: repoInfo.Repo.Name,
+	})
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get ACL of %q", repoInfo.Repo.Name)
+	}
+	for _, entry := range resp.Entries {
+		if entry.Username == auth.AllClusterUsersSubject && entry.Scope != auth.Scope_NONE {
+			return errors.Wrapf(violation, "%q is readable by all users", repoInfo.Repo.Name)
+		}
+	}
+	return nil
+}
+
+// inheritLabels adds the labels that the repos in 'branchInfo's provenance
+// pass on to their downstream repos to the inherited labels of 'branchInfo's
+// repo, and checks that the result doesn't violate a data policy
+func (d *driver) inheritLabels(txnCtx *txnenv.TransactionContext, policies *datapolicy.Engine, branchInfo *pfs.BranchInfo) error {
+	if policies == nil {
+		return nil
+	}
+	repos := d.repos.ReadWrite(txnCtx.Stm)
+	var labels []string
+	for _, provBranch := range branchInfo.Provenance {
+		provRepoInfo := &pfs.RepoInfo{}
+		if err := repos.Get(provBranch.Repo.Name, provRepoInfo); err != nil {
+			return errors.Wrapf(err, "could not get provenance repo %q", provBranch.Repo.Name)
+		}
+		labels = append(labels, policies.Inherited(datapolicy.Labels(provRepoInfo))...)
+	}
+	if len(labels) == 0 {
+		return nil
+	}
+	repoInfo := &pfs.RepoInfo{}
+	return repos.Update(branchInfo.Branch.Repo.Name, repoInfo, func() error {
+		repoInfo.InheritedLabels = datapolicy.Union(repoInfo.InheritedLabels, labels)
+		return d.checkPublicRepo(txnCtx, policies, repoInfo)
+	})
+}
+
+// propagateLabels passes the labels of 'repoInfo' on to the repos downstream
+// of it, i.e. the repos of its branches' subvenance (which includes every
+// downstream branch)
+func (d *driver) propagateLabels(txnCtx *txnenv.TransactionContext, policies *datapolicy.Engine, repoInfo *pfs.RepoInfo) error {
+	if policies == nil {
+		return nil
+	}
+	done := make(map[string]bool)
+	for _, branch := range repoInfo.Branches {
+		branchInfo := &pfs.BranchInfo{}
+		if err := d.branches(branch.Repo.Name).ReadWrite(txnCtx.Stm).Get(branch.Name, branchInfo); err != nil {
+			return errors.Wrapf(err, "could not get branch %q", branch.Name)
+		}
+		for _, subvBranch := range branchInfo.Subvenance {
+			key := path.Join(subvBranch.Repo.Name, subvBranch.Name)
+			if done[key] {
+				continue
+			}
+			done[key] = true
+			subvBranchInfo := &pfs.BranchInfo{}
+			if err := d.branches(subvBranch.Repo.Name).ReadWrite(txnCtx.Stm).Get(subvBranch.Name, subvBranchInfo); err != nil {
+				return errors.Wrapf(err, "could not get subvenant branch %q", key)
+			}
+			if err := d.inheritLabels(txnCtx, policies, subvBranchInfo); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func equalLabels(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
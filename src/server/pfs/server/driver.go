@@ -22,14 +22,17 @@ import (
 
 	globlib "github.com/pachyderm/ohmyglob"
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/audit"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/transaction"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
@@ -167,18 +170,45 @@ func newDriver(
 // checkIsAuthorizedInTransaction is identicalto checkIsAuthorized except that
 // it performs reads consistent with the latest state of the STM transaction.
 func (d *driver) checkIsAuthorizedInTransaction(txnCtx *txnenv.TransactionContext, r *pfs.Repo, s auth.Scope) error {
+	return d.checkBranchIsAuthorizedInTransaction(txnCtx, r, "", s)
+}
+
+// checkBranchIsAuthorizedInTransaction is identical to
+// checkIsAuthorizedInTransaction, except that it also enforces any branch ACLs
+// that apply to 'branch' in 'r'
+func (d *driver) checkBranchIsAuthorizedInTransaction(txnCtx *txnenv.TransactionContext, r *pfs.Repo, branch string, s auth.Scope) error {
 	me, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
 	if auth.IsErrNotActivated(err) {
 		return nil
 	}
 
-	req := &auth.AuthorizeRequest{Repo: r.Name, Scope: s}
+	req := &auth.AuthorizeRequest{Repo: r.Name, Branch: branch, Scope: s}
 	resp, err := txnCtx.Auth().AuthorizeInTransaction(txnCtx, req)
 	if err != nil {
 		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error during authorization check for operation on \"%s\"", r.Name)
 	}
 	if !resp.Authorized {
-		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Required: s}
+		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Branch: branch, Required: s}
+	}
+	return nil
+}
+
+// checkPermissionsInTransaction is like checkIsAuthorizedInTransaction, but
+// checks that the caller has 'perms' on 'r' (which custom roles may grant)
+// rather than a scope
+func (d *driver) checkPermissionsInTransaction(txnCtx *txnenv.TransactionContext, r *pfs.Repo, perms ...auth.Permission) error {
+	me, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	if auth.IsErrNotActivated(err) {
+		return nil
+	}
+
+	req := &auth.AuthorizeRequest{Repo: r.Name, Permissions: perms}
+	resp, err := txnCtx.Auth().AuthorizeInTransaction(txnCtx, req)
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error during authorization check for operation on \"%s\"", r.Name)
+	}
+	if !resp.Authorized {
+		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Permissions: perms}
 	}
 	return nil
 }
@@ -186,31 +216,93 @@ func (d *driver) checkIsAuthorizedInTransaction(txnCtx *txnenv.TransactionContex
 // checkIsAuthorized returns an error if the current user (in 'pachClient') has
 // authorization scope 's' for repo 'r'
 func (d *driver) checkIsAuthorized(pachClient *client.APIClient, r *pfs.Repo, s auth.Scope) error {
+	return d.checkBranchIsAuthorized(pachClient, r, "", s)
+}
+
+// checkBranchIsAuthorized is identical to checkIsAuthorized, except that it
+// also enforces any branch ACLs that apply to 'branch' in 'r'
+func (d *driver) checkBranchIsAuthorized(pachClient *client.APIClient, r *pfs.Repo, branch string, s auth.Scope) error {
 	ctx := pachClient.Ctx()
 	me, err := pachClient.WhoAmI(ctx, &auth.WhoAmIRequest{})
 	if auth.IsErrNotActivated(err) {
 		return nil
 	}
 
-	req := &auth.AuthorizeRequest{Repo: r.Name, Scope: s}
+	req := &auth.AuthorizeRequest{Repo: r.Name, Branch: branch, Scope: s}
 	resp, err := pachClient.AuthAPIClient.Authorize(ctx, req)
 	if err != nil {
 		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error during authorization check for operation on \"%s\"", r.Name)
 	}
 	if !resp.Authorized {
-		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Required: s}
+		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Branch: branch, Required: s}
 	}
 	return nil
 }
 
-func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, description string, update bool) error {
+// checkCommitIsAuthorizedInTransaction is identical to
+// checkBranchIsAuthorizedInTransaction, except that it enforces the branch
+// ACLs of the branch that 'commit' is on
+func (d *driver) checkCommitIsAuthorizedInTransaction(txnCtx *txnenv.TransactionContext, commit *pfs.Commit, s auth.Scope) error {
+	branch, err := commitBranch(d.commits(commit.Repo.Name).ReadWrite(txnCtx.Stm), commit)
+	if err != nil {
+		return err
+	}
+	return d.checkBranchIsAuthorizedInTransaction(txnCtx, commit.Repo, branch, s)
+}
+
+// checkCommitIsAuthorized is identical to checkBranchIsAuthorized, except
+// that it enforces the branch ACLs of the branch that 'commit' is on
+func (d *driver) checkCommitIsAuthorized(pachClient *client.APIClient, commit *pfs.Commit, s auth.Scope) error {
+	branch, err := commitBranch(d.commits(commit.Repo.Name).ReadOnly(pachClient.Ctx()), commit)
+	if err != nil {
+		return err
+	}
+	return d.checkBranchIsAuthorized(pachClient, commit.Repo, branch, s)
+}
+
+// commitBranch returns the branch that 'commit' is on, so that branch ACLs
+// apply to writes that address a commit by ID as well as by branch name.
+// Commits given by ID are resolved to the branch they were started on. ""
+// is returned for commits that aren't on a branch or that don't exist (the
+// caller reports the latter when it resolves the commit).
+func commitBranch(commits interface {
+	Get(key string, val proto.Message) error
+}, commit *pfs.Commit) (string, error) {
+	id, _, err := ancestry.Parse(commit.ID)
+	if err != nil {
+		return "", err
+	}
+	if !uuid.IsUUIDWithoutDashes(id) {
+		return id, nil
+	}
+	commitInfo := &pfs.CommitInfo{}
+	if err := commits.Get(id, commitInfo); err != nil {
+		if col.IsErrNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if commitInfo.Branch == nil {
+		return "", nil
+	}
+	return commitInfo.Branch.Name, nil
+}
+
+func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, description string, labels []string, clearLabels, update bool) error {
 	// Validate arguments
 	if repo == nil {
 		return errors.New("repo cannot be nil")
 	}
+	for _, label := range labels {
+		if err := datapolicy.ValidateLabel(label); err != nil {
+			return err
+		}
+	}
+	labels = datapolicy.Union(labels)
 
 	// Check that the user is logged in (user doesn't need any access level to
-	// create a repo, but they must be authenticated if auth is active)
+	// create a repo, but they must be authenticated if auth is active, and
+	// SetACL below rejects tokens that may not own the new repo)
 	whoAmI, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
 	authIsActivated := !auth.IsErrNotActivated(err)
 	if authIsActivated && err != nil {
@@ -235,7 +327,11 @@ func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, d
 			return errors.Errorf("cannot create \"%s\" as it already exists", repo.Name)
 		}
 
-		if existingRepoInfo.Description == description {
+		// Labels are only replaced if the caller set them (pps, for example,
+		// updates repos without knowing their labels)
+		updateLabels := len(labels) > 0 || clearLabels
+		if existingRepoInfo.Description == description &&
+			(!updateLabels || equalLabels(existingRepoInfo.Labels, labels)) {
 			// Don't overwrite the stored proto with an identical value. This
 			// optimization is impactful because pps will frequently update the __spec__
 			// repo to make sure it exists.
@@ -252,7 +348,25 @@ func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, d
 			return errors.Wrapf(err, "could not update description of %q", repo)
 		}
 		existingRepoInfo.Description = description
-		return repos.Put(repo.Name, &existingRepoInfo)
+		if !updateLabels || equalLabels(existingRepoInfo.Labels, labels) {
+			return repos.Put(repo.Name, &existingRepoInfo)
+		}
+		// Only the repo's owners (and admins) may change its classification
+		if err := d.checkIsAuthorizedInTransaction(txnCtx, repo, auth.Scope_OWNER); err != nil {
+			return errors.Wrapf(err, "could not update labels of %q", repo)
+		}
+		existingRepoInfo.Labels = labels
+		policies, err := d.dataPolicies(txnCtx)
+		if err != nil {
+			return err
+		}
+		if err := d.checkPublicRepo(txnCtx, policies, &existingRepoInfo); err != nil {
+			return err
+		}
+		if err := repos.Put(repo.Name, &existingRepoInfo); err != nil {
+			return err
+		}
+		return d.propagateLabels(txnCtx, policies, &existingRepoInfo)
 	} else {
 		// New repo case
 		if authIsActivated {
@@ -273,6 +387,7 @@ func (d *driver) createRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, d
 			Repo:        repo,
 			Created:     types.TimestampNow(),
 			Description: description,
+			Labels:      labels,
 		})
 	}
 }
@@ -899,7 +1014,7 @@ func (d *driver) listRepo(pachClient *client.APIClient, includeAuth bool) (*pfs.
 	authSeemsActive := true
 	repoInfo := &pfs.RepoInfo{}
 	if err := repos.List(repoInfo, col.DefaultOptions, func(repoName string) error {
-		if repoName == ppsconsts.SpecRepo {
+		if repoName == ppsconsts.SpecRepo || repoName == audit.Repo {
 			return nil
 		}
 		if includeAuth && authSeemsActive {
@@ -946,7 +1061,7 @@ func (d *driver) deleteRepo(txnCtx *txnenv.TransactionContext, repo *pfs.Repo, f
 	}
 
 	// Check if the caller is authorized to delete this repo
-	if err := d.checkIsAuthorizedInTransaction(txnCtx, repo, auth.Scope_OWNER); err != nil {
+	if err := d.checkPermissionsInTransaction(txnCtx, repo, auth.Permission_REPO_DELETE); err != nil {
 		return err
 	}
 
@@ -1139,7 +1254,7 @@ func (d *driver) makeCommit(
 	}
 
 	// Check that caller is authorized
-	if err := d.checkIsAuthorizedInTransaction(txnCtx, parent.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkBranchIsAuthorizedInTransaction(txnCtx, parent.Repo, branch, auth.Scope_WRITER); err != nil {
 		return nil, err
 	}
 
@@ -1487,7 +1602,7 @@ func (d *driver) finishCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Com
 		return errors.New("commit repo cannot be nil")
 	}
 
-	if err := d.checkIsAuthorizedInTransaction(txnCtx, commit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkCommitIsAuthorizedInTransaction(txnCtx, commit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	commitInfo, err := d.resolveCommit(txnCtx.Stm, commit)
@@ -1531,7 +1646,7 @@ func (d *driver) finishCommit(txnCtx *txnenv.TransactionContext, commit *pfs.Com
 
 		if tree == nil {
 			var err error
-			finishedTree, err = d.getTreeForOpenCommit(txnCtx.Client, &pfs.File{Commit: commit}, parentTree)
+			finishedTree, err = d.getTreeForOpenCommit(txnCtx, &pfs.File{Commit: commit}, parentTree)
 			if err != nil {
 				return err
 			}
@@ -1904,6 +2019,23 @@ nextSubvBI:
 //
 // As a side effect, this function also replaces the ID in the given commit
 // with a real commit ID.
+// inspectCommitInTransaction is like inspectCommit, but it runs inside an
+// existing STM and never blocks.
+func (d *driver) inspectCommitInTransaction(txnCtx *txnenv.TransactionContext, commit *pfs.Commit) (*pfs.CommitInfo, error) {
+	if commit == nil {
+		return nil, errors.Errorf("cannot inspect nil commit")
+	}
+	if commit.Repo == nil {
+		return nil, errors.Errorf("cannot inspect commit with nil repo")
+	}
+	if err := d.checkIsAuthorizedInTransaction(txnCtx, commit.Repo, auth.Scope_READER); err != nil {
+		return nil, err
+	}
+	// resolveCommit replaces the ID of the commit it's given, so don't modify
+	// the caller's commit.
+	return d.resolveCommit(txnCtx.Stm, client.NewCommit(commit.Repo.Name, commit.ID))
+}
+
 func (d *driver) inspectCommit(pachClient *client.APIClient, commit *pfs.Commit, blockState pfs.CommitState) (*pfs.CommitInfo, error) {
 	ctx := pachClient.Ctx()
 	if commit == nil {
@@ -2370,7 +2502,7 @@ func (d *driver) deleteCommit(txnCtx *txnenv.TransactionContext, userCommit *pfs
 		return errors.New("commit repo cannot be nil")
 	}
 
-	if err := d.checkIsAuthorizedInTransaction(txnCtx, userCommit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkCommitIsAuthorizedInTransaction(txnCtx, userCommit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	// Main txn: Delete all downstream commits, and update subvenance of upstream commits
@@ -2698,7 +2830,7 @@ func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 	}
 
 	var err error
-	if err := d.checkIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkBranchIsAuthorizedInTransaction(txnCtx, branch.Repo, branch.Name, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	// Validate request
@@ -2848,6 +2980,18 @@ func (d *driver) createBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 		}
 	}
 
+	// Propagate classification labels from each updated branch's provenance to
+	// its repo
+	policies, err := d.dataPolicies(txnCtx)
+	if err != nil {
+		return err
+	}
+	for _, branchInfo := range toUpdate {
+		if err := d.inheritLabels(txnCtx, policies, branchInfo); err != nil {
+			return err
+		}
+	}
+
 	// propagate the head commit to 'branch'. This may also modify 'branch', by
 	// creating a new HEAD commit if 'branch's provenance was changed and its
 	// current HEAD commit has old provenance
@@ -2952,7 +3096,7 @@ func (d *driver) deleteBranch(txnCtx *txnenv.TransactionContext, branch *pfs.Bra
 		return errors.New("branch repo cannot be nil")
 	}
 
-	if err := d.checkIsAuthorizedInTransaction(txnCtx, branch.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkBranchIsAuthorizedInTransaction(txnCtx, branch.Repo, branch.Name, auth.Scope_WRITER); err != nil {
 		return err
 	}
 
@@ -3014,10 +3158,9 @@ func (d *driver) scratchFilePrefix(file *pfs.File) (string, error) {
 
 func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error {
 	var files []*pfs.File
-	var putFilePaths []string
 	var putFileRecords []*pfs.PutFileRecords
 	var mu sync.Mutex
-	oneOff, repo, branch, err := d.forEachPutFile(pachClient, s, func(req *pfs.PutFileRequest, r io.Reader) error {
+	if err := d.forEachPutFile(pachClient, s, func(req *pfs.PutFileRequest, r io.Reader) error {
 		records, err := d.putFile(pachClient, req.File, req.Delimiter, req.TargetFileDatums,
 			req.TargetFileBytes, req.HeaderRecords, req.OverwriteIndex, req.Delete, r)
 		if err != nil {
@@ -3026,37 +3169,151 @@ func (d *driver) putFiles(pachClient *client.APIClient, s *putFileServer) error
 		mu.Lock()
 		defer mu.Unlock()
 		files = append(files, req.File)
-		putFilePaths = append(putFilePaths, req.File.Path)
 		putFileRecords = append(putFileRecords, records)
 		return nil
-	})
-	if err != nil {
+	}); err != nil {
 		return err
 	}
+	if len(files) == 0 {
+		return nil
+	}
 
+	// The file contents have already been uploaded to object storage, so only
+	// the records are written. In an active transaction, they're appended to
+	// the transaction.
 	ctx := pachClient.Ctx()
-	if oneOff {
-		// oneOff puts only work on branches, so we know branch != "". We pass
-		// a commit with no ID, that ID will be filled in with the head of
-		// branch (if it exists).
-		return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
-			_, err := d.makeCommit(txnCtx, "", client.NewCommit(repo, ""), branch, nil, nil, nil, nil, nil, putFilePaths, putFileRecords, "", time.Time{}, time.Time{}, 0)
-			return err
+	activeTxn, err := client.GetTransaction(ctx)
+	if err != nil {
+		return err
+	}
+	if activeTxn != nil {
+		return d.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+			return txn.PutFile(&transaction.PutFileRecordsRequest{
+				Files:   files,
+				Records: putFileRecords,
+			})
 		})
 	}
+	// Otherwise, the records of files in open commits are upserted one file at
+	// a time, as a single etcd transaction for a large request could exceed
+	// etcd's limit on operations per transaction (--max-txn-ops). One-off puts
+	// on a branch still share a single new commit.
+	var oneOffFiles []*pfs.File
+	var oneOffRecords []*pfs.PutFileRecords
+	for i, file := range files {
+		var oneOff bool
+		if err := d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+			branch, err := d.putFileRecordInTransaction(txnCtx, file, putFileRecords[i])
+			oneOff = branch != ""
+			return err
+		}); err != nil {
+			return err
+		}
+		if oneOff {
+			oneOffFiles = append(oneOffFiles, file)
+			oneOffRecords = append(oneOffRecords, putFileRecords[i])
+		}
+	}
+	if len(oneOffFiles) == 0 {
+		return nil
+	}
+	return d.txnEnv.WithWriteContext(ctx, func(txnCtx *txnenv.TransactionContext) error {
+		return d.putFileRecordsInTransaction(txnCtx, oneOffFiles, oneOffRecords)
+	})
+}
+
+// putFileRecordsInTransaction writes 'records[i]' to 'files[i]' inside an existing STM.
+// Files in an open commit are written to the commit's scratch space. Files on
+// a branch whose HEAD is finished (or that has no HEAD) are written in a new
+// commit on that branch, one per repo and branch.
+func (d *driver) putFileRecordsInTransaction(txnCtx *txnenv.TransactionContext, files []*pfs.File, records []*pfs.PutFileRecords) error {
+	if len(files) != len(records) {
+		return errors.Errorf("got %d files but %d sets of records", len(files), len(records))
+	}
+	type repoBranch struct {
+		repo, branch string
+	}
+	var oneOffs []repoBranch
+	oneOffPaths := make(map[repoBranch][]string)
+	oneOffRecords := make(map[repoBranch][]*pfs.PutFileRecords)
 	for i, file := range files {
-		if err := d.upsertPutFileRecords(pachClient, file, putFileRecords[i]); err != nil {
+		branch, err := d.putFileRecordInTransaction(txnCtx, file, records[i])
+		if err != nil {
+			return err
+		}
+		if branch == "" {
+			continue
+		}
+		key := repoBranch{file.Commit.Repo.Name, branch}
+		if _, ok := oneOffPaths[key]; !ok {
+			oneOffs = append(oneOffs, key)
+		}
+		oneOffPaths[key] = append(oneOffPaths[key], file.Path)
+		oneOffRecords[key] = append(oneOffRecords[key], records[i])
+	}
+	for _, key := range oneOffs {
+		// We pass a commit with no ID, that ID will be filled in with the head
+		// of branch (if it exists).
+		if _, err := d.makeCommit(txnCtx, "", client.NewCommit(key.repo, ""), key.branch, nil, nil, nil, nil, nil, oneOffPaths[key], oneOffRecords[key], "", time.Time{}, time.Time{}, 0); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// putFileRecordInTransaction writes 'records' to the scratch space of 'file'
+// inside an existing STM, if 'file' is in an open commit. Otherwise, 'file' is
+// a one-off put on a branch whose HEAD is finished (or that has no HEAD), and
+// nothing is written: the branch that the caller must make a new commit on is
+// returned instead.
+func (d *driver) putFileRecordInTransaction(txnCtx *txnenv.TransactionContext, file *pfs.File, records *pfs.PutFileRecords) (string, error) {
+	// Validate arguments
+	if file == nil {
+		return "", errors.New("file cannot be nil")
+	}
+	if file.Commit == nil {
+		return "", errors.New("file commit cannot be nil")
+	}
+	if file.Commit.Repo == nil {
+		return "", errors.New("file commit repo cannot be nil")
+	}
+	if err := d.checkCommitIsAuthorizedInTransaction(txnCtx, file.Commit, auth.Scope_WRITER); err != nil {
+		return "", err
+	}
+	if err := checkFilePath(file.Path); err != nil {
+		return "", err
+	}
+	branch := ""
+	if !uuid.IsUUIDWithoutDashes(file.Commit.ID) {
+		branch = file.Commit.ID
+	}
+	// resolveCommit replaces commit.ID with an actual commit ID if it's a
+	// branch, so don't modify the caller's commit.
+	commit := client.NewCommit(file.Commit.Repo.Name, file.Commit.ID)
+	commitInfo, err := d.resolveCommit(txnCtx.Stm, commit)
+	if err != nil {
+		if (!isNotFoundErr(err) && !isNoHeadErr(err)) || branch == "" {
+			return "", err
+		}
+	} else if commitInfo.Finished == nil {
+		return "", d.upsertPutFileRecordsInTransaction(txnCtx, &pfs.File{Commit: commit, Path: file.Path}, records)
+	} else if branch == "" {
+		return "", pfsserver.ErrCommitFinished{file.Commit}
+	}
+	return branch, nil
+}
+
 func (d *driver) putFile(pachClient *client.APIClient, file *pfs.File, delimiter pfs.Delimiter,
 	targetFileDatums, targetFileBytes, headerRecords int64, overwriteIndex *pfs.OverwriteIndex,
 	del bool, reader io.Reader) (*pfs.PutFileRecords, error) {
-	if err := d.checkIsAuthorized(pachClient, file.Commit.Repo, auth.Scope_WRITER); err != nil {
+	// Check auth before uploading any data. In an active transaction the repo
+	// may not exist yet, so auth is only checked when the records are written.
+	if activeTxn, err := client.GetTransaction(pachClient.Ctx()); err != nil {
 		return nil, err
+	} else if activeTxn == nil {
+		if err := d.checkCommitIsAuthorized(pachClient, file.Commit, auth.Scope_WRITER); err != nil {
+			return nil, err
+		}
 	}
 	//  validation -- make sure the various putFileSplit options are coherent
 	hasPutFileOptions := targetFileBytes != 0 || targetFileDatums != 0 || headerRecords != 0
@@ -3361,7 +3618,7 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 	if err := d.checkIsAuthorized(pachClient, src.Commit.Repo, auth.Scope_READER); err != nil {
 		return err
 	}
-	if err := d.checkIsAuthorized(pachClient, dst.Commit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkCommitIsAuthorized(pachClient, dst.Commit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	if err := checkFilePath(dst.Path); err != nil {
@@ -3389,7 +3646,7 @@ func (d *driver) copyFile(pachClient *client.APIClient, src *pfs.File, dst *pfs.
 	var records []*pfs.PutFileRecords // used if 'dst' is finished (atomic 'put file')
 	if overwrite {
 		if dstIsOpenCommit {
-			if err := d.deleteFile(pachClient, dst); err != nil {
+			if err := d.upsertPutFileRecords(pachClient, dst, &pfs.PutFileRecords{Tombstone: true}); err != nil {
 				return err
 			}
 		} else {
@@ -3605,7 +3862,7 @@ func (d *driver) getTreeForFile(pachClient *client.APIClient, file *pfs.File) (h
 		if err != nil {
 			return err
 		}
-		result, err = d.getTreeForOpenCommit(txnCtx.Client, file, parentTree)
+		result, err = d.getTreeForOpenCommit(txnCtx, file, parentTree)
 		return err
 	})
 	if err != nil {
@@ -3617,7 +3874,7 @@ func (d *driver) getTreeForFile(pachClient *client.APIClient, file *pfs.File) (h
 // getTreeForOpenCommit obtains the resulting hashtree made by applying the
 // given file to the hashtree of a parent commit. The returned hash tree is not
 // in the treeCache and must be cleaned up by the caller.
-func (d *driver) getTreeForOpenCommit(pachClient *client.APIClient, file *pfs.File, parentTree hashtree.HashTree) (result hashtree.HashTree, retErr error) {
+func (d *driver) getTreeForOpenCommit(txnCtx *txnenv.TransactionContext, file *pfs.File, parentTree hashtree.HashTree) (result hashtree.HashTree, retErr error) {
 	prefix, err := d.scratchFilePrefix(file)
 	if err != nil {
 		return nil, err
@@ -3633,15 +3890,38 @@ func (d *driver) getTreeForOpenCommit(pachClient *client.APIClient, file *pfs.Fi
 		}
 	}()
 
-	recordsCol := d.putFileRecords.ReadOnly(pachClient.Ctx())
+	// Records written earlier in this transaction are only visible in the STM,
+	// so they're skipped when listing etcd and applied (after the records that
+	// were written before the transaction) from the STM.
+	var stmKeys []string
+	if propagater, ok := txnCtx.PfsPropagater().(*Propagater); ok {
+		stmKeys = propagater.scratchKeysWithPrefix(prefix)
+	}
+	inSTM := make(map[string]bool)
+	for _, key := range stmKeys {
+		inSTM[key] = true
+	}
+	recordsCol := d.putFileRecords.ReadOnly(txnCtx.ClientContext)
 	putFileRecords := &pfs.PutFileRecords{}
 	opts := &col.Options{etcd.SortByModRevision, etcd.SortAscend, true}
 	err = recordsCol.ListPrefix(prefix, putFileRecords, opts, func(key string) error {
+		if inSTM[path.Join(prefix, key)] {
+			return nil
+		}
 		return d.applyWrite(path.Join(file.Path, key), putFileRecords, tree)
 	})
 	if err != nil {
 		return nil, err
 	}
+	stmRecordsCol := d.putFileRecords.ReadWrite(txnCtx.Stm)
+	for _, key := range stmKeys {
+		if err := stmRecordsCol.Get(key, putFileRecords); err != nil {
+			return nil, err
+		}
+		if err := d.applyWrite(path.Join(file.Path, strings.TrimPrefix(key, prefix)), putFileRecords, tree); err != nil {
+			return nil, err
+		}
+	}
 	if err := tree.Hash(); err != nil {
 		return nil, err
 	}
@@ -4296,43 +4576,8 @@ func (d *driver) diffFile(pachClient *client.APIClient, newFile *pfs.File, oldFi
 	return newFileInfos, oldFileInfos, nil
 }
 
-func (d *driver) deleteFile(pachClient *client.APIClient, file *pfs.File) error {
-	// Validate arguments
-	if file == nil {
-		return errors.New("file cannot be nil")
-	}
-	if file.Commit == nil {
-		return errors.New("file commit cannot be nil")
-	}
-	if file.Commit.Repo == nil {
-		return errors.New("file commit repo cannot be nil")
-	}
-
-	if err := d.checkIsAuthorized(pachClient, file.Commit.Repo, auth.Scope_WRITER); err != nil {
-		return err
-	}
-	if err := checkFilePath(file.Path); err != nil {
-		return err
-	}
-	branch := ""
-	if !uuid.IsUUIDWithoutDashes(file.Commit.ID) {
-		branch = file.Commit.ID
-	}
-	commitInfo, err := d.inspectCommit(pachClient, file.Commit, pfs.CommitState_STARTED)
-	if err != nil {
-		return err
-	}
-	if commitInfo.Finished != nil {
-		if branch == "" {
-			return pfsserver.ErrCommitFinished{file.Commit}
-		}
-		return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
-			_, err := d.makeCommit(txnCtx, "", client.NewCommit(file.Commit.Repo.Name, ""), branch, nil, nil, nil, nil, nil, []string{file.Path}, []*pfs.PutFileRecords{&pfs.PutFileRecords{Tombstone: true}}, "", time.Time{}, time.Time{}, 0)
-			return err
-		})
-	}
-
-	return d.upsertPutFileRecords(pachClient, file, &pfs.PutFileRecords{Tombstone: true})
+func (d *driver) deleteFile(txnCtx *txnenv.TransactionContext, file *pfs.File) error {
+	return d.putFileRecordsInTransaction(txnCtx, []*pfs.File{file}, []*pfs.PutFileRecords{{Tombstone: true}})
 }
 
 func (d *driver) deleteAll(txnCtx *txnenv.TransactionContext) error {
@@ -4358,40 +4603,53 @@ func (d *driver) upsertPutFileRecords(
 	pachClient *client.APIClient,
 	file *pfs.File,
 	newRecords *pfs.PutFileRecords,
+) error {
+	return d.txnEnv.WithWriteContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		return d.upsertPutFileRecordsInTransaction(txnCtx, file, newRecords)
+	})
+}
+
+// upsertPutFileRecordsInTransaction is identical to upsertPutFileRecords
+// except that it runs inside an existing STM, and records the written key so
+// that later reads of the open commit in the STM can see it.
+func (d *driver) upsertPutFileRecordsInTransaction(
+	txnCtx *txnenv.TransactionContext,
+	file *pfs.File,
+	newRecords *pfs.PutFileRecords,
 ) error {
 	prefix, err := d.scratchFilePrefix(file)
 	if err != nil {
 		return err
 	}
-
-	ctx := pachClient.Ctx()
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
-		commitsCol := d.openCommits.ReadWrite(stm)
-		var commit pfs.Commit
-		err := commitsCol.Get(file.Commit.ID, &commit)
-		if err != nil {
-			return err
-		}
-		// Dumb check to make sure the unmarshalled value exists (and matches the current ID)
-		// to denote that the current commit is indeed open
-		if commit.ID != file.Commit.ID {
-			return errors.Errorf("commit %v is not open", file.Commit.ID)
-		}
-		recordsCol := d.putFileRecords.ReadWrite(stm)
-		var existingRecords pfs.PutFileRecords
-		return recordsCol.Upsert(prefix, &existingRecords, func() error {
-			if newRecords.Tombstone {
-				existingRecords.Tombstone = true
-				existingRecords.Records = nil
-			}
-			existingRecords.Split = newRecords.Split
-			existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
-			existingRecords.Header = newRecords.Header
-			existingRecords.Footer = newRecords.Footer
-			return nil
-		})
-	})
-	return err
+	commitsCol := d.openCommits.ReadWrite(txnCtx.Stm)
+	var commit pfs.Commit
+	if err := commitsCol.Get(file.Commit.ID, &commit); err != nil {
+		return err
+	}
+	// Dumb check to make sure the unmarshalled value exists (and matches the current ID)
+	// to denote that the current commit is indeed open
+	if commit.ID != file.Commit.ID {
+		return errors.Errorf("commit %v is not open", file.Commit.ID)
+	}
+	recordsCol := d.putFileRecords.ReadWrite(txnCtx.Stm)
+	var existingRecords pfs.PutFileRecords
+	if err := recordsCol.Upsert(prefix, &existingRecords, func() error {
+		if newRecords.Tombstone {
+			existingRecords.Tombstone = true
+			existingRecords.Records = nil
+		}
+		existingRecords.Split = newRecords.Split
+		existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
+		existingRecords.Header = newRecords.Header
+		existingRecords.Footer = newRecords.Footer
+		return nil
+	}); err != nil {
+		return err
+	}
+	if propagater, ok := txnCtx.PfsPropagater().(*Propagater); ok {
+		propagater.trackScratchKey(prefix)
+	}
+	return nil
 }
 
 func (d *driver) applyWrite(key string, records *pfs.PutFileRecords, tree hashtree.HashTree) error {
@@ -4634,14 +4892,14 @@ func (s *putFileServer) Peek() (*pfs.PutFileRequest, error) {
 	return req, nil
 }
 
-func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_PutFileServer, f func(*pfs.PutFileRequest, io.Reader) error) (oneOff bool, repo string, branch string, retErr error) {
+func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_PutFileServer, f func(*pfs.PutFileRequest, io.Reader) error) (retErr error) {
 
 	var pr *io.PipeReader
 	var pw *io.PipeWriter
 	var req *pfs.PutFileRequest
 	var eg errgroup.Group
+	var repo string
 	var rawCommitID string
-	var commitID string
 
 	// Always make sure we've closed any hanging pipes and that our callbacks finish
 	defer func() {
@@ -4665,61 +4923,30 @@ func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_Put
 		req := req
 		if req.File != nil {
 			if req.File.Commit == nil {
-				return false, "", "", errors.New("file commit cannot be nil")
+				return errors.New("file commit cannot be nil")
 			}
 			if req.File.Commit.Repo == nil {
-				return false, "", "", errors.New("file commit repo cannot be nil")
-			}
-
-			// For the first file, dereference the commit ID if needed. For
-			// subsequent files, ensure that they're all referencing the same
-			// commit ID, and replace their commit objects to all refer to the
-			// same thing.
-			if rawCommitID == "" {
-				commit := req.File.Commit
-				repo = commit.Repo.Name
-				// The non-dereferenced commit ID. Used to ensure that all
-				// subsequent requests use the same value.
-				rawCommitID = commit.ID
-				// inspectCommit will replace file.Commit.ID with an actual
-				// commit ID if it's a branch. So we want to save it first.
-				if !uuid.IsUUIDWithoutDashes(commit.ID) {
-					branch = commit.ID
-				}
-				// inspect the commit where we're adding files and figure out
-				// if this is a one-off 'put file'.
-				// - if 'commit' refers to an open commit                -> not oneOff
-				// - otherwise (i.e. branch with closed HEAD or no HEAD) -> yes oneOff
-				// Note that if commit is a specific commit ID, it must be
-				// open for this call to succeed
-				commitInfo, err := d.inspectCommit(pachClient, commit, pfs.CommitState_STARTED)
-				if err != nil {
-					if (!isNotFoundErr(err) && !isNoHeadErr(err)) || branch == "" {
-						return false, "", "", err
-					}
-					oneOff = true
-				}
-				if commitInfo != nil && commitInfo.Finished != nil {
-					if branch == "" {
-						return false, "", "", pfsserver.ErrCommitFinished{commit}
-					}
-					oneOff = true
-				}
-				commitID = commit.ID
+				return errors.New("file commit repo cannot be nil")
+			}
+
+			// Ensure that all files reference the same commit. The commit is
+			// resolved (and a new commit started on the branch if its HEAD is
+			// finished) when the records are written.
+			if repo == "" {
+				repo = req.File.Commit.Repo.Name
+				rawCommitID = req.File.Commit.ID
 			} else if req.File.Commit.ID != rawCommitID {
 				err = errors.Errorf("all requests in a put files call must have the same commit ID; expected '%s', got '%s'", rawCommitID, req.File.Commit.ID)
-				return false, "", "", err
+				return err
 			} else if req.File.Commit.Repo.Name != repo {
 				err = errors.Errorf("all requests in a put files call must have the same repo name; expected '%s', got '%s'", repo, req.File.Commit.Repo.Name)
-				return false, "", "", err
-			} else {
-				req.File.Commit.ID = commitID
+				return err
 			}
 
 			if req.Url != "" {
 				url, err := url.Parse(req.Url)
 				if err != nil {
-					return false, "", "", err
+					return err
 				}
 				switch url.Scheme {
 				case "http":
@@ -4728,9 +4955,9 @@ func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_Put
 					d.putFileLimiter.Acquire()
 					resp, err := http.Get(req.Url)
 					if err != nil {
-						return false, "", "", err
+						return err
 					} else if resp.StatusCode >= 400 {
-						return false, "", "", errors.Errorf("error retrieving content from %q: %s", req.Url, resp.Status)
+						return errors.Errorf("error retrieving content from %q: %s", req.Url, resp.Status)
 					}
 					eg.Go(func() (retErr error) {
 						defer d.putFileLimiter.Release()
@@ -4745,11 +4972,11 @@ func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_Put
 					url, err := obj.ParseURL(req.Url)
 					if err != nil {
 						err = errors.Wrapf(err, "error parsing url %v", req.Url)
-						return false, "", "", err
+						return err
 					}
 					objClient, err := obj.NewClientFromURLAndSecret(url, false)
 					if err != nil {
-						return false, "", "", err
+						return err
 					}
 					if req.Recursive {
 						path := strings.TrimPrefix(url.Object, "/")
@@ -4777,13 +5004,13 @@ func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_Put
 							})
 							return nil
 						}); err != nil {
-							return false, "", "", err
+							return err
 						}
 					} else {
 						d.putFileLimiter.Acquire()
 						r, err := objClient.Reader(server.Context(), url.Object, 0, 0)
 						if err != nil {
-							return false, "", "", err
+							return err
 						}
 						eg.Go(func() (retErr error) {
 							defer d.putFileLimiter.Release()
@@ -4824,14 +5051,14 @@ func (d *driver) forEachPutFile(pachClient *client.APIClient, server pfs.API_Put
 			})
 		}
 		if pw == nil {
-			return false, "", "", errors.New("must send a request with a file first")
+			return errors.New("must send a request with a file first")
 		}
 		if _, err := pw.Write(req.Value); err != nil {
-			return false, "", "", err
+			return err
 		}
 	}
 	if err != nil && !errors.Is(err, io.EOF) {
-		return false, "", "", err
+		return err
 	}
-	return oneOff, repo, branch, nil
+	return nil
 }
@@ -5769,6 +5769,38 @@ func TestUpdateRepo(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRepoLabels(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		repo := "test"
+		createRepo := func(req *pfs.CreateRepoRequest) error {
+			req.Repo = pclient.NewRepo(repo)
+			_, err := env.PachClient.PfsAPIClient.CreateRepo(env.PachClient.Ctx(), req)
+			return err
+		}
+		labels := func() []string {
+			ri, err := env.PachClient.InspectRepo(repo)
+			require.NoError(t, err)
+			return ri.Labels
+		}
+
+		require.YesError(t, createRepo(&pfs.CreateRepoRequest{Labels: []string{"not a label"}}))
+		require.NoError(t, createRepo(&pfs.CreateRepoRequest{Labels: []string{"pii", "confidential", "pii"}}))
+		require.Equal(t, []string{"confidential", "pii"}, labels())
+
+		// Updates that don't set labels leave them alone
+		require.NoError(t, createRepo(&pfs.CreateRepoRequest{Update: true, Description: "foo"}))
+		require.Equal(t, []string{"confidential", "pii"}, labels())
+
+		require.NoError(t, createRepo(&pfs.CreateRepoRequest{Update: true, Description: "foo", Labels: []string{"pii"}}))
+		require.Equal(t, []string{"pii"}, labels())
+		require.NoError(t, createRepo(&pfs.CreateRepoRequest{Update: true, Description: "foo", ClearLabels: true}))
+		require.Equal(t, 0, len(labels()))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestPutObjectAsync(t *testing.T) {
 	t.Parallel()
 	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"strings"
+
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -17,6 +19,12 @@ type Propagater struct {
 	// Branches to propagate when the transaction completes
 	branches    []*pfs.Branch
 	isNewCommit bool
+
+	// Scratch space keys written in the STM, in the order they were first
+	// written. These aren't visible to reads outside of the STM, so reads of
+	// open commits in the transaction must get them from the STM instead.
+	scratchKeys   []string
+	scratchKeySet map[string]bool
 }
 
 func (a *apiServer) NewPropagater(stm col.STM) txnenv.PfsPropagater {
@@ -37,6 +45,30 @@ func (t *Propagater) PropagateCommit(branch *pfs.Branch, isNewCommit bool) error
 	return nil
 }
 
+// trackScratchKey records that the scratch space key 'key' was written in the
+// STM.
+func (t *Propagater) trackScratchKey(key string) {
+	if t.scratchKeySet == nil {
+		t.scratchKeySet = make(map[string]bool)
+	}
+	if !t.scratchKeySet[key] {
+		t.scratchKeySet[key] = true
+		t.scratchKeys = append(t.scratchKeys, key)
+	}
+}
+
+// scratchKeysWithPrefix returns the scratch space keys written in the STM that
+// are equal to or under 'prefix', in the order they were first written.
+func (t *Propagater) scratchKeysWithPrefix(prefix string) []string {
+	var result []string
+	for _, key := range t.scratchKeys {
+		if key == prefix || strings.HasPrefix(key, prefix+"/") {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
 // Run performs any final tasks and cleanup tasks in the STM, such as
 // propagating branches
 func (t *Propagater) Run() error {
@@ -31,7 +31,7 @@ func newValidatedAPIServer(embeddedServer APIServer, env *serviceenv.ServiceEnv)
 func (a *validatedAPIServer) DeleteRepoInTransaction(txnCtx *txnenv.TransactionContext, request *pfs.DeleteRepoRequest) error {
 	repo := request.Repo
 	// Check if the caller is authorized to delete this repo
-	if err := a.checkIsAuthorizedInTransaction(txnCtx, repo, auth.Scope_OWNER); err != nil {
+	if err := a.checkPermissionsInTransaction(txnCtx, repo, auth.Permission_REPO_DELETE); err != nil {
 		return err
 	}
 	return a.APIServer.DeleteRepoInTransaction(txnCtx, request)
@@ -217,3 +217,23 @@ func (a *validatedAPIServer) checkIsAuthorizedInTransaction(txnCtx *txnenv.Trans
 	}
 	return nil
 }
+
+// checkPermissionsInTransaction is like checkIsAuthorizedInTransaction, but
+// checks that the caller has 'perms' on 'r' (which custom roles may grant)
+// rather than a scope
+func (a *validatedAPIServer) checkPermissionsInTransaction(txnCtx *txnenv.TransactionContext, r *pfs.Repo, perms ...auth.Permission) error {
+	me, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	if auth.IsErrNotActivated(err) {
+		return nil
+	}
+
+	req := &auth.AuthorizeRequest{Repo: r.Name, Permissions: perms}
+	resp, err := txnCtx.Auth().AuthorizeInTransaction(txnCtx, req)
+	if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "error during authorization check for operation on \"%s\"", r.Name)
+	}
+	if !resp.Authorized {
+		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Permissions: perms}
+	}
+	return nil
+}
@@ -13,6 +13,8 @@ import (
 	"encoding/pem"
 	"math/big"
 	"net"
+	"net/url"
+	"strings"
 	"sync/atomic"
 	"time"
 
@@ -122,3 +124,81 @@ func GenerateSelfSignedCert(address string, name *pkix.Name, ipAddresses ...stri
 		PrivateKey:  key,
 	}, nil
 }
+
+// GenerateCA generates a self-signed certificate authority whose common name
+// is 'commonName', with a private key. It can sign client certs (see
+// GenerateClientCert), and is intended for tests.
+func GenerateCA(commonName string) (*tls.Certificate, error) {
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate RSA private key")
+	}
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(atomic.AddInt64(&serialNumber, 1)),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-1 * time.Second),
+		NotAfter:              time.Now().Add(validDur),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLenZero:        true,
+	}
+	return sign(ca, ca, key, key)
+}
+
+// GenerateClientCert generates a cert for client authentication (e.g. to
+// pachd over mutual TLS) whose common name is 'commonName', signed by 'ca'
+// (as returned by GenerateCA). Each of 'sans' is added to the cert as an
+// email SAN if it contains "@", a URI SAN if it contains "://", and a DNS SAN
+// otherwise.
+func GenerateClientCert(ca *tls.Certificate, commonName string, sans ...string) (*tls.Certificate, error) {
+	caKey, ok := ca.PrivateKey.(*rsa.PrivateKey)
+	if !ok || ca.Leaf == nil {
+		return nil, errors.New("CA must have a parsed leaf cert and an RSA private key")
+	}
+	key, err := rsa.GenerateKey(rand.Reader, rsaKeySize)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not generate RSA private key")
+	}
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(atomic.AddInt64(&serialNumber, 1)),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-1 * time.Second),
+		NotAfter:     time.Now().Add(validDur),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, san := range sans {
+		switch {
+		case strings.Contains(san, "://"):
+			u, err := url.Parse(san)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid URI SAN %q", san)
+			}
+			cert.URIs = append(cert.URIs, u)
+		case strings.Contains(san, "@"):
+			cert.EmailAddresses = append(cert.EmailAddresses, san)
+		default:
+			cert.DNSNames = append(cert.DNSNames, san)
+		}
+	}
+	return sign(cert, ca.Leaf, key, caKey)
+}
+
+// sign signs 'cert' (whose private key is 'key') with 'parent' (whose private
+// key is 'parentKey'), and returns the result with its parsed leaf
+func sign(cert, parent *x509.Certificate, key, parentKey *rsa.PrivateKey) (*tls.Certificate, error) {
+	signedCertDER, err := x509.CreateCertificate(rand.Reader, cert, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not sign certificate")
+	}
+	signedCert, err := x509.ParseCertificate(signedCertDER)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse the just-generated signed certificate")
+	}
+	return &tls.Certificate{
+		Certificate: [][]byte{signedCertDER},
+		Leaf:        signedCert,
+		PrivateKey:  key,
+	}, nil
+}
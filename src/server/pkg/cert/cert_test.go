@@ -153,3 +153,40 @@ TF+qRCQa5ciV5EtqZWgI5xpVSPDxf6C+Mm17vw==
 	require.Equal(t, privateKey, KeyToPEM(&tlsCert))
 	require.Equal(t, cert, PublicCertToPEM(&tlsCert))
 }
+
+// TestClientCert generates a CA and a client cert signed by it, and then
+// verifies the client cert against the CA
+func TestClientCert(t *testing.T) {
+	ca, err := GenerateCA("Test CA")
+	require.NoError(t, err)
+	cert, err := GenerateClientCert(ca, "ci", "ci.example.com",
+		"ci@example.com", "spiffe://example.com/ci")
+	require.NoError(t, err)
+	require.Equal(t, "ci", cert.Leaf.Subject.CommonName)
+	require.Equal(t, []string{"ci.example.com"}, cert.Leaf.DNSNames)
+	require.Equal(t, []string{"ci@example.com"}, cert.Leaf.EmailAddresses)
+	require.Equal(t, 1, len(cert.Leaf.URIs))
+	require.Equal(t, "spiffe://example.com/ci", cert.Leaf.URIs[0].String())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.Leaf)
+	_, err = cert.Leaf.Verify(x509.VerifyOptions{
+		Roots:     pool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.NoError(t, err)
+
+	// The client cert isn't valid for server authentication, and doesn't
+	// verify against a different CA
+	_, err = cert.Leaf.Verify(x509.VerifyOptions{Roots: pool})
+	require.YesError(t, err)
+	otherCA, err := GenerateCA("Other CA")
+	require.NoError(t, err)
+	otherPool := x509.NewCertPool()
+	otherPool.AddCert(otherCA.Leaf)
+	_, err = cert.Leaf.Verify(x509.VerifyOptions{
+		Roots:     otherPool,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	require.YesError(t, err)
+}
@@ -10,6 +10,7 @@ import (
 	"text/template"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 
@@ -104,10 +105,30 @@ func ErrorAndExit(format string, args ...interface{}) {
 	os.Exit(1)
 }
 
+// contextDefaults returns the active context (with any values it inherits
+// filled in), whose defaults are used for the parts of arguments that are
+// omitted. It returns an empty context if the config can't be read.
+var contextDefaults = func() *config.Context {
+	cfg, err := config.Read(false)
+	if err != nil {
+		return &config.Context{}
+	}
+	_, context, err := cfg.ActiveResolvedContext(false)
+	if err != nil || context == nil {
+		return &config.Context{}
+	}
+	return context
+}
+
 // ParseCommit takes an argument of the form "repo[@branch-or-commit]" and
-// returns the corresponding *pfs.Commit.
+// returns the corresponding *pfs.Commit. If the repo or the branch is omitted
+// (e.g. "@master" or "repo@"), the active context's default repo or branch is
+// used.
 func ParseCommit(arg string) (*pfs.Commit, error) {
 	parts := strings.SplitN(arg, "@", 2)
+	if parts[0] == "" && len(parts) == 2 {
+		parts[0] = contextDefaults().DefaultRepo
+	}
 	if parts[0] == "" {
 		return nil, errors.Errorf("invalid format \"%s\": repo cannot be empty", arg)
 	}
@@ -119,6 +140,9 @@ func ParseCommit(arg string) (*pfs.Commit, error) {
 	}
 	if len(parts) == 2 {
 		commit.ID = parts[1]
+		if commit.ID == "" {
+			commit.ID = contextDefaults().DefaultBranch
+		}
 	}
 	return commit, nil
 }
@@ -219,9 +243,14 @@ func ParseCommitProvenances(args []string) ([]*pfs.CommitProvenance, error) {
 }
 
 // ParseFile takes an argument of the form "repo[@branch-or-commit[:path]]", and
-// returns the corresponding *pfs.File.
+// returns the corresponding *pfs.File. If the repo or the branch is omitted
+// (e.g. "@master:/file" or "repo@:/file"), the active context's default repo
+// or branch is used.
 func ParseFile(arg string) (*pfs.File, error) {
 	repoAndRest := strings.SplitN(arg, "@", 2)
+	if repoAndRest[0] == "" && len(repoAndRest) == 2 {
+		repoAndRest[0] = contextDefaults().DefaultRepo
+	}
 	if repoAndRest[0] == "" {
 		return nil, errors.Errorf("invalid format \"%s\": repo cannot be empty", arg)
 	}
@@ -236,6 +265,9 @@ func ParseFile(arg string) (*pfs.File, error) {
 	}
 	if len(repoAndRest) > 1 {
 		commitAndPath := strings.SplitN(repoAndRest[1], ":", 2)
+		if commitAndPath[0] == "" {
+			commitAndPath[0] = contextDefaults().DefaultBranch
+		}
 		if commitAndPath[0] == "" {
 			return nil, errors.Errorf("invalid format \"%s\": commit cannot be empty", arg)
 		}
@@ -243,6 +275,8 @@ func ParseFile(arg string) (*pfs.File, error) {
 		if len(commitAndPath) > 1 {
 			file.Path = commitAndPath[1]
 		}
+	} else {
+		file.Commit.ID = contextDefaults().DefaultBranch
 	}
 	return file, nil
 }
@@ -0,0 +1,112 @@
+package cmdutil
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/config"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// withContextDefaults makes the parse functions use the given context's
+// defaults, returning a function that undoes this.
+func withContextDefaults(context *config.Context) func() {
+	prev := contextDefaults
+	contextDefaults = func() *config.Context { return context }
+	return func() { contextDefaults = prev }
+}
+
+func TestParseDefaults(t *testing.T) {
+	// Without defaults, the repo (and, for files, the branch) is required
+	defer withContextDefaults(&config.Context{})()
+	_, err := ParseFile("@master:/file")
+	require.YesError(t, err)
+	_, err = ParseFile("images@:/file")
+	require.YesError(t, err)
+	_, err = ParseCommit("@master")
+	require.YesError(t, err)
+	file, err := ParseFile("images")
+	require.NoError(t, err)
+	require.Equal(t, "", file.Commit.ID)
+
+	defer withContextDefaults(&config.Context{DefaultRepo: "images", DefaultBranch: "staging"})()
+	file, err = ParseFile("@master:/file")
+	require.NoError(t, err)
+	require.Equal(t, "images", file.Commit.Repo.Name)
+	require.Equal(t, "master", file.Commit.ID)
+	require.Equal(t, "/file", file.Path)
+
+	file, err = ParseFile("edges@:/file")
+	require.NoError(t, err)
+	require.Equal(t, "edges", file.Commit.Repo.Name)
+	require.Equal(t, "staging", file.Commit.ID)
+
+	file, err = ParseFile("@:/file")
+	require.NoError(t, err)
+	require.Equal(t, "images", file.Commit.Repo.Name)
+	require.Equal(t, "staging", file.Commit.ID)
+
+	file, err = ParseFile("edges")
+	require.NoError(t, err)
+	require.Equal(t, "staging", file.Commit.ID)
+
+	branch, err := ParseBranch("@")
+	require.NoError(t, err)
+	require.Equal(t, "images", branch.Repo.Name)
+	require.Equal(t, "staging", branch.Name)
+
+	// Commits without a branch still refer to the whole repo
+	commit, err := ParseCommit("edges")
+	require.NoError(t, err)
+	require.Equal(t, "", commit.ID)
+
+	// An empty argument is still an error
+	_, err = ParseCommit("")
+	require.YesError(t, err)
+}
+
+func TestApplyContextOutputFormat(t *testing.T) {
+	newCmd := func(withOutput bool) (*cobra.Command, *bool, *string) {
+		var raw bool
+		var output string
+		flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+		flags.BoolVar(&raw, "raw", false, "")
+		if withOutput {
+			flags.StringVarP(&output, "output", "o", "", "")
+		}
+		MarkOutputFlags(flags)
+		cmd := &cobra.Command{Use: "test"}
+		cmd.Flags().AddFlagSet(flags)
+		return cmd, &raw, &output
+	}
+
+	defer withContextDefaults(&config.Context{OutputFormat: "yaml"})()
+	cmd, raw, output := newCmd(true)
+	require.NoError(t, ApplyContextOutputFormat(cmd))
+	require.False(t, *raw)
+	require.Equal(t, "yaml", *output)
+
+	// Flags given on the command line take precedence
+	cmd, raw, output = newCmd(true)
+	require.NoError(t, cmd.ParseFlags([]string{"--raw"}))
+	require.NoError(t, ApplyContextOutputFormat(cmd))
+	require.True(t, *raw)
+	require.Equal(t, "", *output)
+
+	// Commands that only print json ignore other formats
+	cmd, raw, _ = newCmd(false)
+	require.NoError(t, ApplyContextOutputFormat(cmd))
+	require.False(t, *raw)
+
+	defer withContextDefaults(&config.Context{OutputFormat: "json"})()
+	cmd, raw, _ = newCmd(false)
+	require.NoError(t, ApplyContextOutputFormat(cmd))
+	require.True(t, *raw)
+
+	// Unmarked flags are left alone
+	cmd = &cobra.Command{Use: "logs"}
+	cmd.Flags().BoolVar(new(bool), "raw", false, "")
+	require.NoError(t, ApplyContextOutputFormat(cmd))
+	require.False(t, cmd.Flags().Changed("raw"))
+}
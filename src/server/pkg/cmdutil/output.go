@@ -0,0 +1,354 @@
+package cmdutil
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serde"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// The output formats that can be selected with --output
+const (
+	TableFormat    = "table"
+	JSONFormat     = "json"
+	JSONLFormat    = "jsonl"
+	YAMLFormat     = "yaml"
+	CSVFormat      = "csv"
+	TemplateFormat = "template"
+	JSONPathFormat = "jsonpath"
+)
+
+const outputFlagUsage = `Output format: "table" (the default), "json", ` +
+	`"yaml", "jsonl" (one json object per line), "csv" (the table's columns, ` +
+	`with raw values), ` +
+	`"template=<go-template>" or "jsonpath=<expr>". Templates and JSONPath ` +
+	`expressions are applied to each result, whose fields have the names used ` +
+	`in json output (e.g. -o 'jsonpath={.repo.name}').`
+
+// outputFormatAnnotation marks the flags that select how a command prints
+// structured output: --raw, and --output where the command supports formats
+// other than json.
+const outputFormatAnnotation = "pachctl_output_format"
+
+// MarkOutputFlags marks the "raw" and "output" flags in 'flags' (if present)
+// as selecting a command's output format, so that they default to the active
+// context's output format (see ApplyContextOutputFormat).
+func MarkOutputFlags(flags *pflag.FlagSet) {
+	for _, name := range []string{"raw", "output"} {
+		if flags.Lookup(name) != nil {
+			flags.SetAnnotation(name, outputFormatAnnotation, []string{"true"})
+		}
+	}
+}
+
+func isOutputFlag(flag *pflag.Flag) bool {
+	return flag != nil && flag.Annotations[outputFormatAnnotation] != nil
+}
+
+// ApplyContextOutputFormat makes 'cmd' print structured output in the active
+// context's output format, if the context has one and neither --raw nor
+// --output was given on the command line. Commands that only print json
+// (i.e. that have no --output flag) are only affected if the context's output
+// format is json.
+func ApplyContextOutputFormat(cmd *cobra.Command) error {
+	flags := cmd.Flags()
+	raw, output := flags.Lookup("raw"), flags.Lookup("output")
+	if !isOutputFlag(raw) || raw.Changed {
+		return nil
+	}
+	format := contextDefaults().OutputFormat
+	switch {
+	case format == "":
+		return nil
+	case isOutputFlag(output):
+		if output.Changed {
+			return nil
+		}
+		return flags.Set("output", format)
+	case format == JSONFormat:
+		return flags.Set("raw", "true")
+	}
+	return nil
+}
+
+// OutputFormat is a parsed --output flag
+type OutputFormat struct {
+	// Name is one of the *Format constants
+	Name string
+
+	template *template.Template
+	jsonPath *jsonpath.JSONPath
+}
+
+// ParseOutputFormat parses the value of an --output flag. The empty string
+// selects the table format.
+func ParseOutputFormat(value string) (*OutputFormat, error) {
+	name, arg := value, ""
+	if i := strings.Index(value, "="); i >= 0 {
+		name, arg = value[:i], value[i+1:]
+	}
+	format := &OutputFormat{Name: strings.ToLower(name)}
+	switch format.Name {
+	case "":
+		format.Name = TableFormat
+	case TableFormat, JSONFormat, JSONLFormat, YAMLFormat, CSVFormat:
+	case TemplateFormat:
+		t, err := template.New("output").Parse(arg)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse output template")
+		}
+		format.template = t
+		return format, nil
+	case JSONPathFormat:
+		// Like kubectl, accept expressions without the enclosing braces
+		if !strings.Contains(arg, "{") {
+			arg = "{" + arg + "}"
+		}
+		format.jsonPath = jsonpath.New("output")
+		if err := format.jsonPath.Parse(arg); err != nil {
+			return nil, errors.Wrapf(err, "could not parse output jsonpath")
+		}
+		return format, nil
+	default:
+		return nil, errors.Errorf("invalid output format %q; use one of "+
+			"table, json, yaml, jsonl, csv, template=<go-template> or jsonpath=<expr>", value)
+	}
+	if arg != "" {
+		return nil, errors.Errorf("output format %q doesn't take an argument", name)
+	}
+	return format, nil
+}
+
+// IsOutputFormat returns true if 'value' is a valid --output flag
+func IsOutputFormat(value string) bool {
+	_, err := ParseOutputFormat(value)
+	return err == nil
+}
+
+// OutputFlags holds the --raw and --output flags of commands that print
+// results
+type OutputFlags struct {
+	Raw    bool
+	Output string
+
+	// RawMarshaler, if set, is used to print results when --raw is given
+	// without --output, so that the output of commands that have always
+	// printed json this way doesn't change.
+	RawMarshaler *jsonpb.Marshaler
+}
+
+// NewOutputFlags returns a new OutputFlags and the flag set that sets it
+func NewOutputFlags() (*OutputFlags, *pflag.FlagSet) {
+	f := &OutputFlags{}
+	flags := pflag.NewFlagSet("", pflag.ContinueOnError)
+	flags.BoolVar(&f.Raw, "raw", false, "Disable pretty printing and print json.")
+	flags.StringVarP(&f.Output, "output", "o", "", outputFlagUsage)
+	MarkOutputFlags(flags)
+	return f, flags
+}
+
+// Format returns the output format selected by the flags
+func (f *OutputFlags) Format() (*OutputFormat, error) {
+	if f.Raw && f.Output == "" {
+		return &OutputFormat{Name: JSONFormat}, nil
+	}
+	format, err := ParseOutputFormat(f.Output)
+	if err != nil {
+		return nil, err
+	}
+	if f.Raw && format.Name == TableFormat {
+		return nil, errors.Errorf("cannot use --raw with --output %s", f.Output)
+	}
+	return format, nil
+}
+
+// Structured returns true if the flags select a format that prints results'
+// fields (rather than a table, which may not need all of them)
+func (f *OutputFlags) Structured() bool {
+	format, err := f.Format()
+	return err == nil && format.Name != TableFormat && format.Name != CSVFormat
+}
+
+// Table describes how a command pretty prints its results
+type Table struct {
+	// Header is the table's header, in the form expected by tabwriter
+	Header string
+	// PrintRow prints a result as a row of the table
+	PrintRow func(w io.Writer, item proto.Message)
+	// PrintDetailed, if set, is used to pretty print each result instead of a
+	// table row. It's set by commands that inspect a single result, and by
+	// commands whose results aren't tabular.
+	PrintDetailed func(item proto.Message) error
+	// CSVHeader and CSVRow are used by --output csv, which is only supported
+	// if they're set. Their columns are the table's, named as in json output,
+	// but hold raw values (e.g. RFC 3339 timestamps and sizes in bytes)
+	// rather than pretty printed ones.
+	CSVHeader []string
+	CSVRow    func(item proto.Message) []string
+}
+
+// Printer prints a command's results in the format selected by its
+// OutputFlags. Each result is written as soon as it's printed, so that list
+// commands stream their results.
+type Printer struct {
+	w         io.Writer
+	format    *OutputFormat
+	table     Table
+	marshaler *jsonpb.Marshaler
+	encoder   serde.Encoder
+	tw        *tabwriter.Writer
+	csv       *csv.Writer
+}
+
+// NewPrinter returns a Printer that writes results to 'w'
+func (f *OutputFlags) NewPrinter(w io.Writer, table Table) (*Printer, error) {
+	format, err := f.Format()
+	if err != nil {
+		return nil, err
+	}
+	p := &Printer{w: w, format: format, table: table}
+	switch format.Name {
+	case JSONFormat:
+		if f.Raw && f.Output == "" && f.RawMarshaler != nil {
+			p.marshaler = f.RawMarshaler
+			break
+		}
+		p.encoder = serde.NewJSONEncoder(w, serde.WithIndent(2), serde.WithOrigName(true))
+	case YAMLFormat:
+		p.encoder = serde.NewYAMLEncoder(w, serde.WithOrigName(true))
+	case JSONLFormat:
+		p.marshaler = &jsonpb.Marshaler{OrigName: true}
+	case CSVFormat:
+		if table.CSVRow == nil {
+			return nil, errors.Errorf("this command doesn't support --output csv")
+		}
+		p.csv = csv.NewWriter(w)
+		if err := p.writeCSV(table.CSVHeader); err != nil {
+			return nil, err
+		}
+	case TableFormat:
+		if table.PrintDetailed == nil {
+			p.tw = tabwriter.NewWriter(w, table.Header)
+		}
+	}
+	return p, nil
+}
+
+// Print prints a single result, for commands that inspect one thing
+func (f *OutputFlags) Print(w io.Writer, table Table, item proto.Message) error {
+	p, err := f.NewPrinter(w, table)
+	if err != nil {
+		return err
+	}
+	if err := p.Print(item); err != nil {
+		return err
+	}
+	return p.Close()
+}
+
+// Print prints one result
+func (p *Printer) Print(item proto.Message) error {
+	switch p.format.Name {
+	case JSONFormat:
+		if p.marshaler != nil {
+			return p.marshaler.Marshal(p.w, item)
+		}
+		if err := p.encoder.EncodeProto(item); err != nil {
+			return err
+		}
+		_, err := io.WriteString(p.w, "\n")
+		return err
+	case YAMLFormat:
+		return p.encoder.EncodeProto(item)
+	case JSONLFormat:
+		if err := p.marshaler.Marshal(p.w, item); err != nil {
+			return err
+		}
+		_, err := io.WriteString(p.w, "\n")
+		return err
+	case CSVFormat:
+		return p.writeCSV(p.table.CSVRow(item))
+	case TemplateFormat, JSONPathFormat:
+		return p.execute(item)
+	}
+	if p.table.PrintDetailed != nil {
+		return p.table.PrintDetailed(item)
+	}
+	p.table.PrintRow(p.tw, item)
+	return nil
+}
+
+// writeCSV writes a csv record, and flushes it so that results are streamed
+func (p *Printer) writeCSV(record []string) error {
+	if err := p.csv.Write(record); err != nil {
+		return errors.EnsureStack(err)
+	}
+	p.csv.Flush()
+	return errors.EnsureStack(p.csv.Error())
+}
+
+// execute prints 'item' with the --output template or jsonpath expression,
+// followed by a newline if the output doesn't end in one
+func (p *Printer) execute(item proto.Message) error {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{OrigName: true}).Marshal(&buf, item); err != nil {
+		return errors.Wrapf(err, "serialization error while canonicalizing output")
+	}
+	var data interface{}
+	d := json.NewDecoder(&buf)
+	d.UseNumber()
+	if err := d.Decode(&data); err != nil {
+		return errors.Wrapf(err, "deserialization error while canonicalizing output")
+	}
+	buf.Reset()
+	if p.format.template != nil {
+		if err := p.format.template.Execute(&buf, data); err != nil {
+			return errors.Wrapf(err, "could not execute output template")
+		}
+	} else if err := p.format.jsonPath.Execute(&buf, data); err != nil {
+		return errors.Wrapf(err, "could not execute output jsonpath")
+	}
+	if buf.Len() == 0 || buf.Bytes()[buf.Len()-1] != '\n' {
+		buf.WriteByte('\n')
+	}
+	_, err := p.w.Write(buf.Bytes())
+	return err
+}
+
+// Close flushes any results that haven't been written yet
+func (p *Printer) Close() error {
+	switch {
+	case p.tw != nil:
+		return p.tw.Flush()
+	case p.csv != nil:
+		p.csv.Flush()
+		return errors.EnsureStack(p.csv.Error())
+	}
+	return nil
+}
+
+// CSVTimestamp formats 't' for csv output, as an RFC 3339 timestamp in UTC
+// (or "" if 't' is unset)
+func CSVTimestamp(t *types.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	ts, err := types.TimestampFromProto(t)
+	if err != nil {
+		return ""
+	}
+	return ts.UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,110 @@
+package cmdutil
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+var testRepos = []*pfs.RepoInfo{
+	{Repo: &pfs.Repo{Name: "images"}, SizeBytes: 100, Description: "raw, unprocessed"},
+	{Repo: &pfs.Repo{Name: "edges"}, SizeBytes: 20},
+}
+
+var testRepoTable = Table{
+	Header: "NAME\tSIZE\tDESCRIPTION\t\n",
+	PrintRow: func(w io.Writer, item proto.Message) {
+		ri := item.(*pfs.RepoInfo)
+		fmt.Fprintf(w, "\x1b[1m%s\x1b[0m\t%d\t%s\t\n", ri.Repo.Name, ri.SizeBytes, ri.Description)
+	},
+	CSVHeader: []string{"name", "size_bytes", "description"},
+	CSVRow: func(item proto.Message) []string {
+		ri := item.(*pfs.RepoInfo)
+		return []string{ri.Repo.Name, strconv.FormatUint(ri.SizeBytes, 10), ri.Description}
+	},
+}
+
+// printRepos prints testRepos with the given output flags
+func printRepos(t *testing.T, f *OutputFlags) string {
+	t.Helper()
+	var buf bytes.Buffer
+	p, err := f.NewPrinter(&buf, testRepoTable)
+	require.NoError(t, err)
+	for _, ri := range testRepos {
+		require.NoError(t, p.Print(ri))
+	}
+	require.NoError(t, p.Close())
+	return buf.String()
+}
+
+func TestOutputFormats(t *testing.T) {
+	require.Equal(t, `{"repo":{"name":"images"},"size_bytes":"100","description":"raw, unprocessed"}
+{"repo":{"name":"edges"},"size_bytes":"20"}
+`, printRepos(t, &OutputFlags{Output: "jsonl"}))
+
+	require.Equal(t, `description: raw, unprocessed
+repo:
+    name: images
+size_bytes: "100"
+---
+repo:
+    name: edges
+size_bytes: "20"
+`, printRepos(t, &OutputFlags{Output: "yaml"}))
+
+	// csv output has the table's columns, with raw values
+	require.Equal(t, `name,size_bytes,description
+images,100,"raw, unprocessed"
+edges,20,
+`, printRepos(t, &OutputFlags{Output: "csv"}))
+	// ...which can contain tabs and newlines
+	var buf bytes.Buffer
+	require.NoError(t, (&OutputFlags{Output: "csv"}).Print(&buf, testRepoTable, &pfs.RepoInfo{
+		Repo:        &pfs.Repo{Name: "logs"},
+		Description: "tab\tseparated\nlines",
+	}))
+	require.Equal(t, "name,size_bytes,description\nlogs,0,\"tab\tseparated\nlines\"\n", buf.String())
+
+	require.Equal(t, "images 100\nedges 20\n",
+		printRepos(t, &OutputFlags{Output: "template={{.repo.name}} {{.size_bytes}}"}))
+	require.Equal(t, "images\nedges\n", printRepos(t, &OutputFlags{Output: "jsonpath={.repo.name}"}))
+	require.Equal(t, "images\nedges\n", printRepos(t, &OutputFlags{Output: "jsonpath=.repo.name"}))
+
+	// --raw is the same as --output json, unless the command prints --raw
+	// output its own way
+	jsonOutput := printRepos(t, &OutputFlags{Output: "json"})
+	require.Equal(t, jsonOutput, printRepos(t, &OutputFlags{Raw: true}))
+	require.True(t, bytes.Contains([]byte(jsonOutput), []byte(`"size_bytes": "100"`)))
+	require.Equal(t, `{"repo":{"name":"images"},"sizeBytes":"100","description":"raw, unprocessed"}{"repo":{"name":"edges"},"sizeBytes":"20"}`,
+		printRepos(t, &OutputFlags{Raw: true, RawMarshaler: &jsonpb.Marshaler{}}))
+}
+
+func TestOutputFormatErrors(t *testing.T) {
+	for _, value := range []string{"xml", "json=x", "template={{.repo", "jsonpath={.repo"} {
+		require.False(t, IsOutputFormat(value), value)
+		_, err := (&OutputFlags{Output: value}).NewPrinter(&bytes.Buffer{}, testRepoTable)
+		require.YesError(t, err)
+	}
+	_, err := (&OutputFlags{Raw: true, Output: "table"}).Format()
+	require.YesError(t, err)
+
+	// Commands that only pretty print results in detail don't support csv
+	_, err = (&OutputFlags{Output: "csv"}).NewPrinter(&bytes.Buffer{}, Table{
+		PrintDetailed: func(proto.Message) error { return nil },
+	})
+	require.YesError(t, err)
+}
+
+func TestStructured(t *testing.T) {
+	require.False(t, (&OutputFlags{}).Structured())
+	require.False(t, (&OutputFlags{Output: "csv"}).Structured())
+	require.True(t, (&OutputFlags{Raw: true}).Structured())
+	require.True(t, (&OutputFlags{Output: "jsonpath={.repo}"}).Structured())
+}
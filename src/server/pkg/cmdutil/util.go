@@ -4,6 +4,9 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 )
 
 // InteractiveConfirm will ask the user to confirm an action on the command-line with a y/n response.
@@ -19,3 +22,22 @@ func InteractiveConfirm() (bool, error) {
 	}
 	return false, nil
 }
+
+// ParseSince parses a --since flag, which is either a duration before now or
+// an RFC 3339 timestamp.
+func ParseSince(since string) (time.Time, error) {
+	return ParseTimeFlag("since", since)
+}
+
+// ParseTimeFlag parses the value of the named time flag, which is either a
+// duration before now or an RFC 3339 timestamp.
+func ParseTimeFlag(flag, value string) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid --%s %q, must be a duration (e.g. 24h) or an RFC 3339 timestamp", flag, value)
+	}
+	return t, nil
+}
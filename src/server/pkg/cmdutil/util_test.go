@@ -0,0 +1,21 @@
+package cmdutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestParseSince(t *testing.T) {
+	since, err := ParseSince("24h")
+	require.NoError(t, err)
+	require.True(t, time.Since(since) >= 24*time.Hour)
+
+	since, err = ParseSince("2020-06-01T00:00:00Z")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), since.UTC())
+
+	_, err = ParseSince("yesterday")
+	require.YesError(t, err)
+}
@@ -0,0 +1,155 @@
+// Package datapolicy evaluates the data classification policies in a
+// cluster's auth config against the labels on PFS repos. Policies are attached
+// to labels (e.g. "pii"), and determine whether a label propagates to
+// downstream repos and which data flows it forbids.
+package datapolicy
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+var validLabelRe = regexp.MustCompile("^[a-zA-Z0-9_-]+$")
+
+// ValidateLabel returns an error if 'label' is not a valid classification
+// label (labels consist of alphanumeric characters, dashes and underscores)
+func ValidateLabel(label string) error {
+	if !validLabelRe.MatchString(label) {
+		return errors.Errorf("invalid label %q: labels may only contain "+
+			"alphanumeric characters, dashes and underscores", label)
+	}
+	return nil
+}
+
+// Validate returns an error if 'policies' is not a valid set of data policies
+func Validate(policies []*auth.DataPolicy) error {
+	seen := make(map[string]bool)
+	for _, p := range policies {
+		if err := ValidateLabel(p.Label); err != nil {
+			return errors.Wrapf(err, "invalid data policy")
+		}
+		if seen[p.Label] {
+			return errors.Errorf("invalid data policy: label %q has more than one policy", p.Label)
+		}
+		seen[p.Label] = true
+	}
+	return nil
+}
+
+// ErrPolicyViolation is returned when an operation would violate a data policy
+type ErrPolicyViolation struct {
+	// Label is the label whose policy would be violated
+	Label string
+	// Rule describes the violated rule, e.g. "deny_egress"
+	Rule string
+	// Op describes the forbidden operation
+	Op string
+}
+
+const errPolicyViolationMsg = "data policy violation"
+
+func (e *ErrPolicyViolation) Error() string {
+	return fmt.Sprintf("%s: %s is forbidden for data labelled %q (%s)", errPolicyViolationMsg, e.Op, e.Label, e.Rule)
+}
+
+// IsErrPolicyViolation returns true if 'err' is an ErrPolicyViolation. Like
+// auth.IsErrNotAuthorized, this matches on the error's text, so that it works
+// on errors that have crossed a GRPC boundary
+func IsErrPolicyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), errPolicyViolationMsg)
+}
+
+// Engine evaluates a set of data policies. A nil Engine has no policies, and
+// permits everything.
+type Engine struct {
+	policies map[string]*auth.DataPolicy
+}
+
+// New returns an Engine that evaluates 'policies'. It returns nil if there are
+// no policies.
+func New(policies []*auth.DataPolicy) *Engine {
+	if len(policies) == 0 {
+		return nil
+	}
+	e := &Engine{policies: make(map[string]*auth.DataPolicy)}
+	for _, p := range policies {
+		e.policies[p.Label] = p
+	}
+	return e
+}
+
+// policy returns the policy for 'label', or an empty policy if there is none
+func (e *Engine) policy(label string) *auth.DataPolicy {
+	if e == nil || e.policies[label] == nil {
+		return &auth.DataPolicy{}
+	}
+	return e.policies[label]
+}
+
+// Inherited returns the subset of 'labels' that propagate to repos downstream
+// of a repo with those labels
+func (e *Engine) Inherited(labels []string) []string {
+	var result []string
+	for _, l := range labels {
+		if e.policy(l).Inherit {
+			result = append(result, l)
+		}
+	}
+	return Union(result)
+}
+
+// CheckEgress returns an ErrPolicyViolation if data with 'labels' may not be
+// egressed out of the cluster
+func (e *Engine) CheckEgress(labels []string) error {
+	for _, l := range labels {
+		if e.policy(l).DenyEgress {
+			return &ErrPolicyViolation{Label: l, Rule: "deny_egress", Op: "egress"}
+		}
+	}
+	return nil
+}
+
+// CheckPublic returns an ErrPolicyViolation if data with 'labels' may not be
+// readable by every user in the cluster
+func (e *Engine) CheckPublic(labels []string) error {
+	for _, l := range labels {
+		if e.policy(l).DenyPublic {
+			return &ErrPolicyViolation{Label: l, Rule: "deny_public", Op: "granting access to all cluster users"}
+		}
+	}
+	return nil
+}
+
+// Labels returns all labels that apply to a repo, i.e. its own labels and the
+// labels it has inherited
+func Labels(repoInfo *pfs.RepoInfo) []string {
+	return Union(repoInfo.Labels, repoInfo.InheritedLabels)
+}
+
+// Union returns the sorted, de-duplicated union of 'labelSets'
+func Union(labelSets ...[]string) []string {
+	set := make(map[string]bool)
+	for _, labels := range labelSets {
+		for _, l := range labels {
+			set[l] = true
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(set))
+	for l := range set {
+		result = append(result, l)
+	}
+	sort.Strings(result)
+	return result
+}
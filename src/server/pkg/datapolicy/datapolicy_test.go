@@ -0,0 +1,57 @@
+package datapolicy
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestValidate(t *testing.T) {
+	require.NoError(t, Validate(nil))
+	require.NoError(t, Validate([]*auth.DataPolicy{
+		{Label: "pii", Inherit: true, DenyEgress: true},
+		{Label: "confidential_2", DenyPublic: true},
+	}))
+	require.YesError(t, Validate([]*auth.DataPolicy{{Label: ""}}))
+	require.YesError(t, Validate([]*auth.DataPolicy{{Label: "not a label"}}))
+	require.YesError(t, Validate([]*auth.DataPolicy{{Label: "pii"}, {Label: "pii"}}))
+}
+
+func TestEngine(t *testing.T) {
+	e := New([]*auth.DataPolicy{
+		{Label: "pii", Inherit: true, DenyEgress: true, DenyPublic: true},
+		{Label: "confidential", DenyPublic: true},
+	})
+
+	// Only labels whose policy sets 'inherit' propagate downstream
+	require.Equal(t, []string{"pii"}, e.Inherited([]string{"pii", "confidential", "other", "pii"}))
+	require.Equal(t, 0, len(e.Inherited([]string{"confidential"})))
+
+	require.NoError(t, e.CheckEgress([]string{"confidential", "other"}))
+	err := e.CheckEgress([]string{"other", "pii"})
+	require.YesError(t, err)
+	require.True(t, IsErrPolicyViolation(err))
+	require.True(t, IsErrPolicyViolation(errors.New(err.Error())))
+	require.Matches(t, `"pii"`, err.Error())
+
+	require.NoError(t, e.CheckPublic([]string{"other"}))
+	require.YesError(t, e.CheckPublic([]string{"confidential"}))
+
+	// A nil engine (no policies) permits everything
+	var none *Engine
+	require.Equal(t, none, New(nil))
+	require.Equal(t, 0, len(none.Inherited([]string{"pii"})))
+	require.NoError(t, none.CheckEgress([]string{"pii"}))
+	require.NoError(t, none.CheckPublic([]string{"pii"}))
+}
+
+func TestLabels(t *testing.T) {
+	require.Equal(t, []string{"a", "b", "c"}, Labels(&pfs.RepoInfo{
+		Labels:          []string{"c", "a"},
+		InheritedLabels: []string{"b", "a"},
+	}))
+	require.Equal(t, 0, len(Labels(&pfs.RepoInfo{})))
+}
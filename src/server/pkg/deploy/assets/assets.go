@@ -770,6 +770,11 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 									Protocol:      "TCP",
 									Name:          "oidc-port",
 								},
+								{
+									ContainerPort: auth.ScimPort,
+									Protocol:      "TCP",
+									Name:          "scim-port",
+								},
 							},
 							VolumeMounts:    volumeMounts,
 							ImagePullPolicy: "IfNotPresent",
@@ -837,6 +842,11 @@ func PachdService(opts *AssetOpts) *v1.Service {
 					Name:     "oidc-port",
 					NodePort: 30000 + auth.OidcPort,
 				},
+				{
+					Port:     auth.ScimPort,
+					Name:     "scim-port",
+					NodePort: 30000 + auth.ScimPort,
+				},
 				{
 					Port:     githook.GitHookPort,
 					Name:     "api-git-port",
@@ -864,7 +864,7 @@ If <object store backend> is \"s3\", then the arguments are:
 		if err != nil {
 			return err
 		}
-		_, activeContext, err := cfg.ActiveContext(true)
+		_, activeContext, err := cfg.ActiveResolvedContext(true)
 		if err != nil {
 			return err
 		}
@@ -1029,7 +1029,7 @@ func Cmds() []*cobra.Command {
 			if err != nil {
 				return err
 			}
-			_, activeContext, err := cfg.ActiveContext(true)
+			_, activeContext, err := cfg.ActiveResolvedContext(true)
 			if err != nil {
 				return err
 			}
@@ -1213,7 +1213,7 @@ persistent volume was manually provisioned (i.e. if you used the
 			if err != nil {
 				return err
 			}
-			_, activeContext, err := cfg.ActiveContext(true)
+			_, activeContext, err := cfg.ActiveResolvedContext(true)
 			if err != nil {
 				return err
 			}
@@ -1322,7 +1322,7 @@ underlying volume will not be removed.`)
 			if err != nil {
 				return err
 			}
-			_, activeContext, err := cfg.ActiveContext(false)
+			_, activeContext, err := cfg.ActiveResolvedContext(false)
 			if err != nil {
 				return err
 			}
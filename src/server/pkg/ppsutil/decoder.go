@@ -2,14 +2,19 @@ package ppsutil
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
 	"unicode"
 
+	"github.com/google/go-jsonnet"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serde"
@@ -18,11 +23,95 @@ import (
 // PipelineManifestReader helps with unmarshalling pipeline configs from JSON.
 // It's used by 'create pipeline' and 'update pipeline'
 type PipelineManifestReader struct {
-	decoder serde.Decoder
+	decoder  serde.Decoder
+	template *ppsclient.PipelineTemplate
 }
 
 // NewPipelineManifestReader creates a new manifest reader from a path.
-func NewPipelineManifestReader(path string) (result *PipelineManifestReader, retErr error) {
+func NewPipelineManifestReader(path string) (*PipelineManifestReader, error) {
+	pipelineBytes, err := readPipelineBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	return newPipelineManifestReader(pipelineBytes), nil
+}
+
+// NewTemplatedPipelineManifestReader creates a new manifest reader from a path
+// containing a Go template or, if IsJsonnetPath(path), a Jsonnet program,
+// which is rendered with 'args' before being decoded. The template and its
+// arguments are attached to every request returned by the reader.
+func NewTemplatedPipelineManifestReader(path string, args map[string]string) (*PipelineManifestReader, error) {
+	templateBytes, err := readPipelineBytes(path)
+	if err != nil {
+		return nil, err
+	}
+	render := RenderPipelineTemplate
+	if IsJsonnetPath(path) {
+		render = RenderPipelineJsonnet
+	}
+	pipelineBytes, err := render(path, templateBytes, args)
+	if err != nil {
+		return nil, err
+	}
+	result := newPipelineManifestReader(pipelineBytes)
+	result.template = &ppsclient.PipelineTemplate{
+		Source: string(templateBytes),
+		Args:   args,
+	}
+	return result, nil
+}
+
+// RenderPipelineTemplate renders a pipeline spec template with 'args'. Args
+// are available in the template as fields of '.', e.g. '{{.repo}}'. It is an
+// error for the template to reference an arg that wasn't provided.
+func RenderPipelineTemplate(name string, templateBytes []byte, args map[string]string) ([]byte, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(string(templateBytes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse pipeline template")
+	}
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, args); err != nil {
+		return nil, errors.Wrapf(err, "could not render pipeline template")
+	}
+	return buf.Bytes(), nil
+}
+
+// IsJsonnetPath returns true if 'path' names a Jsonnet pipeline spec, which
+// must be rendered by RenderPipelineJsonnet even if it takes no args.
+func IsJsonnetPath(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".jsonnet" || ext == ".libsonnet"
+}
+
+// RenderPipelineJsonnet evaluates a Jsonnet pipeline spec with 'args'. Args
+// are available as external variables, e.g. 'std.extVar("repo")'. The program may
+// evaluate to a single pipeline spec or to an array of them, which are
+// rendered as a stream of specs. Imports are resolved relative to 'name'.
+func RenderPipelineJsonnet(name string, templateBytes []byte, args map[string]string) ([]byte, error) {
+	vm := jsonnet.MakeVM()
+	for key, value := range args {
+		vm.ExtVar(key, value)
+	}
+	out, err := vm.EvaluateSnippet(name, string(templateBytes))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not render jsonnet pipeline spec")
+	}
+	if !strings.HasPrefix(strings.TrimSpace(out), "[") {
+		return []byte(out), nil
+	}
+	var specs []json.RawMessage
+	if err := json.Unmarshal([]byte(out), &specs); err != nil {
+		return nil, errors.Wrapf(err, "could not split jsonnet pipeline specs")
+	}
+	buf := &bytes.Buffer{}
+	for _, spec := range specs {
+		buf.Write(spec)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+func readPipelineBytes(path string) (_ []byte, retErr error) {
 	var pipelineBytes []byte
 	if path == "-" {
 		fmt.Print("Reading from stdin.\n")
@@ -52,6 +141,10 @@ func NewPipelineManifestReader(path string) (result *PipelineManifestReader, ret
 			return nil, err
 		}
 	}
+	return pipelineBytes, nil
+}
+
+func newPipelineManifestReader(pipelineBytes []byte) *PipelineManifestReader {
 	// TODO(msteffen): if we can get the yaml decoder to handle leading tabs, as
 	// in pps/cmds/cmds_test.go, then we can get rid of this
 	idx := bytes.IndexFunc(pipelineBytes, func(r rune) bool {
@@ -60,11 +153,11 @@ func NewPipelineManifestReader(path string) (result *PipelineManifestReader, ret
 	if idx >= 0 && pipelineBytes[idx] == '{' {
 		return &PipelineManifestReader{
 			decoder: serde.NewJSONDecoder(bytes.NewReader(pipelineBytes)),
-		}, nil
+		}
 	}
 	return &PipelineManifestReader{
 		decoder: serde.NewYAMLDecoder(bytes.NewReader(pipelineBytes)),
-	}, nil
+	}
 }
 
 // NextCreatePipelineRequest gets the next request from the manifest reader.
@@ -109,6 +202,7 @@ func (r *PipelineManifestReader) NextCreatePipelineRequest() (*ppsclient.CreateP
 	case err != nil:
 		return nil, errors.Wrapf(err, "malformed pipeline spec")
 	default:
+		result.Template = r.template
 		return &result, nil
 	}
 }
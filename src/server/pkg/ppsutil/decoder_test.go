@@ -0,0 +1,103 @@
+package ppsutil
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+const testPipelineTemplate = `
+{
+  "pipeline": {"name": "{{.name}}-edges"},
+  "input": {"pfs": {"repo": "{{.repo}}", "glob": "/*"}},
+  "transform": {"image": "{{.image}}", "cmd": ["python3", "/edges.py"]}
+}
+{
+  "pipeline": {"name": "{{.name}}-montage"},
+  "input": {"pfs": {"repo": "{{.name}}-edges", "glob": "/"}},
+  "transform": {"image": "{{.image}}", "cmd": ["sh"]}
+}
+`
+
+func TestTemplatedPipelineManifestReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline-template")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dag.json.tmpl")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testPipelineTemplate), 0644))
+
+	args := map[string]string{"name": "images", "repo": "raw", "image": "edges:1.0"}
+	reader, err := NewTemplatedPipelineManifestReader(path, args)
+	require.NoError(t, err)
+
+	var names []string
+	for {
+		request, err := reader.NextCreatePipelineRequest()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, "edges:1.0", request.Transform.Image)
+		require.Equal(t, testPipelineTemplate, request.Template.Source)
+		require.Equal(t, args, request.Template.Args)
+		names = append(names, request.Pipeline.Name)
+	}
+	require.Equal(t, []string{"images-edges", "images-montage"}, names)
+}
+
+func TestRenderPipelineTemplateMissingArg(t *testing.T) {
+	_, err := RenderPipelineTemplate("test", []byte(testPipelineTemplate), map[string]string{"name": "images"})
+	require.YesError(t, err)
+}
+
+const testPipelineJsonnet = `
+local name = std.extVar("name");
+[
+  {
+    pipeline: {name: name + "-edges"},
+    input: {pfs: {repo: std.extVar("repo"), glob: "/*"}},
+    transform: {image: std.extVar("image"), cmd: ["python3", "/edges.py"]},
+  },
+  {
+    pipeline: {name: name + "-montage"},
+    input: {pfs: {repo: name + "-edges", glob: "/"}},
+    transform: {image: std.extVar("image"), cmd: ["sh"]},
+  },
+]
+`
+
+func TestJsonnetPipelineManifestReader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipeline-jsonnet")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dag.jsonnet")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testPipelineJsonnet), 0644))
+	require.True(t, IsJsonnetPath(path))
+
+	args := map[string]string{"name": "images", "repo": "raw", "image": "edges:1.0"}
+	reader, err := NewTemplatedPipelineManifestReader(path, args)
+	require.NoError(t, err)
+
+	var names []string
+	for {
+		request, err := reader.NextCreatePipelineRequest()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, "edges:1.0", request.Transform.Image)
+		require.Equal(t, testPipelineJsonnet, request.Template.Source)
+		require.Equal(t, args, request.Template.Args)
+		names = append(names, request.Pipeline.Name)
+	}
+	require.Equal(t, []string{"images-edges", "images-montage"}, names)
+
+	// A missing argument is an error, as with Go templates
+	_, err = NewTemplatedPipelineManifestReader(path, map[string]string{"name": "images"})
+	require.YesError(t, err)
+}
@@ -143,6 +143,7 @@ func GetPipelineInfoAllowIncomplete(pachClient *client.APIClient, name string, p
 	result.Reason = ptr.Reason
 	result.JobCounts = ptr.JobCounts
 	result.LastJobState = ptr.LastJobState
+	result.Usage = ptr.Usage
 	result.SpecCommit = ptr.SpecCommit
 	return result, nil
 }
@@ -290,9 +291,11 @@ func PipelineReqFromInfo(pipelineInfo *pps.PipelineInfo) *pps.CreatePipelineRequ
 		Spout:                 pipelineInfo.Spout,
 		SchedulingSpec:        pipelineInfo.SchedulingSpec,
 		DatumTries:            pipelineInfo.DatumTries,
+		RetryPolicy:           pipelineInfo.RetryPolicy,
 		Standby:               pipelineInfo.Standby,
 		S3Out:                 pipelineInfo.S3Out,
 		Metadata:              pipelineInfo.Metadata,
+		Template:              pipelineInfo.Template,
 	}
 }
 
@@ -329,6 +332,12 @@ func UpdateJobState(pipelines col.ReadWriteCollection, jobs col.ReadWriteCollect
 	}
 	pipelinePtr.JobCounts[int32(state)]++
 	pipelinePtr.LastJobState = state
+	if IsTerminal(state) && !IsTerminal(jobPtr.State) {
+		if pipelinePtr.Usage == nil {
+			pipelinePtr.Usage = &pps.ResourceUsage{}
+		}
+		AddJobUsage(pipelinePtr.Usage, jobPtr.Stats, jobPtr.DataProcessed, jobPtr.DataSkipped, jobPtr.DataFailed)
+	}
 	if err := pipelines.Put(jobPtr.Pipeline.Name, pipelinePtr); err != nil {
 		return err
 	}
@@ -348,6 +357,20 @@ func UpdateJobState(pipelines col.ReadWriteCollection, jobs col.ReadWriteCollect
 	return jobs.Put(jobPtr.Job.ID, jobPtr)
 }
 
+// AddJobUsage adds the resources used by a single job to usage.
+func AddJobUsage(usage *pps.ResourceUsage, stats *pps.ProcessStats, dataProcessed, dataSkipped, dataFailed int64) {
+	usage.Jobs++
+	usage.DataProcessed += dataProcessed
+	usage.DataSkipped += dataSkipped
+	usage.DataFailed += dataFailed
+	if stats != nil {
+		usage.CpuSeconds += stats.CpuSeconds
+		usage.MemoryByteSeconds += stats.MemoryByteSeconds
+		usage.DownloadBytes += stats.DownloadBytes
+		usage.UploadBytes += stats.UploadBytes
+	}
+}
+
 // ContainsS3Inputs returns 'true' if 'in' is or contains any PFS inputs with
 // 'S3' set to true. Any pipelines with s3 inputs lj
 func ContainsS3Inputs(in *pps.Input) bool {
@@ -363,6 +386,25 @@ func ContainsS3Inputs(in *pps.Input) bool {
 	return found
 }
 
+// InputRepos returns the repos that 'in' reads data from: the repos of its
+// PFS and cron inputs, and the repos that its git inputs commit to. This
+// helper is in ppsutil because both PPS (when a pipeline is created) and the
+// worker (before each egress) check these repos' data policies.
+func InputRepos(in *pps.Input) []string {
+	var repos []string
+	pps.VisitInput(in, func(in *pps.Input) {
+		switch {
+		case in.Pfs != nil:
+			repos = append(repos, in.Pfs.Repo)
+		case in.Cron != nil:
+			repos = append(repos, in.Cron.Repo)
+		case in.Git != nil:
+			repos = append(repos, in.Git.Name)
+		}
+	})
+	return repos
+}
+
 // SidecarS3GatewayService returns the name of the kubernetes service created
 // for the job 'jobID' to hand sidecar s3 gateway requests. This helper is in
 // ppsutil because both PPS (which creates the service, in the s3 gateway
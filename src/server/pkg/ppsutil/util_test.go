@@ -0,0 +1,20 @@
+package ppsutil
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestInputRepos(t *testing.T) {
+	in := &pps.Input{Cross: []*pps.Input{
+		{Pfs: &pps.PFSInput{Name: "in", Repo: "images"}},
+		{Union: []*pps.Input{
+			{Cron: &pps.CronInput{Name: "tick", Repo: "edges_tick"}},
+			{Git: &pps.GitInput{Name: "code"}},
+		}},
+	}}
+	require.ElementsEqual(t, []string{"images", "edges_tick", "code"}, InputRepos(in))
+	require.Equal(t, 0, len(InputRepos(nil)))
+}
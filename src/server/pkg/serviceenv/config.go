@@ -25,6 +25,13 @@ type GlobalConfiguration struct {
 	LokiPort      string `env:"LOKI_SERVICE_PORT"`
 	SamlPort      uint16 `env:"SAML_PORT,default=654"`
 	OidcPort      uint16 `env:"OIDC_PORT,default=657"`
+	ScimPort      uint16 `env:"SCIM_PORT,default=658"`
+
+	// The continuous profiling configuration, see src/server/debug/profiler.
+	// A PROFILING_INTERVAL of 0 disables continuous profiling.
+	ProfilingInterval    string `env:"PROFILING_INTERVAL"`
+	ProfilingCPUDuration string `env:"PROFILING_CPU_DURATION"`
+	ProfilingRetention   string `env:"PROFILING_RETENTION"`
 
 	// PPSSpecCommitID is only set for workers and sidecar pachd instances.
 	// Because both pachd and worker need to know the spec commit (the worker so
@@ -50,6 +57,7 @@ type PachdSpecificConfiguration struct {
 	PFSEtcdPrefix              string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
 	AuthEtcdPrefix             string `env:"PACHYDERM_AUTH_ETCD_PREFIX,default=pachyderm_auth"`
 	EnterpriseEtcdPrefix       string `env:"PACHYDERM_ENTERPRISE_ETCD_PREFIX,default=pachyderm_enterprise"`
+	ReplicationEtcdPrefix      string `env:"PACHYDERM_REPLICATION_ETCD_PREFIX,default=pachyderm_replication"`
 	KubeAddress                string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
 	Metrics                    bool   `env:"METRICS,default=true"`
 	Init                       bool   `env:"INIT,default=false"`
@@ -68,6 +76,10 @@ type PachdSpecificConfiguration struct {
 	DeploymentID               string `env:"CLUSTER_DEPLOYMENT_ID,default="`
 	RequireCriticalServersOnly bool   `env:"REQUIRE_CRITICAL_SERVERS_ONLY",default=false"`
 	MetricsEndpoint            string `env:"METRICS_ENDPOINT",default="`
+	AuditSinks                 string `env:"AUDIT_SINKS,default="`
+	VaultAddress               string `env:"VAULT_ADDR,default="`
+	VaultAuthRolePrefix        string `env:"VAULT_AUTH_ROLE_PREFIX,default="`
+	VaultAuthPath              string `env:"VAULT_AUTH_PATH,default=kubernetes"`
 	// TODO: Merge this with the worker specific pod name (PPS_POD_NAME) into a global configuration pod name.
 	PachdPodName string `env:"PACHD_POD_NAME,required"`
 }
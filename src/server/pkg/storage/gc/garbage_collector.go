@@ -37,6 +37,8 @@ func Run(ctx context.Context, objClient obj.Client, db *gorm.DB, opts ...Option)
 	for _, opt := range opts {
 		opt(gc)
 	}
+	registerMetrics()
+	pollingSeconds.Set(gc.polling.Seconds())
 	return gc.pollingFunc(ctx)
 }
 
@@ -82,6 +84,7 @@ func (gc *garbageCollector) pollingFunc(ctx context.Context) error {
 				if err := gc.maybeDeleteChunks(ctx); err != nil {
 					return err
 				}
+				lastPassSeconds.SetToCurrentTime()
 				select {
 				case <-time.After(gc.polling):
 				case <-ctx.Done():
@@ -129,6 +132,7 @@ func (gc *garbageCollector) deleteChunks(ctx context.Context, chunks []string) e
 	}); err != nil {
 		return err
 	}
+	deletedChunksCount.Add(float64(len(toDelete)))
 	return gc.deleteChunks(ctx, transitiveDeletes)
 }
 
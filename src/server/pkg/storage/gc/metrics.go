@@ -0,0 +1,54 @@
+package gc
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+var (
+	// lastPassSeconds is the time at which the garbage collector last
+	// completed a pass, which can be compared against pollingSeconds to
+	// detect a stalled garbage collector
+	lastPassSeconds = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_gc",
+			Name:      "last_pass_timestamp_seconds",
+			Help:      "Unix time at which the garbage collector last completed a pass",
+		},
+	)
+
+	// pollingSeconds is the garbage collector's configured polling interval
+	pollingSeconds = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_gc",
+			Name:      "polling_seconds",
+			Help:      "Time between garbage collector passes",
+		},
+	)
+
+	// deletedChunksCount is the number of chunks deleted by the garbage
+	// collector
+	deletedChunksCount = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "storage_gc",
+			Name:      "deleted_chunks_count",
+			Help:      "Cumulative number of chunks deleted by the garbage collector",
+		},
+	)
+)
+
+func registerMetrics() {
+	for _, c := range []prometheus.Collector{lastPassSeconds, pollingSeconds, deletedChunksCount} {
+		if err := prometheus.Register(c); err != nil {
+			// metrics may be redundantly registered; ignore these errors
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Infof("error registering prometheus metric: %v", err)
+			}
+		}
+	}
+}
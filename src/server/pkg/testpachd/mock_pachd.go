@@ -47,16 +47,19 @@ func linkServers(mockServerPtr interface{}, realServer interface{}) {
 type extractFunc func(*admin.ExtractRequest, admin.API_ExtractServer) error
 type extractPipelineFunc func(context.Context, *admin.ExtractPipelineRequest) (*admin.Op, error)
 type restoreFunc func(admin.API_RestoreServer) error
+type restoreDryRunFunc func(admin.API_RestoreDryRunServer) error
 type inspectClusterFunc func(context.Context, *types.Empty) (*admin.ClusterInfo, error)
 
 type mockExtract struct{ handler extractFunc }
 type mockExtractPipeline struct{ handler extractPipelineFunc }
 type mockRestore struct{ handler restoreFunc }
+type mockRestoreDryRun struct{ handler restoreDryRunFunc }
 type mockInspectCluster struct{ handler inspectClusterFunc }
 
 func (mock *mockExtract) Use(cb extractFunc)                 { mock.handler = cb }
 func (mock *mockExtractPipeline) Use(cb extractPipelineFunc) { mock.handler = cb }
 func (mock *mockRestore) Use(cb restoreFunc)                 { mock.handler = cb }
+func (mock *mockRestoreDryRun) Use(cb restoreDryRunFunc)     { mock.handler = cb }
 func (mock *mockInspectCluster) Use(cb inspectClusterFunc)   { mock.handler = cb }
 
 type adminServerAPI struct {
@@ -68,6 +71,7 @@ type mockAdminServer struct {
 	Extract         mockExtract
 	ExtractPipeline mockExtractPipeline
 	Restore         mockRestore
+	RestoreDryRun   mockRestoreDryRun
 	InspectCluster  mockInspectCluster
 }
 
@@ -89,6 +93,12 @@ func (api *adminServerAPI) Restore(serv admin.API_RestoreServer) error {
 	}
 	return errors.Errorf("unhandled pachd mock admin.Restore")
 }
+func (api *adminServerAPI) RestoreDryRun(serv admin.API_RestoreDryRunServer) error {
+	if api.mock.RestoreDryRun.handler != nil {
+		return api.mock.RestoreDryRun.handler(serv)
+	}
+	return errors.Errorf("unhandled pachd mock admin.RestoreDryRun")
+}
 func (api *adminServerAPI) InspectCluster(ctx context.Context, req *types.Empty) (*admin.ClusterInfo, error) {
 	if api.mock.InspectCluster.handler != nil {
 		return api.mock.InspectCluster.handler(ctx, req)
@@ -123,6 +133,20 @@ type modifyMembersFunc func(context.Context, *auth.ModifyMembersRequest) (*auth.
 type getGroupsFunc func(context.Context, *auth.GetGroupsRequest) (*auth.GetGroupsResponse, error)
 type getUsersFunc func(context.Context, *auth.GetUsersRequest) (*auth.GetUsersResponse, error)
 type getOneTimePasswordFunc func(context.Context, *auth.GetOneTimePasswordRequest) (*auth.GetOneTimePasswordResponse, error)
+type createRoleFunc func(context.Context, *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error)
+type deleteRoleFunc func(context.Context, *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error)
+type listRolesFunc func(context.Context, *auth.ListRolesRequest) (*auth.ListRolesResponse, error)
+type modifyRoleBindingFunc func(context.Context, *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error)
+type getRoleBindingsFunc func(context.Context, *auth.GetRoleBindingsRequest) (*auth.GetRoleBindingsResponse, error)
+type createRobotFunc func(context.Context, *auth.CreateRobotRequest) (*auth.CreateRobotResponse, error)
+type listRobotsFunc func(context.Context, *auth.ListRobotsRequest) (*auth.ListRobotsResponse, error)
+type deleteRobotFunc func(context.Context, *auth.DeleteRobotRequest) (*auth.DeleteRobotResponse, error)
+type createRobotKeyFunc func(context.Context, *auth.CreateRobotKeyRequest) (*auth.CreateRobotKeyResponse, error)
+type listRobotKeysFunc func(context.Context, *auth.ListRobotKeysRequest) (*auth.ListRobotKeysResponse, error)
+type deleteRobotKeyFunc func(context.Context, *auth.DeleteRobotKeyRequest) (*auth.DeleteRobotKeyResponse, error)
+type rotateRobotKeyFunc func(context.Context, *auth.RotateRobotKeyRequest) (*auth.RotateRobotKeyResponse, error)
+type listAuthTokensFunc func(context.Context, *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error)
+type revokeAuthTokensForUserFunc func(context.Context, *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error)
 
 type mockActivateAuth struct{ handler activateAuthFunc }
 type mockDeactivateAuth struct{ handler deactivateAuthFunc }
@@ -148,6 +172,20 @@ type mockModifyMembers struct{ handler modifyMembersFunc }
 type mockGetGroups struct{ handler getGroupsFunc }
 type mockGetUsers struct{ handler getUsersFunc }
 type mockGetOneTimePassword struct{ handler getOneTimePasswordFunc }
+type mockCreateRole struct{ handler createRoleFunc }
+type mockDeleteRole struct{ handler deleteRoleFunc }
+type mockListRoles struct{ handler listRolesFunc }
+type mockModifyRoleBinding struct{ handler modifyRoleBindingFunc }
+type mockGetRoleBindings struct{ handler getRoleBindingsFunc }
+type mockCreateRobot struct{ handler createRobotFunc }
+type mockListRobots struct{ handler listRobotsFunc }
+type mockDeleteRobot struct{ handler deleteRobotFunc }
+type mockCreateRobotKey struct{ handler createRobotKeyFunc }
+type mockListRobotKeys struct{ handler listRobotKeysFunc }
+type mockDeleteRobotKey struct{ handler deleteRobotKeyFunc }
+type mockRotateRobotKey struct{ handler rotateRobotKeyFunc }
+type mockListAuthTokens struct{ handler listAuthTokensFunc }
+type mockRevokeAuthTokensForUser struct{ handler revokeAuthTokensForUserFunc }
 
 func (mock *mockActivateAuth) Use(cb activateAuthFunc)                         { mock.handler = cb }
 func (mock *mockDeactivateAuth) Use(cb deactivateAuthFunc)                     { mock.handler = cb }
@@ -173,6 +211,20 @@ func (mock *mockModifyMembers) Use(cb modifyMembersFunc)                       {
 func (mock *mockGetGroups) Use(cb getGroupsFunc)                               { mock.handler = cb }
 func (mock *mockGetUsers) Use(cb getUsersFunc)                                 { mock.handler = cb }
 func (mock *mockGetOneTimePassword) Use(cb getOneTimePasswordFunc)             { mock.handler = cb }
+func (mock *mockCreateRole) Use(cb createRoleFunc)                             { mock.handler = cb }
+func (mock *mockDeleteRole) Use(cb deleteRoleFunc)                             { mock.handler = cb }
+func (mock *mockListRoles) Use(cb listRolesFunc)                               { mock.handler = cb }
+func (mock *mockModifyRoleBinding) Use(cb modifyRoleBindingFunc)               { mock.handler = cb }
+func (mock *mockGetRoleBindings) Use(cb getRoleBindingsFunc)                   { mock.handler = cb }
+func (mock *mockCreateRobot) Use(cb createRobotFunc)                           { mock.handler = cb }
+func (mock *mockListRobots) Use(cb listRobotsFunc)                             { mock.handler = cb }
+func (mock *mockDeleteRobot) Use(cb deleteRobotFunc)                           { mock.handler = cb }
+func (mock *mockCreateRobotKey) Use(cb createRobotKeyFunc)                     { mock.handler = cb }
+func (mock *mockListRobotKeys) Use(cb listRobotKeysFunc)                       { mock.handler = cb }
+func (mock *mockDeleteRobotKey) Use(cb deleteRobotKeyFunc)                     { mock.handler = cb }
+func (mock *mockRotateRobotKey) Use(cb rotateRobotKeyFunc)                     { mock.handler = cb }
+func (mock *mockListAuthTokens) Use(cb listAuthTokensFunc)                     { mock.handler = cb }
+func (mock *mockRevokeAuthTokensForUser) Use(cb revokeAuthTokensForUserFunc)   { mock.handler = cb }
 
 type authServerAPI struct {
 	mock *mockAuthServer
@@ -204,6 +256,20 @@ type mockAuthServer struct {
 	GetGroups                mockGetGroups
 	GetUsers                 mockGetUsers
 	GetOneTimePassword       mockGetOneTimePassword
+	CreateRole               mockCreateRole
+	DeleteRole               mockDeleteRole
+	ListRoles                mockListRoles
+	ModifyRoleBinding        mockModifyRoleBinding
+	GetRoleBindings          mockGetRoleBindings
+	CreateRobot              mockCreateRobot
+	ListRobots               mockListRobots
+	DeleteRobot              mockDeleteRobot
+	CreateRobotKey           mockCreateRobotKey
+	ListRobotKeys            mockListRobotKeys
+	DeleteRobotKey           mockDeleteRobotKey
+	RotateRobotKey           mockRotateRobotKey
+	ListAuthTokens           mockListAuthTokens
+	RevokeAuthTokensForUser  mockRevokeAuthTokensForUser
 }
 
 func (api *authServerAPI) Activate(ctx context.Context, req *auth.ActivateRequest) (*auth.ActivateResponse, error) {
@@ -350,6 +416,90 @@ func (api *authServerAPI) GetOneTimePassword(ctx context.Context, req *auth.GetO
 	}
 	return nil, errors.Errorf("unhandled pachd mock auth.GetOneTimePassword")
 }
+func (api *authServerAPI) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (*auth.CreateRoleResponse, error) {
+	if api.mock.CreateRole.handler != nil {
+		return api.mock.CreateRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRole")
+}
+func (api *authServerAPI) DeleteRole(ctx context.Context, req *auth.DeleteRoleRequest) (*auth.DeleteRoleResponse, error) {
+	if api.mock.DeleteRole.handler != nil {
+		return api.mock.DeleteRole.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRole")
+}
+func (api *authServerAPI) ListRoles(ctx context.Context, req *auth.ListRolesRequest) (*auth.ListRolesResponse, error) {
+	if api.mock.ListRoles.handler != nil {
+		return api.mock.ListRoles.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRoles")
+}
+func (api *authServerAPI) ModifyRoleBinding(ctx context.Context, req *auth.ModifyRoleBindingRequest) (*auth.ModifyRoleBindingResponse, error) {
+	if api.mock.ModifyRoleBinding.handler != nil {
+		return api.mock.ModifyRoleBinding.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ModifyRoleBinding")
+}
+func (api *authServerAPI) GetRoleBindings(ctx context.Context, req *auth.GetRoleBindingsRequest) (*auth.GetRoleBindingsResponse, error) {
+	if api.mock.GetRoleBindings.handler != nil {
+		return api.mock.GetRoleBindings.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.GetRoleBindings")
+}
+func (api *authServerAPI) CreateRobot(ctx context.Context, req *auth.CreateRobotRequest) (*auth.CreateRobotResponse, error) {
+	if api.mock.CreateRobot.handler != nil {
+		return api.mock.CreateRobot.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRobot")
+}
+func (api *authServerAPI) ListRobots(ctx context.Context, req *auth.ListRobotsRequest) (*auth.ListRobotsResponse, error) {
+	if api.mock.ListRobots.handler != nil {
+		return api.mock.ListRobots.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRobots")
+}
+func (api *authServerAPI) DeleteRobot(ctx context.Context, req *auth.DeleteRobotRequest) (*auth.DeleteRobotResponse, error) {
+	if api.mock.DeleteRobot.handler != nil {
+		return api.mock.DeleteRobot.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRobot")
+}
+func (api *authServerAPI) CreateRobotKey(ctx context.Context, req *auth.CreateRobotKeyRequest) (*auth.CreateRobotKeyResponse, error) {
+	if api.mock.CreateRobotKey.handler != nil {
+		return api.mock.CreateRobotKey.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.CreateRobotKey")
+}
+func (api *authServerAPI) ListRobotKeys(ctx context.Context, req *auth.ListRobotKeysRequest) (*auth.ListRobotKeysResponse, error) {
+	if api.mock.ListRobotKeys.handler != nil {
+		return api.mock.ListRobotKeys.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListRobotKeys")
+}
+func (api *authServerAPI) DeleteRobotKey(ctx context.Context, req *auth.DeleteRobotKeyRequest) (*auth.DeleteRobotKeyResponse, error) {
+	if api.mock.DeleteRobotKey.handler != nil {
+		return api.mock.DeleteRobotKey.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.DeleteRobotKey")
+}
+func (api *authServerAPI) RotateRobotKey(ctx context.Context, req *auth.RotateRobotKeyRequest) (*auth.RotateRobotKeyResponse, error) {
+	if api.mock.RotateRobotKey.handler != nil {
+		return api.mock.RotateRobotKey.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.RotateRobotKey")
+}
+func (api *authServerAPI) ListAuthTokens(ctx context.Context, req *auth.ListAuthTokensRequest) (*auth.ListAuthTokensResponse, error) {
+	if api.mock.ListAuthTokens.handler != nil {
+		return api.mock.ListAuthTokens.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.ListAuthTokens")
+}
+func (api *authServerAPI) RevokeAuthTokensForUser(ctx context.Context, req *auth.RevokeAuthTokensForUserRequest) (*auth.RevokeAuthTokensForUserResponse, error) {
+	if api.mock.RevokeAuthTokensForUser.handler != nil {
+		return api.mock.RevokeAuthTokensForUser.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock auth.RevokeAuthTokensForUser")
+}
 
 /* Enterprise Server Mocks */
 
@@ -775,6 +925,7 @@ type createJobFunc func(context.Context, *pps.CreateJobRequest) (*pps.Job, error
 type inspectJobFunc func(context.Context, *pps.InspectJobRequest) (*pps.JobInfo, error)
 type listJobFunc func(context.Context, *pps.ListJobRequest) (*pps.JobInfos, error)
 type listJobStreamFunc func(*pps.ListJobRequest, pps.API_ListJobStreamServer) error
+type listUsageFunc func(context.Context, *pps.ListUsageRequest) (*pps.ListUsageResponse, error)
 type flushJobFunc func(*pps.FlushJobRequest, pps.API_FlushJobServer) error
 type deleteJobFunc func(context.Context, *pps.DeleteJobRequest) (*types.Empty, error)
 type stopJobFunc func(context.Context, *pps.StopJobRequest) (*types.Empty, error)
@@ -804,6 +955,7 @@ type mockCreateJob struct{ handler createJobFunc }
 type mockInspectJob struct{ handler inspectJobFunc }
 type mockListJob struct{ handler listJobFunc }
 type mockListJobStream struct{ handler listJobStreamFunc }
+type mockListUsage struct{ handler listUsageFunc }
 type mockFlushJob struct{ handler flushJobFunc }
 type mockDeleteJob struct{ handler deleteJobFunc }
 type mockStopJob struct{ handler stopJobFunc }
@@ -833,6 +985,7 @@ func (mock *mockCreateJob) Use(cb createJobFunc)             { mock.handler = cb
 func (mock *mockInspectJob) Use(cb inspectJobFunc)           { mock.handler = cb }
 func (mock *mockListJob) Use(cb listJobFunc)                 { mock.handler = cb }
 func (mock *mockListJobStream) Use(cb listJobStreamFunc)     { mock.handler = cb }
+func (mock *mockListUsage) Use(cb listUsageFunc)             { mock.handler = cb }
 func (mock *mockFlushJob) Use(cb flushJobFunc)               { mock.handler = cb }
 func (mock *mockDeleteJob) Use(cb deleteJobFunc)             { mock.handler = cb }
 func (mock *mockStopJob) Use(cb stopJobFunc)                 { mock.handler = cb }
@@ -868,6 +1021,7 @@ type mockPPSServer struct {
 	InspectJob      mockInspectJob
 	ListJob         mockListJob
 	ListJobStream   mockListJobStream
+	ListUsage       mockListUsage
 	FlushJob        mockFlushJob
 	DeleteJob       mockDeleteJob
 	StopJob         mockStopJob
@@ -918,6 +1072,12 @@ func (api *ppsServerAPI) ListJobStream(req *pps.ListJobRequest, serv pps.API_Lis
 	}
 	return errors.Errorf("unhandled pachd mock pps.ListJobStream")
 }
+func (api *ppsServerAPI) ListUsage(ctx context.Context, req *pps.ListUsageRequest) (*pps.ListUsageResponse, error) {
+	if api.mock.ListUsage.handler != nil {
+		return api.mock.ListUsage.handler(ctx, req)
+	}
+	return nil, errors.Errorf("unhandled pachd mock pps.ListUsage")
+}
 func (api *ppsServerAPI) FlushJob(req *pps.FlushJobRequest, serv pps.API_FlushJobServer) error {
 	if api.mock.FlushJob.handler != nil {
 		return api.mock.FlushJob.handler(req, serv)
@@ -18,6 +18,46 @@ func (mock *mockUpdateJobStateInTransaction) Use(cb updateJobStateInTransactionF
 	mock.handler = cb
 }
 
+type createPipelineInTransactionFunc func(*txnenv.TransactionContext, *pps.CreatePipelineRequest) error
+
+type mockCreatePipelineInTransaction struct {
+	handler createPipelineInTransactionFunc
+}
+
+func (mock *mockCreatePipelineInTransaction) Use(cb createPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type deletePipelineInTransactionFunc func(*txnenv.TransactionContext, *pps.DeletePipelineRequest) error
+
+type mockDeletePipelineInTransaction struct {
+	handler deletePipelineInTransactionFunc
+}
+
+func (mock *mockDeletePipelineInTransaction) Use(cb deletePipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type startPipelineInTransactionFunc func(*txnenv.TransactionContext, *pps.StartPipelineRequest) error
+
+type mockStartPipelineInTransaction struct {
+	handler startPipelineInTransactionFunc
+}
+
+func (mock *mockStartPipelineInTransaction) Use(cb startPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
+type stopPipelineInTransactionFunc func(*txnenv.TransactionContext, *pps.StopPipelineRequest) error
+
+type mockStopPipelineInTransaction struct {
+	handler stopPipelineInTransactionFunc
+}
+
+func (mock *mockStopPipelineInTransaction) Use(cb stopPipelineInTransactionFunc) {
+	mock.handler = cb
+}
+
 type ppsTransactionAPI struct {
 	mock *MockPPSTransactionServer
 }
@@ -27,6 +67,10 @@ type ppsTransactionAPI struct {
 type MockPPSTransactionServer struct {
 	api                         ppsTransactionAPI
 	UpdateJobStateInTransaction mockUpdateJobStateInTransaction
+	CreatePipelineInTransaction mockCreatePipelineInTransaction
+	DeletePipelineInTransaction mockDeletePipelineInTransaction
+	StartPipelineInTransaction  mockStartPipelineInTransaction
+	StopPipelineInTransaction   mockStopPipelineInTransaction
 }
 
 func (api *ppsTransactionAPI) UpdateJobStateInTransaction(txnCtx *txnenv.TransactionContext, req *pps.UpdateJobStateRequest) error {
@@ -36,6 +80,34 @@ func (api *ppsTransactionAPI) UpdateJobStateInTransaction(txnCtx *txnenv.Transac
 	return fmt.Errorf("unhandled pachd mock: pps.UpdateJobStateInTransaction")
 }
 
+func (api *ppsTransactionAPI) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContext, req *pps.CreatePipelineRequest) error {
+	if api.mock.CreatePipelineInTransaction.handler != nil {
+		return api.mock.CreatePipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.CreatePipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) DeletePipelineInTransaction(txnCtx *txnenv.TransactionContext, req *pps.DeletePipelineRequest) error {
+	if api.mock.DeletePipelineInTransaction.handler != nil {
+		return api.mock.DeletePipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.DeletePipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StartPipelineInTransaction(txnCtx *txnenv.TransactionContext, req *pps.StartPipelineRequest) error {
+	if api.mock.StartPipelineInTransaction.handler != nil {
+		return api.mock.StartPipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.StartPipelineInTransaction")
+}
+
+func (api *ppsTransactionAPI) StopPipelineInTransaction(txnCtx *txnenv.TransactionContext, req *pps.StopPipelineRequest) error {
+	if api.mock.StopPipelineInTransaction.handler != nil {
+		return api.mock.StopPipelineInTransaction.handler(txnCtx, req)
+	}
+	return fmt.Errorf("unhandled pachd mock: pps.StopPipelineInTransaction")
+}
+
 // NewMockPPSTransactionServer instantiates a MockPPSTransactionServer
 func NewMockPPSTransactionServer() *MockPPSTransactionServer {
 	result := &MockPPSTransactionServer{}
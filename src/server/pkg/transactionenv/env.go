@@ -28,6 +28,9 @@ type PfsWrites interface {
 
 	CreateBranch(*pfs.CreateBranchRequest) error
 	DeleteBranch(*pfs.DeleteBranchRequest) error
+
+	PutFile(*transaction.PutFileRecordsRequest) error
+	DeleteFile(*pfs.DeleteFileRequest) error
 }
 
 // PpsWrites is an interface providing a wrapper for each operation that
@@ -36,6 +39,11 @@ type PfsWrites interface {
 // depending on if there is an active transaction in the client context.
 type PpsWrites interface {
 	UpdateJobState(*pps.UpdateJobStateRequest) error
+
+	CreatePipeline(*pps.CreatePipelineRequest) error
+	DeletePipeline(*pps.DeletePipelineRequest) error
+	StartPipeline(*pps.StartPipelineRequest) error
+	StopPipeline(*pps.StopPipelineRequest) error
 }
 
 // AuthWrites is an interface providing a wrapper for each operation that
@@ -96,6 +104,13 @@ func (t *TransactionContext) PropagateCommit(branch *pfs.Branch, isNewCommit boo
 	return t.pfsPropagater.PropagateCommit(branch, isNewCommit)
 }
 
+// PfsPropagater returns the PfsPropagater for the transaction, which PFS also
+// uses to keep track of the writes made earlier in the transaction (which
+// can't be read outside of the transaction's STM).
+func (t *TransactionContext) PfsPropagater() PfsPropagater {
+	return t.pfsPropagater
+}
+
 func (t *TransactionContext) finish() error {
 	return t.pfsPropagater.Run()
 }
@@ -120,6 +135,11 @@ type AuthTransactionServer interface {
 
 	GetACLInTransaction(*TransactionContext, *auth.GetACLRequest) (*auth.GetACLResponse, error)
 	SetACLInTransaction(*TransactionContext, *auth.SetACLRequest) (*auth.SetACLResponse, error)
+
+	GetDataPoliciesInTransaction(*TransactionContext) ([]*auth.DataPolicy, error)
+
+	GetAuthTokenInTransaction(*TransactionContext, *auth.GetAuthTokenRequest) (*auth.GetAuthTokenResponse, error)
+	RevokeAuthTokenInTransaction(*TransactionContext, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error)
 }
 
 // PfsTransactionServer is an interface for the transactionally-supported
@@ -135,14 +155,25 @@ type PfsTransactionServer interface {
 	FinishCommitInTransaction(*TransactionContext, *pfs.FinishCommitRequest) error
 	DeleteCommitInTransaction(*TransactionContext, *pfs.DeleteCommitRequest) error
 
+	InspectCommitInTransaction(*TransactionContext, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error)
+
 	CreateBranchInTransaction(*TransactionContext, *pfs.CreateBranchRequest) error
+	InspectBranchInTransaction(*TransactionContext, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error)
 	DeleteBranchInTransaction(*TransactionContext, *pfs.DeleteBranchRequest) error
+
+	PutFileInTransaction(*TransactionContext, *transaction.PutFileRecordsRequest) error
+	DeleteFileInTransaction(*TransactionContext, *pfs.DeleteFileRequest) error
 }
 
 // PpsTransactionServer is an interface for the transactionally-supported
 // methods that can be called through the PPS server.
 type PpsTransactionServer interface {
 	UpdateJobStateInTransaction(*TransactionContext, *pps.UpdateJobStateRequest) error
+
+	CreatePipelineInTransaction(*TransactionContext, *pps.CreatePipelineRequest) error
+	DeletePipelineInTransaction(*TransactionContext, *pps.DeletePipelineRequest) error
+	StartPipelineInTransaction(*TransactionContext, *pps.StartPipelineRequest) error
+	StopPipelineInTransaction(*TransactionContext, *pps.StopPipelineRequest) error
 }
 
 // TransactionEnv contains the APIServer instances for each subsystem that may
@@ -235,11 +266,41 @@ func (t *directTransaction) DeleteBranch(original *pfs.DeleteBranchRequest) erro
 	return t.txnCtx.txnEnv.pfsServer.DeleteBranchInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) PutFile(original *transaction.PutFileRecordsRequest) error {
+	req := proto.Clone(original).(*transaction.PutFileRecordsRequest)
+	return t.txnCtx.txnEnv.pfsServer.PutFileInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) DeleteFile(original *pfs.DeleteFileRequest) error {
+	req := proto.Clone(original).(*pfs.DeleteFileRequest)
+	return t.txnCtx.txnEnv.pfsServer.DeleteFileInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) UpdateJobState(original *pps.UpdateJobStateRequest) error {
 	req := proto.Clone(original).(*pps.UpdateJobStateRequest)
 	return t.txnCtx.txnEnv.ppsServer.UpdateJobStateInTransaction(t.txnCtx, req)
 }
 
+func (t *directTransaction) CreatePipeline(original *pps.CreatePipelineRequest) error {
+	req := proto.Clone(original).(*pps.CreatePipelineRequest)
+	return t.txnCtx.txnEnv.ppsServer.CreatePipelineInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) DeletePipeline(original *pps.DeletePipelineRequest) error {
+	req := proto.Clone(original).(*pps.DeletePipelineRequest)
+	return t.txnCtx.txnEnv.ppsServer.DeletePipelineInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) StartPipeline(original *pps.StartPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StartPipelineRequest)
+	return t.txnCtx.txnEnv.ppsServer.StartPipelineInTransaction(t.txnCtx, req)
+}
+
+func (t *directTransaction) StopPipeline(original *pps.StopPipelineRequest) error {
+	req := proto.Clone(original).(*pps.StopPipelineRequest)
+	return t.txnCtx.txnEnv.ppsServer.StopPipelineInTransaction(t.txnCtx, req)
+}
+
 func (t *directTransaction) SetScope(original *auth.SetScopeRequest) (*auth.SetScopeResponse, error) {
 	req := proto.Clone(original).(*auth.SetScopeRequest)
 	return t.txnCtx.txnEnv.authServer.SetScopeInTransaction(t.txnCtx, req)
@@ -302,11 +363,41 @@ func (t *appendTransaction) DeleteBranch(req *pfs.DeleteBranchRequest) error {
 	return err
 }
 
+func (t *appendTransaction) PutFile(req *transaction.PutFileRecordsRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{PutFile: req})
+	return err
+}
+
+func (t *appendTransaction) DeleteFile(req *pfs.DeleteFileRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DeleteFile: req})
+	return err
+}
+
 func (t *appendTransaction) UpdateJobState(req *pps.UpdateJobStateRequest) error {
 	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{UpdateJobState: req})
 	return err
 }
 
+func (t *appendTransaction) CreatePipeline(req *pps.CreatePipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{CreatePipeline: req})
+	return err
+}
+
+func (t *appendTransaction) DeletePipeline(req *pps.DeletePipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{DeletePipeline: req})
+	return err
+}
+
+func (t *appendTransaction) StartPipeline(req *pps.StartPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StartPipeline: req})
+	return err
+}
+
+func (t *appendTransaction) StopPipeline(req *pps.StopPipelineRequest) error {
+	_, err := t.txnEnv.txnServer.AppendRequest(t.ctx, t.activeTxn, &transaction.TransactionRequest{StopPipeline: req})
+	return err
+}
+
 func (t *appendTransaction) SetScope(original *auth.SetScopeRequest) (*auth.SetScopeResponse, error) {
 	panic("SetScope not yet implemented in transactions")
 }
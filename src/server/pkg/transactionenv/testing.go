@@ -5,6 +5,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/transaction"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 )
 
@@ -65,6 +66,21 @@ func (mats *MockAuthTransactionServer) SetACLInTransaction(*TransactionContext,
 	return nil, unimplementedError("AuthTransactionServer.SetACLInTransaction")
 }
 
+// GetDataPoliciesInTransaction always errors
+func (mats *MockAuthTransactionServer) GetDataPoliciesInTransaction(*TransactionContext) ([]*auth.DataPolicy, error) {
+	return nil, unimplementedError("AuthTransactionServer.GetDataPoliciesInTransaction")
+}
+
+// GetAuthTokenInTransaction always errors
+func (mats *MockAuthTransactionServer) GetAuthTokenInTransaction(*TransactionContext, *auth.GetAuthTokenRequest) (*auth.GetAuthTokenResponse, error) {
+	return nil, unimplementedError("AuthTransactionServer.GetAuthTokenInTransaction")
+}
+
+// RevokeAuthTokenInTransaction always errors
+func (mats *MockAuthTransactionServer) RevokeAuthTokenInTransaction(*TransactionContext, *auth.RevokeAuthTokenRequest) (*auth.RevokeAuthTokenResponse, error) {
+	return nil, unimplementedError("AuthTransactionServer.RevokeAuthTokenInTransaction")
+}
+
 // MockPfsTransactionServer is a simple mock that can be used to satisfy the
 // PfsTransactionServer interface
 type MockPfsTransactionServer struct{}
@@ -109,16 +125,36 @@ func (mpts *MockPfsTransactionServer) DeleteCommitInTransaction(*TransactionCont
 	return unimplementedError("PfsTransactionServer.DeleteCommitInTransaction")
 }
 
+// InspectCommitInTransaction always errors
+func (mpts *MockPfsTransactionServer) InspectCommitInTransaction(*TransactionContext, *pfs.InspectCommitRequest) (*pfs.CommitInfo, error) {
+	return nil, unimplementedError("PfsTransactionServer.InspectCommitInTransaction")
+}
+
 // CreateBranchInTransaction always errors
 func (mpts *MockPfsTransactionServer) CreateBranchInTransaction(*TransactionContext, *pfs.CreateBranchRequest) error {
 	return unimplementedError("PfsTransactionServer.CreateBranchInTransaction")
 }
 
+// InspectBranchInTransaction always errors
+func (mpts *MockPfsTransactionServer) InspectBranchInTransaction(*TransactionContext, *pfs.InspectBranchRequest) (*pfs.BranchInfo, error) {
+	return nil, unimplementedError("PfsTransactionServer.InspectBranchInTransaction")
+}
+
 // DeleteBranchInTransaction always errors
 func (mpts *MockPfsTransactionServer) DeleteBranchInTransaction(*TransactionContext, *pfs.DeleteBranchRequest) error {
 	return unimplementedError("PfsTransactionServer.DeleteBranchInTransaction")
 }
 
+// PutFileInTransaction always errors
+func (mpts *MockPfsTransactionServer) PutFileInTransaction(*TransactionContext, *transaction.PutFileRecordsRequest) error {
+	return unimplementedError("PfsTransactionServer.PutFileInTransaction")
+}
+
+// DeleteFileInTransaction always errors
+func (mpts *MockPfsTransactionServer) DeleteFileInTransaction(*TransactionContext, *pfs.DeleteFileRequest) error {
+	return unimplementedError("PfsTransactionServer.DeleteFileInTransaction")
+}
+
 // MockPpsTransactionServer is a simple mock that can be used to satisfy the
 // PpsTransactionServer interface
 type MockPpsTransactionServer struct{}
@@ -132,3 +168,23 @@ func NewMockPpsTransactionServer() *MockPpsTransactionServer {
 func (mpts *MockPpsTransactionServer) UpdateJobStateInTransaction(*TransactionContext, *pps.UpdateJobStateRequest) error {
 	return unimplementedError("PpsTransactionServer.UpdateJobStateInTransaction")
 }
+
+// CreatePipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) CreatePipelineInTransaction(*TransactionContext, *pps.CreatePipelineRequest) error {
+	return unimplementedError("PpsTransactionServer.CreatePipelineInTransaction")
+}
+
+// DeletePipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) DeletePipelineInTransaction(*TransactionContext, *pps.DeletePipelineRequest) error {
+	return unimplementedError("PpsTransactionServer.DeletePipelineInTransaction")
+}
+
+// StartPipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) StartPipelineInTransaction(*TransactionContext, *pps.StartPipelineRequest) error {
+	return unimplementedError("PpsTransactionServer.StartPipelineInTransaction")
+}
+
+// StopPipelineInTransaction always errors
+func (mpts *MockPpsTransactionServer) StopPipelineInTransaction(*TransactionContext, *pps.StopPipelineRequest) error {
+	return unimplementedError("PpsTransactionServer.StopPipelineInTransaction")
+}
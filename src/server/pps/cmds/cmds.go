@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/fatih/color"
@@ -26,9 +27,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/progress"
 	"github.com/pachyderm/pachyderm/src/server/pkg/serde"
-	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	"github.com/pachyderm/pachyderm/src/server/pps/pretty"
+	txncmds "github.com/pachyderm/pachyderm/src/server/transaction/cmds"
 
 	prompt "github.com/c-bata/go-prompt"
 	units "github.com/docker/go-units"
@@ -72,16 +73,7 @@ func encoder(format string, w ...io.Writer) serde.Encoder {
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
 
-	raw := false
-	var output string
-	outputFlags := pflag.NewFlagSet("", pflag.ExitOnError)
-	outputFlags.BoolVar(&raw, "raw", false, "Disable pretty printing; serialize data structures to an encoding such as json or yaml")
-	// --output is empty by default, so that we can print an error if a user
-	// explicitly sets --output without --raw, but the effective default is set in
-	// encode(), which assumes "json" if 'format' is empty.
-	// Note: because of how spf13/flags works, no other StringVarP that sets
-	// 'output' can have a default value either
-	outputFlags.StringVarP(&output, "output", "o", "", "Output format when --raw is set: \"json\" or \"yaml\" (default \"json\")")
+	output, outputFlags := cmdutil.NewOutputFlags()
 
 	fullTimestamps := false
 	fullTimestampsFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -105,36 +97,53 @@ If the job fails, the output commit will not be populated with data.`,
 	commands = append(commands, cmdutil.CreateDocsAlias(jobDocs, "job", " job$"))
 
 	var block bool
+	var timeline bool
+	var timelineWidth int
 	inspectJob := &cobra.Command{
 		Use:   "{{alias}} <job>",
 		Short: "Return info about a job.",
 		Long:  "Return info about a job.",
+		Example: `
+# Return info about job XXX
+$ {{alias}} XXX
+
+# Render the timeline of job XXX (its master's steps, and when each worker
+# was downloading, processing and uploading datums) as a Gantt chart
+$ {{alias}} XXX --timeline`,
 		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine("user")
 			if err != nil {
 				return err
 			}
 			defer client.Close()
-			jobInfo, err := client.InspectJob(args[0], block, true)
+			jobInfo, err := client.PpsAPIClient.InspectJob(client.Ctx(), &ppsclient.InspectJobRequest{
+				Job:        pachdclient.NewJob(args[0]),
+				BlockState: block,
+				Full:       true,
+				Timeline:   timeline,
+			})
 			if err != nil {
-				cmdutil.ErrorAndExit("error from InspectJob: %s", err.Error())
+				cmdutil.ErrorAndExit("error from InspectJob: %s", grpcutil.ScrubGRPC(err).Error())
 			}
 			if jobInfo == nil {
 				cmdutil.ErrorAndExit("job %s not found.", args[0])
 			}
-			if raw {
-				return encoder(output).EncodeProto(jobInfo)
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
-			}
-			ji := &pretty.PrintableJobInfo{
-				JobInfo:        jobInfo,
-				FullTimestamps: fullTimestamps,
+			table := jobTable(fullTimestamps)
+			table.PrintDetailed = func(proto.Message) error {
+				if timeline {
+					return pretty.PrintJobTimeline(os.Stdout, jobInfo.Timeline, timelineWidth)
+				}
+				return pretty.PrintDetailedJobInfo(&pretty.PrintableJobInfo{
+					JobInfo:        jobInfo,
+					FullTimestamps: fullTimestamps,
+				})
 			}
-			return pretty.PrintDetailedJobInfo(ji)
+			return output.Print(os.Stdout, table, jobInfo)
 		}),
 	}
 	inspectJob.Flags().BoolVarP(&block, "block", "b", false, "block until the job has either succeeded or failed")
+	inspectJob.Flags().BoolVar(&timeline, "timeline", false, "Render the job's timeline as a Gantt chart (with --raw or --output, include the timeline's spans in the output).")
+	inspectJob.Flags().IntVar(&timelineWidth, "timeline-width", 80, "The number of columns in the Gantt chart rendered by --timeline.")
 	inspectJob.Flags().AddFlagSet(outputFlags)
 	inspectJob.Flags().AddFlagSet(fullTimestampsFlags)
 	shell.RegisterCompletionFunc(inspectJob, shell.JobCompletion)
@@ -161,8 +170,16 @@ $ {{alias}} -p foo --history all
 $ {{alias}} -i foo@XXX -i bar@YYY
 
 # Return all jobs in pipeline foo and whose input commits include bar@YYY
-$ {{alias}} -p foo -i bar@YYY`,
+$ {{alias}} -p foo -i bar@YYY
+
+# Return the IDs and states of all jobs whose output commit is foo@XXX
+$ {{alias}} --output-commit foo@XXX -o 'template={{.job.id}} {{.state}}'`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			if outputCommitStr == "" && output.Output != "" && !cmdutil.IsOutputFormat(output.Output) {
+				// -o used to be short for --output-commit
+				fmt.Fprintln(os.Stderr, "WARNING: '-o <repo>@<branch-or-commit>' is deprecated, use '--output-commit <repo>@<branch-or-commit>'")
+				outputCommitStr, output.Output = output.Output, ""
+			}
 			commits, err := cmdutil.ParseCommits(inputCommitStrs)
 			if err != nil {
 				return err
@@ -186,29 +203,24 @@ $ {{alias}} -p foo -i bar@YYY`,
 			defer client.Close()
 
 			return pager.Page(noPager, os.Stdout, func(w io.Writer) error {
-				if raw {
-					e := encoder(output)
-					return client.ListJobF(pipelineName, commits, outputCommit, history, true, func(ji *ppsclient.JobInfo) error {
-						return e.EncodeProto(ji)
-					})
-				} else if output != "" {
-					cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+				printer, err := output.NewPrinter(w, jobTable(fullTimestamps))
+				if err != nil {
+					return err
 				}
-				writer := tabwriter.NewWriter(w, pretty.JobHeader)
-				if err := client.ListJobF(pipelineName, commits, outputCommit, history, false, func(ji *ppsclient.JobInfo) error {
-					pretty.PrintJobInfo(writer, ji, fullTimestamps)
-					return nil
+				// Tables don't need the jobs' full details
+				if err := client.ListJobF(pipelineName, commits, outputCommit, history, output.Structured(), func(ji *ppsclient.JobInfo) error {
+					return printer.Print(ji)
 				}); err != nil {
 					return err
 				}
-				return writer.Flush()
+				return printer.Close()
 			})
 		}),
 	}
 	listJob.Flags().StringVarP(&pipelineName, "pipeline", "p", "", "Limit to jobs made by pipeline.")
 	listJob.MarkFlagCustom("pipeline", "__pachctl_get_pipeline")
-	listJob.Flags().StringVarP(&outputCommitStr, "output", "o", "", "List jobs with a specific output commit. format: <repo>@<branch-or-commit>")
-	listJob.MarkFlagCustom("output", "__pachctl_get_repo_commit")
+	listJob.Flags().StringVar(&outputCommitStr, "output-commit", "", "List jobs with a specific output commit. format: <repo>@<branch-or-commit>")
+	listJob.MarkFlagCustom("output-commit", "__pachctl_get_repo_commit")
 	listJob.Flags().StringSliceVarP(&inputCommitStrs, "input", "i", []string{}, "List jobs with a specific set of input commits. format: <repo>@<branch-or-commit>")
 	listJob.MarkFlagCustom("input", "__pachctl_get_repo_commit")
 	listJob.Flags().AddFlagSet(outputFlags)
@@ -225,6 +237,92 @@ $ {{alias}} -p foo -i bar@YYY`,
 		})
 	commands = append(commands, cmdutil.CreateAlias(listJob, "list job"))
 
+	var since string
+	var groupBy string
+	var labelKey string
+	listUsage := &cobra.Command{
+		Short: "Return the resources used by jobs.",
+		Long:  "Return the CPU time, memory, data transferred and datums processed by jobs, grouped by pipeline or by a pipeline label.",
+		Example: `
+# Return the resources used by each pipeline
+$ {{alias}}
+
+# Return the resources used in the last week by each team, based on the
+# "team" label in the pipelines' metadata
+$ {{alias}} --since 168h --group-by label --label team
+
+# Return the resources used since the start of June
+$ {{alias}} --since 2020-06-01T00:00:00Z`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			request := &ppsclient.ListUsageRequest{LabelKey: labelKey}
+			switch groupBy {
+			case "pipeline":
+				request.GroupBy = ppsclient.ListUsageRequest_PIPELINE
+			case "label":
+				request.GroupBy = ppsclient.ListUsageRequest_LABEL
+				if labelKey == "" {
+					return errors.Errorf("--label must be set with --group-by label")
+				}
+			default:
+				return errors.Errorf("--group-by must be one of pipeline or label, got %q", groupBy)
+			}
+			if since != "" {
+				sinceTime, err := cmdutil.ParseSince(since)
+				if err != nil {
+					return err
+				}
+				if request.Since, err = types.TimestampProto(sinceTime); err != nil {
+					return err
+				}
+			}
+
+			client, err := pachdclient.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			response, err := client.PpsAPIClient.ListUsage(client.Ctx(), request)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			printer, err := output.NewPrinter(os.Stdout, cmdutil.Table{
+				Header: pretty.UsageHeader,
+				PrintRow: func(w io.Writer, item proto.Message) {
+					pretty.PrintUsage(w, item.(*ppsclient.ResourceUsage))
+				},
+				CSVHeader: []string{"group", "jobs", "cpu_seconds",
+					"memory_byte_seconds", "download_bytes", "upload_bytes",
+					"data_processed", "data_skipped", "data_failed"},
+				CSVRow: func(item proto.Message) []string {
+					usage := item.(*ppsclient.ResourceUsage)
+					return []string{usage.Group, strconv.FormatInt(usage.Jobs, 10),
+						strconv.FormatFloat(usage.CpuSeconds, 'f', -1, 64),
+						strconv.FormatFloat(usage.MemoryByteSeconds, 'f', -1, 64),
+						strconv.FormatUint(usage.DownloadBytes, 10),
+						strconv.FormatUint(usage.UploadBytes, 10),
+						strconv.FormatInt(usage.DataProcessed, 10),
+						strconv.FormatInt(usage.DataSkipped, 10),
+						strconv.FormatInt(usage.DataFailed, 10)}
+				},
+			})
+			if err != nil {
+				return err
+			}
+			for _, usage := range response.Usage {
+				if err := printer.Print(usage); err != nil {
+					return err
+				}
+			}
+			return printer.Close()
+		}),
+	}
+	listUsage.Flags().StringVar(&since, "since", "", "Only count jobs started after this time, either a duration before now (e.g. 24h) or an RFC 3339 timestamp.")
+	listUsage.Flags().StringVar(&groupBy, "group-by", "pipeline", "Group usage by 'pipeline' or by 'label'.")
+	listUsage.Flags().StringVar(&labelKey, "label", "", "The pipeline label to group by, with --group-by label.")
+	listUsage.Flags().AddFlagSet(outputFlags)
+	commands = append(commands, cmdutil.CreateAlias(listUsage, "list usage"))
+
 	var pipelines cmdutil.RepeatedStringArg
 	flushJob := &cobra.Command{
 		Use:   "{{alias}} <repo>@<branch-or-commit> ...",
@@ -237,9 +335,6 @@ $ {{alias}} foo@XXX bar@YYY
 # Return jobs caused by foo@XXX leading to pipelines bar and baz.
 $ {{alias}} foo@XXX -p bar -p baz`,
 		Run: cmdutil.Run(func(args []string) error {
-			if output != "" && !raw {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
-			}
 			commits, err := cmdutil.ParseCommits(args)
 			if err != nil {
 				return err
@@ -250,27 +345,16 @@ $ {{alias}} foo@XXX -p bar -p baz`,
 				return err
 			}
 			defer c.Close()
-			var writer *tabwriter.Writer
-			if !raw {
-				writer = tabwriter.NewWriter(os.Stdout, pretty.JobHeader)
+			printer, err := output.NewPrinter(os.Stdout, jobTable(fullTimestamps))
+			if err != nil {
+				return err
 			}
-			e := encoder(output)
 			if err := c.FlushJob(commits, pipelines, func(ji *ppsclient.JobInfo) error {
-				if raw {
-					if err := e.EncodeProto(ji); err != nil {
-						return err
-					}
-					return nil
-				}
-				pretty.PrintJobInfo(writer, ji, fullTimestamps)
-				return nil
+				return printer.Print(ji)
 			}); err != nil {
 				return err
 			}
-			if !raw {
-				return writer.Flush()
-			}
-			return nil
+			return printer.Close()
 		}),
 	}
 	flushJob.Flags().VarP(&pipelines, "pipeline", "p", "Wait only for jobs leading to a specific set of pipelines")
@@ -383,22 +467,16 @@ each datum.`,
 			if page < 0 {
 				return errors.Errorf("page must be zero or positive")
 			}
-			if raw {
-				e := encoder(output)
-				return client.ListDatumF(args[0], pageSize, page, func(di *ppsclient.DatumInfo) error {
-					return e.EncodeProto(di)
-				})
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			printer, err := output.NewPrinter(os.Stdout, datumTable())
+			if err != nil {
+				return err
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.DatumHeader)
 			if err := client.ListDatumF(args[0], pageSize, page, func(di *ppsclient.DatumInfo) error {
-				pretty.PrintDatumInfo(writer, di)
-				return nil
+				return printer.Print(di)
 			}); err != nil {
 				return err
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
 	listDatum.Flags().Int64Var(&pageSize, "pageSize", 0, "Specify the number of results sent back in a single page")
@@ -421,19 +499,19 @@ each datum.`,
 			if err != nil {
 				return err
 			}
-			if raw {
-				return encoder(output).EncodeProto(datumInfo)
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			table := datumTable()
+			table.PrintDetailed = func(proto.Message) error {
+				pretty.PrintDetailedDatumInfo(os.Stdout, datumInfo)
+				return nil
 			}
-			pretty.PrintDetailedDatumInfo(os.Stdout, datumInfo)
-			return nil
+			return output.Print(os.Stdout, table, datumInfo)
 		}),
 	}
 	inspectDatum.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectDatum, "inspect datum"))
 
 	var (
+		raw         bool
 		jobID       string
 		datumID     string
 		commaInputs string // comma-separated list of input files of interest
@@ -570,11 +648,22 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	var registry string
 	var username string
 	var pipelinePath string
+	var templateArgs []string
 	createPipeline := &cobra.Command{
 		Short: "Create a new pipeline.",
-		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
+		Long:  "Create a new pipeline from a pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html. If the file contains several pipelines, they're created in a single transaction.",
+		Example: `
+		# Create a pipeline from a spec file
+		$ {{alias}} -f pipeline.json
+
+		# Create the pipelines in a Go template, filling in {{.repo}} and {{.image}}
+		$ {{alias}} -f dag.json.tmpl --arg repo=images --arg image=edges:1.0
+
+		# Create the pipelines in a Jsonnet file, which reads its arguments with
+		# std.extVar("repo") and std.extVar("image")
+		$ {{alias}} -f dag.jsonnet --arg repo=images --arg image=edges:1.0`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, false)
+			return pipelineHelper(false, build, pushImages, registry, username, pipelinePath, templateArgs, false)
 		}),
 	}
 	createPipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -582,6 +671,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	createPipeline.Flags().BoolVarP(&pushImages, "push-images", "p", false, "If true, push local docker images into the docker registry.")
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
+	createPipeline.Flags().StringArrayVar(&templateArgs, "arg", nil, "Render the pipeline spec as a Go template (or, if the file ends in .jsonnet, a Jsonnet program), with the given 'key=value' argument. May be repeated.")
 	commands = append(commands, cmdutil.CreateAlias(createPipeline, "create pipeline"))
 
 	var reprocess bool
@@ -589,7 +679,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Short: "Update an existing Pachyderm pipeline.",
 		Long:  "Update a Pachyderm pipeline with a new pipeline specification. For details on the format, see http://docs.pachyderm.io/en/latest/reference/pipeline_spec.html.",
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, true)
+			return pipelineHelper(reprocess, build, pushImages, registry, username, pipelinePath, templateArgs, true)
 		}),
 	}
 	updatePipeline.Flags().StringVarP(&pipelinePath, "file", "f", "-", "The JSON file containing the pipeline, it can be a url or local file. - reads from stdin.")
@@ -598,6 +688,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "index.docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
+	updatePipeline.Flags().StringArrayVar(&templateArgs, "arg", nil, "Render the pipeline spec as a Go template (or, if the file ends in .jsonnet, a Jsonnet program), with the given 'key=value' argument. May be repeated.")
 	commands = append(commands, cmdutil.CreateAlias(updatePipeline, "update pipeline"))
 
 	runPipeline := &cobra.Command{
@@ -662,6 +753,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 	}
 	commands = append(commands, cmdutil.CreateAlias(runCron, "run cron"))
 
+	var showTemplate bool
 	inspectPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Return info about a pipeline.",
@@ -679,22 +771,29 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if pipelineInfo == nil {
 				return errors.Errorf("pipeline %s not found", args[0])
 			}
-			if raw {
-				return encoder(output).EncodeProto(pipelineInfo)
-			} else if output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw")
+			if showTemplate {
+				if pipelineInfo.Template == nil {
+					return errors.Errorf("pipeline %s was not created from a template", args[0])
+				}
+				_, err := fmt.Print(pipelineInfo.Template.Source)
+				return err
 			}
-			pi := &pretty.PrintablePipelineInfo{
-				PipelineInfo:   pipelineInfo,
-				FullTimestamps: fullTimestamps,
+			table := pipelineTable(fullTimestamps)
+			table.PrintDetailed = func(proto.Message) error {
+				return pretty.PrintDetailedPipelineInfo(os.Stdout, &pretty.PrintablePipelineInfo{
+					PipelineInfo:   pipelineInfo,
+					FullTimestamps: fullTimestamps,
+				})
 			}
-			return pretty.PrintDetailedPipelineInfo(os.Stdout, pi)
+			return output.Print(os.Stdout, table, pipelineInfo)
 		}),
 	}
 	inspectPipeline.Flags().AddFlagSet(outputFlags)
+	inspectPipeline.Flags().BoolVar(&showTemplate, "template", false, "Print the template the pipeline spec was rendered from, rather than the pipeline info.")
 	inspectPipeline.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectPipeline, "inspect pipeline"))
 
+	var specFormat string
 	extractPipeline := &cobra.Command{
 		Use:   "{{alias}} <pipeline>",
 		Short: "Return the manifest used to create a pipeline.",
@@ -709,10 +808,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return err
 			}
-			return encoder(output).EncodeProto(createPipelineRequest)
+			return encoder(specFormat).EncodeProto(createPipelineRequest)
 		}),
 	}
-	extractPipeline.Flags().StringVarP(&output, "output", "o", "", "Output format: \"json\" or \"yaml\" (default \"json\")")
+	extractPipeline.Flags().StringVarP(&specFormat, "output", "o", "", "Output format: \"json\" or \"yaml\" (default \"json\")")
 	commands = append(commands, cmdutil.CreateAlias(extractPipeline, "extract pipeline"))
 
 	var editor string
@@ -735,7 +834,7 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return err
 			}
-			if err := encoder(output, f).EncodeProto(createPipelineRequest); err != nil {
+			if err := encoder(specFormat, f).EncodeProto(createPipelineRequest); err != nil {
 				return err
 			}
 			defer func() {
@@ -772,18 +871,18 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			}
 			request.Update = true
 			request.Reprocess = reprocess
-			if _, err := client.PpsAPIClient.CreatePipeline(
-				client.Ctx(),
-				request,
-			); err != nil {
+			return txncmds.WithActiveTransaction(client, func(client *pachdclient.APIClient) error {
+				_, err := client.PpsAPIClient.CreatePipeline(
+					client.Ctx(),
+					request,
+				)
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	editPipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 	editPipeline.Flags().StringVar(&editor, "editor", "", "Editor to use for modifying the manifest.")
-	editPipeline.Flags().StringVarP(&output, "output", "o", "", "Output format: \"json\" or \"yaml\" (default \"json\")")
+	editPipeline.Flags().StringVarP(&specFormat, "output", "o", "", "Output format: \"json\" or \"yaml\" (default \"json\")")
 	commands = append(commands, cmdutil.CreateAlias(editPipeline, "edit pipeline"))
 
 	var spec bool
@@ -793,10 +892,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 		Long:  "Return info about all pipelines.",
 		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
 			// validate flags
-			if raw && spec {
+			if output.Raw && spec {
 				return errors.Errorf("cannot set both --raw and --spec")
-			} else if !raw && !spec && output != "" {
-				cmdutil.ErrorAndExit("cannot set --output (-o) without --raw or --spec")
+			} else if spec && output.Output == "" {
+				output.Output = cmdutil.JSONFormat
 			}
 			history, err := cmdutil.ParseHistory(history)
 			if err != nil {
@@ -822,34 +921,31 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return grpcutil.ScrubGRPC(err)
 			}
 			pipelineInfos := response.PipelineInfo
-			if raw {
-				e := encoder(output)
-				for _, pipelineInfo := range pipelineInfos {
-					if err := e.EncodeProto(pipelineInfo); err != nil {
-						return err
-					}
-				}
-				return nil
-			} else if spec {
-				e := encoder(output)
-				for _, pipelineInfo := range pipelineInfos {
-					if err := e.EncodeProto(ppsutil.PipelineReqFromInfo(pipelineInfo)); err != nil {
-						return err
-					}
-				}
-				return nil
+			printer, err := output.NewPrinter(os.Stdout, pipelineTable(fullTimestamps))
+			if err != nil {
+				return err
 			}
-			for _, pi := range pipelineInfos {
-				if ppsutil.ErrorState(pi.State) {
-					fmt.Fprintln(os.Stderr, "One or more pipelines have encountered errors, use inspect pipeline to get more info.")
-					break
+			if spec && !output.Structured() {
+				return errors.Errorf("--spec can't be printed as a table")
+			}
+			if !output.Structured() {
+				for _, pi := range pipelineInfos {
+					if ppsutil.ErrorState(pi.State) {
+						fmt.Fprintln(os.Stderr, "One or more pipelines have encountered errors, use inspect pipeline to get more info.")
+						break
+					}
 				}
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.PipelineHeader)
 			for _, pipelineInfo := range pipelineInfos {
-				pretty.PrintPipelineInfo(writer, pipelineInfo, fullTimestamps)
+				var item proto.Message = pipelineInfo
+				if spec {
+					item = ppsutil.PipelineReqFromInfo(pipelineInfo)
+				}
+				if err := printer.Print(item); err != nil {
+					return err
+				}
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
 	listPipeline.Flags().BoolVarP(&spec, "spec", "s", false, "Output 'create pipeline' compatibility specs.")
@@ -887,10 +983,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if len(args) > 0 {
 				req.Pipeline = pachdclient.NewPipeline(args[0])
 			}
-			if _, err = client.PpsAPIClient.DeletePipeline(client.Ctx(), req); err != nil {
+			return txncmds.WithActiveTransaction(client, func(client *pachdclient.APIClient) error {
+				_, err := client.PpsAPIClient.DeletePipeline(client.Ctx(), req)
 				return grpcutil.ScrubGRPC(err)
-			}
-			return nil
+			})
 		}),
 	}
 	deletePipeline.Flags().BoolVar(&all, "all", false, "delete all pipelines")
@@ -908,7 +1004,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StartPipeline(args[0]); err != nil {
+			if err := txncmds.WithActiveTransaction(client, func(client *pachdclient.APIClient) error {
+				return client.StartPipeline(args[0])
+			}); err != nil {
 				cmdutil.ErrorAndExit("error from StartPipeline: %s", err.Error())
 			}
 			return nil
@@ -926,7 +1024,9 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 				return err
 			}
 			defer client.Close()
-			if err := client.StopPipeline(args[0]); err != nil {
+			if err := txncmds.WithActiveTransaction(client, func(client *pachdclient.APIClient) error {
+				return client.StopPipeline(args[0])
+			}); err != nil {
 				cmdutil.ErrorAndExit("error from StopPipeline: %s", err.Error())
 			}
 			return nil
@@ -1011,11 +1111,10 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.SecretHeader)
-			pretty.PrintSecretInfo(writer, secretInfo)
-			return writer.Flush()
+			return output.Print(os.Stdout, secretTable(), secretInfo)
 		}),
 	}
+	inspectSecret.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectSecret, "inspect secret"))
 
 	listSecret := &cobra.Command{
@@ -1036,13 +1135,19 @@ All jobs created by a pipeline will create commits in the pipeline's output repo
 			if err != nil {
 				return grpcutil.ScrubGRPC(err)
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.SecretHeader)
+			printer, err := output.NewPrinter(os.Stdout, secretTable())
+			if err != nil {
+				return err
+			}
 			for _, si := range secretInfos.GetSecretInfo() {
-				pretty.PrintSecretInfo(writer, si)
+				if err := printer.Print(si); err != nil {
+					return err
+				}
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
+	listSecret.Flags().AddFlagSet(outputFlags)
 	commands = append(commands, cmdutil.CreateAlias(listSecret, "list secret"))
 
 	var memory string
@@ -1090,22 +1195,44 @@ you can increase the amount of memory used for the bloom filters with the
 	return commands
 }
 
-func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, update bool) error {
-	if build && pushImages {
-		logrus.Warning("`--push-images` is redundant, as it's already enabled with `--build`")
+func parseTemplateArgs(args []string) (map[string]string, error) {
+	result := make(map[string]string)
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid template argument %q, must be of the form 'key=value'", arg)
+		}
+		result[parts[0]] = parts[1]
 	}
+	return result, nil
+}
 
-	pipelineReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
-	if err != nil {
-		return err
+func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, username, pipelinePath string, templateArgs []string, update bool) error {
+	if build && pushImages {
+		logrus.Warning("`--push-images` is redundant, as it's already enabled with `--build`")
 	}
 
-	pc, err := pachdclient.NewOnUserMachine("user")
-	if err != nil {
-		return errors.Wrapf(err, "error connecting to pachd")
+	var pipelineReader *ppsutil.PipelineManifestReader
+	if len(templateArgs) > 0 || ppsutil.IsJsonnetPath(pipelinePath) {
+		args, err := parseTemplateArgs(templateArgs)
+		if err != nil {
+			return err
+		}
+		if pipelineReader, err = ppsutil.NewTemplatedPipelineManifestReader(pipelinePath, args); err != nil {
+			return err
+		}
+	} else {
+		var err error
+		if pipelineReader, err = ppsutil.NewPipelineManifestReader(pipelinePath); err != nil {
+			return err
+		}
 	}
-	defer pc.Close()
 
+	// Decode and check every request in the manifest before creating any
+	// pipelines, so that a malformed spec late in the file doesn't leave the
+	// DAG half-created.
+	var requests []*ppsclient.CreatePipelineRequest
+	names := make(map[string]bool)
 	for {
 		request, err := pipelineReader.NextCreatePipelineRequest()
 		if errors.Is(err, io.EOF) {
@@ -1120,12 +1247,20 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 		if request.Pipeline.Name == "" {
 			return errors.New("no pipeline `name` specified")
 		}
-
-		// Add trace if env var is set
-		if ctx, ok := extended.StartAnyExtendedTrace(pc.Ctx(), "/pps.API/CreatePipeline", request.Pipeline.Name); ok {
-			pc = pc.WithCtx(ctx)
+		if names[request.Pipeline.Name] {
+			return errors.Errorf("pipeline %q is specified more than once", request.Pipeline.Name)
 		}
+		names[request.Pipeline.Name] = true
+		requests = append(requests, request)
+	}
 
+	pc, err := pachdclient.NewOnUserMachine("user")
+	if err != nil {
+		return errors.Wrapf(err, "error connecting to pachd")
+	}
+	defer pc.Close()
+
+	for _, request := range requests {
 		if update {
 			request.Update = true
 			request.Reprocess = reprocess
@@ -1196,15 +1331,25 @@ func pipelineHelper(reprocess bool, build bool, pushImages bool, registry, usern
 			}
 		}
 
-		if _, err := pc.PpsAPIClient.CreatePipeline(
-			pc.Ctx(),
-			request,
-		); err != nil {
-			return grpcutil.ScrubGRPC(err)
-		}
 	}
 
-	return nil
+	createPipelines := func(pc *pachdclient.APIClient) error {
+		for _, request := range requests {
+			// Add trace if env var is set. The request is sent with pc's
+			// metadata (its auth token and active transaction) as well.
+			ctx, _ := extended.StartAnyExtendedTrace(pc.Ctx(), "/pps.API/CreatePipeline", request.Pipeline.Name)
+			if _, err := pc.WithCtx(ctx).PpsAPIClient.CreatePipeline(pc.WithCtx(ctx).Ctx(), request); err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+		}
+		return nil
+	}
+	if len(requests) == 1 {
+		return txncmds.WithActiveTransaction(pc, createPipelines)
+	}
+	// Create a DAG in a single transaction, so that either all of its
+	// pipelines are created or none are
+	return txncmds.WithNewOrActiveTransaction(pc, createPipelines)
 }
 
 func dockerBuildHelper(request *ppsclient.CreatePipelineRequest, build bool, registry, username, pipelineParentPath string) error {
@@ -1482,3 +1627,83 @@ func (arr ByCreationTime) Less(i, j int) bool {
 
 	return false
 }
+
+// jobTable returns the table printed by 'list job'
+func jobTable(fullTimestamps bool) cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.JobHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintJobInfo(w, item.(*ppsclient.JobInfo), fullTimestamps)
+		},
+		CSVHeader: []string{"id", "pipeline", "started", "finished", "restart",
+			"data_processed", "data_skipped", "data_recovered", "data_failed",
+			"data_quarantined", "data_total", "download_bytes", "upload_bytes",
+			"state", "reason"},
+		CSVRow: func(item proto.Message) []string {
+			ji := item.(*ppsclient.JobInfo)
+			return []string{ji.Job.ID, ji.Pipeline.Name, cmdutil.CSVTimestamp(ji.Started),
+				cmdutil.CSVTimestamp(ji.Finished), strconv.FormatUint(ji.Restart, 10),
+				strconv.FormatInt(ji.DataProcessed, 10), strconv.FormatInt(ji.DataSkipped, 10),
+				strconv.FormatInt(ji.DataRecovered, 10), strconv.FormatInt(ji.DataFailed, 10),
+				strconv.FormatInt(ji.DataQuarantined, 10), strconv.FormatInt(ji.DataTotal, 10),
+				strconv.FormatUint(ji.Stats.GetDownloadBytes(), 10),
+				strconv.FormatUint(ji.Stats.GetUploadBytes(), 10), ji.State.String(), ji.Reason}
+		},
+	}
+}
+
+// datumTable returns the table printed by 'list datum'
+func datumTable() cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.DatumHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintDatumInfo(w, item.(*ppsclient.DatumInfo))
+		},
+		CSVHeader: []string{"id", "state", "total_time_seconds"},
+		CSVRow: func(item proto.Message) []string {
+			di := item.(*ppsclient.DatumInfo)
+			var totalTime string
+			if di.Stats != nil {
+				totalTime = strconv.FormatFloat(pachdclient.GetDatumTotalTime(di.Stats).Seconds(), 'f', -1, 64)
+			}
+			return []string{di.Datum.ID, di.State.String(), totalTime}
+		},
+	}
+}
+
+// pipelineTable returns the table printed by 'list pipeline'
+func pipelineTable(fullTimestamps bool) cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.PipelineHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintPipelineInfo(w, item.(*ppsclient.PipelineInfo), fullTimestamps)
+		},
+		CSVHeader: []string{"name", "version", "input", "created_at", "state",
+			"last_job_state", "description"},
+		CSVRow: func(item proto.Message) []string {
+			pi := item.(*ppsclient.PipelineInfo)
+			var input string
+			if pi.Transform != nil {
+				input = pretty.ShorthandInput(pi.Input)
+			}
+			return []string{pi.Pipeline.Name, strconv.FormatUint(pi.Version, 10), input,
+				cmdutil.CSVTimestamp(pi.CreatedAt), pi.State.String(),
+				pi.LastJobState.String(), pi.Description}
+		},
+	}
+}
+
+// secretTable returns the table printed by 'list secret'
+func secretTable() cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.SecretHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintSecretInfo(w, item.(*ppsclient.SecretInfo))
+		},
+		CSVHeader: []string{"name", "type", "creation_timestamp"},
+		CSVRow: func(item proto.Message) []string {
+			si := item.(*ppsclient.SecretInfo)
+			return []string{si.Secret.Name, si.Type, cmdutil.CSVTimestamp(si.CreationTimestamp)}
+		},
+	}
+}
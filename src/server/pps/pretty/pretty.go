@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strings"
 	"text/template"
+	"time"
 
 	units "github.com/docker/go-units"
 	"github.com/fatih/color"
@@ -29,6 +31,8 @@ const (
 	DatumHeader = "ID\tSTATUS\tTIME\t\n"
 	// SecretHeader is the header for secrets
 	SecretHeader = "NAME\tTYPE\tCREATED\t\n"
+	// UsageHeader is the header for resource usage
+	UsageHeader = "GROUP\tJOBS\tCPU\tMEMORY\tDL\tUL\tPROCESSED\tSKIPPED\tFAILED\t\n"
 	// jobReasonLen is the amount of the job reason that we print
 	jobReasonLen = 25
 )
@@ -66,6 +70,24 @@ func PrintJobInfo(w io.Writer, jobInfo *ppsclient.JobInfo, fullTimestamps bool)
 	fmt.Fprintln(w)
 }
 
+// PrintUsage pretty-prints the resource usage of a group of jobs.
+func PrintUsage(w io.Writer, usage *ppsclient.ResourceUsage) {
+	group := usage.Group
+	if group == "" {
+		group = "-"
+	}
+	fmt.Fprintf(w, "%s\t", group)
+	fmt.Fprintf(w, "%d\t", usage.Jobs)
+	fmt.Fprintf(w, "%s\t", cpuSeconds(usage.CpuSeconds))
+	fmt.Fprintf(w, "%s\t", byteSeconds(usage.MemoryByteSeconds))
+	fmt.Fprintf(w, "%s\t", pretty.Size(usage.DownloadBytes))
+	fmt.Fprintf(w, "%s\t", pretty.Size(usage.UploadBytes))
+	fmt.Fprintf(w, "%d\t", usage.DataProcessed)
+	fmt.Fprintf(w, "%d\t", usage.DataSkipped)
+	fmt.Fprintf(w, "%d\t", usage.DataFailed)
+	fmt.Fprintln(w)
+}
+
 // PrintPipelineInfo pretty-prints pipeline info.
 func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo, fullTimestamps bool) {
 	if pipelineInfo.Transform == nil {
@@ -141,12 +163,15 @@ Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
 Skipped: {{.DataSkipped}}
 Recovered: {{.DataRecovered}}
+Quarantined: {{.DataQuarantined}}
 Total: {{.DataTotal}}
 Data Downloaded: {{prettySize .Stats.DownloadBytes}}
 Data Uploaded: {{prettySize .Stats.UploadBytes}}
 Download Time: {{prettyDuration .Stats.DownloadTime}}
 Process Time: {{prettyDuration .Stats.ProcessTime}}
 Upload Time: {{prettyDuration .Stats.UploadTime}}
+CPU Time: {{cpuSeconds .Stats.CpuSeconds}}
+Memory Usage: {{byteSeconds .Stats.MemoryByteSeconds}}
 Datum Timeout: {{.DatumTimeout}}
 Job Timeout: {{.JobTimeout}}
 Worker Status:
@@ -172,7 +197,7 @@ Transform:
 {{prettyTransform .Transform}} {{if .OutputCommit}}
 Output Commit: {{.OutputCommit.ID}} {{end}} {{ if .StatsCommit }}
 Stats Commit: {{.StatsCommit.ID}} {{end}} {{ if .Egress }}
-Egress: {{.Egress.URL}} {{end}}
+Egress: {{egressTarget .Egress}} {{end}}
 `)
 	if err != nil {
 		return err
@@ -227,11 +252,19 @@ Input:
 Output Branch: {{.OutputBranch}}
 Transform:
 {{prettyTransform .Transform}}
-{{ if .Egress }}Egress: {{.Egress.URL}} {{end}}
+{{ if .Egress }}Egress: {{egressTarget .Egress}} {{end}}
+{{ if .Template }}Template Args:
+{{templateArgs .Template}}{{end}}
 {{if .RecentError}} Recent Error: {{.RecentError}} {{end}}
 Job Counts:
-{{jobCounts .JobCounts}}
-`)
+{{jobCounts .JobCounts}}{{ if .Usage }}
+Usage:
+  Jobs: {{.Usage.Jobs}}
+  CPU Time: {{cpuSeconds .Usage.CpuSeconds}}
+  Memory Usage: {{byteSeconds .Usage.MemoryByteSeconds}}
+  Data Downloaded: {{prettySize .Usage.DownloadBytes}}
+  Data Uploaded: {{prettySize .Usage.UploadBytes}}
+{{end}}`)
 	if err != nil {
 		return err
 	}
@@ -329,6 +362,8 @@ func datumState(datumState ppsclient.DatumState) string {
 		return color.New(color.FgRed).SprintFunc()("failed")
 	case ppsclient.DatumState_RECOVERED:
 		return color.New(color.FgYellow).SprintFunc()("recovered")
+	case ppsclient.DatumState_QUARANTINED:
+		return color.New(color.FgRed).SprintFunc()("quarantined")
 	case ppsclient.DatumState_SUCCESS:
 		return color.New(color.FgGreen).SprintFunc()("success")
 	}
@@ -424,6 +459,43 @@ func jobCounts(counts map[int32]int32) string {
 	return buffer.String()
 }
 
+func templateArgs(pipelineTemplate *ppsclient.PipelineTemplate) string {
+	var keys []string
+	for key := range pipelineTemplate.Args {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var buffer bytes.Buffer
+	for _, key := range keys {
+		fmt.Fprintf(&buffer, "  %s: %s\n", key, pipelineTemplate.Args[key])
+	}
+	return buffer.String()
+}
+
+func cpuSeconds(seconds float64) string {
+	return fmt.Sprintf("%.2fs", seconds)
+}
+
+// byteSeconds formats a memory-byte-seconds value, e.g. "1.5GiB-s".
+func byteSeconds(byteSeconds float64) string {
+	return pretty.Size(uint64(byteSeconds)) + "-s"
+}
+
+func egressTarget(egress *ppsclient.Egress) string {
+	switch {
+	case egress.SqlDatabase != nil:
+		target := fmt.Sprintf("%s (%s)", egress.SqlDatabase.Url, egress.SqlDatabase.FileFormat)
+		if egress.SqlDatabase.Table != "" {
+			target += fmt.Sprintf(" table: %s", egress.SqlDatabase.Table)
+		}
+		return target
+	case egress.Http != nil:
+		return fmt.Sprintf("POST %s", egress.Http.Url)
+	default:
+		return egress.URL
+	}
+}
+
 func prettyTransform(transform *ppsclient.Transform) (string, error) {
 	result, err := json.MarshalIndent(transform, "", "  ")
 	if err != nil {
@@ -476,4 +548,194 @@ var funcMap = template.FuncMap{
 	"prettySize":           pretty.Size,
 	"jobCounts":            jobCounts,
 	"prettyTransform":      prettyTransform,
+	"templateArgs":         templateArgs,
+	"egressTarget":         egressTarget,
+	"cpuSeconds":           cpuSeconds,
+	"byteSeconds":          byteSeconds,
+}
+
+const (
+	// timelineLabelLen is the maximum width of the row labels of a timeline
+	timelineLabelLen = 40
+	// timelineBackground marks time spent in a span that has no more
+	// specific phase (e.g. a worker's task between datums)
+	timelineBackground = '-'
+)
+
+// timelineChar returns the character that a span is drawn with in a worker's
+// row of a timeline.
+func timelineChar(span *ppsclient.TimelineSpan) byte {
+	switch {
+	case span.Name == "download":
+		return 'D'
+	case span.Name == "process":
+		return 'P'
+	case span.Name == "upload":
+		return 'U'
+	case strings.HasPrefix(span.Name, "merg"):
+		return 'M'
+	}
+	return timelineBackground
+}
+
+type timelineRow struct {
+	label string
+	first time.Time
+	// coverage is how long each character was drawn for in each column
+	coverage []map[byte]time.Duration
+}
+
+func (r *timelineRow) add(span *ppsclient.TimelineSpan, char byte, start time.Time, column time.Duration) {
+	spanStart, err := types.TimestampFromProto(span.Start)
+	if err != nil {
+		return
+	}
+	spanEnd, err := types.TimestampFromProto(span.End)
+	if err != nil {
+		return
+	}
+	if r.first.IsZero() || spanStart.Before(r.first) {
+		r.first = spanStart
+	}
+	for i := range r.coverage {
+		colStart := start.Add(time.Duration(i) * column)
+		colEnd := colStart.Add(column)
+		from, to := spanStart, spanEnd
+		if from.Before(colStart) {
+			from = colStart
+		}
+		if to.After(colEnd) {
+			to = colEnd
+		}
+		if to.After(from) || (spanStart.Equal(spanEnd) && !spanStart.Before(colStart) && spanStart.Before(colEnd)) {
+			if r.coverage[i] == nil {
+				r.coverage[i] = make(map[byte]time.Duration)
+			}
+			// Count instantaneous spans as a nanosecond, so that they're drawn
+			r.coverage[i][char] += to.Sub(from) + 1
+		}
+	}
+}
+
+// String draws each column with the character that covers the most of it,
+// preferring specific phases over the background.
+func (r *timelineRow) String() string {
+	var result strings.Builder
+	for _, coverage := range r.coverage {
+		char := byte(' ')
+		var longest time.Duration
+		for c, d := range coverage {
+			if c == timelineBackground {
+				continue
+			}
+			if d > longest || (d == longest && c < char) {
+				char, longest = c, d
+			}
+		}
+		if char == ' ' && coverage[timelineBackground] > 0 {
+			char = timelineBackground
+		}
+		result.WriteByte(char)
+	}
+	return result.String()
+}
+
+func roundDuration(d time.Duration) time.Duration {
+	if d >= 10*time.Second {
+		return d.Round(time.Second)
+	}
+	return d.Round(time.Millisecond)
+}
+
+// PrintJobTimeline renders a job's timeline as an ASCII Gantt chart, width
+// columns wide. The first rows are the job and the steps of its master, and
+// then there's a row for each worker, showing when it was downloading (D),
+// processing (P) and uploading (U) datums, and merging (M) hashtrees.
+func PrintJobTimeline(w io.Writer, timeline *ppsclient.JobTimeline, width int) error {
+	if timeline == nil || len(timeline.Spans) == 0 {
+		_, err := fmt.Fprintln(w, "No timeline has been recorded for this job yet.")
+		return err
+	}
+	if width < 10 {
+		width = 10
+	}
+	var start, end time.Time
+	for _, span := range timeline.Spans {
+		spanStart, err := types.TimestampFromProto(span.Start)
+		if err != nil {
+			return err
+		}
+		spanEnd, err := types.TimestampFromProto(span.End)
+		if err != nil {
+			return err
+		}
+		if start.IsZero() || spanStart.Before(start) {
+			start = spanStart
+		}
+		if spanEnd.After(end) {
+			end = spanEnd
+		}
+	}
+	column := end.Sub(start) / time.Duration(width)
+	if column <= 0 {
+		column = time.Nanosecond
+	}
+
+	// The job's root span, the master's steps (grouped by name, as steps may
+	// be retried) and the workers each get a row
+	rows := make(map[string]*timelineRow)
+	var masterRows, workerRows []*timelineRow
+	for _, span := range timeline.Spans {
+		var key, label string
+		char := byte('=')
+		switch {
+		case span.ParentSpanID == "":
+			key, label = "job", "job"
+		case span.Worker == "":
+			key, label = "step "+span.Name, span.Name
+		default:
+			key, label = "worker "+span.Worker, span.Worker
+			char = timelineChar(span)
+		}
+		if span.Error != "" && char == '=' {
+			char = 'X'
+		}
+		row, ok := rows[key]
+		if !ok {
+			row = &timelineRow{label: safeTrim(label, timelineLabelLen), coverage: make([]map[byte]time.Duration, width)}
+			rows[key] = row
+			if span.Worker == "" {
+				masterRows = append(masterRows, row)
+			} else {
+				workerRows = append(workerRows, row)
+			}
+		}
+		row.add(span, char, start, column)
+	}
+	sort.SliceStable(masterRows, func(i, j int) bool {
+		if (masterRows[i].label == "job") != (masterRows[j].label == "job") {
+			return masterRows[i].label == "job"
+		}
+		return masterRows[i].first.Before(masterRows[j].first)
+	})
+	sort.SliceStable(workerRows, func(i, j int) bool {
+		return workerRows[i].label < workerRows[j].label
+	})
+
+	labelLen := 0
+	for _, row := range rows {
+		if len(row.label) > labelLen {
+			labelLen = len(row.label)
+		}
+	}
+	total := roundDuration(end.Sub(start))
+	fmt.Fprintf(w, "%-*s |%s%*s|\n", labelLen, "", "0s", width-2, total)
+	for _, row := range append(masterRows, workerRows...) {
+		fmt.Fprintf(w, "%-*s |%s|\n", labelLen, row.label, row)
+	}
+	fmt.Fprintf(w, "\nEach column is %v. Steps: = running, X failed. Workers: D download, P process, U upload, M merge, - other task work.\n", roundDuration(column))
+	if timeline.DatumSpansDropped > 0 {
+		fmt.Fprintf(w, "Datum spans recorded for a sample of datums only (%d spans dropped).\n", timeline.DatumSpansDropped)
+	}
+	return nil
 }
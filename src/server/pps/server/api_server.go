@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net/url"
 	"path"
 	"path/filepath"
 	"sort"
@@ -26,10 +27,12 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pkg/tracing/extended"
 	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/client/transaction"
 	pfsServer "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/lokiutil"
@@ -46,6 +49,7 @@ import (
 	workercommon "github.com/pachyderm/pachyderm/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/src/server/worker/datum"
 	workerserver "github.com/pachyderm/pachyderm/src/server/worker/server"
+	workertimeline "github.com/pachyderm/pachyderm/src/server/worker/timeline"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
@@ -55,6 +59,7 @@ import (
 	"github.com/willf/bloom"
 	"golang.org/x/net/context"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/metadata"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -177,7 +182,7 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 	return nil
 }
 
-func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName string, input *pps.Input, job bool) error {
+func (a *apiServer) validateInput(txnCtx *txnenv.TransactionContext, pipelineName string, input *pps.Input, job bool) error {
 	if err := validateNames(make(map[string]bool), input); err != nil {
 		return err
 	}
@@ -218,14 +223,20 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 				}
 				// Note that input.Pfs.Commit is empty if a) this is a job b) one of
 				// the job pipeline's input branches has no commits yet
-				if job && input.Pfs.Commit != "" {
+				if txnCtx == nil {
+					// txnCtx is nil for dry runs, whose inputs needn't exist yet
+				} else if job && input.Pfs.Commit != "" {
 					// for jobs we check that the input commit exists
-					if _, err := pachClient.InspectCommit(input.Pfs.Repo, input.Pfs.Commit); err != nil {
+					if _, err := txnCtx.Pfs().InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
+						Commit: client.NewCommit(input.Pfs.Repo, input.Pfs.Commit),
+					}); err != nil {
 						return err
 					}
 				} else {
 					// for pipelines we only check that the repo exists
-					if _, err := pachClient.InspectRepo(input.Pfs.Repo); err != nil {
+					if _, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
+						Repo: client.NewRepo(input.Pfs.Repo),
+					}); err != nil {
 						return err
 					}
 				}
@@ -299,6 +310,123 @@ func validateTransform(transform *pps.Transform) error {
 	if transform.Image == "" {
 		return errors.Errorf("pipeline transform must contain an image")
 	}
+	for _, secret := range transform.Secrets {
+		if secret.VaultPath == "" {
+			continue
+		}
+		if secret.Name != "" {
+			return errors.Errorf("secret %q cannot set both name and vault_path", secret.Name)
+		}
+		if secret.EnvVar == "" && secret.MountPath == "" {
+			return errors.Errorf("vault secret %q must set env_var or mount_path", secret.VaultPath)
+		}
+		if secret.EnvVar != "" && secret.Key == "" {
+			return errors.Errorf("vault secret %q must set key to populate env_var", secret.VaultPath)
+		}
+	}
+	return nil
+}
+
+func validateRetryPolicy(policy *pps.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	var initial, max time.Duration
+	var err error
+	if policy.InitialBackoff != nil {
+		if initial, err = types.DurationFromProto(policy.InitialBackoff); err != nil {
+			return err
+		}
+		if initial < 0 {
+			return errors.Errorf("initial_backoff cannot be negative")
+		}
+	}
+	if policy.MaxBackoff != nil {
+		if max, err = types.DurationFromProto(policy.MaxBackoff); err != nil {
+			return err
+		}
+		if max < initial {
+			return errors.Errorf("max_backoff (%v) must be at least initial_backoff (%v)", max, initial)
+		}
+	}
+	if policy.Multiplier != 0 && policy.Multiplier < 1 {
+		return errors.Errorf("multiplier must be at least 1, got %v", policy.Multiplier)
+	}
+	return nil
+}
+
+func validateSpoutSource(spout *pps.Spout) error {
+	if spout.Kafka != nil && spout.Ingest != nil {
+		return errors.Errorf("spout can only have one of kafka and ingest")
+	}
+	if spout.Kafka != nil {
+		if len(spout.Kafka.Brokers) == 0 {
+			return errors.Errorf("kafka spout must specify at least one broker")
+		}
+		if spout.Kafka.Topic == "" {
+			return errors.Errorf("kafka spout must specify a topic")
+		}
+	}
+	if spout.Ingest != nil && (spout.Service == nil || spout.Service.InternalPort == 0) {
+		return errors.Errorf("ingest spout must specify service.internal_port to listen on")
+	}
+	if spout.Batch != nil {
+		if spout.Kafka == nil && spout.Ingest == nil {
+			return errors.Errorf("spout batch can only be set for kafka and ingest spouts")
+		}
+		if spout.Batch.MaxMessages < 0 || spout.Batch.MaxBytes < 0 {
+			return errors.Errorf("spout batch limits cannot be negative")
+		}
+		if spout.Batch.MaxDelay != nil {
+			delay, err := types.DurationFromProto(spout.Batch.MaxDelay)
+			if err != nil {
+				return err
+			}
+			if delay <= 0 {
+				return errors.Errorf("spout batch max_delay must be positive")
+			}
+		}
+	}
+	return nil
+}
+
+func validateEgress(egress *pps.Egress) error {
+	if egress == nil {
+		return nil
+	}
+	var targets int
+	if egress.URL != "" {
+		targets++
+	}
+	if egress.SqlDatabase != nil {
+		targets++
+		u, err := url.Parse(egress.SqlDatabase.Url)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse sql_database.url")
+		}
+		switch u.Scheme {
+		case "postgres", "postgresql":
+		case "mysql":
+			if egress.SqlDatabase.FileFormat == pps.SQLEgress_PGDUMP {
+				return errors.Errorf("PGDUMP files can only be egressed to postgres")
+			}
+		default:
+			return errors.Errorf("sql_database.url must start with postgres:// or mysql://, got %q", egress.SqlDatabase.Url)
+		}
+	}
+	if egress.Http != nil {
+		targets++
+		u, err := url.Parse(egress.Http.Url)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse http.url")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.Errorf("http.url must start with http:// or https://, got %q", egress.Http.Url)
+		}
+	}
+	if targets != 1 {
+		return errors.Errorf("egress must set exactly one of URL, sql_database or http")
+	}
 	return nil
 }
 
@@ -403,24 +531,35 @@ const (
 	pipelineOpUpdate
 	// pipelineOpUpdate is required for DeletePipeline
 	pipelineOpDelete
+	// pipelineOpRestartDatum is required for RestartDatum
+	pipelineOpRestartDatum
 )
 
 // authorizePipelineOp checks if the user indicated by 'ctx' is authorized
-// to perform 'operation' on the pipeline in 'info'
+// to perform 'operation' on the pipeline in 'info'. If the pipeline has its own
+// ACL, that ACL is checked rather than the ACL of the pipeline's output repo.
 func (a *apiServer) authorizePipelineOp(pachClient *client.APIClient, operation pipelineOperation, input *pps.Input, output string) error {
-	ctx := pachClient.Ctx()
-	me, err := pachClient.WhoAmI(ctx, &auth.WhoAmIRequest{})
+	return a.txnEnv.WithReadContext(pachClient.Ctx(), func(txnCtx *txnenv.TransactionContext) error {
+		return a.authorizePipelineOpInTransaction(txnCtx, operation, input, output)
+	})
+}
+
+// authorizePipelineOpInTransaction is identical to authorizePipelineOp, except
+// that it performs reads consistent with the latest state of the STM
+// transaction.
+func (a *apiServer) authorizePipelineOpInTransaction(txnCtx *txnenv.TransactionContext, operation pipelineOperation, input *pps.Input, output string) error {
+	me, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
 	if auth.IsErrNotActivated(err) {
 		return nil // Auth isn't activated, skip authorization completely
 	} else if err != nil {
 		return err
 	}
 
-	if input != nil && operation != pipelineOpDelete {
+	if input != nil && operation != pipelineOpDelete && operation != pipelineOpRestartDatum {
 		// Check that the user is authorized to read all input repos, and write to the
 		// output repo (which the pipeline needs to be able to do on the user's
 		// behalf)
-		var eg errgroup.Group
+		var visitErr error
 		done := make(map[string]struct{}) // don't double-authorize repos
 		pps.VisitInput(input, func(in *pps.Input) {
 			var repo string
@@ -431,30 +570,26 @@ func (a *apiServer) authorizePipelineOp(pachClient *client.APIClient, operation
 				return
 			}
 
-			if _, ok := done[repo]; ok {
+			if _, ok := done[repo]; ok || visitErr != nil {
 				return
 			}
 			done[repo] = struct{}{}
-			eg.Go(func() error {
-				resp, err := pachClient.Authorize(ctx, &auth.AuthorizeRequest{
-					Repo:  repo,
-					Scope: auth.Scope_READER,
-				})
-				if err != nil {
-					return err
-				}
-				if !resp.Authorized {
-					return &auth.ErrNotAuthorized{
-						Subject:  me.Username,
-						Repo:     repo,
-						Required: auth.Scope_READER,
-					}
-				}
-				return nil
+			resp, err := txnCtx.Auth().AuthorizeInTransaction(txnCtx, &auth.AuthorizeRequest{
+				Repo:  repo,
+				Scope: auth.Scope_READER,
 			})
+			if err != nil {
+				visitErr = err
+			} else if !resp.Authorized {
+				visitErr = &auth.ErrNotAuthorized{
+					Subject:  me.Username,
+					Repo:     repo,
+					Required: auth.Scope_READER,
+				}
+			}
 		})
-		if err := eg.Wait(); err != nil {
-			return err
+		if visitErr != nil {
+			return visitErr
 		}
 	}
 
@@ -464,32 +599,42 @@ func (a *apiServer) authorizePipelineOp(pachClient *client.APIClient, operation
 	// repo yet, and it's possible to check that the output repo doesn't exist
 	// (if it did exist, we'd have to check that the user has permission to write
 	// to it, and this is simpler)
+	// 'required' is reported in errors, but 'permission' is what's checked
 	var required auth.Scope
+	var permission auth.Permission
+	inspectOutputRepo := func() error {
+		_, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
+			Repo: client.NewRepo(output),
+		})
+		return err
+	}
 	switch operation {
 	case pipelineOpCreate:
-		if _, err := pachClient.InspectRepo(output); err == nil {
+		if err := inspectOutputRepo(); err == nil {
 			return errors.Errorf("cannot overwrite repo \"%s\" with new output repo", output)
 		} else if !isNotFoundErr(err) {
 			return err
 		}
 	case pipelineOpListDatum, pipelineOpGetLogs:
-		required = auth.Scope_READER
-	case pipelineOpUpdate:
-		required = auth.Scope_WRITER
+		required, permission = auth.Scope_READER, auth.Permission_PIPELINE_LOGS
+	case pipelineOpUpdate, pipelineOpRestartDatum:
+		required, permission = auth.Scope_WRITER, auth.Permission_PIPELINE_UPDATE
 	case pipelineOpDelete:
-		if _, err := pachClient.InspectRepo(output); isNotFoundErr(err) {
+		if err := inspectOutputRepo(); isNotFoundErr(err) {
 			// special case: the pipeline output repo has been deleted (so the
 			// pipeline is now invalid). It should be possible to delete the pipeline.
 			return nil
 		}
-		required = auth.Scope_OWNER
+		required, permission = auth.Scope_OWNER, auth.Permission_PIPELINE_DELETE
 	default:
 		return errors.Errorf("internal error, unrecognized operation %v", operation)
 	}
 	if required != auth.Scope_NONE {
-		resp, err := pachClient.Authorize(ctx, &auth.AuthorizeRequest{
-			Repo:  output,
-			Scope: required,
+		resp, err := txnCtx.Auth().AuthorizeInTransaction(txnCtx, &auth.AuthorizeRequest{
+			Repo:        output,
+			Pipeline:    output,
+			Scope:       required,
+			Permissions: []auth.Permission{permission},
 		})
 		if err != nil {
 			return err
@@ -498,6 +643,7 @@ func (a *apiServer) authorizePipelineOp(pachClient *client.APIClient, operation
 			return &auth.ErrNotAuthorized{
 				Subject:  me.Username,
 				Repo:     output,
+				Pipeline: output,
 				Required: required,
 			}
 		}
@@ -505,6 +651,35 @@ func (a *apiServer) authorizePipelineOp(pachClient *client.APIClient, operation
 	return nil
 }
 
+// checkEgressPolicyInTransaction returns an error if the pipeline whose output repo is
+// 'output' reads data from 'input' (or has an output repo) with a
+// classification label whose data policy forbids egress
+func (a *apiServer) checkEgressPolicyInTransaction(txnCtx *txnenv.TransactionContext, input *pps.Input, output string) error {
+	repos := append([]string{output}, ppsutil.InputRepos(input)...)
+	policies, err := txnCtx.Auth().GetDataPoliciesInTransaction(txnCtx)
+	if auth.IsErrNotActivated(err) || len(policies) == 0 {
+		return nil // no policies to enforce
+	} else if err != nil {
+		return err
+	}
+	engine := datapolicy.New(policies)
+	for _, repo := range repos {
+		repoInfo, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
+			Repo: client.NewRepo(repo),
+		})
+		if err != nil {
+			if isNotFoundErr(err) {
+				continue // e.g. the output repo of a new pipeline
+			}
+			return err
+		}
+		if err := engine.CheckEgress(datapolicy.Labels(repoInfo)); err != nil {
+			return errors.Wrapf(err, "pipeline %q cannot egress data from %q", output, repo)
+		}
+	}
+	return nil
+}
+
 func (a *apiServer) UpdateJobState(ctx context.Context, request *pps.UpdateJobStateRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -534,6 +709,7 @@ func (a *apiServer) UpdateJobStateInTransaction(txnCtx *txnenv.TransactionContex
 	jobPtr.DataSkipped = request.DataSkipped
 	jobPtr.DataFailed = request.DataFailed
 	jobPtr.DataRecovered = request.DataRecovered
+	jobPtr.DataQuarantined = request.DataQuarantined
 	jobPtr.DataTotal = request.DataTotal
 	jobPtr.Stats = request.Stats
 
@@ -556,19 +732,20 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 	}
 	_, err = col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
 		jobPtr := &pps.EtcdJobInfo{
-			Job:           job,
-			OutputCommit:  request.OutputCommit,
-			Pipeline:      request.Pipeline,
-			Stats:         request.Stats,
-			Restart:       request.Restart,
-			DataProcessed: request.DataProcessed,
-			DataSkipped:   request.DataSkipped,
-			DataTotal:     request.DataTotal,
-			DataFailed:    request.DataFailed,
-			DataRecovered: request.DataRecovered,
-			StatsCommit:   request.StatsCommit,
-			Started:       request.Started,
-			Finished:      request.Finished,
+			Job:             job,
+			OutputCommit:    request.OutputCommit,
+			Pipeline:        request.Pipeline,
+			Stats:           request.Stats,
+			Restart:         request.Restart,
+			DataProcessed:   request.DataProcessed,
+			DataSkipped:     request.DataSkipped,
+			DataTotal:       request.DataTotal,
+			DataFailed:      request.DataFailed,
+			DataRecovered:   request.DataRecovered,
+			DataQuarantined: request.DataQuarantined,
+			StatsCommit:     request.StatsCommit,
+			Started:         request.Started,
+			Finished:        request.Finished,
 		}
 		return ppsutil.UpdateJobState(a.pipelines.ReadWrite(stm), a.jobs.ReadWrite(stm), jobPtr, request.State, request.Reason)
 	})
@@ -636,7 +813,11 @@ func (a *apiServer) InspectJob(ctx context.Context, request *pps.InspectJobReque
 					return nil, err
 				}
 				if ppsutil.IsTerminal(jobPtr.State) {
-					return a.jobInfoFromPtr(pachClient, jobPtr, true)
+					jobInfo, err := a.jobInfoFromPtr(pachClient, jobPtr, true)
+					if err != nil {
+						return nil, err
+					}
+					return jobInfo, a.loadJobTimeline(pachClient, request, jobInfo)
 				}
 			}
 		}
@@ -649,6 +830,9 @@ func (a *apiServer) InspectJob(ctx context.Context, request *pps.InspectJobReque
 	if err != nil {
 		return nil, err
 	}
+	if err := a.loadJobTimeline(pachClient, request, jobInfo); err != nil {
+		return nil, err
+	}
 	if request.Full {
 		// If the job is running we fill in WorkerStatus field, otherwise we just
 		// return the jobInfo.
@@ -674,6 +858,19 @@ func (a *apiServer) InspectJob(ctx context.Context, request *pps.InspectJobReque
 	return jobInfo, nil
 }
 
+// loadJobTimeline fills in the timeline of the given job, if it was requested.
+func (a *apiServer) loadJobTimeline(pachClient *client.APIClient, request *pps.InspectJobRequest, jobInfo *pps.JobInfo) error {
+	if !request.Timeline {
+		return nil
+	}
+	timeline, err := workertimeline.Load(pachClient, jobInfo.Job.ID)
+	if err != nil {
+		return errors.Wrapf(err, "could not load the timeline of job %s", jobInfo.Job.ID)
+	}
+	jobInfo.Timeline = timeline
+	return nil
+}
+
 // listJob is the internal implementation of ListJob shared between ListJob and
 // ListJobStream. When ListJob is removed, this should be inlined into
 // ListJobStream.
@@ -781,22 +978,23 @@ func (a *apiServer) listJob(pachClient *client.APIClient, pipeline *pps.Pipeline
 
 func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.EtcdJobInfo, full bool) (*pps.JobInfo, error) {
 	result := &pps.JobInfo{
-		Job:           jobPtr.Job,
-		Pipeline:      jobPtr.Pipeline,
-		OutputRepo:    &pfs.Repo{Name: jobPtr.Pipeline.Name},
-		OutputCommit:  jobPtr.OutputCommit,
-		Restart:       jobPtr.Restart,
-		DataProcessed: jobPtr.DataProcessed,
-		DataSkipped:   jobPtr.DataSkipped,
-		DataTotal:     jobPtr.DataTotal,
-		DataFailed:    jobPtr.DataFailed,
-		DataRecovered: jobPtr.DataRecovered,
-		Stats:         jobPtr.Stats,
-		StatsCommit:   jobPtr.StatsCommit,
-		State:         jobPtr.State,
-		Reason:        jobPtr.Reason,
-		Started:       jobPtr.Started,
-		Finished:      jobPtr.Finished,
+		Job:             jobPtr.Job,
+		Pipeline:        jobPtr.Pipeline,
+		OutputRepo:      &pfs.Repo{Name: jobPtr.Pipeline.Name},
+		OutputCommit:    jobPtr.OutputCommit,
+		Restart:         jobPtr.Restart,
+		DataProcessed:   jobPtr.DataProcessed,
+		DataSkipped:     jobPtr.DataSkipped,
+		DataTotal:       jobPtr.DataTotal,
+		DataFailed:      jobPtr.DataFailed,
+		DataRecovered:   jobPtr.DataRecovered,
+		DataQuarantined: jobPtr.DataQuarantined,
+		Stats:           jobPtr.Stats,
+		StatsCommit:     jobPtr.StatsCommit,
+		State:           jobPtr.State,
+		Reason:          jobPtr.Reason,
+		Started:         jobPtr.Started,
+		Finished:        jobPtr.Finished,
 	}
 	commitInfo, err := pachClient.InspectCommit(jobPtr.OutputCommit.Repo.Name, jobPtr.OutputCommit.ID)
 	if err != nil {
@@ -849,6 +1047,7 @@ func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.Etc
 		result.DatumTimeout = pipelineInfo.DatumTimeout
 		result.JobTimeout = pipelineInfo.JobTimeout
 		result.DatumTries = pipelineInfo.DatumTries
+		result.RetryPolicy = pipelineInfo.RetryPolicy
 		result.SchedulingSpec = pipelineInfo.SchedulingSpec
 		result.PodSpec = pipelineInfo.PodSpec
 		result.PodPatch = pipelineInfo.PodPatch
@@ -895,6 +1094,153 @@ func (a *apiServer) ListJobStream(request *pps.ListJobRequest, resp pps.API_List
 	})
 }
 
+// ListUsage implements the protobuf pps.ListUsage RPC
+func (a *apiServer) ListUsage(ctx context.Context, request *pps.ListUsageRequest) (response *pps.ListUsageResponse, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if request.GroupBy == pps.ListUsageRequest_LABEL && request.LabelKey == "" {
+		return nil, errors.Errorf("label_key must be set when grouping by label")
+	}
+	var since time.Time
+	if request.Since != nil {
+		var err error
+		if since, err = types.TimestampFromProto(request.Since); err != nil {
+			return nil, err
+		}
+	}
+	pachClient := a.env.GetPachClient(ctx)
+	authIsActive := true
+	if _, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); auth.IsErrNotActivated(err) {
+		authIsActive = false
+	} else if err != nil {
+		return nil, err
+	}
+	// readable caches whether the caller may read each pipeline's output repo.
+	// As in ListJob, the usage of pipelines that the caller can't read is
+	// silently skipped.
+	readable := make(map[string]bool)
+	canRead := func(pipelineName string) (bool, error) {
+		if !authIsActive {
+			return true, nil
+		}
+		if ok, cached := readable[pipelineName]; cached {
+			return ok, nil
+		}
+		resp, err := pachClient.Authorize(pachClient.Ctx(), &auth.AuthorizeRequest{
+			Repo:  pipelineName,
+			Scope: auth.Scope_READER,
+		})
+		if err != nil {
+			return false, err
+		}
+		readable[pipelineName] = resp.Authorized
+		return resp.Authorized, nil
+	}
+	// labels caches the label value of each pipeline, when grouping by label
+	labels := make(map[string]string)
+	groupOf := func(pipelineName string, pipelineInfo *pps.PipelineInfo) (string, error) {
+		if request.GroupBy != pps.ListUsageRequest_LABEL {
+			return pipelineName, nil
+		}
+		label, ok := labels[pipelineName]
+		if !ok {
+			if pipelineInfo == nil {
+				var err error
+				pipelineInfo, err = a.inspectPipeline(pachClient, pipelineName)
+				if err != nil && !isNotFoundErr(err) {
+					return "", err
+				}
+			}
+			if pipelineInfo != nil && pipelineInfo.Metadata != nil {
+				label = pipelineInfo.Metadata.Labels[request.LabelKey]
+			}
+			labels[pipelineName] = label
+		}
+		return label, nil
+	}
+	usage := make(map[string]*pps.ResourceUsage)
+	addUsage := func(group string, u *pps.ResourceUsage) {
+		if usage[group] == nil {
+			usage[group] = &pps.ResourceUsage{Group: group}
+		}
+		total := usage[group]
+		total.Jobs += u.Jobs
+		total.CpuSeconds += u.CpuSeconds
+		total.MemoryByteSeconds += u.MemoryByteSeconds
+		total.DownloadBytes += u.DownloadBytes
+		total.UploadBytes += u.UploadBytes
+		total.DataProcessed += u.DataProcessed
+		total.DataSkipped += u.DataSkipped
+		total.DataFailed += u.DataFailed
+	}
+	if request.Since == nil {
+		// Each pipeline keeps a running total of its finished jobs' usage (see
+		// ppsutil.UpdateJobState), so the jobs themselves only need to be read if
+		// some must be excluded
+		pipelinePtr := &pps.EtcdPipelineInfo{}
+		if err := a.pipelines.ReadOnly(ctx).List(pipelinePtr, col.DefaultOptions, func(name string) error {
+			if pipelinePtr.Usage == nil {
+				return nil
+			}
+			if ok, err := canRead(name); err != nil || !ok {
+				return err
+			}
+			var pipelineInfo *pps.PipelineInfo
+			if request.GroupBy == pps.ListUsageRequest_LABEL {
+				var err error
+				if pipelineInfo, err = ppsutil.GetPipelineInfo(pachClient, name, pipelinePtr); err != nil {
+					return err
+				}
+			}
+			group, err := groupOf(name, pipelineInfo)
+			if err != nil {
+				return err
+			}
+			addUsage(group, pipelinePtr.Usage)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	} else {
+		// The usage of each job is read from the same etcd records that the
+		// pipelines' running totals are added up from
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := a.jobs.ReadOnly(ctx).List(jobPtr, col.DefaultOptions, func(string) error {
+			if jobPtr.Started == nil || !ppsutil.IsTerminal(jobPtr.State) {
+				return nil
+			}
+			started, err := types.TimestampFromProto(jobPtr.Started)
+			if err != nil {
+				return err
+			}
+			if started.Before(since) {
+				return nil
+			}
+			if ok, err := canRead(jobPtr.Pipeline.Name); err != nil || !ok {
+				return err
+			}
+			group, err := groupOf(jobPtr.Pipeline.Name, nil)
+			if err != nil {
+				return err
+			}
+			jobUsage := &pps.ResourceUsage{}
+			ppsutil.AddJobUsage(jobUsage, jobPtr.Stats, jobPtr.DataProcessed, jobPtr.DataSkipped, jobPtr.DataFailed)
+			addUsage(group, jobUsage)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	response = &pps.ListUsageResponse{}
+	for _, u := range usage {
+		response.Usage = append(response.Usage, u)
+	}
+	sort.Slice(response.Usage, func(i, j int) bool {
+		return response.Usage[i].Group < response.Usage[j].Group
+	})
+	return response, nil
+}
+
 // FlushJob implements the protobuf pps.FlushJob RPC
 func (a *apiServer) FlushJob(request *pps.FlushJobRequest, resp pps.API_FlushJobServer) (retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
@@ -960,6 +1306,13 @@ func (a *apiServer) DeleteJob(ctx context.Context, request *pps.DeleteJobRequest
 	if err != nil {
 		return nil, err
 	}
+	// The job's timeline is kept in object storage rather than etcd. Removing
+	// it is best-effort, as not every job has one.
+	if _, err := pachClient.DeleteObjDirect(ctx, &pfs.DeleteObjDirectRequest{
+		Object: workertimeline.Object(request.Job.ID),
+	}); err != nil {
+		logrus.Errorf("could not delete the timeline of job %s: %v", request.Job.ID, err)
+	}
 	return &types.Empty{}, nil
 }
 
@@ -1014,6 +1367,9 @@ func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumR
 	if err != nil {
 		return nil, err
 	}
+	if err := a.authorizePipelineOp(pachClient, pipelineOpRestartDatum, jobInfo.Input, jobInfo.Pipeline.Name); err != nil {
+		return nil, err
+	}
 	workerPoolID := ppsutil.PipelineRcName(jobInfo.Pipeline.Name, jobInfo.PipelineVersion)
 	if err := workerserver.Cancel(ctx, workerPoolID, a.env.GetEtcdClient(), a.etcdPrefix, a.workerGrpcPort, request.Job.ID, request.DataFilters); err != nil {
 		return nil, err
@@ -1278,6 +1634,18 @@ func (a *apiServer) getDatum(pachClient *client.APIClient, repo string, commit *
 		return nil, err
 	}
 
+	// Check if quarantined
+	stateFile = &pfs.File{
+		Commit: commit,
+		Path:   fmt.Sprintf("/%v/quarantined", datumID),
+	}
+	_, err = pfsClient.InspectFile(ctx, &pfs.InspectFileRequest{File: stateFile})
+	if err == nil {
+		datumInfo.State = pps.DatumState_QUARANTINED
+	} else if !isNotFoundErr(err) {
+		return nil, err
+	}
+
 	// Populate stats
 	var buffer bytes.Buffer
 	if err := pachClient.GetFile(commit.Repo.Name, commit.ID, fmt.Sprintf("/%v/stats", datumID), 0, 0, &buffer); err != nil {
@@ -1712,13 +2080,14 @@ func (a *apiServer) validatePipelineRequest(request *pps.CreatePipelineRequest)
 	if request.S3Out && request.EnableStats {
 		return errors.New("stats are not supported for pipelines that output via Pachyderm's S3 gateway")
 	}
-	if request.Transform == nil {
+	builtinSpout := request.Spout != nil && (request.Spout.Kafka != nil || request.Spout.Ingest != nil)
+	if request.Transform == nil && !builtinSpout {
 		return errors.Errorf("pipeline must specify a transform")
 	}
 	return nil
 }
 
-func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+func (a *apiServer) validatePipeline(txnCtx *txnenv.TransactionContext, pipelineInfo *pps.PipelineInfo) error {
 	if pipelineInfo.Pipeline == nil {
 		return errors.New("invalid pipeline spec: Pipeline field cannot be nil")
 	}
@@ -1739,7 +2108,18 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 	if err := validateTransform(pipelineInfo.Transform); err != nil {
 		return errors.Wrapf(err, "invalid transform")
 	}
-	if err := a.validateInput(pachClient, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false); err != nil {
+	for _, secret := range pipelineInfo.Transform.Secrets {
+		if secret.VaultPath != "" && a.env.VaultAddress == "" {
+			return errors.Errorf("pipeline uses vault secret %q, but pachd is not "+
+				"configured with a vault address (VAULT_ADDR)", secret.VaultPath)
+		}
+	}
+	if txnCtx != nil {
+		if err := a.authorizeVaultSecretsInTransaction(txnCtx, pipelineInfo); err != nil {
+			return err
+		}
+	}
+	if err := a.validateInput(txnCtx, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false); err != nil {
 		return err
 	}
 	if pipelineInfo.ParallelismSpec != nil {
@@ -1778,6 +2158,12 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 			return err
 		}
 	}
+	if err := validateRetryPolicy(pipelineInfo.RetryPolicy); err != nil {
+		return errors.Wrapf(err, "invalid retry policy")
+	}
+	if err := validateEgress(pipelineInfo.Egress); err != nil {
+		return errors.Wrapf(err, "invalid egress")
+	}
 	if pipelineInfo.PodSpec != "" && !json.Valid([]byte(pipelineInfo.PodSpec)) {
 		return errors.Errorf("malformed PodSpec")
 	}
@@ -1808,6 +2194,9 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 		if pipelineInfo.Spout.Service == nil && pipelineInfo.Input != nil {
 			return errors.Errorf("spout pipelines (without a service) must not have an input")
 		}
+		if err := validateSpoutSource(pipelineInfo.Spout); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -1828,58 +2217,54 @@ func branchProvenance(input *pps.Input) []*pfs.Branch {
 	return result
 }
 
-// hardStopPipeline does essentially the same thing as StopPipeline (deletes the
-// pipeline's branch provenance, deletes any open commits, deletes any k8s
-// workers), but does it immediately. This is to avoid races between operations
-// that will do subsequent work (e.g. UpdatePipeline and DeletePipeline) and the
-// PPS master
-func (a *apiServer) hardStopPipeline(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
+// hardStopPipelineInTransaction does essentially the same thing as
+// StopPipeline (deletes the pipeline's branch provenance, deletes any open
+// commits, deletes any k8s workers), but does it immediately. This is to avoid
+// races between operations that will do subsequent work (e.g. UpdatePipeline
+// and DeletePipeline) and the PPS master
+func (a *apiServer) hardStopPipelineInTransaction(txnCtx *txnenv.TransactionContext, pipelineInfo *pps.PipelineInfo) error {
 	// Remove the output branch's provenance so that no new jobs can be created
-	if err := pachClient.CreateBranch(
-		pipelineInfo.Pipeline.Name,
-		pipelineInfo.OutputBranch,
-		pipelineInfo.OutputBranch,
-		nil,
-	); err != nil && !isNotFoundErr(err) {
+	if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch: client.NewBranch(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch),
+		Head:   client.NewCommit(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch),
+	}); err != nil && !isNotFoundErr(err) {
 		return errors.Wrapf(err, "could not recreate original output branch")
 	}
 	if pipelineInfo.EnableStats {
-		if err := pachClient.CreateBranch(
-			pipelineInfo.Pipeline.Name,
-			"stats",
-			"stats",
-			nil,
-		); err != nil && !isNotFoundErr(err) {
+		if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch: client.NewBranch(pipelineInfo.Pipeline.Name, "stats"),
+			Head:   client.NewCommit(pipelineInfo.Pipeline.Name, "stats"),
+		}); err != nil && !isNotFoundErr(err) {
 			return errors.Wrapf(err, "could not recreate original stats branch")
 		}
 	}
 
-	// Now that new commits won't be created on the master branch, enumerate
-	// existing commits and close any open ones.
-	iter, err := pachClient.ListCommitStream(pachClient.Ctx(), &pfs.ListCommitRequest{
-		Repo: client.NewRepo(pipelineInfo.Pipeline.Name),
-		To:   client.NewCommit(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch),
-	})
-	if err != nil {
-		return errors.Wrapf(err, "couldn't get open commits on '%s'", pipelineInfo.OutputBranch)
-	}
-	// Finish all open commits, most recent first (so that we finish the
-	// current job's output commit--the oldest--last, and unblock the master
-	// only after all other commits are also finished, preventing any new jobs)
-	for {
-		ci, err := iter.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		} else if err != nil {
-			return err
+	// Now that new commits won't be created on the master branch, walk the
+	// existing commits and close any open ones, most recent first (so that we
+	// finish the current job's output commit--the oldest--last, and unblock the
+	// master only after all other commits are also finished, preventing any new
+	// jobs)
+	commit := client.NewCommit(pipelineInfo.Pipeline.Name, pipelineInfo.OutputBranch)
+	for commit != nil {
+		ci, err := txnCtx.Pfs().InspectCommitInTransaction(txnCtx, &pfs.InspectCommitRequest{
+			Commit: commit,
+		})
+		if err != nil {
+			if isNotFoundErr(err) || pfsServer.IsNoHeadErr(err) {
+				break // no output repo, branch or commits yet
+			}
+			return errors.Wrapf(err, "couldn't get open commits on '%s'", pipelineInfo.OutputBranch)
 		}
 		if ci.Finished == nil {
 			// Finish the commit and don't pass a tree
-			pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+			if err := txnCtx.Pfs().FinishCommitInTransaction(txnCtx, &pfs.FinishCommitRequest{
 				Commit: ci.Commit,
 				Empty:  true,
-			})
+			}); err != nil {
+				return err
+			}
 		}
+		commit = ci.ParentCommit
 	}
 	return nil
 }
@@ -1928,22 +2313,52 @@ func (a *apiServer) sudo(pachClient *client.APIClient, f func(*client.APIClient)
 	return f(superUserClient)
 }
 
-// makePipelineInfoCommit is a helper for CreatePipeline that creates a commit
-// with 'pipelineInfo' in SpecRepo (in PFS). It's called in both the case where
-// a user is updating a pipeline and the case where a user is creating a new
-// pipeline.
-func (a *apiServer) makePipelineInfoCommit(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) (result *pfs.Commit, retErr error) {
+// sudoTransaction is identical to sudo, except that it calls 'f' with a copy
+// of 'txnCtx' whose client and client context (which in-transaction auth
+// checks read the caller's token from) carry PPS's superuser token.
+func (a *apiServer) sudoTransaction(txnCtx *txnenv.TransactionContext, f func(*txnenv.TransactionContext) error) error {
+	return a.sudo(txnCtx.Client, func(superUserClient *client.APIClient) error {
+		md, _ := metadata.FromIncomingContext(txnCtx.ClientContext)
+		md = md.Copy()
+		md.Set(auth.ContextTokenKey, superUserToken)
+		superUserTxnCtx := *txnCtx
+		superUserTxnCtx.Client = superUserClient
+		superUserTxnCtx.ClientContext = metadata.NewIncomingContext(superUserClient.Ctx(), md)
+		return f(&superUserTxnCtx)
+	})
+}
+
+// makePipelineInfoCommitInTransaction is a helper for CreatePipeline that
+// creates a commit with 'pipelineInfo' in SpecRepo (in PFS). It's called in
+// both the case where a user is updating a pipeline and the case where a user
+// is creating a new pipeline.
+func (a *apiServer) makePipelineInfoCommitInTransaction(txnCtx *txnenv.TransactionContext, pipelineInfo *pps.PipelineInfo) (*pfs.Commit, error) {
 	pipelineName := pipelineInfo.Pipeline.Name
 	var commit *pfs.Commit
-	if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
+	if err := a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
 		data, err := pipelineInfo.Marshal()
 		if err != nil {
 			return errors.Wrapf(err, "could not marshal PipelineInfo")
 		}
-		if _, err = superUserClient.PutFileOverwrite(ppsconsts.SpecRepo, pipelineName, ppsconsts.SpecFile, bytes.NewReader(data), 0); err != nil {
+		// The spec is uploaded to object storage outside of the transaction (which
+		// is harmless if the transaction fails, as objects are content-addressed),
+		// and only the file's records are written in the transaction
+		object, size, err := superUserTxnCtx.Client.PutObject(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if err := superUserTxnCtx.Pfs().PutFileInTransaction(superUserTxnCtx, &transaction.PutFileRecordsRequest{
+			Files: []*pfs.File{client.NewFile(ppsconsts.SpecRepo, pipelineName, ppsconsts.SpecFile)},
+			Records: []*pfs.PutFileRecords{{
+				Tombstone: true, // overwrite the previous spec
+				Records:   []*pfs.PutFileRecord{{ObjectHash: object.Hash, SizeBytes: size}},
+			}},
+		}); err != nil {
 			return err
 		}
-		branchInfo, err := superUserClient.InspectBranch(ppsconsts.SpecRepo, pipelineName)
+		branchInfo, err := superUserTxnCtx.Pfs().InspectBranchInTransaction(superUserTxnCtx, &pfs.InspectBranchRequest{
+			Branch: client.NewBranch(ppsconsts.SpecRepo, pipelineName),
+		})
 		if err != nil {
 			return err
 		}
@@ -1955,10 +2370,13 @@ func (a *apiServer) makePipelineInfoCommit(pachClient *client.APIClient, pipelin
 	return commit, nil
 }
 
-func (a *apiServer) fixPipelineInputRepoACLs(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) error {
-	add := make(map[string]struct{})
-	remove := make(map[string]struct{})
-	var pipelineName string
+// pipelineInputRepoACLChanges is a helper for fixPipelineInputRepoACLs that
+// returns the name of the pipeline and the repos that must be added to or
+// removed from the pipeline's input repo ACLs when 'prevPipelineInfo' is
+// replaced by 'pipelineInfo' (either of which may be nil).
+func pipelineInputRepoACLChanges(pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) (pipelineName string, add, remove map[string]struct{}, retErr error) {
+	add = make(map[string]struct{})
+	remove = make(map[string]struct{})
 	// Figure out which repos 'pipeline' might no longer be using
 	if prevPipelineInfo != nil {
 		pipelineName = prevPipelineInfo.Pipeline.Name
@@ -1984,7 +2402,7 @@ func (a *apiServer) fixPipelineInputRepoACLs(pachClient *client.APIClient, pipel
 		if pipelineName == "" {
 			pipelineName = pipelineInfo.Pipeline.Name
 		} else if pipelineInfo.Pipeline.Name != pipelineName {
-			return errors.Errorf("pipelineInfo (%s) and prevPipelineInfo (%s) do not "+
+			return "", nil, nil, errors.Errorf("pipelineInfo (%s) and prevPipelineInfo (%s) do not "+
 				"belong to matching pipelines; this is a bug",
 				pipelineInfo.Pipeline.Name, prevPipelineInfo.Pipeline.Name)
 		}
@@ -2011,9 +2429,17 @@ func (a *apiServer) fixPipelineInputRepoACLs(pachClient *client.APIClient, pipel
 		})
 	}
 	if pipelineName == "" {
-		return errors.Errorf("fixPipelineInputRepoACLs called with both current and " +
+		return "", nil, nil, errors.Errorf("fixPipelineInputRepoACLs called with both current and " +
 			"previous pipelineInfos == to nil; this is a bug")
 	}
+	return pipelineName, add, remove, nil
+}
+
+func (a *apiServer) fixPipelineInputRepoACLs(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) error {
+	pipelineName, add, remove, err := pipelineInputRepoACLChanges(pipelineInfo, prevPipelineInfo)
+	if err != nil {
+		return err
+	}
 
 	var eg errgroup.Group
 	// Remove pipeline from old, unused inputs
@@ -2067,6 +2493,55 @@ func (a *apiServer) fixPipelineInputRepoACLs(pachClient *client.APIClient, pipel
 	return nil
 }
 
+// fixPipelineInputRepoACLsInTransaction is identical to
+// fixPipelineInputRepoACLs, except that it updates the ACLs inside an existing
+// STM transaction.
+func (a *apiServer) fixPipelineInputRepoACLsInTransaction(txnCtx *txnenv.TransactionContext, pipelineInfo *pps.PipelineInfo, prevPipelineInfo *pps.PipelineInfo) error {
+	pipelineName, add, remove, err := pipelineInputRepoACLChanges(pipelineInfo, prevPipelineInfo)
+	if err != nil {
+		return err
+	}
+	setScope := func(repo string, scope auth.Scope) error {
+		return a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+			_, err := superUserTxnCtx.Auth().SetScopeInTransaction(superUserTxnCtx, &auth.SetScopeRequest{
+				Repo:     repo,
+				Username: auth.PipelinePrefix + pipelineName,
+				Scope:    scope,
+			})
+			return err
+		})
+	}
+	// Sort the repos so that the transaction's writes are deterministic
+	sortedRepos := func(repos map[string]struct{}) []string {
+		var result []string
+		for repo := range repos {
+			result = append(result, repo)
+		}
+		sort.Strings(result)
+		return result
+	}
+	// Remove pipeline from old, unused inputs
+	for _, repo := range sortedRepos(remove) {
+		if err := setScope(repo, auth.Scope_NONE); err != nil && !isNotFoundErr(err) {
+			// not found can happen if input repo is force-deleted; nothing to remove
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error fixing ACLs on \"%s\"'s input repos", pipelineName)
+		}
+	}
+	// Add pipeline to every new input's ACL as a READER
+	for _, repo := range sortedRepos(add) {
+		if err := setScope(repo, auth.Scope_READER); err != nil {
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error fixing ACLs on \"%s\"'s input repos", pipelineName)
+		}
+	}
+	// Add pipeline to its output repo's ACL as a WRITER if it's new
+	if prevPipelineInfo == nil {
+		if err := setScope(pipelineName, auth.Scope_WRITER); err != nil {
+			return errors.Wrapf(grpcutil.ScrubGRPC(err), "error fixing ACLs on \"%s\"'s input repos", pipelineName)
+		}
+	}
+	return nil
+}
+
 // getExpectedNumWorkers is a helper function for CreatePipeline that transforms
 // the parallelism spec in CreatePipelineRequest.Parallelism into a constant
 // that can be stored in EtcdPipelineInfo.Parallelism
@@ -2095,26 +2570,6 @@ func getExpectedNumWorkers(kc *kube.Clientset, pipelineInfo *pps.PipelineInfo) (
 }
 
 // CreatePipeline implements the protobuf pps.CreatePipeline RPC
-//
-// Implementation note:
-// - CreatePipeline always creates pipeline output branches such that the
-//   pipeline's spec branch is in the pipeline output branch's provenance
-// - CreatePipeline will always create a new output commit, but that's done
-//   by CreateBranch at the bottom of the function, which sets the new output
-//   branch provenance, rather than makePipelineInfoCommit higher up.
-// - This is because CreatePipeline calls hardStopPipeline towards the top,
-// 	 breakng the provenance connection from the spec branch to the output branch
-// - For straightforward pipeline updates (e.g. new pipeline image)
-//   stopping + updating + starting the pipeline isn't necessary
-// - However it is necessary in many slightly atypical cases  (e.g. the
-//   pipeline input changed: if the spec commit is created while the
-//   output branch has its old provenance, or the output branch gets new
-//   provenance while the old spec commit is the HEAD of the spec branch,
-//   then an output commit will be created with provenance that doesn't
-//   match its spec's PipelineInfo.Input. Another example is when
-//   request.Reprocess == true).
-// - Rather than try to enumerate every case where we can't create a spec
-//   commit without stopping the pipeline, we just always stop the pipeline
 func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -2135,13 +2590,35 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 
 	// propagate trace info (doesn't affect intra-RPC trace)
 	ctx = extended.TraceIn2Out(ctx)
-	pachClient := a.env.GetPachClient(ctx)
-	ctx = pachClient.Ctx() // GetPachClient propagates auth info to inner ctx
-	pfsClient := pachClient.PfsAPIClient
-	// Reprocess overrides the salt in the request
+	// Reprocess overrides the salt in the request. This is done before the
+	// request is (possibly) appended to a transaction, so that the salt is the
+	// same every time the transaction runs
 	if request.Salt == "" || request.Reprocess {
 		request.Salt = uuid.NewWithoutDashes()
 	}
+	if request.DryRun {
+		pipelineInfo, err := newPipelineInfo(request)
+		if err != nil {
+			return nil, err
+		}
+		// The inputs of a dry run needn't exist yet, so they aren't checked
+		if err := a.validatePipeline(nil, pipelineInfo); err != nil {
+			return nil, err
+		}
+		return &types.Empty{}, nil
+	}
+
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.CreatePipeline(request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// newPipelineInfo returns the PipelineInfo described by 'request', with
+// defaults populated.
+func newPipelineInfo(request *pps.CreatePipelineRequest) (*pps.PipelineInfo, error) {
 	pipelineInfo := &pps.PipelineInfo{
 		Pipeline:              request.Pipeline,
 		Version:               1,
@@ -2168,24 +2645,69 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		JobTimeout:            request.JobTimeout,
 		Standby:               request.Standby,
 		DatumTries:            request.DatumTries,
+		RetryPolicy:           request.RetryPolicy,
 		SchedulingSpec:        request.SchedulingSpec,
 		PodSpec:               request.PodSpec,
 		PodPatch:              request.PodPatch,
 		S3Out:                 request.S3Out,
 		Metadata:              request.Metadata,
+		Template:              request.Template,
 	}
 	if err := setPipelineDefaults(pipelineInfo); err != nil {
 		return nil, err
 	}
+	return pipelineInfo, nil
+}
+
+// CreatePipelineInTransaction is identical to CreatePipeline except that it
+// can run inside an existing etcd STM transaction.  This is not an RPC.
+//
+// Implementation note:
+// - CreatePipeline always creates pipeline output branches such that the
+//   pipeline's spec branch is in the pipeline output branch's provenance
+// - CreatePipeline will always create a new output commit, but that's done
+//   by CreateBranch at the bottom of the function, which sets the new output
+//   branch provenance, rather than makePipelineInfoCommit higher up.
+// - This is because CreatePipeline calls hardStopPipeline towards the top,
+// 	 breakng the provenance connection from the spec branch to the output branch
+// - For straightforward pipeline updates (e.g. new pipeline image)
+//   stopping + updating + starting the pipeline isn't necessary
+// - However it is necessary in many slightly atypical cases  (e.g. the
+//   pipeline input changed: if the spec commit is created while the
+//   output branch has its old provenance, or the output branch gets new
+//   provenance while the old spec commit is the HEAD of the spec branch,
+//   then an output commit will be created with provenance that doesn't
+//   match its spec's PipelineInfo.Input. Another example is when
+//   request.Reprocess == true).
+// - Rather than try to enumerate every case where we can't create a spec
+//   commit without stopping the pipeline, we just always stop the pipeline
+// - Commits are only propagated when the transaction finishes, so none are
+//   created with a mismatched or missing spec commit while it runs
+func (a *apiServer) CreatePipelineInTransaction(txnCtx *txnenv.TransactionContext, request *pps.CreatePipelineRequest) error {
+	if err := a.validatePipelineRequest(request); err != nil {
+		return err
+	}
+	// Requests batched by a client don't pass through CreatePipeline, so they
+	// may not have a salt yet
+	if request.Salt == "" {
+		request.Salt = uuid.NewWithoutDashes()
+	}
+	pipelineInfo, err := newPipelineInfo(request)
+	if err != nil {
+		return err
+	}
 	// Validate final PipelineInfo (now that defaults have been populated)
-	if err := a.validatePipeline(pachClient, pipelineInfo); err != nil {
-		return nil, err
+	if err := a.validatePipeline(txnCtx, pipelineInfo); err != nil {
+		return err
 	}
 
 	var visitErr error
 	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if visitErr != nil {
+			return
+		}
 		if input.Cron != nil {
-			if _, err := pachClient.PfsAPIClient.CreateRepo(pachClient.Ctx(),
+			if err := txnCtx.Pfs().CreateRepoInTransaction(txnCtx,
 				&pfs.CreateRepoRequest{
 					Repo:        client.NewRepo(input.Cron.Repo),
 					Description: fmt.Sprintf("Cron tick repo for pipeline %s.", request.Pipeline.Name),
@@ -2194,7 +2716,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			}
 		}
 		if input.Git != nil {
-			if _, err := pachClient.PfsAPIClient.CreateRepo(pachClient.Ctx(),
+			if err := txnCtx.Pfs().CreateRepoInTransaction(txnCtx,
 				&pfs.CreateRepoRequest{
 					Repo:        client.NewRepo(input.Git.Name),
 					Description: fmt.Sprintf("Git input repo for pipeline %s.", request.Pipeline.Name),
@@ -2204,7 +2726,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}
 	})
 	if visitErr != nil {
-		return nil, visitErr
+		return visitErr
 	}
 
 	// Authorize pipeline creation
@@ -2212,16 +2734,25 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	if request.Update {
 		operation = pipelineOpUpdate
 	}
-	if err := a.authorizePipelineOp(pachClient, operation, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
+	if err := a.authorizePipelineOpInTransaction(txnCtx, operation, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
+	}
+	if pipelineInfo.Egress != nil {
+		if err := a.checkEgressPolicyInTransaction(txnCtx, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+			return err
+		}
 	}
 	pipelineName := pipelineInfo.Pipeline.Name
 	pps.SortInput(pipelineInfo.Input) // Makes datum hashes comparable
+	pipelines := a.pipelines.ReadWrite(txnCtx.Stm)
 	update := false
+	var pipelinePtr pps.EtcdPipelineInfo
 	if request.Update {
-		// inspect the pipeline to see if this is a real update
-		if _, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err == nil {
+		// check if the pipeline exists, to see if this is a real update
+		if err := pipelines.Get(pipelineName, &pipelinePtr); err == nil {
 			update = true
+		} else if !col.IsErrNotFound(err) {
+			return err
 		}
 	}
 	var (
@@ -2239,150 +2770,155 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 	// Get the expected number of workers for this pipeline
 	parallelism, err := getExpectedNumWorkers(a.env.GetKubeClient(), pipelineInfo)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	// getSpecCommit returns the request's spec commit if it has one (e.g. it's
+	// restoring an incremental extract), and otherwise makes a new one. The spec
+	// commit must be created before restoring output branch provenance, so that
+	// no commits are created with a mismatched spec commit.
+	getSpecCommit := func() (*pfs.Commit, error) {
+		if request.SpecCommit == nil {
+			return a.makePipelineInfoCommitInTransaction(txnCtx, pipelineInfo)
+		}
+		// Make sure that the spec commit actually exists
+		var commitInfo *pfs.CommitInfo
+		if err := a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+			var err error
+			commitInfo, err = superUserTxnCtx.Pfs().InspectCommitInTransaction(superUserTxnCtx, &pfs.InspectCommitRequest{
+				Commit: request.SpecCommit,
+			})
+			return err
+		}); err != nil {
+			return nil, errors.Wrap(err, "error inspecting spec commit")
+		}
+		return commitInfo.Commit, nil
 	}
 
 	if update {
 		// Help user fix inconsistency if previous UpdatePipeline call failed
-		if ci, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineName); err != nil {
-			return nil, err
-		} else if ci.Finished == nil {
-			return nil, errors.Errorf("the HEAD commit of this pipeline's spec branch " +
-				"is open. Either another CreatePipeline call is running or a previous " +
-				"call crashed. If you're sure no other CreatePipeline commands are " +
-				"running, you can run 'pachctl update pipeline --clean' which will " +
-				"delete this open commit")
+		if err := a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+			ci, err := superUserTxnCtx.Pfs().InspectCommitInTransaction(superUserTxnCtx, &pfs.InspectCommitRequest{
+				Commit: client.NewCommit(ppsconsts.SpecRepo, pipelineName),
+			})
+			if err != nil {
+				return err
+			} else if ci.Finished == nil {
+				return errors.Errorf("the HEAD commit of this pipeline's spec branch " +
+					"is open. Either another CreatePipeline call is running or a previous " +
+					"call crashed. If you're sure no other CreatePipeline commands are " +
+					"running, you can run 'pachctl update pipeline --clean' which will " +
+					"delete this open commit")
+			}
+			return nil
+		}); err != nil {
+			return err
 		}
 
 		// Remove provenance from existing output branch, so that creating a new
 		// spec commit doesn't create an output commit in the old output branch.
-		if err := a.hardStopPipeline(pachClient, pipelineInfo); err != nil {
-			return nil, err
+		if err := a.hardStopPipelineInTransaction(txnCtx, pipelineInfo); err != nil {
+			return err
 		}
 
 		// Look up existing pipelineInfo and update it, writing updated
-		// pipelineInfo back to PFS in a new commit. Do this inside an etcd
-		// transaction as PFS doesn't support transactions and this prevents
-		// concurrent UpdatePipeline calls from racing
-		var (
-			pipelinePtr     pps.EtcdPipelineInfo
-			oldPipelineInfo *pps.PipelineInfo
-		)
-		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-			// Read existing PipelineInfo from PFS output repo
-			return a.pipelines.ReadWrite(stm).Update(pipelineName, &pipelinePtr, func() error {
-				var err error
-
-				// We can't recover from an incomplete pipeline info here because
-				// modifying the spec repo depends on being able to access the previous
-				// commit. We therefore use `GetPipelineInfo` which will error if the
-				// spec commit isn't working.
-				oldPipelineInfo, err = ppsutil.GetPipelineInfo(pachClient, pipelineName, &pipelinePtr)
-				if err != nil {
-					return err
-				}
+		// pipelineInfo back to PFS in a new commit.
+		// We can't recover from an incomplete pipeline info here because
+		// modifying the spec repo depends on being able to access the previous
+		// commit. We therefore use `GetPipelineInfo` which will error if the
+		// spec commit isn't working.
+		oldPipelineInfo, err := ppsutil.GetPipelineInfo(txnCtx.Client, pipelineName, &pipelinePtr)
+		if err != nil {
+			return err
+		}
 
-				// Cannot disable stats after it has been enabled.
-				if oldPipelineInfo.EnableStats && !pipelineInfo.EnableStats {
-					return newErrPipelineUpdate(pipelineInfo.Pipeline.Name, "cannot disable stats")
-				}
+		// Cannot disable stats after it has been enabled.
+		if oldPipelineInfo.EnableStats && !pipelineInfo.EnableStats {
+			return newErrPipelineUpdate(pipelineInfo.Pipeline.Name, "cannot disable stats")
+		}
 
-				// Modify pipelineInfo (increment Version, and *preserve Stopped* so
-				// that updating a pipeline doesn't restart it)
-				pipelineInfo.Version = oldPipelineInfo.Version + 1
-				if oldPipelineInfo.Stopped {
-					provenance = nil // CreateBranch() below shouldn't create new output
-					pipelineInfo.Stopped = true
-				}
-				if !request.Reprocess {
-					pipelineInfo.Salt = oldPipelineInfo.Salt
-				}
-				// Must create spec commit before restoring output branch provenance, so
-				// that no commits are created with a mismatched spec commit
-				specCommit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
-				if err != nil {
-					return err
-				}
-				// Update pipelinePtr to point to new commit
-				pipelinePtr.SpecCommit = specCommit
-				// Reset pipeline state (PPS master/pipeline controller recreates RC)
-				pipelinePtr.State = pps.PipelineState_PIPELINE_STARTING
-				// Clear any failure reasons
-				pipelinePtr.Reason = ""
-				// Update pipeline parallelism
-				pipelinePtr.Parallelism = uint64(parallelism)
-				return nil
-			})
-		}); err != nil {
-			return nil, err
+		// Modify pipelineInfo (increment Version, and *preserve Stopped* so
+		// that updating a pipeline doesn't restart it)
+		pipelineInfo.Version = oldPipelineInfo.Version + 1
+		if oldPipelineInfo.Stopped {
+			provenance = nil // CreateBranch() below shouldn't create new output
+			pipelineInfo.Stopped = true
+		}
+		if !request.Reprocess {
+			pipelineInfo.Salt = oldPipelineInfo.Salt
+		}
+		specCommit, err := getSpecCommit()
+		if err != nil {
+			return err
+		}
+		// Update pipelinePtr to point to new commit
+		pipelinePtr.SpecCommit = specCommit
+		// Reset pipeline state (PPS master/pipeline controller recreates RC)
+		pipelinePtr.State = pps.PipelineState_PIPELINE_STARTING
+		// Clear any failure reasons
+		pipelinePtr.Reason = ""
+		// Update pipeline parallelism
+		pipelinePtr.Parallelism = uint64(parallelism)
+		if err := pipelines.Put(pipelineName, &pipelinePtr); err != nil {
+			return err
 		}
 
 		if !request.Reprocess {
 			// don't branch the output/stats/marker commit chain from the old pipeline (re-use old branch HEAD)
 			// However it's valid to set request.Update == true even if no pipeline exists, so only
 			// set outputBranchHead if there's an old pipeline to update
-			_, err := pfsClient.InspectBranch(ctx, &pfs.InspectBranchRequest{Branch: outputBranch})
-			if err != nil && !isNotFoundErr(err) {
-				return nil, err
-			} else if err == nil {
+			branchExists := func(branch *pfs.Branch) (bool, error) {
+				_, err := txnCtx.Pfs().InspectBranchInTransaction(txnCtx, &pfs.InspectBranchRequest{Branch: branch})
+				if err != nil && !isNotFoundErr(err) {
+					return false, err
+				}
+				return err == nil, nil
+			}
+			if exists, err := branchExists(outputBranch); err != nil {
+				return err
+			} else if exists {
 				outputBranchHead = client.NewCommit(pipelineName, pipelineInfo.OutputBranch)
 			}
-
-			_, err = pfsClient.InspectBranch(ctx, &pfs.InspectBranchRequest{Branch: statsBranch})
-			if err != nil && !isNotFoundErr(err) {
-				return nil, err
-			} else if err == nil {
+			if exists, err := branchExists(statsBranch); err != nil {
+				return err
+			} else if exists {
 				statsBranchHead = client.NewCommit(pipelineName, "stats")
 			}
-
-			_, err = pfsClient.InspectBranch(ctx, &pfs.InspectBranchRequest{Branch: markerBranch})
-			if err != nil && !isNotFoundErr(err) {
-				return nil, err
-			} else if err == nil {
+			if exists, err := branchExists(markerBranch); err != nil {
+				return err
+			} else if exists {
 				markerBranchHead = client.NewCommit(pipelineName, ppsconsts.SpoutMarkerBranch)
 			}
 		}
 
 		if pipelinePtr.AuthToken != "" {
-			if err := a.fixPipelineInputRepoACLs(pachClient, pipelineInfo, oldPipelineInfo); err != nil {
-				return nil, err
+			if err := a.fixPipelineInputRepoACLsInTransaction(txnCtx, pipelineInfo, oldPipelineInfo); err != nil {
+				return err
 			}
 		}
 	} else {
 		// Create output repo, pipeline output, and stats
-		if _, err := pachClient.PfsAPIClient.CreateRepo(pachClient.Ctx(),
+		if err := txnCtx.Pfs().CreateRepoInTransaction(txnCtx,
 			&pfs.CreateRepoRequest{
 				Repo:        client.NewRepo(pipelineName),
 				Description: fmt.Sprintf("Output repo for pipeline %s.", request.Pipeline.Name),
 			}); err != nil && !isAlreadyExistsErr(err) {
-			return nil, err
+			return err
 		}
 
-		// Must create spec commit before restoring output branch provenance, so
-		// that no commits are created with a missing spec commit
-		var commit *pfs.Commit
+		commit, err := getSpecCommit()
+		if err != nil {
+			return err
+		}
 		if request.SpecCommit != nil {
-			// Make sure that the spec commit actually exists
-			commitInfo, err := pachClient.PfsAPIClient.InspectCommit(pachClient.Ctx(), &pfs.InspectCommitRequest{Commit: request.SpecCommit})
-			if err != nil {
-				return nil, errors.Wrap(err, "error inspecting spec commit")
-			}
-			// It does, so we use that as the spec commit, rather than making a new one
-			commit = commitInfo.Commit
 			// We also use the existing head for the branches, rather than making a new one.
 			outputBranchHead = client.NewCommit(pipelineName, pipelineInfo.OutputBranch)
 			statsBranchHead = client.NewCommit(pipelineName, "stats")
-		} else {
-			var err error
-			commit, err = a.makePipelineInfoCommit(pachClient, pipelineInfo)
-			if err != nil {
-				return nil, err
-			}
 		}
 
 		// pipelinePtr will be written to etcd, pointing at 'commit'. May include an
 		// auth token
-		pipelinePtr := &pps.EtcdPipelineInfo{
+		pipelinePtr = pps.EtcdPipelineInfo{
 			SpecCommit:  commit,
 			State:       pps.PipelineState_PIPELINE_STARTING,
 			Parallelism: uint64(parallelism),
@@ -2390,8 +2926,8 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 
 		// Generate pipeline's auth token & add pipeline to the ACLs of input/output
 		// repos
-		if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
-			tokenResp, err := superUserClient.GetAuthToken(superUserClient.Ctx(), &auth.GetAuthTokenRequest{
+		if err := a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+			tokenResp, err := superUserTxnCtx.Auth().GetAuthTokenInTransaction(superUserTxnCtx, &auth.GetAuthTokenRequest{
 				Subject: auth.PipelinePrefix + request.Pipeline.Name,
 			})
 			if err != nil {
@@ -2403,27 +2939,20 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			pipelinePtr.AuthToken = tokenResp.Token
 			return nil
 		}); err != nil {
-			return nil, err
+			return err
 		}
 
-		// Put a pointer to the new PipelineInfo commit into etcd
-		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-			err := a.pipelines.ReadWrite(stm).Create(pipelineName, pipelinePtr)
+		// Put a pointer to the new PipelineInfo commit into etcd. If this fails,
+		// the transaction (including the spec commit) is discarded.
+		if err := pipelines.Create(pipelineName, &pipelinePtr); err != nil {
 			if isAlreadyExistsErr(err) {
-				if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
-					return superUserClient.DeleteCommit(ppsconsts.SpecRepo, commit.ID)
-				}); err != nil {
-					return errors.Wrapf(grpcutil.ScrubGRPC(err), "couldn't clean up orphaned spec commit")
-				}
 				return newErrPipelineExists(pipelineName)
 			}
 			return err
-		}); err != nil {
-			return nil, err
 		}
 		if pipelinePtr.AuthToken != "" {
-			if err := a.fixPipelineInputRepoACLs(pachClient, pipelineInfo, nil); err != nil {
-				return nil, err
+			if err := a.fixPipelineInputRepoACLsInTransaction(txnCtx, pipelineInfo, nil); err != nil {
+				return err
 			}
 		}
 	}
@@ -2435,37 +2964,46 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 
 	// Create/update output branch (creating new output commit for the pipeline
 	// and restarting the pipeline)
-	if _, err := pfsClient.CreateBranch(ctx, &pfs.CreateBranchRequest{
+	if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
 		Branch:     outputBranch,
 		Provenance: provenance,
 		Head:       outputBranchHead,
 	}); err != nil {
-		return nil, errors.Wrapf(err, "could not create/update output branch")
+		return errors.Wrapf(err, "could not create/update output branch")
 	}
 	if pipelineInfo.EnableStats {
-		if _, err := pfsClient.CreateBranch(ctx, &pfs.CreateBranchRequest{
-			Branch:     client.NewBranch(pipelineName, "stats"),
+		if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+			Branch:     statsBranch,
 			Provenance: []*pfs.Branch{outputBranch},
 			Head:       statsBranchHead,
 		}); err != nil {
-			return nil, errors.Wrapf(err, "could not create/update stats branch")
+			return errors.Wrapf(err, "could not create/update stats branch")
 		}
 	}
 	if pipelineInfo.Spout != nil && pipelineInfo.Spout.Marker != "" {
-		if _, err := pfsClient.CreateBranch(ctx, &pfs.CreateBranchRequest{
+		if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
 			Branch: markerBranch,
 			Head:   markerBranchHead,
 		}); err != nil {
-			return nil, errors.Wrapf(err, "could not create/update marker branch")
+			return errors.Wrapf(err, "could not create/update marker branch")
 		}
 	}
-
-	return &types.Empty{}, nil
+	return nil
 }
 
 // setPipelineDefaults sets the default values for a pipeline info
 func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) error {
 	now := time.Now()
+	if spout := pipelineInfo.Spout; spout != nil && (spout.Kafka != nil || spout.Ingest != nil) {
+		// Built-in spout sources don't run user code, so they don't need a
+		// transform, but they always checkpoint in a marker.
+		if pipelineInfo.Transform == nil {
+			pipelineInfo.Transform = &pps.Transform{}
+		}
+		if spout.Marker == "" {
+			spout.Marker = "checkpoint"
+		}
+	}
 	if pipelineInfo.Transform.Image == "" {
 		pipelineInfo.Transform.Image = DefaultUserImage
 	}
@@ -2701,263 +3239,342 @@ func (a *apiServer) DeletePipeline(ctx context.Context, request *pps.DeletePipel
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.env.GetPachClient(ctx)
-	ctx, err := checkLoggedIn(pachClient)
-	if err != nil {
+	if _, err := checkLoggedIn(pachClient); err != nil {
 		return nil, err
 	}
+	if !request.All && request.Pipeline == nil {
+		return nil, errors.New("request.Pipeline cannot be nil")
+	}
 
-	// Possibly list pipelines in etcd (skip PFS read--don't need it) and delete them
+	// List the pipelines being deleted, so that their workers can be deleted
+	// once the pipelines are (if there's no active transaction)
+	var pipelines []string
 	if request.All {
-		request.Pipeline = &pps.Pipeline{}
 		pipelinePtr := &pps.EtcdPipelineInfo{}
 		if err := a.pipelines.ReadOnly(ctx).List(pipelinePtr, col.DefaultOptions, func(pipelineName string) error {
-			request.Pipeline.Name = pipelineName
-			_, err := a.deletePipeline(pachClient, request)
-			return err
+			pipelines = append(pipelines, pipelineName)
+			return nil
 		}); err != nil {
 			return nil, err
 		}
-		return &types.Empty{}, nil
+	} else {
+		pipelines = append(pipelines, request.Pipeline.Name)
+	}
+
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.DeletePipeline(request)
+	}); err != nil {
+		return nil, err
 	}
 
-	// Otherwise delete single pipeline from request
-	return a.deletePipeline(pachClient, request)
+	// Delete the pipelines' workers. Pipelines deleted in a transaction have their
+	// workers deleted by the PPS master once the transaction is finished.
+	if activeTxn, err := client.GetTransaction(ctx); err != nil {
+		return nil, err
+	} else if activeTxn == nil {
+		for _, pipeline := range pipelines {
+			if err := a.deletePipelineResources(pachClient.Ctx(), pipeline); err != nil {
+				return nil, errors.Wrapf(err, "error deleting workers")
+			}
+		}
+	}
+	return &types.Empty{}, nil
 }
 
-// cleanUpSpecBranch handles the corner case where a spec branch was created for
-// a new pipeline, but the etcdPipelineInfo was never created successfully (and
-// the pipeline is in an inconsistent state). It's called if a pipeline's
-// etcdPipelineInfo wasn't found, checks if an orphaned branch exists, and if
-// so, deletes the orphaned branch.
-func (a *apiServer) cleanUpSpecBranch(pachClient *client.APIClient, pipeline string) error {
-	specBranchInfo, err := pachClient.InspectBranch(ppsconsts.SpecRepo, pipeline)
-	if err != nil && (specBranchInfo != nil && specBranchInfo.Head != nil) {
-		// No spec branch (and no etcd pointer) => the pipeline doesn't exist
-		return errors.Wrapf(err, "pipeline %v was not found", pipeline)
+// DeletePipelineInTransaction is identical to DeletePipeline except that it
+// can run inside an existing etcd STM transaction, and it leaves deleting the
+// pipeline's workers to the PPS master.  This is not an RPC.
+func (a *apiServer) DeletePipelineInTransaction(txnCtx *txnenv.TransactionContext, request *pps.DeletePipelineRequest) error {
+	if !request.All {
+		if request.Pipeline == nil {
+			return errors.New("request.Pipeline cannot be nil")
+		}
+		return a.deletePipelineInTransaction(txnCtx, request.Pipeline.Name, request)
 	}
-	// branch exists but head is nil => pipeline creation never finished/
-	// pps state is invalid. Delete nil branch
-	return grpcutil.ScrubGRPC(a.sudo(pachClient, func(superUserClient *client.APIClient) error {
-		return superUserClient.DeleteBranch(ppsconsts.SpecRepo, pipeline, true)
-	}))
+
+	// List pipelines in etcd (skip PFS read--don't need it) and delete them.
+	// Pipelines created earlier in the transaction aren't listed.
+	var pipelines []string
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(txnCtx.ClientContext).List(pipelinePtr, col.DefaultOptions, func(pipelineName string) error {
+		pipelines = append(pipelines, pipelineName)
+		return nil
+	}); err != nil {
+		return err
+	}
+	for _, pipeline := range pipelines {
+		if err := a.deletePipelineInTransaction(txnCtx, pipeline, request); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.DeletePipelineRequest) (response *types.Empty, retErr error) {
-	ctx := pachClient.Ctx() // pachClient will propagate auth info
+// cleanUpSpecBranchInTransaction handles the corner case where a spec branch
+// was created for a new pipeline, but the etcdPipelineInfo was never created
+// successfully (and the pipeline is in an inconsistent state). It's called if
+// a pipeline's etcdPipelineInfo wasn't found, and deletes the orphaned branch
+// if it exists.
+func (a *apiServer) cleanUpSpecBranchInTransaction(txnCtx *txnenv.TransactionContext, pipeline string) error {
+	return grpcutil.ScrubGRPC(a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+		return superUserTxnCtx.Pfs().DeleteBranchInTransaction(superUserTxnCtx, &pfs.DeleteBranchRequest{
+			Branch: client.NewBranch(ppsconsts.SpecRepo, pipeline),
+			Force:  true,
+		})
+	}))
+}
 
+func (a *apiServer) deletePipelineInTransaction(txnCtx *txnenv.TransactionContext, pipelineName string, request *pps.DeletePipelineRequest) error {
 	// Check if there's an EtcdPipelineInfo for this pipeline. If not, we can't
 	// authorize, and must return something here
+	pipelines := a.pipelines.ReadWrite(txnCtx.Stm)
 	pipelinePtr := pps.EtcdPipelineInfo{}
-	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, &pipelinePtr); err != nil {
+	if err := pipelines.Get(pipelineName, &pipelinePtr); err != nil {
 		if col.IsErrNotFound(err) {
-			if err := a.cleanUpSpecBranch(pachClient, request.Pipeline.Name); err != nil {
-				return nil, err
-			}
-			return &types.Empty{}, nil
-
+			return a.cleanUpSpecBranchInTransaction(txnCtx, pipelineName)
 		}
-		return nil, err
+		return err
 	}
 
-	// Get current pipeline info from:
-	// - etcdPipelineInfo
-	// - spec commit in etcdPipelineInfo (which may not be the HEAD of the
-	//   pipeline's spec branch_
-	// - kubernetes services (for service pipelines, githook pipelines, etc)
-	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	// Get current pipeline info from the spec commit in etcdPipelineInfo (which
+	// may not be the HEAD of the pipeline's spec branch)
+	pipelineInfo, err := ppsutil.GetPipelineInfo(txnCtx.Client, pipelineName, &pipelinePtr)
 	if err != nil {
 		logrus.Errorf("error inspecting pipeline: %v", err)
-		pipelineInfo = &pps.PipelineInfo{Pipeline: request.Pipeline, OutputBranch: "master"}
+		pipelineInfo = &pps.PipelineInfo{Pipeline: client.NewPipeline(pipelineName), OutputBranch: "master"}
 	}
 
 	// check if the output repo exists--if not, the pipeline is non-functional and
 	// the rest of the delete operation continues without any auth checks
-	if _, err := pachClient.InspectRepo(request.Pipeline.Name); err != nil && !isNotFoundErr(err) {
-		return nil, err
+	if _, err := txnCtx.Pfs().InspectRepoInTransaction(txnCtx, &pfs.InspectRepoRequest{
+		Repo: client.NewRepo(pipelineName),
+	}); err != nil && !isNotFoundErr(err) {
+		return err
 	} else if !isNotFoundErr(err) {
 		// Check if the caller is authorized to delete this pipeline. This must be
 		// done after cleaning up the spec branch HEAD commit, because the
 		// authorization condition depends on the pipeline's PipelineInfo
-		if err := a.authorizePipelineOp(pachClient, pipelineOpDelete, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-			return nil, err
+		if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpDelete, pipelineInfo.Input, pipelineName); err != nil {
+			return err
 		}
 		if request.KeepRepo {
 			// Remove branch provenance (pass branch twice so that it continues to point
 			// at the same commit, but also pass empty provenance slice)
-			if err := pachClient.CreateBranch(
-				request.Pipeline.Name,
-				pipelineInfo.OutputBranch,
-				pipelineInfo.OutputBranch,
-				nil,
-			); err != nil {
-				return nil, err
+			if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+				Branch: client.NewBranch(pipelineName, pipelineInfo.OutputBranch),
+				Head:   client.NewCommit(pipelineName, pipelineInfo.OutputBranch),
+			}); err != nil {
+				return err
 			}
 		} else {
 			// delete the pipeline's output repo
-			if err := pachClient.DeleteRepo(request.Pipeline.Name, request.Force); err != nil {
-				return nil, err
+			if err := txnCtx.Pfs().DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+				Repo:  client.NewRepo(pipelineName),
+				Force: request.Force,
+			}); err != nil {
+				return err
 			}
 		}
 	}
 
-	// Delete pipeline's workers
-	if err := a.deletePipelineResources(ctx, request.Pipeline.Name); err != nil {
-		return nil, errors.Wrapf(err, "error deleting workers")
-	}
-
 	// If necessary, revoke the pipeline's auth token and remove it from its
 	// inputs' ACLs
 	if pipelinePtr.AuthToken != "" {
 		// If auth was deactivated after the pipeline was created, don't bother
 		// revoking
-		if _, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); err == nil {
-			if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
-				// 'pipelineInfo' == nil => remove pipeline from all input repos
-				if err := a.fixPipelineInputRepoACLs(superUserClient, nil, pipelineInfo); err != nil {
+		if _, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{}); err == nil {
+			// 'pipelineInfo' == nil => remove pipeline from all input repos
+			if err := a.fixPipelineInputRepoACLsInTransaction(txnCtx, nil, pipelineInfo); err != nil {
+				return errors.Wrapf(err, "error revoking old auth token")
+			}
+			if err := a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+				// Delete the pipeline's own ACL, if it has one
+				if _, err := superUserTxnCtx.Auth().SetACLInTransaction(superUserTxnCtx, &auth.SetACLRequest{
+					Pipeline: pipelineName,
+				}); err != nil {
 					return grpcutil.ScrubGRPC(err)
 				}
-				_, err := superUserClient.RevokeAuthToken(superUserClient.Ctx(),
-					&auth.RevokeAuthTokenRequest{
-						Token: pipelinePtr.AuthToken,
-					})
+				_, err := superUserTxnCtx.Auth().RevokeAuthTokenInTransaction(superUserTxnCtx, &auth.RevokeAuthTokenRequest{
+					Token: pipelinePtr.AuthToken,
+				})
 				return grpcutil.ScrubGRPC(err)
 			}); err != nil {
-				return nil, errors.Wrapf(err, "error revoking old auth token")
+				return errors.Wrapf(err, "error revoking old auth token")
 			}
 		}
 	}
 
-	// Kill or delete all of the pipeline's jobs
-	var eg errgroup.Group
+	// Kill and delete all of the pipeline's jobs
+	var jobIDs []string
 	jobPtr := &pps.EtcdJobInfo{}
-	if err := a.jobs.ReadOnly(ctx).GetByIndex(ppsdb.JobsPipelineIndex, request.Pipeline, jobPtr, col.DefaultOptions, func(jobID string) error {
-		eg.Go(func() error {
-			_, err := a.DeleteJob(ctx, &pps.DeleteJobRequest{Job: client.NewJob(jobID)})
-			if isNotFoundErr(err) {
-				return nil
-			}
-			return err
-		})
+	if err := a.jobs.ReadOnly(txnCtx.ClientContext).GetByIndex(ppsdb.JobsPipelineIndex, client.NewPipeline(pipelineName), jobPtr, col.DefaultOptions, func(jobID string) error {
+		jobIDs = append(jobIDs, jobID)
 		return nil
 	}); err != nil {
-		return nil, err
+		return err
 	}
-	if err := eg.Wait(); err != nil {
-		return nil, err
+	jobs := a.jobs.ReadWrite(txnCtx.Stm)
+	for _, jobID := range jobIDs {
+		if err := jobs.Get(jobID, jobPtr); err != nil {
+			if col.IsErrNotFound(err) {
+				continue
+			}
+			return err
+		}
+		// Finish the job's output commit without a tree -- worker/master will mark
+		// the job 'killed'
+		if err := txnCtx.Pfs().FinishCommitInTransaction(txnCtx, &pfs.FinishCommitRequest{
+			Commit: jobPtr.OutputCommit,
+			Empty:  true,
+		}); err != nil {
+			if !(pfsServer.IsCommitFinishedErr(err) || pfsServer.IsCommitNotFoundErr(err) || pfsServer.IsCommitDeletedErr(err) || isNotFoundErr(err)) {
+				return err
+			}
+		}
+		if err := jobs.Delete(jobID); err != nil {
+			return err
+		}
 	}
 
-	eg = errgroup.Group{}
 	// Delete pipeline branch in SpecRepo (leave commits, to preserve downstream
 	// commits)
-	eg.Go(func() error {
-		return a.sudo(pachClient, func(superUserClient *client.APIClient) error {
-			return grpcutil.ScrubGRPC(superUserClient.DeleteBranch(ppsconsts.SpecRepo, request.Pipeline.Name, request.Force))
-		})
-	})
+	if err := a.sudoTransaction(txnCtx, func(superUserTxnCtx *txnenv.TransactionContext) error {
+		return grpcutil.ScrubGRPC(superUserTxnCtx.Pfs().DeleteBranchInTransaction(superUserTxnCtx, &pfs.DeleteBranchRequest{
+			Branch: client.NewBranch(ppsconsts.SpecRepo, pipelineName),
+			Force:  request.Force,
+		}))
+	}); err != nil {
+		return err
+	}
 	// Delete EtcdPipelineInfo
-	eg.Go(func() error {
-		if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
-			return a.pipelines.ReadWrite(stm).Delete(request.Pipeline.Name)
-		}); err != nil {
-			return errors.Wrapf(err, "collection.Delete")
-		}
-		return nil
-	})
+	if err := pipelines.Delete(pipelineName); err != nil {
+		return errors.Wrapf(err, "collection.Delete")
+	}
 	// Delete cron input repos
 	if !request.KeepRepo {
+		var visitErr error
 		pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
-			if input.Cron != nil {
-				eg.Go(func() error {
-					return pachClient.DeleteRepo(input.Cron.Repo, request.Force)
+			if input.Cron != nil && visitErr == nil {
+				visitErr = txnCtx.Pfs().DeleteRepoInTransaction(txnCtx, &pfs.DeleteRepoRequest{
+					Repo:  client.NewRepo(input.Cron.Repo),
+					Force: request.Force,
 				})
 			}
 		})
+		if visitErr != nil {
+			return visitErr
+		}
 	}
-	if err := eg.Wait(); err != nil {
+	return nil
+}
+
+// inspectPipelineInTransaction is a helper for the pipeline operations that
+// run in a transaction. Unlike inspectPipeline, it returns the pipeline's
+// EtcdPipelineInfo as read in the STM rather than k8s and worker details.
+// Note that the PipelineInfo is read from the pipeline's spec commit outside of
+// the transaction, so the spec commit must not have been made earlier in the
+// same transaction.
+func (a *apiServer) inspectPipelineInTransaction(txnCtx *txnenv.TransactionContext, name string) (*pps.PipelineInfo, error) {
+	pipelinePtr := pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadWrite(txnCtx.Stm).Get(name, &pipelinePtr); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, errors.Errorf("pipeline \"%s\" not found", name)
+		}
 		return nil, err
 	}
-	return &types.Empty{}, nil
+	return ppsutil.GetPipelineInfo(txnCtx.Client, name, &pipelinePtr)
 }
 
 // StartPipeline implements the protobuf pps.StartPipeline RPC
 func (a *apiServer) StartPipeline(ctx context.Context, request *pps.StartPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	pachClient := a.env.GetPachClient(ctx)
 
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.StartPipeline(request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// StartPipelineInTransaction is identical to StartPipeline except that it can
+// run inside an existing etcd STM transaction.  This is not an RPC.
+func (a *apiServer) StartPipelineInTransaction(txnCtx *txnenv.TransactionContext, request *pps.StartPipelineRequest) error {
 	// Get request.Pipeline's info
-	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	pipelineInfo, err := a.inspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// check if the caller is authorized to update this pipeline
-	if err := a.authorizePipelineOp(pachClient, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
 	}
 
 	// Remove 'Stopped' from the pipeline spec
 	pipelineInfo.Stopped = false
-	commit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
+	commit, err := a.makePipelineInfoCommitInTransaction(txnCtx, pipelineInfo)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit); err != nil {
-		return nil, err
+	if err := a.updatePipelineSpecCommitInTransaction(txnCtx, request.Pipeline.Name, commit); err != nil {
+		return err
 	}
 
 	// Replace missing branch provenance (removed by StopPipeline)
 	provenance := append(branchProvenance(pipelineInfo.Input),
 		client.NewBranch(ppsconsts.SpecRepo, pipelineInfo.Pipeline.Name))
-	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
-		pipelineInfo.OutputBranch,
-		pipelineInfo.OutputBranch,
-		provenance,
-	); err != nil {
-		return nil, err
-	}
-	return &types.Empty{}, nil
+	return txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch:     client.NewBranch(request.Pipeline.Name, pipelineInfo.OutputBranch),
+		Head:       client.NewCommit(request.Pipeline.Name, pipelineInfo.OutputBranch),
+		Provenance: provenance,
+	})
 }
 
 // StopPipeline implements the protobuf pps.StopPipeline RPC
 func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	pachClient := a.env.GetPachClient(ctx)
 
+	if err := a.txnEnv.WithTransaction(ctx, func(txn txnenv.Transaction) error {
+		return txn.StopPipeline(request)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// StopPipelineInTransaction is identical to StopPipeline except that it can
+// run inside an existing etcd STM transaction.  This is not an RPC.
+func (a *apiServer) StopPipelineInTransaction(txnCtx *txnenv.TransactionContext, request *pps.StopPipelineRequest) error {
 	// Get request.Pipeline's info
-	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	pipelineInfo, err := a.inspectPipelineInTransaction(txnCtx, request.Pipeline.Name)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// check if the caller is authorized to update this pipeline
-	if err := a.authorizePipelineOp(pachClient, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
-		return nil, err
+	if err := a.authorizePipelineOpInTransaction(txnCtx, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return err
 	}
 
 	// Remove branch provenance (pass branch twice so that it continues to point
 	// at the same commit, but also pass empty provenance slice)
-	if err := pachClient.CreateBranch(
-		request.Pipeline.Name,
-		pipelineInfo.OutputBranch,
-		pipelineInfo.OutputBranch,
-		nil,
-	); err != nil {
-		return nil, err
+	if err := txnCtx.Pfs().CreateBranchInTransaction(txnCtx, &pfs.CreateBranchRequest{
+		Branch: client.NewBranch(request.Pipeline.Name, pipelineInfo.OutputBranch),
+		Head:   client.NewCommit(request.Pipeline.Name, pipelineInfo.OutputBranch),
+	}); err != nil {
+		return err
 	}
 
 	// Update PipelineInfo with new state
 	pipelineInfo.Stopped = true
-	commit, err := a.makePipelineInfoCommit(pachClient, pipelineInfo)
+	commit, err := a.makePipelineInfoCommitInTransaction(txnCtx, pipelineInfo)
 	if err != nil {
-		return nil, err
-	}
-	if a.updatePipelineSpecCommit(pachClient, request.Pipeline.Name, commit); err != nil {
-		return nil, err
+		return err
 	}
-	return &types.Empty{}, nil
+	return a.updatePipelineSpecCommitInTransaction(txnCtx, request.Pipeline.Name, commit)
 }
 
 func (a *apiServer) RunPipeline(ctx context.Context, request *pps.RunPipelineRequest) (response *types.Empty, retErr error) {
@@ -3694,20 +4311,17 @@ func isNotFoundErr(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "not found")
 }
 
-func (a *apiServer) updatePipelineSpecCommit(pachClient *client.APIClient, pipelineName string, commit *pfs.Commit) error {
-	_, err := col.NewSTM(pachClient.Ctx(), a.env.GetEtcdClient(), func(stm col.STM) error {
-		pipelines := a.pipelines.ReadWrite(stm)
-		pipelinePtr := &pps.EtcdPipelineInfo{}
-		if err := pipelines.Get(pipelineName, pipelinePtr); err != nil {
-			return err
+func (a *apiServer) updatePipelineSpecCommitInTransaction(txnCtx *txnenv.TransactionContext, pipelineName string, commit *pfs.Commit) error {
+	pipelines := a.pipelines.ReadWrite(txnCtx.Stm)
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := pipelines.Get(pipelineName, pipelinePtr); err != nil {
+		if isNotFoundErr(err) {
+			return newErrPipelineNotFound(pipelineName)
 		}
-		pipelinePtr.SpecCommit = commit
-		return pipelines.Put(pipelineName, pipelinePtr)
-	})
-	if isNotFoundErr(err) {
-		return newErrPipelineNotFound(pipelineName)
+		return err
 	}
-	return err
+	pipelinePtr.SpecCommit = commit
+	return pipelines.Put(pipelineName, pipelinePtr)
 }
 
 // RepoNameToEnvString is a helper which uppercases a repo name for
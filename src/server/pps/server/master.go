@@ -107,6 +107,13 @@ func (a *apiServer) master() {
 					if err := a.step(pachClient, pipeline, event.Ver, event.Rev); err != nil {
 						log.Errorf("PPS master: %v", err)
 					}
+				case watch.EventDelete:
+					// Pipelines deleted in a transaction leave their workers
+					// behind, so clean them up here (this is a no-op if
+					// DeletePipeline already removed them)
+					if err := a.deletePipelineResources(pachClient.Ctx(), string(event.Key)); err != nil {
+						log.Errorf("PPS master: %v", err)
+					}
 				}
 			case event := <-watchChan:
 				// if we get an error we restart the watch, k8s watches seem to
@@ -0,0 +1,139 @@
+package server
+
+import (
+	"path"
+	"strings"
+
+	vault "github.com/hashicorp/vault/api"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
+)
+
+// vaultCredsPath splits 'vaultPath' into the mount path of a secrets engine
+// and the name of a role, if it has the form <mount>/creds/<role>, which is
+// where the Pachyderm Vault plugin issues tokens
+func vaultCredsPath(vaultPath string) (mount, role string, ok bool) {
+	vaultPath = strings.Trim(vaultPath, "/")
+	i := strings.LastIndex(vaultPath, "/creds/")
+	if i <= 0 {
+		return "", "", false
+	}
+	mount, role = vaultPath[:i], vaultPath[i+len("/creds/"):]
+	if role == "" || strings.Contains(role, "/") {
+		return "", "", false
+	}
+	return mount, role, true
+}
+
+// vaultPluginRole is a role of the Pachyderm Vault plugin, as returned by
+// reading <mount>/roles/<role>
+type vaultPluginRole struct {
+	subject  string
+	repos    []string
+	readOnly bool
+}
+
+// readVaultPluginRole reads the Pachyderm Vault plugin role that
+// 'vaultPath' issues tokens for. It returns nil if 'vaultPath' isn't a
+// <mount>/creds/<role> path of the plugin. pachd reads roles with the token
+// in its VAULT_TOKEN env var.
+func (a *apiServer) readVaultPluginRole(vaultPath string) (*vaultPluginRole, error) {
+	mount, name, ok := vaultCredsPath(vaultPath)
+	if !ok {
+		return nil, nil
+	}
+	vaultClient, err := vault.NewClient(&vault.Config{
+		Address: a.env.VaultAddress,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating vault client")
+	}
+	if vaultClient.Token() == "" {
+		return nil, errors.Errorf("cannot check vault secret %q: pachd has no "+
+			"vault token (VAULT_TOKEN)", vaultPath)
+	}
+	secret, err := vaultClient.Logical().Read(path.Join(mount, "roles", name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read vault role for %q", vaultPath)
+	}
+	if secret == nil {
+		return nil, nil
+	}
+	// Other secrets engines (e.g. the database engine) have creds/<role>
+	// paths too, but only the Pachyderm plugin's roles have a subject
+	subject, ok := secret.Data["subject"].(string)
+	if !ok {
+		return nil, nil
+	}
+	role := &vaultPluginRole{subject: subject}
+	role.readOnly, _ = secret.Data["read_only"].(bool)
+	repos, _ := secret.Data["repos"].([]interface{})
+	for _, repo := range repos {
+		if repo, ok := repo.(string); ok && repo != "" {
+			role.repos = append(role.repos, repo)
+		}
+	}
+	return role, nil
+}
+
+// authorizeVaultSecretsInTransaction checks that the caller may give the
+// pipeline in 'pipelineInfo' its Vault-backed secrets. Cluster admins may use
+// any Vault path. Other users may only use the Pachyderm Vault plugin's
+// creds/<role> paths, and only for roles whose tokens are restricted to repos
+// that the caller can access themselves (as a reader, for read-only roles, or
+// as a writer).
+func (a *apiServer) authorizeVaultSecretsInTransaction(txnCtx *txnenv.TransactionContext, pipelineInfo *pps.PipelineInfo) error {
+	var vaultPaths []string
+	for _, secret := range pipelineInfo.Transform.Secrets {
+		if secret.VaultPath != "" {
+			vaultPaths = append(vaultPaths, secret.VaultPath)
+		}
+	}
+	if len(vaultPaths) == 0 {
+		return nil
+	}
+	me, err := txnCtx.Client.WhoAmI(txnCtx.ClientContext, &auth.WhoAmIRequest{})
+	if auth.IsErrNotActivated(err) {
+		return nil // Auth isn't activated, skip authorization completely
+	} else if err != nil {
+		return err
+	}
+	if me.IsAdmin {
+		return nil
+	}
+	for _, vaultPath := range vaultPaths {
+		role, err := a.readVaultPluginRole(vaultPath)
+		if err != nil {
+			return err
+		}
+		if role == nil || len(role.repos) == 0 {
+			return errors.Errorf("only cluster admins may create pipelines that "+
+				"read vault secret %q (other users may only use Pachyderm plugin "+
+				"roles that are restricted to repos)", vaultPath)
+		}
+		required := auth.Scope_WRITER
+		if role.readOnly {
+			required = auth.Scope_READER
+		}
+		for _, repo := range role.repos {
+			resp, err := txnCtx.Auth().AuthorizeInTransaction(txnCtx, &auth.AuthorizeRequest{
+				Repo:  repo,
+				Scope: required,
+			})
+			if err != nil {
+				return err
+			}
+			if !resp.Authorized {
+				return errors.Wrapf(&auth.ErrNotAuthorized{
+					Subject:  me.Username,
+					Repo:     repo,
+					Required: required,
+				}, "cannot use vault secret %q", vaultPath)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+)
+
+func TestVaultCredsPath(t *testing.T) {
+	mount, role, ok := vaultCredsPath("pachyderm/creds/edges")
+	require.True(t, ok)
+	require.Equal(t, "pachyderm", mount)
+	require.Equal(t, "edges", role)
+	mount, role, ok = vaultCredsPath("/auth/pachyderm/creds/edges/")
+	require.True(t, ok)
+	require.Equal(t, "auth/pachyderm", mount)
+	require.Equal(t, "edges", role)
+	for _, p := range []string{"secret/data/db", "creds/edges", "pachyderm/creds/", "pachyderm/creds/a/b"} {
+		_, _, ok := vaultCredsPath(p)
+		require.False(t, ok, p)
+	}
+}
+
+func TestReadVaultPluginRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "pachd-token", r.Header.Get("X-Vault-Token"))
+		var data map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/pachyderm/roles/edges":
+			data = map[string]interface{}{
+				"subject":   "robot:edges",
+				"repos":     []string{"images", "edges"},
+				"read_only": true,
+			}
+		case "/v1/database/roles/readonly":
+			data = map[string]interface{}{"db_name": "postgres"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": data}))
+	}))
+	defer server.Close()
+	defer os.Setenv("VAULT_TOKEN", os.Getenv("VAULT_TOKEN"))
+	os.Setenv("VAULT_TOKEN", "pachd-token")
+
+	a := &apiServer{env: &serviceenv.ServiceEnv{Configuration: &serviceenv.Configuration{
+		PachdSpecificConfiguration: &serviceenv.PachdSpecificConfiguration{VaultAddress: server.URL},
+	}}}
+	role, err := a.readVaultPluginRole("pachyderm/creds/edges")
+	require.NoError(t, err)
+	require.Equal(t, &vaultPluginRole{subject: "robot:edges", repos: []string{"images", "edges"}, readOnly: true}, role)
+
+	// Paths that aren't the plugin's creds paths have no plugin role
+	for _, p := range []string{"secret/data/db", "database/creds/readonly", "pachyderm/creds/missing"} {
+		role, err := a.readVaultPluginRole(p)
+		require.NoError(t, err)
+		require.Nil(t, role)
+	}
+
+	// pachd can't check roles without a vault token
+	os.Setenv("VAULT_TOKEN", "")
+	_, err = a.readVaultPluginRole("pachyderm/creds/edges")
+	require.YesError(t, err)
+}
@@ -191,6 +191,36 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 		workerEnv = append(workerEnv, v1.EnvVar{Name: "LOKI_LOGGING", Value: "true"})
 	}
+	// Propagate the continuous profiling configuration to worker and sidecar
+	for _, v := range []v1.EnvVar{
+		{Name: "PROFILING_INTERVAL", Value: a.env.ProfilingInterval},
+		{Name: "PROFILING_CPU_DURATION", Value: a.env.ProfilingCPUDuration},
+		{Name: "PROFILING_RETENTION", Value: a.env.ProfilingRetention},
+	} {
+		if v.Value != "" {
+			sidecarEnv = append(sidecarEnv, v)
+			workerEnv = append(workerEnv, v)
+		}
+	}
+	// Export worker traces to the same OTLP collector as pachd, if any
+	for _, name := range tracing.OTLPEndpointEnvVars {
+		if value := os.Getenv(name); value != "" {
+			sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: name, Value: value})
+			workerEnv = append(workerEnv, v1.EnvVar{Name: name, Value: value})
+		}
+	}
+	// Let workers read Vault-backed secrets
+	if a.env.VaultAddress != "" {
+		workerEnv = append(workerEnv, []v1.EnvVar{
+			{Name: client.VaultAddressEnv, Value: a.env.VaultAddress},
+			{Name: client.VaultAuthRolePrefixEnv, Value: a.env.VaultAuthRolePrefix},
+			{Name: client.VaultAuthPathEnv, Value: a.env.VaultAuthPath},
+		}...)
+	}
+	// Audit the RPCs that workers make to their sidecar
+	if a.env.AuditSinks != "" {
+		sidecarEnv = append(sidecarEnv, v1.EnvVar{Name: "AUDIT_SINKS", Value: a.env.AuditSinks})
+	}
 
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
@@ -479,6 +509,9 @@ func (a *apiServer) getWorkerOptions(ptr *pps.EtcdPipelineInfo, pipelineInfo *pp
 	var volumes []v1.Volume
 	var volumeMounts []v1.VolumeMount
 	for _, secret := range transform.Secrets {
+		if secret.VaultPath != "" {
+			continue // the worker reads these from Vault itself
+		}
 		if secret.MountPath != "" {
 			volumes = append(volumes, v1.Volume{
 				Name: secret.Name,
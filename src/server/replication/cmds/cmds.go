@@ -0,0 +1,133 @@
+package cmds
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
+	"github.com/pachyderm/pachyderm/src/server/replication/pretty"
+	"github.com/spf13/cobra"
+)
+
+// Cmds returns a slice containing replication commands.
+func Cmds() []*cobra.Command {
+	var commands []*cobra.Command
+
+	replicationDocs := &cobra.Command{
+		Short: "Docs for replications.",
+		Long: `Replications mirror the commits of a repo in another Pachyderm cluster
+into a repo in this cluster.
+
+Replicated commits keep their IDs, parents and provenance (where the
+provenance was also replicated), and only the data that this cluster doesn't
+already have is transferred. The replicated repo should be treated as a
+read-only mirror, for example as a disaster recovery copy of the remote repo.
+
+Replications are run by pachd, and their lag is exported as the prometheus
+metric pachyderm_replication_lag_seconds.`,
+	}
+	commands = append(commands, cmdutil.CreateDocsAlias(replicationDocs, "replication", " replication$"))
+
+	var remoteAddress string
+	var remoteRepo string
+	var remoteToken string
+	var branches []string
+	var repo string
+	var update bool
+	createReplication := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Create a new replication.",
+		Long: "Create a new replication, which mirrors the commits of a repo in " +
+			"a remote cluster into a repo in this cluster. Only cluster admins " +
+			"may create replications.",
+		Example: `
+# Mirror the master branch of "images" at pachd.example.com into the local
+# repo "images"
+$ {{alias}} images-dr --remote grpcs://pachd.example.com:30650 --remote-repo images
+
+# Mirror two branches into the local repo "images-replica", authenticating to
+# the remote cluster with a token read from stdin
+$ {{alias}} images-dr --remote pachd.example.com:30650 --remote-repo images \
+    --branch master --branch staging --repo images-replica --remote-token -`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			if remoteToken == "-" {
+				fmt.Println("Please paste the remote cluster's Pachyderm auth token:")
+				token, err := cmdutil.ReadPassword("")
+				if err != nil {
+					return errors.Wrapf(err, "error reading token")
+				}
+				remoteToken = strings.TrimSpace(token) // drop trailing newline
+			}
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.CreateReplication(args[0], remoteAddress, remoteToken, remoteRepo, branches, repo, update)
+		}),
+	}
+	createReplication.Flags().StringVar(&remoteAddress, "remote", "", "The address of the remote cluster's pachd (e.g. grpcs://pachd.example.com:30650).")
+	createReplication.Flags().StringVar(&remoteRepo, "remote-repo", "", "The repo in the remote cluster to replicate.")
+	createReplication.Flags().StringVar(&remoteToken, "remote-token", "", "A Pachyderm auth token for the remote cluster, or '-' to read the token from stdin. Not needed if auth isn't activated in the remote cluster.")
+	createReplication.Flags().StringSliceVarP(&branches, "branch", "b", nil, "A branch of the remote repo to replicate (default master).")
+	createReplication.Flags().StringVar(&repo, "repo", "", "The local repo that commits are replicated into (default the remote repo's name).")
+	createReplication.Flags().BoolVar(&update, "update", false, "Update the replication if it already exists.")
+	commands = append(commands, cmdutil.CreateAlias(createReplication, "create replication"))
+
+	var raw bool
+	var fullTimestamps bool
+	listReplication := &cobra.Command{
+		Short: "Return all replications.",
+		Long:  "Return all replications, along with their state, the number of commits and amount of data that they have replicated, and their lag.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			infos, err := c.ListReplication()
+			if err != nil {
+				return err
+			}
+			if raw {
+				marshaller := &jsonpb.Marshaler{Indent: "  "}
+				for _, info := range infos {
+					if err := marshaller.Marshal(os.Stdout, info); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+			writer := tabwriter.NewWriter(os.Stdout, pretty.ReplicationHeader)
+			for _, info := range infos {
+				pretty.PrintReplicationInfo(writer, info, fullTimestamps)
+			}
+			return writer.Flush()
+		}),
+	}
+	listReplication.Flags().BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	listReplication.Flags().BoolVar(&fullTimestamps, "full-timestamps", false, "Return absolute timestamps (as opposed to the default, relative timestamps).")
+	commands = append(commands, cmdutil.CreateAlias(listReplication, "list replication"))
+
+	deleteReplication := &cobra.Command{
+		Use:   "{{alias}} <name>",
+		Short: "Delete a replication.",
+		Long:  "Delete a replication. The commits that it has already replicated are left in the local repo.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine("user")
+			if err != nil {
+				return err
+			}
+			defer c.Close()
+			return c.DeleteReplication(args[0])
+		}),
+	}
+	commands = append(commands, cmdutil.CreateAlias(deleteReplication, "delete replication"))
+
+	return commands
+}
@@ -0,0 +1,48 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client/replication"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+)
+
+const (
+	// ReplicationHeader is the header for replications.
+	ReplicationHeader = "NAME\tREMOTE\tBRANCHES\tREPO\tSTATE\tCOMMITS\tDATA\tLAG\tLAST REPLICATED\t\n"
+)
+
+// PrintReplicationInfo pretty-prints replication info.
+func PrintReplicationInfo(w io.Writer, info *replication.ReplicationInfo, fullTimestamps bool) {
+	fmt.Fprintf(w, "%s\t", info.Name)
+	fmt.Fprintf(w, "%s@%s\t", info.Remote.Repo, info.Remote.PachdAddress)
+	fmt.Fprintf(w, "%s\t", strings.Join(info.Branches, ", "))
+	fmt.Fprintf(w, "%s\t", info.Repo)
+	status := info.Status
+	if status == nil {
+		status = &replication.ReplicationStatus{}
+	}
+	if status.State == replication.ReplicationState_FAILING && status.Reason != "" {
+		fmt.Fprintf(w, "%s: %s\t", status.State, status.Reason)
+	} else {
+		fmt.Fprintf(w, "%s\t", status.State)
+	}
+	fmt.Fprintf(w, "%d\t", status.Commits)
+	fmt.Fprintf(w, "%s\t", pretty.Size(status.Bytes))
+	if status.Lag != nil {
+		fmt.Fprintf(w, "%s\t", pretty.Duration(status.Lag))
+	} else {
+		fmt.Fprintf(w, "-\t")
+	}
+	switch {
+	case status.LastReplicated == nil:
+		fmt.Fprintf(w, "-\t")
+	case fullTimestamps:
+		fmt.Fprintf(w, "%s\t", status.LastReplicated.String())
+	default:
+		fmt.Fprintf(w, "%s\t", pretty.Ago(status.LastReplicated))
+	}
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/replication"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ancestry"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+)
+
+const (
+	replicationsPrefix = "replications"
+	statusesPrefix     = "statuses"
+)
+
+type apiServer struct {
+	log.Logger
+	env        *serviceenv.ServiceEnv
+	etcdPrefix string
+	// replications holds each ReplicationInfo (without its status), and
+	// statuses holds each ReplicationStatus, so that the master's status
+	// updates don't conflict with users' changes
+	replications col.Collection
+	statuses     col.Collection
+}
+
+func newAPIServer(env *serviceenv.ServiceEnv, etcdPrefix string) *apiServer {
+	a := &apiServer{
+		Logger:     log.NewLogger("replication.API"),
+		env:        env,
+		etcdPrefix: etcdPrefix,
+		replications: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, replicationsPrefix),
+			nil,
+			&replication.ReplicationInfo{},
+			nil,
+			nil,
+		),
+		statuses: col.NewCollection(
+			env.GetEtcdClient(),
+			path.Join(etcdPrefix, statusesPrefix),
+			nil,
+			&replication.ReplicationStatus{},
+			nil,
+			nil,
+		),
+	}
+	registerMetrics()
+	go a.master()
+	return a
+}
+
+// checkIsAdmin returns an error if auth is active and the caller isn't a
+// cluster admin
+func checkIsAdmin(pachClient *client.APIClient, op string) error {
+	whoAmI, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return err
+	}
+	if !whoAmI.IsAdmin {
+		return &auth.ErrNotAuthorized{
+			Subject: whoAmI.Username,
+			AdminOp: op,
+		}
+	}
+	return nil
+}
+
+// newRemoteClient connects to the remote cluster of a replication
+func newRemoteClient(remote *replication.Remote) (*client.APIClient, error) {
+	addr, err := grpcutil.ParsePachdAddress(remote.PachdAddress)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse remote pachd address")
+	}
+	var options []client.Option
+	if addr.Secured {
+		options = append(options, client.WithSystemCAs)
+	}
+	c, err := client.NewFromAddress(addr.Hostname(), options...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not connect to remote pachd at %q", remote.PachdAddress)
+	}
+	c.SetAuthToken(remote.AuthToken)
+	return c, nil
+}
+
+// CreateReplication implements the protobuf replication.CreateReplication RPC
+func (a *apiServer) CreateReplication(ctx context.Context, req *replication.CreateReplicationRequest) (response *types.Empty, retErr error) {
+	// We don't log the request, since it contains the remote's auth token
+	func() { a.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(nil, response, retErr, time.Since(start)) }(time.Now())
+
+	pachClient := a.env.GetPachClient(ctx)
+	if err := checkIsAdmin(pachClient, "CreateReplication"); err != nil {
+		return nil, err
+	}
+	if err := ancestry.ValidateName(req.Name); err != nil {
+		return nil, errors.Wrapf(err, "invalid replication name")
+	}
+	if req.Remote == nil || req.Remote.PachdAddress == "" || req.Remote.Repo == "" {
+		return nil, errors.Errorf("a replication must have a remote pachd address and repo")
+	}
+	if req.Repo == "" {
+		req.Repo = req.Remote.Repo
+	}
+	if len(req.Branches) == 0 {
+		req.Branches = []string{"master"}
+	}
+	for _, branch := range req.Branches {
+		if err := ancestry.ValidateName(branch); err != nil {
+			return nil, errors.Wrapf(err, "invalid branch name")
+		}
+	}
+
+	// Check that the remote repo can be read, so that bad addresses and
+	// tokens are reported now rather than by ListReplication
+	remote, err := newRemoteClient(req.Remote)
+	if err != nil {
+		return nil, err
+	}
+	defer remote.Close()
+	if _, err := remote.WithCtx(ctx).InspectRepo(req.Remote.Repo); err != nil {
+		return nil, errors.Wrapf(err, "could not inspect remote repo %q", req.Remote.Repo)
+	}
+
+	// Two replications can't write to the same local repo
+	info := &replication.ReplicationInfo{}
+	if err := a.replications.ReadOnly(ctx).List(info, col.DefaultOptions, func(name string) error {
+		if name != req.Name && info.Repo == req.Repo {
+			return errors.Errorf("repo %q is already replicated by %q", req.Repo, name)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := pachClient.PfsAPIClient.CreateRepo(pachClient.Ctx(), &pfs.CreateRepoRequest{
+		Repo:        client.NewRepo(req.Repo),
+		Description: "Replicated from " + req.Remote.Repo + " at " + req.Remote.PachdAddress,
+	}); err != nil && !errutil.IsAlreadyExistError(err) {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		replications := a.replications.ReadWrite(stm)
+		info := &replication.ReplicationInfo{}
+		if err := replications.Get(req.Name, info); err == nil {
+			if !req.Update {
+				return errors.Errorf("replication %q already exists", req.Name)
+			}
+		} else if !col.IsErrNotFound(err) {
+			return err
+		} else {
+			info.Created = types.TimestampNow()
+		}
+		info.Name = req.Name
+		info.Remote = req.Remote
+		info.Branches = req.Branches
+		info.Repo = req.Repo
+		return replications.Put(req.Name, info)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+// ListReplication implements the protobuf replication.ListReplication RPC
+func (a *apiServer) ListReplication(ctx context.Context, req *replication.ListReplicationRequest) (response *replication.ListReplicationResponse, retErr error) {
+	func() { a.Log(req, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(req, response, retErr, time.Since(start)) }(time.Now())
+
+	response = &replication.ListReplicationResponse{}
+	info := &replication.ReplicationInfo{}
+	statuses := a.statuses.ReadOnly(ctx)
+	if err := a.replications.ReadOnly(ctx).List(info, &col.Options{Target: etcd.SortByKey, Order: etcd.SortAscend}, func(name string) error {
+		result := proto.Clone(info).(*replication.ReplicationInfo)
+		result.Remote.AuthToken = ""
+		result.Status = &replication.ReplicationStatus{}
+		if err := statuses.Get(name, result.Status); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		response.Replications = append(response.Replications, result)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// DeleteReplication implements the protobuf replication.DeleteReplication RPC
+func (a *apiServer) DeleteReplication(ctx context.Context, req *replication.DeleteReplicationRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(req, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(req, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := checkIsAdmin(a.env.GetPachClient(ctx), "DeleteReplication"); err != nil {
+		return nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		if err := a.replications.ReadWrite(stm).Delete(req.Name); err != nil {
+			return err
+		}
+		if err := a.statuses.ReadWrite(stm).Delete(req.Name); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
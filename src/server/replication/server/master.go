@@ -0,0 +1,149 @@
+package server
+
+import (
+	"context"
+	"path"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/replication"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+const (
+	masterLockPath = "_master_lock"
+)
+
+// The master process runs every replication. Only one pachd runs the master
+// at a time, so that each replication is only run once.
+func (a *apiServer) master() {
+	masterLock := dlock.NewDLock(a.env.GetEtcdClient(), path.Join(a.etcdPrefix, masterLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx, err := masterLock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer masterLock.Unlock(ctx)
+
+		logrus.Infof("replication master: launching master process")
+
+		replicationWatcher, err := a.replications.ReadOnly(ctx).Watch()
+		if err != nil {
+			return errors.Wrapf(err, "error creating watch")
+		}
+		defer replicationWatcher.Close()
+
+		// running maps the name of each running replication to the function
+		// that stops it
+		running := make(map[string]context.CancelFunc)
+		for {
+			select {
+			case event := <-replicationWatcher.Watch():
+				if event.Err != nil {
+					return errors.Wrapf(event.Err, "event err")
+				}
+				name := string(event.Key)
+				if stop, ok := running[name]; ok {
+					stop()
+					delete(running, name)
+				}
+				if event.Type == watch.EventPut {
+					info := &replication.ReplicationInfo{}
+					if err := event.Unmarshal(&name, info); err != nil {
+						return err
+					}
+					replicationCtx, stop := context.WithCancel(ctx)
+					running[name] = stop
+					go a.runReplication(replicationCtx, info)
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logrus.Errorf("replication master: error running the master process: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// runReplication runs the replication 'info' (retrying if it fails) until
+// 'ctx' is canceled
+func (a *apiServer) runReplication(ctx context.Context, info *replication.ReplicationInfo) {
+	backoff.RetryUntilCancel(ctx, func() error {
+		return a.replicate(ctx, info)
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		logrus.Errorf("replication %q failed: %v; retrying in %v", info.Name, err, d)
+		errorsCount.WithLabelValues(info.Name).Inc()
+		if err := a.updateStatus(ctx, info.Name, func(status *replication.ReplicationStatus) {
+			status.State = replication.ReplicationState_FAILING
+			status.Reason = err.Error()
+		}); err != nil {
+			logrus.Errorf("could not update the status of replication %q: %v", info.Name, err)
+		}
+		return nil
+	})
+}
+
+func (a *apiServer) replicate(ctx context.Context, info *replication.ReplicationInfo) error {
+	token, err := a.superUserToken(ctx)
+	if err != nil {
+		return err
+	}
+	local := a.env.GetPachClient(ctx)
+	local.SetAuthToken(token)
+	remote, err := newRemoteClient(info.Remote)
+	if err != nil {
+		return err
+	}
+	defer remote.Close()
+	status := &replication.ReplicationStatus{}
+	if err := a.statuses.ReadOnly(ctx).Get(info.Name, status); err != nil && !col.IsErrNotFound(err) {
+		return err
+	}
+	r := newReplicator(info, local, remote.WithCtx(ctx), a.env.StorageRoot, status, func(status *replication.ReplicationStatus) error {
+		return a.updateStatus(ctx, info.Name, func(s *replication.ReplicationStatus) {
+			*s = *status
+		})
+	})
+	return r.run()
+}
+
+// updateStatus applies 'f' to the status of the replication 'name'
+func (a *apiServer) updateStatus(ctx context.Context, name string, f func(*replication.ReplicationStatus)) error {
+	_, err := col.NewSTM(ctx, a.env.GetEtcdClient(), func(stm col.STM) error {
+		// Don't recreate the status of a deleted replication
+		if err := a.replications.ReadWrite(stm).Get(name, &replication.ReplicationInfo{}); err != nil {
+			return err
+		}
+		status := &replication.ReplicationStatus{}
+		return a.statuses.ReadWrite(stm).Upsert(name, status, func() error {
+			f(status)
+			return nil
+		})
+	})
+	return err
+}
+
+// superUserToken returns the token that replications use to write to local
+// repos, which is PPS's superuser token (or "" if auth has never been
+// activated)
+func (a *apiServer) superUserToken(ctx context.Context) (string, error) {
+	superUserTokenCol := col.NewCollection(a.env.GetEtcdClient(), ppsconsts.PPSTokenKey, nil, &types.StringValue{}, nil, nil).ReadOnly(ctx)
+	var result types.StringValue
+	if err := superUserTokenCol.Get("", &result); err != nil {
+		if col.IsErrNotFound(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return result.Value, nil
+}
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+)
+
+var (
+	// lagSeconds is the time between a remote commit finishing and it being
+	// replicated, for the most recently replicated commit of each replication
+	lagSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "replication",
+			Name:      "lag_seconds",
+			Help:      "Time between the most recently replicated commit finishing in the remote cluster and it being replicated",
+		},
+		[]string{"replication"},
+	)
+
+	// lastReplicatedSeconds is the time at which each replication last
+	// replicated a commit, which can be used to alert on stalled replications
+	lastReplicatedSeconds = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "pachyderm",
+			Subsystem: "replication",
+			Name:      "last_replicated_timestamp_seconds",
+			Help:      "Unix time at which a commit was last replicated",
+		},
+		[]string{"replication"},
+	)
+
+	// commitsCount is the number of commits replicated by each replication
+	commitsCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "replication",
+			Name:      "commits_count",
+			Help:      "Cumulative number of commits replicated",
+		},
+		[]string{"replication"},
+	)
+
+	// bytesCount is the amount of data transferred by each replication
+	bytesCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "replication",
+			Name:      "bytes_count",
+			Help:      "Cumulative number of bytes transferred from the remote cluster",
+		},
+		[]string{"replication"},
+	)
+
+	// errorsCount is the number of times each replication has failed (and
+	// been retried)
+	errorsCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "pachyderm",
+			Subsystem: "replication",
+			Name:      "errors_count",
+			Help:      "Cumulative number of replication failures",
+		},
+		[]string{"replication"},
+	)
+)
+
+func registerMetrics() {
+	for _, c := range []prometheus.Collector{lagSeconds, lastReplicatedSeconds, commitsCount, bytesCount, errorsCount} {
+		if err := prometheus.Register(c); err != nil {
+			// metrics may be redundantly registered; ignore these errors
+			if !errors.As(err, &prometheus.AlreadyRegisteredError{}) {
+				logrus.Infof("error registering prometheus metric: %v", err)
+			}
+		}
+	}
+}
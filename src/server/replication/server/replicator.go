@@ -0,0 +1,363 @@
+package server
+
+import (
+	"io"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/replication"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+// replicator mirrors the commits on some branches of a remote repo into a
+// local repo. Commits are replicated with the same IDs, and only the blocks
+// that are missing from the local cluster are transferred.
+type replicator struct {
+	info   *replication.ReplicationInfo
+	local  *client.APIClient
+	remote *client.APIClient
+	// storageRoot is scratch space for the remote commits' hashtrees
+	storageRoot string
+	// onStatus is called with the replication's status after each replicated
+	// commit
+	onStatus func(*replication.ReplicationStatus) error
+
+	mu     sync.Mutex
+	status *replication.ReplicationStatus
+	// blocks and objects are those known to be in the local cluster
+	blocks  map[string]bool
+	objects map[string]bool
+}
+
+func newReplicator(info *replication.ReplicationInfo, local, remote *client.APIClient, storageRoot string,
+	status *replication.ReplicationStatus, onStatus func(*replication.ReplicationStatus) error) *replicator {
+	return &replicator{
+		info:        info,
+		local:       local,
+		remote:      remote,
+		storageRoot: storageRoot,
+		onStatus:    onStatus,
+		status:      status,
+		blocks:      make(map[string]bool),
+		objects:     make(map[string]bool),
+	}
+}
+
+// run replicates the commits on each of the replicated branches, and then
+// waits for (and replicates) new commits. It only returns if there's an
+// error, or the local client's context is canceled.
+func (r *replicator) run() error {
+	if err := r.init(); err != nil {
+		return err
+	}
+	branches := r.info.Branches
+	if len(branches) == 0 {
+		branches = []string{"master"}
+	}
+	eg, ctx := errgroup.WithContext(r.local.Ctx())
+	r.local = r.local.WithCtx(ctx)
+	r.remote = r.remote.WithCtx(ctx)
+	for _, branch := range branches {
+		branch := branch
+		eg.Go(func() error {
+			return r.remote.SubscribeCommitF(r.info.Remote.Repo, branch, nil, "", pfs.CommitState_FINISHED, func(ci *pfs.CommitInfo) error {
+				return r.replicateCommit(ci, branch)
+			})
+		})
+	}
+	return eg.Wait()
+}
+
+// init creates the local repo (if it doesn't exist) and reads the blocks that
+// are already in the local cluster
+func (r *replicator) init() error {
+	if _, err := r.local.PfsAPIClient.CreateRepo(r.local.Ctx(), &pfs.CreateRepoRequest{
+		Repo:        client.NewRepo(r.info.Repo),
+		Description: "Replicated from " + r.info.Remote.Repo + " at " + r.info.Remote.PachdAddress,
+	}); err != nil && !errutil.IsAlreadyExistError(err) {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create repo %q", r.info.Repo)
+	}
+	return r.local.ListBlock(func(block *pfs.Block) error {
+		r.blocks[block.Hash] = true
+		return nil
+	})
+}
+
+// replicateCommit replicates 'ci' (along with any of its ancestors that are
+// missing locally) and points the local copy of 'branch' at it
+func (r *replicator) replicateCommit(ci *pfs.CommitInfo, branch string) error {
+	exists, err := r.localCommitExists(ci.Commit.ID)
+	if err != nil {
+		return err
+	}
+	if exists {
+		// SubscribeCommit starts with every commit on the branch, so commits
+		// that have already been replicated are skipped. The branch is only
+		// moved if another branch's replication created the commit.
+		remoteBranch, err := r.remote.InspectBranch(r.info.Remote.Repo, branch)
+		if err != nil {
+			return err
+		}
+		if remoteBranch.Head == nil || remoteBranch.Head.ID != ci.Commit.ID {
+			return nil
+		}
+		return r.local.CreateBranch(r.info.Repo, branch, ci.Commit.ID, nil)
+	}
+	// Replicate the commit's missing ancestors, oldest first
+	missing := []*pfs.CommitInfo{ci}
+	for parent := ci.ParentCommit; parent != nil; {
+		exists, err := r.localCommitExists(parent.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			break
+		}
+		parentInfo, err := r.remote.InspectCommit(r.info.Remote.Repo, parent.ID)
+		if err != nil {
+			return err
+		}
+		missing = append(missing, parentInfo)
+		parent = parentInfo.ParentCommit
+	}
+	for i := len(missing) - 1; i >= 0; i-- {
+		var b string
+		if i == 0 {
+			b = branch
+		}
+		if err := r.copyCommit(missing[i], b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *replicator) localCommitExists(id string) (bool, error) {
+	if _, err := r.local.InspectCommit(r.info.Repo, id); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// copyCommit copies the data in 'ci' to the local cluster, and then creates a
+// commit with the same ID, contents and (if possible) provenance in the local
+// repo. If 'branch' is set, it's pointed at the new commit.
+func (r *replicator) copyCommit(ci *pfs.CommitInfo, branch string) error {
+	for _, tree := range append([]*pfs.Object{ci.Tree}, ci.Trees...) {
+		if err := r.copyTree(tree); err != nil {
+			return err
+		}
+	}
+	if err := r.copyObject(ci.Datums); err != nil {
+		return err
+	}
+	provenance, err := r.provenance(ci, branch)
+	if err != nil {
+		return err
+	}
+	parent := client.NewCommit(r.info.Repo, "")
+	if ci.ParentCommit != nil {
+		parent.ID = ci.ParentCommit.ID
+	}
+	if _, err := r.local.PfsAPIClient.BuildCommit(r.local.Ctx(), &pfs.BuildCommitRequest{
+		Parent:     parent,
+		Branch:     branch,
+		Origin:     ci.Origin,
+		Provenance: provenance,
+		Tree:       ci.Tree,
+		Trees:      ci.Trees,
+		Datums:     ci.Datums,
+		ID:         ci.Commit.ID,
+		SizeBytes:  ci.SizeBytes,
+		Started:    ci.Started,
+		Finished:   ci.Finished,
+	}); err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not create commit %s@%s", r.info.Repo, ci.Commit.ID)
+	}
+
+	now := time.Now()
+	r.mu.Lock()
+	r.status.State = replication.ReplicationState_RUNNING
+	r.status.Reason = ""
+	r.status.Commits++
+	r.status.LastCommit = client.NewCommit(r.info.Repo, ci.Commit.ID)
+	r.status.LastReplicated, _ = types.TimestampProto(now)
+	if finished, err := types.TimestampFromProto(ci.Finished); err == nil {
+		r.status.Lag = types.DurationProto(now.Sub(finished))
+		lagSeconds.WithLabelValues(r.info.Name).Set(now.Sub(finished).Seconds())
+	}
+	lastReplicatedSeconds.WithLabelValues(r.info.Name).Set(float64(now.Unix()))
+	commitsCount.WithLabelValues(r.info.Name).Inc()
+	status := proto.Clone(r.status).(*replication.ReplicationStatus)
+	r.mu.Unlock()
+	if r.onStatus != nil {
+		return r.onStatus(status)
+	}
+	return nil
+}
+
+// provenance returns the provenance of 'ci' that can be preserved locally,
+// which is the provenance whose commits have been replicated (under the same
+// repo names) and, if 'branch' is set, whose branches the local 'branch' is
+// provenant on. Replications never set branch provenance themselves, as that
+// would cause the local cluster to create its own commits downstream of the
+// replicated ones.
+func (r *replicator) provenance(ci *pfs.CommitInfo, branch string) ([]*pfs.CommitProvenance, error) {
+	if len(ci.Provenance) == 0 {
+		return nil, nil
+	}
+	var branchProvenance map[string]bool
+	if branch != "" {
+		branchProvenance = make(map[string]bool)
+		branchInfo, err := r.local.InspectBranch(r.info.Repo, branch)
+		if err != nil && !errutil.IsNotFoundError(err) {
+			return nil, err
+		}
+		if branchInfo != nil {
+			for _, b := range branchInfo.Provenance {
+				branchProvenance[path.Join(b.Repo.Name, b.Name)] = true
+			}
+		}
+	}
+	var result []*pfs.CommitProvenance
+	for _, p := range ci.Provenance {
+		if p.Commit == nil || p.Branch == nil {
+			continue
+		}
+		if branchProvenance != nil && p.Commit.Repo.Name != ppsconsts.SpecRepo &&
+			!branchProvenance[path.Join(p.Branch.Repo.Name, p.Branch.Name)] {
+			continue
+		}
+		if _, err := r.local.InspectCommit(p.Commit.Repo.Name, p.Commit.ID); err != nil {
+			if errutil.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		result = append(result, p)
+	}
+	return result, nil
+}
+
+// copyTree copies the hashtree 'tree', and every object and block that it
+// refers to, to the local cluster
+func (r *replicator) copyTree(tree *pfs.Object) (retErr error) {
+	if tree == nil {
+		return nil
+	}
+	if err := r.copyObject(tree); err != nil {
+		return err
+	}
+	t, err := hashtree.GetHashTreeObject(r.remote, r.storageRoot, tree)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := t.Destroy(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	return t.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		switch {
+		case node.FileNode != nil:
+			for _, object := range node.FileNode.Objects {
+				if err := r.copyObject(object); err != nil {
+					return err
+				}
+			}
+			for _, blockRef := range node.FileNode.BlockRefs {
+				if err := r.copyBlock(blockRef.Block); err != nil {
+					return err
+				}
+			}
+		case node.DirNode != nil && node.DirNode.Shared != nil:
+			if err := r.copyObject(node.DirNode.Shared.Header); err != nil {
+				return err
+			}
+			if err := r.copyObject(node.DirNode.Shared.Footer); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// copyObject copies 'object' to the local cluster, if it's missing. Only the
+// object's block is transferred, and the object is recreated locally with the
+// same hash and block ref.
+func (r *replicator) copyObject(object *pfs.Object) error {
+	if object == nil || r.hasObject(object.Hash) {
+		return nil
+	}
+	resp, err := r.local.ObjectAPIClient.CheckObject(r.local.Ctx(), &pfs.CheckObjectRequest{Object: object})
+	if err != nil {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if !resp.Exists {
+		objectInfo, err := r.remote.InspectObject(object.Hash)
+		if err != nil {
+			return err
+		}
+		if err := r.copyBlock(objectInfo.BlockRef.Block); err != nil {
+			return err
+		}
+		if _, err := r.local.ObjectAPIClient.CreateObject(r.local.Ctx(), &pfs.CreateObjectRequest{
+			Object:   object,
+			BlockRef: objectInfo.BlockRef,
+		}); err != nil {
+			return grpcutil.ScrubGRPC(err)
+		}
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.objects[object.Hash] = true
+	return nil
+}
+
+// copyBlock copies 'block' to the local cluster, if it's missing
+func (r *replicator) copyBlock(block *pfs.Block) error {
+	if block == nil || r.hasBlock(block.Hash) {
+		return nil
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(r.remote.GetBlock(block.Hash, pw))
+	}()
+	n, err := r.local.PutBlock(block.Hash, pr)
+	pr.CloseWithError(err)
+	if err != nil {
+		return errors.Wrapf(err, "could not copy block %s", block.Hash)
+	}
+	bytesCount.WithLabelValues(r.info.Name).Add(float64(n))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.blocks[block.Hash] = true
+	r.status.Bytes += uint64(n)
+	return nil
+}
+
+func (r *replicator) hasObject(hash string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.objects[hash]
+}
+
+func (r *replicator) hasBlock(hash string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.blocks[hash]
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/replication"
+	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+)
+
+// putCommit creates a finished commit on master of 'repo' containing 'files'
+func putCommit(t *testing.T, c *client.APIClient, repo string, files map[string]string) *pfs.CommitInfo {
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+	for path, content := range files {
+		_, err = c.PutFile(repo, commit.ID, path, strings.NewReader(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+	commitInfo, err := c.InspectCommit(repo, commit.ID)
+	require.NoError(t, err)
+	return commitInfo
+}
+
+func getFile(t *testing.T, c *client.APIClient, repo, commit, path string) string {
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(repo, commit, path, 0, 0, &buf))
+	return buf.String()
+}
+
+func TestReplicator(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(remoteEnv *testpachd.RealEnv) error {
+		return testpachd.WithRealEnv(func(localEnv *testpachd.RealEnv) error {
+			remote := remoteEnv.PachClient
+			local := localEnv.PachClient
+			require.NoError(t, remote.CreateRepo("images"))
+			big := strings.Repeat("big file\n", 100*1024)
+			commit1 := putCommit(t, remote, "images", map[string]string{"big": big})
+
+			info := &replication.ReplicationInfo{
+				Name:   "images-dr",
+				Remote: &replication.Remote{Repo: "images"},
+				Repo:   "images-replica",
+			}
+			var statuses []*replication.ReplicationStatus
+			r := newReplicator(info, local, remote, localEnv.Directory, &replication.ReplicationStatus{}, func(status *replication.ReplicationStatus) error {
+				statuses = append(statuses, status)
+				return nil
+			})
+			require.NoError(t, r.init())
+			require.NoError(t, r.replicateCommit(commit1, "master"))
+			require.Equal(t, big, getFile(t, local, "images-replica", commit1.Commit.ID, "big"))
+			require.Equal(t, 1, len(statuses))
+			require.Equal(t, int64(1), statuses[0].Commits)
+			require.Equal(t, commit1.Commit.ID, statuses[0].LastCommit.ID)
+			require.True(t, statuses[0].Bytes >= uint64(len(big)))
+
+			// Only the new file (and the new commit's tree) is transferred for
+			// the second commit, and commits keep their IDs and parents
+			commit2 := putCommit(t, remote, "images", map[string]string{"small": "small file\n"})
+			require.NoError(t, r.replicateCommit(commit2, "master"))
+			require.Equal(t, "small file\n", getFile(t, local, "images-replica", "master", "small"))
+			require.Equal(t, big, getFile(t, local, "images-replica", "master", "big"))
+			commitInfo, err := local.InspectCommit("images-replica", "master")
+			require.NoError(t, err)
+			require.Equal(t, commit2.Commit.ID, commitInfo.Commit.ID)
+			require.Equal(t, commit1.Commit.ID, commitInfo.ParentCommit.ID)
+			require.Equal(t, commit2.SizeBytes, commitInfo.SizeBytes)
+			require.Equal(t, 2, len(statuses))
+			require.True(t, statuses[1].Bytes-statuses[0].Bytes < uint64(len(big)))
+			return nil
+		})
+	})
+	require.NoError(t, err)
+}
+
+func TestReplicatorSkipsReplicatedCommits(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(remoteEnv *testpachd.RealEnv) error {
+		return testpachd.WithRealEnv(func(localEnv *testpachd.RealEnv) error {
+			remote := remoteEnv.PachClient
+			local := localEnv.PachClient
+			require.NoError(t, remote.CreateRepo("images"))
+			var commitInfos []*pfs.CommitInfo
+			for _, file := range []string{"a", "b", "c"} {
+				commitInfos = append(commitInfos, putCommit(t, remote, "images", map[string]string{file: file}))
+			}
+			info := &replication.ReplicationInfo{
+				Name:   "images",
+				Remote: &replication.Remote{Repo: "images"},
+				Repo:   "images",
+			}
+
+			// Replicating the last commit replicates its missing ancestors
+			r := newReplicator(info, local, remote, localEnv.Directory, &replication.ReplicationStatus{}, nil)
+			require.NoError(t, r.init())
+			require.NoError(t, r.replicateCommit(commitInfos[2], "master"))
+			localCommitInfos, err := local.ListCommitByRepo("images")
+			require.NoError(t, err)
+			require.Equal(t, 3, len(localCommitInfos))
+			require.Equal(t, int64(3), r.status.Commits)
+			require.Equal(t, "a", getFile(t, local, "images", commitInfos[0].Commit.ID, "a"))
+
+			// A restarted replication skips the commits that were already
+			// replicated, and transfers nothing
+			r = newReplicator(info, local, remote, localEnv.Directory, &replication.ReplicationStatus{}, nil)
+			require.NoError(t, r.init())
+			for _, commitInfo := range commitInfos {
+				require.NoError(t, r.replicateCommit(commitInfo, "master"))
+			}
+			require.Equal(t, int64(0), r.status.Commits)
+			require.Equal(t, uint64(0), r.status.Bytes)
+			branchInfo, err := local.InspectBranch("images", "master")
+			require.NoError(t, err)
+			require.Equal(t, commitInfos[2].Commit.ID, branchInfo.Head.ID)
+			return nil
+		})
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,18 @@
+package server
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/replication"
+	"github.com/pachyderm/pachyderm/src/server/pkg/serviceenv"
+)
+
+// APIServer represents a replication API server
+type APIServer interface {
+	replication.APIServer
+}
+
+// NewAPIServer returns a new replication APIServer. Replications are stored
+// in etcd under 'etcdPrefix', and are run by whichever pachd holds the
+// replication master lock.
+func NewAPIServer(env *serviceenv.ServiceEnv, etcdPrefix string) APIServer {
+	return newAPIServer(env, etcdPrefix)
+}
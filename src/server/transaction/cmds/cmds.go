@@ -2,15 +2,17 @@ package cmds
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/transaction"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
-	"github.com/pachyderm/pachyderm/src/server/pkg/tabwriter"
 	"github.com/pachyderm/pachyderm/src/server/transaction/pretty"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -21,11 +23,9 @@ import (
 func Cmds() []*cobra.Command {
 	var commands []*cobra.Command
 
-	marshaller := &jsonpb.Marshaler{Indent: "  "}
-
-	raw := false
-	rawFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
-	rawFlags.BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	output, outputFlags := cmdutil.NewOutputFlags()
+	// --raw (without --output) prints json the way it always has
+	output.RawMarshaler = &jsonpb.Marshaler{Indent: "  "}
 
 	fullTimestamps := false
 	fullTimestampsFlags := pflag.NewFlagSet("", pflag.ContinueOnError)
@@ -64,22 +64,19 @@ transaction' or cancelled with 'delete transaction'.`,
 			if err != nil {
 				return err
 			}
-			if raw {
-				for _, transaction := range transactions {
-					if err := marshaller.Marshal(os.Stdout, transaction); err != nil {
-						return err
-					}
-				}
-				return nil
+			printer, err := output.NewPrinter(os.Stdout, transactionTable(fullTimestamps))
+			if err != nil {
+				return err
 			}
-			writer := tabwriter.NewWriter(os.Stdout, pretty.TransactionHeader)
 			for _, transaction := range transactions {
-				pretty.PrintTransactionInfo(writer, transaction, fullTimestamps)
+				if err := printer.Print(transaction); err != nil {
+					return err
+				}
 			}
-			return writer.Flush()
+			return printer.Close()
 		}),
 	}
-	listTransaction.Flags().AddFlagSet(rawFlags)
+	listTransaction.Flags().AddFlagSet(outputFlags)
 	listTransaction.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(listTransaction, "list transaction"))
 
@@ -251,16 +248,17 @@ transaction' or cancelled with 'delete transaction'.`,
 			if info == nil {
 				return errors.Errorf("transaction %s not found", txn.ID)
 			}
-			if raw {
-				return marshaller.Marshal(os.Stdout, info)
+			table := transactionTable(fullTimestamps)
+			table.PrintDetailed = func(proto.Message) error {
+				return pretty.PrintDetailedTransactionInfo(&pretty.PrintableTransactionInfo{
+					TransactionInfo: info,
+					FullTimestamps:  fullTimestamps,
+				})
 			}
-			return pretty.PrintDetailedTransactionInfo(&pretty.PrintableTransactionInfo{
-				TransactionInfo: info,
-				FullTimestamps:  fullTimestamps,
-			})
+			return output.Print(os.Stdout, table, info)
 		}),
 	}
-	inspectTransaction.Flags().AddFlagSet(rawFlags)
+	inspectTransaction.Flags().AddFlagSet(outputFlags)
 	inspectTransaction.Flags().AddFlagSet(fullTimestampsFlags)
 	commands = append(commands, cmdutil.CreateAlias(inspectTransaction, "inspect transaction"))
 
@@ -295,3 +293,19 @@ transaction' or cancelled with 'delete transaction'.`,
 
 	return commands
 }
+
+// transactionTable returns the table printed by 'list transaction'
+func transactionTable(fullTimestamps bool) cmdutil.Table {
+	return cmdutil.Table{
+		Header: pretty.TransactionHeader,
+		PrintRow: func(w io.Writer, item proto.Message) {
+			pretty.PrintTransactionInfo(w, item.(*transaction.TransactionInfo), fullTimestamps)
+		},
+		CSVHeader: []string{"transaction", "started", "requests"},
+		CSVRow: func(item proto.Message) []string {
+			info := item.(*transaction.TransactionInfo)
+			return []string{info.Transaction.ID, cmdutil.CSVTimestamp(info.Started),
+				strconv.Itoa(len(info.Requests))}
+		},
+	}
+}
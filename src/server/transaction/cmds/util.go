@@ -82,3 +82,19 @@ func WithActiveTransaction(c *client.APIClient, callback func(*client.APIClient)
 	}
 	return err
 }
+
+// WithNewOrActiveTransaction is like WithActiveTransaction, but if there is no
+// active transaction, the callback is run in a new transaction, which is
+// finished if the callback succeeds and deleted otherwise. This is for
+// executing several RPCs that should succeed or fail together.
+func WithNewOrActiveTransaction(c *client.APIClient, callback func(*client.APIClient) error) error {
+	txn, err := getActiveTransaction()
+	if err != nil {
+		return err
+	}
+	if txn != nil {
+		return WithActiveTransaction(c, callback)
+	}
+	_, err = c.ExecuteInTransaction(callback)
+	return err
+}
@@ -131,6 +131,47 @@ func sprintUpdateJobState(request *pps.UpdateJobStateRequest) string {
 	)
 }
 
+func sprintPutFile(request *transaction.PutFileRecordsRequest) string {
+	if len(request.Files) == 0 {
+		return "put file (no files)"
+	}
+	file := request.Files[0]
+	if len(request.Files) == 1 {
+		return fmt.Sprintf("put file %s@%s:%s", file.Commit.Repo.Name, file.Commit.ID, file.Path)
+	}
+	return fmt.Sprintf("put file %s@%s:%s (and %d more)", file.Commit.Repo.Name, file.Commit.ID, file.Path, len(request.Files)-1)
+}
+
+func sprintDeleteFile(request *pfs.DeleteFileRequest) string {
+	return fmt.Sprintf("delete file %s@%s:%s", request.File.Commit.Repo.Name, request.File.Commit.ID, request.File.Path)
+}
+
+func sprintCreatePipeline(request *pps.CreatePipelineRequest) string {
+	if request.Update {
+		return fmt.Sprintf("update pipeline %s", request.Pipeline.Name)
+	}
+	return fmt.Sprintf("create pipeline %s", request.Pipeline.Name)
+}
+
+func sprintDeletePipeline(request *pps.DeletePipelineRequest) string {
+	force := ""
+	if request.Force {
+		force = " --force"
+	}
+	if request.All {
+		return fmt.Sprintf("delete pipeline --all%s", force)
+	}
+	return fmt.Sprintf("delete pipeline %s%s", request.Pipeline.Name, force)
+}
+
+func sprintStartPipeline(request *pps.StartPipelineRequest) string {
+	return fmt.Sprintf("start pipeline %s", request.Pipeline.Name)
+}
+
+func sprintStopPipeline(request *pps.StopPipelineRequest) string {
+	return fmt.Sprintf("stop pipeline %s", request.Pipeline.Name)
+}
+
 func transactionRequests(
 	requests []*transaction.TransactionRequest,
 	responses []*transaction.TransactionResponse,
@@ -162,6 +203,18 @@ func transactionRequests(
 			line = sprintDeleteBranch(request.DeleteBranch)
 		} else if request.UpdateJobState != nil {
 			line = sprintUpdateJobState(request.UpdateJobState)
+		} else if request.PutFile != nil {
+			line = sprintPutFile(request.PutFile)
+		} else if request.DeleteFile != nil {
+			line = sprintDeleteFile(request.DeleteFile)
+		} else if request.CreatePipeline != nil {
+			line = sprintCreatePipeline(request.CreatePipeline)
+		} else if request.DeletePipeline != nil {
+			line = sprintDeletePipeline(request.DeletePipeline)
+		} else if request.StartPipeline != nil {
+			line = sprintStartPipeline(request.StartPipeline)
+		} else if request.StopPipeline != nil {
+			line = sprintStopPipeline(request.StopPipeline)
 		} else {
 			line = "ERROR (unknown request type)"
 		}
@@ -194,6 +194,24 @@ func (d *driver) runTransaction(txnCtx *txnenv.TransactionContext, info *transac
 		} else if request.UpdateJobState != nil {
 			err = directTxn.UpdateJobState(request.UpdateJobState)
 			response = &transaction.TransactionResponse{}
+		} else if request.PutFile != nil {
+			err = directTxn.PutFile(request.PutFile)
+			response = &transaction.TransactionResponse{}
+		} else if request.DeleteFile != nil {
+			err = directTxn.DeleteFile(request.DeleteFile)
+			response = &transaction.TransactionResponse{}
+		} else if request.CreatePipeline != nil {
+			err = directTxn.CreatePipeline(request.CreatePipeline)
+			response = &transaction.TransactionResponse{}
+		} else if request.DeletePipeline != nil {
+			err = directTxn.DeletePipeline(request.DeletePipeline)
+			response = &transaction.TransactionResponse{}
+		} else if request.StartPipeline != nil {
+			err = directTxn.StartPipeline(request.StartPipeline)
+			response = &transaction.TransactionResponse{}
+		} else if request.StopPipeline != nil {
+			err = directTxn.StopPipeline(request.StopPipeline)
+			response = &transaction.TransactionResponse{}
 		} else if request.DeleteAll != nil {
 			// TODO: extend this to delete everything through PFS, PPS, Auth and
 			// update the client DeleteAll call to use only this, then remove unused
@@ -1,14 +1,18 @@
 package testing
 
 import (
+	"bytes"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/transaction"
 	"github.com/pachyderm/pachyderm/src/server/pkg/testpachd"
+	txnenv "github.com/pachyderm/pachyderm/src/server/pkg/transactionenv"
 )
 
 func TestEmptyTransaction(t *testing.T) {
@@ -567,3 +571,165 @@ func TestBatchTransaction(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func requireFileContent(t *testing.T, c *client.APIClient, repo, commit, path, expected string) {
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(repo, commit, path, 0, 0, &buf))
+	require.Equal(t, expected, buf.String())
+}
+
+func TestPutFile(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		repo := "foo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+
+		// Write to a commit started in the same transaction
+		commit, err := txnClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = txnClient.PutFile(repo, commit.ID, "a", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		_, err = txnClient.PutFile(repo, commit.ID, "b", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+		require.NoError(t, txnClient.FinishCommit(repo, commit.ID))
+
+		// Nothing is written until the transaction is finished
+		commitInfos, err := env.PachClient.ListCommit(repo, "", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(commitInfos))
+
+		info, err := env.PachClient.FinishTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(info.Requests))
+		require.Equal(t, 4, len(info.Responses))
+
+		requireFileContent(t, env.PachClient, repo, commit.ID, "a", "foo\n")
+		requireFileContent(t, env.PachClient, repo, commit.ID, "b", "bar\n")
+		commitInfo, err := env.PachClient.InspectCommit(repo, "master")
+		require.NoError(t, err)
+		require.Equal(t, commit.ID, commitInfo.Commit.ID)
+		require.NotNil(t, commitInfo.Finished)
+
+		// Writing to a branch with a finished head creates a new commit
+		txn, err = env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient = env.PachClient.WithTransaction(txn)
+		_, err = txnClient.PutFile(repo, "master", "c", strings.NewReader("baz\n"))
+		require.NoError(t, err)
+		require.NoError(t, txnClient.DeleteFile(repo, "master", "a"))
+		_, err = env.PachClient.FinishTransaction(txn)
+		require.NoError(t, err)
+
+		commitInfos, err = env.PachClient.ListCommit(repo, "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(commitInfos))
+		requireFileContent(t, env.PachClient, repo, "master", "b", "bar\n")
+		requireFileContent(t, env.PachClient, repo, "master", "c", "baz\n")
+		_, err = env.PachClient.InspectFile(repo, "master", "a")
+		require.YesError(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestPutFileFinishedCommit(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		repo := "foo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		commit, err := env.PachClient.StartCommit(repo, "master")
+		require.NoError(t, err)
+		require.NoError(t, env.PachClient.FinishCommit(repo, commit.ID))
+
+		txn, err := env.PachClient.StartTransaction()
+		require.NoError(t, err)
+		txnClient := env.PachClient.WithTransaction(txn)
+
+		// The commit can't be written to, so the append fails
+		_, err = txnClient.PutFile(repo, commit.ID, "a", strings.NewReader("foo\n"))
+		require.YesError(t, err)
+
+		info, err := env.PachClient.InspectTransaction(txn)
+		require.NoError(t, err)
+		require.Equal(t, 0, len(info.Requests))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestBatchDeleteFile(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		repo := "foo"
+		require.NoError(t, env.PachClient.CreateRepo(repo))
+		_, err := env.PachClient.PutFile(repo, "master", "a", strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		_, err = env.PachClient.PutFile(repo, "master", "b", strings.NewReader("bar\n"))
+		require.NoError(t, err)
+
+		info, err := env.PachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+			// client.DeleteFile streams through PutFile, so use the raw request
+			for _, path := range []string{"a", "b"} {
+				_, err := builder.PfsAPIClient.DeleteFile(builder.Ctx(), &pfs.DeleteFileRequest{
+					File: client.NewFile(repo, "master", path),
+				})
+				require.NoError(t, err)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(info.Responses))
+
+		// As outside of a transaction, each delete on a branch whose head is
+		// finished gets its own commit
+		commitInfos, err := env.PachClient.ListCommit(repo, "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 4, len(commitInfos))
+		fileInfos, err := env.PachClient.ListFile(repo, "master", "")
+		require.NoError(t, err)
+		require.Equal(t, 0, len(fileInfos))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestBatchPipelineOps(t *testing.T) {
+	t.Parallel()
+	err := testpachd.WithRealEnv(func(env *testpachd.RealEnv) error {
+		var ops []string
+		env.MockPPSTransactionServer.CreatePipelineInTransaction.Use(func(txnCtx *txnenv.TransactionContext, req *pps.CreatePipelineRequest) error {
+			ops = append(ops, "create "+req.Pipeline.Name)
+			return nil
+		})
+		env.MockPPSTransactionServer.StopPipelineInTransaction.Use(func(txnCtx *txnenv.TransactionContext, req *pps.StopPipelineRequest) error {
+			ops = append(ops, "stop "+req.Pipeline.Name)
+			return nil
+		})
+		env.MockPPSTransactionServer.StartPipelineInTransaction.Use(func(txnCtx *txnenv.TransactionContext, req *pps.StartPipelineRequest) error {
+			ops = append(ops, "start "+req.Pipeline.Name)
+			return nil
+		})
+		env.MockPPSTransactionServer.DeletePipelineInTransaction.Use(func(txnCtx *txnenv.TransactionContext, req *pps.DeletePipelineRequest) error {
+			ops = append(ops, "delete "+req.Pipeline.Name)
+			return nil
+		})
+
+		info, err := env.PachClient.RunBatchInTransaction(func(builder *client.TransactionBuilder) error {
+			_, err := builder.PpsAPIClient.CreatePipeline(builder.Ctx(), &pps.CreatePipelineRequest{Pipeline: client.NewPipeline("a")})
+			require.NoError(t, err)
+			require.NoError(t, builder.StopPipeline("a"))
+			require.NoError(t, builder.StartPipeline("a"))
+			require.NoError(t, builder.DeletePipeline("a", false))
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, 4, len(info.Responses))
+		require.Equal(t, []string{"create a", "stop a", "start a", "delete a"}, ops)
+		return nil
+	})
+	require.NoError(t, err)
+}
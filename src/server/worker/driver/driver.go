@@ -37,7 +37,6 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/exec"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
-	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
@@ -48,6 +47,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/common"
 	"github.com/pachyderm/pachyderm/src/server/worker/logs"
 	"github.com/pachyderm/pachyderm/src/server/worker/stats"
+	"github.com/pachyderm/pachyderm/src/server/worker/timeline"
 )
 
 const (
@@ -147,7 +147,11 @@ type Driver interface {
 	// bound to the callback, and any resources will be cleaned up upon return.
 	WithDatumCache(func(*hashtree.MergeCache, *hashtree.MergeCache) error) error
 
-	Egress(commit *pfs.Commit, egressURL string) error
+	// Egress writes the contents of the given commit to the target described
+	// by egress. Targets that retry individual files (HTTP) call 'retrying'
+	// with the attempt that failed before each retry, and don't retry once a
+	// file has failed more than HTTPEgressRetries times.
+	Egress(commit *pfs.Commit, egress *pps.Egress, retrying func(attempt int, err error, d time.Duration)) error
 }
 
 type driver struct {
@@ -187,6 +191,10 @@ type driver struct {
 	// These caches are used for storing and merging hashtrees from jobs until the
 	// job is complete
 	chunkCaches, chunkStatsCaches cache.WorkerCache
+
+	// vaultSecrets reads the pipeline's Vault-backed secrets, or is nil if the
+	// pipeline has none
+	vaultSecrets *vaultSecrets
 }
 
 // NewDriver constructs a Driver object using the given clients and pipeline
@@ -248,6 +256,10 @@ func NewDriver(
 		namespace:        namespace,
 	}
 
+	if result.vaultSecrets, err = newVaultSecrets(pipelineInfo); err != nil {
+		return nil, err
+	}
+
 	if pipelineInfo.Transform.User != "" {
 		user, err := lookupDockerUser(pipelineInfo.Transform.User)
 		if err != nil && !os.IsNotExist(err) {
@@ -466,7 +478,9 @@ func (d *driver) WithData(
 
 	// Download input data into a temporary directory
 	// This can be interrupted via the pachClient using driver.WithContext
+	span := timeline.StartFromContext(d.pachClient.Ctx(), "download")
 	dir, err := d.downloadData(logger, inputs, puller, stats, inputTree)
+	span.Finish(err)
 	// We run these cleanup functions no matter what, so that if
 	// downloadData partially succeeded, we still clean up the resources.
 	defer func() {
@@ -705,6 +719,8 @@ func (d *driver) RunUserCode(
 	rawDatumTimeout *types.Duration,
 ) (retErr error) {
 	ctx := d.pachClient.Ctx()
+	span := timeline.StartFromContext(ctx, "process")
+	defer func() { span.Finish(retErr) }()
 	d.reportUserCodeStats(logger)
 	defer func(start time.Time) { d.reportDeferredUserCodeStats(retErr, start, procStats, logger) }(time.Now())
 	logger.Logf("beginning to run user code")
@@ -728,6 +744,10 @@ func (d *driver) RunUserCode(
 	if len(d.pipelineInfo.Transform.Cmd) == 0 {
 		return errors.New("invalid pipeline transform, no command specified")
 	}
+	environ, err := d.withVaultSecrets(environ)
+	if err != nil {
+		return err
+	}
 
 	// Run user code
 	cmd := exec.CommandContext(ctx, d.pipelineInfo.Transform.Cmd[0], d.pipelineInfo.Transform.Cmd[1:]...)
@@ -741,7 +761,8 @@ func (d *driver) RunUserCode(
 		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
 	}
 	cmd.Dir = filepath.Join(d.rootDir, d.pipelineInfo.Transform.WorkingDir)
-	err := cmd.Start()
+	start := time.Now()
+	err = cmd.Start()
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -751,6 +772,11 @@ func (d *driver) RunUserCode(
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
+	if procStats != nil {
+		cpuSeconds, memoryByteSeconds := userCodeUsage(state, time.Since(start))
+		procStats.CpuSeconds += cpuSeconds
+		procStats.MemoryByteSeconds += memoryByteSeconds
+	}
 	if common.IsDone(ctx) {
 		if err = ctx.Err(); err != nil {
 			return errors.EnsureStack(err)
@@ -796,6 +822,10 @@ func (d *driver) RunUserErrorHandlingCode(logger logs.TaggedLogger, environ []st
 		}
 	}(time.Now())
 
+	environ, err := d.withVaultSecrets(environ)
+	if err != nil {
+		return err
+	}
 	cmd := exec.CommandContext(ctx, d.pipelineInfo.Transform.ErrCmd[0], d.pipelineInfo.Transform.ErrCmd[1:]...)
 	if d.pipelineInfo.Transform.ErrStdin != nil {
 		cmd.Stdin = strings.NewReader(strings.Join(d.pipelineInfo.Transform.ErrStdin, "\n") + "\n")
@@ -807,7 +837,7 @@ func (d *driver) RunUserErrorHandlingCode(logger logs.TaggedLogger, environ []st
 		cmd.SysProcAttr = makeCmdCredentials(*d.uid, *d.gid)
 	}
 	cmd.Dir = d.pipelineInfo.Transform.WorkingDir
-	err := cmd.Start()
+	err = cmd.Start()
 	if err != nil {
 		return errors.EnsureStack(err)
 	}
@@ -1029,6 +1059,8 @@ func (d *driver) UploadOutput(
 	stats *pps.ProcessStats,
 	statsTree *hashtree.Ordered,
 ) (retBuffer []byte, retErr error) {
+	span := timeline.StartFromContext(d.pachClient.Ctx(), "upload")
+	defer func() { span.Finish(retErr) }()
 	defer d.ReportUploadStats(time.Now(), stats, logger)
 	logger.Logf("starting to upload output")
 	defer func(start time.Time) {
@@ -1283,20 +1315,3 @@ func (d *driver) UserCodeEnv(
 
 	return result
 }
-
-func (d *driver) Egress(commit *pfs.Commit, egressURL string) error {
-	// copy the pach client (preserving auth info) so we can set a different
-	// number of concurrent streams
-	pachClient := d.PachClient().WithCtx(d.PachClient().Ctx())
-	pachClient.SetMaxConcurrentStreams(100)
-
-	url, err := obj.ParseURL(egressURL)
-	if err != nil {
-		return err
-	}
-	objClient, err := obj.NewClientFromURLAndSecret(url, false)
-	if err != nil {
-		return err
-	}
-	return filesync.PushObj(pachClient, commit, objClient, url.Object)
-}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -27,6 +28,18 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	}
 }
 
+// userCodeUsage returns the CPU seconds and memory byte-seconds used by a
+// finished user code process that ran for 'elapsed'.
+func userCodeUsage(state *os.ProcessState, elapsed time.Duration) (float64, float64) {
+	cpuSeconds := (state.UserTime() + state.SystemTime()).Seconds()
+	var memoryByteSeconds float64
+	if rusage, ok := state.SysUsage().(*syscall.Rusage); ok {
+		// Maxrss is in kilobytes on linux
+		memoryByteSeconds = float64(rusage.Maxrss) * 1024 * elapsed.Seconds()
+	}
+	return cpuSeconds, memoryByteSeconds
+}
+
 // WithActiveData is implemented differently in unix vs windows because of how
 // symlinks work on windows. Here, we create symlinks to the scratch space
 // directory, then clean up before returning.
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
@@ -19,6 +20,10 @@ func makeCmdCredentials(uid uint32, gid uint32) *syscall.SysProcAttr {
 	return nil
 }
 
+func userCodeUsage(state *os.ProcessState, elapsed time.Duration) (float64, float64) {
+	return (state.UserTime() + state.SystemTime()).Seconds(), 0
+}
+
 // Note: this function only exists for tests, the real system uses a fifo for
 // this (which does not exist in the normal filesystem on Windows)
 func createSpoutFifo(path string) error {
@@ -0,0 +1,487 @@
+package driver
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	// Register the MySQL driver with database/sql; lib/pq is used directly.
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	pgdump "github.com/pachyderm/pachyderm/src/server/pkg/sql"
+	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+)
+
+// HTTPEgressPathHeader is the header that carries the path of the file being
+// sent by an HTTP egress.
+const HTTPEgressPathHeader = "Pach-File-Path"
+
+// HTTPEgressRetries is the number of times an HTTP egress retries sending a
+// file before failing. Files are retried individually, so that files that
+// were already delivered aren't sent again.
+const HTTPEgressRetries = 3
+
+// httpEgressClient is used to send files to HTTP egress targets. The timeout
+// bounds the time spent sending a single file, including reading its
+// contents from PFS.
+var httpEgressClient = &http.Client{Timeout: 10 * time.Minute}
+
+func (d *driver) Egress(commit *pfs.Commit, egress *pps.Egress, retrying func(attempt int, err error, d time.Duration)) error {
+	// copy the pach client (preserving auth info) so we can set a different
+	// number of concurrent streams
+	pachClient := d.PachClient().WithCtx(d.PachClient().Ctx())
+	pachClient.SetMaxConcurrentStreams(100)
+
+	switch {
+	case egress.SqlDatabase != nil:
+		return egressSQL(pachClient, commit, egress.SqlDatabase)
+	case egress.Http != nil:
+		return egressHTTP(pachClient, commit, egress.Http, retrying)
+	default:
+		return egressObj(pachClient, commit, egress.URL)
+	}
+}
+
+func egressObj(pachClient *client.APIClient, commit *pfs.Commit, egressURL string) error {
+	url, err := obj.ParseURL(egressURL)
+	if err != nil {
+		return err
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(url, false)
+	if err != nil {
+		return err
+	}
+	return filesync.PushObj(pachClient, commit, objClient, url.Object)
+}
+
+// walkFiles calls cb with a reader for the contents of every file in commit,
+// in path order.
+func walkFiles(pachClient *client.APIClient, commit *pfs.Commit, cb func(path string, r io.Reader) error) error {
+	return pachClient.Walk(commit.Repo.Name, commit.ID, "", func(fileInfo *pfs.FileInfo) error {
+		if fileInfo.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		r := getFile(pachClient, commit, fileInfo.File.Path)
+		err := cb(fileInfo.File.Path, r)
+		r.Close()
+		return errors.Wrapf(err, "error egressing %q", fileInfo.File.Path)
+	})
+}
+
+// getFile returns a reader for the contents of the file at 'path' in commit.
+// Closing the reader unblocks the request for the file if it wasn't read to
+// the end.
+func getFile(pachClient *client.APIClient, commit *pfs.Commit, path string) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(pachClient.GetFile(commit.Repo.Name, commit.ID, path, 0, 0, pw))
+	}()
+	return pr
+}
+
+func egressHTTP(pachClient *client.APIClient, commit *pfs.Commit, egress *pps.HTTPEgress, retrying func(attempt int, err error, d time.Duration)) error {
+	return pachClient.Walk(commit.Repo.Name, commit.ID, "", func(fileInfo *pfs.FileInfo) error {
+		if fileInfo.FileType != pfs.FileType_FILE {
+			return nil
+		}
+		path := fileInfo.File.Path
+		var attempts int
+		err := backoff.RetryNotify(func() error {
+			// Each attempt reads the file from the beginning
+			attempts++
+			r := getFile(pachClient, commit, path)
+			defer r.Close()
+			return postFile(pachClient, egress, path, r)
+		}, backoff.NewExponentialBackOff(), func(err error, d time.Duration) error {
+			if attempts > HTTPEgressRetries {
+				return err
+			}
+			if retrying != nil {
+				retrying(attempts, errors.Wrapf(err, "error egressing %q", path), d)
+			}
+			return nil
+		})
+		return errors.Wrapf(err, "error egressing %q after %d attempt(s)", path, attempts)
+	})
+}
+
+// postFile sends the contents of the file at 'path' to an HTTP egress target
+func postFile(pachClient *client.APIClient, egress *pps.HTTPEgress, path string, r io.Reader) error {
+	req, err := http.NewRequest(http.MethodPost, egress.Url, r)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(pachClient.Ctx())
+	for k, v := range egress.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set(HTTPEgressPathHeader, path)
+	resp, err := httpEgressClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return errors.Errorf("%s returned %s: %s", egress.Url, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// sqlDSN converts the URL of an SQL egress into a database/sql driver name and
+// data source name.
+func sqlDSN(egress *pps.SQLEgress) (string, string, error) {
+	u, err := url.Parse(egress.Url)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "could not parse SQL egress URL")
+	}
+	if egress.PasswordEnvVar != "" {
+		password, ok := os.LookupEnv(egress.PasswordEnvVar)
+		if !ok {
+			return "", "", errors.Errorf("environment variable %q is not set", egress.PasswordEnvVar)
+		}
+		var user string
+		if u.User != nil {
+			user = u.User.Username()
+		}
+		u.User = url.UserPassword(user, password)
+	}
+	switch u.Scheme {
+	case "postgres", "postgresql":
+		return "postgres", u.String(), nil
+	case "mysql":
+		// go-sql-driver/mysql doesn't accept URLs, it wants
+		// user:password@tcp(host:port)/db?params
+		var userInfo string
+		if u.User != nil {
+			userInfo = u.User.Username()
+			if password, ok := u.User.Password(); ok {
+				userInfo += ":" + password
+			}
+			userInfo += "@"
+		}
+		dsn := fmt.Sprintf("%stcp(%s)%s", userInfo, u.Host, u.Path)
+		if u.RawQuery != "" {
+			dsn += "?" + u.RawQuery
+		}
+		return "mysql", dsn, nil
+	default:
+		return "", "", errors.Errorf("unsupported SQL egress scheme %q, must be postgres or mysql", u.Scheme)
+	}
+}
+
+// egressSQL loads every file in commit into the database in a single
+// transaction, so that a failed egress can be retried without duplicating
+// rows.
+func egressSQL(pachClient *client.APIClient, commit *pfs.Commit, egress *pps.SQLEgress) (retErr error) {
+	driverName, dsn, err := sqlDSN(egress)
+	if err != nil {
+		return err
+	}
+	if egress.FileFormat == pps.SQLEgress_PGDUMP && driverName != "postgres" {
+		return errors.Errorf("pgdump files can only be egressed to postgres")
+	}
+	db, err := sql.Open(driverName, dsn)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	tx, err := db.BeginTx(pachClient.Ctx(), nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			tx.Rollback()
+		}
+	}()
+	if err := walkFiles(pachClient, commit, func(path string, r io.Reader) error {
+		rows, err := newSQLRowReader(egress.FileFormat, r)
+		if err != nil {
+			return err
+		}
+		table := egress.Table
+		if table == "" {
+			table = rows.Table()
+		}
+		if table == "" {
+			table = sqlTableFromPath(path)
+		}
+		return loadSQLRows(tx, driverName, table, rows)
+	}); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// sqlTableFromPath returns the table that the file at 'p' is loaded into when
+// no table is given explicitly: the first path component, minus any
+// extension.
+func sqlTableFromPath(p string) string {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if i := strings.Index(p, "/"); i >= 0 {
+		p = p[:i]
+	}
+	return strings.TrimSuffix(p, path.Ext(p))
+}
+
+func loadSQLRows(tx *sql.Tx, driverName, table string, rows sqlRowReader) (retErr error) {
+	columns := rows.Columns()
+	var query string
+	if driverName == "postgres" {
+		schema, name := "", table
+		if i := strings.Index(table, "."); i >= 0 {
+			schema, name = table[:i], table[i+1:]
+		}
+		if schema != "" {
+			query = pq.CopyInSchema(schema, name, columns...)
+		} else {
+			query = pq.CopyIn(name, columns...)
+		}
+	} else {
+		quoted := make([]string, len(columns))
+		for i, c := range columns {
+			quoted[i] = quoteMySQLIdentifier(c)
+		}
+		query = fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+			quoteMySQLTable(table),
+			strings.Join(quoted, ", "),
+			strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", "))
+	}
+	stmt, err := tx.Prepare(query)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	for {
+		values, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := stmt.Exec(values...); err != nil {
+			return err
+		}
+	}
+	if driverName == "postgres" {
+		// An Exec with no arguments flushes the COPY
+		if _, err := stmt.Exec(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func quoteMySQLIdentifier(name string) string {
+	return "`" + strings.Replace(name, "`", "``", -1) + "`"
+}
+
+// quoteMySQLTable quotes a table name, which may be qualified with its
+// database (e.g. "db.table"), for use in a MySQL statement
+func quoteMySQLTable(table string) string {
+	parts := strings.SplitN(table, ".", 2)
+	for i, part := range parts {
+		parts[i] = quoteMySQLIdentifier(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// sqlRowReader reads the rows of a single file being egressed to a database.
+type sqlRowReader interface {
+	// Table returns the table named in the file itself, if any.
+	Table() string
+	Columns() []string
+	// Next returns the values of the next row, in the same order as
+	// Columns(), or io.EOF.
+	Next() ([]interface{}, error)
+}
+
+func newSQLRowReader(format pps.SQLEgress_FileFormat, r io.Reader) (sqlRowReader, error) {
+	switch format {
+	case pps.SQLEgress_CSV:
+		return newCSVRowReader(r)
+	case pps.SQLEgress_JSON:
+		return newJSONRowReader(r)
+	case pps.SQLEgress_PGDUMP:
+		return newPGDumpRowReader(r)
+	default:
+		return nil, errors.Errorf("unsupported SQL egress file format %v", format)
+	}
+}
+
+type csvRowReader struct {
+	r       *csv.Reader
+	columns []string
+}
+
+func newCSVRowReader(r io.Reader) (*csvRowReader, error) {
+	cr := csv.NewReader(r)
+	columns, err := cr.Read()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read CSV header")
+	}
+	return &csvRowReader{r: cr, columns: columns}, nil
+}
+
+func (c *csvRowReader) Table() string     { return "" }
+func (c *csvRowReader) Columns() []string { return c.columns }
+
+func (c *csvRowReader) Next() ([]interface{}, error) {
+	record, err := c.r.Read()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]interface{}, len(record))
+	for i, v := range record {
+		values[i] = v
+	}
+	return values, nil
+}
+
+// jsonRowReader reads a stream of JSON objects. The columns are the keys of
+// the first object; keys missing from later objects are loaded as NULL.
+type jsonRowReader struct {
+	d       *json.Decoder
+	columns []string
+	first   map[string]interface{}
+}
+
+func newJSONRowReader(r io.Reader) (*jsonRowReader, error) {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	var first map[string]interface{}
+	if err := d.Decode(&first); err != nil {
+		return nil, errors.Wrapf(err, "could not read first JSON object")
+	}
+	columns := make([]string, 0, len(first))
+	for k := range first {
+		columns = append(columns, k)
+	}
+	sort.Strings(columns)
+	return &jsonRowReader{d: d, columns: columns, first: first}, nil
+}
+
+func (j *jsonRowReader) Table() string     { return "" }
+func (j *jsonRowReader) Columns() []string { return j.columns }
+
+func (j *jsonRowReader) Next() ([]interface{}, error) {
+	object := j.first
+	j.first = nil
+	if object == nil {
+		if err := j.d.Decode(&object); err != nil {
+			return nil, err
+		}
+	}
+	values := make([]interface{}, len(j.columns))
+	for i, c := range j.columns {
+		switch v := object[c].(type) {
+		case nil, string, bool:
+			values[i] = v
+		case json.Number:
+			values[i] = v.String()
+		default:
+			// Nested objects and arrays are loaded as JSON text
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = string(b)
+		}
+	}
+	return values, nil
+}
+
+var copyStatement = regexp.MustCompile(`(?m)^COPY\s+(\S+)\s*\(([^)]*)\)\s+FROM\s+stdin;`)
+
+// pgDumpRowReader reads the rows of the COPY statement in a pg_dump file.
+type pgDumpRowReader struct {
+	r       *pgdump.PGDumpReader
+	table   string
+	columns []string
+	first   []byte
+}
+
+func newPGDumpRowReader(r io.Reader) (*pgDumpRowReader, error) {
+	pr := pgdump.NewPGDumpReader(bufio.NewReader(r))
+	// Reading the first row populates the header
+	first, err := pr.ReadRow()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	match := copyStatement.FindSubmatch(pr.Header)
+	if match == nil {
+		return nil, errors.Errorf("pgdump header does not contain a COPY statement")
+	}
+	var columns []string
+	for _, c := range strings.Split(string(match[2]), ",") {
+		columns = append(columns, strings.Trim(strings.TrimSpace(c), `"`))
+	}
+	return &pgDumpRowReader{
+		r:       pr,
+		table:   strings.Replace(string(match[1]), `"`, "", -1),
+		columns: columns,
+		first:   first,
+	}, nil
+}
+
+func (p *pgDumpRowReader) Table() string     { return p.table }
+func (p *pgDumpRowReader) Columns() []string { return p.columns }
+
+func (p *pgDumpRowReader) Next() ([]interface{}, error) {
+	row := p.first
+	p.first = nil
+	if row == nil {
+		var err error
+		if row, err = p.r.ReadRow(); err != nil {
+			return nil, err
+		}
+		if row == nil {
+			// The end-of-data marker was read, only the footer remains
+			return nil, io.EOF
+		}
+	}
+	fields := strings.Split(strings.TrimSuffix(string(row), "\n"), "\t")
+	if len(fields) != len(p.columns) {
+		return nil, errors.Errorf("pgdump row has %d fields, expected %d", len(fields), len(p.columns))
+	}
+	values := make([]interface{}, len(fields))
+	for i, f := range fields {
+		if f == `\N` {
+			continue
+		}
+		values[i] = unescapeCopyText(f)
+	}
+	return values, nil
+}
+
+var copyTextEscapes = strings.NewReplacer(`\\`, `\`, `\t`, "\t", `\n`, "\n", `\r`, "\r")
+
+// unescapeCopyText undoes the backslash escaping of COPY's text format.
+func unescapeCopyText(s string) string {
+	return copyTextEscapes.Replace(s)
+}
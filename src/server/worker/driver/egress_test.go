@@ -0,0 +1,103 @@
+package driver
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func readAllRows(t *testing.T, rows sqlRowReader) [][]interface{} {
+	var result [][]interface{}
+	for {
+		values, err := rows.Next()
+		if errors.Is(err, io.EOF) {
+			return result
+		}
+		require.NoError(t, err)
+		result = append(result, values)
+	}
+}
+
+func TestSQLTableFromPath(t *testing.T) {
+	require.Equal(t, "users", sqlTableFromPath("/users.csv"))
+	require.Equal(t, "users", sqlTableFromPath("/users/part-1.csv"))
+	require.Equal(t, "users", sqlTableFromPath("users"))
+}
+
+func TestSQLDSN(t *testing.T) {
+	require.NoError(t, os.Setenv("TEST_SQL_EGRESS_PASSWORD", "secret"))
+	defer os.Unsetenv("TEST_SQL_EGRESS_PASSWORD")
+
+	driverName, dsn, err := sqlDSN(&pps.SQLEgress{
+		Url:            "postgres://pach@db:5432/data?sslmode=disable",
+		PasswordEnvVar: "TEST_SQL_EGRESS_PASSWORD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "postgres", driverName)
+	require.Equal(t, "postgres://pach:secret@db:5432/data?sslmode=disable", dsn)
+
+	driverName, dsn, err = sqlDSN(&pps.SQLEgress{
+		Url:            "mysql://pach@db:3306/data?parseTime=true",
+		PasswordEnvVar: "TEST_SQL_EGRESS_PASSWORD",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "mysql", driverName)
+	require.Equal(t, "pach:secret@tcp(db:3306)/data?parseTime=true", dsn)
+
+	_, _, err = sqlDSN(&pps.SQLEgress{Url: "sqlite:///tmp/db"})
+	require.YesError(t, err)
+	_, _, err = sqlDSN(&pps.SQLEgress{Url: "postgres://db/data", PasswordEnvVar: "TEST_SQL_EGRESS_MISSING"})
+	require.YesError(t, err)
+}
+
+func TestCSVRowReader(t *testing.T) {
+	rows, err := newSQLRowReader(pps.SQLEgress_CSV, strings.NewReader("id,name\n1,foo\n2,bar\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name"}, rows.Columns())
+	require.Equal(t, [][]interface{}{{"1", "foo"}, {"2", "bar"}}, readAllRows(t, rows))
+}
+
+func TestJSONRowReader(t *testing.T) {
+	rows, err := newSQLRowReader(pps.SQLEgress_JSON, strings.NewReader(`
+{"name": "foo", "id": 1, "tags": ["a"]}
+{"id": 2.5, "name": null}
+`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name", "tags"}, rows.Columns())
+	require.Equal(t, [][]interface{}{
+		{"1", "foo", `["a"]`},
+		{"2.5", nil, nil},
+	}, readAllRows(t, rows))
+}
+
+func TestPGDumpRowReader(t *testing.T) {
+	dump := `--
+-- PostgreSQL database dump
+--
+
+COPY public.users (id, name) FROM stdin;
+1	foo\tbar
+2	\N
+\.
+
+--
+-- PostgreSQL database dump complete
+--
+`
+	rows, err := newSQLRowReader(pps.SQLEgress_PGDUMP, strings.NewReader(dump))
+	require.NoError(t, err)
+	require.Equal(t, "public.users", rows.Table())
+	require.Equal(t, []string{"id", "name"}, rows.Columns())
+	require.Equal(t, [][]interface{}{{"1", "foo\tbar"}, {"2", nil}}, readAllRows(t, rows))
+}
+
+func TestQuoteMySQLTable(t *testing.T) {
+	require.Equal(t, "`users`", quoteMySQLTable("users"))
+	require.Equal(t, "`db`.`users`", quoteMySQLTable("db.users"))
+	require.Equal(t, "`db`.```users```", quoteMySQLTable("db.`users`"))
+}
@@ -0,0 +1,237 @@
+package driver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sync"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// serviceAccountTokenPath is where Kubernetes mounts the worker's service
+// account token, which the worker uses to log in to Vault
+const serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// vaultSecrets reads a pipeline's Vault-backed secrets (SecretMounts with
+// VaultPath set) before each datum, and keeps the leases governing them (and
+// the worker's own Vault token) alive across datums.
+type vaultSecrets struct {
+	client   *vault.Client
+	authRole string // Vault role that the worker logs in as (see vaultAuthRole)
+	authPath string // mount path of Vault's Kubernetes auth method
+
+	// mu protects the fields below
+	mu sync.Mutex
+	// tokenExpires is when the worker's Vault token expires (zero if it doesn't)
+	tokenExpires time.Time
+	// leases holds the most recently read secret at each Vault path
+	leases map[string]*vaultLease
+}
+
+// vaultLease is a secret read from Vault, along with the ID, duration, and last
+// renew time of the lease that governs it
+type vaultLease struct {
+	data      map[string]interface{}
+	id        string
+	renewable bool
+	lastRenew time.Time
+	duration  time.Duration
+}
+
+// stale returns true if 'l' is past the midpoint of its lease (or has no lease
+// at all, as with static secrets) and should be renewed or re-read
+func (l *vaultLease) stale() bool {
+	if l.duration == 0 {
+		return true
+	}
+	return time.Now().After(l.lastRenew.Add(l.duration / 2))
+}
+
+// newVaultSecrets returns a vaultSecrets for 'pipelineInfo', or nil if the
+// pipeline has no Vault-backed secrets. The Vault address and auth settings
+// are read from the env vars that pachd sets in the worker's pod spec.
+func newVaultSecrets(pipelineInfo *pps.PipelineInfo) (*vaultSecrets, error) {
+	if pipelineInfo.Transform == nil {
+		return nil, nil
+	}
+	var hasVaultSecrets bool
+	for _, secret := range pipelineInfo.Transform.Secrets {
+		hasVaultSecrets = hasVaultSecrets || secret.VaultPath != ""
+	}
+	if !hasVaultSecrets {
+		return nil, nil
+	}
+	address := os.Getenv(client.VaultAddressEnv)
+	if address == "" {
+		return nil, errors.Errorf("pipeline has vault secrets, but %s is not set", client.VaultAddressEnv)
+	}
+	// vault.NewClient picks up VAULT_TOKEN, if set, which is used as-is
+	// instead of logging in
+	vaultClient, err := vault.NewClient(&vault.Config{
+		Address: address,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating vault client")
+	}
+	authPath := os.Getenv(client.VaultAuthPathEnv)
+	if authPath == "" {
+		authPath = "kubernetes"
+	}
+	return &vaultSecrets{
+		client:   vaultClient,
+		authRole: vaultAuthRole(pipelineInfo),
+		authPath: authPath,
+		leases:   make(map[string]*vaultLease),
+	}, nil
+}
+
+// vaultAuthRole returns the Vault role that the workers of 'pipelineInfo' log
+// in as. It's derived from the pipeline's name, rather than set by pachd in
+// the worker's environment, so that a pipeline can't log in as another
+// pipeline's role by overriding its env vars.
+func vaultAuthRole(pipelineInfo *pps.PipelineInfo) string {
+	return os.Getenv(client.VaultAuthRolePrefixEnv) + pipelineInfo.Pipeline.Name
+}
+
+// login logs in to Vault using the worker's Kubernetes service account token,
+// if the worker has no Vault token or its token is about to expire (a token
+// from VAULT_TOKEN is used as-is). The caller must hold v.mu.
+func (v *vaultSecrets) login() error {
+	if v.client.Token() != "" && (v.tokenExpires.IsZero() || time.Now().Add(time.Minute).Before(v.tokenExpires)) {
+		return nil
+	}
+	jwt, err := ioutil.ReadFile(serviceAccountTokenPath)
+	if err != nil {
+		return errors.Wrapf(err, "could not read service account token")
+	}
+	secret, err := v.client.Logical().Write(path.Join("auth", v.authPath, "login"), map[string]interface{}{
+		"role": v.authRole,
+		"jwt":  string(jwt),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "could not log in to vault as %q", v.authRole)
+	}
+	if secret == nil || secret.Auth == nil {
+		return errors.Errorf("vault login response did not contain a token")
+	}
+	v.client.SetToken(secret.Auth.ClientToken)
+	v.tokenExpires = time.Time{}
+	if secret.Auth.LeaseDuration > 0 {
+		v.tokenExpires = time.Now().Add(time.Duration(secret.Auth.LeaseDuration) * time.Second)
+	}
+	return nil
+}
+
+// get returns the data of the secret at 'vaultPath', renewing its lease or
+// re-reading it if the lease is past its midpoint
+func (v *vaultSecrets) get(vaultPath string) (map[string]interface{}, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if err := v.login(); err != nil {
+		return nil, err
+	}
+	l, ok := v.leases[vaultPath]
+	if ok && !l.stale() {
+		return l.data, nil
+	}
+	if ok && l.renewable && l.id != "" {
+		secret, err := v.client.Sys().Renew(l.id, 0)
+		// If the renewed lease is much shorter than before, it's approaching its
+		// max TTL, so read a new secret instead
+		if err == nil && secret != nil && time.Duration(secret.LeaseDuration)*time.Second >= l.duration/2 {
+			l.lastRenew = time.Now()
+			l.duration = time.Duration(secret.LeaseDuration) * time.Second
+			return l.data, nil
+		}
+	}
+	secret, err := v.client.Logical().Read(vaultPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read secret %q from vault", vaultPath)
+	}
+	if secret == nil {
+		return nil, errors.Errorf("no secret at %q in vault", vaultPath)
+	}
+	l = &vaultLease{
+		data:      secret.Data,
+		id:        secret.LeaseID,
+		renewable: secret.Renewable,
+		lastRenew: time.Now(),
+		duration:  time.Duration(secret.LeaseDuration) * time.Second,
+	}
+	// Secrets in version 2 of the KV engine are nested under "data"
+	if nested, ok := secret.Data["data"].(map[string]interface{}); ok {
+		if _, ok := secret.Data["metadata"]; ok {
+			l.data = nested
+		}
+	}
+	v.leases[vaultPath] = l
+	return l.data, nil
+}
+
+// vaultValue converts a field of a Vault secret to the string exposed to user
+// code
+func vaultValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return "", errors.EnsureStack(err)
+	}
+	return string(b), nil
+}
+
+// withVaultSecrets reads the pipeline's Vault-backed secrets and exposes them
+// to user code, appending env vars to 'environ' and writing each field of
+// secrets with a mount path to a file under that path
+func (d *driver) withVaultSecrets(environ []string) ([]string, error) {
+	if d.vaultSecrets == nil {
+		return environ, nil
+	}
+	for _, secret := range d.pipelineInfo.Transform.Secrets {
+		if secret.VaultPath == "" {
+			continue
+		}
+		data, err := d.vaultSecrets.get(secret.VaultPath)
+		if err != nil {
+			return nil, err
+		}
+		if secret.EnvVar != "" {
+			value, ok := data[secret.Key]
+			if !ok {
+				return nil, errors.Errorf("vault secret %q has no key %q", secret.VaultPath, secret.Key)
+			}
+			s, err := vaultValue(value)
+			if err != nil {
+				return nil, err
+			}
+			environ = append(environ, fmt.Sprintf("%s=%s", secret.EnvVar, s))
+		}
+		if secret.MountPath != "" {
+			mountPath := filepath.Join(d.rootDir, secret.MountPath)
+			if err := os.MkdirAll(mountPath, 0755); err != nil {
+				return nil, errors.EnsureStack(err)
+			}
+			for key, value := range data {
+				s, err := vaultValue(value)
+				if err != nil {
+					return nil, err
+				}
+				// 0644 matches the default mode of Kubernetes secret volumes
+				if err := ioutil.WriteFile(filepath.Join(mountPath, key), []byte(s), 0644); err != nil {
+					return nil, errors.EnsureStack(err)
+				}
+			}
+		}
+	}
+	return environ, nil
+}
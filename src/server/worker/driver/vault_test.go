@@ -0,0 +1,90 @@
+package driver
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestWithVaultSecrets(t *testing.T) {
+	var reads int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reads++
+		var resp map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/secret/data/db":
+			// KV v2 secret, with no lease
+			resp = map[string]interface{}{"data": map[string]interface{}{
+				"data":     map[string]interface{}{"password": "hunter2"},
+				"metadata": map[string]interface{}{"version": 1},
+			}}
+		case "/v1/pachyderm/creds/edges":
+			resp = map[string]interface{}{
+				"lease_id":       "pachyderm/creds/edges/abc",
+				"lease_duration": 600,
+				"renewable":      true,
+				"data":           map[string]interface{}{"user_token": "token", "pachd_address": "pachd:650"},
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer server.Close()
+
+	vaultClient, err := vault.NewClient(&vault.Config{Address: server.URL})
+	require.NoError(t, err)
+	vaultClient.SetToken("root")
+	rootDir, err := ioutil.TempDir("", "vault-secrets")
+	require.NoError(t, err)
+	defer os.RemoveAll(rootDir)
+	d := &driver{
+		pipelineInfo: &pps.PipelineInfo{Transform: &pps.Transform{Secrets: []*pps.SecretMount{
+			{VaultPath: "secret/data/db", Key: "password", EnvVar: "DB_PASSWORD"},
+			{VaultPath: "pachyderm/creds/edges", MountPath: "/pach-creds"},
+		}}},
+		rootDir: rootDir,
+		vaultSecrets: &vaultSecrets{
+			client: vaultClient,
+			leases: make(map[string]*vaultLease),
+		},
+	}
+
+	environ, err := d.withVaultSecrets([]string{"FOO=bar"})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{"FOO=bar", "DB_PASSWORD=hunter2"}, environ)
+	token, err := ioutil.ReadFile(filepath.Join(rootDir, "pach-creds", "user_token"))
+	require.NoError(t, err)
+	require.Equal(t, "token", string(token))
+	require.Equal(t, 2, reads)
+
+	// The KV secret has no lease and is re-read for every datum, but the
+	// leased secret is reused until its lease is half over
+	_, err = d.withVaultSecrets(nil)
+	require.NoError(t, err)
+	require.Equal(t, 3, reads)
+
+	// Missing secrets are reported
+	d.pipelineInfo.Transform.Secrets = []*pps.SecretMount{{VaultPath: "secret/data/missing", Key: "k", EnvVar: "K"}}
+	_, err = d.withVaultSecrets(nil)
+	require.YesError(t, err)
+}
+
+func TestVaultAuthRole(t *testing.T) {
+	defer os.Setenv(client.VaultAuthRolePrefixEnv, os.Getenv(client.VaultAuthRolePrefixEnv))
+	os.Setenv(client.VaultAuthRolePrefixEnv, "pachyderm-")
+	// Each pipeline's workers log in as their own role
+	require.Equal(t, "pachyderm-edges", vaultAuthRole(&pps.PipelineInfo{Pipeline: &pps.Pipeline{Name: "edges"}}))
+	require.Equal(t, "pachyderm-montage", vaultAuthRole(&pps.PipelineInfo{Pipeline: &pps.Pipeline{Name: "montage"}}))
+}
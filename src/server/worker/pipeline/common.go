@@ -76,55 +76,69 @@ func ReceiveSpout(
 			retErr = err
 		}
 	}()
-	cancelCtx, cancel := context.WithCancel(ctx)
-	repo := pipelineInfo.Pipeline.Name
 	for {
 		if err := withTmpFile("pachyderm_spout_commit", func(f *os.File) error {
 			if err := getNextTarStream(f, out); err != nil {
 				return err
 			}
-			return withSpoutCommit(cancelCtx, pachClient, pipelineInfo, logger, f, func(commit *pfs.Commit, tr *tar.Reader) error {
-				for {
-					hdr, err := tr.Next()
-					if err != nil {
-						if errors.Is(err, io.EOF) {
-							return nil
-						}
+			return CommitSpoutTar(ctx, pachClient, pipelineInfo, logger, f)
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// CommitSpoutTar writes the tar stream in f to a new output commit of a spout
+// pipeline, retrying until it succeeds or ctx is canceled. Files under the
+// spout's marker are also written to the marker branch.
+func CommitSpoutTar(
+	ctx context.Context,
+	pachClient *client.APIClient,
+	pipelineInfo *pps.PipelineInfo,
+	logger logs.TaggedLogger,
+	f *os.File,
+) error {
+	cancelCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	repo := pipelineInfo.Pipeline.Name
+	return withSpoutCommit(cancelCtx, pachClient, pipelineInfo, logger, f, func(commit *pfs.Commit, tr *tar.Reader) error {
+		for {
+			hdr, err := tr.Next()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return nil
+				}
+				return err
+			}
+			if err := withSpoutFile(cancelCtx, logger, tr, func(r io.Reader) error {
+				if pipelineInfo.Spout.Marker != "" && strings.HasPrefix(path.Clean(hdr.Name), pipelineInfo.Spout.Marker) {
+					// Check to see if this spout is the latest version of this spout by seeing if its spec commit has any children.
+					// TODO: There is a race condition here where the spout could be updated after this check, but before the PutFileOverwrite.
+					spec, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineInfo.SpecCommit.ID)
+					if err != nil && !errutil.IsNotFoundError(err) {
 						return err
 					}
-					if err := withSpoutFile(cancelCtx, logger, tr, func(r io.Reader) error {
-						if pipelineInfo.Spout.Marker != "" && strings.HasPrefix(path.Clean(hdr.Name), pipelineInfo.Spout.Marker) {
-							// Check to see if this spout is the latest version of this spout by seeing if its spec commit has any children.
-							// TODO: There is a race condition here where the spout could be updated after this check, but before the PutFileOverwrite.
-							spec, err := pachClient.InspectCommit(ppsconsts.SpecRepo, pipelineInfo.SpecCommit.ID)
-							if err != nil && !errutil.IsNotFoundError(err) {
-								return err
-							}
-							if spec != nil && len(spec.ChildCommits) != 0 {
-								cancel()
-								return errors.New("outdated spout, now shutting down")
-							}
-							_, err = pachClient.PutFileOverwrite(repo, ppsconsts.SpoutMarkerBranch, hdr.Name, r, 0)
-							if err != nil {
-								return err
-							}
-						} else if pipelineInfo.Spout.Overwrite {
-							_, err := pachClient.PutFileOverwrite(repo, commit.ID, hdr.Name, r, 0)
-							if err != nil {
-								return err
-							}
-						}
-						_, err := pachClient.PutFile(repo, commit.ID, hdr.Name, r)
+					if spec != nil && len(spec.ChildCommits) != 0 {
+						cancel()
+						return errors.New("outdated spout, now shutting down")
+					}
+					_, err = pachClient.PutFileOverwrite(repo, ppsconsts.SpoutMarkerBranch, hdr.Name, r, 0)
+					if err != nil {
 						return err
-					}); err != nil {
+					}
+				} else if pipelineInfo.Spout.Overwrite {
+					_, err := pachClient.PutFileOverwrite(repo, commit.ID, hdr.Name, r, 0)
+					if err != nil {
 						return err
 					}
 				}
-			})
-		}); err != nil {
-			return err
+				_, err := pachClient.PutFile(repo, commit.ID, hdr.Name, r)
+				return err
+			}); err != nil {
+				return err
+			}
 		}
-	}
+	})
 }
 
 // TODO: Refactor into a file util package.
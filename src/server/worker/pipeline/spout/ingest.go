@@ -0,0 +1,93 @@
+package spout
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+// ingestSource accepts data pushed to the spout over HTTP or TCP.
+type ingestSource struct {
+	spec *pps.IngestSpoutSource
+	port int32
+}
+
+func (i *ingestSource) Run(ctx context.Context, _ map[string]int64, msgs chan<- *message) error {
+	l, err := net.Listen("tcp", fmt.Sprintf(":%d", i.port))
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+	if i.spec.Protocol == pps.IngestSpoutSource_TCP {
+		return serveTCP(ctx, l, msgs)
+	}
+	server := &http.Server{Handler: ingestHandler(ctx, msgs)}
+	if err := server.Serve(l); err != nil && ctx.Err() == nil {
+		return err
+	}
+	return ctx.Err()
+}
+
+// ingestHandler sends the body of every POST request to msgs, and responds
+// once the message has been committed.
+func ingestHandler(ctx context.Context, msgs chan<- *message) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		data, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		p := path.Clean(r.URL.Path)
+		if p == "/" || p == "." {
+			p = "/" + uuid.NewWithoutDashes()
+		}
+		m := &message{path: p, data: data, done: make(chan error, 1)}
+		select {
+		case msgs <- m:
+		case <-ctx.Done():
+			http.Error(w, "spout is shutting down", http.StatusServiceUnavailable)
+			return
+		}
+		if err := <-m.done; err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	})
+}
+
+// serveTCP sends every line received on a connection accepted by l to msgs.
+func serveTCP(ctx context.Context, l net.Listener, msgs chan<- *message) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				select {
+				case msgs <- &message{path: "/" + uuid.NewWithoutDashes(), data: append([]byte(nil), scanner.Bytes()...)}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+}
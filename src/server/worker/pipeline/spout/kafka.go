@@ -0,0 +1,82 @@
+package spout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/segmentio/kafka-go"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// kafkaSource reads every partition of a Kafka topic. Offsets are tracked by
+// the spout's marker rather than a consumer group, so that they only advance
+// once messages have been committed to the output repo.
+type kafkaSource struct {
+	spec *pps.KafkaSpoutSource
+}
+
+func (k *kafkaSource) partitions(ctx context.Context) ([]kafka.Partition, error) {
+	var lookupErr error
+	for _, broker := range k.spec.Brokers {
+		partitions, err := kafka.LookupPartitions(ctx, "tcp", broker, k.spec.Topic)
+		if err == nil {
+			return partitions, nil
+		}
+		lookupErr = err
+	}
+	return nil, errors.Wrapf(lookupErr, "could not look up partitions of kafka topic %q", k.spec.Topic)
+}
+
+func (k *kafkaSource) Run(ctx context.Context, offsets map[string]int64, msgs chan<- *message) error {
+	partitions, err := k.partitions(ctx)
+	if err != nil {
+		return err
+	}
+	eg, ctx := errgroup.WithContext(ctx)
+	for _, p := range partitions {
+		partition := strconv.Itoa(p.ID)
+		r := kafka.NewReader(kafka.ReaderConfig{
+			Brokers:   k.spec.Brokers,
+			Topic:     k.spec.Topic,
+			Partition: p.ID,
+			MinBytes:  1,
+			MaxBytes:  10e6,
+		})
+		offset, ok := offsets[partition]
+		if !ok {
+			offset = kafka.FirstOffset
+		}
+		if err := r.SetOffset(offset); err != nil {
+			r.Close()
+			return err
+		}
+		eg.Go(func() (retErr error) {
+			defer func() {
+				if err := r.Close(); err != nil && retErr == nil {
+					retErr = err
+				}
+			}()
+			for {
+				m, err := r.ReadMessage(ctx)
+				if err != nil {
+					return err
+				}
+				select {
+				case msgs <- &message{
+					path:      fmt.Sprintf("/%s/%d-%d", m.Topic, m.Partition, m.Offset),
+					data:      m.Value,
+					partition: partition,
+					offset:    m.Offset,
+				}:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		})
+	}
+	return eg.Wait()
+}
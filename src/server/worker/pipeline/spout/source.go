@@ -0,0 +1,225 @@
+package spout
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
+)
+
+const (
+	defaultBatchMessages = 1000
+	defaultBatchDelay    = 10 * time.Second
+)
+
+// message is a single piece of data read by a built-in source.
+type message struct {
+	// path is the file in the output commit that data is written to.
+	path string
+	data []byte
+	// partition and offset, if partition is set, are checkpointed in the
+	// spout's marker once the message has been committed. The source is
+	// restarted from offset+1.
+	partition string
+	offset    int64
+	// done, if set, receives the result of committing the message.
+	done chan error
+}
+
+// source is a built-in spout source. Run reads messages, starting from the
+// checkpointed offsets, and sends them on msgs until ctx is canceled.
+type source interface {
+	Run(ctx context.Context, offsets map[string]int64, msgs chan<- *message) error
+}
+
+// newSource returns the built-in source configured in spout, or nil if the
+// spout runs user code.
+func newSource(spout *pps.Spout) source {
+	switch {
+	case spout.Kafka != nil:
+		return &kafkaSource{spec: spout.Kafka}
+	case spout.Ingest != nil:
+		return &ingestSource{spec: spout.Ingest, port: spout.Service.InternalPort}
+	default:
+		return nil
+	}
+}
+
+// readOffsets reads the offsets checkpointed in the spout's marker.
+func readOffsets(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) (map[string]int64, error) {
+	offsets := make(map[string]int64)
+	var buf bytes.Buffer
+	if err := pachClient.GetFile(pipelineInfo.Pipeline.Name, ppsconsts.SpoutMarkerBranch, pipelineInfo.Spout.Marker, 0, 0, &buf); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return offsets, nil
+		}
+		return nil, err
+	}
+	if buf.Len() == 0 {
+		return offsets, nil
+	}
+	if err := json.Unmarshal(buf.Bytes(), &offsets); err != nil {
+		return nil, errors.Wrapf(err, "could not parse spout marker %q", pipelineInfo.Spout.Marker)
+	}
+	return offsets, nil
+}
+
+// batcher groups messages into output commits according to a SpoutBatch.
+type batcher struct {
+	maxMessages int
+	maxBytes    int64
+	maxDelay    time.Duration
+	marker      string
+	offsets     map[string]int64
+	// commit writes the tar stream in f to a new output commit
+	commit func(f *os.File) error
+
+	messages []*message
+	size     int64
+}
+
+func newBatcher(spout *pps.Spout, offsets map[string]int64, commit func(f *os.File) error) (*batcher, error) {
+	b := &batcher{
+		maxMessages: defaultBatchMessages,
+		maxDelay:    defaultBatchDelay,
+		marker:      spout.Marker,
+		offsets:     offsets,
+		commit:      commit,
+	}
+	if spout.Batch != nil {
+		if spout.Batch.MaxMessages > 0 {
+			b.maxMessages = int(spout.Batch.MaxMessages)
+		}
+		b.maxBytes = spout.Batch.MaxBytes
+		if spout.Batch.MaxDelay != nil {
+			delay, err := types.DurationFromProto(spout.Batch.MaxDelay)
+			if err != nil {
+				return nil, err
+			}
+			b.maxDelay = delay
+		}
+	}
+	return b, nil
+}
+
+// run commits the messages received on msgs until ctx is canceled.
+func (b *batcher) run(ctx context.Context, msgs <-chan *message) error {
+	var deadline <-chan time.Time
+	for {
+		select {
+		case m := <-msgs:
+			if len(b.messages) == 0 {
+				deadline = time.After(b.maxDelay)
+			}
+			if b.add(m) {
+				if err := b.flush(); err != nil {
+					return err
+				}
+				deadline = nil
+			}
+		case <-deadline:
+			if err := b.flush(); err != nil {
+				return err
+			}
+			deadline = nil
+		case <-ctx.Done():
+			b.ack(ctx.Err())
+			return ctx.Err()
+		}
+	}
+}
+
+// add adds m to the current batch, and returns true if the batch is full.
+func (b *batcher) add(m *message) bool {
+	b.messages = append(b.messages, m)
+	b.size += int64(len(m.data))
+	return len(b.messages) >= b.maxMessages || (b.maxBytes > 0 && b.size >= b.maxBytes)
+}
+
+// flush commits the current batch, along with the offsets of its messages.
+func (b *batcher) flush() (retErr error) {
+	if len(b.messages) == 0 {
+		return nil
+	}
+	defer func() {
+		b.ack(retErr)
+	}()
+	f, err := ioutil.TempFile(os.TempDir(), "pachyderm_spout_batch")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil && retErr == nil {
+			retErr = err
+		}
+		if err := f.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	offsets := make(map[string]int64)
+	for k, v := range b.offsets {
+		offsets[k] = v
+	}
+	tw := tar.NewWriter(f)
+	for _, m := range b.messages {
+		if err := writeTarFile(tw, m.path, m.data); err != nil {
+			return err
+		}
+		if m.partition != "" && m.offset+1 > offsets[m.partition] {
+			offsets[m.partition] = m.offset + 1
+		}
+	}
+	if len(offsets) > 0 {
+		checkpoint, err := json.Marshal(offsets)
+		if err != nil {
+			return err
+		}
+		if err := writeTarFile(tw, b.marker, checkpoint); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := b.commit(f); err != nil {
+		return err
+	}
+	b.offsets = offsets
+	return nil
+}
+
+// ack reports the result of committing the current batch to its messages and
+// resets the batch.
+func (b *batcher) ack(err error) {
+	for _, m := range b.messages {
+		if m.done != nil {
+			m.done <- err
+		}
+	}
+	b.messages = nil
+	b.size = 0
+}
+
+func writeTarFile(tw *tar.Writer, path string, data []byte) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Name: strings.TrimPrefix(path, "/"),
+		Mode: 0600,
+		Size: int64(len(data)),
+	}); err != nil {
+		return err
+	}
+	_, err := tw.Write(data)
+	return err
+}
@@ -0,0 +1,92 @@
+package spout
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// readBatch returns the contents of the tar stream committed by a batcher.
+func readBatch(t *testing.T, f *os.File) map[string]string {
+	_, err := f.Seek(0, 0)
+	require.NoError(t, err)
+	files := make(map[string]string)
+	tr := tar.NewReader(f)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = string(data)
+	}
+}
+
+func TestBatcherMaxMessages(t *testing.T) {
+	var batches []map[string]string
+	b, err := newBatcher(&pps.Spout{
+		Marker: "checkpoint",
+		Batch:  &pps.SpoutBatch{MaxMessages: 2},
+	}, map[string]int64{"0": 5}, func(f *os.File) error {
+		batches = append(batches, readBatch(t, f))
+		return nil
+	})
+	require.NoError(t, err)
+
+	require.False(t, b.add(&message{path: "/topic/0-5", data: []byte("a"), partition: "0", offset: 5}))
+	require.True(t, b.add(&message{path: "/topic/1-0", data: []byte("b"), partition: "1", offset: 0}))
+	require.NoError(t, b.flush())
+	require.Equal(t, 1, len(batches))
+	require.Equal(t, "a", batches[0]["topic/0-5"])
+	require.Equal(t, "b", batches[0]["topic/1-0"])
+
+	var offsets map[string]int64
+	require.NoError(t, json.Unmarshal([]byte(batches[0]["checkpoint"]), &offsets))
+	require.Equal(t, map[string]int64{"0": 6, "1": 1}, offsets)
+	require.Equal(t, offsets, b.offsets)
+}
+
+func TestBatcherMaxBytes(t *testing.T) {
+	b, err := newBatcher(&pps.Spout{
+		Marker: "checkpoint",
+		Batch:  &pps.SpoutBatch{MaxBytes: 10},
+	}, map[string]int64{}, func(f *os.File) error { return nil })
+	require.NoError(t, err)
+	require.False(t, b.add(&message{path: "/a", data: []byte("12345")}))
+	require.True(t, b.add(&message{path: "/b", data: []byte("67890")}))
+}
+
+func TestBatcherMaxDelay(t *testing.T) {
+	committed := make(chan map[string]string, 1)
+	b, err := newBatcher(&pps.Spout{
+		Marker: "checkpoint",
+		Batch:  &pps.SpoutBatch{MaxDelay: types.DurationProto(10 * time.Millisecond)},
+	}, map[string]int64{}, func(f *os.File) error {
+		committed <- readBatch(t, f)
+		return nil
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgs := make(chan *message)
+	go b.run(ctx, msgs)
+	done := make(chan error, 1)
+	msgs <- &message{path: "/a", data: []byte("foo"), done: done}
+	require.NoError(t, <-done)
+	// Messages without a partition don't write a checkpoint
+	require.Equal(t, map[string]string{"a": "foo"}, <-committed)
+}
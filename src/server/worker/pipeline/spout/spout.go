@@ -1,6 +1,8 @@
 package spout
 
 import (
+	"os"
+
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -28,6 +30,10 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 		return pachClient.DeleteCommit(pipelineInfo.Pipeline.Name, c.Commit.ID)
 	})
 
+	if source := newSource(pipelineInfo.Spout); source != nil {
+		return runSource(driver, logger, source)
+	}
+
 	// TODO: do something with stats?
 	_, err := driver.WithData(nil, nil, logger, func(dir string, stats *pps.ProcessStats) error {
 		inputs := []*common.Input{} // Spouts take no inputs
@@ -44,3 +50,25 @@ func Run(driver driver.Driver, logger logs.TaggedLogger) error {
 	})
 	return err
 }
+
+// runSource runs a built-in spout source, which needs no user code, until the
+// driver is canceled.
+func runSource(driver driver.Driver, logger logs.TaggedLogger, source source) error {
+	pachClient := driver.PachClient()
+	pipelineInfo := driver.PipelineInfo()
+	offsets, err := readOffsets(pachClient, pipelineInfo)
+	if err != nil {
+		return err
+	}
+	eg, ctx := errgroup.WithContext(pachClient.Ctx())
+	b, err := newBatcher(pipelineInfo.Spout, offsets, func(f *os.File) error {
+		return pipeline.CommitSpoutTar(ctx, pachClient, pipelineInfo, logger, f)
+	})
+	if err != nil {
+		return err
+	}
+	msgs := make(chan *message)
+	eg.Go(func() error { return source.Run(ctx, offsets, msgs) })
+	eg.Go(func() error { return b.run(ctx, msgs) })
+	return eg.Wait()
+}
@@ -136,7 +136,7 @@ func (td *testDriver) WithDatumCache(cb func(*hashtree.MergeCache, *hashtree.Mer
 	return td.inner.WithDatumCache(cb)
 }
 
-func (td *testDriver) Egress(commit *pfs.Commit, egressURL string) error {
+func (td *testDriver) Egress(commit *pfs.Commit, egress *pps.Egress, retrying func(attempt int, err error, d time.Duration)) error {
 	return nil
 }
 
@@ -15,14 +15,17 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/datapolicy"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
@@ -33,8 +36,17 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/src/server/worker/logs"
 	"github.com/pachyderm/pachyderm/src/server/worker/pipeline/transform/chain"
+	"github.com/pachyderm/pachyderm/src/server/worker/timeline"
 )
 
+// maxEgressRetries is the number of times a failed egress is retried before
+// the job is failed.
+const maxEgressRetries = 3
+
+// timelineSaveInterval is the minimum interval between saves of a job's
+// timeline while its tasks are running.
+const timelineSaveInterval = 10 * time.Second
+
 func jobArtifactPrefix(jobID string) string {
 	return path.Join("artifacts", fmt.Sprintf("job-%s", jobID))
 }
@@ -61,6 +73,13 @@ type pendingJob struct {
 	jdit            chain.JobDatumIterator
 	taskMaster      *work.Master
 
+	// timeline records the job's timeline, and rootSpan is the span covering
+	// the whole job
+	timeline          *timeline.Recorder
+	rootSpan          *timeline.Span
+	timelineMu        sync.Mutex
+	timelineSavedTime time.Time
+
 	// These are filled in when the RUNNING phase completes, but may be re-fetched
 	// from object storage.
 	chunkHashtrees []*HashtreeInfo
@@ -240,6 +259,11 @@ func (reg *registry) succeedJob(
 		return err
 	}
 
+	var reason string
+	if pj.ji.DataQuarantined > 0 {
+		reason = fmt.Sprintf("%d datums quarantined", pj.ji.DataQuarantined)
+	}
+
 	var newState pps.JobState
 	if pj.ji.Egress == nil {
 		pj.logger.Logf("job successful, closing commits")
@@ -250,7 +274,7 @@ func (reg *registry) succeedJob(
 	}
 
 	// Use the registry's driver so that the job's supervision goroutine cannot cancel us
-	if err := finishJob(reg.driver.PipelineInfo(), reg.driver.PachClient(), pj.ji, newState, "", datums, trees, size, statsTrees, statsSize); err != nil {
+	if err := finishJob(reg.driver.PipelineInfo(), reg.driver.PachClient(), pj.ji, newState, reason, datums, trees, size, statsTrees, statsSize); err != nil {
 		return err
 	}
 
@@ -258,6 +282,9 @@ func (reg *registry) succeedJob(
 		return err
 	}
 
+	if newState == pps.JobState_JOB_SUCCESS {
+		pj.finishTimeline()
+	}
 	return reg.jobChain.Succeed(pj)
 }
 
@@ -278,6 +305,7 @@ func (reg *registry) failJob(
 		return err
 	}
 
+	pj.finishTimeline()
 	// Disregard job chain errors when failing the job - in case of egress, the
 	// pending job should already have been removed from the chain.
 	reg.jobChain.Fail(pj)
@@ -299,6 +327,7 @@ func (reg *registry) killJob(
 		return err
 	}
 
+	pj.finishTimeline()
 	return reg.jobChain.Fail(pj)
 }
 
@@ -314,16 +343,17 @@ func (reg *registry) cleanJobArtifacts(job *pps.Job) error {
 
 func writeJobInfo(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
 	_, err := pachClient.PpsAPIClient.UpdateJobState(pachClient.Ctx(), &pps.UpdateJobStateRequest{
-		Job:           jobInfo.Job,
-		State:         jobInfo.State,
-		Reason:        jobInfo.Reason,
-		Restart:       jobInfo.Restart,
-		DataProcessed: jobInfo.DataProcessed,
-		DataSkipped:   jobInfo.DataSkipped,
-		DataTotal:     jobInfo.DataTotal,
-		DataFailed:    jobInfo.DataFailed,
-		DataRecovered: jobInfo.DataRecovered,
-		Stats:         jobInfo.Stats,
+		Job:             jobInfo.Job,
+		State:           jobInfo.State,
+		Reason:          jobInfo.Reason,
+		Restart:         jobInfo.Restart,
+		DataProcessed:   jobInfo.DataProcessed,
+		DataSkipped:     jobInfo.DataSkipped,
+		DataTotal:       jobInfo.DataTotal,
+		DataFailed:      jobInfo.DataFailed,
+		DataRecovered:   jobInfo.DataRecovered,
+		DataQuarantined: jobInfo.DataQuarantined,
+		Stats:           jobInfo.Stats,
 	})
 	return err
 }
@@ -333,6 +363,68 @@ func (pj *pendingJob) writeJobInfo() error {
 	return writeJobInfo(pj.driver.PachClient(), pj.ji)
 }
 
+// logStep runs cb as a step of the job, which is both logged and recorded in
+// the job's timeline.
+func (pj *pendingJob) logStep(name string, cb func() error) error {
+	span := pj.timeline.Start(nil, name, "")
+	err := pj.logger.LogStep(name, cb)
+	span.Finish(err)
+	pj.saveTimeline(true)
+	return err
+}
+
+// addTimeline adds the spans recorded by a worker for one of the job's tasks
+// to the job's timeline.
+func (pj *pendingJob) addTimeline(tl *pps.JobTimeline) {
+	pj.timeline.Add(tl)
+	pj.saveTimeline(false)
+}
+
+// saveTimeline stores the job's timeline. Unless force is set, the timeline
+// is only stored if it hasn't been for timelineSaveInterval. Failing to store
+// the timeline doesn't affect the job, so errors are only logged.
+func (pj *pendingJob) saveTimeline(force bool) {
+	pj.timelineMu.Lock()
+	defer pj.timelineMu.Unlock()
+	if !force && time.Since(pj.timelineSavedTime) < timelineSaveInterval {
+		return
+	}
+	pj.timelineSavedTime = time.Now()
+	if err := pj.timeline.Save(pj.driver.PachClient()); err != nil {
+		pj.logger.Logf("could not store job timeline: %v", err)
+	}
+}
+
+// finishTimeline records the span covering the whole job, once the job has
+// finished, and stores the job's final timeline.
+func (pj *pendingJob) finishTimeline() {
+	var err error
+	if pj.ji.State != pps.JobState_JOB_SUCCESS {
+		err = errors.Errorf("%s: %s", pj.ji.State, pj.ji.Reason)
+	}
+	pj.rootSpan.Finish(err)
+	pj.saveTimeline(true)
+}
+
+// startTimeline starts recording the job's timeline, continuing from any
+// timeline stored by a previous master.
+func (pj *pendingJob) startTimeline() {
+	tl, err := timeline.Load(pj.driver.PachClient(), pj.ji.Job.ID)
+	if err != nil {
+		pj.logger.Logf("could not load job timeline, starting a new one: %v", err)
+	}
+	// The master's steps are recorded without a worker, to distinguish them
+	// from the tasks run by workers (which include the master's own pod)
+	pj.timeline = timeline.NewRecorder(pj.ji.Job.ID, "", -1, tl)
+	started := time.Now()
+	if pj.ji.Started != nil {
+		if t, err := types.TimestampFromProto(pj.ji.Started); err == nil {
+			started = t
+		}
+	}
+	pj.rootSpan = pj.timeline.StartRoot(fmt.Sprintf("job %s (pipeline %s)", pj.ji.Job.ID, pj.ji.Pipeline.Name), started)
+}
+
 func (reg *registry) initializeJobChain(commitInfo *pfs.CommitInfo) error {
 	if reg.jobChain == nil {
 		// Get the most recent successful commit starting from the given commit
@@ -665,10 +757,12 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commi
 			"is updated", jobInfo.Job.ID, jobInfo.PipelineVersion, reg.driver.PipelineInfo().Version)
 	}
 
+	pj.startTimeline()
+
 	// Inputs must be ready before we can construct a datum iterator, so do this
 	// synchronously to ensure correct order in the jobChain.
 	if pj.ji.State == pps.JobState_JOB_STARTING {
-		if err := pj.logger.LogStep("waiting for job inputs", func() error {
+		if err := pj.logStep("waiting for job inputs", func() error {
 			return reg.processJobStarting(pj)
 		}); err != nil {
 			return err
@@ -820,7 +914,7 @@ func (reg *registry) startJob(commitInfo *pfs.CommitInfo, statsCommit *pfs.Commi
 
 			// If egress fails, there isn't much we can do - the output commit is
 			// already done, so the job is 'complete' regardless.
-			pj.logger.LogStep("egressing job data", func() error {
+			pj.logStep("egressing job data", func() error {
 				return reg.processJobEgress(pj)
 			})
 		}
@@ -879,11 +973,11 @@ func (reg *registry) processJob(pj *pendingJob) error {
 	case state == pps.JobState_JOB_STARTING:
 		return errors.New("job should have been moved out of the STARTING state before processJob")
 	case state == pps.JobState_JOB_RUNNING:
-		return pj.logger.LogStep("processing job datums", func() error {
+		return pj.logStep("processing job datums", func() error {
 			return reg.processJobRunning(pj)
 		})
 	case state == pps.JobState_JOB_MERGING:
-		return pj.logger.LogStep("merging job hashtrees", func() error {
+		return pj.logStep("merging job hashtrees", func() error {
 			return reg.processJobMerging(pj)
 		})
 	case state == pps.JobState_JOB_EGRESSING:
@@ -926,7 +1020,7 @@ func (reg *registry) processJobStarting(pj *pendingJob) error {
 // Iterator fulfills the chain.JobData interface for pendingJob
 func (pj *pendingJob) Iterator() (datum.Iterator, error) {
 	var dit datum.Iterator
-	err := pj.logger.LogStep("constructing datum iterator", func() (err error) {
+	err := pj.logStep("constructing datum iterator", func() (err error) {
 		dit, err = datum.NewIterator(pj.driver.PachClient(), pj.ji.Input)
 		return
 	})
@@ -942,7 +1036,7 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 	// Spawn a goroutine to emit tasks on the datum task channel
 	eg.Go(func() error {
 		defer close(subtasks)
-		return pj.logger.LogStep("collecting datums for tasks", func() error {
+		return pj.logStep("collecting datums for tasks", func() error {
 			for {
 				numDatums, err := pj.jdit.NextBatch(ctx)
 				if err != nil {
@@ -967,7 +1061,7 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 
 	// Run subtasks until we are done
 	eg.Go(func() error {
-		return pj.logger.LogStep("running datum tasks", func() error {
+		return pj.logStep("running datum tasks", func() error {
 			return pj.taskMaster.RunSubtasksChan(
 				subtasks,
 				func(ctx context.Context, taskInfo *work.TaskInfo) error {
@@ -984,6 +1078,7 @@ func (reg *registry) processJobRunning(pj *pendingJob) error {
 					defer mutex.Unlock()
 
 					mergeStats(stats, data.Stats)
+					pj.addTimeline(data.Timeline)
 
 					if data.ChunkHashtree != nil {
 						chunkHashtrees = append(chunkHashtrees, data.ChunkHashtree)
@@ -1043,12 +1138,13 @@ func (pj *pendingJob) saveJobStats(stats *DatumStats) {
 	pj.ji.DataProcessed = stats.DatumsProcessed
 	pj.ji.DataFailed = stats.DatumsFailed
 	pj.ji.DataRecovered = stats.DatumsRecovered
+	pj.ji.DataQuarantined = stats.DatumsQuarantined
 	pj.ji.DataTotal = int64(pj.jdit.MaxLen())
 	pj.ji.Stats = stats.ProcessStats
 }
 
 func (pj *pendingJob) finalizeJobStats() {
-	pj.ji.DataSkipped = int64(pj.jdit.MaxLen()) - pj.ji.DataProcessed - pj.ji.DataFailed - pj.ji.DataRecovered
+	pj.ji.DataSkipped = int64(pj.jdit.MaxLen()) - pj.ji.DataProcessed - pj.ji.DataFailed - pj.ji.DataRecovered - pj.ji.DataQuarantined
 }
 
 func (pj *pendingJob) storeHashtreeInfos(chunks []*HashtreeInfo, stats []*HashtreeInfo) (retErr error) {
@@ -1295,6 +1391,8 @@ func (reg *registry) processJobMerging(pj *pendingJob) error {
 				return errors.Errorf("merge task for shard %d failed, no tree returned", data.Shard)
 			}
 
+			pj.addTimeline(data.Timeline)
+
 			mutex.Lock()
 			defer mutex.Unlock()
 
@@ -1350,12 +1448,22 @@ func (reg *registry) storeJobDatums(pj *pendingJob) (*pfs.Object, error) {
 }
 
 func (reg *registry) processJobEgress(pj *pendingJob) error {
+	if err := checkEgressPolicy(pj.driver.PachClient(), pj.ji); err != nil {
+		if datapolicy.IsErrPolicyViolation(err) {
+			return reg.failJob(pj, err.Error(), nil, 0)
+		}
+		return err
+	}
 	if err := reg.egress(pj); err != nil {
-		return reg.failJob(pj, fmt.Sprintf("egress error: %v", err), nil, 0)
+		return reg.failJob(pj, err.Error(), nil, 0)
 	}
 
 	pj.ji.State = pps.JobState_JOB_SUCCESS
-	return pj.writeJobInfo()
+	if err := pj.writeJobInfo(); err != nil {
+		return err
+	}
+	pj.finishTimeline()
+	return nil
 }
 
 func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string, error) {
@@ -1392,21 +1500,79 @@ func failedInputs(pachClient *client.APIClient, jobInfo *pps.JobInfo) ([]string,
 	return failed, vistErr
 }
 
-func (reg *registry) egress(pj *pendingJob) error {
-	var egressFailureCount int
-	return backoff.RetryNotify(func() (retErr error) {
-		if pj.ji.Egress != nil {
-			return pj.logger.LogStep("egress upload", func() error {
-				return pj.driver.Egress(pj.ji.OutputCommit, pj.ji.Egress.URL)
-			})
+// checkEgressPolicy returns an error if the job's input or output repos carry
+// a classification label whose data policy forbids egress. Labels may be added
+// to a repo (or inherited by it) after the pipeline was created, so this is
+// checked again before each egress.
+func checkEgressPolicy(pachClient *client.APIClient, jobInfo *pps.JobInfo) error {
+	if jobInfo.Egress == nil {
+		return nil
+	}
+	resp, err := pachClient.GetConfiguration(pachClient.Ctx(), &auth.GetConfigurationRequest{})
+	if auth.IsErrNotActivated(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(grpcutil.ScrubGRPC(err), "could not get data policies")
+	}
+	policies := datapolicy.New(resp.Configuration.DataPolicies)
+	if policies == nil {
+		return nil
+	}
+	repos := append([]string{jobInfo.OutputCommit.Repo.Name}, ppsutil.InputRepos(jobInfo.Input)...)
+	for _, repo := range repos {
+		repoInfo, err := pachClient.InspectRepo(repo)
+		if err != nil {
+			return err
+		}
+		if err := policies.CheckEgress(datapolicy.Labels(repoInfo)); err != nil {
+			return errors.Wrapf(err, "cannot egress data from %q", repo)
 		}
+	}
+	return nil
+}
+
+func (reg *registry) egress(pj *pendingJob) error {
+	if pj.ji.Egress == nil {
 		return nil
-	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
-		egressFailureCount++
-		if egressFailureCount > 3 {
+	}
+	// Keep the reason the job finished with (e.g. quarantined datums) so it can
+	// be restored once egress succeeds.
+	reason := pj.ji.Reason
+	// Surface each failure on the job while it stays in JOB_EGRESSING
+	retrying := func(attempt int, err error, d time.Duration) {
+		pj.logger.Logf("egress attempt %d failed: %v; retrying in %v", attempt, err, d)
+		pj.ji.Reason = fmt.Sprintf("egress attempt %d failed: %v; retrying in %v", attempt, err, d)
+		if err := pj.writeJobInfo(); err != nil {
+			pj.logger.Logf("could not update job reason: %v", err)
+		}
+	}
+	var attempts int
+	expBackOff := backoff.NewExponentialBackOff()
+	expBackOff.MaxElapsedTime = 0
+	var b backoff.BackOff = expBackOff
+	if pj.ji.Egress.Http != nil {
+		// HTTP egress retries each file itself (and reports its own attempts);
+		// retrying the whole egress would resend files that were already
+		// delivered.
+		b = &backoff.StopBackOff{}
+	}
+	if err := backoff.RetryNotify(func() (retErr error) {
+		attempts++
+		return pj.logStep("egress upload", func() error {
+			return pj.driver.Egress(pj.ji.OutputCommit, pj.ji.Egress, retrying)
+		})
+	}, b, func(err error, d time.Duration) error {
+		if attempts > maxEgressRetries {
 			return err
 		}
-		pj.logger.Logf("egress failed: %v; retrying in %v", err, d)
+		retrying(attempts, err, d)
 		return nil
-	})
+	}); err != nil {
+		if pj.ji.Egress.Http != nil {
+			return errors.Wrapf(err, "egress failed")
+		}
+		return errors.Wrapf(err, "egress failed after %d attempt(s)", attempts)
+	}
+	pj.ji.Reason = reason
+	return nil
 }
@@ -356,6 +356,7 @@ type DatumStats struct {
 	DatumsSkipped        int64             `protobuf:"varint,3,opt,name=datums_skipped,json=datumsSkipped,proto3" json:"datums_skipped,omitempty"`
 	DatumsFailed         int64             `protobuf:"varint,5,opt,name=datums_failed,json=datumsFailed,proto3" json:"datums_failed,omitempty"`
 	DatumsRecovered      int64             `protobuf:"varint,6,opt,name=datums_recovered,json=datumsRecovered,proto3" json:"datums_recovered,omitempty"`
+	DatumsQuarantined    int64             `protobuf:"varint,9,opt,name=datums_quarantined,json=datumsQuarantined,proto3" json:"datums_quarantined,omitempty"`
 	FailedDatumID        string            `protobuf:"bytes,8,opt,name=failed_datum_id,json=failedDatumId,proto3" json:"failed_datum_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -430,6 +431,13 @@ func (m *DatumStats) GetDatumsRecovered() int64 {
 	return 0
 }
 
+func (m *DatumStats) GetDatumsQuarantined() int64 {
+	if m != nil {
+		return m.DatumsQuarantined
+	}
+	return 0
+}
+
 func (m *DatumStats) GetFailedDatumID() string {
 	if m != nil {
 		return m.FailedDatumID
@@ -443,13 +451,14 @@ type DatumData struct {
 	DatumsObject string      `protobuf:"bytes,8,opt,name=datums_object,json=datumsObject,proto3" json:"datums_object,omitempty"`
 	OutputCommit *pfs.Commit `protobuf:"bytes,3,opt,name=output_commit,json=outputCommit,proto3" json:"output_commit,omitempty"`
 	// Outputs
-	Stats                 *DatumStats   `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
-	ChunkHashtree         *HashtreeInfo `protobuf:"bytes,5,opt,name=chunk_hashtree,json=chunkHashtree,proto3" json:"chunk_hashtree,omitempty"`
-	StatsHashtree         *HashtreeInfo `protobuf:"bytes,6,opt,name=stats_hashtree,json=statsHashtree,proto3" json:"stats_hashtree,omitempty"`
-	RecoveredDatumsObject string        `protobuf:"bytes,7,opt,name=recovered_datums_object,json=recoveredDatumsObject,proto3" json:"recovered_datums_object,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}      `json:"-"`
-	XXX_unrecognized      []byte        `json:"-"`
-	XXX_sizecache         int32         `json:"-"`
+	Stats                 *DatumStats      `protobuf:"bytes,4,opt,name=stats,proto3" json:"stats,omitempty"`
+	ChunkHashtree         *HashtreeInfo    `protobuf:"bytes,5,opt,name=chunk_hashtree,json=chunkHashtree,proto3" json:"chunk_hashtree,omitempty"`
+	StatsHashtree         *HashtreeInfo    `protobuf:"bytes,6,opt,name=stats_hashtree,json=statsHashtree,proto3" json:"stats_hashtree,omitempty"`
+	RecoveredDatumsObject string           `protobuf:"bytes,7,opt,name=recovered_datums_object,json=recoveredDatumsObject,proto3" json:"recovered_datums_object,omitempty"`
+	Timeline              *pps.JobTimeline `protobuf:"bytes,9,opt,name=timeline,proto3" json:"timeline,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
+	XXX_unrecognized      []byte           `json:"-"`
+	XXX_sizecache         int32            `json:"-"`
 }
 
 func (m *DatumData) Reset()         { *m = DatumData{} }
@@ -534,6 +543,13 @@ func (m *DatumData) GetRecoveredDatumsObject() string {
 	return ""
 }
 
+func (m *DatumData) GetTimeline() *pps.JobTimeline {
+	if m != nil {
+		return m.Timeline
+	}
+	return nil
+}
+
 type MergeData struct {
 	// Inputs
 	JobID     string          `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -542,11 +558,12 @@ type MergeData struct {
 	Shard     int64           `protobuf:"varint,4,opt,name=shard,proto3" json:"shard,omitempty"`
 	Stats     bool            `protobuf:"varint,5,opt,name=stats,proto3" json:"stats,omitempty"`
 	// Outputs
-	Tree                 *pfs.Object `protobuf:"bytes,6,opt,name=tree,proto3" json:"tree,omitempty"`
-	TreeSize             uint64      `protobuf:"varint,7,opt,name=tree_size,json=treeSize,proto3" json:"tree_size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Tree                 *pfs.Object      `protobuf:"bytes,6,opt,name=tree,proto3" json:"tree,omitempty"`
+	TreeSize             uint64           `protobuf:"varint,7,opt,name=tree_size,json=treeSize,proto3" json:"tree_size,omitempty"`
+	Timeline             *pps.JobTimeline `protobuf:"bytes,8,opt,name=timeline,proto3" json:"timeline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *MergeData) Reset()         { *m = MergeData{} }
@@ -631,6 +648,13 @@ func (m *MergeData) GetTreeSize() uint64 {
 	return 0
 }
 
+func (m *MergeData) GetTimeline() *pps.JobTimeline {
+	if m != nil {
+		return m.Timeline
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DatumInputs)(nil), "pachyderm.worker.pipeline.transform.DatumInputs")
 	proto.RegisterType((*DatumInputsList)(nil), "pachyderm.worker.pipeline.transform.DatumInputsList")
@@ -648,55 +672,58 @@ func init() {
 }
 
 var fileDescriptor_21583a759eb7fa97 = []byte{
-	// 760 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xd1, 0x4e, 0xdb, 0x4a,
-	0x10, 0x55, 0x12, 0x62, 0xf0, 0x26, 0x26, 0x97, 0x15, 0xdc, 0x1b, 0x71, 0x25, 0xc2, 0x35, 0x42,
-	0x02, 0xe9, 0xca, 0x86, 0x5c, 0x09, 0xe9, 0xbe, 0x42, 0x5a, 0x11, 0xd4, 0x0a, 0xea, 0xbc, 0x54,
-	0xed, 0x83, 0xe5, 0xd8, 0x9b, 0xd8, 0x84, 0x78, 0xad, 0x5d, 0x87, 0xb6, 0xfc, 0x43, 0x3f, 0xa2,
-	0x5f, 0xd1, 0x5f, 0xe8, 0x63, 0xbf, 0x00, 0x55, 0xf9, 0x92, 0x6a, 0x67, 0xd6, 0xc1, 0xe9, 0x4b,
-	0x23, 0x1e, 0x2c, 0xef, 0x9c, 0x3d, 0x73, 0x66, 0x76, 0x66, 0xc7, 0x26, 0x27, 0x92, 0x89, 0x7b,
-	0x26, 0xdc, 0x0f, 0x5c, 0x4c, 0x98, 0x70, 0xb3, 0x24, 0x63, 0x77, 0x49, 0xca, 0xdc, 0x5c, 0x04,
-	0xa9, 0x1c, 0x71, 0x31, 0x7d, 0x5a, 0x39, 0x99, 0xe0, 0x39, 0xa7, 0x07, 0x59, 0x10, 0xc6, 0x9f,
-	0x22, 0x26, 0xa6, 0x0e, 0x3a, 0x39, 0x85, 0x93, 0xb3, 0xa0, 0xee, 0x6e, 0x8f, 0xf9, 0x98, 0x03,
-	0xdf, 0x55, 0x2b, 0x74, 0xdd, 0xdd, 0x0e, 0xef, 0x12, 0x96, 0xe6, 0x6e, 0x36, 0x92, 0xea, 0xf9,
-	0x15, 0xcd, 0xa4, 0x7a, 0x34, 0xfa, 0xcf, 0x72, 0x62, 0x21, 0x9f, 0x4e, 0x79, 0xaa, 0x5f, 0x48,
-	0xb1, 0xaf, 0x48, 0xa3, 0x17, 0xe4, 0xb3, 0x69, 0x3f, 0xcd, 0x66, 0xb9, 0xa4, 0x87, 0xc4, 0x48,
-	0x60, 0xd5, 0xae, 0xec, 0xd7, 0x8e, 0x1a, 0x5d, 0xcb, 0xd1, 0x6c, 0xd8, 0xf7, 0xf4, 0x26, 0xdd,
-	0x26, 0xf5, 0x24, 0x8d, 0xd8, 0xc7, 0x76, 0x75, 0xbf, 0x72, 0x54, 0xf3, 0xd0, 0xb0, 0xdf, 0x93,
-	0x56, 0x49, 0xeb, 0x55, 0x22, 0x73, 0x7a, 0x49, 0x8c, 0x48, 0x41, 0x85, 0xde, 0x89, 0xb3, 0xc2,
-	0xc9, 0x9d, 0x92, 0x8a, 0xa7, 0xfd, 0x95, 0xf8, 0x65, 0x20, 0xe3, 0x5c, 0x30, 0x76, 0x3d, 0xbc,
-	0x65, 0x61, 0x2e, 0xe9, 0x01, 0xb1, 0xc2, 0x78, 0x96, 0x4e, 0x7c, 0x8e, 0x00, 0xc4, 0x30, 0xbd,
-	0x26, 0x80, 0x25, 0x92, 0xcc, 0x83, 0x5c, 0x2e, 0x48, 0x55, 0x24, 0x01, 0xa8, 0x49, 0xf6, 0x31,
-	0x69, 0x79, 0x2c, 0xe4, 0xf7, 0x4c, 0xb0, 0x08, 0x82, 0x4b, 0xfa, 0x27, 0x31, 0xe2, 0x40, 0xc6,
-	0xac, 0x50, 0xd5, 0x96, 0x7d, 0x4a, 0x76, 0x96, 0xa9, 0x45, 0xa0, 0x36, 0x59, 0x5f, 0xce, 0xa3,
-	0x30, 0xed, 0x94, 0x34, 0x8b, 0xd4, 0xfb, 0xe9, 0x88, 0x2b, 0x66, 0x10, 0x45, 0x82, 0x49, 0xc5,
-	0xac, 0x28, 0xa6, 0x36, 0xe9, 0xbf, 0x84, 0xc8, 0xd9, 0x30, 0x0f, 0xe4, 0xc4, 0x4f, 0x22, 0x28,
-	0xae, 0x79, 0x6e, 0xcd, 0x1f, 0x3b, 0xe6, 0x00, 0xd1, 0x7e, 0xcf, 0x33, 0x35, 0xa1, 0x1f, 0xa9,
-	0x14, 0x31, 0x44, 0xbb, 0x06, 0x32, 0xda, 0xb2, 0xbf, 0x54, 0x09, 0x81, 0xd4, 0x06, 0xea, 0x8c,
-	0xf4, 0x8c, 0x58, 0x99, 0xe0, 0x21, 0x93, 0xd2, 0x87, 0x43, 0x43, 0xd0, 0x46, 0x77, 0xcb, 0x51,
-	0x17, 0xe5, 0x06, 0x77, 0x80, 0xe9, 0x35, 0xb3, 0x92, 0x45, 0x8f, 0xc9, 0x1f, 0x58, 0x7b, 0x5f,
-	0xc3, 0x2c, 0xd2, 0xfd, 0x6e, 0x21, 0x7e, 0x53, 0xc0, 0xf4, 0x90, 0x6c, 0x6a, 0xaa, 0x9c, 0x24,
-	0x59, 0xc6, 0x22, 0xc8, 0xa8, 0xe6, 0x59, 0x88, 0x0e, 0x10, 0x54, 0xbd, 0xd0, 0xb4, 0x51, 0x90,
-	0xdc, 0xb1, 0xa8, 0x5d, 0x07, 0x56, 0x13, 0xc1, 0x97, 0x80, 0x95, 0xc2, 0x8a, 0xa2, 0xce, 0x6d,
-	0xa3, 0x1c, 0x76, 0x51, 0x7e, 0xfa, 0x3f, 0x69, 0xa1, 0x90, 0x0f, 0x3b, 0xaa, 0x66, 0x1b, 0x50,
-	0xb3, 0xad, 0xf9, 0x63, 0xc7, 0x42, 0x3d, 0xbc, 0x4b, 0x3d, 0xcf, 0x1a, 0x95, 0xcc, 0xc8, 0xfe,
-	0x5a, 0x23, 0x26, 0xac, 0x7b, 0x41, 0x1e, 0xd0, 0x7d, 0x62, 0xdc, 0xf2, 0xa1, 0xf2, 0x87, 0x86,
-	0x9c, 0x9b, 0xf3, 0xc7, 0x4e, 0xfd, 0x8a, 0x0f, 0xfb, 0x3d, 0xaf, 0x7e, 0xcb, 0x87, 0xfd, 0x72,
-	0xea, 0xba, 0xe4, 0x10, 0xa8, 0x48, 0x1d, 0xef, 0x00, 0x3d, 0x21, 0x16, 0x9f, 0xe5, 0xd9, 0x2c,
-	0xf7, 0xd5, 0xd4, 0x24, 0xd8, 0x97, 0x46, 0xb7, 0xe1, 0xa8, 0x41, 0xbd, 0x00, 0xc8, 0x6b, 0x22,
-	0x03, 0x2d, 0xfa, 0x82, 0xd4, 0xb1, 0x27, 0x6b, 0xc0, 0x74, 0x57, 0x1f, 0x0f, 0xec, 0x18, 0x7a,
-	0xd3, 0xb7, 0x64, 0x13, 0x27, 0x21, 0xd6, 0xf7, 0x0c, 0x2a, 0xdb, 0xe8, 0x9e, 0xae, 0xa4, 0x57,
-	0xbe, 0x9c, 0x1e, 0x8e, 0x54, 0x01, 0x29, 0x65, 0x1c, 0x9f, 0x85, 0xb2, 0xf1, 0x6c, 0x65, 0x10,
-	0x5a, 0x28, 0x9f, 0x91, 0xbf, 0x16, 0x0d, 0xf6, 0x97, 0x6b, 0xbb, 0x0e, 0xb5, 0xdd, 0x11, 0xcb,
-	0x23, 0x89, 0x45, 0xb6, 0x3f, 0x57, 0x89, 0xf9, 0x9a, 0x89, 0x31, 0x5b, 0xb1, 0x73, 0xd7, 0xc4,
-	0x2c, 0x72, 0xc7, 0xe1, 0x7f, 0x56, 0xf2, 0x4f, 0x1a, 0xf4, 0x80, 0x18, 0x59, 0x20, 0x58, 0xba,
-	0xdc, 0x5e, 0xcc, 0xce, 0xd3, 0x5b, 0xea, 0x0b, 0x29, 0xe3, 0x40, 0x44, 0xd0, 0xd8, 0x9a, 0x87,
-	0x06, 0xa0, 0xd0, 0x6e, 0xd5, 0x9e, 0x8d, 0xa2, 0x7b, 0x1d, 0xb2, 0x56, 0xaa, 0xec, 0x92, 0x1c,
-	0x6c, 0xd0, 0xbf, 0x89, 0xa9, 0xde, 0xbe, 0x4c, 0x1e, 0x18, 0x14, 0x67, 0xcd, 0xdb, 0x50, 0xc0,
-	0x20, 0x79, 0x60, 0xe7, 0x6f, 0xbe, 0xcd, 0xf7, 0x2a, 0xdf, 0xe7, 0x7b, 0x95, 0x1f, 0xf3, 0xbd,
-	0xca, 0xbb, 0x8b, 0x71, 0x92, 0xc7, 0xb3, 0xa1, 0xfa, 0x6c, 0xbb, 0x8b, 0x43, 0x96, 0x56, 0x52,
-	0x84, 0xee, 0xef, 0x7e, 0x57, 0x43, 0x03, 0xfe, 0x0d, 0xff, 0xfd, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x6f, 0x96, 0xe4, 0xe7, 0xd9, 0x06, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0xdb, 0x36,
+	0x14, 0x86, 0xe3, 0x58, 0xb5, 0x8e, 0xad, 0xa6, 0x21, 0xd2, 0xcd, 0xe8, 0x80, 0x24, 0x53, 0x50,
+	0xa0, 0x05, 0x3a, 0x29, 0xf5, 0x80, 0x02, 0xbb, 0x4d, 0xbd, 0xa1, 0x0e, 0x36, 0xb4, 0x65, 0x76,
+	0x31, 0x6c, 0x17, 0x82, 0x7e, 0xe8, 0x48, 0x49, 0x2c, 0x6a, 0x24, 0xd5, 0x6d, 0x7d, 0xb5, 0xbd,
+	0xc0, 0x2e, 0xfb, 0x04, 0xc1, 0x60, 0x60, 0xef, 0x31, 0xf0, 0x90, 0x52, 0xa4, 0xde, 0xd4, 0xe8,
+	0x85, 0x20, 0x9e, 0xef, 0x7c, 0xfc, 0x0e, 0xcf, 0x0f, 0x25, 0x38, 0x95, 0x4c, 0xbc, 0x63, 0x22,
+	0xfc, 0x83, 0x8b, 0x6b, 0x26, 0xc2, 0xaa, 0xa8, 0xd8, 0x4d, 0x51, 0xb2, 0x50, 0x89, 0xb8, 0x94,
+	0x2b, 0x2e, 0xd6, 0x77, 0xab, 0xa0, 0x12, 0x5c, 0x71, 0x72, 0x52, 0xc5, 0x69, 0xfe, 0x57, 0xc6,
+	0xc4, 0x3a, 0x30, 0x9b, 0x82, 0x66, 0x53, 0xd0, 0x52, 0x1f, 0x1d, 0x5c, 0xf2, 0x4b, 0x8e, 0xfc,
+	0x50, 0xaf, 0xcc, 0xd6, 0x47, 0x07, 0xe9, 0x4d, 0xc1, 0x4a, 0x15, 0x56, 0x2b, 0xa9, 0x9f, 0x8f,
+	0xd1, 0x4a, 0xea, 0xc7, 0xa2, 0x5f, 0xf7, 0x0f, 0x96, 0xf2, 0xf5, 0x9a, 0x97, 0xf6, 0x65, 0x28,
+	0xfe, 0x39, 0x4c, 0x16, 0xb1, 0xaa, 0xd7, 0xcb, 0xb2, 0xaa, 0x95, 0x24, 0x8f, 0xc1, 0x29, 0x70,
+	0x35, 0x1b, 0x1c, 0x0f, 0x9f, 0x4c, 0xe6, 0x5e, 0x60, 0xd9, 0xe8, 0xa7, 0xd6, 0x49, 0x0e, 0x60,
+	0x54, 0x94, 0x19, 0xfb, 0x73, 0xb6, 0x73, 0x3c, 0x78, 0x32, 0xa4, 0xc6, 0xf0, 0x7f, 0x83, 0xbd,
+	0x8e, 0xd6, 0x8f, 0x85, 0x54, 0xe4, 0x15, 0x38, 0x99, 0x86, 0x1a, 0xbd, 0xd3, 0x60, 0x8b, 0xcc,
+	0x83, 0x8e, 0x0a, 0xb5, 0xfb, 0xb5, 0xf8, 0xab, 0x58, 0xe6, 0x4a, 0x30, 0xf6, 0x3a, 0xb9, 0x62,
+	0xa9, 0x92, 0xe4, 0x04, 0xbc, 0x34, 0xaf, 0xcb, 0xeb, 0x88, 0x1b, 0x00, 0x63, 0xb8, 0x74, 0x8a,
+	0x60, 0x87, 0x24, 0x55, 0xac, 0x64, 0x4b, 0xda, 0x31, 0x24, 0x04, 0x2d, 0xc9, 0x7f, 0x0a, 0x7b,
+	0x94, 0xa5, 0xfc, 0x1d, 0x13, 0x2c, 0xc3, 0xe0, 0x92, 0x7c, 0x01, 0x4e, 0x1e, 0xcb, 0x9c, 0x35,
+	0xaa, 0xd6, 0xf2, 0x9f, 0xc3, 0xc3, 0x3e, 0xb5, 0x09, 0x34, 0x83, 0x7b, 0xfd, 0x73, 0x34, 0xa6,
+	0x5f, 0xc2, 0xb4, 0x39, 0xfa, 0xb2, 0x5c, 0x71, 0xcd, 0x8c, 0xb3, 0x4c, 0x30, 0xa9, 0x99, 0x03,
+	0xcd, 0xb4, 0x26, 0x79, 0x06, 0x20, 0xeb, 0x44, 0xc5, 0xf2, 0x3a, 0x2a, 0x32, 0x2c, 0xae, 0x7b,
+	0xe6, 0x6d, 0x6e, 0x8f, 0xdc, 0x0b, 0x83, 0x2e, 0x17, 0xd4, 0xb5, 0x84, 0x65, 0xa6, 0x8f, 0x68,
+	0x42, 0xcc, 0x86, 0x28, 0x63, 0x2d, 0xff, 0xc3, 0x0e, 0x00, 0x1e, 0xed, 0x42, 0xe7, 0x48, 0x5e,
+	0x80, 0x57, 0x09, 0x9e, 0x32, 0x29, 0x23, 0x4c, 0x1a, 0x83, 0x4e, 0xe6, 0xfb, 0x81, 0x1e, 0x94,
+	0x37, 0xc6, 0x83, 0x4c, 0x3a, 0xad, 0x3a, 0x16, 0x79, 0x0a, 0x0f, 0x4c, 0xed, 0x23, 0x0b, 0xb3,
+	0xcc, 0xf6, 0x7b, 0xcf, 0xe0, 0x6f, 0x1a, 0x98, 0x3c, 0x86, 0xfb, 0x96, 0x2a, 0xaf, 0x8b, 0xaa,
+	0x62, 0x19, 0x9e, 0x68, 0x48, 0x3d, 0x83, 0x5e, 0x18, 0x50, 0xf7, 0xc2, 0xd2, 0x56, 0x71, 0x71,
+	0xc3, 0xb2, 0xd9, 0x08, 0x59, 0x53, 0x03, 0xfe, 0x80, 0x58, 0x27, 0xac, 0x68, 0xea, 0x3c, 0x73,
+	0xba, 0x61, 0xdb, 0xf2, 0x93, 0x6f, 0x80, 0x58, 0xea, 0xef, 0x75, 0x2c, 0xe2, 0x52, 0x15, 0x25,
+	0xcb, 0x66, 0x2e, 0x92, 0xf7, 0x8d, 0xe7, 0xed, 0x9d, 0x83, 0x7c, 0x07, 0x7b, 0x26, 0x6e, 0x84,
+	0x3e, 0x5d, 0xe2, 0x31, 0x96, 0x78, 0x7f, 0x73, 0x7b, 0xe4, 0x99, 0xf0, 0x66, 0xf4, 0x16, 0xd4,
+	0x5b, 0x75, 0xcc, 0xcc, 0xff, 0x6f, 0x08, 0x2e, 0xae, 0x17, 0xb1, 0x8a, 0xc9, 0x31, 0x38, 0x57,
+	0x3c, 0xd1, 0xfb, 0xb1, 0x7f, 0x67, 0xee, 0xe6, 0xf6, 0x68, 0x74, 0xce, 0x93, 0xe5, 0x82, 0x8e,
+	0xae, 0x78, 0xb2, 0xec, 0x66, 0x6a, 0x3b, 0x84, 0x81, 0x9a, 0x4c, 0xcd, 0xc8, 0x90, 0x53, 0xf0,
+	0x78, 0xad, 0xaa, 0x5a, 0x45, 0xfa, 0x92, 0x15, 0xa6, 0x8d, 0x93, 0xf9, 0x24, 0xd0, 0xf7, 0xfa,
+	0x25, 0x42, 0x74, 0x6a, 0x18, 0xc6, 0x22, 0xdf, 0xc3, 0xc8, 0xb4, 0x70, 0x17, 0x99, 0xe1, 0xf6,
+	0xb7, 0xc9, 0x34, 0xd8, 0xec, 0x26, 0xbf, 0xc0, 0x7d, 0x73, 0x71, 0x72, 0x3b, 0x96, 0xd8, 0x88,
+	0xc9, 0xfc, 0xf9, 0x56, 0x7a, 0xdd, 0x59, 0xa6, 0xe6, 0x06, 0x36, 0x90, 0x56, 0x36, 0xb7, 0xad,
+	0x55, 0x76, 0x3e, 0x5b, 0x19, 0x85, 0x5a, 0xe5, 0x17, 0xf0, 0x65, 0x3b, 0x0f, 0x51, 0xbf, 0xb6,
+	0xf7, 0xb0, 0xb6, 0x0f, 0x45, 0xff, 0x06, 0xdb, 0x22, 0x3f, 0x83, 0xb1, 0x2a, 0xd6, 0x18, 0x09,
+	0x27, 0x63, 0x32, 0x7f, 0x80, 0x83, 0x7f, 0xce, 0x93, 0x9f, 0x2d, 0x4e, 0x5b, 0x86, 0xff, 0xf7,
+	0x0e, 0xb8, 0x3f, 0x31, 0x71, 0xc9, 0xb6, 0xec, 0xf3, 0x6b, 0x70, 0x9b, 0x4c, 0xcd, 0x97, 0xe5,
+	0xb3, 0x52, 0xbd, 0xd3, 0x20, 0x27, 0xe0, 0x54, 0xb1, 0x60, 0x65, 0x7f, 0x18, 0x4c, 0x2e, 0xd4,
+	0xba, 0xf4, 0xe7, 0x57, 0xe6, 0xb1, 0xc8, 0x70, 0x0c, 0x86, 0xd4, 0x18, 0x88, 0xe2, 0x70, 0xe8,
+	0x66, 0x8e, 0x9b, 0x5e, 0x1f, 0xc1, 0x6e, 0xa7, 0x0f, 0x3d, 0x39, 0x74, 0x90, 0xaf, 0xc0, 0xd5,
+	0xef, 0x48, 0x16, 0xef, 0x19, 0x96, 0x72, 0x97, 0x8e, 0x35, 0x70, 0x51, 0xbc, 0x67, 0xbd, 0xea,
+	0x8d, 0x3f, 0x55, 0xbd, 0xb3, 0xb7, 0xff, 0x6c, 0x0e, 0x07, 0x1f, 0x36, 0x87, 0x83, 0x7f, 0x37,
+	0x87, 0x83, 0x5f, 0x5f, 0x5e, 0x16, 0x2a, 0xaf, 0x13, 0xfd, 0x07, 0x09, 0xdb, 0x92, 0x74, 0x56,
+	0x52, 0xa4, 0xe1, 0xa7, 0xfe, 0x9c, 0x89, 0x83, 0xbf, 0xa9, 0x6f, 0xff, 0x1f, 0x00, 0xa6, 0x6c,
+	0x10, 0x76, 0x64, 0x07, 0x00, 0x00,
 }
 
 func (m *DatumInputs) Marshal() (dAtA []byte, err error) {
@@ -975,6 +1002,11 @@ func (m *DatumStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.DatumsQuarantined != 0 {
+		i = encodeVarintTransform(dAtA, i, uint64(m.DatumsQuarantined))
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.FailedDatumID) > 0 {
 		i -= len(m.FailedDatumID)
 		copy(dAtA[i:], m.FailedDatumID)
@@ -1041,6 +1073,18 @@ func (m *DatumData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timeline != nil {
+		{
+			size, err := m.Timeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransform(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DatumsObject) > 0 {
 		i -= len(m.DatumsObject)
 		copy(dAtA[i:], m.DatumsObject)
@@ -1137,6 +1181,18 @@ func (m *MergeData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Timeline != nil {
+		{
+			size, err := m.Timeline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTransform(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.TreeSize != 0 {
 		i = encodeVarintTransform(dAtA, i, uint64(m.TreeSize))
 		i--
@@ -1365,6 +1421,9 @@ func (m *DatumStats) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	if m.DatumsQuarantined != 0 {
+		n += 1 + sovTransform(uint64(m.DatumsQuarantined))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1405,6 +1464,10 @@ func (m *DatumData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTransform(uint64(l))
 	}
+	if m.Timeline != nil {
+		l = m.Timeline.Size()
+		n += 1 + l + sovTransform(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1444,6 +1507,10 @@ func (m *MergeData) Size() (n int) {
 	if m.TreeSize != 0 {
 		n += 1 + sovTransform(uint64(m.TreeSize))
 	}
+	if m.Timeline != nil {
+		l = m.Timeline.Size()
+		n += 1 + l + sovTransform(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2264,6 +2331,25 @@ func (m *DatumStats) Unmarshal(dAtA []byte) error {
 			}
 			m.FailedDatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumsQuarantined", wireType)
+			}
+			m.DatumsQuarantined = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumsQuarantined |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -2558,6 +2644,42 @@ func (m *DatumData) Unmarshal(dAtA []byte) error {
 			}
 			m.DatumsObject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeline == nil {
+				m.Timeline = &pps.JobTimeline{}
+			}
+			if err := m.Timeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -2808,6 +2930,42 @@ func (m *MergeData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransform
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransform
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransform
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timeline == nil {
+				m.Timeline = &pps.JobTimeline{}
+			}
+			if err := m.Timeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransform(dAtA[iNdEx:])
@@ -335,6 +335,22 @@ func TestJobFailedDatum(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobQuarantinedDatum(t *testing.T) {
+	pi := defaultPipelineInfo()
+	pi.Transform.Cmd = []string{"bash", "-c", "(exit 1)"}
+	pi.RetryPolicy = &pps.RetryPolicy{Quarantine: true}
+	err := withWorkerSpawnerPair(pi, func(env *testEnv) error {
+		ctx, etcdJobInfo := mockBasicJob(t, env, pi)
+		triggerJob(t, env, pi, []*inputFile{newInput("file", "foobar")})
+		ctx = withTimeout(ctx, 10*time.Second)
+		<-ctx.Done()
+		require.Equal(t, pps.JobState_JOB_SUCCESS, etcdJobInfo.State)
+		require.Equal(t, "1 datums quarantined", etcdJobInfo.Reason)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestJobMultiDatum(t *testing.T) {
 	pi := defaultPipelineInfo()
 	err := withWorkerSpawnerPair(pi, func(env *testEnv) error {
@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -25,6 +26,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/exec"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
@@ -34,6 +36,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/worker/driver"
 	"github.com/pachyderm/pachyderm/src/server/worker/logs"
 	"github.com/pachyderm/pachyderm/src/server/worker/server"
+	"github.com/pachyderm/pachyderm/src/server/worker/timeline"
 )
 
 var (
@@ -95,18 +98,95 @@ func mergeStats(x, y *DatumStats) error {
 		}
 		xps.DownloadBytes += yps.DownloadBytes
 		xps.UploadBytes += yps.UploadBytes
+		xps.CpuSeconds += yps.CpuSeconds
+		xps.MemoryByteSeconds += yps.MemoryByteSeconds
 	}
 
 	x.DatumsProcessed += y.DatumsProcessed
 	x.DatumsSkipped += y.DatumsSkipped
 	x.DatumsFailed += y.DatumsFailed
 	x.DatumsRecovered += y.DatumsRecovered
+	x.DatumsQuarantined += y.DatumsQuarantined
 	if x.FailedDatumID == "" {
 		x.FailedDatumID = y.FailedDatumID
 	}
 	return nil
 }
 
+// datumBackOff returns the backoff to use between attempts of a failed datum,
+// as specified by the pipeline's retry policy.
+func datumBackOff(policy *pps.RetryPolicy) (backoff.BackOff, error) {
+	if policy == nil || policy.InitialBackoff == nil {
+		return &backoff.ZeroBackOff{}, nil
+	}
+	initial, err := types.DurationFromProto(policy.InitialBackoff)
+	if err != nil {
+		return nil, err
+	}
+	maxInterval := backoff.DefaultMaxInterval
+	if policy.MaxBackoff != nil {
+		if maxInterval, err = types.DurationFromProto(policy.MaxBackoff); err != nil {
+			return nil, err
+		}
+	}
+	multiplier := policy.Multiplier
+	if multiplier == 0 {
+		multiplier = 2
+	}
+	b := &backoff.ExponentialBackOff{
+		InitialInterval:     initial,
+		RandomizationFactor: backoff.DefaultRandomizationFactor,
+		Multiplier:          multiplier,
+		MaxInterval:         maxInterval,
+		MaxElapsedTime:      0,
+		Clock:               backoff.SystemClock,
+	}
+	b.Reset()
+	return b, nil
+}
+
+// isRetryable returns true if a datum that failed with err should be retried
+// under the pipeline's retry policy. If the policy restricts retries to
+// specific return codes, any error that isn't an exit of the user code with
+// one of those codes is permanent.
+func isRetryable(policy *pps.RetryPolicy, err error) bool {
+	if policy == nil || len(policy.RetryReturnCode) == 0 {
+		return true
+	}
+	exitErr := &exec.ExitError{}
+	if !errors.As(err, &exitErr) {
+		return false
+	}
+	status, ok := exitErr.Sys().(syscall.WaitStatus)
+	if !ok {
+		return false
+	}
+	for _, returnCode := range policy.RetryReturnCode {
+		if int(returnCode) == status.ExitStatus() {
+			return true
+		}
+	}
+	return false
+}
+
+// logStep runs cb as a step of a task, which is both logged and recorded in
+// the job's timeline (as a child of the span carried by the driver's context).
+func logStep(driver driver.Driver, logger logs.TaggedLogger, name string, cb func() error) error {
+	span := timeline.StartFromContext(driver.PachClient().Ctx(), name)
+	err := logger.LogStep(name, cb)
+	span.Finish(err)
+	return err
+}
+
+// startTaskSpan starts a span covering a task, in a new recorder for the
+// task's timeline, and returns a driver whose context carries the span. Only
+// the first timeline.MaxDatumsPerTask datums of the task are recorded.
+func startTaskSpan(d driver.Driver, jobID string, name string) (driver.Driver, *timeline.Recorder, *timeline.Span) {
+	recorder := timeline.NewRecorder(jobID, os.Getenv(client.PPSPodNameEnv), timeline.MaxDatumsPerTask, nil)
+	span := recorder.Start(nil, name, "")
+	return d.WithContext(timeline.WithSpan(d.PachClient().Ctx(), span)), recorder, span
+}
+
 // Worker handles a transform pipeline work subtask, then returns.
 func Worker(driver driver.Driver, logger logs.TaggedLogger, subtask *work.Task, status *Status) (retErr error) {
 	defer func() {
@@ -125,7 +205,11 @@ func Worker(driver driver.Driver, logger logs.TaggedLogger, subtask *work.Task,
 		return status.withJob(datumData.JobID, func() error {
 			logger = logger.WithJob(datumData.JobID)
 			if err := logger.LogStep("datum task", func() error {
-				return handleDatumTask(driver, logger, datumData, subtask.ID, status)
+				driver, recorder, span := startTaskSpan(driver, datumData.JobID, "datum task")
+				err := handleDatumTask(driver, logger, datumData, subtask.ID, status)
+				span.Finish(err)
+				datumData.Timeline = recorder.Timeline()
+				return err
 			}); err != nil {
 				return err
 			}
@@ -141,7 +225,11 @@ func Worker(driver driver.Driver, logger logs.TaggedLogger, subtask *work.Task,
 		return status.withJob(mergeData.JobID, func() error {
 			logger = logger.WithJob(mergeData.JobID)
 			if err := logger.LogStep("merge task", func() error {
-				return handleMergeTask(driver, logger, mergeData)
+				driver, recorder, span := startTaskSpan(driver, mergeData.JobID, fmt.Sprintf("merge task (shard %d)", mergeData.Shard))
+				err := handleMergeTask(driver, logger, mergeData)
+				span.Finish(err)
+				mergeData.Timeline = recorder.Timeline()
+				return err
 			}); err != nil {
 				return err
 			}
@@ -181,7 +269,7 @@ func forEachDatum(driver driver.Driver, object string, cb func(int64, []*common.
 }
 
 func uploadRecoveredDatums(driver driver.Driver, logger logs.TaggedLogger, recoveredDatums []string, object string) (retErr error) {
-	return logger.LogStep("uploading recovered datums", func() error {
+	return logStep(driver, logger, "uploading recovered datums", func() error {
 		message := &RecoveredDatums{Hashes: recoveredDatums}
 
 		writer, err := driver.PachClient().DirectObjWriter(object)
@@ -208,7 +296,7 @@ func uploadChunk(
 	object string,
 	subtaskID string,
 ) (retErr error) {
-	return logger.LogStep("uploading hashtree chunk", func() error {
+	return logStep(driver, logger, "uploading hashtree chunk", func() error {
 		// Merge the datums for this job into a chunk
 		buf := &bytes.Buffer{}
 		if err := subtaskCache.Merge(hashtree.NewWriter(buf), nil, nil); err != nil {
@@ -283,7 +371,7 @@ func handleDatumTask(driver driver.Driver, logger logs.TaggedLogger, data *Datum
 
 		var queueSize, dataProcessed, dataRecovered int64
 		// TODO: the status.GetStatus call may read the process stats without having a lock, it this ~ok?
-		if err := logger.LogStep("processing datums", func() error {
+		if err := logStep(driver, logger, "processing datums", func() error {
 			return status.withStats(data.Stats.ProcessStats, &queueSize, &dataProcessed, &dataRecovered, func() error {
 				ctx, cancel := context.WithCancel(driver.PachClient().Ctx())
 				defer cancel()
@@ -412,6 +500,11 @@ func processDatum(
 		return stats, recoveredDatums, nil
 	}
 
+	// Record the datum in the task's timeline, and pass the span to the driver
+	// so that downloading, processing and uploading are recorded as its children
+	span := timeline.FromContext(driver.PachClient().Ctx()).StartDatum("datum", datumID)
+	driver = driver.WithContext(timeline.WithSpan(driver.PachClient().Ctx(), span))
+
 	statsRoot := path.Join("/", datumID)
 	var inputTree, outputTree *hashtree.Ordered
 	var statsTree *hashtree.Unordered
@@ -443,8 +536,15 @@ func processDatum(
 		}()
 	}
 
+	retryPolicy := driver.PipelineInfo().RetryPolicy
+	b, err := datumBackOff(retryPolicy)
+	if err != nil {
+		return stats, recoveredDatums, err
+	}
+
 	var failures int64
-	if err := backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
+	var quarantined bool
+	err = backoff.RetryUntilCancel(driver.PachClient().Ctx(), func() error {
 		var err error
 
 		// WithData will download the inputs for this datum
@@ -460,7 +560,8 @@ func processDatum(
 				return status.withDatum(inputs, cancel, func() error {
 					env := driver.UserCodeEnv(logger.JobID(), outputCommit, inputs)
 					if err := driver.RunUserCode(logger, env, processStats, driver.PipelineInfo().DatumTimeout); err != nil {
-						if driver.PipelineInfo().Transform.ErrCmd != nil && failures == driver.PipelineInfo().DatumTries-1 {
+						lastTry := failures == driver.PipelineInfo().DatumTries-1 || !isRetryable(retryPolicy, err)
+						if driver.PipelineInfo().Transform.ErrCmd != nil && lastTry {
 							if err = driver.RunUserErrorHandlingCode(logger, env, processStats, driver.PipelineInfo().DatumTimeout); err != nil {
 								return errors.Wrap(err, "RunUserErrorHandlingCode")
 							}
@@ -487,10 +588,13 @@ func processDatum(
 			return datumCache.Put(uuid.NewWithoutDashes(), bytes.NewReader(hashtreeBytes))
 		})
 		return err
-	}, &backoff.ZeroBackOff{}, func(err error, d time.Duration) error {
+	}, b, func(err error, d time.Duration) error {
 		failures++
-		if failures >= driver.PipelineInfo().DatumTries {
+		if failures >= driver.PipelineInfo().DatumTries || !isRetryable(retryPolicy, err) {
 			logger.Logf("failed to process datum with error: %+v", err)
+			// Quarantined datums are recorded under a separate file in the stats
+			// tree so that ListDatum can distinguish them from failed datums.
+			quarantined = retryPolicy != nil && retryPolicy.Quarantine && !errors.Is(err, errDatumRecovered)
 			if statsTree != nil {
 				object, size, err := driver.PachClient().PutObject(strings.NewReader(err.Error()))
 				if err != nil {
@@ -504,7 +608,11 @@ func processDatum(
 					if err != nil {
 						return err
 					}
-					statsTree.PutFile("failure", h, size, objectInfo.BlockRef)
+					if quarantined {
+						statsTree.PutFile("quarantined", h, size, objectInfo.BlockRef)
+					} else {
+						statsTree.PutFile("failure", h, size, objectInfo.BlockRef)
+					}
 				}
 			}
 			return err
@@ -516,10 +624,18 @@ func processDatum(
 		}
 		logger.Logf("failed processing datum: %v, retrying in %v", err, d)
 		return nil
-	}); errors.Is(err, errDatumRecovered) {
+	})
+	span.Finish(err)
+	if errors.Is(err, errDatumRecovered) {
 		// keep track of the recovered datums
 		recoveredDatums = []string{tag}
 		stats.DatumsRecovered++
+	} else if err != nil && quarantined {
+		// Quarantined datums are treated like recovered datums so that they are
+		// reprocessed by the next job rather than skipped.
+		logger.Logf("quarantining datum %s", datumID)
+		recoveredDatums = []string{tag}
+		stats.DatumsQuarantined++
 	} else if err != nil {
 		stats.FailedDatumID = datumID
 		stats.DatumsFailed++
@@ -663,7 +779,7 @@ func handleMergeTask(driver driver.Driver, logger logs.TaggedLogger, data *Merge
 		}
 	}()
 
-	if err := logger.LogStep("downloading hashtree chunks", func() error {
+	if err := logStep(driver, logger, "downloading hashtree chunks", func() error {
 		eg, _ := errgroup.WithContext(driver.PachClient().Ctx())
 		limiter := limit.New(20) // TODO: base this off of configuration
 
@@ -728,7 +844,7 @@ func handleMergeTask(driver driver.Driver, logger logs.TaggedLogger, data *Merge
 		return err
 	}
 
-	return logger.LogStep("merging hashtree chunks", func() error {
+	return logStep(driver, logger, "merging hashtree chunks", func() error {
 		tree, size, err := merge(driver, parentReader, cache, data.Shard)
 		if err != nil {
 			return err
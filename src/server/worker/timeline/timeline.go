@@ -0,0 +1,314 @@
+// Package timeline records the timeline of a job: the steps run by the job's
+// master, the tasks run by its workers and the phases of processing each
+// datum (downloading, processing and uploading). The timeline is stored in
+// object storage, so that it can be rendered by 'pachctl inspect job
+// --timeline', and each span is also exported as part of a trace (whose trace
+// ID is the job ID) if tracing is configured.
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+const (
+	// MaxDatumsPerTask is the number of datums in each task whose spans are
+	// recorded. The spans of the remaining datums are dropped (and counted),
+	// as recording every datum of a large job would make its timeline huge.
+	MaxDatumsPerTask = 20
+	// MaxSpans is the maximum number of spans stored in a job's timeline.
+	MaxSpans = 10000
+)
+
+// Object returns the path of the object that a job's timeline is stored in.
+// This is deliberately outside of the job's artifacts, which are deleted when
+// the job finishes.
+func Object(jobID string) string {
+	return path.Join("timelines", fmt.Sprintf("job-%s", jobID))
+}
+
+// RootSpanID returns the ID of the span covering the whole job. It's derived
+// from the job ID, so that the spans recorded by workers (and by a restarted
+// master) all share the same root.
+func RootSpanID(jobID string) string {
+	return spanIDString(rootID(jobID))
+}
+
+func rootID(jobID string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(jobID))
+	return h.Sum64()
+}
+
+func spanIDString(id uint64) string {
+	return fmt.Sprintf("%016x", id)
+}
+
+func traceID(jobID string) jaeger.TraceID {
+	if id, err := jaeger.TraceIDFromString(jobID); err == nil {
+		return id
+	}
+	return jaeger.TraceID{Low: rootID(jobID)}
+}
+
+// Recorder records the spans of a job's timeline. It's safe for concurrent
+// use.
+type Recorder struct {
+	jobID     string
+	worker    string
+	maxDatums int
+	traceID   jaeger.TraceID
+
+	mu       sync.Mutex
+	timeline *pps.JobTimeline
+	datums   map[string]bool
+}
+
+// NewRecorder creates a recorder for the given job's timeline, which records
+// spans as run by worker. Spans are added to timeline, which may be nil.
+// The spans of at most maxDatums datums are recorded (or of every datum if
+// maxDatums is negative).
+func NewRecorder(jobID, worker string, maxDatums int, timeline *pps.JobTimeline) *Recorder {
+	if timeline == nil {
+		timeline = &pps.JobTimeline{}
+	}
+	return &Recorder{
+		jobID:     jobID,
+		worker:    worker,
+		maxDatums: maxDatums,
+		traceID:   traceID(jobID),
+		timeline:  timeline,
+		datums:    make(map[string]bool),
+	}
+}
+
+// Timeline returns a copy of the recorded timeline.
+func (r *Recorder) Timeline() *pps.JobTimeline {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &pps.JobTimeline{
+		Spans:             append([]*pps.TimelineSpan(nil), r.timeline.Spans...),
+		DatumSpansDropped: r.timeline.DatumSpansDropped,
+	}
+}
+
+// Add adds the spans of another timeline (e.g. one recorded by a worker) to
+// the recorded timeline.
+func (r *Recorder) Add(timeline *pps.JobTimeline) {
+	if timeline == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.timeline.DatumSpansDropped += timeline.DatumSpansDropped
+	for _, span := range timeline.Spans {
+		r.addLocked(span)
+	}
+}
+
+func (r *Recorder) addLocked(span *pps.TimelineSpan) {
+	if len(r.timeline.Spans) >= MaxSpans {
+		r.timeline.DatumSpansDropped++
+		return
+	}
+	r.timeline.Spans = append(r.timeline.Spans, span)
+}
+
+// Span is a span in progress. A nil Span is valid, and ignores all calls, so
+// that code can be instrumented whether or not a timeline is being recorded.
+type Span struct {
+	r        *Recorder
+	span     *pps.TimelineSpan
+	start    time.Time
+	parentID uint64
+	id       uint64
+	finished bool
+}
+
+// StartRoot starts the span covering the whole job, which started at start.
+func (r *Recorder) StartRoot(name string, start time.Time) *Span {
+	return r.start(name, "", rootID(r.jobID), 0, start)
+}
+
+// Start starts a span. The span is a child of parent or, if parent is nil, of
+// the job's root span.
+func (r *Recorder) Start(parent *Span, name, datumID string) *Span {
+	parentID := rootID(r.jobID)
+	if parent != nil {
+		parentID = parent.id
+	}
+	if datumID != "" && !r.keepDatum(datumID) {
+		return nil
+	}
+	return r.start(name, datumID, rand.Uint64(), parentID, time.Now())
+}
+
+// keepDatum returns true if the spans of the given datum should be recorded,
+// and counts the span as dropped otherwise.
+func (r *Recorder) keepDatum(datumID string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.datums[datumID] {
+		if r.maxDatums >= 0 && len(r.datums) >= r.maxDatums {
+			r.timeline.DatumSpansDropped++
+			return false
+		}
+		r.datums[datumID] = true
+	}
+	return true
+}
+
+func (r *Recorder) start(name, datumID string, id, parentID uint64, start time.Time) *Span {
+	span := &pps.TimelineSpan{
+		SpanID:  spanIDString(id),
+		Name:    name,
+		Worker:  r.worker,
+		DatumID: datumID,
+	}
+	if parentID != 0 {
+		span.ParentSpanID = spanIDString(parentID)
+	}
+	return &Span{r: r, span: span, start: start, id: id, parentID: parentID}
+}
+
+// Start starts a child of s, for the same datum as s (if any).
+func (s *Span) Start(name string) *Span {
+	if s == nil {
+		return nil
+	}
+	return s.r.Start(s, name, s.span.DatumID)
+}
+
+// StartDatum starts a child of s for processing the given datum.
+func (s *Span) StartDatum(name, datumID string) *Span {
+	if s == nil {
+		return nil
+	}
+	return s.r.Start(s, name, datumID)
+}
+
+// Finish ends the span, and records it along with err, which may be nil.
+// Only the first call to Finish has any effect.
+func (s *Span) Finish(err error) {
+	if s == nil {
+		return
+	}
+	end := time.Now()
+	s.r.mu.Lock()
+	if s.finished {
+		s.r.mu.Unlock()
+		return
+	}
+	s.finished = true
+	s.span.Start, _ = types.TimestampProto(s.start)
+	s.span.End, _ = types.TimestampProto(end)
+	if err != nil {
+		s.span.Error = err.Error()
+	}
+	s.r.addLocked(s.span)
+	s.r.mu.Unlock()
+	s.export(end)
+}
+
+// export reports the span to the global tracer, with the IDs it was recorded
+// with, so that the exported trace matches the stored timeline.
+func (s *Span) export(end time.Time) {
+	if !tracing.IsActive() {
+		return
+	}
+	ctx := jaeger.NewSpanContext(s.r.traceID, jaeger.SpanID(s.id), jaeger.SpanID(s.parentID), true, nil)
+	tags := opentracing.Tags{"job-id": s.r.jobID}
+	if s.span.Worker != "" {
+		tags["worker"] = s.span.Worker
+	}
+	if s.span.DatumID != "" {
+		tags["datum-id"] = s.span.DatumID
+	}
+	if s.span.Error != "" {
+		tags["error"] = true
+		tags["error.message"] = s.span.Error
+	}
+	span := opentracing.GlobalTracer().StartSpan(s.span.Name, jaeger.SelfRef(ctx), opentracing.StartTime(s.start), tags)
+	span.FinishWithOptions(opentracing.FinishOptions{FinishTime: end})
+}
+
+type spanKey struct{}
+
+// WithSpan returns a context that carries span, so that functions that are
+// passed the context can record children of span.
+func WithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+// FromContext returns the span carried by ctx, or nil if there is none.
+func FromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// StartFromContext starts a child of the span carried by ctx, if any. The
+// returned span is nil (and ignores all calls) if ctx doesn't carry a span.
+func StartFromContext(ctx context.Context, name string) *Span {
+	return FromContext(ctx).Start(name)
+}
+
+// Load reads a job's stored timeline. A job that has no stored timeline has
+// an empty one.
+func Load(pachClient *client.APIClient, jobID string) (_ *pps.JobTimeline, retErr error) {
+	timeline := &pps.JobTimeline{}
+	reader, err := pachClient.DirectObjReader(Object(jobID))
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return timeline, nil
+		}
+		return nil, errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := reader.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	if err := pbutil.NewReader(reader).Read(timeline); err != nil {
+		if errutil.IsNotFoundError(err) {
+			return &pps.JobTimeline{}, nil
+		}
+		return nil, err
+	}
+	return timeline, nil
+}
+
+// Save stores the recorded timeline, replacing any timeline previously stored
+// for the job.
+func (r *Recorder) Save(pachClient *client.APIClient) (retErr error) {
+	timeline := r.Timeline()
+	writer, err := pachClient.DirectObjWriter(Object(r.jobID))
+	if err != nil {
+		return errors.EnsureStack(err)
+	}
+	defer func() {
+		if err := writer.Close(); err != nil && retErr == nil {
+			retErr = errors.EnsureStack(err)
+		}
+	}()
+	_, err = pbutil.NewWriter(writer).Write(timeline)
+	return err
+}
@@ -0,0 +1,106 @@
+package timeline
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/errors"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+const testJobID = "0123456789abcdef0123456789abcdef"
+
+func spanNames(timeline *pps.JobTimeline) []string {
+	var result []string
+	for _, span := range timeline.Spans {
+		result = append(result, span.Name)
+	}
+	return result
+}
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder(testJobID, "worker-1", -1, nil)
+	task := r.Start(nil, "datum task", "")
+	ctx := WithSpan(context.Background(), task)
+	datum := FromContext(ctx).StartDatum("datum", "d1")
+	download := StartFromContext(WithSpan(ctx, datum), "download")
+	download.Finish(nil)
+	datum.Finish(errors.New("user code failed"))
+	task.Finish(nil)
+	// Only the first call to Finish records the span
+	task.Finish(errors.New("ignored"))
+
+	timeline := r.Timeline()
+	require.Equal(t, []string{"download", "datum", "datum task"}, spanNames(timeline))
+	downloadSpan, datumSpan, taskSpan := timeline.Spans[0], timeline.Spans[1], timeline.Spans[2]
+	require.Equal(t, RootSpanID(testJobID), taskSpan.ParentSpanID)
+	require.Equal(t, taskSpan.SpanID, datumSpan.ParentSpanID)
+	require.Equal(t, datumSpan.SpanID, downloadSpan.ParentSpanID)
+	require.Equal(t, "d1", downloadSpan.DatumID)
+	require.Equal(t, "worker-1", downloadSpan.Worker)
+	require.Equal(t, "user code failed", datumSpan.Error)
+	require.Equal(t, "", taskSpan.Error)
+	require.True(t, taskSpan.End.Compare(taskSpan.Start) >= 0)
+
+	// The root span has a deterministic ID and no parent
+	root := r.StartRoot("job", task.start)
+	root.Finish(nil)
+	rootSpan := r.Timeline().Spans[3]
+	require.Equal(t, RootSpanID(testJobID), rootSpan.SpanID)
+	require.Equal(t, "", rootSpan.ParentSpanID)
+}
+
+func TestNilSpan(t *testing.T) {
+	// Code is instrumented whether or not its context carries a span
+	span := StartFromContext(context.Background(), "download")
+	require.True(t, span == nil)
+	require.True(t, span.Start("child") == nil)
+	require.True(t, span.StartDatum("datum", "d1") == nil)
+	span.Finish(nil)
+	require.Equal(t, context.Background(), WithSpan(context.Background(), span))
+}
+
+func TestDatumSampling(t *testing.T) {
+	r := NewRecorder(testJobID, "worker-1", 2, nil)
+	task := r.Start(nil, "datum task", "")
+	for i := 0; i < 5; i++ {
+		datum := task.StartDatum("datum", fmt.Sprintf("d%d", i))
+		datum.Start("download").Finish(nil)
+		datum.Start("process").Finish(nil)
+		datum.Finish(nil)
+	}
+	task.Finish(nil)
+	timeline := r.Timeline()
+	require.Equal(t, 2*3+1, len(timeline.Spans))
+	// The spans of the other datums are never started, so only their datum
+	// spans are counted
+	require.Equal(t, int64(3), timeline.DatumSpansDropped)
+
+	// Merging timelines keeps the dropped count
+	master := NewRecorder(testJobID, "", -1, nil)
+	master.Add(timeline)
+	master.Add(timeline)
+	require.Equal(t, 2*len(timeline.Spans), len(master.Timeline().Spans))
+	require.Equal(t, int64(6), master.Timeline().DatumSpansDropped)
+}
+
+func TestMaxSpans(t *testing.T) {
+	r := NewRecorder(testJobID, "", -1, nil)
+	spans := make([]*pps.TimelineSpan, MaxSpans+10)
+	for i := range spans {
+		spans[i] = &pps.TimelineSpan{Name: "step"}
+	}
+	r.Add(&pps.JobTimeline{Spans: spans})
+	require.Equal(t, MaxSpans, len(r.Timeline().Spans))
+	require.Equal(t, int64(10), r.Timeline().DatumSpansDropped)
+}
+
+func TestTraceID(t *testing.T) {
+	id := traceID(testJobID)
+	require.Equal(t, testJobID, fmt.Sprintf("%016x%016x", id.High, id.Low))
+	// Job IDs that aren't valid trace IDs still produce a stable trace ID
+	require.Equal(t, traceID("not-hex"), traceID("not-hex"))
+	require.True(t, traceID("not-hex").IsValid())
+}